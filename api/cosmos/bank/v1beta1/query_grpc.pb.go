@@ -32,6 +32,10 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_ScheduledSends_FullMethodName             = "/cosmos.bank.v1beta1.Query/ScheduledSends"
+	Query_PullPaymentAllowances_FullMethodName      = "/cosmos.bank.v1beta1.Query/PullPaymentAllowances"
+	Query_SupplyHistory_FullMethodName              = "/cosmos.bank.v1beta1.Query/SupplyHistory"
+	Query_TotalAccountValue_FullMethodName          = "/cosmos.bank.v1beta1.Query/TotalAccountValue"
 )
 
 // QueryClient is the client API for Query service.
@@ -90,6 +94,18 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// ScheduledSends queries the pending scheduled sends of an account.
+	ScheduledSends(ctx context.Context, in *QueryScheduledSendsRequest, opts ...grpc.CallOption) (*QueryScheduledSendsResponse, error)
+	// PullPaymentAllowances queries the pull payment allowances granted by an
+	// account.
+	PullPaymentAllowances(ctx context.Context, in *QueryPullPaymentAllowancesRequest, opts ...grpc.CallOption) (*QueryPullPaymentAllowancesResponse, error)
+	// SupplyHistory queries the supply checkpoints recorded for a denom,
+	// optionally restricted to a block height range.
+	SupplyHistory(ctx context.Context, in *QuerySupplyHistoryRequest, opts ...grpc.CallOption) (*QuerySupplyHistoryResponse, error)
+	// TotalAccountValue queries the total value of an account per denom,
+	// composing its balances with the value held for it by the other modules,
+	// e.g. its bonded and unbonding stake and its unclaimed rewards.
+	TotalAccountValue(ctx context.Context, in *QueryTotalAccountValueRequest, opts ...grpc.CallOption) (*QueryTotalAccountValueResponse, error)
 }
 
 type queryClient struct {
@@ -217,6 +233,42 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) ScheduledSends(ctx context.Context, in *QueryScheduledSendsRequest, opts ...grpc.CallOption) (*QueryScheduledSendsResponse, error) {
+	out := new(QueryScheduledSendsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledSends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PullPaymentAllowances(ctx context.Context, in *QueryPullPaymentAllowancesRequest, opts ...grpc.CallOption) (*QueryPullPaymentAllowancesResponse, error) {
+	out := new(QueryPullPaymentAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_PullPaymentAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHistory(ctx context.Context, in *QuerySupplyHistoryRequest, opts ...grpc.CallOption) (*QuerySupplyHistoryResponse, error) {
+	out := new(QuerySupplyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalAccountValue(ctx context.Context, in *QueryTotalAccountValueRequest, opts ...grpc.CallOption) (*QueryTotalAccountValueResponse, error) {
+	out := new(QueryTotalAccountValueResponse)
+	err := c.cc.Invoke(ctx, Query_TotalAccountValue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -273,6 +325,18 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// ScheduledSends queries the pending scheduled sends of an account.
+	ScheduledSends(context.Context, *QueryScheduledSendsRequest) (*QueryScheduledSendsResponse, error)
+	// PullPaymentAllowances queries the pull payment allowances granted by an
+	// account.
+	PullPaymentAllowances(context.Context, *QueryPullPaymentAllowancesRequest) (*QueryPullPaymentAllowancesResponse, error)
+	// SupplyHistory queries the supply checkpoints recorded for a denom,
+	// optionally restricted to a block height range.
+	SupplyHistory(context.Context, *QuerySupplyHistoryRequest) (*QuerySupplyHistoryResponse, error)
+	// TotalAccountValue queries the total value of an account per denom,
+	// composing its balances with the value held for it by the other modules,
+	// e.g. its bonded and unbonding stake and its unclaimed rewards.
+	TotalAccountValue(context.Context, *QueryTotalAccountValueRequest) (*QueryTotalAccountValueResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -319,6 +383,18 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) ScheduledSends(context.Context, *QueryScheduledSendsRequest) (*QueryScheduledSendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledSends not implemented")
+}
+func (UnimplementedQueryServer) PullPaymentAllowances(context.Context, *QueryPullPaymentAllowancesRequest) (*QueryPullPaymentAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullPaymentAllowances not implemented")
+}
+func (UnimplementedQueryServer) SupplyHistory(context.Context, *QuerySupplyHistoryRequest) (*QuerySupplyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHistory not implemented")
+}
+func (UnimplementedQueryServer) TotalAccountValue(context.Context, *QueryTotalAccountValueRequest) (*QueryTotalAccountValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalAccountValue not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -566,6 +642,78 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledSends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledSends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledSends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledSends(ctx, req.(*QueryScheduledSendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PullPaymentAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPullPaymentAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PullPaymentAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PullPaymentAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PullPaymentAllowances(ctx, req.(*QueryPullPaymentAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHistory(ctx, req.(*QuerySupplyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalAccountValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalAccountValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalAccountValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalAccountValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalAccountValue(ctx, req.(*QueryTotalAccountValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -625,6 +773,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "ScheduledSends",
+			Handler:    _Query_ScheduledSends_Handler,
+		},
+		{
+			MethodName: "PullPaymentAllowances",
+			Handler:    _Query_PullPaymentAllowances_Handler,
+		},
+		{
+			MethodName: "SupplyHistory",
+			Handler:    _Query_SupplyHistory_Handler,
+		},
+		{
+			MethodName: "TotalAccountValue",
+			Handler:    _Query_TotalAccountValue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                 = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName            = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName                 = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName       = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_ScheduleSend_FullMethodName         = "/cosmos.bank.v1beta1.Msg/ScheduleSend"
+	Msg_CancelScheduledSend_FullMethodName  = "/cosmos.bank.v1beta1.Msg/CancelScheduledSend"
+	Msg_AuthorizePullPayment_FullMethodName = "/cosmos.bank.v1beta1.Msg/AuthorizePullPayment"
+	Msg_RevokePullPayment_FullMethodName    = "/cosmos.bank.v1beta1.Msg/RevokePullPayment"
+	Msg_PullPayment_FullMethodName          = "/cosmos.bank.v1beta1.Msg/PullPayment"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +49,21 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// ScheduleSend defines a method for escrowing coins to be sent from one
+	// account to another at a future block time or height.
+	ScheduleSend(ctx context.Context, in *MsgScheduleSend, opts ...grpc.CallOption) (*MsgScheduleSendResponse, error)
+	// CancelScheduledSend defines a method for cancelling a pending scheduled
+	// send and returning the escrowed coins to the sender.
+	CancelScheduledSend(ctx context.Context, in *MsgCancelScheduledSend, opts ...grpc.CallOption) (*MsgCancelScheduledSendResponse, error)
+	// AuthorizePullPayment defines a method for allowing a payee to pull up to a
+	// given amount of coins per period from the payer's account. An existing
+	// allowance between the same payer and payee is replaced.
+	AuthorizePullPayment(ctx context.Context, in *MsgAuthorizePullPayment, opts ...grpc.CallOption) (*MsgAuthorizePullPaymentResponse, error)
+	// RevokePullPayment defines a method for revoking a pull payment allowance.
+	RevokePullPayment(ctx context.Context, in *MsgRevokePullPayment, opts ...grpc.CallOption) (*MsgRevokePullPaymentResponse, error)
+	// PullPayment defines a method for a payee to pull coins from a payer's
+	// account within the limits of a pull payment allowance.
+	PullPayment(ctx context.Context, in *MsgPullPayment, opts ...grpc.CallOption) (*MsgPullPaymentResponse, error)
 }
 
 type msgClient struct {
@@ -99,6 +119,51 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) ScheduleSend(ctx context.Context, in *MsgScheduleSend, opts ...grpc.CallOption) (*MsgScheduleSendResponse, error) {
+	out := new(MsgScheduleSendResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleSend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledSend(ctx context.Context, in *MsgCancelScheduledSend, opts ...grpc.CallOption) (*MsgCancelScheduledSendResponse, error) {
+	out := new(MsgCancelScheduledSendResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledSend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AuthorizePullPayment(ctx context.Context, in *MsgAuthorizePullPayment, opts ...grpc.CallOption) (*MsgAuthorizePullPaymentResponse, error) {
+	out := new(MsgAuthorizePullPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_AuthorizePullPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokePullPayment(ctx context.Context, in *MsgRevokePullPayment, opts ...grpc.CallOption) (*MsgRevokePullPaymentResponse, error) {
+	out := new(MsgRevokePullPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokePullPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PullPayment(ctx context.Context, in *MsgPullPayment, opts ...grpc.CallOption) (*MsgPullPaymentResponse, error) {
+	out := new(MsgPullPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_PullPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -117,6 +182,21 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// ScheduleSend defines a method for escrowing coins to be sent from one
+	// account to another at a future block time or height.
+	ScheduleSend(context.Context, *MsgScheduleSend) (*MsgScheduleSendResponse, error)
+	// CancelScheduledSend defines a method for cancelling a pending scheduled
+	// send and returning the escrowed coins to the sender.
+	CancelScheduledSend(context.Context, *MsgCancelScheduledSend) (*MsgCancelScheduledSendResponse, error)
+	// AuthorizePullPayment defines a method for allowing a payee to pull up to a
+	// given amount of coins per period from the payer's account. An existing
+	// allowance between the same payer and payee is replaced.
+	AuthorizePullPayment(context.Context, *MsgAuthorizePullPayment) (*MsgAuthorizePullPaymentResponse, error)
+	// RevokePullPayment defines a method for revoking a pull payment allowance.
+	RevokePullPayment(context.Context, *MsgRevokePullPayment) (*MsgRevokePullPaymentResponse, error)
+	// PullPayment defines a method for a payee to pull coins from a payer's
+	// account within the limits of a pull payment allowance.
+	PullPayment(context.Context, *MsgPullPayment) (*MsgPullPaymentResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -139,6 +219,21 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) ScheduleSend(context.Context, *MsgScheduleSend) (*MsgScheduleSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSend not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledSend(context.Context, *MsgCancelScheduledSend) (*MsgCancelScheduledSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledSend not implemented")
+}
+func (UnimplementedMsgServer) AuthorizePullPayment(context.Context, *MsgAuthorizePullPayment) (*MsgAuthorizePullPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizePullPayment not implemented")
+}
+func (UnimplementedMsgServer) RevokePullPayment(context.Context, *MsgRevokePullPayment) (*MsgRevokePullPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePullPayment not implemented")
+}
+func (UnimplementedMsgServer) PullPayment(context.Context, *MsgPullPayment) (*MsgPullPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullPayment not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -242,6 +337,96 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSend(ctx, req.(*MsgScheduleSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledSend(ctx, req.(*MsgCancelScheduledSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AuthorizePullPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizePullPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorizePullPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AuthorizePullPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorizePullPayment(ctx, req.(*MsgAuthorizePullPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokePullPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePullPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePullPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokePullPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePullPayment(ctx, req.(*MsgRevokePullPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PullPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPullPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PullPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PullPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PullPayment(ctx, req.(*MsgPullPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +454,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "ScheduleSend",
+			Handler:    _Msg_ScheduleSend_Handler,
+		},
+		{
+			MethodName: "CancelScheduledSend",
+			Handler:    _Msg_CancelScheduledSend_Handler,
+		},
+		{
+			MethodName: "AuthorizePullPayment",
+			Handler:    _Msg_AuthorizePullPayment_Handler,
+		},
+		{
+			MethodName: "RevokePullPayment",
+			Handler:    _Msg_RevokePullPayment_Handler,
+		},
+		{
+			MethodName: "PullPayment",
+			Handler:    _Msg_PullPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
-	Service_Status_FullMethodName = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Config_FullMethodName      = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_Status_FullMethodName      = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Health_FullMethodName      = "/cosmos.base.node.v1beta1.Service/Health"
+	Service_AnteHandler_FullMethodName = "/cosmos.base.node.v1beta1.Service/AnteHandler"
+	Service_Attestation_FullMethodName = "/cosmos.base.node.v1beta1.Service/Attestation"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +34,16 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Health queries for an aggregated view of the node, combining the
+	// application versions, the consensus sync status, the pruning and snapshot
+	// settings and the operator configuration.
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	// AnteHandler queries for the ordered decorators composing the AnteHandler
+	// run by the node, along with their configuration.
+	AnteHandler(ctx context.Context, in *AnteHandlerRequest, opts ...grpc.CallOption) (*AnteHandlerResponse, error)
+	// Attestation queries for the versions of the code run by the node, signed
+	// by the node key so that they can be attested remotely.
+	Attestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*AttestationResponse, error)
 }
 
 type serviceClient struct {
@@ -59,6 +72,33 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, Service_Health_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AnteHandler(ctx context.Context, in *AnteHandlerRequest, opts ...grpc.CallOption) (*AnteHandlerResponse, error) {
+	out := new(AnteHandlerResponse)
+	err := c.cc.Invoke(ctx, Service_AnteHandler_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Attestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*AttestationResponse, error) {
+	out := new(AttestationResponse)
+	err := c.cc.Invoke(ctx, Service_Attestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -67,6 +107,16 @@ type ServiceServer interface {
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// Health queries for an aggregated view of the node, combining the
+	// application versions, the consensus sync status, the pruning and snapshot
+	// settings and the operator configuration.
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	// AnteHandler queries for the ordered decorators composing the AnteHandler
+	// run by the node, along with their configuration.
+	AnteHandler(context.Context, *AnteHandlerRequest) (*AnteHandlerResponse, error)
+	// Attestation queries for the versions of the code run by the node, signed
+	// by the node key so that they can be attested remotely.
+	Attestation(context.Context, *AttestationRequest) (*AttestationResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +130,15 @@ func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*Conf
 func (UnimplementedServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedServiceServer) AnteHandler(context.Context, *AnteHandlerRequest) (*AnteHandlerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnteHandler not implemented")
+}
+func (UnimplementedServiceServer) Attestation(context.Context, *AttestationRequest) (*AttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestation not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +188,60 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AnteHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnteHandlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AnteHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_AnteHandler_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AnteHandler(ctx, req.(*AnteHandlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Attestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Attestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Attestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Attestation(ctx, req.(*AttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +257,18 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Service_Health_Handler,
+		},
+		{
+			MethodName: "AnteHandler",
+			Handler:    _Service_AnteHandler_Handler,
+		},
+		{
+			MethodName: "Attestation",
+			Handler:    _Service_Attestation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -14,12 +14,13 @@ import (
 )
 
 var (
-	md_PageRequest             protoreflect.MessageDescriptor
-	fd_PageRequest_key         protoreflect.FieldDescriptor
-	fd_PageRequest_offset      protoreflect.FieldDescriptor
-	fd_PageRequest_limit       protoreflect.FieldDescriptor
-	fd_PageRequest_count_total protoreflect.FieldDescriptor
-	fd_PageRequest_reverse     protoreflect.FieldDescriptor
+	md_PageRequest                  protoreflect.MessageDescriptor
+	fd_PageRequest_key              protoreflect.FieldDescriptor
+	fd_PageRequest_offset           protoreflect.FieldDescriptor
+	fd_PageRequest_limit            protoreflect.FieldDescriptor
+	fd_PageRequest_count_total      protoreflect.FieldDescriptor
+	fd_PageRequest_reverse          protoreflect.FieldDescriptor
+	fd_PageRequest_skip_count_total protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +31,7 @@ func init() {
 	fd_PageRequest_limit = md_PageRequest.Fields().ByName("limit")
 	fd_PageRequest_count_total = md_PageRequest.Fields().ByName("count_total")
 	fd_PageRequest_reverse = md_PageRequest.Fields().ByName("reverse")
+	fd_PageRequest_skip_count_total = md_PageRequest.Fields().ByName("skip_count_total")
 }
 
 var _ protoreflect.Message = (*fastReflection_PageRequest)(nil)
@@ -127,6 +129,12 @@ func (x *fastReflection_PageRequest) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.SkipCountTotal != false {
+		value := protoreflect.ValueOfBool(x.SkipCountTotal)
+		if !f(fd_PageRequest_skip_count_total, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -152,6 +160,8 @@ func (x *fastReflection_PageRequest) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CountTotal != false
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		return x.Reverse != false
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		return x.SkipCountTotal != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -178,6 +188,8 @@ func (x *fastReflection_PageRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.CountTotal = false
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		x.Reverse = false
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		x.SkipCountTotal = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -209,6 +221,9 @@ func (x *fastReflection_PageRequest) Get(descriptor protoreflect.FieldDescriptor
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		value := x.Reverse
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		value := x.SkipCountTotal
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -239,6 +254,8 @@ func (x *fastReflection_PageRequest) Set(fd protoreflect.FieldDescriptor, value
 		x.CountTotal = value.Bool()
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		x.Reverse = value.Bool()
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		x.SkipCountTotal = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -269,6 +286,8 @@ func (x *fastReflection_PageRequest) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field count_total of message cosmos.base.query.v1beta1.PageRequest is not mutable"))
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		panic(fmt.Errorf("field reverse of message cosmos.base.query.v1beta1.PageRequest is not mutable"))
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		panic(fmt.Errorf("field skip_count_total of message cosmos.base.query.v1beta1.PageRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -292,6 +311,8 @@ func (x *fastReflection_PageRequest) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.base.query.v1beta1.PageRequest.reverse":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.base.query.v1beta1.PageRequest.skip_count_total":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.query.v1beta1.PageRequest"))
@@ -377,6 +398,9 @@ func (x *fastReflection_PageRequest) ProtoMethods() *protoiface.Methods {
 		if x.Reverse {
 			n += 2
 		}
+		if x.SkipCountTotal {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -406,6 +430,16 @@ func (x *fastReflection_PageRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SkipCountTotal {
+			i--
+			if x.SkipCountTotal {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.Reverse {
 			i--
 			if x.Reverse {
@@ -604,6 +638,26 @@ func (x *fastReflection_PageRequest) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Reverse = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkipCountTotal", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SkipCountTotal = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1136,7 +1190,8 @@ type PageRequest struct {
 
 	// key is a value returned in PageResponse.next_key to begin
 	// querying the next page most efficiently. Only one of offset or key
-	// should be set.
+	// should be set. The next page begins right after the last result of the
+	// previous page, including the results inserted since.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// offset is a numeric offset that can be used when key is unavailable.
 	// It is less efficient than using key. Only one of offset or key should
@@ -1152,6 +1207,10 @@ type PageRequest struct {
 	CountTotal bool `protobuf:"varint,4,opt,name=count_total,json=countTotal,proto3" json:"count_total,omitempty"`
 	// reverse is set to true if results are to be returned in the descending order.
 	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// skip_count_total is set to true to skip counting the total number of
+	// results when the limit is left empty, which is done by default, so that the
+	// page is returned without iterating over all the results.
+	SkipCountTotal bool `protobuf:"varint,6,opt,name=skip_count_total,json=skipCountTotal,proto3" json:"skip_count_total,omitempty"`
 }
 
 func (x *PageRequest) Reset() {
@@ -1209,6 +1268,13 @@ func (x *PageRequest) GetReverse() bool {
 	return false
 }
 
+func (x *PageRequest) GetSkipCountTotal() bool {
+	if x != nil {
+		return x.SkipCountTotal
+	}
+	return false
+}
+
 // PageResponse is to be embedded in gRPC response messages where the
 // corresponding request message has used PageRequest.
 //
@@ -1223,7 +1289,10 @@ type PageResponse struct {
 
 	// next_key is the key to be passed to PageRequest.key to
 	// query the next page most efficiently. It will be empty if
-	// there are no more results.
+	// there are no more results. It must be passed as is: it is the
+	// last key of the page suffixed with a zero byte in ascending
+	// order and the last key of the page, excluded from the next page,
+	// in reverse order, rather than the first key of the next page.
 	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
 	// total is total number of results available if PageRequest.count_total
 	// was set, its value is undefined otherwise
@@ -1273,7 +1342,7 @@ var file_cosmos_base_query_v1beta1_pagination_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x74, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
@@ -1283,26 +1352,30 @@ var file_cosmos_base_query_v1beta1_pagination_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x08, 0x42, 0x13, 0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x33, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x42, 0xf0, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x3b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x51, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73,
-	0x65, 0x5c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x13, 0xda, 0xb4,
+	0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35,
+	0x31, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x3f, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0xf0, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x42, 0x0f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x3b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x42, 0x51, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65,
+	0x5c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
+	0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8,6 +8,7 @@ package tendermintv1beta1
 
 import (
 	context "context"
+	v1beta1 "cosmossdk.io/api/cosmos/store/v1beta1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,6 +27,7 @@ const (
 	Service_GetLatestValidatorSet_FullMethodName   = "/cosmos.base.tendermint.v1beta1.Service/GetLatestValidatorSet"
 	Service_GetValidatorSetByHeight_FullMethodName = "/cosmos.base.tendermint.v1beta1.Service/GetValidatorSetByHeight"
 	Service_ABCIQuery_FullMethodName               = "/cosmos.base.tendermint.v1beta1.Service/ABCIQuery"
+	Service_StoreQueryBatch_FullMethodName         = "/cosmos.base.tendermint.v1beta1.Service/StoreQueryBatch"
 )
 
 // ServiceClient is the client API for Service service.
@@ -48,6 +50,10 @@ type ServiceClient interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// StoreQueryBatch queries the values of a batch of keys, across multiple
+	// stores, at the same height, along with their Merkle proofs up to the app
+	// hash if requested, in a single call.
+	StoreQueryBatch(ctx context.Context, in *v1beta1.QueryBatchRequest, opts ...grpc.CallOption) (*v1beta1.QueryBatchResponse, error)
 }
 
 type serviceClient struct {
@@ -121,6 +127,15 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) StoreQueryBatch(ctx context.Context, in *v1beta1.QueryBatchRequest, opts ...grpc.CallOption) (*v1beta1.QueryBatchResponse, error) {
+	out := new(v1beta1.QueryBatchResponse)
+	err := c.cc.Invoke(ctx, Service_StoreQueryBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -141,6 +156,10 @@ type ServiceServer interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// StoreQueryBatch queries the values of a batch of keys, across multiple
+	// stores, at the same height, along with their Merkle proofs up to the app
+	// hash if requested, in a single call.
+	StoreQueryBatch(context.Context, *v1beta1.QueryBatchRequest) (*v1beta1.QueryBatchResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -169,6 +188,9 @@ func (UnimplementedServiceServer) GetValidatorSetByHeight(context.Context, *GetV
 func (UnimplementedServiceServer) ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (UnimplementedServiceServer) StoreQueryBatch(context.Context, *v1beta1.QueryBatchRequest) (*v1beta1.QueryBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreQueryBatch not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -308,6 +330,24 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StoreQueryBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1beta1.QueryBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreQueryBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_StoreQueryBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreQueryBatch(ctx, req.(*v1beta1.QueryBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +383,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "StoreQueryBatch",
+			Handler:    _Service_StoreQueryBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
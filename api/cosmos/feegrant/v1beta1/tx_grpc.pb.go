@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName        = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_CreateFeePool_FullMethodName         = "/cosmos.feegrant.v1beta1.Msg/CreateFeePool"
+	Msg_UpdateFeePoolGrantees_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/UpdateFeePoolGrantees"
+	Msg_RevokeFeePool_FullMethodName         = "/cosmos.feegrant.v1beta1.Msg/RevokeFeePool"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +39,14 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// CreateFeePool creates a fee pool funded by the granter and shared by many
+	// grantees.
+	CreateFeePool(ctx context.Context, in *MsgCreateFeePool, opts ...grpc.CallOption) (*MsgCreateFeePoolResponse, error)
+	// UpdateFeePoolGrantees adds grantees to or removes grantees from the
+	// allow-list of a fee pool.
+	UpdateFeePoolGrantees(ctx context.Context, in *MsgUpdateFeePoolGrantees, opts ...grpc.CallOption) (*MsgUpdateFeePoolGranteesResponse, error)
+	// RevokeFeePool revokes the fee pool of the granter.
+	RevokeFeePool(ctx context.Context, in *MsgRevokeFeePool, opts ...grpc.CallOption) (*MsgRevokeFeePoolResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +84,33 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) CreateFeePool(ctx context.Context, in *MsgCreateFeePool, opts ...grpc.CallOption) (*MsgCreateFeePoolResponse, error) {
+	out := new(MsgCreateFeePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateFeePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeePoolGrantees(ctx context.Context, in *MsgUpdateFeePoolGrantees, opts ...grpc.CallOption) (*MsgUpdateFeePoolGranteesResponse, error) {
+	out := new(MsgUpdateFeePoolGranteesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeePoolGrantees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeFeePool(ctx context.Context, in *MsgRevokeFeePool, opts ...grpc.CallOption) (*MsgRevokeFeePoolResponse, error) {
+	out := new(MsgRevokeFeePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeFeePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -87,6 +123,14 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// CreateFeePool creates a fee pool funded by the granter and shared by many
+	// grantees.
+	CreateFeePool(context.Context, *MsgCreateFeePool) (*MsgCreateFeePoolResponse, error)
+	// UpdateFeePoolGrantees adds grantees to or removes grantees from the
+	// allow-list of a fee pool.
+	UpdateFeePoolGrantees(context.Context, *MsgUpdateFeePoolGrantees) (*MsgUpdateFeePoolGranteesResponse, error)
+	// RevokeFeePool revokes the fee pool of the granter.
+	RevokeFeePool(context.Context, *MsgRevokeFeePool) (*MsgRevokeFeePoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -103,6 +147,15 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) CreateFeePool(context.Context, *MsgCreateFeePool) (*MsgCreateFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeePool not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeePoolGrantees(context.Context, *MsgUpdateFeePoolGrantees) (*MsgUpdateFeePoolGranteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePoolGrantees not implemented")
+}
+func (UnimplementedMsgServer) RevokeFeePool(context.Context, *MsgRevokeFeePool) (*MsgRevokeFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeePool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +223,60 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFeePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateFeePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFeePool(ctx, req.(*MsgCreateFeePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeePoolGrantees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePoolGrantees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePoolGrantees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeePoolGrantees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePoolGrantees(ctx, req.(*MsgUpdateFeePoolGrantees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFeePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeFeePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeFeePool(ctx, req.(*MsgRevokeFeePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +296,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "CreateFeePool",
+			Handler:    _Msg_CreateFeePool_Handler,
+		},
+		{
+			MethodName: "UpdateFeePoolGrantees",
+			Handler:    _Msg_UpdateFeePoolGrantees_Handler,
+		},
+		{
+			MethodName: "RevokeFeePool",
+			Handler:    _Msg_RevokeFeePool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -32,6 +30,8 @@ const (
 	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_TallySnapshot_FullMethodName       = "/cosmos.gov.v1.Query/TallySnapshot"
+	Query_ExecutionReport_FullMethodName     = "/cosmos.gov.v1.Query/ExecutionReport"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +60,12 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// TallySnapshot queries the live tally of a proposal in voting period, which
+	// is updated on each vote rather than computed from all the votes.
+	TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error)
+	// ExecutionReport queries the result of the execution of the messages of a
+	// passed proposal: the gas consumed, and the events or error of each message.
+	ExecutionReport(ctx context.Context, in *QueryExecutionReportRequest, opts ...grpc.CallOption) (*QueryExecutionReportResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +175,24 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error) {
+	out := new(QueryTallySnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_TallySnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionReport(ctx context.Context, in *QueryExecutionReportRequest, opts ...grpc.CallOption) (*QueryExecutionReportResponse, error) {
+	out := new(QueryExecutionReportResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +219,12 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// TallySnapshot queries the live tally of a proposal in voting period, which
+	// is updated on each vote rather than computed from all the votes.
+	TallySnapshot(context.Context, *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error)
+	// ExecutionReport queries the result of the execution of the messages of a
+	// passed proposal: the gas consumed, and the events or error of each message.
+	ExecutionReport(context.Context, *QueryExecutionReportRequest) (*QueryExecutionReportResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +265,12 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) TallySnapshot(context.Context, *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallySnapshot not implemented")
+}
+func (UnimplementedQueryServer) ExecutionReport(context.Context, *QueryExecutionReportRequest) (*QueryExecutionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionReport not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +482,42 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallySnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallySnapshot(ctx, req.(*QueryTallySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionReport(ctx, req.(*QueryExecutionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +569,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "TallySnapshot",
+			Handler:    _Query_TallySnapshot_Handler,
+		},
+		{
+			MethodName: "ExecutionReport",
+			Handler:    _Query_ExecutionReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
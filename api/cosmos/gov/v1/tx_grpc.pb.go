@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -31,6 +29,7 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_FlagProposalSpam_FullMethodName             = "/cosmos.gov.v1.Msg/FlagProposalSpam"
 )
 
 // MsgClient is the client API for Msg service.
@@ -60,6 +59,11 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// FlagProposalSpam defines a method for a bonded validator to flag a proposal
+	// in deposit or voting period as spam. Once the validators flagging the
+	// proposal hold the spam flag threshold of the bonded tokens, the proposal is
+	// closed and its deposits are burnt.
+	FlagProposalSpam(ctx context.Context, in *MsgFlagProposalSpam, opts ...grpc.CallOption) (*MsgFlagProposalSpamResponse, error)
 }
 
 type msgClient struct {
@@ -160,6 +164,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) FlagProposalSpam(ctx context.Context, in *MsgFlagProposalSpam, opts ...grpc.CallOption) (*MsgFlagProposalSpamResponse, error) {
+	out := new(MsgFlagProposalSpamResponse)
+	err := c.cc.Invoke(ctx, Msg_FlagProposalSpam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -187,6 +200,11 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// FlagProposalSpam defines a method for a bonded validator to flag a proposal
+	// in deposit or voting period as spam. Once the validators flagging the
+	// proposal hold the spam flag threshold of the bonded tokens, the proposal is
+	// closed and its deposits are burnt.
+	FlagProposalSpam(context.Context, *MsgFlagProposalSpam) (*MsgFlagProposalSpamResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -224,6 +242,9 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) FlagProposalSpam(context.Context, *MsgFlagProposalSpam) (*MsgFlagProposalSpamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagProposalSpam not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -417,6 +438,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FlagProposalSpam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlagProposalSpam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlagProposalSpam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FlagProposalSpam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlagProposalSpam(ctx, req.(*MsgFlagProposalSpam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +503,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "FlagProposalSpam",
+			Handler:    _Msg_FlagProposalSpam_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName        = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName  = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_LiftTombstone_FullMethodName = "/cosmos.slashing.v1beta1.Msg/LiftTombstone"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,11 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// LiftTombstone defines a governance operation for lifting the tombstone of a
+	// validator, e.g. after an accidental double-sign. The validator stays jailed
+	// for a waiting period, after which it can be unjailed, and part of its stake
+	// is burned. The authority defaults to the x/gov module account.
+	LiftTombstone(ctx context.Context, in *MsgLiftTombstone, opts ...grpc.CallOption) (*MsgLiftTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +68,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) LiftTombstone(ctx context.Context, in *MsgLiftTombstone, opts ...grpc.CallOption) (*MsgLiftTombstoneResponse, error) {
+	out := new(MsgLiftTombstoneResponse)
+	err := c.cc.Invoke(ctx, Msg_LiftTombstone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +88,11 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// LiftTombstone defines a governance operation for lifting the tombstone of a
+	// validator, e.g. after an accidental double-sign. The validator stays jailed
+	// for a waiting period, after which it can be unjailed, and part of its stake
+	// is burned. The authority defaults to the x/gov module account.
+	LiftTombstone(context.Context, *MsgLiftTombstone) (*MsgLiftTombstoneResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +106,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) LiftTombstone(context.Context, *MsgLiftTombstone) (*MsgLiftTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftTombstone not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +158,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LiftTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LiftTombstone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftTombstone(ctx, req.(*MsgLiftTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +191,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "LiftTombstone",
+			Handler:    _Msg_LiftTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
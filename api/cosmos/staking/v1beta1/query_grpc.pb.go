@@ -33,6 +33,8 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_SlashSimulation_FullMethodName               = "/cosmos.staking.v1beta1.Query/SlashSimulation"
+	Query_DelegatorWeights_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorWeights"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +94,15 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SlashSimulation queries the token value a delegator would be left with on
+	// a validator, both for its delegation and its unbonding delegation entries,
+	// if the validator were slashed by the given fraction. No state is modified.
+	SlashSimulation(ctx context.Context, in *QuerySlashSimulationRequest, opts ...grpc.CallOption) (*QuerySlashSimulationResponse, error)
+	// DelegatorWeights queries, in delegator address order, the token value of
+	// the delegations of each delegator to a validator set, and optionally its
+	// pro rata share of an amount to distribute, e.g. for airdrops. Use the
+	// x-cosmos-block-height header to query the weights at a given height.
+	DelegatorWeights(ctx context.Context, in *QueryDelegatorWeightsRequest, opts ...grpc.CallOption) (*QueryDelegatorWeightsResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +239,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SlashSimulation(ctx context.Context, in *QuerySlashSimulationRequest, opts ...grpc.CallOption) (*QuerySlashSimulationResponse, error) {
+	out := new(QuerySlashSimulationResponse)
+	err := c.cc.Invoke(ctx, Query_SlashSimulation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorWeights(ctx context.Context, in *QueryDelegatorWeightsRequest, opts ...grpc.CallOption) (*QueryDelegatorWeightsResponse, error) {
+	out := new(QueryDelegatorWeightsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorWeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +314,15 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SlashSimulation queries the token value a delegator would be left with on
+	// a validator, both for its delegation and its unbonding delegation entries,
+	// if the validator were slashed by the given fraction. No state is modified.
+	SlashSimulation(context.Context, *QuerySlashSimulationRequest) (*QuerySlashSimulationResponse, error)
+	// DelegatorWeights queries, in delegator address order, the token value of
+	// the delegations of each delegator to a validator set, and optionally its
+	// pro rata share of an amount to distribute, e.g. for airdrops. Use the
+	// x-cosmos-block-height header to query the weights at a given height.
+	DelegatorWeights(context.Context, *QueryDelegatorWeightsRequest) (*QueryDelegatorWeightsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +372,12 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) SlashSimulation(context.Context, *QuerySlashSimulationRequest) (*QuerySlashSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashSimulation not implemented")
+}
+func (UnimplementedQueryServer) DelegatorWeights(context.Context, *QueryDelegatorWeightsRequest) (*QueryDelegatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWeights not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +643,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashSimulation(ctx, req.(*QuerySlashSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWeights(ctx, req.(*QueryDelegatorWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +742,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SlashSimulation",
+			Handler:    _Query_SlashSimulation_Handler,
+		},
+		{
+			MethodName: "DelegatorWeights",
+			Handler:    _Query_DelegatorWeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
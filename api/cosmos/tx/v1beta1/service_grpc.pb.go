@@ -19,15 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
-	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
-	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
-	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
-	Service_GetBlockWithTxs_FullMethodName = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
-	Service_TxDecode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxDecode"
-	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
-	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
-	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_Simulate_FullMethodName          = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_GetTx_FullMethodName             = "/cosmos.tx.v1beta1.Service/GetTx"
+	Service_GetTxMsgResponses_FullMethodName = "/cosmos.tx.v1beta1.Service/GetTxMsgResponses"
+	Service_BroadcastTx_FullMethodName       = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	Service_GetTxsEvent_FullMethodName       = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
+	Service_GetBlockWithTxs_FullMethodName   = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
+	Service_TxDecode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxDecode"
+	Service_TxEncode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxEncode"
+	Service_TxEncodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
+	Service_TxDecodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
 )
 
 // ServiceClient is the client API for Service service.
@@ -38,6 +39,8 @@ type ServiceClient interface {
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	// GetTxMsgResponses fetches the responses of the Msg handlers of a tx by hash.
+	GetTxMsgResponses(ctx context.Context, in *GetTxMsgResponsesRequest, opts ...grpc.CallOption) (*GetTxMsgResponsesResponse, error)
 	// BroadcastTx broadcast transaction.
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
@@ -80,6 +83,15 @@ func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grp
 	return out, nil
 }
 
+func (c *serviceClient) GetTxMsgResponses(ctx context.Context, in *GetTxMsgResponsesRequest, opts ...grpc.CallOption) (*GetTxMsgResponsesResponse, error) {
+	out := new(GetTxMsgResponsesResponse)
+	err := c.cc.Invoke(ctx, Service_GetTxMsgResponses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
 	out := new(BroadcastTxResponse)
 	err := c.cc.Invoke(ctx, Service_BroadcastTx_FullMethodName, in, out, opts...)
@@ -151,6 +163,8 @@ type ServiceServer interface {
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
+	// GetTxMsgResponses fetches the responses of the Msg handlers of a tx by hash.
+	GetTxMsgResponses(context.Context, *GetTxMsgResponsesRequest) (*GetTxMsgResponsesResponse, error)
 	// BroadcastTx broadcast transaction.
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
@@ -178,6 +192,9 @@ func (UnimplementedServiceServer) Simulate(context.Context, *SimulateRequest) (*
 func (UnimplementedServiceServer) GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
+func (UnimplementedServiceServer) GetTxMsgResponses(context.Context, *GetTxMsgResponsesRequest) (*GetTxMsgResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxMsgResponses not implemented")
+}
 func (UnimplementedServiceServer) BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
 }
@@ -248,6 +265,24 @@ func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetTxMsgResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxMsgResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTxMsgResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetTxMsgResponses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTxMsgResponses(ctx, req.(*GetTxMsgResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BroadcastTxRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +424,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
 		},
+		{
+			MethodName: "GetTxMsgResponses",
+			Handler:    _Service_GetTxMsgResponses_Handler,
+		},
 		{
 			MethodName: "BroadcastTx",
 			Handler:    _Service_BroadcastTx_Handler,
@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SoftwareUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
-	Msg_CancelUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SoftwareUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
+	Msg_CancelUpgrade_FullMethodName     = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_RescheduleUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/RescheduleUpgrade"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,11 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height. Being a distinct message, its
+	// governance parameters, e.g. its quorum, may differ from the ones of
+	// SoftwareUpgrade.
+	RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +68,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error) {
+	out := new(MsgRescheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, Msg_RescheduleUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +86,11 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height. Being a distinct message, its
+	// governance parameters, e.g. its quorum, may differ from the ones of
+	// SoftwareUpgrade.
+	RescheduleUpgrade(context.Context, *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +104,9 @@ func (UnimplementedMsgServer) SoftwareUpgrade(context.Context, *MsgSoftwareUpgra
 func (UnimplementedMsgServer) CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (UnimplementedMsgServer) RescheduleUpgrade(context.Context, *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleUpgrade not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +156,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RescheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RescheduleUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, req.(*MsgRescheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +189,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "RescheduleUpgrade",
+			Handler:    _Msg_RescheduleUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
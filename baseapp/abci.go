@@ -11,6 +11,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -34,6 +36,10 @@ const (
 	QueryPathP2P    = "p2p"
 	QueryPathStore  = "store"
 
+	// QueryPathStoreBatch queries a batch of keys across multiple stores, the
+	// request data being a protobuf encoded storetypes.QueryBatchRequest.
+	QueryPathStoreBatch = "store_batch"
+
 	QueryPathBroadcastTx = "/cosmos.tx.v1beta1.Service/BroadcastTx"
 )
 
@@ -202,9 +208,15 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 	case QueryPathStore:
 		resp = handleQueryStore(app, path, *req)
 
+	case QueryPathStoreBatch:
+		resp = handleQueryStoreBatch(app, *req)
+
 	case QueryPathP2P:
 		resp = handleQueryP2P(app, path)
 
+	case QueryPathCustom:
+		resp = handleQueryCustom(app, path, req)
+
 	default:
 		resp = sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path"), app.trace)
 	}
@@ -359,6 +371,10 @@ func (app *BaseApp) CheckTx(req *abci.CheckTxRequest) (*abci.CheckTxResponse, er
 		return nil, fmt.Errorf("unknown RequestCheckTx type: %s", req.Type)
 	}
 
+	if app.InMaintenance() {
+		return sdkerrors.ResponseCheckTxWithEvents(sdkerrors.ErrMaintenance, 0, 0, nil, app.trace), nil
+	}
+
 	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
@@ -369,7 +385,7 @@ func (app *BaseApp) CheckTx(req *abci.CheckTxRequest) (*abci.CheckTxResponse, er
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    app.markEventsToIndex(result.Events),
 	}, nil
 }
 
@@ -718,13 +734,17 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
-func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (_ *abci.FinalizeBlockResponse, err error) {
 	var events []abci.Event
 
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
 		return nil, err
 	}
 
+	if app.InMaintenance() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrMaintenance, "cannot finalize block %d", req.Height)
+	}
+
 	if err := app.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
@@ -772,6 +792,13 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 			LastCommit:      sdk.ToSDKCommitInfo(req.DecidedLastCommit),
 		}))
 
+	// The spans of the txs and msgs of the block are children of the span of
+	// the block.
+	blockCtx, blockSpan := startSpan(app.finalizeBlockState.Context(), "FinalizeBlock",
+		attribute.Int64("height", req.Height), attribute.Int("txs", len(req.Txs)))
+	defer func() { telemetry.EndSpan(blockSpan, err) }()
+	app.finalizeBlockState.SetContext(blockCtx)
+
 	// GasMeter must be set after we get a context with updated consensus params.
 	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
@@ -782,6 +809,11 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 			WithHeaderHash(req.Hash))
 	}
 
+	// Warm the store caches with the keys the transactions are going to read
+	// while the block is executed.
+	stopPrefetch := app.prefetch(req.Txs)
+	defer stopPrefetch()
+
 	if err := app.preBlock(req); err != nil {
 		return nil, err
 	}
@@ -806,39 +838,14 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
-	// Iterate over all raw transactions in the proposal and attempt to execute
-	// them, gathering the execution results.
-	//
-	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
-	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	var txResults []*abci.ExecTxResult
+	if app.canExecuteTxsInParallel(req.Txs) {
+		txResults, err = app.executeTxsInParallel(ctx, req.Txs)
+	} else {
+		txResults, err = app.executeTxs(ctx, req.Txs)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -859,6 +866,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	}
 
 	events = append(events, endBlock.Events...)
+	if summary, ok := app.blockSummaryEvent(events, txResults); ok {
+		events = append(events, summary)
+	}
+
 	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
 
 	return &abci.FinalizeBlockResponse{
@@ -869,6 +880,45 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	}, nil
 }
 
+// executeTxs iterates over all raw transactions in the proposal and attempts to
+// execute them one after the other, gathering the execution results.
+//
+// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+// vote extensions, so skip those.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTx(rawTx)
+		} else {
+			// In the case where a transaction included in a block proposal is malformed,
+			// we still want to return a default response to comet. This is because comet
+			// expects a response for each transaction included in a block proposal.
+			response = sdkerrors.ResponseExecTxResultWithEvents(
+				sdkerrors.ErrTxDecode,
+				0,
+				0,
+				nil,
+				false,
+			)
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
 // FinalizeBlock will execute the block proposal provided by RequestFinalizeBlock.
 // Specifically, it will execute an application's BeginBlock (if defined), followed
 // by the transactions in the proposal, finally followed by the application's
@@ -893,8 +943,19 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 		// check if the hash we got is the same as the one we are executing
 		aborted := app.optimisticExec.AbortIfNeeded(req.Hash)
 		// Wait for the OE to finish, regardless of whether it was aborted or not
+		waitStart := telemetry.Now()
 		res, err = app.optimisticExec.WaitResult()
 
+		// report how long the commit decision waited on the OE, and whether its
+		// result got reused, so that the gain of OE can be measured
+		result := "aborted"
+		if !aborted {
+			result = "reused"
+		}
+		labels := []metrics.Label{telemetry.NewLabel("result", result)}
+		telemetry.IncrCounterWithLabels([]string{"optimistic_execution"}, 1, labels)
+		telemetry.MeasureSinceWithLabels([]string{"optimistic_execution", "wait"}, waitStart, labels)
+
 		// only return if we are not aborting
 		if !aborted {
 			if res != nil {
@@ -930,12 +991,50 @@ func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 	}
 
 	if halt {
+		// the halt handler may not have been called on Commit when halting per halt-time
+		app.handleHalt(app.LastBlockHeight())
 		return fmt.Errorf("halt per configuration height %d time %d", app.haltHeight, app.haltTime)
 	}
 
 	return nil
 }
 
+// isLastBlockBeforeHalt returns true if the next block will be rejected by checkHalt,
+// either because the height reached halt-height or the block time reached halt-time.
+func (app *BaseApp) isLastBlockBeforeHalt(height int64, time time.Time) bool {
+	return (app.haltHeight > 0 && uint64(height) >= app.haltHeight) ||
+		(app.haltTime > 0 && time.Unix() >= int64(app.haltTime))
+}
+
+// handleHalt calls the halt handler, if any, the first time the node halts.
+func (app *BaseApp) handleHalt(lastHeight int64) {
+	if app.haltHandler == nil || app.haltHandled {
+		return
+	}
+	app.haltHandled = true
+
+	app.logger.Info("calling halt handler", "height", lastHeight)
+	if err := app.haltHandler(lastHeight); err != nil {
+		app.logger.Error("halt handler failed", "height", lastHeight, "err", err)
+	}
+}
+
+// snapshotOnHalt is the halt handler set by SetHaltSnapshot, it synchronously
+// takes a state snapshot of the last committed block.
+func (app *BaseApp) snapshotOnHalt(lastHeight int64) error {
+	if app.snapshotManager == nil {
+		return errors.New("state sync snapshots are not configured")
+	}
+
+	snapshot, err := app.snapshotManager.Create(uint64(lastHeight))
+	if err != nil {
+		return fmt.Errorf("failed to create halt snapshot: %w", err)
+	}
+
+	app.logger.Info("created halt snapshot", "height", snapshot.Height, "format", snapshot.Format, "hash", fmt.Sprintf("%X", snapshot.Hash))
+	return nil
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -987,6 +1086,13 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 		app.prepareCheckStater(app.checkState.Context())
 	}
 
+	// The next block would be rejected per the halt configuration, hand over the
+	// committed state to the halt handler instead of taking a periodic snapshot.
+	if app.haltHandler != nil && app.isLastBlockBeforeHalt(header.Height, header.Time) {
+		app.handleHalt(header.Height)
+		return resp, nil
+	}
+
 	// The SnapshotIfApplicable method will create the snapshot by starting the goroutine
 	app.snapshotManager.SnapshotIfApplicable(header.Height)
 
@@ -1086,6 +1192,47 @@ func handleQueryStore(app *BaseApp, path []string, req abci.QueryRequest) *abci.
 	return &abciResp
 }
 
+func handleQueryStoreBatch(app *BaseApp, req abci.QueryRequest) *abci.QueryResponse {
+	// "/store_batch" for batches of store queries
+	queryable, ok := app.cms.(storetypes.BatchQueryable)
+	if !ok {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multi-store does not support batch queries"), app.trace)
+	}
+
+	var batchReq storetypes.QueryBatchRequest
+	if err := batchReq.Unmarshal(req.Data); err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to decode batch query request: %s", err), app.trace)
+	}
+
+	// the height of the batch defaults to the height of the ABCI query
+	if batchReq.Height == 0 {
+		batchReq.Height = req.Height
+	}
+
+	if batchReq.Height <= 1 && batchReq.Prove {
+		return sdkerrors.QueryResult(
+			errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"cannot query with proof when height <= 1; please provide a valid height",
+			), app.trace)
+	}
+
+	batchRes, err := queryable.QueryBatch(&batchReq)
+	if err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
+	bz, err := batchRes.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to marshal batch query response"), app.trace)
+	}
+
+	return &abci.QueryResponse{
+		Height: batchRes.Height,
+		Value:  bz,
+	}
+}
+
 func handleQueryP2P(app *BaseApp, path []string) *abci.QueryResponse {
 	// "/p2p" prefix for p2p queries
 	if len(path) < 4 {
@@ -1159,13 +1306,30 @@ func (app *BaseApp) getContextForProposal(ctx sdk.Context, height int64) sdk.Con
 	return ctx
 }
 
-func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.QueryRequest) *abci.QueryResponse {
+func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.QueryRequest) (resp *abci.QueryResponse) {
 	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	resp, err := handler(ctx, req)
+	// queries exceeding the query gas limit panic with an out of gas error, surface
+	// it as such instead of the generic panic error returned by Query.
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			resp = sdkerrors.QueryResult(errorsmod.Wrapf(
+				sdkerrors.ErrOutOfGas, "query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				oog.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			), app.trace)
+			resp.Height = req.Height
+		}
+	}()
+
+	resp, err = handler(ctx, req)
 	if err != nil {
 		resp = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		resp.Height = req.Height
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -38,6 +39,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -305,6 +307,62 @@ func TestABCI_FinalizeBlock_WithBeginAndEndBlocker(t *testing.T) {
 	require.Equal(t, int64(1), app.LastBlockHeight())
 }
 
+func TestABCI_FinalizeBlock_BlockSummary(t *testing.T) {
+	name := t.Name()
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil)
+
+	app.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+		return sdk.BeginBlock{
+			Events: []abci.Event{
+				{Type: "coinbase", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10stake"}}},
+				{Type: "slash", Attributes: []abci.EventAttribute{{Key: "jailed", Value: "val1"}}},
+				{Type: "slash", Attributes: []abci.EventAttribute{{Key: "power", Value: "10"}}},
+			},
+		}, nil
+	})
+
+	app.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+		return sdk.EndBlock{
+			Events: []abci.Event{
+				{Type: "coinbase", Attributes: []abci.EventAttribute{{Key: "amount", Value: "5stake,1atom"}}},
+				{Type: "active_proposal"},
+				{Type: "inactive_proposal"},
+			},
+		}, nil
+	})
+
+	app.RegisterBlockSummaryMetrics(
+		sdk.BlockSummaryMetric{Name: "tokens_minted", EventType: "coinbase", Attribute: "amount", Coins: true},
+		sdk.BlockSummaryMetric{Name: "tokens_burned", EventType: "burn", Attribute: "amount", Coins: true},
+		sdk.BlockSummaryMetric{Name: "validators_jailed", EventType: "slash", Attribute: "jailed"},
+		sdk.BlockSummaryMetric{Name: "proposals_changed_state", EventType: "active_proposal"},
+		sdk.BlockSummaryMetric{Name: "proposals_changed_state", EventType: "inactive_proposal"},
+	)
+	require.Panics(t, func() {
+		app.RegisterBlockSummaryMetrics(sdk.BlockSummaryMetric{Name: "tokens_minted", EventType: "mint"})
+	})
+	require.Panics(t, func() {
+		app.RegisterBlockSummaryMetrics(sdk.BlockSummaryMetric{Name: "fees", EventType: "tx", Coins: true})
+	})
+
+	_, err := app.InitChain(&abci.InitChainRequest{InitialHeight: 1})
+	require.NoError(t, err)
+
+	res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+
+	require.Len(t, res.Events, 7)
+	summary := res.Events[6]
+	require.Equal(t, sdk.EventTypeBlockSummary, summary.Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: "tokens_minted", Value: "1atom,15stake", Index: true},
+		{Key: "tokens_burned", Value: "", Index: true},
+		{Key: "validators_jailed", Value: "1", Index: true},
+		{Key: "proposals_changed_state", Value: "2", Index: true},
+	}, summary.Attributes)
+}
+
 func TestABCI_ExtendVote(t *testing.T) {
 	name := t.Name()
 	db := dbm.NewMemDB()
@@ -490,6 +548,51 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// gasConsumingQueryImpl is a testdata query server consuming gas on SayHello.
+type gasConsumingQueryImpl struct {
+	testdata.QueryImpl
+	gas uint64
+}
+
+func (q gasConsumingQueryImpl) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(q.gas, "say hello")
+	return q.QueryImpl.SayHello(ctx, req)
+}
+
+func TestABCI_GRPCQueryOutOfGas(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(
+			bapp.GRPCQueryRouter(),
+			gasConsumingQueryImpl{gas: 150},
+		)
+	}
+
+	suite := NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryGasLimit(100))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: suite.baseApp.LastBlockHeight() + 1})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	req := testdata.SayHelloRequest{Name: fooStr}
+	reqBz, err := req.Marshal()
+	require.NoError(t, err)
+
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{
+		Data: reqBz,
+		Path: "/testpb.Query/SayHello",
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), resQuery.Code, resQuery)
+	require.Contains(t, resQuery.Log, "query out of gas in location: say hello; gasLimit: 100")
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.QueryResponse {
@@ -1126,6 +1229,80 @@ func TestABCI_MaxBlockGasLimits(t *testing.T) {
 	}
 }
 
+func TestABCI_BlockGasReservation(t *testing.T) {
+	gasGranted := uint64(10)
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(storetypes.NewGasMeter(gasGranted))
+			count, _ := parseTxMemo(t, tx)
+			newCtx.GasMeter().ConsumeGas(uint64(count), "counter-ante")
+
+			// count the ante handler runs, as a fee or sequence would be, without
+			// consuming gas
+			store := newCtx.MultiStore().GetKVStore(capKey1)
+			store.Set(anteRunsKey, []byte{anteRuns(store) + 1})
+
+			return newCtx, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetBlockGasReservation(true))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{
+				MaxGas: 25,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+
+	deliver := func(tx signing.Tx) error {
+		_, _, err := suite.baseApp.SimDeliver(suite.txConfig.TxEncoder(), tx)
+		return err
+	}
+	blockGasUsed := func() uint64 {
+		return getFinalizeBlockStateCtx(suite.baseApp).BlockGasMeter().GasConsumed()
+	}
+
+	// the unused gas of the reservations is refunded
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, deliver(newTxCounter(t, suite.txConfig, 2, 1)))
+		require.Equal(t, uint64(3*i), blockGasUsed())
+	}
+
+	// a tx running out of gas uses its whole gas limit
+	require.ErrorIs(t, deliver(newTxCounter(t, suite.txConfig, 5, 8)), sdkerrors.ErrOutOfGas)
+	require.Equal(t, uint64(19), blockGasUsed())
+
+	require.Equal(t, byte(4), anteRuns(getFinalizeBlockStateCtx(suite.baseApp).MultiStore().GetKVStore(capKey1)))
+
+	// a tx whose gas limit exceeds the remaining block gas is rejected, even if
+	// the gas it would use fits in the block, but its ante handler state is
+	// kept and the gas used by the ante handler is accounted
+	err = deliver(newTxCounter(t, suite.txConfig, 2, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.ErrorContains(t, err, "exceeds the remaining block gas 6")
+	require.Equal(t, uint64(21), blockGasUsed())
+	require.Equal(t, byte(5), anteRuns(getFinalizeBlockStateCtx(suite.baseApp).MultiStore().GetKVStore(capKey1)))
+	require.False(t, getFinalizeBlockStateCtx(suite.baseApp).BlockGasMeter().IsPastLimit())
+}
+
+var anteRunsKey = []byte("ante-runs")
+
+func anteRuns(store storetypes.KVStore) byte {
+	bz := store.Get(anteRunsKey)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return bz[0]
+}
+
 func TestABCI_GasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *baseapp.BaseApp) {
@@ -1246,6 +1423,68 @@ func TestABCI_Query(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestABCI_QueryStoreBatch(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.KVStore(capKey1).Set(key, value)
+			ctx.KVStore(capKey2).Set(value, key)
+			return
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		bz, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, height, 0))
+		require.NoError(t, err)
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: [][]byte{bz}})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	batchReq := &storetypes.QueryBatchRequest{
+		Prove: true,
+		Keys: []*storetypes.QueryBatchKey{
+			{StoreName: capKey1.Name(), Key: key},
+			{StoreName: capKey2.Name(), Key: value},
+		},
+	}
+	data, err := batchReq.Marshal()
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/store_batch", Data: data})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(2), res.Height)
+
+	var batchRes storetypes.QueryBatchResponse
+	require.NoError(t, batchRes.Unmarshal(res.Value))
+	require.Equal(t, int64(2), batchRes.Height)
+	require.Len(t, batchRes.Results, 2)
+	require.Equal(t, value, batchRes.Results[0].Value)
+	require.NotNil(t, batchRes.Results[0].ProofOps)
+	require.Equal(t, key, batchRes.Results[1].Value)
+	require.NotNil(t, batchRes.Results[1].ProofOps)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/store_batch", Data: data, Height: 1})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "cannot query with proof when height <= 1")
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/store_batch", Data: []byte("invalid")})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "failed to decode batch query request")
+}
+
 func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	logger := log.NewTestLogger(t)
 	db := dbm.NewMemDB()
@@ -2036,6 +2275,69 @@ func TestABCI_HaltChain(t *testing.T) {
 	}
 }
 
+func TestABCI_HaltHandler(t *testing.T) {
+	var haltedAt []int64
+	suite := NewBaseAppSuite(t, baseapp.SetHaltHeight(2))
+	suite.baseApp.SetHaltHandler(func(lastHeight int64) error {
+		haltedAt = append(haltedAt, lastHeight)
+		return nil
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the handler is called on the commit of the halt height
+	require.Equal(t, []int64{2}, haltedAt)
+
+	// and not called again once the next block is rejected
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 3})
+	require.Error(t, err)
+	require.Equal(t, []int64{2}, haltedAt)
+}
+
+func TestABCI_Maintenance(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMaintenance(true))
+	require.True(t, suite.baseApp.InMaintenance())
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, counterKey})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: txBytes, Type: abci.CHECK_TX_TYPE_CHECK})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrMaintenance.ABCICode(), res.Code)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrMaintenance)
+
+	// the maintenance mode can be disabled while the node is running
+	suite.baseApp.SetMaintenance(false)
+
+	res, err = suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: txBytes, Type: abci.CHECK_TX_TYPE_CHECK})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+}
+
 func TestBaseApp_PreBlocker(t *testing.T) {
 	db := dbm.NewMemDB()
 	name := t.Name()
@@ -2404,6 +2706,67 @@ func TestOptimisticExecution(t *testing.T) {
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
 
+func TestOptimisticExecution_Telemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{
+		MetricsSink: telemetry.MetricSinkInMem,
+		Enabled:     true,
+		ServiceName: "test",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+	})
+
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution())
+
+	_, err = suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the first block is never executed optimistically, the second one commits
+	// with the proposal executed optimistically and the third one with another
+	// proposal
+	for _, reuse := range []bool{true, true, false} {
+		height := suite.baseApp.LastBlockHeight() + 1
+		hash := []byte("some-hash" + strconv.FormatInt(height, 10))
+
+		respProcProp, err := suite.baseApp.ProcessProposal(&abci.ProcessProposalRequest{Height: height, Hash: hash})
+		require.NoError(t, err)
+		require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, respProcProp.Status)
+
+		if !reuse {
+			hash = []byte("other-hash" + strconv.FormatInt(height, 10))
+		}
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Hash: hash})
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var res struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &res))
+
+	results := make(map[string]int)
+	for _, counter := range res.Counters {
+		if counter.Name == "test.optimistic_execution" {
+			results[counter.Labels["result"]] += counter.Count
+		}
+	}
+	require.Equal(t, map[string]int{"reused": 1, "aborted": 1}, results)
+}
+
 func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
 		TxPriority:      mempool.NewDefaultTxPriority(),
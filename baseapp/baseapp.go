@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/maps"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -57,6 +59,25 @@ const (
 	execModeFinalize                            // Finalize a block proposal
 )
 
+var execModeNames = [...]string{
+	execModeCheck:               "check",
+	execModeReCheck:             "recheck",
+	execModeSimulate:            "simulate",
+	execModePrepareProposal:     "prepare_proposal",
+	execModeProcessProposal:     "process_proposal",
+	execModeVoteExtension:       "vote_extension",
+	execModeVerifyVoteExtension: "verify_vote_extension",
+	execModeFinalize:            "finalize",
+}
+
+func (m execMode) String() string {
+	if int(m) < len(execModeNames) {
+		return execModeNames[m]
+	}
+
+	return fmt.Sprintf("execMode(%d)", m)
+}
+
 var _ servertypes.ABCI = (*BaseApp)(nil)
 
 // BaseApp reflects the ABCI application implementation.
@@ -79,6 +100,15 @@ type BaseApp struct {
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
+	// descriptions of the decorators of the ante handler, if set with SetAnteDecorators
+	anteDescription []sdk.AnteDecoratorDescription
+
+	// metrics of the block summary event emitted at the end of FinalizeBlock
+	blockSummaryMetrics []sdk.BlockSummaryMetric
+
+	// routes of the custom ABCI queries, keyed by route
+	customQueryRoutes map[string]sdk.CustomQueryRoute
+
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
@@ -133,6 +163,23 @@ type BaseApp struct {
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
+	// reserveBlockGas reserves the gas limit of the txs against the block gas
+	// meter before executing their messages, see SetBlockGasReservation.
+	reserveBlockGas bool
+
+	// prefetchLimit defines the maximum number of keys prefetched per prefetch
+	// hint before executing a block; prefetching is disabled if 0.
+	prefetchLimit int
+
+	// parallelExecWorkers defines the number of txs of a block executed
+	// concurrently, see SetParallelExecution; parallel execution is disabled if
+	// lower than 2.
+	parallelExecWorkers int
+
+	// simulationCache memoizes the execution results of the cacheable Msgs
+	// during simulation, see SetSimulationCacheSize; it is disabled if nil.
+	simulationCache *simulationCache
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -149,6 +196,15 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// haltHandler is called once the node halts per haltHeight or haltTime,
+	// haltHandled records whether it was called already
+	haltHandler HaltHandler
+	haltHandled bool
+
+	// maintenance makes the node refuse to process txs and blocks while still
+	// serving queries, it can be toggled while the node is running
+	maintenance atomic.Bool
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from CometBFT. It is used as part of the process of determining the
@@ -164,8 +220,8 @@ type BaseApp struct {
 	// application's version string
 	version string
 
-	// recovery handler for app.runTx method
-	runTxRecoveryMiddleware recoveryMiddleware
+	// custom recovery handlers for app.runTx method
+	runTxRecoveryHandlers []RecoveryHandler
 
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
@@ -174,9 +230,22 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// indexEventTypes defines the set of event types whose attributes are all
+	// indexed by CometBFT, in addition to indexEvents and to the attributes
+	// marked to be indexed by the modules.
+	indexEventTypes map[string]struct{}
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// storeAccessPolicy governs the stores the modules can obtain from the
+	// contexts of the block and tx execution, if set.
+	storeAccessPolicy *sdk.StoreAccessPolicy
+
+	// streamingSinks are the ABCIListener sinks registered by the app, which
+	// can be enabled from the app config by name.
+	streamingSinks map[string]storetypes.ABCIListener
+
 	chainID string
 
 	cdc codec.Codec
@@ -233,8 +302,6 @@ func NewBaseApp(
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
-	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
-
 	// Initialize with an empty interface registry to avoid nil pointer dereference.
 	// Unless SetInterfaceRegistry is called with an interface registry with proper address codecs baseapp will panic.
 	app.cdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -410,6 +477,12 @@ func (app *BaseApp) AnteHandler() sdk.AnteHandler {
 	return app.anteHandler
 }
 
+// AnteHandlerDescription returns the ordered descriptions of the decorators of
+// the AnteHandler of the app, or nil if it was not set with SetAnteDecorators.
+func (app *BaseApp) AnteHandlerDescription() []sdk.AnteDecoratorDescription {
+	return app.anteDescription
+}
+
 // Mempool returns the Mempool of the app.
 func (app *BaseApp) Mempool() mempool.Mempool {
 	return app.mempool
@@ -449,6 +522,24 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+// HaltHandler is called once when the node halts per the halt-height or halt-time
+// configuration, with the height of the last committed block. It runs while
+// the node still holds the state of that block, e.g. to export it before the
+// node is shutdown.
+type HaltHandler func(lastHeight int64) error
+
+// SetMaintenance enables or disables the maintenance mode, in which the node
+// refuses to check txs and finalize blocks while still serving queries at the
+// latest committed height. It can be called while the node is running.
+func (app *BaseApp) SetMaintenance(enabled bool) {
+	app.maintenance.Store(enabled)
+}
+
+// InMaintenance returns whether the maintenance mode is enabled.
+func (app *BaseApp) InMaintenance() bool {
+	return app.maintenance.Load()
+}
+
 func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
@@ -469,6 +560,20 @@ func (app *BaseApp) setIndexEvents(ie []string) {
 	}
 }
 
+func (app *BaseApp) setIndexEventTypes(types []string) {
+	app.indexEventTypes = make(map[string]struct{}, len(types))
+
+	for _, t := range types {
+		app.indexEventTypes[t] = struct{}{}
+	}
+}
+
+// markEventsToIndex marks the attributes of the given events to be indexed by
+// CometBFT according to the index-events and index-event-types settings.
+func (app *BaseApp) markEventsToIndex(events []abci.Event) []abci.Event {
+	return sdk.MarkEventsToIndexWithTypes(events, app.indexEvents, app.indexEventTypes)
+}
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -490,6 +595,7 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 		ms: ms,
 		ctx: sdk.NewContext(ms, false, app.logger).
 			WithStreamingManager(app.streamingManager).
+			WithStoreAccessPolicy(app.storeAccessPolicy).
 			WithBlockHeader(h).
 			WithHeaderInfo(headerInfo),
 	}
@@ -551,11 +657,12 @@ func (app *BaseApp) StoreConsensusParams(ctx context.Context, cp cmtproto.Consen
 	return app.paramStore.Set(ctx, cp)
 }
 
-// AddRunTxRecoveryHandler adds custom app.runTx method panic handlers.
+// AddRunTxRecoveryHandler adds custom app.runTx method panic handlers. The
+// handlers added last are invoked first, and all of them are invoked before
+// the standard out of gas handler, so they can convert known panics, including
+// out of gas panics, into specific errors.
 func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
-	for _, h := range handlers {
-		app.runTxRecoveryMiddleware = newRecoveryMiddleware(h, app.runTxRecoveryMiddleware)
-	}
+	app.runTxRecoveryHandlers = append(app.runTxRecoveryHandlers, handlers...)
 }
 
 // GetMaximumBlockGas gets the maximum gas from the consensus params. It panics
@@ -741,33 +848,41 @@ func (app *BaseApp) beginBlock(req *abci.FinalizeBlockRequest) (sdk.BeginBlock,
 			)
 		}
 
-		resp.Events = sdk.MarkEventsToIndex(resp.Events, app.indexEvents)
+		resp.Events = app.markEventsToIndex(resp.Events)
 	}
 
 	return resp, nil
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
+	return app.deliverTxInContext(app.getContextForTx(execModeFinalize, tx), tx, nil)
+}
+
+// deliverTxInContext is like deliverTx, but runs the tx in the given context
+// rather than in the context of the finalize block state. The decoded tx may
+// be given to avoid decoding it again.
+func (app *BaseApp) deliverTxInContext(ctx sdk.Context, tx []byte, decodedTx sdk.Tx) *abci.ExecTxResult {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
 
 	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+		// the telemetry of a speculative execution is only recorded if its
+		// result is kept, see executeTxsInParallel.
+		if _, ok := speculativeExecution(ctx); !ok {
+			recordTxTelemetry(gInfo, resultStr)
+		}
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	gInfo, result, anteEvents, err := app.runTxInContext(ctx, execModeFinalize, tx, decodedTx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
-			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
+			app.markEventsToIndex(anteEvents),
 			app.trace,
 		)
 		return resp
@@ -778,12 +893,21 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    app.markEventsToIndex(result.Events),
 	}
 
 	return resp
 }
 
+// recordTxTelemetry records the telemetry of a tx delivered with the given
+// result, either "successful" or "failed".
+func recordTxTelemetry(gInfo sdk.GasInfo, resultStr string) {
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+}
+
 // endBlock is an application-defined function that is called after transactions
 // have been processed in FinalizeBlock.
 func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
@@ -803,7 +927,7 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 			)
 		}
 
-		eb.Events = sdk.MarkEventsToIndex(eb.Events, app.indexEvents)
+		eb.Events = app.markEventsToIndex(eb.Events)
 		endblock = eb
 	}
 
@@ -818,12 +942,23 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxInContext(app.getContextForTx(mode, txBytes), mode, txBytes, nil)
+}
+
+// runTxInContext is like runTx, but runs the tx in the given context rather
+// than in the context of the state of the execution mode. The decoded tx may be
+// given to avoid decoding it again.
+func (app *BaseApp) runTxInContext(
+	ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx,
+) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	ctx, txSpan := startSpan(ctx, "tx", attribute.String("mode", mode.String()))
+	defer func() { telemetry.EndSpan(txSpan, err) }()
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -833,7 +968,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, newDefaultRecoveryMiddleware())
+			for _, h := range app.runTxRecoveryHandlers {
+				recoveryMW = newRecoveryMiddleware(h, recoveryMW)
+			}
 			err, result = processRecovery(r, recoveryMW), nil
 			ctx.Logger().Error("panic recovered in runTx", "err", err)
 		}
@@ -841,17 +979,31 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
 	}()
 
-	blockGasConsumed := false
+	var (
+		blockGasConsumed bool
+		reservedBlockGas uint64
+	)
 
 	// consumeBlockGas makes sure block gas is consumed at most once. It must
 	// happen after tx processing, and must be executed even if tx processing
 	// fails. Hence, it's execution is deferred.
 	consumeBlockGas := func() {
-		if !blockGasConsumed {
-			blockGasConsumed = true
-			ctx.BlockGasMeter().ConsumeGas(
-				ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
-			)
+		if blockGasConsumed {
+			return
+		}
+		blockGasConsumed = true
+
+		gasUsed := ctx.GasMeter().GasConsumedToLimit()
+		switch {
+		case reservedBlockGas > 0:
+			// the gas limit of the tx was reserved, refund the unused part
+			ctx.BlockGasMeter().RefundGas(reservedBlockGas-min(gasUsed, reservedBlockGas), "block gas refund")
+		case app.reserveBlockGas:
+			// the tx failed before reserving its gas limit, account the gas it
+			// used without exceeding the block gas limit
+			ctx.BlockGasMeter().ConsumeGas(min(gasUsed, ctx.BlockGasMeter().GasRemaining()), "block gas meter")
+		default:
+			ctx.BlockGasMeter().ConsumeGas(gasUsed, "block gas meter")
 		}
 	}
 
@@ -865,9 +1017,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
-	if err != nil {
-		return sdk.GasInfo{}, nil, nil, err
+	if tx == nil {
+		tx, err = app.txDecoder(txBytes)
+		if err != nil {
+			return sdk.GasInfo{}, nil, nil, err
+		}
 	}
 
 	msgs := tx.GetMsgs()
@@ -897,7 +1051,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteCtx, anteSpan := startSpan(anteCtx, "ante")
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		telemetry.EndSpan(anteSpan, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -906,7 +1062,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// Also, in the case of the tx aborting, we need to track gas consumed via
 			// the instantiated gas meter in the AnteHandler, so we update the context
 			// prior to returning.
-			ctx = newCtx.WithMultiStore(ms)
+			ctx = withSpan(newCtx.WithMultiStore(ms), txSpan)
 		}
 
 		events := ctx.EventManager().Events()
@@ -924,6 +1080,20 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, nil, err
 		}
 
+		if mode == execModeFinalize && app.reserveBlockGas {
+			if remaining := ctx.BlockGasMeter().GasRemaining(); gasWanted > remaining {
+				// the tx does not fit in the block, its messages are not run.
+				// The state of the ante handler, e.g. the fee and sequence, is
+				// kept and the gas it used is accounted, so that the tx is not
+				// included in the block for free.
+				msCache.Write()
+				return gInfo, nil, events.ToABCIEvents(), errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "tx gas limit %d exceeds the remaining block gas %d", gasWanted, remaining)
+			}
+
+			ctx.BlockGasMeter().ConsumeGas(gasWanted, "block gas reservation")
+			reservedBlockGas = gasWanted
+		}
+
 		msCache.Write()
 		anteEvents = events.ToABCIEvents()
 	}
@@ -933,6 +1103,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
+	} else if exec, ok := speculativeExecution(ctx); ok {
+		// the tx is removed from the mempool if the result of its speculative
+		// execution is kept, see executeTxsInParallel.
+		exec.removeFromMempool = true
 	} else if mode == execModeFinalize {
 		err = app.mempool.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
@@ -951,7 +1125,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	// Result if any single message fails or does not have a registered Handler.
 	msgsV2, err := tx.GetMsgsV2()
 	if err == nil {
-		result, err = app.runMsgs(runMsgCtx, msgs, msgsV2, mode)
+		msgsCtx, msgsSpan := startSpan(runMsgCtx, "msgs", attribute.Int("count", len(msgs)))
+		result, err = app.runMsgs(msgsCtx, msgs, msgsV2, mode)
+		telemetry.EndSpan(msgsSpan, err)
 	}
 
 	// Run optional postHandlers (should run regardless of the execution result).
@@ -961,9 +1137,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// The runMsgCtx context currently contains events emitted by the ante handler.
 		// We clear this to correctly order events without duplicates.
 		// Note that the state is still preserved.
-		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
-
+		postCtx, postSpan := startSpan(runMsgCtx.WithEventManager(sdk.NewEventManager()), "post")
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		telemetry.EndSpan(postSpan, errPostHandler)
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -1013,7 +1189,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := app.execMsg(ctx, handler, msg, mode)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -561,6 +563,49 @@ func TestCustomRunTxPanicHandler(t *testing.T) {
 	}
 }
 
+func TestTypedRunTxRecoveryHandler(t *testing.T) {
+	nestedOutOfGasErr := errorsmod.Register("fakeModule", 100501, "out of gas in nested call")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			panic(storetypes.ErrorOutOfGas{Descriptor: "nested call"})
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	bz, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// without a custom handler, the standard out of gas error is returned
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{bz}})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), res.TxResults[0].Code)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// handlers of other types are ignored
+	suite.baseApp.AddRunTxRecoveryHandler(
+		sdk.NewTypedRecoveryHandler(func(storetypes.ErrorOutOfGas) error {
+			return nestedOutOfGasErr
+		}),
+		sdk.NewTypedRecoveryHandler(func(string) error {
+			return errors.New("unexpected handler")
+		}),
+	)
+
+	res, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 2, Txs: [][]byte{bz}})
+	require.NoError(t, err)
+	require.Equal(t, nestedOutOfGasErr.ABCICode(), res.TxResults[0].Code)
+	require.Equal(t, "fakeModule", res.TxResults[0].Codespace)
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) {
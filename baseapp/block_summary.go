@@ -0,0 +1,122 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterBlockSummaryMetrics registers metrics of the block summary event,
+// emitted at the end of FinalizeBlock. No block summary event is emitted if no
+// metric is registered.
+func (app *BaseApp) RegisterBlockSummaryMetrics(metrics ...sdk.BlockSummaryMetric) {
+	if app.sealed {
+		panic("RegisterBlockSummaryMetrics() on sealed BaseApp")
+	}
+
+	for _, metric := range metrics {
+		if metric.Name == "" || metric.EventType == "" {
+			panic(fmt.Sprintf("block summary metric %q: name and event type are required", metric.Name))
+		}
+		if metric.Coins && metric.Attribute == "" {
+			panic(fmt.Sprintf("block summary metric %q: attribute is required to sum coins", metric.Name))
+		}
+		for _, m := range app.blockSummaryMetrics {
+			if m.Name == metric.Name && m.Coins != metric.Coins {
+				panic(fmt.Sprintf("block summary metric %q: cannot both count events and sum coins", metric.Name))
+			}
+		}
+
+		app.blockSummaryMetrics = append(app.blockSummaryMetrics, metric)
+	}
+}
+
+// blockSummaryEvent returns the block summary event aggregating the given
+// block events and events of the transaction results, or false if no block
+// summary metric is registered. The attributes of the event follow the
+// registration order of the metrics.
+func (app *BaseApp) blockSummaryEvent(events []abci.Event, txResults []*abci.ExecTxResult) (abci.Event, bool) {
+	if len(app.blockSummaryMetrics) == 0 {
+		return abci.Event{}, false
+	}
+
+	var (
+		names  []string
+		counts = make(map[string]uint64)
+		coins  = make(map[string]sdk.Coins)
+	)
+	for _, metric := range app.blockSummaryMetrics {
+		if _, ok := counts[metric.Name]; !ok {
+			names = append(names, metric.Name)
+			counts[metric.Name] = 0
+		}
+	}
+
+	aggregate := func(event abci.Event) {
+		for _, metric := range app.blockSummaryMetrics {
+			if metric.EventType != event.Type {
+				continue
+			}
+
+			if metric.Attribute == "" {
+				counts[metric.Name]++
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if attr.Key != metric.Attribute {
+					continue
+				}
+
+				if !metric.Coins {
+					counts[metric.Name]++
+					break
+				}
+
+				amount, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					app.logger.Error("failed to parse block summary metric coins", "metric", metric.Name, "value", attr.Value, "err", err)
+					break
+				}
+				coins[metric.Name] = coins[metric.Name].Add(amount...)
+				break
+			}
+		}
+	}
+
+	for _, event := range events {
+		aggregate(event)
+	}
+	for _, res := range txResults {
+		for _, event := range res.Events {
+			aggregate(event)
+		}
+	}
+
+	summary := abci.Event{Type: sdk.EventTypeBlockSummary}
+	for _, name := range names {
+		value := strconv.FormatUint(counts[name], 10)
+		if app.isCoinsBlockSummaryMetric(name) {
+			value = coins[name].String()
+		}
+
+		summary.Attributes = append(summary.Attributes, abci.EventAttribute{Key: name, Value: value, Index: true})
+	}
+
+	return summary, true
+}
+
+// isCoinsBlockSummaryMetric returns whether the block summary metrics of the
+// given name sum coins.
+func (app *BaseApp) isCoinsBlockSummaryMetric(name string) bool {
+	for _, metric := range app.blockSummaryMetrics {
+		if metric.Name == name {
+			return metric.Coins
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterCustomQueryRoutes registers the handlers of the ABCI queries of path
+// "/custom/{route}/...". Custom queries are served after the gRPC queries, with
+// a read-only view of the state and the gas limit and timeout of their route.
+func (app *BaseApp) RegisterCustomQueryRoutes(routes ...sdk.CustomQueryRoute) {
+	if app.sealed {
+		panic("RegisterCustomQueryRoutes() on sealed BaseApp")
+	}
+
+	if app.customQueryRoutes == nil {
+		app.customQueryRoutes = make(map[string]sdk.CustomQueryRoute)
+	}
+
+	for _, route := range routes {
+		if route.Route == "" || route.Handler == nil {
+			panic(fmt.Sprintf("custom query route %q: route and handler are required", route.Route))
+		}
+		if _, ok := app.customQueryRoutes[route.Route]; ok {
+			panic(fmt.Sprintf("custom query route %q already registered", route.Route))
+		}
+
+		app.customQueryRoutes[route.Route] = route
+	}
+}
+
+// errReadOnlyQuery is the panic value of the writes of a custom query handler.
+type errReadOnlyQuery struct {
+	descriptor string
+}
+
+// errQueryTimeout is the panic value of the custom queries exceeding the timeout
+// of their route.
+type errQueryTimeout struct{}
+
+func handleQueryCustom(app *BaseApp, path []string, req *abci.QueryRequest) (resp *abci.QueryResponse) {
+	// "/custom" prefix for the queries of the custom query routes
+	if len(path) < 2 || path[1] == "" {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "no route for custom query specified"), app.trace)
+	}
+
+	route, ok := app.customQueryRoutes[path[1]]
+	if !ok {
+		return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no custom query route for %s", path[1]), app.trace)
+	}
+
+	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
+	gasLimit := app.queryGasLimit
+	if route.GasLimit > 0 && route.GasLimit < gasLimit {
+		gasLimit = route.GasLimit
+	}
+	var gasMeter storetypes.GasMeter = storetypes.NewGasMeter(gasLimit)
+	if route.Timeout > 0 {
+		deadline := time.Now().Add(route.Timeout)
+		goCtx, cancel := context.WithDeadline(ctx.Context(), deadline)
+		defer cancel()
+
+		ctx = ctx.WithContext(goCtx)
+		gasMeter = &deadlineGasMeter{GasMeter: gasMeter, deadline: deadline}
+	}
+	ctx = ctx.WithGasMeter(gasMeter).
+		WithMultiStore(readOnlyMultiStore{MultiStore: ctx.MultiStore()})
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+					r.Descriptor, gasMeter.Limit(), gasMeter.GasConsumed())
+			case errQueryTimeout:
+				err = errorsmod.Wrapf(sdkerrors.ErrQueryTimeout, "query exceeded the timeout of %s; gasUsed: %d", route.Timeout, gasMeter.GasConsumed())
+			case errReadOnlyQuery:
+				err = errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "custom queries cannot write to the state: %s", r.descriptor)
+			default:
+				panic(r)
+			}
+
+			resp = sdkerrors.QueryResult(err, app.trace)
+			resp.Height = req.Height
+		}
+	}()
+
+	bz, err := route.Handler(ctx, path[2:], req)
+	if err != nil {
+		resp = sdkerrors.QueryResult(err, app.trace)
+		resp.Height = req.Height
+		return resp
+	}
+
+	return &abci.QueryResponse{
+		Height: req.Height,
+		Value:  bz,
+	}
+}
+
+// deadlineGasMeter aborts a query once its deadline is passed, on the next gas
+// consumption.
+type deadlineGasMeter struct {
+	storetypes.GasMeter
+	deadline time.Time
+}
+
+func (m *deadlineGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if time.Now().After(m.deadline) {
+		panic(errQueryTimeout{})
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// readOnlyMultiStore gives a read-only access to the stores of a branch of the
+// multistore.
+type readOnlyMultiStore struct {
+	storetypes.MultiStore
+}
+
+func (ms readOnlyMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return readOnlyKVStore{KVStore: ms.MultiStore.GetKVStore(key)}
+}
+
+func (ms readOnlyMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return readOnlyKVStore{KVStore: ms.MultiStore.GetKVStore(key)}
+}
+
+// readOnlyKVStore panics on writes.
+type readOnlyKVStore struct {
+	storetypes.KVStore
+}
+
+func (s readOnlyKVStore) Set(key, _ []byte) {
+	panic(errReadOnlyQuery{descriptor: fmt.Sprintf("set %X", key)})
+}
+
+func (s readOnlyKVStore) Delete(key []byte) {
+	panic(errReadOnlyQuery{descriptor: fmt.Sprintf("delete %X", key)})
+}
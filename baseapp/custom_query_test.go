@@ -0,0 +1,89 @@
+package baseapp_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestCustomQueryRoutes(t *testing.T) {
+	routes := []sdk.CustomQueryRoute{
+		{
+			Route: "echo",
+			Handler: func(ctx sdk.Context, path []string, req *abci.QueryRequest) ([]byte, error) {
+				ctx.KVStore(capKey1).Get([]byte("key"))
+				return []byte(strings.Join(path, "/") + ":" + string(req.Data)), nil
+			},
+		},
+		{
+			Route: "write",
+			Handler: func(ctx sdk.Context, _ []string, _ *abci.QueryRequest) ([]byte, error) {
+				ctx.KVStore(capKey1).Set([]byte("key"), []byte("value"))
+				return nil, nil
+			},
+		},
+		{
+			Route:    "gas",
+			GasLimit: 100,
+			Handler: func(ctx sdk.Context, _ []string, _ *abci.QueryRequest) ([]byte, error) {
+				ctx.GasMeter().ConsumeGas(150, "test")
+				return nil, nil
+			},
+		},
+		{
+			Route:   "slow",
+			Timeout: time.Millisecond,
+			Handler: func(ctx sdk.Context, _ []string, _ *abci.QueryRequest) ([]byte, error) {
+				<-ctx.Context().Done()
+				ctx.KVStore(capKey1).Get([]byte("key"))
+				return nil, nil
+			},
+		},
+	}
+	require.Panics(t, func() {
+		NewBaseAppSuite(t, func(app *baseapp.BaseApp) { app.RegisterCustomQueryRoutes(routes[0], routes[0]) })
+	})
+
+	suite := NewBaseAppSuite(t, func(app *baseapp.BaseApp) { app.RegisterCustomQueryRoutes(routes...) })
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	resp, err := suite.baseApp.Query(context.Background(), &abci.QueryRequest{Path: "/custom/echo/a/b", Data: []byte("data")})
+	require.NoError(t, err)
+	require.Zero(t, resp.Code, resp.Log)
+	require.Equal(t, []byte("a/b:data"), resp.Value)
+	require.Equal(t, int64(1), resp.Height)
+
+	testCases := []struct {
+		path string
+		err  error
+	}{
+		{"/custom/unknown", sdkerrors.ErrUnknownRequest},
+		{"/custom/write", sdkerrors.ErrUnauthorized},
+		{"/custom/gas", sdkerrors.ErrOutOfGas},
+		{"/custom/slow", sdkerrors.ErrQueryTimeout},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			resp, err := suite.baseApp.Query(context.Background(), &abci.QueryRequest{Path: tc.path})
+			require.NoError(t, err)
+			require.Equal(t, tc.err.(interface{ ABCICode() uint32 }).ABCICode(), resp.Code, resp.Log)
+		})
+	}
+}
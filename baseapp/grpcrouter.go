@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -26,6 +27,9 @@ type GRPCQueryRouter struct {
 	hybridHandlers map[string][]func(ctx context.Context, req, resp protoiface.MessageV1) error
 	// responseByRequestName maps the request name to the response name.
 	responseByRequestName map[string]string
+	// moduleQuerySafe is the registry of the request names of the queries
+	// annotated as module query safe, i.e. deterministic and gas metered.
+	moduleQuerySafe map[string]struct{}
 	// binaryCodec is used to encode/decode binary protobuf messages.
 	binaryCodec codec.BinaryCodec
 	// cdc is the gRPC codec used by the router to correctly unmarshal messages.
@@ -48,6 +52,7 @@ func NewGRPCQueryRouter() *GRPCQueryRouter {
 		routes:                map[string]GRPCQueryHandler{},
 		hybridHandlers:        map[string][]func(ctx context.Context, req, resp protoiface.MessageV1) error{},
 		responseByRequestName: map[string]string{},
+		moduleQuerySafe:       map[string]struct{}{},
 	}
 }
 
@@ -140,6 +145,26 @@ func (qrt *GRPCQueryRouter) ResponseNameByRequestName(requestName string) string
 	return qrt.responseByRequestName[requestName]
 }
 
+// IsModuleQuerySafe returns whether the query with the given request name is
+// annotated as module query safe, i.e. is deterministic and gas metered, and can
+// thus be invoked from within the state machine.
+func (qrt *GRPCQueryRouter) IsModuleQuerySafe(requestName string) bool {
+	_, ok := qrt.moduleQuerySafe[requestName]
+	return ok
+}
+
+// ModuleQuerySafeRequests returns the sorted request names of the registered
+// queries annotated as module query safe.
+func (qrt *GRPCQueryRouter) ModuleQuerySafeRequests() []string {
+	names := make([]string, 0, len(qrt.moduleQuerySafe))
+	for name := range qrt.moduleQuerySafe {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 func (qrt *GRPCQueryRouter) registerHybridHandler(sd *grpc.ServiceDesc, method grpc.MethodDesc, handler interface{}) error {
 	// extract message name from method descriptor
 	inputName, err := protocompat.RequestFullNameFromMethodDesc(sd, method)
@@ -154,6 +179,13 @@ func (qrt *GRPCQueryRouter) registerHybridHandler(sd *grpc.ServiceDesc, method g
 	if err != nil {
 		return err
 	}
+	moduleQuerySafe, err := protocompat.IsModuleQuerySafe(sd, method)
+	if err != nil {
+		return err
+	}
+	if moduleQuerySafe {
+		qrt.moduleQuerySafe[string(inputName)] = struct{}{}
+	}
 	// map input name to output name
 	qrt.responseByRequestName[string(inputName)] = string(outputName)
 	qrt.hybridHandlers[string(inputName)] = append(qrt.hybridHandlers[string(inputName)], methodHandler)
@@ -8,6 +8,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
@@ -97,6 +98,21 @@ func TestGRPCRouterHybridHandlers(t *testing.T) {
 	})
 }
 
+func TestGRPCQueryRouter_ModuleQuerySafe(t *testing.T) {
+	qr := baseapp.NewGRPCQueryRouter()
+	qr.SetInterfaceRegistry(testdata.NewTestInterfaceRegistry())
+	testdata_pulsar.RegisterQueryServer(qr, testdata_pulsar.QueryImpl{})
+	bankv1beta1.RegisterQueryServer(qr, bankv1beta1.UnimplementedQueryServer{})
+
+	require.True(t, qr.IsModuleQuerySafe("cosmos.bank.v1beta1.QueryBalanceRequest"))
+	require.True(t, qr.IsModuleQuerySafe("cosmos.bank.v1beta1.QueryDenomOwnersRequest"))
+	require.False(t, qr.IsModuleQuerySafe("testpb.EchoRequest"))
+
+	requests := qr.ModuleQuerySafeRequests()
+	require.Contains(t, requests, "cosmos.bank.v1beta1.QueryBalanceRequest")
+	require.IsIncreasing(t, requests)
+}
+
 func TestRegisterQueryServiceTwice(t *testing.T) {
 	// Setup baseapp.
 	var appBuilder *runtime.AppBuilder
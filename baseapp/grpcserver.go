@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -67,6 +68,26 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
 
+		defer func() {
+			if r := recover(); r != nil {
+				oog, ok := r.(storetypes.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+
+				resp, err = nil, status.Errorf(
+					codes.ResourceExhausted, "query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+					oog.Descriptor, sdkCtx.GasMeter().Limit(), sdkCtx.GasMeter().GasConsumed(),
+				)
+			}
+
+			// report the gas consumed by the query, also when it ran out of gas.
+			gasUsed := metadata.Pairs(grpctypes.GRPCQueryGasUsedHeader, strconv.FormatUint(sdkCtx.GasMeter().GasConsumed(), 10))
+			if trailerErr := grpc.SetTrailer(grpcCtx, gasUsed); trailerErr != nil {
+				app.logger.Error("failed to set gRPC trailer", "err", trailerErr)
+			}
+		}()
+
 		return handler(grpcCtx, req)
 	}
 
@@ -13,6 +13,8 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
@@ -247,3 +249,25 @@ func ResponseFullNameFromMethodDesc(sd *grpc.ServiceDesc, method grpc.MethodDesc
 	}
 	return methodDesc.Output().FullName(), nil
 }
+
+// IsModuleQuerySafe returns whether the provided service's method is annotated
+// with the cosmos.query.v1.module_query_safe option.
+func IsModuleQuerySafe(sd *grpc.ServiceDesc, method grpc.MethodDesc) (bool, error) {
+	methodFullName := protoreflect.FullName(fmt.Sprintf("%s.%s", sd.ServiceName, method.MethodName))
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(methodFullName)
+	if err != nil {
+		return false, fmt.Errorf("cannot find method descriptor %s", methodFullName)
+	}
+	methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false, fmt.Errorf("invalid method descriptor %s", methodFullName)
+	}
+
+	opts := methodDesc.Options()
+	if opts == nil {
+		return false, nil
+	}
+
+	safe, _ := proto2.GetExtension(opts, queryv1.E_ModuleQuerySafe).(bool)
+	return safe, nil
+}
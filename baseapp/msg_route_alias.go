@@ -0,0 +1,93 @@
+package baseapp
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RouteResolver resolves the type URL of a Msg without a registered route to
+// the type URL of the Msg it must be routed as, e.g. after the Msg was moved to
+// another proto package. It returns false if the type URL cannot be resolved.
+type RouteResolver = func(typeURL string) (string, bool)
+
+// RegisterAlias registers aliasTypeURL as an alias of typeURL: Msgs with the
+// alias type URL are routed to the handler of typeURL, so that renamed or
+// migrated Msgs can still be submitted with their former type URL.
+//
+// The Msg with the alias type URL must still be registered in the interface
+// registry to be decoded, and must be wire compatible with the Msg of typeURL,
+// to which it is converted before being handled.
+func (msr *MsgServiceRouter) RegisterAlias(aliasTypeURL, typeURL string) {
+	if aliasTypeURL == typeURL {
+		panic(fmt.Errorf("cannot register type URL %s as an alias of itself", typeURL))
+	}
+
+	if msr.aliases == nil {
+		msr.aliases = make(map[string]string)
+	}
+	msr.aliases[aliasTypeURL] = typeURL
+}
+
+// SetRouteResolver sets a RouteResolver used to route the Msgs which have
+// neither a registered route nor an alias. As with aliases, the Msgs are
+// converted to the Msg of the resolved type URL before being handled.
+func (msr *MsgServiceRouter) SetRouteResolver(resolver RouteResolver) {
+	msr.routeResolver = resolver
+}
+
+// aliasHandler returns the MsgServiceHandler of the type URL the given type
+// URL is an alias of or is resolved to, or nil if not found.
+func (msr *MsgServiceRouter) aliasHandler(aliasTypeURL string) MsgServiceHandler {
+	typeURL, ok := msr.aliases[aliasTypeURL]
+	if !ok && msr.routeResolver != nil {
+		typeURL, ok = msr.routeResolver(aliasTypeURL)
+	}
+	if !ok {
+		return nil
+	}
+
+	handler, ok := msr.routes[typeURL]
+	if !ok {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		converted, err := msr.convertMsg(msg, typeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, converted)
+	}
+}
+
+// convertMsg converts the given Msg to the Msg with the given type URL by
+// re-encoding it.
+func (msr *MsgServiceRouter) convertMsg(msg sdk.Msg, typeURL string) (sdk.Msg, error) {
+	target, err := msr.interfaceRegistry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(bz, target); err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %w", sdk.MsgTypeURL(msg), typeURL, err)
+	}
+	if err := codectypes.UnpackInterfaces(target, msr.interfaceRegistry); err != nil {
+		return nil, err
+	}
+
+	converted, ok := target.(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement sdk.Msg", target)
+	}
+
+	return converted, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -32,11 +33,17 @@ type MessageRouter interface {
 
 // MsgServiceRouter routes fully-qualified Msg service methods to their handler.
 type MsgServiceRouter struct {
-	interfaceRegistry codectypes.InterfaceRegistry
-	routes            map[string]MsgServiceHandler
-	hybridHandlers    map[string]func(ctx context.Context, req, resp protoiface.MessageV1) error
-	responseByMsgName map[string]string
-	circuitBreaker    CircuitBreaker
+	interfaceRegistry   codectypes.InterfaceRegistry
+	routes              map[string]MsgServiceHandler
+	hybridHandlers      map[string]func(ctx context.Context, req, resp protoiface.MessageV1) error
+	responseByMsgName   map[string]string
+	circuitBreaker      CircuitBreaker
+	preMsgHandler       PreMsgHandler
+	postMsgHandler      PostMsgHandler
+	prefetchHints       map[string]PrefetchHintsFn
+	simulationCacheable map[string]bool
+	aliases             map[string]string
+	routeResolver       RouteResolver
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -55,18 +62,45 @@ func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
 	msr.circuitBreaker = cb
 }
 
+// SetPreMsgHandler sets a handler which is invoked before each Msg routed by
+// the MsgServiceRouter is executed. Returning an error aborts the execution
+// of the Msg.
+func (msr *MsgServiceRouter) SetPreMsgHandler(handler PreMsgHandler) {
+	msr.preMsgHandler = handler
+}
+
+// SetPostMsgHandler sets a handler which is invoked after each Msg routed by
+// the MsgServiceRouter has been successfully executed. Returning an error
+// fails the execution of the Msg.
+func (msr *MsgServiceRouter) SetPostMsgHandler(handler PostMsgHandler) {
+	msr.postMsgHandler = handler
+}
+
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// PreMsgHandler defines a function type which is invoked before a Msg is
+// executed by its MsgServiceHandler.
+type PreMsgHandler = func(ctx sdk.Context, msg sdk.Msg) error
+
+// PostMsgHandler defines a function type which is invoked with a Msg and its
+// response once the Msg has been executed by its MsgServiceHandler.
+type PostMsgHandler = func(ctx sdk.Context, msg sdk.Msg, resp proto.Message) error
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
-	return msr.routes[sdk.MsgTypeURL(msg)]
+	return msr.HandlerByTypeURL(sdk.MsgTypeURL(msg))
 }
 
 // HandlerByTypeURL returns the MsgServiceHandler for a given query route path or nil
-// if not found.
+// if not found. Type URLs without a route are looked up in the registered
+// aliases and then resolved with the RouteResolver, if any.
 func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler {
-	return msr.routes[typeURL]
+	if handler, ok := msr.routes[typeURL]; ok {
+		return handler
+	}
+
+	return msr.aliasHandler(typeURL)
 }
 
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
@@ -77,9 +111,18 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 //     RegisterInterfaces,
 //   - or if a service is being registered twice.
 func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	msr.RegisterModuleService("", sd, handler)
+}
+
+// RegisterModuleService registers the service like RegisterService, on behalf
+// of the given module. The handlers of the service are executed with the module
+// as the executing module of the Context, whose store accesses are checked
+// against the sdk.StoreAccessPolicy of the Context. The handlers of the
+// services registered without a module keep the executing module of the caller.
+func (msr *MsgServiceRouter) RegisterModuleService(moduleName string, sd *grpc.ServiceDesc, handler interface{}) {
 	// Adds a top-level query handler based on the gRPC service name.
 	for _, method := range sd.Methods {
-		err := msr.registerMsgServiceHandler(sd, method, handler)
+		err := msr.registerMsgServiceHandler(moduleName, sd, method, handler)
 		if err != nil {
 			panic(err)
 		}
@@ -135,7 +178,7 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	return nil
 }
 
-func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, method grpc.MethodDesc, handler interface{}) error {
+func (msr *MsgServiceRouter) registerMsgServiceHandler(moduleName string, sd *grpc.ServiceDesc, method grpc.MethodDesc, handler interface{}) error {
 	fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
 	methodHandler := method.Handler
 
@@ -191,10 +234,17 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (_ *sdk.Result, err error) {
+		ctx, span := startSpan(ctx, fqMethod)
+		defer func() { telemetry.EndSpan(span, err) }()
+
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
+			handlerCtx := ctx
+			if moduleName != "" {
+				handlerCtx = ctx.WithExecutingModule(moduleName)
+			}
+			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, handlerCtx)
 			return handler(goCtx, msg)
 		}
 
@@ -216,6 +266,12 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			}
 		}
 
+		if msr.preMsgHandler != nil {
+			if err := msr.preMsgHandler(ctx, msg); err != nil {
+				return nil, err
+			}
+		}
+
 		// Call the method handler from the service description with the handler object.
 		// We don't do any decoding here because the decoding was already done.
 		res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
@@ -228,6 +284,12 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
 		}
 
+		if msr.postMsgHandler != nil {
+			if err := msr.postMsgHandler(ctx, msg, resMsg); err != nil {
+				return nil, err
+			}
+		}
+
 		anyResp, err := codectypes.NewAnyWithValue(resMsg)
 		if err != nil {
 			return nil, err
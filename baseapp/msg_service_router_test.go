@@ -2,22 +2,28 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	authsigning "cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -121,6 +127,171 @@ func TestHybridHandlerByMsgName(t *testing.T) {
 	require.Equal(t, resp.Name, "Spot")
 }
 
+func TestPreAndPostMsgHandlers(t *testing.T) {
+	// Setup baseapp and router.
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	var calls []string
+	app.MsgServiceRouter().SetPreMsgHandler(func(ctx sdk.Context, msg sdk.Msg) error {
+		calls = append(calls, "pre:"+sdk.MsgTypeURL(msg))
+		if msg.(*testdata.MsgCreateDog).Owner == "rejected" {
+			return errors.New("rejected by pre msg handler")
+		}
+		return nil
+	})
+	app.MsgServiceRouter().SetPostMsgHandler(func(ctx sdk.Context, msg sdk.Msg, resp gogoproto.Message) error {
+		calls = append(calls, "post:"+resp.(*testdata.MsgCreateDogResponse).Name)
+		return nil
+	})
+
+	require.NoError(t, app.Init())
+	ctx := app.NewContext(true)
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}, Owner: "me"}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pre:/testpb.MsgCreateDog", "post:Spot"}, calls)
+
+	calls = nil
+	_, err = handler(ctx, &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}, Owner: "rejected"})
+	require.ErrorContains(t, err, "rejected by pre msg handler")
+	require.Equal(t, []string{"pre:/testpb.MsgCreateDog"}, calls)
+}
+
+// dogsMsgServer records the executing module of the Msgs it handles.
+type dogsMsgServer struct {
+	executingModule string
+}
+
+func (s *dogsMsgServer) CreateDog(ctx context.Context, msg *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	s.executingModule = sdk.UnwrapSDKContext(ctx).ExecutingModule()
+	return &testdata.MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+// moduleRegistrar registers the services on behalf of a module.
+type moduleRegistrar struct {
+	router     *baseapp.MsgServiceRouter
+	moduleName string
+}
+
+func (r moduleRegistrar) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	r.router.RegisterModuleService(r.moduleName, sd, handler)
+}
+
+func TestRegisterModuleService(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+
+	server := &dogsMsgServer{}
+	testdata.RegisterMsgServer(moduleRegistrar{router: app.MsgServiceRouter(), moduleName: "dogs"}, server)
+	require.NoError(t, app.Init())
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}, Owner: "me"}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	// the Msg is executed on behalf of the module of its service.
+	ctx := app.NewContext(true).WithExecutingModule("cats")
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "dogs", server.executingModule)
+}
+
+// legacyMsgCreateDog is MsgCreateDog before a proto package move.
+type legacyMsgCreateDog struct {
+	testdata.MsgCreateDog
+}
+
+func (*legacyMsgCreateDog) XXX_MessageName() string { return "testpb.legacy.MsgCreateDog" }
+
+func TestMsgRouteAliases(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+
+	router := app.MsgServiceRouter()
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	var calls []string
+	router.SetPreMsgHandler(func(ctx sdk.Context, msg sdk.Msg) error {
+		calls = append(calls, sdk.MsgTypeURL(msg))
+		return nil
+	})
+
+	require.NoError(t, app.Init())
+	ctx := app.NewContext(true)
+
+	msg := &legacyMsgCreateDog{testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}, Owner: "me"}}
+	require.Equal(t, "/testpb.legacy.MsgCreateDog", sdk.MsgTypeURL(msg))
+	require.Nil(t, router.Handler(msg))
+
+	// aliases are routed to the handler of the Msg they are an alias of
+	router.RegisterAlias("/testpb.legacy.MsgCreateDog", "/testpb.MsgCreateDog")
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.Len(t, res.MsgResponses, 1)
+	var resp testdata.MsgCreateDogResponse
+	require.NoError(t, resp.Unmarshal(res.MsgResponses[0].Value))
+	require.Equal(t, "Spot", resp.Name)
+	require.Equal(t, []string{"/testpb.MsgCreateDog"}, calls)
+
+	// aliases of unknown routes are not routed
+	router.RegisterAlias("/testpb.legacy.MsgCreateCat", "/testpb.MsgCreateCat")
+	require.Nil(t, router.HandlerByTypeURL("/testpb.legacy.MsgCreateCat"))
+
+	// type URLs without route nor alias are resolved with the route resolver
+	router.SetRouteResolver(func(typeURL string) (string, bool) {
+		name, ok := strings.CutPrefix(typeURL, "/testpb.v0.")
+		return "/testpb." + name, ok
+	})
+	require.NotNil(t, router.HandlerByTypeURL("/testpb.v0.MsgCreateDog"))
+	require.Nil(t, router.HandlerByTypeURL("/testpb.v0.MsgCreateCat"))
+	require.Nil(t, router.HandlerByTypeURL("/other.MsgCreateDog"))
+
+	require.Panics(t, func() { router.RegisterAlias("/testpb.MsgCreateDog", "/testpb.MsgCreateDog") })
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 
@@ -0,0 +1,241 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// VersionedAppCreator creates the application serving a range of app versions.
+// The created application must load the latest version of the state shared by
+// all the applications of the AppMultiplexer, e.g. with LoadLatestVersion.
+type VersionedAppCreator func() (servertypes.ABCI, error)
+
+var _ servertypes.ABCI = (*AppMultiplexer)(nil)
+
+// AppMultiplexer hosts multiple versions of an application behind one ABCI
+// entrypoint, so that an in-place upgrade changing the handling of the txs
+// doesn't require to swap the binary. Each application serves the app versions
+// from its first app version up to the first app version of the next one, the
+// application serving the app version set in the consensus params handling
+// the ABCI requests.
+//
+// The active application is selected when the multiplexer is created and on
+// InitChain, and switched after each Commit if the app version of the
+// committed consensus params is served by another application. The replaced
+// applications are not closed, since they share their database with the
+// active one.
+type AppMultiplexer struct {
+	logger   log.Logger
+	creators map[uint64]VersionedAppCreator
+	versions []uint64 // the first app versions of the applications, sorted
+
+	mtx           sync.RWMutex
+	active        servertypes.ABCI
+	activeVersion uint64 // the first app version of the active application
+}
+
+// NewAppMultiplexer returns an AppMultiplexer creating the applications with
+// the given creators, keyed by the first app version they serve. It creates
+// the application serving the lowest app version, then switches to the one
+// serving the app version of the latest committed state.
+func NewAppMultiplexer(logger log.Logger, creators map[uint64]VersionedAppCreator) (*AppMultiplexer, error) {
+	if len(creators) == 0 {
+		return nil, errors.New("app multiplexer requires at least one app")
+	}
+
+	versions := make([]uint64, 0, len(creators))
+	for version, creator := range creators {
+		if creator == nil {
+			return nil, fmt.Errorf("nil app creator for app version %d", version)
+		}
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+
+	m := &AppMultiplexer{
+		logger:   logger.With(log.ModuleKey, "multiplexer"),
+		creators: creators,
+		versions: versions,
+	}
+
+	if err := m.activate(versions[0]); err != nil {
+		return nil, err
+	}
+	if err := m.switchToCommittedVersion(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ActiveAppVersion returns the first app version served by the active
+// application.
+func (m *AppMultiplexer) ActiveAppVersion() uint64 {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	return m.activeVersion
+}
+
+// app returns the active application.
+func (m *AppMultiplexer) app() servertypes.ABCI {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	return m.active
+}
+
+// activate makes the application serving the given app version the active
+// one, creating it if it isn't already active.
+func (m *AppMultiplexer) activate(appVersion uint64) error {
+	// the application serving the app version is the one with the greatest
+	// first app version lower than or equal to the app version.
+	i, found := slices.BinarySearch(m.versions, appVersion)
+	if !found {
+		if i == 0 {
+			return fmt.Errorf("no app serves app version %d, the lowest served app version is %d", appVersion, m.versions[0])
+		}
+		i--
+	}
+	version := m.versions[i]
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.active != nil && m.activeVersion == version {
+		return nil
+	}
+
+	app, err := m.creators[version]()
+	if err != nil {
+		return fmt.Errorf("failed to create app for app version %d: %w", appVersion, err)
+	}
+
+	if m.active != nil {
+		m.logger.Info("switching app", "from", m.activeVersion, "to", version, "app_version", appVersion)
+	}
+	m.active, m.activeVersion = app, version
+
+	return nil
+}
+
+// switchToCommittedVersion activates the application serving the app version
+// of the latest committed state, as reported by the active application, if
+// any state is committed.
+func (m *AppMultiplexer) switchToCommittedVersion() error {
+	res, err := m.app().Info(&abci.InfoRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get the committed app version: %w", err)
+	}
+	if res.LastBlockHeight == 0 {
+		return nil
+	}
+
+	return m.activate(res.AppVersion)
+}
+
+// Info implements the ABCI interface.
+func (m *AppMultiplexer) Info(req *abci.InfoRequest) (*abci.InfoResponse, error) {
+	return m.app().Info(req)
+}
+
+// Query implements the ABCI interface.
+func (m *AppMultiplexer) Query(ctx context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	return m.app().Query(ctx, req)
+}
+
+// CheckTx implements the ABCI interface.
+func (m *AppMultiplexer) CheckTx(req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	return m.app().CheckTx(req)
+}
+
+// InitChain implements the ABCI interface. It activates the application
+// serving the app version of the genesis consensus params, if set, before
+// delegating.
+func (m *AppMultiplexer) InitChain(req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	if version := req.ConsensusParams.GetVersion(); version != nil {
+		if err := m.activate(version.App); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.app().InitChain(req)
+}
+
+// PrepareProposal implements the ABCI interface.
+func (m *AppMultiplexer) PrepareProposal(req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+	return m.app().PrepareProposal(req)
+}
+
+// ProcessProposal implements the ABCI interface.
+func (m *AppMultiplexer) ProcessProposal(req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+	return m.app().ProcessProposal(req)
+}
+
+// FinalizeBlock implements the ABCI interface.
+func (m *AppMultiplexer) FinalizeBlock(req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	return m.app().FinalizeBlock(req)
+}
+
+// ExtendVote implements the ABCI interface.
+func (m *AppMultiplexer) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+	return m.app().ExtendVote(ctx, req)
+}
+
+// VerifyVoteExtension implements the ABCI interface.
+func (m *AppMultiplexer) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+	return m.app().VerifyVoteExtension(req)
+}
+
+// Commit implements the ABCI interface. Once the state is committed, it
+// switches to the application serving the committed app version, which
+// handles the next blocks.
+func (m *AppMultiplexer) Commit() (*abci.CommitResponse, error) {
+	res, err := m.app().Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.switchToCommittedVersion(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListSnapshots implements the ABCI interface.
+func (m *AppMultiplexer) ListSnapshots(req *abci.ListSnapshotsRequest) (*abci.ListSnapshotsResponse, error) {
+	return m.app().ListSnapshots(req)
+}
+
+// OfferSnapshot implements the ABCI interface.
+func (m *AppMultiplexer) OfferSnapshot(req *abci.OfferSnapshotRequest) (*abci.OfferSnapshotResponse, error) {
+	return m.app().OfferSnapshot(req)
+}
+
+// LoadSnapshotChunk implements the ABCI interface.
+func (m *AppMultiplexer) LoadSnapshotChunk(req *abci.LoadSnapshotChunkRequest) (*abci.LoadSnapshotChunkResponse, error) {
+	return m.app().LoadSnapshotChunk(req)
+}
+
+// ApplySnapshotChunk implements the ABCI interface. Once the snapshot is
+// restored, it switches to the application serving the restored app version.
+func (m *AppMultiplexer) ApplySnapshotChunk(req *abci.ApplySnapshotChunkRequest) (*abci.ApplySnapshotChunkResponse, error) {
+	res, err := m.app().ApplySnapshotChunk(req)
+	if err != nil || res.Result != abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT {
+		return res, err
+	}
+
+	if err := m.switchToCommittedVersion(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
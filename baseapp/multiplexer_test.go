@@ -0,0 +1,108 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// chainState is the state shared by the multiplexed apps.
+type chainState struct {
+	height     int64
+	appVersion uint64
+	// nextAppVersion is the app version committed with the next block.
+	nextAppVersion uint64
+}
+
+// versionedApp is an app recording the blocks it finalizes.
+type versionedApp struct {
+	servertypes.ABCI
+
+	state  *chainState
+	blocks []int64
+}
+
+func (a *versionedApp) Info(*abci.InfoRequest) (*abci.InfoResponse, error) {
+	return &abci.InfoResponse{LastBlockHeight: a.state.height, AppVersion: a.state.appVersion}, nil
+}
+
+func (a *versionedApp) InitChain(req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	a.state.appVersion = req.ConsensusParams.GetVersion().GetApp()
+	a.state.nextAppVersion = a.state.appVersion
+	return &abci.InitChainResponse{}, nil
+}
+
+func (a *versionedApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	a.blocks = append(a.blocks, req.Height)
+	return &abci.FinalizeBlockResponse{}, nil
+}
+
+func (a *versionedApp) Commit() (*abci.CommitResponse, error) {
+	a.state.height++
+	a.state.appVersion = a.state.nextAppVersion
+	return &abci.CommitResponse{}, nil
+}
+
+func TestAppMultiplexer(t *testing.T) {
+	state := &chainState{}
+	apps := map[uint64]*versionedApp{}
+	creators := map[uint64]baseapp.VersionedAppCreator{}
+	for _, version := range []uint64{1, 3} {
+		creators[version] = func() (servertypes.ABCI, error) {
+			apps[version] = &versionedApp{state: state}
+			return apps[version], nil
+		}
+	}
+
+	_, err := baseapp.NewAppMultiplexer(log.NewNopLogger(), nil)
+	require.Error(t, err)
+
+	m, err := baseapp.NewAppMultiplexer(log.NewNopLogger(), creators)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), m.ActiveAppVersion())
+
+	_, err = m.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 0}}})
+	require.ErrorContains(t, err, "no app serves app version 0")
+
+	_, err = m.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{Version: &cmtproto.VersionParams{App: 2}}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), m.ActiveAppVersion())
+
+	finalize := func(upgradeTo uint64) {
+		t.Helper()
+		_, err := m.FinalizeBlock(&abci.FinalizeBlockRequest{Height: state.height + 1})
+		require.NoError(t, err)
+		if upgradeTo != 0 {
+			state.nextAppVersion = upgradeTo
+		}
+		_, err = m.Commit()
+		require.NoError(t, err)
+	}
+
+	// the app serving app version 3 handles the blocks following the block
+	// committing app version 3.
+	finalize(0)
+	finalize(3)
+	require.Equal(t, uint64(3), m.ActiveAppVersion())
+	finalize(4)
+	finalize(0)
+	require.Equal(t, []int64{1, 2}, apps[1].blocks)
+	require.Equal(t, []int64{3, 4}, apps[3].blocks)
+
+	res, err := m.Info(&abci.InfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.AppVersion)
+
+	// on restart, the app serving the committed app version is activated.
+	m, err = baseapp.NewAppMultiplexer(log.NewNopLogger(), creators)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), m.ActiveAppVersion())
+	require.Empty(t, apps[3].blocks)
+}
@@ -11,6 +11,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // FinalizeBlockFunc is the function that is called by the OE to finalize the
@@ -110,6 +112,7 @@ func (oe *OptimisticExecution) Execute(req *abci.ProcessProposalRequest) {
 		oe.mtx.Lock()
 
 		executionTime := time.Since(start)
+		telemetry.MeasureSince(start, "optimistic_execution", "duration")
 		oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", oe.request.Height, "hash", hex.EncodeToString(oe.request.Hash))
 		oe.response, oe.err = resp, err
 
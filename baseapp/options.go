@@ -9,8 +9,10 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	"cosmossdk.io/store/encryptedkv"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -30,6 +32,16 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning overrides the pruning strategy of specific stores of the
+// multistore associated with the app, by store key name.
+func SetStorePruning(storeOpts map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		for name, opts := range storeOpts {
+			bapp.cms.SetStorePruning(name, opts)
+		}
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -49,6 +61,65 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetBlockGasReservation returns an option that enables reserving the gas
+// limit of a transaction against the block gas meter once its AnteHandler has
+// run, before executing its messages. The gas left unused by the transaction is
+// refunded after its execution. Transactions whose gas limit exceeds the
+// remaining block gas are rejected without executing their messages, so that
+// the gas accounted for the block never exceeds the maximum block gas, even
+// when transactions fail late in the block. The state of their AnteHandler,
+// e.g. the fee and sequence, is kept so that they are not included for free.
+func SetBlockGasReservation(reserve bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.reserveBlockGas = reserve }
+}
+
+// SetPrefetchLimit returns an option that enables prefetching the keys
+// declared by the prefetch hints of the Msgs of a block before executing it,
+// reading at most limit keys per hint. Prefetching is disabled if limit is 0.
+func SetPrefetchLimit(limit int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.prefetchLimit = limit }
+}
+
+// SetParallelExecution returns an option that enables the experimental
+// parallel execution of the txs of a block, executing up to workers txs
+// concurrently. The txs are first executed speculatively against the state
+// preceding them while recording the keys they read and write, then the txs
+// having read keys written by a preceding tx are executed again one after the
+// other, so that the results are the same as when executing the txs
+// sequentially.
+//
+// The speculative executions only write to a branch of the state and are kept
+// only if the operations of the tx on the block gas meter have the same results
+// as when executing the txs sequentially. The txs are removed from the mempool
+// when their result is kept. The components keeping state outside of the
+// stores, e.g. in memory, must call sdk.InvalidateSpeculation before accessing
+// it while executing a tx. Parallel execution is disabled if workers is lower
+// than 2 and when store tracing is enabled.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.parallelExecWorkers = workers }
+}
+
+// SetSimulationCacheSize returns an option that enables memoizing the
+// execution results of the Msgs registered with
+// MsgServiceRouter.RegisterSimulationCacheable during simulation, keeping the
+// results of at most size Msgs per state version. It spares re-executing the
+// same Msgs when a tx is simulated repeatedly, e.g. while a user edits its
+// fees. The cache is disabled if size is 0.
+func SetSimulationCacheSize(size int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if size <= 0 {
+			bapp.simulationCache = nil
+			return
+		}
+
+		cache, err := newSimulationCache(size)
+		if err != nil {
+			panic(err)
+		}
+		bapp.simulationCache = cache
+	}
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -59,6 +130,22 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltTime(haltTime) }
 }
 
+// SetHaltSnapshot returns a BaseApp option function that makes the node take a
+// state snapshot of the last committed block when it halts per the halt-height
+// or halt-time configuration.
+func SetHaltSnapshot(enable bool) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if enable {
+			bapp.SetHaltHandler(bapp.snapshotOnHalt)
+		}
+	}
+}
+
+// SetMaintenance returns a BaseApp option function that enables the maintenance mode.
+func SetMaintenance(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetMaintenance(enabled) }
+}
+
 // SetMinRetainBlocks returns a BaseApp option function that sets the minimum
 // block retention height value when determining which heights to prune during
 // ABCI Commit.
@@ -76,6 +163,12 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetIndexEventTypes provides a BaseApp option function that forces CometBFT
+// to index all the attributes of the events of the given types.
+func SetIndexEventTypes(types []string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setIndexEventTypes(types) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -86,6 +179,28 @@ func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
 }
 
+// SetIAVLLazyLoading enables the fast nodes of the IAVL stores and the lazy
+// loading of the nodes traversed when iterating over their past versions,
+// exporting or importing them, e.g. when restoring a state sync snapshot.
+func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetIAVLLazyLoading(lazyLoading) }
+}
+
+// SetStoreEncryption provides a BaseApp option function that encrypts the values
+// of the given stores holding node-local data with the keys of the keyring, see
+// rootmulti.Store.SetStoreEncryption. It panics if the CommitMultiStore of the
+// app is not a rootmulti.Store.
+func SetStoreEncryption(keyring *encryptedkv.Keyring, keys ...storetypes.StoreKey) func(*BaseApp) {
+	return func(app *BaseApp) {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			panic(fmt.Sprintf("store encryption requires a rootmulti.Store, got %T", app.cms))
+		}
+
+		rms.SetStoreEncryption(keyring, keys...)
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -107,6 +222,19 @@ func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
+// SetStreamingSink registers a streaming sink under the given name, e.g. a
+// streaming.PublisherListener publishing to Kafka. The sink streams the blocks
+// once its name is listed in the streaming.abci.sinks setting of the app
+// config, see RegisterStreamingServices.
+func SetStreamingSink(name string, listener storetypes.ABCIListener) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if app.streamingSinks == nil {
+			app.streamingSinks = make(map[string]storetypes.ABCIListener)
+		}
+		app.streamingSinks[name] = listener
+	}
+}
+
 // SetStoreLoader allows customization of the rootMultiStore initialization.
 func SetStoreLoader(loader StoreLoader) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetStoreLoader(loader) }
@@ -239,6 +367,14 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	}
 
 	app.anteHandler = ah
+	app.anteDescription = nil
+}
+
+// SetAnteDecorators sets the AnteHandler chaining the given decorators, and
+// records their descriptions, reported by AnteHandlerDescription.
+func (app *BaseApp) SetAnteDecorators(decorators ...sdk.AnteDecorator) {
+	app.SetAnteHandler(sdk.ChainAnteDecorators(decorators...))
+	app.anteDescription = sdk.DescribeAnteDecorators(decorators...)
 }
 
 func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
@@ -381,11 +517,29 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	app.cms.SetMetrics(gatherer)
 }
 
+// SetHaltHandler sets the handler called once the node halts per the halt-height
+// or halt-time configuration. It can be set after the BaseApp is sealed, but
+// must be set before the node starts.
+func (app *BaseApp) SetHaltHandler(handler HaltHandler) {
+	app.haltHandler = handler
+}
+
 // SetStreamingManager sets the streaming manager for the BaseApp.
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
 
+// SetStoreAccessPolicy sets the policy governing the stores each module can
+// obtain from the Context while executing its Msgs and ABCI methods. The
+// modules executed are tagged by the MsgServiceRouter and the module manager.
+func (app *BaseApp) SetStoreAccessPolicy(policy *sdk.StoreAccessPolicy) {
+	if app.sealed {
+		panic("SetStoreAccessPolicy() on sealed BaseApp")
+	}
+
+	app.storeAccessPolicy = policy
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
@@ -0,0 +1,460 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// canExecuteTxsInParallel returns whether the given txs can be executed by
+// executeTxsInParallel, see SetParallelExecution.
+func (app *BaseApp) canExecuteTxsInParallel(txs [][]byte) bool {
+	if app.parallelExecWorkers < 2 || len(txs) < 2 || app.cms.TracingEnabled() {
+		return false
+	}
+
+	_, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	return ok
+}
+
+// executeTxsInParallel executes the given txs like executeTxs, but runs them
+// speculatively and concurrently against the state preceding the txs first,
+// recording the keys each of them reads and writes, and the operations on its
+// block gas meter.
+//
+// The speculative executions only write to a branch of the state: the removal
+// of the txs from the mempool is deferred, and the executions accessing state
+// kept outside of the stores are invalidated, see sdk.InvalidateSpeculation.
+//
+// The results are then validated in the order of the txs: the result of a tx
+// is kept if its execution is valid, if none of the keys it read was written by
+// a preceding tx and if the operations on its block gas meter have the same
+// results against the actual block gas meter, in which case its writes and
+// block gas are applied and it is removed from the mempool. Otherwise, the tx
+// is executed again against the current state. The results are thus the same
+// as the ones of executeTxs.
+func (app *BaseApp) executeTxsInParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	storeKeys := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	}).StoreKeysByName()
+	ms := app.finalizeBlockState.ms
+	blockGasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+
+	// newBlockGasMeter returns a block gas meter in the given state, built like
+	// the actual block gas meter.
+	maxBlockGas := app.GetMaximumBlockGas(app.finalizeBlockState.Context())
+	newBlockGasMeter := func(consumed storetypes.Gas) storetypes.GasMeter {
+		meter := storetypes.NewInfiniteGasMeter()
+		if maxBlockGas > 0 {
+			meter = storetypes.NewGasMeter(maxBlockGas)
+		}
+		_ = recoverPanic(func() { meter.ConsumeGas(consumed, "block gas meter") })
+
+		return meter
+	}
+
+	// the txs are decoded beforehand as decoding them is not safe for
+	// concurrent use.
+	decodedTxs := make([]sdk.Tx, len(txs))
+	for i, rawTx := range txs {
+		decodedTxs[i], _ = app.txDecoder(rawTx)
+	}
+
+	// the txs only read the state until all of them are executed, so they can
+	// share it.
+	startBlockGas := blockGasMeter.GasConsumed()
+	executions := make([]*txExecution, len(txs))
+	work := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < min(len(txs), app.parallelExecWorkers); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				if decodedTxs[i] != nil {
+					gasLog := &blockGasLog{meter: newBlockGasMeter(startBlockGas)}
+					executions[i] = app.executeTxInBranch(ms, storeKeys, gasLog, txs[i], decodedTxs[i], true)
+					executions[i].blockGasLog = gasLog
+				}
+			}
+		}()
+	}
+
+	for i := range txs {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	written := writtenKeys{}
+	reused, reexecuted := 0, 0
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, rawTx := range txs {
+		exec := executions[i]
+		if exec == nil {
+			// the tx is malformed, see executeTxs.
+			txResults = append(txResults, sdkerrors.ResponseExecTxResultWithEvents(sdkerrors.ErrTxDecode, 0, 0, nil, false))
+			continue
+		}
+
+		reuse := !exec.speculation.Invalid() &&
+			!written.conflict(exec.stores) &&
+			exec.blockGasLog.replay(newBlockGasMeter(blockGasMeter.GasConsumed()))
+		if reuse && exec.removeFromMempool {
+			// a failure is reported by executing the tx again, as done when
+			// executing the txs sequentially.
+			if err := app.mempool.Remove(decodedTxs[i]); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				reuse = false
+			}
+		}
+
+		if reuse {
+			exec.blockGasLog.replay(blockGasMeter)
+			recordTxTelemetry(sdk.GasInfo{GasWanted: uint64(exec.result.GasWanted), GasUsed: uint64(exec.result.GasUsed)}, txResultStr(exec.result))
+			reused++
+		} else {
+			exec = app.executeTxInBranch(ms, storeKeys, blockGasMeter, rawTx, decodedTxs[i], false)
+			reexecuted++
+		}
+
+		exec.write(ms, storeKeys)
+		written.add(exec.stores)
+		txResults = append(txResults, exec.result)
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+	}
+
+	telemetry.IncrCounter(float32(reused), "parallel_execution", "reused")
+	telemetry.IncrCounter(float32(reexecuted), "parallel_execution", "reexecuted")
+
+	return txResults, nil
+}
+
+// txResultStr returns the result of a tx recorded by the telemetry.
+func txResultStr(result *abci.ExecTxResult) string {
+	if result.IsOK() {
+		return "successful"
+	}
+
+	return "failed"
+}
+
+// txExecution is the result of the execution of a tx in a branch of the state,
+// along with the keys it read and wrote.
+type txExecution struct {
+	result *abci.ExecTxResult
+	stores map[string]*trackingStore
+
+	// speculation, blockGasLog and removeFromMempool are only set for the
+	// speculative executions. removeFromMempool is set if the execution reached
+	// the removal of the tx from the mempool, which is deferred until its result
+	// is kept.
+	speculation       *sdk.Speculation
+	blockGasLog       *blockGasLog
+	removeFromMempool bool
+}
+
+// txExecutionKey is the Context key of the speculative txExecution of a tx.
+type txExecutionKey struct{}
+
+// speculativeExecution returns the speculative execution of the tx executed in
+// the given context, if any.
+func speculativeExecution(ctx sdk.Context) (*txExecution, bool) {
+	exec, ok := ctx.Value(txExecutionKey{}).(*txExecution)
+	return exec, ok
+}
+
+// executeTxInBranch executes the given tx in a branch of ms consuming block gas
+// from the given meter, speculatively or not. The writes of the tx are kept in
+// the branch.
+func (app *BaseApp) executeTxInBranch(
+	ms storetypes.MultiStore, storeKeys map[string]storetypes.StoreKey, blockGasMeter storetypes.GasMeter, tx []byte, decodedTx sdk.Tx, speculative bool,
+) *txExecution {
+	exec := &txExecution{stores: make(map[string]*trackingStore, len(storeKeys))}
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(storeKeys))
+	for name, key := range storeKeys {
+		store := newTrackingStore(ms.GetKVStore(key))
+		exec.stores[name] = store
+		stores[key] = store
+	}
+
+	branch := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, storeKeys, nil, nil)
+	ctx := app.getContextForTx(execModeFinalize, tx).
+		WithMultiStore(branch).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	if speculative {
+		ctx, exec.speculation = sdk.WithSpeculation(ctx)
+		ctx = ctx.WithValue(txExecutionKey{}, exec)
+	}
+
+	exec.result = app.deliverTxInContext(ctx, tx, decodedTx)
+	branch.Write()
+
+	return exec
+}
+
+// write applies the writes of the tx to ms.
+func (e *txExecution) write(ms storetypes.MultiStore, storeKeys map[string]storetypes.StoreKey) {
+	for name, store := range e.stores {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		parent := ms.GetKVStore(storeKeys[name])
+		for _, key := range store.writes {
+			if value := store.Get([]byte(key)); value != nil {
+				parent.Set([]byte(key), value)
+			} else {
+				parent.Delete([]byte(key))
+			}
+		}
+	}
+}
+
+// blockGasLog is the block gas meter of a tx executed speculatively. It starts
+// in the state of the block gas meter preceding the txs and logs the operations
+// of the tx along with their results, so that they can be replayed against the
+// actual block gas meter to check that the tx behaves the same.
+type blockGasLog struct {
+	meter storetypes.GasMeter
+	ops   []blockGasOp
+}
+
+var _ storetypes.GasMeter = (*blockGasLog)(nil)
+
+// blockGasOp is an operation on a block gas meter, returning the value read or
+// the value of the panic of the meter.
+type blockGasOp struct {
+	do     func(storetypes.GasMeter) any
+	result any
+}
+
+func (l *blockGasLog) log(do func(storetypes.GasMeter) any) any {
+	result := do(l.meter)
+	l.ops = append(l.ops, blockGasOp{do: do, result: result})
+	return result
+}
+
+// replay replays the logged operations against the given meter, returning
+// whether they all had the same results.
+func (l *blockGasLog) replay(meter storetypes.GasMeter) bool {
+	for _, op := range l.ops {
+		if op.do(meter) != op.result {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *blockGasLog) GasConsumed() storetypes.Gas {
+	return l.log(func(m storetypes.GasMeter) any { return m.GasConsumed() }).(storetypes.Gas)
+}
+
+func (l *blockGasLog) GasConsumedToLimit() storetypes.Gas {
+	return l.log(func(m storetypes.GasMeter) any { return m.GasConsumedToLimit() }).(storetypes.Gas)
+}
+
+func (l *blockGasLog) GasRemaining() storetypes.Gas {
+	return l.log(func(m storetypes.GasMeter) any { return m.GasRemaining() }).(storetypes.Gas)
+}
+
+func (l *blockGasLog) Limit() storetypes.Gas {
+	return l.log(func(m storetypes.GasMeter) any { return m.Limit() }).(storetypes.Gas)
+}
+
+func (l *blockGasLog) IsPastLimit() bool {
+	return l.log(func(m storetypes.GasMeter) any { return m.IsPastLimit() }).(bool)
+}
+
+func (l *blockGasLog) IsOutOfGas() bool {
+	return l.log(func(m storetypes.GasMeter) any { return m.IsOutOfGas() }).(bool)
+}
+
+func (l *blockGasLog) String() string {
+	return l.log(func(m storetypes.GasMeter) any { return m.String() }).(string)
+}
+
+func (l *blockGasLog) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if r := l.log(func(m storetypes.GasMeter) any {
+		return recoverPanic(func() { m.ConsumeGas(amount, descriptor) })
+	}); r != nil {
+		panic(r)
+	}
+}
+
+func (l *blockGasLog) RefundGas(amount storetypes.Gas, descriptor string) {
+	if r := l.log(func(m storetypes.GasMeter) any {
+		return recoverPanic(func() { m.RefundGas(amount, descriptor) })
+	}); r != nil {
+		panic(r)
+	}
+}
+
+// recoverPanic calls f, returning the value of its panic if any.
+func recoverPanic(f func()) (r any) {
+	defer func() { r = recover() }()
+	f()
+
+	return nil
+}
+
+// keyRange is a range of keys, end being exclusive. A nil end is unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+// trackingStore is a branch of a KVStore recording the keys read from the
+// parent store and the keys written to the branch.
+type trackingStore struct {
+	*cachekv.Store
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes []string
+}
+
+var _ storetypes.KVStore = (*trackingStore)(nil)
+
+func newTrackingStore(parent storetypes.KVStore) *trackingStore {
+	s := &trackingStore{reads: map[string]struct{}{}}
+	s.Store = cachekv.NewStore(readTrackingStore{KVStore: parent, store: s})
+	return s
+}
+
+func (s *trackingStore) Set(key, value []byte) {
+	s.Store.Set(key, value)
+	s.writes = append(s.writes, string(key))
+}
+
+func (s *trackingStore) Delete(key []byte) {
+	s.Store.Delete(key)
+	s.writes = append(s.writes, string(key))
+}
+
+// CacheWrap implements the CacheWrapper interface, branching the tracking
+// store itself rather than its underlying cache.
+func (s *trackingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *trackingStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// readTrackingStore records the keys read from the parent store of a
+// trackingStore. It must not be written to, the writes being kept in the
+// branch.
+type readTrackingStore struct {
+	storetypes.KVStore
+	store *trackingStore
+}
+
+func (s readTrackingStore) Get(key []byte) []byte {
+	s.store.reads[string(key)] = struct{}{}
+	return s.KVStore.Get(key)
+}
+
+func (s readTrackingStore) Has(key []byte) bool {
+	s.store.reads[string(key)] = struct{}{}
+	return s.KVStore.Has(key)
+}
+
+func (s readTrackingStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.store.ranges = append(s.store.ranges, keyRange{start: start, end: end})
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s readTrackingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.store.ranges = append(s.store.ranges, keyRange{start: start, end: end})
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s readTrackingStore) Set(_, _ []byte) {
+	panic("cannot write to the parent of a tracking store")
+}
+
+func (s readTrackingStore) Delete(_ []byte) {
+	panic("cannot write to the parent of a tracking store")
+}
+
+// writtenKeys holds the sorted keys written by the txs of a block, per store
+// name.
+type writtenKeys map[string][]string
+
+// add adds the keys written to the given stores.
+func (w writtenKeys) add(stores map[string]*trackingStore) {
+	for name, store := range stores {
+		keys := w[name]
+		for _, key := range store.writes {
+			i := sort.SearchStrings(keys, key)
+			if i < len(keys) && keys[i] == key {
+				continue
+			}
+
+			keys = append(keys, "")
+			copy(keys[i+1:], keys[i:])
+			keys[i] = key
+		}
+		w[name] = keys
+	}
+}
+
+// conflict returns whether any of the keys read from the given stores was
+// written.
+func (w writtenKeys) conflict(stores map[string]*trackingStore) bool {
+	for name, store := range stores {
+		keys := w[name]
+		if len(keys) == 0 {
+			continue
+		}
+
+		for key := range store.reads {
+			if i := sort.SearchStrings(keys, key); i < len(keys) && keys[i] == key {
+				return true
+			}
+		}
+
+		for _, r := range store.ranges {
+			if i := sort.SearchStrings(keys, string(r.start)); i < len(keys) && (r.end == nil || keys[i] < string(r.end)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,186 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestParallelExecution(t *testing.T) {
+	// the ante handler increments one of three counters, so that the txs
+	// incrementing the same counter conflict.
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			counter, failOnAnte := parseTxMemo(t, tx)
+			if failOnAnte {
+				return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			key := []byte{byte(counter % 3)}
+			value := getIntFromStore(t, store, key) + 1
+			setIntOnStore(store, key, value)
+			ctx.EventManager().EmitEvents(counterEvent("ante_handler", value))
+
+			return ctx, nil
+		})
+	}
+
+	blocks := newParallelTestBlocks(t)
+	run := func(opts ...func(*baseapp.BaseApp)) ([]*abci.FinalizeBlockResponse, [][]byte) {
+		return runParallelTestBlocks(t, blocks, 25_000, append(opts, anteOpt)...)
+	}
+
+	expResponses, expAppHashes := run()
+	responses, appHashes := run(baseapp.SetParallelExecution(4))
+	require.Equal(t, expAppHashes, appHashes)
+	require.Equal(t, expResponses, responses)
+
+	// the block gas limit is reached by the last txs of the blocks
+	require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), responses[0].TxResults[0].Code)
+	require.True(t, responses[0].TxResults[1].IsOK())
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), responses[0].TxResults[5].Code)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), responses[0].TxResults[10].Code)
+}
+
+func TestParallelExecutionSideEffects(t *testing.T) {
+	// the ante handler stores the block gas consumed before the tx, rejects the
+	// txs whose counter was already seen modulo 7 using an in-memory set, and
+	// increments one of three counters, so that the txs conflict through the
+	// block gas meter, the in-memory state and the store.
+	newAnteOpt := func() func(*baseapp.BaseApp) {
+		seen := make(map[int64]bool)
+		return func(bapp *baseapp.BaseApp) {
+			bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(30))
+				counter, failOnAnte := parseTxMemo(t, tx)
+				if failOnAnte {
+					return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+				}
+
+				store := ctx.MultiStore().GetKVStore(capKey1)
+				setIntOnStore(store, []byte{'g', byte(counter)}, int64(ctx.BlockGasMeter().GasConsumed()))
+
+				if counter%2 == 0 {
+					speculative := sdk.InvalidateSpeculation(ctx)
+					if seen[counter%7] {
+						return ctx, errorsmod.Wrap(sdkerrors.ErrTxInMempoolCache, "duplicate counter")
+					}
+					if !speculative {
+						seen[counter%7] = true
+					}
+				}
+
+				key := []byte{byte(counter % 3)}
+				setIntOnStore(store, key, getIntFromStore(t, store, key)+1)
+
+				return ctx, nil
+			})
+		}
+	}
+
+	blocks := newParallelTestBlocks(t)
+	run := func(opts ...func(*baseapp.BaseApp)) ([]*abci.FinalizeBlockResponse, [][]byte, []int64) {
+		mempool := &removalRecorder{t: t}
+		opts = append(opts, baseapp.SetMempool(mempool), baseapp.SetBlockGasReservation(true), newAnteOpt())
+		responses, appHashes := runParallelTestBlocks(t, blocks, 180, opts...)
+		return responses, appHashes, mempool.removed
+	}
+
+	expResponses, expAppHashes, expRemoved := run()
+	responses, appHashes, removed := run(baseapp.SetParallelExecution(4))
+	require.Equal(t, expAppHashes, appHashes)
+	require.Equal(t, expResponses, responses)
+	require.Equal(t, expRemoved, removed)
+
+	// the txs are removed once from the mempool, in the order of the blocks,
+	// except those failing in the ante handler or exceeding the block gas
+	require.Equal(t, []int64{0, 1, 2, 3, 5, 6, 7, 8, 10, 11, 12, 13, 15, 17, 18, 19, 21, 23, 25, 27, 29}, removed)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), responses[0].TxResults[10].Code)
+	require.Equal(t, sdkerrors.ErrTxInMempoolCache.ABCICode(), responses[1].TxResults[7].Code)
+}
+
+// removalRecorder is a mempool recording the counters of the txs removed from
+// it.
+type removalRecorder struct {
+	mempool.NoOpMempool
+
+	t       *testing.T
+	removed []int64
+}
+
+func (r *removalRecorder) Remove(tx sdk.Tx) error {
+	counter, _ := parseTxMemo(r.t, tx)
+	r.removed = append(r.removed, counter)
+	return nil
+}
+
+// newParallelTestBlocks returns three blocks of a malformed tx followed by ten
+// counter txs, the fifth one failing in the ante handler. The txs are built
+// once as their signers are random.
+func newParallelTestBlocks(t *testing.T) [][][]byte {
+	t.Helper()
+
+	txConfig := NewBaseAppSuite(t).txConfig
+	blocks := make([][][]byte, 3)
+	for height := range blocks {
+		blocks[height] = [][]byte{[]byte("malformed")}
+		for i := 0; i < 10; i++ {
+			counter := int64(height*10 + i)
+			tx := newTxCounter(t, txConfig, counter, counter)
+			if i == 4 {
+				tx = setFailOnAnte(t, txConfig, tx, true)
+			}
+
+			txBytes, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			blocks[height] = append(blocks[height], txBytes)
+		}
+	}
+
+	return blocks
+}
+
+// runParallelTestBlocks finalizes and commits the blocks with the given
+// maximum block gas, returning the responses and the app hashes.
+func runParallelTestBlocks(
+	t *testing.T, blocks [][][]byte, maxGas int64, opts ...func(*baseapp.BaseApp),
+) ([]*abci.FinalizeBlockResponse, [][]byte) {
+	t.Helper()
+
+	suite := NewBaseAppSuite(t, opts...)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{gas: 20})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}},
+	})
+	require.NoError(t, err)
+
+	var (
+		responses []*abci.FinalizeBlockResponse
+		appHashes [][]byte
+	)
+	for i, txs := range blocks {
+		res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: int64(i) + 1, Txs: txs})
+		require.NoError(t, err)
+		responses = append(responses, res)
+
+		commit, err := suite.baseApp.Commit()
+		require.NoError(t, err)
+		require.NotNil(t, commit)
+		appHashes = append(appHashes, suite.baseApp.LastCommitID().Hash)
+	}
+
+	return responses, appHashes
+}
@@ -0,0 +1,177 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrefetchHint declares a range of keys of a store that a Msg handler is going
+// to read.
+type PrefetchHint struct {
+	// StoreKey is the name of the store, e.g. the module name.
+	StoreKey string
+	// Prefix is the prefix of the keys to prefetch. An empty prefix covers the
+	// whole store.
+	Prefix []byte
+}
+
+// PrefetchHintsFn returns the prefetch hints of a Msg. It must be cheap and
+// must not access the state, as it is invoked before the Msg is executed.
+type PrefetchHintsFn = func(msg sdk.Msg) []PrefetchHint
+
+// RegisterPrefetchHints registers the function returning the prefetch hints of
+// the Msgs with the given type URL.
+func (msr *MsgServiceRouter) RegisterPrefetchHints(msgTypeURL string, fn PrefetchHintsFn) {
+	if msr.prefetchHints == nil {
+		msr.prefetchHints = make(map[string]PrefetchHintsFn)
+	}
+	msr.prefetchHints[msgTypeURL] = fn
+}
+
+// PrefetchHints returns the prefetch hints registered for the given Msg, if
+// any.
+func (msr *MsgServiceRouter) PrefetchHints(msg sdk.Msg) []PrefetchHint {
+	fn, ok := msr.prefetchHints[sdk.MsgTypeURL(msg)]
+	if !ok {
+		return nil
+	}
+
+	return fn(msg)
+}
+
+// prefetch warms the store caches with the keys the Msgs of the given txs are
+// going to read, according to their prefetch hints. The keys are read from the
+// last committed version of the state in background goroutines, reading at
+// most prefetchLimit keys per hint. The returned function stops the prefetching
+// and waits for the goroutines to return.
+func (app *BaseApp) prefetch(txs [][]byte) (stop func()) {
+	stop = func() {}
+	if app.prefetchLimit <= 0 || app.LastBlockHeight() == 0 {
+		return stop
+	}
+
+	hints := app.collectPrefetchHints(txs)
+	if len(hints) == 0 {
+		return stop
+	}
+
+	keysByName, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return stop
+	}
+
+	// the last committed version is immutable, so it can be read concurrently
+	// with the execution of the block.
+	ms, err := app.cms.CacheMultiStoreWithVersion(app.LastBlockHeight())
+	if err != nil {
+		app.logger.Debug("failed to branch state for prefetching", "err", err)
+		return stop
+	}
+
+	storeKeys := keysByName.StoreKeysByName()
+	ctx, cancel := context.WithCancel(context.Background())
+	work := make(chan PrefetchHint)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < min(len(hints), runtime.NumCPU()); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for hint := range work {
+				app.prefetchHint(ctx, ms, storeKeys[hint.StoreKey], hint.Prefix)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(work)
+		for _, hint := range hints {
+			select {
+			case work <- hint:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// collectPrefetchHints returns the deduplicated prefetch hints of the Msgs of
+// the given txs. Txs which fail to decode are skipped.
+func (app *BaseApp) collectPrefetchHints(txs [][]byte) (hints []PrefetchHint) {
+	defer func() {
+		// prefetching is best effort, it must never prevent a block from being
+		// executed.
+		if r := recover(); r != nil {
+			app.logger.Error("panic while collecting prefetch hints", "err", r)
+			hints = nil
+		}
+	}()
+
+	seen := make(map[string]struct{})
+	for _, rawTx := range txs {
+		tx, err := app.txDecoder(rawTx)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			for _, hint := range app.msgServiceRouter.PrefetchHints(msg) {
+				id := fmt.Sprintf("%s/%x", hint.StoreKey, hint.Prefix)
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+				hints = append(hints, hint)
+			}
+		}
+	}
+
+	return hints
+}
+
+// prefetchHint reads up to prefetchLimit keys with the given prefix from the
+// store, until the context is canceled.
+func (app *BaseApp) prefetchHint(ctx context.Context, ms storetypes.CacheMultiStore, key storetypes.StoreKey, prefix []byte) {
+	if key == nil {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Debug("failed to prefetch store", "store", key.Name(), "err", r)
+		}
+	}()
+
+	store, ok := ms.GetStore(key).(storetypes.KVStore)
+	if !ok {
+		return
+	}
+
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	for i := 0; it.Valid() && i < app.prefetchLimit; i++ {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		// loading the value warms the caches along the path of the key.
+		_ = it.Value()
+		it.Next()
+	}
+}
@@ -0,0 +1,104 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Contains(s string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Contains(b.buf.Bytes(), []byte(s))
+}
+
+type keyValueServerWithHook struct {
+	onSet func()
+}
+
+func (m keyValueServerWithHook) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	if m.onSet != nil {
+		m.onSet()
+	}
+	return MsgKeyValueImpl{}.Set(ctx, msg)
+}
+
+func TestPrefetchHints(t *testing.T) {
+	trace := &syncBuffer{}
+	suite := NewBaseAppSuite(t, baseapp.SetPrefetchLimit(10), func(bapp *baseapp.BaseApp) {
+		bapp.SetCommitMultiStoreTracer(trace)
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the prefetched key must have been read before the Msg is executed
+	prefetched := fmt.Sprintf(`"operation":"iterValue","key":"","value":"%s"`, base64.StdEncoding.EncodeToString([]byte("value")))
+	server := &keyValueServerWithHook{}
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), server)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(key string) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte("value"), Signer: addr.String()}))
+		setTxSignature(t, builder, 0)
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{newTx("hot/1")}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.False(t, trace.Contains(prefetched))
+
+	hintCalls := 0
+	suite.baseApp.MsgServiceRouter().RegisterPrefetchHints(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{}), func(msg sdk.Msg) []baseapp.PrefetchHint {
+		hintCalls++
+		return []baseapp.PrefetchHint{
+			{StoreKey: capKey2.Name(), Prefix: []byte("hot/")},
+			{StoreKey: capKey2.Name(), Prefix: []byte("hot/")},
+			{StoreKey: "unknown", Prefix: []byte("hot/")},
+		}
+	})
+	server.onSet = func() {
+		require.Eventually(t, func() bool { return trace.Contains(prefetched) }, 5*time.Second, 10*time.Millisecond)
+	}
+
+	res, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 2, Txs: [][]byte{newTx("cold/1"), []byte("invalid")}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	require.Equal(t, 1, hintCalls)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+}
@@ -14,7 +14,7 @@ import (
 // RecoveryHandler handles recovery() object.
 // Return a non-nil error if recoveryObj was processed.
 // Return nil if recoveryObj was not processed.
-type RecoveryHandler func(recoveryObj interface{}) error
+type RecoveryHandler = sdk.RecoveryHandler
 
 // recoveryMiddleware is wrapper for RecoveryHandler to create chained recovery handling.
 // returns (recoveryMiddleware, nil) if recoveryObj was not processed and should be passed to the next middleware in chain.
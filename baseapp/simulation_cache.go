@@ -0,0 +1,155 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	lru "github.com/hashicorp/golang-lru"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterSimulationCacheable marks the Msgs with the given type URL as
+// cacheable during simulation, see SetSimulationCacheSize. Only Msgs whose
+// execution is deterministic for a given state version, does not depend on the
+// state written by the AnteHandler or by the preceding Msgs of a tx, and does
+// not write state read by the following Msgs of a tx should be registered,
+// e.g. query-like Msgs.
+func (msr *MsgServiceRouter) RegisterSimulationCacheable(msgTypeURL string) {
+	if msr.simulationCacheable == nil {
+		msr.simulationCacheable = make(map[string]bool)
+	}
+	msr.simulationCacheable[msgTypeURL] = true
+}
+
+// isSimulationCacheable returns true if the given Msg was registered as
+// cacheable during simulation.
+func (msr *MsgServiceRouter) isSimulationCacheable(msg sdk.Msg) bool {
+	return msr.simulationCacheable[sdk.MsgTypeURL(msg)]
+}
+
+// simulationCacheEntry is the memoized execution of a Msg.
+type simulationCacheEntry struct {
+	gasUsed uint64
+	result  *sdk.Result
+}
+
+// simulationCache memoizes the execution results of the cacheable Msgs during
+// simulation, keyed by the Msg bytes. The entries are only valid for the state
+// version at which they were recorded, and are dropped once a new block is
+// committed.
+type simulationCache struct {
+	mtx     sync.Mutex
+	height  int64
+	entries *lru.Cache
+}
+
+func newSimulationCache(size int) (*simulationCache, error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulationCache{entries: entries}, nil
+}
+
+// get returns the entry recorded for the given key at the given height, if
+// any. Entries recorded at another height are dropped.
+func (c *simulationCache) get(height int64, key string) (simulationCacheEntry, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height != c.height {
+		c.entries.Purge()
+		c.height = height
+		return simulationCacheEntry{}, false
+	}
+
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		return simulationCacheEntry{}, false
+	}
+
+	return entry.(simulationCacheEntry), true
+}
+
+// add records the entry of the given key at the given height.
+func (c *simulationCache) add(height int64, key string, entry simulationCacheEntry) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height != c.height {
+		c.entries.Purge()
+		c.height = height
+	}
+
+	c.entries.Add(key, entry)
+}
+
+// simulationCacheKey returns the key of a Msg in the simulation cache.
+func simulationCacheKey(msg sdk.Msg) (string, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return sdk.MsgTypeURL(msg) + "/" + hex.EncodeToString(hash[:]), nil
+}
+
+// execMsg executes a Msg with the given handler. When simulating, the results
+// of the Msgs registered as cacheable are served from the simulation cache if
+// present, consuming the gas consumed by their original execution without
+// executing them again. Failed executions are not cached.
+func (app *BaseApp) execMsg(ctx sdk.Context, handler MsgServiceHandler, msg sdk.Msg, mode execMode) (*sdk.Result, error) {
+	if mode != execModeSimulate || app.simulationCache == nil || !app.msgServiceRouter.isSimulationCacheable(msg) {
+		return handler(ctx, msg)
+	}
+
+	key, err := simulationCacheKey(msg)
+	if err != nil {
+		return handler(ctx, msg)
+	}
+
+	height := app.LastBlockHeight()
+	if entry, ok := app.simulationCache.get(height, key); ok {
+		ctx.GasMeter().ConsumeGas(entry.gasUsed, "cached simulation")
+		return cloneResult(entry.result), nil
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	result, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	app.simulationCache.add(height, key, simulationCacheEntry{
+		gasUsed: ctx.GasMeter().GasConsumed() - gasBefore,
+		result:  cloneResult(result),
+	})
+
+	return result, nil
+}
+
+// cloneResult returns a copy of a Msg result which can be modified without
+// altering the original one.
+func cloneResult(result *sdk.Result) *sdk.Result {
+	events := make([]abci.Event, len(result.Events))
+	for i, event := range result.Events {
+		events[i] = abci.Event{
+			Type:       event.Type,
+			Attributes: append([]abci.EventAttribute(nil), event.Attributes...),
+		}
+	}
+
+	return &sdk.Result{
+		Data:         append([]byte(nil), result.Data...),
+		Log:          result.Log,
+		Events:       events,
+		MsgResponses: append([]*codectypes.Any(nil), result.MsgResponses...),
+	}
+}
@@ -0,0 +1,88 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type countingCounterServer struct {
+	CounterServerImplGasMeterOnly
+	calls *int
+}
+
+func (m countingCounterServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	*m.calls++
+	return m.CounterServerImplGasMeterOnly.IncrementCounter(ctx, msg)
+}
+
+func TestSimulationCache(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetSimulationCacheSize(10))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	calls := 0
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), countingCounterServer{calls: &calls})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(memo string, counters ...int64) []byte {
+		msgs := make([]sdk.Msg, 0, len(counters))
+		for _, c := range counters {
+			msgs = append(msgs, &baseapptestutil.MsgCounter{Counter: c, Signer: addr.String()})
+		}
+
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetMemo(memo)
+		setTxSignature(t, builder, 0)
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// Msgs are not cached unless registered as cacheable
+	gInfo, _, err := suite.baseApp.Simulate(newTx("a", 7))
+	require.NoError(t, err)
+	_, _, err = suite.baseApp.Simulate(newTx("a", 7))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	suite.baseApp.MsgServiceRouter().RegisterSimulationCacheable(sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}))
+
+	// a tx with different bytes but the same Msg is served from the cache
+	calls = 0
+	for _, memo := range []string{"a", "b", "c"} {
+		cachedInfo, result, err := suite.baseApp.Simulate(newTx(memo, 7))
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, gInfo.GasUsed, cachedInfo.GasUsed)
+	}
+	require.Equal(t, 1, calls)
+
+	// each distinct Msg is executed once
+	_, _, err = suite.baseApp.Simulate(newTx("a", 7, 8))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// the cache is dropped once a new block is committed
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	_, _, err = suite.baseApp.Simulate(newTx("a", 7))
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
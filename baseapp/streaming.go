@@ -1,15 +1,20 @@
 package baseapp
 
 import (
+	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
-	"cosmossdk.io/store/streaming"
+	storestreaming "cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,10 +25,27 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+	StreamingABCISinksTomlKey         = "sinks"
+	StreamingABCIFileDirTomlKey       = "file-dir"
+	StreamingABCIGRPCAddressTomlKey   = "grpc-address"
 )
 
-// RegisterStreamingServices registers streaming services with the BaseApp.
+// The built-in streaming sinks, see RegisterStreamingServices.
+const (
+	// StreamingFileSink writes the data of each block to a file of the
+	// streaming.abci.file-dir directory, see streaming.FileListener.
+	StreamingFileSink = "file"
+	// StreamingGRPCSink streams the data of each block to the ABCIListenerService
+	// served at streaming.abci.grpc-address, see streaming.GRPCListener.
+	StreamingGRPCSink = "grpc"
+)
+
+// RegisterStreamingServices registers streaming services with the BaseApp: the
+// ABCIListener plugins, and the sinks listed in the streaming.abci.sinks
+// setting, which are either built in or registered with SetStreamingSink.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	var listeners []storetypes.ABCIListener
+
 	// register streaming services
 	streamingCfg := cast.ToStringMap(appOpts.Get(StreamingTomlKey))
 	for service := range streamingCfg {
@@ -31,52 +53,129 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
 		if len(pluginName) > 0 {
 			logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
-			plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
+			plugin, err := storestreaming.NewStreamingPlugin(pluginName, logLevel)
 			if err != nil {
 				return fmt.Errorf("failed to load streaming plugin: %w", err)
 			}
-			if err := app.registerStreamingPlugin(appOpts, keys, plugin); err != nil {
-				return fmt.Errorf("failed to register streaming plugin %w", err)
+			listener, ok := plugin.(storetypes.ABCIListener)
+			if !ok {
+				return fmt.Errorf("failed to register streaming plugin: unexpected plugin type %T", plugin)
 			}
+			listeners = append(listeners, listener)
 		}
 	}
 
+	sinks, err := app.newStreamingSinks(appOpts)
+	if err != nil {
+		return err
+	}
+	listeners = append(listeners, sinks...)
+
+	if len(listeners) > 0 {
+		return app.registerABCIListeners(appOpts, keys, listeners)
+	}
+
 	return nil
 }
 
-// registerStreamingPlugin registers streaming plugins with the BaseApp.
-func (app *BaseApp) registerStreamingPlugin(
-	appOpts servertypes.AppOptions,
-	keys map[string]*storetypes.KVStoreKey,
-	streamingPlugin interface{},
-) error {
-	v, ok := streamingPlugin.(storetypes.ABCIListener)
-	if !ok {
-		return fmt.Errorf("unexpected plugin type %T", v)
+// newStreamingSinks returns the sinks listed in the app config.
+func (app *BaseApp) newStreamingSinks(appOpts servertypes.AppOptions) ([]storetypes.ABCIListener, error) {
+	abciKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, key)
 	}
 
-	app.registerABCIListenerPlugin(appOpts, keys, v)
-	return nil
+	var sinks []storetypes.ABCIListener
+	for _, name := range cast.ToStringSlice(appOpts.Get(abciKey(StreamingABCISinksTomlKey))) {
+		switch name = strings.TrimSpace(name); name {
+		case StreamingFileSink:
+			dir := strings.TrimSpace(cast.ToString(appOpts.Get(abciKey(StreamingABCIFileDirTomlKey))))
+			if dir == "" {
+				return nil, fmt.Errorf("%s must be set to use the %s streaming sink", abciKey(StreamingABCIFileDirTomlKey), name)
+			}
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+			}
+
+			sink, err := streaming.NewFileListener(dir)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create the %s streaming sink: %w", name, err)
+			}
+			sinks = append(sinks, sink)
+
+		case StreamingGRPCSink:
+			address := strings.TrimSpace(cast.ToString(appOpts.Get(abciKey(StreamingABCIGRPCAddressTomlKey))))
+			if address == "" {
+				return nil, fmt.Errorf("%s must be set to use the %s streaming sink", abciKey(StreamingABCIGRPCAddressTomlKey), name)
+			}
+
+			conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create the %s streaming sink: %w", name, err)
+			}
+			sinks = append(sinks, streaming.NewGRPCListener(conn))
+
+		default:
+			sink, ok := app.streamingSinks[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown streaming sink %q", name)
+			}
+			sinks = append(sinks, sink)
+		}
+	}
+
+	return sinks, nil
 }
 
-// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface.
-func (app *BaseApp) registerABCIListenerPlugin(
+// registerABCIListeners registers the listeners with the BaseApp, exposing
+// the changes of the stores listed in the app config.
+func (app *BaseApp) registerABCIListeners(
 	appOpts servertypes.AppOptions,
 	keys map[string]*storetypes.KVStoreKey,
-	abciListener storetypes.ABCIListener,
-) {
+	listeners []storetypes.ABCIListener,
+) error {
 	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
 	stopNodeOnErr := cast.ToBool(appOpts.Get(stopNodeOnErrKey))
 	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIKeysTomlKey)
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
-	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
-	app.cms.AddListeners(exposedKeys)
+	exposedPrefixes, err := exposeStorePrefixesSorted(exposeKeysStr, keys)
+	if err != nil {
+		return err
+	}
+
+	// the listeners are given the exposed changes only, even when other
+	// changes are listened to for the subscribers of SubscribeStoreChanges.
+	for i, listener := range listeners {
+		listeners[i] = streaming.NewPrefixListener(listener, exposedPrefixes...)
+	}
+	app.addPrefixListeners(exposedPrefixes)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, listeners...),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
+
+	return nil
+}
+
+// SubscribeStoreChanges registers an ABCIListener given the state changes
+// selected by the store prefixes only, e.g. the balances of an address in the
+// bank store. The stores are listened to with the prefixes, so that the writes
+// of the other keys are filtered out by the store listeners before being
+// streamed.
+func (app *BaseApp) SubscribeStoreChanges(listener storetypes.ABCIListener, prefixes ...storetypes.StorePrefix) {
+	app.addPrefixListeners(prefixes)
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, streaming.NewPrefixListener(listener, prefixes...))
+}
+
+func (app *BaseApp) addPrefixListeners(prefixes []storetypes.StorePrefix) {
+	for _, prefix := range prefixes {
+		if len(prefix.Prefix) == 0 {
+			app.cms.AddListeners([]storetypes.StoreKey{prefix.Key})
+			continue
+		}
+		app.cms.AddPrefixListeners(prefix.Key, [][]byte{prefix.Prefix})
+	}
 }
 
 func exposeAll(list []string) bool {
@@ -88,25 +187,34 @@ func exposeAll(list []string) bool {
 	return false
 }
 
-func exposeStoreKeysSorted(keysStr []string, keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
-	var exposeStoreKeys []storetypes.StoreKey
+// exposeStorePrefixesSorted returns the store prefixes of the keys setting, in
+// the form {storeKey} for all the changes of a store or {storeKey}:{hexPrefix}
+// for the changes of the keys with the prefix, ignoring unknown stores.
+func exposeStorePrefixesSorted(keysStr []string, keys map[string]*storetypes.KVStoreKey) ([]storetypes.StorePrefix, error) {
+	var exposedPrefixes []storetypes.StorePrefix
 	if exposeAll(keysStr) {
-		exposeStoreKeys = make([]storetypes.StoreKey, 0, len(keys))
+		exposedPrefixes = make([]storetypes.StorePrefix, 0, len(keys))
 		for key := range keys {
-			exposeStoreKeys = append(exposeStoreKeys, keys[key])
+			exposedPrefixes = append(exposedPrefixes, storetypes.StorePrefix{Key: keys[key]})
 		}
 	} else {
-		exposeStoreKeys = make([]storetypes.StoreKey, 0, len(keysStr))
+		exposedPrefixes = make([]storetypes.StorePrefix, 0, len(keysStr))
 		for _, keyStr := range keysStr {
-			if storeKey, ok := keys[keyStr]; ok {
-				exposeStoreKeys = append(exposeStoreKeys, storeKey)
+			name, prefixStr, _ := strings.Cut(keyStr, ":")
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key prefix of streamed store %s: %w", name, err)
+			}
+
+			if storeKey, ok := keys[name]; ok {
+				exposedPrefixes = append(exposedPrefixes, storetypes.StorePrefix{Key: storeKey, Prefix: prefix})
 			}
 		}
 	}
 	// sort storeKeys for deterministic output
-	sort.SliceStable(exposeStoreKeys, func(i, j int) bool {
-		return exposeStoreKeys[i].Name() < exposeStoreKeys[j].Name()
+	sort.SliceStable(exposedPrefixes, func(i, j int) bool {
+		return exposedPrefixes[i].Key.Name() < exposedPrefixes[j].Key.Name()
 	})
 
-	return exposeStoreKeys
+	return exposedPrefixes, nil
 }
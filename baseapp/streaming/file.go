@@ -0,0 +1,136 @@
+package streaming
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.ABCIListener = (*FileListener)(nil)
+
+// FileListener is an ABCIListener writing the data of each block to a file of
+// a directory, named after the height of the block (see BlockFileName). The
+// file holds the ListenFinalizeBlockRequest then the ListenCommitRequest of the
+// block, each prefixed with its length as an uvarint.
+//
+// The file of a block is written once the block is committed, and appears
+// atomically in the directory.
+type FileListener struct {
+	dir   string
+	block blockData
+}
+
+// NewFileListener creates a FileListener writing to the given directory,
+// creating it if needed.
+func NewFileListener(dir string) (*FileListener, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FileListener{dir: dir}, nil
+}
+
+// BlockFileName returns the name of the file holding the data of the block at
+// the given height.
+func BlockFileName(height int64) string {
+	return fmt.Sprintf("block-%d", height)
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *FileListener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	l.block.record(req, res)
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *FileListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	finalizeBlock, commit := l.block.commit(ctx, res, changeSet)
+	err := l.writeBlock(commit.BlockHeight, finalizeBlock, commit)
+	return stopOnErr(ctx, err, "failed to write the block to the streaming file")
+}
+
+func (l *FileListener) writeBlock(height int64, msgs ...proto.Message) error {
+	f, err := os.CreateTemp(l.dir, BlockFileName(height)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	for _, msg := range msgs {
+		if err := writeDelimited(w, msg); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(l.dir, BlockFileName(height)))
+}
+
+// ReadBlockFile reads the data of a block written by a FileListener.
+func ReadBlockFile(path string) (*streamingabci.ListenFinalizeBlockRequest, *streamingabci.ListenCommitRequest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	finalizeBlock := &streamingabci.ListenFinalizeBlockRequest{}
+	if err := readDelimited(r, finalizeBlock); err != nil {
+		return nil, nil, fmt.Errorf("failed to read the FinalizeBlock data: %w", err)
+	}
+
+	commit := &streamingabci.ListenCommitRequest{}
+	if err := readDelimited(r, commit); err != nil {
+		return nil, nil, fmt.Errorf("failed to read the commit data: %w", err)
+	}
+
+	return finalizeBlock, commit, nil
+}
+
+func writeDelimited(w io.Writer, msg proto.Message) error {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(bz)))); err != nil {
+		return err
+	}
+
+	_, err = w.Write(bz)
+	return err
+}
+
+func readDelimited(r *bufio.Reader, msg proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(bz, msg)
+}
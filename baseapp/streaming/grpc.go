@@ -0,0 +1,46 @@
+package streaming
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/grpc"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.ABCIListener = (*GRPCListener)(nil)
+
+// GRPCListener is an ABCIListener streaming the data of each block to a remote
+// ABCIListenerService, e.g. an indexer, as it is executed. Unlike the
+// ABCIListener plugins, the service runs outside of the node and is not
+// managed by it.
+type GRPCListener struct {
+	client streamingabci.ABCIListenerServiceClient
+	height int64
+}
+
+// NewGRPCListener creates a GRPCListener calling the ABCIListenerService
+// served over the given connection.
+func NewGRPCListener(conn grpc.ClientConnInterface) *GRPCListener {
+	return &GRPCListener{client: streamingabci.NewABCIListenerServiceClient(conn)}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *GRPCListener) ListenFinalizeBlock(ctx context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	l.height = req.Height
+	_, err := l.client.ListenFinalizeBlock(ctx, &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res})
+	return stopOnErr(ctx, err, "FinalizeBlock streaming over gRPC failed")
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *GRPCListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	req := &streamingabci.ListenCommitRequest{
+		BlockHeight: blockHeight(ctx, l.height),
+		Res:         &res,
+		ChangeSet:   changeSet,
+	}
+	_, err := l.client.ListenCommit(ctx, req)
+	return stopOnErr(ctx, err, "Commit streaming over gRPC failed")
+}
@@ -0,0 +1,70 @@
+// Package streaming provides built-in ABCIListener sinks streaming the data of
+// each block, i.e. the FinalizeBlock request and response and the state changes
+// of the block, to external systems such as indexers.
+//
+// The data of a block is encoded with the ListenFinalizeBlockRequest and
+// ListenCommitRequest messages of the ABCIListenerService, so that consumers
+// decode it the same whatever the sink.
+package streaming
+
+import (
+	"context"
+	"os"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// blockData holds the data of the block being committed.
+type blockData struct {
+	finalizeBlock *streamingabci.ListenFinalizeBlockRequest
+}
+
+// record records the FinalizeBlock request and response of the block.
+func (d *blockData) record(req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) {
+	d.finalizeBlock = &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res}
+}
+
+// commit returns the FinalizeBlock data and the commit data of the block, and
+// resets the recorded data.
+func (d *blockData) commit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) (*streamingabci.ListenFinalizeBlockRequest, *streamingabci.ListenCommitRequest) {
+	finalizeBlock := d.finalizeBlock
+	d.finalizeBlock = nil
+	if finalizeBlock == nil {
+		finalizeBlock = &streamingabci.ListenFinalizeBlockRequest{}
+	}
+
+	return finalizeBlock, &streamingabci.ListenCommitRequest{
+		BlockHeight: blockHeight(ctx, finalizeBlock.GetReq().GetHeight()),
+		Res:         &res,
+		ChangeSet:   changeSet,
+	}
+}
+
+// blockHeight returns the height of the block being committed, falling back to
+// the height of the last FinalizeBlock request outside of an SDK context.
+func blockHeight(ctx context.Context, finalizeBlockHeight int64) int64 {
+	if sdkCtx, ok := ctx.(storetypes.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+
+	return finalizeBlockHeight
+}
+
+// stopOnErr stops the node if it is configured to stop on streaming errors,
+// like the ABCIListener plugins do, and returns err otherwise.
+func stopOnErr(ctx context.Context, err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	sdkCtx, ok := ctx.(storetypes.Context)
+	if ok && sdkCtx.StreamingManager().StopNodeOnErr {
+		sdkCtx.Logger().Error(msg, "height", sdkCtx.BlockHeight(), "err", err)
+		os.Exit(1)
+	}
+
+	return err
+}
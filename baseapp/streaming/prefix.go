@@ -0,0 +1,53 @@
+package streaming
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.ABCIListener = (*PrefixListener)(nil)
+
+// PrefixListener is an ABCIListener forwarding to another listener the state
+// changes selected by a set of store prefixes only, e.g. the balances of an
+// address in the bank store. The stores must be listened to with the same
+// prefixes, see BaseApp.SubscribeStoreChanges, so that the other changes are
+// filtered out by the store listeners.
+type PrefixListener struct {
+	listener storetypes.ABCIListener
+	prefixes []storetypes.StorePrefix
+}
+
+// NewPrefixListener creates a PrefixListener forwarding to the given listener
+// the state changes selected by one of the given store prefixes.
+func NewPrefixListener(listener storetypes.ABCIListener, prefixes ...storetypes.StorePrefix) *PrefixListener {
+	return &PrefixListener{listener: listener, prefixes: prefixes}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *PrefixListener) ListenFinalizeBlock(ctx context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	return l.listener.ListenFinalizeBlock(ctx, req, res)
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *PrefixListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	var selected []*storetypes.StoreKVPair
+	for _, pair := range changeSet {
+		if l.selects(pair) {
+			selected = append(selected, pair)
+		}
+	}
+
+	return l.listener.ListenCommit(ctx, res, selected)
+}
+
+func (l *PrefixListener) selects(pair *storetypes.StoreKVPair) bool {
+	for _, prefix := range l.prefixes {
+		if prefix.Matches(pair) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package streaming
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// The kinds of messages published by a PublisherListener.
+const (
+	// MessageFinalizeBlock is the kind of the ListenFinalizeBlockRequest
+	// messages.
+	MessageFinalizeBlock = "finalize_block"
+	// MessageCommit is the kind of the ListenCommitRequest messages.
+	MessageCommit = "commit"
+)
+
+// Publisher publishes messages to a message broker, e.g. a Kafka producer
+// mapping each kind of message to a topic and using the height as message key.
+type Publisher interface {
+	// Publish publishes the encoded message of the given kind for the block at
+	// the given height.
+	Publish(ctx context.Context, kind string, height int64, msg []byte) error
+}
+
+var _ storetypes.ABCIListener = (*PublisherListener)(nil)
+
+// PublisherListener is an ABCIListener publishing the data of each block once
+// it is committed, so that brokers never receive the data of a block which was
+// not committed.
+type PublisherListener struct {
+	publisher Publisher
+	block     blockData
+}
+
+// NewPublisherListener creates a PublisherListener publishing to the given
+// publisher.
+func NewPublisherListener(publisher Publisher) *PublisherListener {
+	return &PublisherListener{publisher: publisher}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *PublisherListener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	l.block.record(req, res)
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *PublisherListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	finalizeBlock, commit := l.block.commit(ctx, res, changeSet)
+	err := l.publish(ctx, MessageFinalizeBlock, commit.BlockHeight, finalizeBlock)
+	if err == nil {
+		err = l.publish(ctx, MessageCommit, commit.BlockHeight, commit)
+	}
+
+	return stopOnErr(ctx, err, "failed to publish the block")
+}
+
+func (l *PublisherListener) publish(ctx context.Context, kind string, height int64, msg proto.Message) error {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return l.publisher.Publish(ctx, kind, height, bz)
+}
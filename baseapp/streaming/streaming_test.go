@@ -0,0 +1,126 @@
+package streaming_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
+)
+
+// listen streams a block to the listener.
+func listen(t *testing.T, listener storetypes.ABCIListener, height int64) (*streamingabci.ListenFinalizeBlockRequest, *streamingabci.ListenCommitRequest) {
+	t.Helper()
+
+	req := abci.FinalizeBlockRequest{Height: height, Txs: [][]byte{[]byte("tx")}}
+	res := abci.FinalizeBlockResponse{AppHash: []byte("app-hash"), TxResults: []*abci.ExecTxResult{{Code: 1}}}
+	commitRes := abci.CommitResponse{RetainHeight: height - 1}
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")},
+		{StoreKey: "gov", Key: []byte("deleted"), Delete: true},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, listener.ListenFinalizeBlock(ctx, req, res))
+	require.NoError(t, listener.ListenCommit(ctx, commitRes, changeSet))
+
+	return &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res},
+		&streamingabci.ListenCommitRequest{BlockHeight: height, Res: &commitRes, ChangeSet: changeSet}
+}
+
+func TestFileListener(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "streaming")
+	listener, err := streaming.NewFileListener(dir)
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		expFinalizeBlock, expCommit := listen(t, listener, height)
+
+		finalizeBlock, commit, err := streaming.ReadBlockFile(filepath.Join(dir, streaming.BlockFileName(height)))
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expFinalizeBlock, finalizeBlock))
+		require.True(t, proto.Equal(expCommit, commit))
+	}
+
+	// only the block files are left in the directory.
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "block-1"), filepath.Join(dir, "block-2")}, files)
+}
+
+type recordingPublisher struct {
+	kinds   []string
+	heights []int64
+	msgs    [][]byte
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, kind string, height int64, msg []byte) error {
+	p.kinds = append(p.kinds, kind)
+	p.heights = append(p.heights, height)
+	p.msgs = append(p.msgs, msg)
+	return nil
+}
+
+func TestPublisherListener(t *testing.T) {
+	publisher := &recordingPublisher{}
+	expFinalizeBlock, expCommit := listen(t, streaming.NewPublisherListener(publisher), 3)
+
+	require.Equal(t, []string{streaming.MessageFinalizeBlock, streaming.MessageCommit}, publisher.kinds)
+	require.Equal(t, []int64{3, 3}, publisher.heights)
+
+	finalizeBlock := &streamingabci.ListenFinalizeBlockRequest{}
+	require.NoError(t, proto.Unmarshal(publisher.msgs[0], finalizeBlock))
+	require.True(t, proto.Equal(expFinalizeBlock, finalizeBlock))
+
+	commit := &streamingabci.ListenCommitRequest{}
+	require.NoError(t, proto.Unmarshal(publisher.msgs[1], commit))
+	require.True(t, proto.Equal(expCommit, commit))
+}
+
+type recordingServer struct {
+	finalizeBlocks []*streamingabci.ListenFinalizeBlockRequest
+	commits        []*streamingabci.ListenCommitRequest
+}
+
+func (s *recordingServer) ListenFinalizeBlock(_ context.Context, req *streamingabci.ListenFinalizeBlockRequest) (*streamingabci.ListenFinalizeBlockResponse, error) {
+	s.finalizeBlocks = append(s.finalizeBlocks, req)
+	return &streamingabci.ListenFinalizeBlockResponse{}, nil
+}
+
+func (s *recordingServer) ListenCommit(_ context.Context, req *streamingabci.ListenCommitRequest) (*streamingabci.ListenCommitResponse, error) {
+	s.commits = append(s.commits, req)
+	return &streamingabci.ListenCommitResponse{}, nil
+}
+
+func TestGRPCListener(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	recorder := &recordingServer{}
+	streamingabci.RegisterABCIListenerServiceServer(server, recorder)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	expFinalizeBlock, expCommit := listen(t, streaming.NewGRPCListener(conn), 4)
+	require.Len(t, recorder.finalizeBlocks, 1)
+	require.True(t, proto.Equal(expFinalizeBlock, recorder.finalizeBlocks[0]))
+	require.Len(t, recorder.commits, 1)
+	require.True(t, proto.Equal(expCommit, recorder.commits[0]))
+}
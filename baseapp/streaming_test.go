@@ -2,7 +2,9 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,7 +14,9 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -146,3 +150,114 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+type streamingAppOptions map[string]interface{}
+
+func (o streamingAppOptions) Get(key string) interface{} { return o[key] }
+
+func TestRegisterStreamingServices_Sinks(t *testing.T) {
+	home := t.TempDir()
+	mockListener := NewMockABCIListener("custom")
+	sinkOpt := baseapp.SetStreamingSink("custom", &mockListener)
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	suite := NewBaseAppSuite(t, sinkOpt, distOpt)
+
+	appOpts := streamingAppOptions{
+		flags.FlagHome:                    home,
+		"streaming.abci.sinks":            []string{baseapp.StreamingFileSink, "custom"},
+		"streaming.abci.file-dir":         "streaming",
+		"streaming.abci.keys":             []string{"*"},
+		"streaming.abci.stop-node-on-err": false,
+	}
+	keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, keys))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	// the first block reuses the FinalizeBlock state of InitChain.
+	getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte("key"), []byte("value"))
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	expectedChangeSet := []*storetypes.StoreKVPair{{StoreKey: distKey1.Name(), Key: []byte("key"), Value: []byte("value")}}
+	require.Equal(t, expectedChangeSet, mockListener.ChangeSet)
+
+	finalizeBlock, commit, err := streaming.ReadBlockFile(filepath.Join(home, "streaming", streaming.BlockFileName(1)))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), finalizeBlock.Req.Height)
+	require.Equal(t, int64(1), commit.BlockHeight)
+	require.Equal(t, expectedChangeSet, commit.ChangeSet)
+}
+
+func TestSubscribeStoreChanges(t *testing.T) {
+	sinkListener := NewMockABCIListener("sink")
+	subscriber := NewMockABCIListener("subscriber")
+	sinkOpt := baseapp.SetStreamingSink("custom", &sinkListener)
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	subscribeOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SubscribeStoreChanges(&subscriber, storetypes.StorePrefix{Key: distKey1, Prefix: []byte("a/")})
+	}
+	suite := NewBaseAppSuite(t, sinkOpt, distOpt, subscribeOpt)
+
+	// the sink is given the changes of the keys prefixed by "b/" only.
+	appOpts := streamingAppOptions{
+		"streaming.abci.sinks": []string{"custom"},
+		"streaming.abci.keys":  []string{distKey1.Name() + ":" + hex.EncodeToString([]byte("b/"))},
+	}
+	keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, keys))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	store := getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1)
+	store.Set([]byte("a/1"), []byte("1"))
+	store.Set([]byte("b/1"), []byte("2"))
+	store.Set([]byte("c/1"), []byte("3"))
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	require.Equal(t, []*storetypes.StoreKVPair{{StoreKey: distKey1.Name(), Key: []byte("a/1"), Value: []byte("1")}}, subscriber.ChangeSet)
+	require.Equal(t, []*storetypes.StoreKVPair{{StoreKey: distKey1.Name(), Key: []byte("b/1"), Value: []byte("2")}}, sinkListener.ChangeSet)
+}
+
+func TestRegisterStreamingServices_InvalidSinks(t *testing.T) {
+	testCases := map[string]struct {
+		appOpts streamingAppOptions
+		expErr  string
+	}{
+		"unknown sink": {
+			appOpts: streamingAppOptions{"streaming.abci.sinks": []string{"kafka"}},
+			expErr:  `unknown streaming sink "kafka"`,
+		},
+		"file sink without directory": {
+			appOpts: streamingAppOptions{"streaming.abci.sinks": []string{baseapp.StreamingFileSink}},
+			expErr:  "streaming.abci.file-dir must be set",
+		},
+		"grpc sink without address": {
+			appOpts: streamingAppOptions{"streaming.abci.sinks": []string{baseapp.StreamingGRPCSink}},
+			expErr:  "streaming.abci.grpc-address must be set",
+		},
+		"invalid key prefix": {
+			appOpts: streamingAppOptions{
+				"streaming.abci.sinks":        []string{baseapp.StreamingGRPCSink},
+				"streaming.abci.grpc-address": "localhost:9191",
+				"streaming.abci.keys":         []string{"bank:zz"},
+			},
+			expErr: "invalid key prefix of streamed store bank",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t)
+			err := suite.baseApp.RegisterStreamingServices(tc.appOpts, map[string]*storetypes.KVStoreKey{})
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package baseapp
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// tracer traces the execution of blocks, txs and msgs. Its spans are only
+// recorded when tracing is enabled in the app config.
+var tracer = telemetry.Tracer("github.com/cosmos/cosmos-sdk/baseapp")
+
+// startSpan starts a span as a child of the span of the context, and returns
+// the context holding the new span.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	spanCtx, span := tracer.Start(ctx.Context(), name, trace.WithAttributes(attrs...))
+	return ctx.WithContext(spanCtx), span
+}
+
+// withSpan returns the context with span as the current span.
+func withSpan(ctx sdk.Context, span trace.Span) sdk.Context {
+	return ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+}
@@ -0,0 +1,66 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+type recordingExporter struct {
+	spans []telemetry.SpanData
+}
+
+func (e *recordingExporter) ExportSpans(_ context.Context, spans []telemetry.SpanData) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordingExporter) Shutdown(context.Context) error { return nil }
+
+func TestTracing(t *testing.T) {
+	exporter := &recordingExporter{}
+	provider, err := telemetry.NewTracerProvider(exporter, 1)
+	require.NoError(t, err)
+	otel.SetTracerProvider(provider)
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err = suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Shutdown(context.Background()))
+	spans := map[string]telemetry.SpanData{}
+	for _, span := range exporter.spans {
+		spans[span.Name] = span
+	}
+
+	block, txSpan, ante, msgs, msg := spans["FinalizeBlock"], spans["tx"], spans["ante"], spans["msgs"], spans["/Counter/IncrementCounter"]
+	for _, span := range []telemetry.SpanData{block, txSpan, ante, msgs, msg} {
+		require.True(t, span.SpanContext.IsValid())
+		require.Equal(t, block.SpanContext.TraceID(), span.SpanContext.TraceID())
+	}
+
+	require.False(t, block.Parent.IsValid())
+	require.Equal(t, block.SpanContext.SpanID(), txSpan.Parent.SpanID())
+	require.Equal(t, txSpan.SpanContext.SpanID(), ante.Parent.SpanID())
+	require.Equal(t, txSpan.SpanContext.SpanID(), msgs.Parent.SpanID())
+	require.Equal(t, msgs.SpanContext.SpanID(), msg.Parent.SpanID())
+}
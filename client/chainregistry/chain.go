@@ -0,0 +1,229 @@
+package chainregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var bech32PrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// Chain is the metadata of a chain, as described by the chain.json file of the
+// chain registry. Only the fields used by the clients are decoded.
+type Chain struct {
+	ChainName    string  `json:"chain_name"`
+	ChainID      string  `json:"chain_id"`
+	Bech32Prefix string  `json:"bech32_prefix"`
+	Slip44       uint32  `json:"slip44,omitempty"`
+	Fees         Fees    `json:"fees"`
+	Staking      Staking `json:"staking"`
+	APIs         APIs    `json:"apis"`
+}
+
+// Fees are the tokens accepted to pay the fees of the chain.
+type Fees struct {
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// FeeToken is a token accepted to pay fees, with its gas prices.
+type FeeToken struct {
+	Denom            string      `json:"denom"`
+	FixedMinGasPrice json.Number `json:"fixed_min_gas_price,omitempty"`
+	LowGasPrice      json.Number `json:"low_gas_price,omitempty"`
+	AverageGasPrice  json.Number `json:"average_gas_price,omitempty"`
+	HighGasPrice     json.Number `json:"high_gas_price,omitempty"`
+}
+
+// Staking are the staking tokens of the chain.
+type Staking struct {
+	StakingTokens []StakingToken `json:"staking_tokens"`
+}
+
+// StakingToken is a staking token of the chain.
+type StakingToken struct {
+	Denom string `json:"denom"`
+}
+
+// APIs are the public endpoints of the chain.
+type APIs struct {
+	RPC  []Endpoint `json:"rpc,omitempty"`
+	REST []Endpoint `json:"rest,omitempty"`
+	GRPC []Endpoint `json:"grpc,omitempty"`
+}
+
+// Endpoint is a public endpoint of the chain.
+type Endpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// Validate validates the fields of the chain used by the clients.
+func (c Chain) Validate() error {
+	if c.ChainName == "" {
+		return errors.New("chain name cannot be empty")
+	}
+
+	if c.ChainID == "" {
+		return fmt.Errorf("chain %s: chain ID cannot be empty", c.ChainName)
+	}
+
+	if !bech32PrefixRegex.MatchString(c.Bech32Prefix) {
+		return fmt.Errorf("chain %s: invalid bech32 prefix %q", c.ChainName, c.Bech32Prefix)
+	}
+
+	for _, token := range c.Fees.FeeTokens {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return fmt.Errorf("chain %s: invalid fee token: %w", c.ChainName, err)
+		}
+
+		for _, price := range []json.Number{token.FixedMinGasPrice, token.LowGasPrice, token.AverageGasPrice, token.HighGasPrice} {
+			if _, err := parseGasPrice(price); err != nil {
+				return fmt.Errorf("chain %s: invalid gas price of fee token %s: %w", c.ChainName, token.Denom, err)
+			}
+		}
+	}
+
+	for _, token := range c.Staking.StakingTokens {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return fmt.Errorf("chain %s: invalid staking token: %w", c.ChainName, err)
+		}
+	}
+
+	for _, endpoints := range [][]Endpoint{c.APIs.RPC, c.APIs.REST, c.APIs.GRPC} {
+		for _, endpoint := range endpoints {
+			if endpoint.Address == "" {
+				return fmt.Errorf("chain %s: endpoint address cannot be empty", c.ChainName)
+			}
+		}
+	}
+
+	for _, endpoint := range c.APIs.RPC {
+		u, err := url.Parse(endpoint.Address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("chain %s: invalid RPC endpoint %q", c.ChainName, endpoint.Address)
+		}
+	}
+
+	return nil
+}
+
+// parseGasPrice parses a gas price of the registry, zero if not set.
+func parseGasPrice(price json.Number) (sdkmath.LegacyDec, error) {
+	if price == "" {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	dec, err := sdkmath.LegacyNewDecFromStr(price.String())
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if dec.IsNegative() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("negative gas price %s", price)
+	}
+
+	return dec, nil
+}
+
+// GasPrices returns the gas prices of the fee tokens, using their average gas
+// price, or else their low or fixed minimum gas price. The fee tokens without
+// gas price are omitted.
+func (c Chain) GasPrices() (sdk.DecCoins, error) {
+	var gasPrices sdk.DecCoins
+	for _, token := range c.Fees.FeeTokens {
+		for _, price := range []json.Number{token.AverageGasPrice, token.LowGasPrice, token.FixedMinGasPrice} {
+			dec, err := parseGasPrice(price)
+			if err != nil {
+				return nil, err
+			}
+
+			if dec.IsPositive() {
+				gasPrices = gasPrices.Add(sdk.NewDecCoinFromDec(token.Denom, dec))
+				break
+			}
+		}
+	}
+
+	return gasPrices, nil
+}
+
+// RPCAddresses returns the addresses of the RPC endpoints of the chain.
+func (c Chain) RPCAddresses() []string {
+	addresses := make([]string, len(c.APIs.RPC))
+	for i, endpoint := range c.APIs.RPC {
+		addresses[i] = endpoint.Address
+	}
+
+	return addresses
+}
+
+// ConfigureContext returns the client context configured for the chain: its
+// chain ID, the address codecs and prefixes of its bech32 prefix and, if the
+// chain has RPC endpoints, a client failing over them in order.
+func (c Chain) ConfigureContext(clientCtx client.Context) (client.Context, error) {
+	validatorPrefix := c.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator
+	consensusPrefix := c.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixConsensus
+
+	clientCtx = clientCtx.
+		WithChainID(c.ChainID).
+		WithAddressPrefix(c.Bech32Prefix).
+		WithValidatorPrefix(validatorPrefix).
+		WithAddressCodec(addresscodec.NewBech32Codec(c.Bech32Prefix)).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec(validatorPrefix)).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec(consensusPrefix))
+
+	addresses := c.RPCAddresses()
+	if len(addresses) == 0 {
+		return clientCtx, nil
+	}
+
+	clients := make([]client.CometRPC, len(addresses))
+	for i, address := range addresses {
+		rpcClient, err := client.NewClientFromNode(address)
+		if err != nil {
+			return clientCtx, fmt.Errorf("chain %s: failed to create a client for %s: %w", c.ChainName, address, err)
+		}
+		clients[i] = rpcClient
+	}
+
+	if len(clients) == 1 {
+		return clientCtx.WithNodeURI(addresses[0]).WithClient(clients[0]), nil
+	}
+
+	failoverClient, err := client.NewFailoverClient(clients, client.DefaultFailoverOptions())
+	if err != nil {
+		return clientCtx, err
+	}
+
+	return clientCtx.WithNodeURI(addresses[0]).WithClient(failoverClient), nil
+}
+
+// ConfigureFactory returns the transaction factory configured for the chain:
+// its chain ID and, unless the factory has fees or gas prices, the gas prices
+// of the chain.
+func (c Chain) ConfigureFactory(f tx.Factory) (tx.Factory, error) {
+	f = f.WithChainID(c.ChainID)
+	if !f.Fees().IsZero() || !f.GasPrices().IsZero() {
+		return f, nil
+	}
+
+	gasPrices, err := c.GasPrices()
+	if err != nil {
+		return f, err
+	}
+
+	if gasPrices.IsZero() {
+		return f, nil
+	}
+
+	return f.WithGasPrices(gasPrices.String()), nil
+}
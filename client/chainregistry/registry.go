@@ -0,0 +1,159 @@
+// Package chainregistry loads the metadata of chains, e.g. their chain ID,
+// bech32 prefix, fee tokens and public endpoints, from a chain registry such as
+// https://github.com/cosmos/chain-registry, and configures the client context
+// and transaction factory from it.
+package chainregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSource is the cosmos chain registry.
+	DefaultSource = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+	// DefaultCacheTTL is the default duration the chains are cached for.
+	DefaultCacheTTL = time.Hour
+
+	// maxChainFileSize is the maximum size of a chain.json file.
+	maxChainFileSize = 1 << 20
+)
+
+// Registry loads the chains from a chain registry source, either an HTTP(S)
+// base URL or a local directory, laid out as the cosmos chain registry: the
+// metadata of a chain is in the <source>/<chain name>/chain.json file.
+// The chains are validated and cached.
+type Registry struct {
+	source     string
+	cacheTTL   time.Duration
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedChain
+	now   func() time.Time
+}
+
+type cachedChain struct {
+	chain    Chain
+	loadedAt time.Time
+}
+
+// Option configures a Registry.
+type Option func(*Registry)
+
+// WithCacheTTL sets the duration the chains are cached for. Zero disables the
+// cache.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(r *Registry) {
+		r.cacheTTL = ttl
+	}
+}
+
+// WithHTTPClient sets the HTTP client fetching the chains from an HTTP(S)
+// source.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(r *Registry) {
+		r.httpClient = httpClient
+	}
+}
+
+// NewRegistry returns a Registry loading the chains from the given source,
+// DefaultSource if empty.
+func NewRegistry(source string, opts ...Option) *Registry {
+	if source == "" {
+		source = DefaultSource
+	}
+
+	r := &Registry{
+		source:     strings.TrimSuffix(source, "/"),
+		cacheTTL:   DefaultCacheTTL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		cache:      make(map[string]cachedChain),
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Chain returns the chain with the given name, from the cache if loaded less
+// than the cache TTL ago.
+func (r *Registry) Chain(ctx context.Context, chainName string) (Chain, error) {
+	if chainName == "" || strings.ContainsAny(chainName, `/\`) || chainName == "." || chainName == ".." {
+		return Chain{}, fmt.Errorf("invalid chain name %q", chainName)
+	}
+
+	r.mu.Lock()
+	cached, ok := r.cache[chainName]
+	r.mu.Unlock()
+	if ok && r.now().Sub(cached.loadedAt) < r.cacheTTL {
+		return cached.chain, nil
+	}
+
+	bz, err := r.read(ctx, chainName)
+	if err != nil {
+		return Chain{}, fmt.Errorf("failed to load chain %s: %w", chainName, err)
+	}
+
+	var chain Chain
+	if err := json.Unmarshal(bz, &chain); err != nil {
+		return Chain{}, fmt.Errorf("failed to parse chain %s: %w", chainName, err)
+	}
+
+	if err := chain.Validate(); err != nil {
+		return Chain{}, err
+	}
+
+	if chain.ChainName != chainName {
+		return Chain{}, fmt.Errorf("chain %s: unexpected chain name %s", chainName, chain.ChainName)
+	}
+
+	if r.cacheTTL > 0 {
+		r.mu.Lock()
+		r.cache[chainName] = cachedChain{chain: chain, loadedAt: r.now()}
+		r.mu.Unlock()
+	}
+
+	return chain, nil
+}
+
+// read reads the chain.json file of the given chain from the source.
+func (r *Registry) read(ctx context.Context, chainName string) ([]byte, error) {
+	if !strings.HasPrefix(r.source, "http://") && !strings.HasPrefix(r.source, "https://") {
+		f, err := os.Open(filepath.Join(r.source, chainName, "chain.json"))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return io.ReadAll(io.LimitReader(f, maxChainFileSize))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.source+"/"+chainName+"/chain.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, maxChainFileSize))
+}
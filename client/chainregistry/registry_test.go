@@ -0,0 +1,224 @@
+package chainregistry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/chainregistry"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const chainJSON = `{
+  "$schema": "../chain.schema.json",
+  "chain_name": "testchain",
+  "chain_id": "testchain-1",
+  "bech32_prefix": "test",
+  "slip44": 118,
+  "fees": {
+    "fee_tokens": [
+      {
+        "denom": "utest",
+        "fixed_min_gas_price": 0.001,
+        "low_gas_price": 0.01,
+        "average_gas_price": 0.025,
+        "high_gas_price": 0.03
+      },
+      {
+        "denom": "uother",
+        "fixed_min_gas_price": 0.5
+      },
+      {
+        "denom": "ufree"
+      }
+    ]
+  },
+  "staking": {
+    "staking_tokens": [{ "denom": "utest" }]
+  },
+  "apis": {
+    "rpc": [
+      { "address": "http://localhost:26657", "provider": "a" },
+      { "address": "http://localhost:36657", "provider": "b" }
+    ],
+    "grpc": [{ "address": "localhost:9090" }]
+  }
+}`
+
+func TestRegistryHTTP(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/testchain/chain.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(chainJSON))
+	}))
+	defer srv.Close()
+
+	registry := chainregistry.NewRegistry(srv.URL + "/")
+
+	chain, err := registry.Chain(context.Background(), "testchain")
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", chain.ChainID)
+	require.Equal(t, "test", chain.Bech32Prefix)
+	require.Equal(t, uint32(118), chain.Slip44)
+	require.Equal(t, []string{"http://localhost:26657", "http://localhost:36657"}, chain.RPCAddresses())
+
+	// the chain is cached.
+	_, err = registry.Chain(context.Background(), "testchain")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load())
+
+	_, err = registry.Chain(context.Background(), "unknown")
+	require.ErrorContains(t, err, "404")
+
+	_, err = registry.Chain(context.Background(), "../testchain")
+	require.ErrorContains(t, err, "invalid chain name")
+
+	// zero TTL disables the cache.
+	registry = chainregistry.NewRegistry(srv.URL, chainregistry.WithCacheTTL(0))
+	requests.Store(0)
+	for i := 0; i < 2; i++ {
+		_, err = registry.Chain(context.Background(), "testchain")
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestRegistryDir(t *testing.T) {
+	dir := t.TempDir()
+	writeChain(t, dir, "testchain", chainJSON)
+	writeChain(t, dir, "othername", chainJSON)
+	writeChain(t, dir, "invalid", `{"chain_name": "invalid", "chain_id": "invalid-1", "bech32_prefix": "Inv"}`)
+	writeChain(t, dir, "malformed", `{"chain_name":`)
+
+	registry := chainregistry.NewRegistry(dir)
+
+	chain, err := registry.Chain(context.Background(), "testchain")
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", chain.ChainID)
+
+	_, err = registry.Chain(context.Background(), "othername")
+	require.ErrorContains(t, err, "unexpected chain name")
+
+	_, err = registry.Chain(context.Background(), "invalid")
+	require.ErrorContains(t, err, "invalid bech32 prefix")
+
+	_, err = registry.Chain(context.Background(), "malformed")
+	require.ErrorContains(t, err, "failed to parse chain")
+
+	_, err = registry.Chain(context.Background(), "missing")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestChainValidate(t *testing.T) {
+	valid := func() chainregistry.Chain {
+		return chainregistry.Chain{
+			ChainName:    "testchain",
+			ChainID:      "testchain-1",
+			Bech32Prefix: "test",
+			Fees: chainregistry.Fees{
+				FeeTokens: []chainregistry.FeeToken{{Denom: "utest", AverageGasPrice: "0.025"}},
+			},
+			APIs: chainregistry.APIs{
+				RPC: []chainregistry.Endpoint{{Address: "https://rpc.test.com:443"}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*chainregistry.Chain)
+		expErr   string
+	}{
+		{"valid", func(*chainregistry.Chain) {}, ""},
+		{"empty chain name", func(c *chainregistry.Chain) { c.ChainName = "" }, "chain name cannot be empty"},
+		{"empty chain ID", func(c *chainregistry.Chain) { c.ChainID = "" }, "chain ID cannot be empty"},
+		{"empty bech32 prefix", func(c *chainregistry.Chain) { c.Bech32Prefix = "" }, "invalid bech32 prefix"},
+		{"invalid bech32 prefix", func(c *chainregistry.Chain) { c.Bech32Prefix = "te st" }, "invalid bech32 prefix"},
+		{"invalid fee denom", func(c *chainregistry.Chain) { c.Fees.FeeTokens[0].Denom = "1" }, "invalid fee token"},
+		{"invalid gas price", func(c *chainregistry.Chain) { c.Fees.FeeTokens[0].HighGasPrice = "abc" }, "invalid gas price"},
+		{"negative gas price", func(c *chainregistry.Chain) { c.Fees.FeeTokens[0].LowGasPrice = "-1" }, "negative gas price"},
+		{"invalid staking denom", func(c *chainregistry.Chain) {
+			c.Staking.StakingTokens = []chainregistry.StakingToken{{Denom: "1"}}
+		}, "invalid staking token"},
+		{"empty endpoint", func(c *chainregistry.Chain) {
+			c.APIs.GRPC = []chainregistry.Endpoint{{Provider: "a"}}
+		}, "endpoint address cannot be empty"},
+		{"invalid RPC endpoint", func(c *chainregistry.Chain) { c.APIs.RPC[0].Address = "rpc.test.com" }, "invalid RPC endpoint"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := valid()
+			tc.malleate(&chain)
+
+			err := chain.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestChainConfigure(t *testing.T) {
+	dir := t.TempDir()
+	writeChain(t, dir, "testchain", chainJSON)
+
+	chain, err := chainregistry.NewRegistry(dir).Chain(context.Background(), "testchain")
+	require.NoError(t, err)
+
+	gasPrices, err := chain.GasPrices()
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000uother,0.025000000000000000utest", gasPrices.String())
+
+	clientCtx, err := chain.ConfigureContext(client.Context{})
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", clientCtx.ChainID)
+	require.Equal(t, "http://localhost:26657", clientCtx.NodeURI)
+	require.NotNil(t, clientCtx.Client)
+
+	addr := sdk.AccAddress("addr")
+	addrStr, err := clientCtx.AddressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	require.Regexp(t, "^test1", addrStr)
+
+	valAddrStr, err := clientCtx.ValidatorAddressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	require.Regexp(t, "^testvaloper1", valAddrStr)
+
+	consAddrStr, err := clientCtx.ConsensusAddressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	require.Regexp(t, "^testvalcons1", consAddrStr)
+
+	f, err := chain.ConfigureFactory(tx.Factory{})
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", f.ChainID())
+	require.Equal(t, gasPrices, f.GasPrices())
+
+	// the fees or gas prices of the factory are kept.
+	f, err = chain.ConfigureFactory(tx.Factory{}.WithFees("10stake"))
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", f.ChainID())
+	require.True(t, f.GasPrices().IsZero())
+	require.Equal(t, "10stake", f.Fees().String())
+}
+
+func writeChain(t *testing.T, dir, chainName, chainJSON string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, chainName), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, chainName, "chain.json"), []byte(chainJSON), 0o600))
+}
@@ -143,14 +143,29 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.Client == nil || flagSet.Changed(flags.FlagNode) {
 		rpcURI, _ := flagSet.GetString(flags.FlagNode)
 		if rpcURI != "" {
-			clientCtx = clientCtx.WithNodeURI(rpcURI)
-
-			client, err := NewClientFromNode(rpcURI)
-			if err != nil {
-				return clientCtx, err
+			// the first node of a comma-separated list is the preferred one,
+			// the others being failed over to.
+			rpcURIs := strings.Split(rpcURI, ",")
+			clientCtx = clientCtx.WithNodeURI(rpcURIs[0])
+
+			clients := make([]CometRPC, len(rpcURIs))
+			for i, uri := range rpcURIs {
+				client, err := NewClientFromNode(strings.TrimSpace(uri))
+				if err != nil {
+					return clientCtx, err
+				}
+				clients[i] = client
 			}
 
-			clientCtx = clientCtx.WithClient(client)
+			if len(clients) == 1 {
+				clientCtx = clientCtx.WithClient(clients[0])
+			} else {
+				client, err := NewFailoverClient(clients, DefaultFailoverOptions())
+				if err != nil {
+					return clientCtx, err
+				}
+				clientCtx = clientCtx.WithClient(client)
+			}
 		}
 	}
 
@@ -168,11 +183,38 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 				})))
 			}
 
-			grpcClient, err := grpc.NewClient(grpcURI, dialOpts...)
-			if err != nil {
-				return Context{}, err
+			maxRetries, _ := flagSet.GetInt(flags.FlagGRPCMaxRetries)
+			queryInterceptors := WithQueryInterceptors(DefaultRetryOptions(maxRetries))
+
+			// the first endpoint of a comma-separated list is the preferred
+			// one, the others being failed over to.
+			grpcURIs := strings.Split(grpcURI, ",")
+			conns := make([]*grpc.ClientConn, len(grpcURIs))
+			for i, uri := range grpcURIs {
+				// with a single endpoint, the query interceptors are
+				// installed on its connection, else on the failover one so
+				// that the queries are retried once all the endpoints failed.
+				connOpts := dialOpts
+				if len(grpcURIs) == 1 {
+					connOpts = append(connOpts, queryInterceptors)
+				}
+
+				conn, err := grpc.NewClient(strings.TrimSpace(uri), connOpts...)
+				if err != nil {
+					return Context{}, err
+				}
+				conns[i] = conn
+			}
+
+			if len(conns) == 1 {
+				clientCtx = clientCtx.WithGRPCClient(conns[0])
+			} else {
+				grpcClient, err := NewFailoverGRPCClient(conns, DefaultFailoverOptions(), queryInterceptors)
+				if err != nil {
+					return Context{}, err
+				}
+				clientCtx = clientCtx.WithGRPCClient(grpcClient)
 			}
-			clientCtx = clientCtx.WithGRPCClient(grpcClient)
 		}
 	}
 
@@ -229,6 +271,24 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithOffline(offline)
 	}
 
+	if clientCtx.SigningData == nil || flagSet.Changed(flags.FlagSigningData) {
+		path, _ := flagSet.GetString(flags.FlagSigningData)
+
+		if path != "" {
+			signingData, err := ReadSigningData(path)
+			if err != nil {
+				return clientCtx, err
+			}
+
+			if clientCtx.ChainID != "" && clientCtx.ChainID != signingData.ChainID {
+				return clientCtx, fmt.Errorf("chain ID %s doesn't match the chain ID %s of the signing data", clientCtx.ChainID, signingData.ChainID)
+			}
+
+			// the signing data replaces any query to the node.
+			clientCtx = clientCtx.WithSigningData(signingData).WithChainID(signingData.ChainID).WithOffline(true)
+		}
+	}
+
 	if !clientCtx.UseLedger || flagSet.Changed(flags.FlagUseLedger) {
 		useLedger, _ := flagSet.GetBool(flags.FlagUseLedger)
 		clientCtx = clientCtx.WithUseLedger(useLedger)
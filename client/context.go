@@ -66,6 +66,10 @@ type Context struct {
 	LedgerHasProtobuf bool
 	PreprocessTxHook  PreprocessTxFn
 
+	// SigningData is the snapshot of the chain and account state used to sign
+	// transactions offline, set with the --signing-data flag.
+	SigningData *SigningData
+
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
 
@@ -220,6 +224,12 @@ func (ctx Context) WithOffline(offline bool) Context {
 	return ctx
 }
 
+// WithSigningData returns a copy of the context with an updated SigningData.
+func (ctx Context) WithSigningData(signingData *SigningData) Context {
+	ctx.SigningData = signingData
+	return ctx
+}
+
 // WithFromName returns a copy of the context with an updated from account name.
 func (ctx Context) WithFromName(name string) Context {
 	ctx.FromName = name
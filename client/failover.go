@@ -0,0 +1,445 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+const (
+	// DefaultMaxHeightLag is the default number of blocks a node can lag
+	// behind the most advanced node before being failed over.
+	DefaultMaxHeightLag = 5
+	// DefaultHealthCheckInterval is the default interval between two health
+	// checks of the nodes.
+	DefaultHealthCheckInterval = 30 * time.Second
+)
+
+// FailoverOptions configures the failover across multiple nodes.
+type FailoverOptions struct {
+	// MaxHeightLag is the number of blocks a node can lag behind the most
+	// advanced node before being failed over. Zero disables the detection of
+	// lagging nodes.
+	MaxHeightLag int64
+	// HealthCheckInterval is the interval between two health checks of the
+	// nodes, after which the nodes failed over are tried again.
+	HealthCheckInterval time.Duration
+}
+
+// DefaultFailoverOptions returns the default FailoverOptions.
+func DefaultFailoverOptions() FailoverOptions {
+	return FailoverOptions{
+		MaxHeightLag:        DefaultMaxHeightLag,
+		HealthCheckInterval: DefaultHealthCheckInterval,
+	}
+}
+
+// failover tracks the health of nodes, ordered by preference.
+type failover struct {
+	opts FailoverOptions
+
+	mtx sync.Mutex
+	// heights are the last known heights of the nodes, 0 if unknown and -1 if
+	// the node is down.
+	heights   []int64
+	checkedAt time.Time
+}
+
+func newFailover(n int, opts FailoverOptions) *failover {
+	return &failover{opts: opts, heights: make([]int64, n)}
+}
+
+// checkDue returns whether the health check interval elapsed, resetting the
+// health of the nodes if so, so that the nodes failed over are tried again.
+func (f *failover) checkDue() bool {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if !f.checkedAt.IsZero() && time.Since(f.checkedAt) < f.opts.HealthCheckInterval {
+		return false
+	}
+
+	f.checkedAt = time.Now()
+	clear(f.heights)
+	return true
+}
+
+// candidates returns the nodes to try in order: the healthy nodes by
+// preference, then the others.
+func (f *failover) candidates() []int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	var maxHeight int64
+	for _, h := range f.heights {
+		maxHeight = max(maxHeight, h)
+	}
+
+	healthy := make([]int, 0, len(f.heights))
+	var unhealthy []int
+	for i, h := range f.heights {
+		lagging := h > 0 && f.opts.MaxHeightLag > 0 && maxHeight-h > f.opts.MaxHeightLag
+		if h < 0 || lagging {
+			unhealthy = append(unhealthy, i)
+			continue
+		}
+		healthy = append(healthy, i)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// observe records the height of a node, or that it is down if height is
+// negative.
+func (f *failover) observe(i int, height int64) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.heights[i] = height
+}
+
+// isConnectionError returns whether the error is a failure to reach the node,
+// rather than an error returned by the node.
+func isConnectionError(err error) bool {
+	var (
+		netErr net.Error
+		urlErr *url.Error
+	)
+	return errors.As(err, &netErr) || errors.As(err, &urlErr)
+}
+
+var _ CometRPC = (*FailoverClient)(nil)
+
+// FailoverClient is a CometRPC sending the requests to the first healthy node
+// of a list of nodes, ordered by preference. A node is unhealthy if it can't
+// be reached or if it lags behind the most advanced node by more than
+// FailoverOptions.MaxHeightLag blocks, as reported by their status, checked
+// every FailoverOptions.HealthCheckInterval.
+//
+// The queries failing to reach a node are retried on the next node. The
+// broadcasts are not, since the transaction may have reached the node.
+type FailoverClient struct {
+	clients  []CometRPC
+	failover *failover
+}
+
+// NewFailoverClient returns a FailoverClient over the given clients, ordered
+// by preference.
+func NewFailoverClient(clients []CometRPC, opts FailoverOptions) (*FailoverClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("failover client requires at least one client")
+	}
+
+	return &FailoverClient{clients: clients, failover: newFailover(len(clients), opts)}, nil
+}
+
+// candidates checks the health of the nodes if due, and returns the nodes to
+// try in order.
+func (c *FailoverClient) candidates(ctx context.Context) []int {
+	if c.failover.checkDue() {
+		var wg sync.WaitGroup
+		for i, client := range c.clients {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := client.Status(ctx)
+				if err != nil {
+					c.failover.observe(i, -1)
+					return
+				}
+				c.failover.observe(i, res.SyncInfo.LatestBlockHeight)
+			}()
+		}
+		wg.Wait()
+	}
+
+	return c.failover.candidates()
+}
+
+// failoverQuery sends a query to the first healthy node, retrying on the next
+// ones if the node can't be reached.
+func failoverQuery[T any](ctx context.Context, c *FailoverClient, fn func(CometRPC) (T, error)) (res T, err error) {
+	for _, i := range c.candidates(ctx) {
+		res, err = fn(c.clients[i])
+		if err == nil || !isConnectionError(err) {
+			return res, err
+		}
+		c.failover.observe(i, -1)
+	}
+
+	return res, err
+}
+
+// failoverBroadcast sends a broadcast to the first healthy node.
+func failoverBroadcast[T any](ctx context.Context, c *FailoverClient, fn func(CometRPC) (T, error)) (T, error) {
+	i := c.candidates(ctx)[0]
+	res, err := fn(c.clients[i])
+	if err != nil && isConnectionError(err) {
+		c.failover.observe(i, -1)
+	}
+
+	return res, err
+}
+
+func (c *FailoverClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultABCIInfo, error) {
+		return client.ABCIInfo(ctx)
+	})
+}
+
+func (c *FailoverClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *FailoverClient) ABCIQueryWithOptions(
+	ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return failoverBroadcast(ctx, c, func(client CometRPC) (*coretypes.ResultBroadcastTxCommit, error) {
+		return client.BroadcastTxCommit(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return failoverBroadcast(ctx, c, func(client CometRPC) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxAsync(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return failoverBroadcast(ctx, c, func(client CometRPC) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxSync(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultValidators, error) {
+		return client.Validators(ctx, height, page, perPage)
+	})
+}
+
+func (c *FailoverClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultStatus, error) {
+		return client.Status(ctx)
+	})
+}
+
+func (c *FailoverClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultBlock, error) {
+		return client.Block(ctx, height)
+	})
+}
+
+func (c *FailoverClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultBlock, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+func (c *FailoverClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultBlockResults, error) {
+		return client.BlockResults(ctx, height)
+	})
+}
+
+func (c *FailoverClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultBlockchainInfo, error) {
+		return client.BlockchainInfo(ctx, minHeight, maxHeight)
+	})
+}
+
+func (c *FailoverClient) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultCommit, error) {
+		return client.Commit(ctx, height)
+	})
+}
+
+func (c *FailoverClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultTx, error) {
+		return client.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *FailoverClient) TxSearch(
+	ctx context.Context, query string, prove bool, page, perPage *int, orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultTxSearch, error) {
+		return client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverClient) BlockSearch(
+	ctx context.Context, query string, page, perPage *int, orderBy string,
+) (*coretypes.ResultBlockSearch, error) {
+	return failoverQuery(ctx, c, func(client CometRPC) (*coretypes.ResultBlockSearch, error) {
+		return client.BlockSearch(ctx, query, page, perPage, orderBy)
+	})
+}
+
+// NewFailoverGRPCClient returns a gRPC client connection sending the calls to
+// the first healthy connection of the given connections, ordered by
+// preference. A connection is unhealthy if its node is unavailable or if it
+// lags behind the most advanced node by more than
+// FailoverOptions.MaxHeightLag blocks, as reported by the node service status,
+// checked every FailoverOptions.HealthCheckInterval, or by the block height
+// header of the responses.
+//
+// The calls failing with an unavailable node are retried on the next
+// connection. Closing the returned connection doesn't close the given ones.
+// The given dial options, e.g. WithQueryInterceptors, apply to the returned
+// connection, their interceptors running before the failover.
+func NewFailoverGRPCClient(conns []*grpc.ClientConn, opts FailoverOptions, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if len(conns) == 0 {
+		return nil, errors.New("failover gRPC client requires at least one connection")
+	}
+
+	f := newFailover(len(conns), opts)
+	candidates := func(ctx context.Context) []int {
+		if f.checkDue() {
+			var wg sync.WaitGroup
+			for i, conn := range conns {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					height, err := grpcNodeHeight(ctx, conn)
+					switch {
+					case status.Code(err) == codes.Unavailable:
+						f.observe(i, -1)
+					case err == nil:
+						f.observe(i, height)
+					}
+				}()
+			}
+			wg.Wait()
+		}
+
+		return f.candidates()
+	}
+
+	unary := func(ctx context.Context, method string, req, reply any, _ *grpc.ClientConn, _ grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+		for _, i := range candidates(ctx) {
+			var md metadata.MD
+			err = conns[i].Invoke(ctx, method, req, reply, append(opts, grpc.Header(&md))...)
+			if status.Code(err) == codes.Unavailable {
+				f.observe(i, -1)
+				continue
+			}
+
+			if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+				if height, err := strconv.ParseInt(heights[0], 10, 64); err == nil && height > 0 {
+					f.observe(i, height)
+				}
+			}
+			return err
+		}
+
+		return err
+	}
+
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, _ *grpc.ClientConn, method string, _ grpc.Streamer, opts ...grpc.CallOption) (s grpc.ClientStream, err error) {
+		for _, i := range candidates(ctx) {
+			s, err = conns[i].NewStream(ctx, desc, method, opts...)
+			if status.Code(err) != codes.Unavailable {
+				return s, err
+			}
+			f.observe(i, -1)
+		}
+
+		return s, err
+	}
+
+	// the calls are all routed by the interceptors to the given connections,
+	// the target of the returned connection is never dialed.
+	return grpc.NewClient(
+		"passthrough:///failover",
+		append(dialOpts,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(unary),
+			grpc.WithChainStreamInterceptor(stream),
+		)...,
+	)
+}
+
+// nodeStatusMethod is the method of the node service returning the status of
+// the node, whose response holds the block height as field 2.
+const nodeStatusMethod = "/cosmos.base.node.v1beta1.Service/Status"
+
+// grpcNodeHeight returns the block height of the node reported by its node
+// service status. The response is decoded from the wire format, since the
+// node service types can't be imported by this package.
+func grpcNodeHeight(ctx context.Context, conn *grpc.ClientConn) (int64, error) {
+	var res []byte
+	if err := conn.Invoke(ctx, nodeStatusMethod, []byte{}, &res, grpc.ForceCodec(rawCodec{})); err != nil {
+		return 0, err
+	}
+
+	for len(res) > 0 {
+		num, typ, n := protowire.ConsumeTag(res)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		res = res[n:]
+
+		if num == 2 && typ == protowire.VarintType {
+			height, n := protowire.ConsumeVarint(res)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			return int64(height), nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, res)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		res = res[n:]
+	}
+
+	return 0, nil
+}
+
+// rawCodec is a gRPC codec passing the messages as their wire format.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	bz, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("raw codec: expected []byte, got %T", v)
+	}
+	return bz, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	bz, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("raw codec: expected *[]byte, got %T", v)
+	}
+	*bz = append((*bz)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
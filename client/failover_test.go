@@ -0,0 +1,143 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+)
+
+// fakeNode is a CometRPC reporting a fixed height, or failing to be reached
+// if down.
+type fakeNode struct {
+	client.CometRPC
+
+	height int64
+	down   bool
+	calls  int
+}
+
+func (n *fakeNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	if n.down {
+		return nil, &url.Error{Op: "Post", URL: "http://node", Err: errors.New("connection refused")}
+	}
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: n.height}}, nil
+}
+
+func (n *fakeNode) Validators(ctx context.Context, _ *int64, _, _ *int) (*coretypes.ResultValidators, error) {
+	if _, err := n.Status(ctx); err != nil {
+		return nil, err
+	}
+	n.calls++
+	return &coretypes.ResultValidators{BlockHeight: n.height}, nil
+}
+
+func TestFailoverClient(t *testing.T) {
+	_, err := client.NewFailoverClient(nil, client.DefaultFailoverOptions())
+	require.Error(t, err)
+
+	primary, lagging, secondary := &fakeNode{height: 100}, &fakeNode{height: 90}, &fakeNode{height: 102}
+	opts := client.DefaultFailoverOptions()
+	opts.HealthCheckInterval = 0
+
+	c, err := client.NewFailoverClient([]client.CometRPC{lagging, primary, secondary}, opts)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// the lagging node is failed over.
+	res, err := c.Validators(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), res.BlockHeight)
+
+	// the node down is failed over.
+	primary.down = true
+	res, err = c.Validators(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(102), res.BlockHeight)
+
+	// the node going down between the health checks is failed over.
+	primary.down = false
+	opts.HealthCheckInterval = time.Hour
+	c, err = client.NewFailoverClient([]client.CometRPC{primary, secondary}, opts)
+	require.NoError(t, err)
+	_, err = c.Validators(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	primary.down = true
+	res, err = c.Validators(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(102), res.BlockHeight)
+	require.Equal(t, 2, secondary.calls)
+}
+
+// fakeNodeService is a node service reporting a fixed height and minimum gas
+// price.
+type fakeNodeService struct {
+	node.UnimplementedServiceServer
+
+	height      uint64
+	minGasPrice string
+}
+
+func (s *fakeNodeService) Status(context.Context, *node.StatusRequest) (*node.StatusResponse, error) {
+	return &node.StatusResponse{Height: s.height, EarliestStoreHeight: 1}, nil
+}
+
+func (s *fakeNodeService) Config(context.Context, *node.ConfigRequest) (*node.ConfigResponse, error) {
+	return &node.ConfigResponse{MinimumGasPrice: s.minGasPrice}, nil
+}
+
+func startFakeNodeService(t *testing.T, svc *fakeNodeService) (*grpc.ClientConn, func()) {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	node.RegisterServiceServer(srv, svc)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn, srv.Stop
+}
+
+func TestFailoverGRPCClient(t *testing.T) {
+	_, err := client.NewFailoverGRPCClient(nil, client.DefaultFailoverOptions())
+	require.Error(t, err)
+
+	lagging, _ := startFakeNodeService(t, &fakeNodeService{height: 10, minGasPrice: "1stake"})
+	primary, stopPrimary := startFakeNodeService(t, &fakeNodeService{height: 100, minGasPrice: "2stake"})
+	secondary, _ := startFakeNodeService(t, &fakeNodeService{height: 100, minGasPrice: "3stake"})
+
+	conn, err := client.NewFailoverGRPCClient([]*grpc.ClientConn{lagging, primary, secondary}, client.DefaultFailoverOptions())
+	require.NoError(t, err)
+	defer conn.Close()
+	svc := node.NewServiceClient(conn)
+	ctx := context.Background()
+
+	// the lagging node is failed over.
+	res, err := svc.Config(ctx, &node.ConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "2stake", res.MinimumGasPrice)
+
+	// the node down is failed over.
+	stopPrimary()
+	res, err = svc.Config(ctx, &node.ConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "3stake", res.MinimumGasPrice)
+}
@@ -5,6 +5,7 @@ package cmtservice
 
 import (
 	context "context"
+	types "cosmossdk.io/store/types"
 	fmt "fmt"
 	v11 "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -1191,97 +1192,101 @@ func init() {
 }
 
 var fileDescriptor_40c93fb3ef485c5d = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0x69, 0x6c, 0x3f, 0xe9, 0xff, 0xdf, 0x64, 0x12, 0x5a, 0xd7, 0xb4, 0x6e, 0xb0,
-	0x44, 0x9b, 0xb6, 0x64, 0xb7, 0x76, 0xda, 0xb4, 0x87, 0x52, 0x94, 0x34, 0x25, 0x0d, 0xb4, 0x25,
-	0x6c, 0x10, 0x48, 0x08, 0x69, 0xb5, 0xf6, 0x8e, 0x37, 0xab, 0xd8, 0x3b, 0xd3, 0x9d, 0xb1, 0xc1,
-	0x42, 0x48, 0x88, 0x0f, 0x80, 0x90, 0xf8, 0x0a, 0x1c, 0xe0, 0xc6, 0xa1, 0x82, 0x13, 0x95, 0xe0,
-	0x54, 0x71, 0xaa, 0x8a, 0x84, 0xaa, 0x1e, 0x10, 0x6a, 0x91, 0xf8, 0x1a, 0x68, 0x5e, 0xd6, 0xde,
-	0xcd, 0x4b, 0xed, 0xf4, 0x06, 0x17, 0x6b, 0xf6, 0x79, 0xfd, 0xfd, 0x9e, 0x67, 0xe6, 0x99, 0x31,
-	0x9c, 0x6b, 0x10, 0xd6, 0x26, 0xcc, 0xaa, 0xbb, 0x0c, 0x5b, 0x1c, 0x87, 0x1e, 0x8e, 0xda, 0x41,
-	0xc8, 0xad, 0x6e, 0xb5, 0x8e, 0xb9, 0x5b, 0xb5, 0xee, 0x76, 0x70, 0xd4, 0x33, 0x69, 0x44, 0x38,
-	0x41, 0x65, 0x65, 0x6b, 0x0a, 0x5b, 0x73, 0x60, 0x6b, 0x6a, 0xdb, 0xd2, 0xac, 0x4f, 0x7c, 0x22,
-	0x4d, 0x2d, 0xb1, 0x52, 0x5e, 0xa5, 0xe3, 0x3e, 0x21, 0x7e, 0x0b, 0x5b, 0xf2, 0xab, 0xde, 0x69,
-	0x5a, 0x6e, 0xa8, 0x03, 0x96, 0x4e, 0x68, 0x95, 0x4b, 0x03, 0xcb, 0x0d, 0x43, 0xc2, 0x5d, 0x1e,
-	0x90, 0x90, 0x69, 0xed, 0xcb, 0x0d, 0xd2, 0xc6, 0xbc, 0xde, 0xe4, 0x16, 0xad, 0x51, 0xab, 0x5b,
-	0xb5, 0x78, 0x8f, 0xe2, 0x58, 0x79, 0xb2, 0xaf, 0x94, 0xd2, 0x9d, 0xea, 0x14, 0x2d, 0xc9, 0xa1,
-	0xcf, 0x88, 0xba, 0x7e, 0x10, 0xca, 0x44, 0x7b, 0xd9, 0xee, 0x51, 0x82, 0x64, 0xdc, 0xe3, 0xca,
-	0xd6, 0x51, 0x2c, 0x75, 0x3d, 0xf6, 0x45, 0x54, 0x6f, 0x91, 0xc6, 0xb6, 0x56, 0x4f, 0xbb, 0xed,
-	0x20, 0x24, 0x96, 0xfc, 0x55, 0xa2, 0xca, 0xe7, 0x06, 0x94, 0xd7, 0x30, 0x7f, 0xdf, 0x6d, 0x05,
-	0x9e, 0xcb, 0x49, 0xb4, 0x89, 0xf9, 0x4a, 0xef, 0x26, 0x0e, 0xfc, 0x2d, 0x6e, 0xe3, 0xbb, 0x1d,
-	0xcc, 0x38, 0x3a, 0x0a, 0x13, 0x5b, 0x52, 0x50, 0x34, 0xe6, 0x8c, 0xf9, 0xac, 0xad, 0xbf, 0xd0,
-	0x9b, 0x00, 0x03, 0x1e, 0xc5, 0xcc, 0x9c, 0x31, 0x3f, 0x59, 0x3b, 0x6d, 0x26, 0xfb, 0xa3, 0x1a,
-	0xa7, 0x39, 0x98, 0x1b, 0xae, 0x8f, 0x75, 0x4c, 0x3b, 0xe1, 0x59, 0x79, 0x6c, 0xc0, 0xa9, 0x7d,
-	0x21, 0x30, 0x4a, 0x42, 0x86, 0xd1, 0x2b, 0x70, 0x58, 0x12, 0x71, 0x52, 0x48, 0x26, 0xa5, 0x4c,
-	0x99, 0xa2, 0x75, 0x80, 0x6e, 0x1c, 0x82, 0x15, 0x33, 0x73, 0xd9, 0xf9, 0xc9, 0xda, 0x59, 0xf3,
-	0xf9, 0xdb, 0xc5, 0xec, 0x27, 0xb5, 0x13, 0xce, 0x68, 0x2d, 0xc5, 0x2c, 0x2b, 0x99, 0x9d, 0x19,
-	0xca, 0x4c, 0x41, 0x4d, 0x51, 0x6b, 0xc2, 0x89, 0x35, 0xcc, 0x6f, 0xb9, 0x1c, 0xb3, 0x14, 0xbf,
-	0xb8, 0xb4, 0xe9, 0x12, 0x1a, 0x2f, 0x5c, 0xc2, 0xdf, 0x0d, 0x38, 0xb9, 0x4f, 0xa2, 0x7f, 0x77,
-	0x01, 0xef, 0x1b, 0x50, 0xe8, 0xa7, 0x40, 0x35, 0xc8, 0xb9, 0x9e, 0x17, 0x61, 0xc6, 0x24, 0xfe,
-	0xc2, 0x4a, 0xf1, 0xd1, 0xbd, 0x85, 0x59, 0x1d, 0x76, 0x59, 0x69, 0x36, 0x79, 0x14, 0x84, 0xbe,
-	0x1d, 0x1b, 0xa2, 0x05, 0xc8, 0xd1, 0x4e, 0xdd, 0xd9, 0xc6, 0x3d, 0xbd, 0x45, 0x67, 0x4d, 0x75,
-	0xe2, 0xcd, 0x78, 0x18, 0x98, 0xcb, 0x61, 0xcf, 0x9e, 0xa0, 0x9d, 0xfa, 0xdb, 0xb8, 0x27, 0xea,
-	0xd4, 0x25, 0x3c, 0x08, 0x7d, 0x87, 0x92, 0x8f, 0x71, 0x24, 0xb1, 0x67, 0xed, 0x49, 0x25, 0xdb,
-	0x10, 0x22, 0x74, 0x1e, 0xa6, 0x69, 0x44, 0x28, 0x61, 0x38, 0x72, 0x68, 0x14, 0x90, 0x28, 0xe0,
-	0xbd, 0xe2, 0xb8, 0xb4, 0x9b, 0x8a, 0x15, 0x1b, 0x5a, 0x5e, 0xa9, 0xc2, 0xb1, 0x35, 0xcc, 0x57,
-	0x44, 0x99, 0x47, 0x3c, 0x57, 0x95, 0x27, 0x06, 0x14, 0x77, 0xfb, 0xe8, 0x3e, 0x5e, 0x82, 0xbc,
-	0xea, 0x63, 0xe0, 0xe9, 0xfd, 0x52, 0x32, 0xe3, 0x43, 0x6f, 0xaa, 0x29, 0xd1, 0xad, 0x9a, 0xd2,
-	0x77, 0x7d, 0xd5, 0xce, 0x49, 0xdb, 0x75, 0x0f, 0x99, 0x70, 0x48, 0x2e, 0x75, 0x0d, 0x8a, 0xfb,
-	0xf9, 0xd8, 0xca, 0x0c, 0x7d, 0x00, 0x05, 0xe6, 0x6d, 0x3b, 0xca, 0x47, 0xf5, 0xef, 0xd5, 0x61,
-	0x5b, 0x41, 0x01, 0x9e, 0x79, 0x72, 0x6f, 0xe1, 0x88, 0xb2, 0x5c, 0x60, 0xde, 0xf6, 0xdc, 0x05,
-	0xf3, 0xe2, 0x65, 0x3b, 0xcf, 0xbc, 0x6d, 0xa9, 0xae, 0x1c, 0x83, 0x97, 0xfa, 0x1b, 0x55, 0x65,
-	0x54, 0xd5, 0x10, 0x53, 0xe0, 0xe8, 0x4e, 0xcd, 0x7f, 0x84, 0xf3, 0x0c, 0x4c, 0xaf, 0x61, 0xbe,
-	0xd9, 0x0b, 0x1b, 0x62, 0x67, 0x6a, 0xbe, 0x26, 0xa0, 0xa4, 0x50, 0x53, 0x2d, 0x42, 0x8e, 0x29,
-	0x91, 0x64, 0x9a, 0xb7, 0xe3, 0xcf, 0xca, 0xac, 0xb4, 0xbf, 0x43, 0x3c, 0xbc, 0x1e, 0x36, 0x49,
-	0x1c, 0xe5, 0x67, 0x03, 0x66, 0x52, 0x62, 0x1d, 0xe7, 0x16, 0x4c, 0x7b, 0xb8, 0xe9, 0x76, 0x5a,
-	0xdc, 0x09, 0x89, 0x87, 0x9d, 0x20, 0x6c, 0x12, 0x5d, 0xbb, 0xb9, 0x41, 0x1d, 0x68, 0x8d, 0x8a,
-	0x2a, 0xac, 0x2a, 0xcb, 0x7e, 0x90, 0x23, 0x5e, 0x5a, 0x80, 0x3e, 0x82, 0x19, 0x97, 0xd2, 0x56,
-	0xd0, 0x90, 0x87, 0xd2, 0xe9, 0xe2, 0x88, 0x0d, 0x46, 0xfe, 0xf9, 0xa1, 0x23, 0x42, 0x99, 0xcb,
-	0xd0, 0x28, 0x11, 0x47, 0xcb, 0x2b, 0x3f, 0x65, 0x60, 0x32, 0x61, 0x83, 0x10, 0x8c, 0x87, 0x6e,
-	0x1b, 0xab, 0x23, 0x6e, 0xcb, 0x35, 0x3a, 0x0e, 0x79, 0x97, 0x52, 0x47, 0xca, 0x33, 0x52, 0x9e,
-	0x73, 0x29, 0xbd, 0x23, 0x54, 0x45, 0xc8, 0xc5, 0x80, 0xb2, 0x4a, 0xa3, 0x3f, 0xd1, 0x49, 0x00,
-	0x3f, 0xe0, 0x4e, 0x83, 0xb4, 0xdb, 0x01, 0x97, 0x27, 0xb4, 0x60, 0x17, 0xfc, 0x80, 0x5f, 0x97,
-	0x02, 0xa1, 0xae, 0x77, 0x82, 0x96, 0xe7, 0x70, 0xd7, 0x67, 0xc5, 0x43, 0x4a, 0x2d, 0x25, 0xef,
-	0xb9, 0x3e, 0x93, 0xde, 0xa4, 0xcf, 0x75, 0x42, 0x7b, 0x13, 0x8d, 0x14, 0xdd, 0x88, 0xbd, 0x3d,
-	0x4c, 0x59, 0x31, 0x27, 0xa7, 0xe5, 0xe9, 0x61, 0xa5, 0xb8, 0x4d, 0xbc, 0x4e, 0x0b, 0xeb, 0x2c,
-	0xab, 0x98, 0x32, 0xb4, 0x0c, 0x48, 0x5f, 0xe7, 0x62, 0xef, 0xc5, 0xd9, 0xf2, 0x72, 0xba, 0xed,
-	0xb1, 0xad, 0x16, 0xed, 0x29, 0x25, 0xd8, 0xf4, 0xb6, 0xe3, 0xfa, 0xdd, 0x84, 0x09, 0x15, 0x57,
-	0x54, 0x8e, 0xba, 0x7c, 0x2b, 0xae, 0x9c, 0x58, 0x27, 0xcb, 0x93, 0x49, 0x97, 0x67, 0x0a, 0xb2,
-	0xac, 0xd3, 0xd6, 0x45, 0x13, 0xcb, 0xca, 0x16, 0x4c, 0x2d, 0xaf, 0x5c, 0x5f, 0x7f, 0x57, 0xcc,
-	0xe6, 0x78, 0x4a, 0x21, 0x18, 0xf7, 0x5c, 0xee, 0xca, 0x98, 0x87, 0x6d, 0xb9, 0xee, 0xe7, 0xc9,
-	0x24, 0xf2, 0x0c, 0xa6, 0x59, 0x36, 0xf5, 0x4a, 0x98, 0x85, 0x43, 0x34, 0x22, 0x5d, 0x2c, 0xeb,
-	0x9f, 0xb7, 0xd5, 0x47, 0xe5, 0xcb, 0x0c, 0x4c, 0x27, 0x52, 0xe9, 0x5d, 0x8b, 0x60, 0xbc, 0x41,
-	0x3c, 0xd5, 0xf9, 0xff, 0xd9, 0x72, 0x2d, 0x50, 0xb6, 0x88, 0x1f, 0xa3, 0x6c, 0x11, 0x5f, 0x58,
-	0xc9, 0xed, 0xac, 0x1a, 0x2a, 0xd7, 0x22, 0x4b, 0x10, 0x7a, 0xf8, 0x13, 0xd9, 0xc6, 0xac, 0xad,
-	0x3e, 0x84, 0xaf, 0x98, 0xfb, 0x13, 0x12, 0xba, 0x58, 0x0a, 0xbb, 0xae, 0xdb, 0xea, 0xe0, 0x62,
-	0x4e, 0xca, 0xd4, 0x07, 0xba, 0x01, 0x05, 0x1a, 0x11, 0xd2, 0x74, 0x08, 0x65, 0xb2, 0xf6, 0x93,
-	0xb5, 0xf9, 0x61, 0xad, 0xdc, 0x10, 0x0e, 0xef, 0x50, 0x66, 0xe7, 0xa9, 0x5e, 0x25, 0x4a, 0x50,
-	0x48, 0x95, 0xe0, 0x04, 0x14, 0x04, 0x15, 0x46, 0xdd, 0x06, 0x2e, 0x82, 0xda, 0x48, 0x7d, 0xc1,
-	0x5b, 0xe3, 0xf9, 0xcc, 0x54, 0xb6, 0x72, 0x1d, 0x72, 0x3a, 0xa2, 0xe0, 0x27, 0x06, 0x54, 0xdc,
-	0x45, 0xb1, 0x8e, 0x99, 0x64, 0x06, 0x4c, 0xe2, 0xbe, 0x64, 0x07, 0x7d, 0xa9, 0x6c, 0x40, 0x3e,
-	0x86, 0x85, 0x56, 0x21, 0x2b, 0xd8, 0x18, 0x72, 0x63, 0x9e, 0x19, 0x91, 0xcd, 0x4a, 0xe1, 0xc1,
-	0x1f, 0xa7, 0xc6, 0xbe, 0xfd, 0xfb, 0xfb, 0x73, 0x86, 0x2d, 0xdc, 0x6b, 0xbf, 0x00, 0xe4, 0x36,
-	0x71, 0xd4, 0x0d, 0x1a, 0x18, 0x7d, 0x67, 0xc0, 0x64, 0x62, 0xd6, 0xa0, 0xda, 0xb0, 0xa0, 0xbb,
-	0xe7, 0x55, 0x69, 0xf1, 0x40, 0x3e, 0x6a, 0x5b, 0x54, 0xaa, 0x5f, 0xfc, 0xf6, 0xd7, 0xd7, 0x99,
-	0xf3, 0xe8, 0xac, 0x35, 0xe4, 0x95, 0xdc, 0x1f, 0x75, 0xe8, 0x1b, 0x03, 0x60, 0x30, 0x5e, 0x51,
-	0x75, 0x84, 0xb4, 0xe9, 0xf9, 0x5c, 0xaa, 0x1d, 0xc4, 0x45, 0x03, 0xb5, 0x24, 0xd0, 0xb3, 0xe8,
-	0xcc, 0x30, 0xa0, 0x7a, 0xa8, 0xa3, 0x1f, 0x0c, 0xf8, 0x7f, 0xfa, 0xd2, 0x43, 0x97, 0x46, 0xc8,
-	0xbb, 0xfb, 0xfa, 0x2c, 0x2d, 0x1d, 0xd4, 0x4d, 0x43, 0xbe, 0x24, 0x21, 0x5b, 0x68, 0x61, 0x18,
-	0x64, 0x79, 0x2d, 0x32, 0xab, 0x25, 0x63, 0xa0, 0xfb, 0x06, 0x4c, 0xed, 0x7c, 0xa3, 0xa0, 0xcb,
-	0x23, 0x60, 0xd8, 0xeb, 0x25, 0x54, 0xba, 0x72, 0x70, 0x47, 0x0d, 0xff, 0xb2, 0x84, 0x5f, 0x45,
-	0xd6, 0x88, 0xf0, 0x3f, 0x55, 0x47, 0xf2, 0x33, 0xf4, 0xc8, 0x48, 0x3c, 0x44, 0x92, 0x2f, 0x66,
-	0x74, 0x75, 0xe4, 0x4a, 0xee, 0xf1, 0xa2, 0x2f, 0xbd, 0xfe, 0x82, 0xde, 0x9a, 0xcf, 0x55, 0xc9,
-	0x67, 0x09, 0x5d, 0x1c, 0xc6, 0x67, 0xf0, 0xd8, 0xc6, 0xbc, 0xdf, 0x95, 0x27, 0x86, 0x7c, 0x6d,
-	0xee, 0xf5, 0x4f, 0x0a, 0x5d, 0x1b, 0x01, 0xd8, 0x73, 0xfe, 0x05, 0x96, 0xde, 0x78, 0x61, 0x7f,
-	0x4d, 0xed, 0x9a, 0xa4, 0x76, 0x05, 0x2d, 0x1d, 0x8c, 0x5a, 0xbf, 0x63, 0x3f, 0x1a, 0x50, 0xe8,
-	0x5f, 0x19, 0xe8, 0xc2, 0x30, 0x38, 0x3b, 0x2f, 0xb2, 0x52, 0xf5, 0x00, 0x1e, 0x1a, 0xf2, 0x8d,
-	0x5f, 0x77, 0x5d, 0xc0, 0x4b, 0x92, 0xc5, 0x6b, 0xe8, 0xdc, 0x30, 0x16, 0x6e, 0xbd, 0x11, 0x38,
-	0xf2, 0x5f, 0xce, 0xca, 0xed, 0x07, 0x4f, 0xcb, 0xc6, 0xc3, 0xa7, 0x65, 0xe3, 0xcf, 0xa7, 0x65,
-	0xe3, 0xab, 0x67, 0xe5, 0xb1, 0x87, 0xcf, 0xca, 0x63, 0x8f, 0x9f, 0x95, 0xc7, 0x3e, 0x5c, 0xf4,
-	0x03, 0xbe, 0xd5, 0xa9, 0x8b, 0x17, 0x59, 0x1c, 0x6f, 0x90, 0xce, 0x6a, 0xb4, 0x02, 0x1c, 0x72,
-	0xcb, 0x8f, 0x68, 0xc3, 0x6a, 0xb4, 0x39, 0x53, 0x73, 0xb8, 0x3e, 0x21, 0xff, 0xb8, 0x2c, 0xfe,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xad, 0x63, 0x50, 0x37, 0x11, 0x00, 0x00,
+	// 1502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x5d, 0x6f, 0x1b, 0xc5,
+	0x1a, 0xce, 0xda, 0x69, 0x6c, 0x8f, 0x7b, 0x4e, 0x93, 0x49, 0x4e, 0xeb, 0xfa, 0xb4, 0xae, 0x8f,
+	0xa5, 0x43, 0xd3, 0x94, 0xec, 0xd6, 0x4e, 0x93, 0x56, 0xa8, 0x14, 0xc5, 0x4d, 0x49, 0x03, 0xfd,
+	0x08, 0x1b, 0x04, 0x12, 0x42, 0x5a, 0xad, 0x77, 0xc7, 0x9b, 0x55, 0xec, 0x9d, 0xe9, 0xce, 0xd8,
+	0x60, 0x21, 0x24, 0xc4, 0x0f, 0x40, 0x48, 0xfc, 0x05, 0x2e, 0xe0, 0x8e, 0x8b, 0x02, 0x57, 0x54,
+	0xe2, 0xae, 0xe2, 0xaa, 0x2a, 0x12, 0xaa, 0x7a, 0x81, 0x50, 0x8b, 0xc4, 0x7f, 0xe0, 0x0a, 0xcd,
+	0xc7, 0xae, 0xd7, 0xb1, 0x53, 0x3b, 0xbd, 0x83, 0x1b, 0x6b, 0xe6, 0xfd, 0x7c, 0x9e, 0xf7, 0x9d,
+	0x7d, 0x67, 0x0c, 0x96, 0x1c, 0x4c, 0xdb, 0x98, 0x1a, 0x0d, 0x9b, 0x22, 0x83, 0xa1, 0xc0, 0x45,
+	0x61, 0xdb, 0x0f, 0x98, 0xd1, 0xad, 0x36, 0x10, 0xb3, 0xab, 0xc6, 0xdd, 0x0e, 0x0a, 0x7b, 0x3a,
+	0x09, 0x31, 0xc3, 0xb0, 0x24, 0x6d, 0x75, 0x6e, 0xab, 0xf7, 0x6d, 0x75, 0x65, 0x5b, 0x5c, 0xf0,
+	0xb0, 0x87, 0x85, 0xa9, 0xc1, 0x57, 0xd2, 0xab, 0x78, 0xd2, 0xc3, 0xd8, 0x6b, 0x21, 0x43, 0xec,
+	0x1a, 0x9d, 0xa6, 0x61, 0x07, 0x2a, 0x60, 0xf1, 0x94, 0x52, 0xd9, 0xc4, 0x37, 0xec, 0x20, 0xc0,
+	0xcc, 0x66, 0x3e, 0x0e, 0xa8, 0xd2, 0xfe, 0xd7, 0xc1, 0x6d, 0xc4, 0x1a, 0x4d, 0x66, 0x90, 0x1a,
+	0x31, 0xba, 0x55, 0x83, 0xf5, 0x08, 0x8a, 0x94, 0xa7, 0x63, 0xa5, 0x90, 0xee, 0x57, 0x0f, 0xd0,
+	0x12, 0x1c, 0x62, 0x46, 0xc4, 0xf6, 0xfc, 0x40, 0x24, 0x1a, 0x65, 0x3b, 0xa2, 0x04, 0xc9, 0xb8,
+	0x27, 0xa5, 0xad, 0x25, 0x59, 0xca, 0x8d, 0x52, 0x95, 0x55, 0x18, 0xca, 0x70, 0x88, 0x46, 0xd5,
+	0x6f, 0x14, 0xe6, 0x46, 0x0b, 0x3b, 0x7b, 0x4a, 0x3d, 0x67, 0xb7, 0xfd, 0x00, 0x1b, 0xe2, 0x57,
+	0x8a, 0x2a, 0x9f, 0x68, 0xa0, 0xb4, 0x89, 0xd8, 0x3b, 0x76, 0xcb, 0x77, 0x6d, 0x86, 0xc3, 0x1d,
+	0xc4, 0xea, 0xbd, 0x1b, 0xc8, 0xf7, 0x76, 0x99, 0x89, 0xee, 0x76, 0x10, 0x65, 0xf0, 0x38, 0x98,
+	0xd9, 0x15, 0x82, 0x82, 0x56, 0xd6, 0x16, 0xd3, 0xa6, 0xda, 0xc1, 0xd7, 0x01, 0xe8, 0x33, 0x2d,
+	0xa4, 0xca, 0xda, 0x62, 0xbe, 0xf6, 0x92, 0x9e, 0xec, 0xa0, 0x84, 0xa6, 0x80, 0xea, 0xdb, 0xb6,
+	0x87, 0x54, 0x4c, 0x33, 0xe1, 0x59, 0x79, 0xac, 0x81, 0x33, 0x07, 0x42, 0xa0, 0x04, 0x07, 0x14,
+	0xc1, 0xff, 0x81, 0xa3, 0x82, 0x88, 0x35, 0x80, 0x24, 0x2f, 0x64, 0xd2, 0x14, 0x6e, 0x01, 0xd0,
+	0x8d, 0x42, 0xd0, 0x42, 0xaa, 0x9c, 0x5e, 0xcc, 0xd7, 0xce, 0xe9, 0xcf, 0x3f, 0x50, 0x7a, 0x9c,
+	0xd4, 0x4c, 0x38, 0xc3, 0xcd, 0x01, 0x66, 0x69, 0xc1, 0xec, 0xec, 0x58, 0x66, 0x12, 0xea, 0x00,
+	0xb5, 0x26, 0x38, 0xb5, 0x89, 0xd8, 0x4d, 0x9b, 0x21, 0x3a, 0xc0, 0x2f, 0x2a, 0xed, 0x60, 0x09,
+	0xb5, 0x17, 0x2e, 0xe1, 0x2f, 0x1a, 0x38, 0x7d, 0x40, 0xa2, 0xbf, 0x77, 0x01, 0xef, 0x6b, 0x20,
+	0x17, 0xa7, 0x80, 0x35, 0x90, 0xb1, 0x5d, 0x37, 0x44, 0x94, 0x0a, 0xfc, 0xb9, 0x7a, 0xe1, 0xd1,
+	0xbd, 0xe5, 0x05, 0x15, 0x76, 0x5d, 0x6a, 0x76, 0x58, 0xe8, 0x07, 0x9e, 0x19, 0x19, 0xc2, 0x65,
+	0x90, 0x21, 0x9d, 0x86, 0xb5, 0x87, 0x7a, 0xea, 0x88, 0x2e, 0xe8, 0x72, 0x26, 0xe8, 0xd1, 0xb8,
+	0xd0, 0xd7, 0x83, 0x9e, 0x39, 0x43, 0x3a, 0x8d, 0x37, 0x51, 0x8f, 0xd7, 0xa9, 0x8b, 0x99, 0x1f,
+	0x78, 0x16, 0xc1, 0x1f, 0xa0, 0x50, 0x60, 0x4f, 0x9b, 0x79, 0x29, 0xdb, 0xe6, 0x22, 0x78, 0x1e,
+	0xcc, 0x91, 0x10, 0x13, 0x4c, 0x51, 0x68, 0x91, 0xd0, 0xc7, 0xa1, 0xcf, 0x7a, 0x85, 0x69, 0x61,
+	0x37, 0x1b, 0x29, 0xb6, 0x95, 0xbc, 0x52, 0x05, 0x27, 0x36, 0x11, 0xab, 0xf3, 0x32, 0x4f, 0xf8,
+	0x5d, 0x55, 0x9e, 0x68, 0xa0, 0x30, 0xec, 0xa3, 0xfa, 0xb8, 0x0a, 0xb2, 0xb2, 0x8f, 0xbe, 0xab,
+	0xce, 0x4b, 0x51, 0x8f, 0x3e, 0x7a, 0x5d, 0xce, 0x91, 0x6e, 0x55, 0x17, 0xbe, 0x5b, 0x1b, 0x66,
+	0x46, 0xd8, 0x6e, 0xb9, 0x50, 0x07, 0x47, 0xc4, 0x52, 0xd5, 0xa0, 0x70, 0x90, 0x8f, 0x29, 0xcd,
+	0xe0, 0xbb, 0x20, 0x47, 0xdd, 0x3d, 0x4b, 0xfa, 0xc8, 0xfe, 0xfd, 0x7f, 0xdc, 0x51, 0x90, 0x80,
+	0xe7, 0x9f, 0xdc, 0x5b, 0x3e, 0x26, 0x2d, 0x97, 0xa9, 0xbb, 0x57, 0xbe, 0xa0, 0x5f, 0xbc, 0x64,
+	0x66, 0xa9, 0xbb, 0x27, 0xd4, 0x95, 0x13, 0xe0, 0x3f, 0xf1, 0x41, 0x95, 0x19, 0x65, 0x35, 0xf8,
+	0x14, 0x38, 0xbe, 0x5f, 0xf3, 0x0f, 0xe1, 0x3c, 0x0f, 0xe6, 0x36, 0x11, 0xdb, 0xe9, 0x05, 0x0e,
+	0x3f, 0x99, 0x8a, 0xaf, 0x0e, 0x60, 0x52, 0xa8, 0xa8, 0x16, 0x40, 0x86, 0x4a, 0x91, 0x60, 0x9a,
+	0x35, 0xa3, 0x6d, 0x65, 0x41, 0xd8, 0xdf, 0xc6, 0x2e, 0xda, 0x0a, 0x9a, 0x38, 0x8a, 0xf2, 0xa3,
+	0x06, 0xe6, 0x07, 0xc4, 0x2a, 0xce, 0x4d, 0x30, 0xe7, 0xa2, 0xa6, 0xdd, 0x69, 0x31, 0x2b, 0xc0,
+	0x2e, 0xb2, 0xfc, 0xa0, 0x89, 0x55, 0xed, 0xca, 0xfd, 0x3a, 0x90, 0x1a, 0xe1, 0x55, 0xd8, 0x90,
+	0x96, 0x71, 0x90, 0x63, 0xee, 0xa0, 0x00, 0xbe, 0x0f, 0xe6, 0x6d, 0x42, 0x5a, 0xbe, 0x23, 0x3e,
+	0x4a, 0xab, 0x8b, 0x42, 0xda, 0x1f, 0xf9, 0xe7, 0xc7, 0x8e, 0x08, 0x69, 0x2e, 0x42, 0xc3, 0x44,
+	0x1c, 0x25, 0xaf, 0xfc, 0x90, 0x02, 0xf9, 0x84, 0x0d, 0x84, 0x60, 0x3a, 0xb0, 0xdb, 0x48, 0x7e,
+	0xe2, 0xa6, 0x58, 0xc3, 0x93, 0x20, 0x6b, 0x13, 0x62, 0x09, 0x79, 0x4a, 0xc8, 0x33, 0x36, 0x21,
+	0xb7, 0xb9, 0xaa, 0x00, 0x32, 0x11, 0xa0, 0xb4, 0xd4, 0xa8, 0x2d, 0x3c, 0x0d, 0x80, 0xe7, 0x33,
+	0xcb, 0xc1, 0xed, 0xb6, 0xcf, 0xc4, 0x17, 0x9a, 0x33, 0x73, 0x9e, 0xcf, 0xae, 0x09, 0x01, 0x57,
+	0x37, 0x3a, 0x7e, 0xcb, 0xb5, 0x98, 0xed, 0xd1, 0xc2, 0x11, 0xa9, 0x16, 0x92, 0xb7, 0x6d, 0x8f,
+	0x0a, 0x6f, 0x1c, 0x73, 0x9d, 0x51, 0xde, 0x58, 0x21, 0x85, 0xd7, 0x23, 0x6f, 0x17, 0x11, 0x5a,
+	0xc8, 0x94, 0xd3, 0x43, 0xa3, 0x7b, 0x44, 0x29, 0x6e, 0x61, 0xb7, 0xd3, 0x42, 0x2a, 0xcb, 0x06,
+	0x22, 0x14, 0xae, 0x03, 0xa8, 0x2e, 0x7c, 0x7e, 0xf6, 0xa2, 0x6c, 0x59, 0x31, 0xdd, 0x46, 0x1c,
+	0xab, 0x15, 0x73, 0x56, 0x0a, 0x76, 0xdc, 0xbd, 0xa8, 0x7e, 0x37, 0xc0, 0x8c, 0x8c, 0xcb, 0x2b,
+	0x47, 0x6c, 0xb6, 0x1b, 0x55, 0x8e, 0xaf, 0x93, 0xe5, 0x49, 0x0d, 0x96, 0x67, 0x16, 0xa4, 0x69,
+	0xa7, 0xad, 0x8a, 0xc6, 0x97, 0x95, 0x5d, 0x30, 0xbb, 0x5e, 0xbf, 0xb6, 0xf5, 0x16, 0x9f, 0xcd,
+	0xd1, 0x94, 0x82, 0x60, 0xda, 0xb5, 0x99, 0x2d, 0x62, 0x1e, 0x35, 0xc5, 0x3a, 0xce, 0x93, 0x4a,
+	0xe4, 0xe9, 0x4f, 0xb3, 0xf4, 0xc0, 0x2b, 0x61, 0x01, 0x1c, 0x21, 0x21, 0xee, 0x22, 0x51, 0xff,
+	0xac, 0x29, 0x37, 0x95, 0xcf, 0x52, 0x60, 0x2e, 0x91, 0x4a, 0x9d, 0x5a, 0x08, 0xa6, 0x1d, 0xec,
+	0xca, 0xce, 0xff, 0xcb, 0x14, 0x6b, 0x8e, 0xb2, 0x85, 0xbd, 0x08, 0x65, 0x0b, 0x7b, 0xdc, 0x4a,
+	0x1c, 0x67, 0xd9, 0x50, 0xb1, 0xe6, 0x59, 0xfc, 0xc0, 0x45, 0x1f, 0x8a, 0x36, 0xa6, 0x4d, 0xb9,
+	0xe1, 0xbe, 0x7c, 0xee, 0xcf, 0x08, 0xe8, 0x7c, 0xc9, 0xed, 0xba, 0x76, 0xab, 0x83, 0x0a, 0x19,
+	0x21, 0x93, 0x1b, 0x78, 0x1d, 0xe4, 0x48, 0x88, 0x71, 0xd3, 0xc2, 0x84, 0x8a, 0xda, 0xe7, 0x6b,
+	0x8b, 0xe3, 0x5a, 0xb9, 0xcd, 0x1d, 0xee, 0x10, 0x6a, 0x66, 0x89, 0x5a, 0x25, 0x4a, 0x90, 0x1b,
+	0x28, 0xc1, 0x29, 0x90, 0xe3, 0x54, 0x28, 0xb1, 0x1d, 0x54, 0x00, 0xf2, 0x20, 0xc5, 0x82, 0x37,
+	0xa6, 0xb3, 0xa9, 0xd9, 0x74, 0xe5, 0x1a, 0xc8, 0xa8, 0x88, 0x9c, 0x1f, 0x1f, 0x50, 0x51, 0x17,
+	0xf9, 0x3a, 0x62, 0x92, 0xea, 0x33, 0x89, 0xfa, 0x92, 0xee, 0xf7, 0xa5, 0xb2, 0x0d, 0xb2, 0x11,
+	0x2c, 0xb8, 0x01, 0xd2, 0x9c, 0x8d, 0x56, 0x4e, 0x0f, 0xdd, 0xbd, 0x07, 0xb3, 0xa9, 0xe7, 0x1e,
+	0xfc, 0x7a, 0x66, 0xea, 0xab, 0x3f, 0xbe, 0x59, 0xd2, 0x4c, 0xee, 0x5e, 0xfb, 0x33, 0x0f, 0x32,
+	0x3b, 0x28, 0xec, 0xfa, 0x0e, 0x82, 0x5f, 0x6b, 0x20, 0x9f, 0x98, 0x35, 0xb0, 0x36, 0x2e, 0xe8,
+	0xf0, 0xbc, 0x2a, 0xae, 0x1c, 0xca, 0x47, 0x1e, 0x8b, 0x4a, 0xf5, 0xd3, 0x9f, 0x7f, 0xff, 0x22,
+	0x75, 0x1e, 0x9e, 0x33, 0xc6, 0xbc, 0xa3, 0xe3, 0x51, 0x07, 0xbf, 0xd4, 0x00, 0xe8, 0x8f, 0x57,
+	0x58, 0x9d, 0x20, 0xed, 0xe0, 0x7c, 0x2e, 0xd6, 0x0e, 0xe3, 0xa2, 0x80, 0x1a, 0x02, 0xe8, 0x39,
+	0x78, 0x76, 0x1c, 0x50, 0x35, 0xd4, 0xe1, 0x77, 0x1a, 0xf8, 0xf7, 0xe0, 0xa5, 0x07, 0x57, 0x27,
+	0xc8, 0x3b, 0x7c, 0x7d, 0x16, 0xd7, 0x0e, 0xeb, 0xa6, 0x20, 0xaf, 0x0a, 0xc8, 0x06, 0x5c, 0x1e,
+	0x07, 0x59, 0x5c, 0x8b, 0xd4, 0x68, 0x89, 0x18, 0xf0, 0xbe, 0x06, 0x66, 0xf7, 0xbf, 0x51, 0xe0,
+	0xa5, 0x09, 0x30, 0x8c, 0x7a, 0x09, 0x15, 0x2f, 0x1f, 0xde, 0x51, 0xc1, 0xbf, 0x24, 0xe0, 0x57,
+	0xa1, 0x31, 0x21, 0xfc, 0x8f, 0xe4, 0x27, 0xf9, 0x31, 0x7c, 0xa4, 0x25, 0x1e, 0x22, 0xc9, 0x17,
+	0x33, 0xbc, 0x32, 0x71, 0x25, 0x47, 0xbc, 0xe8, 0x8b, 0xaf, 0xbe, 0xa0, 0xb7, 0xe2, 0x73, 0x45,
+	0xf0, 0x59, 0x83, 0x17, 0xc7, 0xf1, 0xe9, 0x3f, 0xb6, 0x11, 0x8b, 0xbb, 0xf2, 0x44, 0x13, 0xaf,
+	0xcd, 0x51, 0xff, 0xa4, 0xe0, 0xd5, 0x09, 0x80, 0x3d, 0xe7, 0x5f, 0x60, 0xf1, 0xb5, 0x17, 0xf6,
+	0x57, 0xd4, 0xae, 0x0a, 0x6a, 0x97, 0xe1, 0xda, 0xe1, 0xa8, 0xc5, 0x1d, 0xfb, 0x5e, 0x03, 0xb9,
+	0xf8, 0xca, 0x80, 0x17, 0xc6, 0xc1, 0xd9, 0x7f, 0x91, 0x15, 0xab, 0x87, 0xf0, 0x50, 0x90, 0xaf,
+	0xff, 0x34, 0x74, 0x01, 0xaf, 0x09, 0x16, 0x2f, 0xc3, 0xa5, 0x71, 0x2c, 0xec, 0x86, 0xe3, 0x5b,
+	0xe2, 0x5f, 0x0e, 0xfc, 0x56, 0x03, 0xc7, 0x76, 0x18, 0x0e, 0x91, 0x88, 0x5e, 0xb7, 0x99, 0xb3,
+	0x0b, 0xe3, 0x89, 0x2c, 0xfe, 0xcc, 0xc7, 0x18, 0xfa, 0x16, 0x11, 0xec, 0xc5, 0xf1, 0x86, 0x0a,
+	0xed, 0x9d, 0x21, 0xb4, 0xab, 0x72, 0x72, 0xae, 0xbd, 0xa2, 0x2d, 0x55, 0xaa, 0x63, 0x67, 0x12,
+	0x8f, 0x2f, 0x11, 0x5b, 0x0d, 0x1e, 0xb8, 0x7e, 0xeb, 0xc1, 0xd3, 0x92, 0xf6, 0xf0, 0x69, 0x49,
+	0xfb, 0xed, 0x69, 0x49, 0xfb, 0xfc, 0x59, 0x69, 0xea, 0xe1, 0xb3, 0xd2, 0xd4, 0xe3, 0x67, 0xa5,
+	0xa9, 0xf7, 0x56, 0x3c, 0x9f, 0xed, 0x76, 0x1a, 0xfc, 0x25, 0x19, 0x85, 0xed, 0x27, 0x36, 0x9c,
+	0x96, 0x8f, 0x02, 0x66, 0x78, 0x21, 0x71, 0x0c, 0xa7, 0xcd, 0xa8, 0xbc, 0x3f, 0x1a, 0x33, 0xe2,
+	0x0f, 0xd7, 0xca, 0x5f, 0x03, 0x00, 0x2f, 0xcd, 0x14, 0x22, 0x11, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1312,6 +1317,10 @@ type ServiceClient interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// StoreQueryBatch queries the values of a batch of keys, across multiple
+	// stores, at the same height, along with their Merkle proofs up to the app
+	// hash if requested, in a single call.
+	StoreQueryBatch(ctx context.Context, in *types.QueryBatchRequest, opts ...grpc.CallOption) (*types.QueryBatchResponse, error)
 }
 
 type serviceClient struct {
@@ -1385,6 +1394,15 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) StoreQueryBatch(ctx context.Context, in *types.QueryBatchRequest, opts ...grpc.CallOption) (*types.QueryBatchResponse, error) {
+	out := new(types.QueryBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/StoreQueryBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetNodeInfo queries the current node info.
@@ -1403,6 +1421,10 @@ type ServiceServer interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// StoreQueryBatch queries the values of a batch of keys, across multiple
+	// stores, at the same height, along with their Merkle proofs up to the app
+	// hash if requested, in a single call.
+	StoreQueryBatch(context.Context, *types.QueryBatchRequest) (*types.QueryBatchResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1430,6 +1452,9 @@ func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context,
 func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (*UnimplementedServiceServer) StoreQueryBatch(ctx context.Context, req *types.QueryBatchRequest) (*types.QueryBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreQueryBatch not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1561,6 +1586,24 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StoreQueryBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.QueryBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreQueryBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/StoreQueryBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreQueryBatch(ctx, req.(*types.QueryBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1593,6 +1636,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "StoreQueryBatch",
+			Handler:    _Service_StoreQueryBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
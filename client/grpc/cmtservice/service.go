@@ -9,6 +9,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -236,7 +239,7 @@ func (s queryServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABC
 
 	if path := baseapp.SplitABCIQueryPath(req.Path); len(path) > 0 {
 		switch path[0] {
-		case baseapp.QueryPathApp, baseapp.QueryPathStore, baseapp.QueryPathP2P, baseapp.QueryPathCustom:
+		case baseapp.QueryPathApp, baseapp.QueryPathStore, baseapp.QueryPathStoreBatch, baseapp.QueryPathP2P, baseapp.QueryPathCustom:
 			// valid path
 
 		default:
@@ -253,6 +256,40 @@ func (s queryServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABC
 	return FromABCIResponseQuery(res), nil
 }
 
+func (s queryServer) StoreQueryBatch(ctx context.Context, req *storetypes.QueryBatchRequest) (*storetypes.QueryBatchResponse, error) {
+	if s.queryFn == nil {
+		return nil, status.Error(codes.Internal, "ABCI Query handler undefined")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.queryFn(ctx, &abci.QueryRequest{
+		Path:   "/" + baseapp.QueryPathStoreBatch,
+		Data:   data,
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	batchRes := &storetypes.QueryBatchResponse{}
+	if err := batchRes.Unmarshal(res.Value); err != nil {
+		return nil, err
+	}
+
+	return batchRes, nil
+}
+
 // RegisterTendermintService registers the CometBFT queries on the gRPC router.
 func RegisterTendermintService(
 	clientCtx client.Context,
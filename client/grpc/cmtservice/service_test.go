@@ -0,0 +1,51 @@
+package cmtservice
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestStoreQueryBatch(t *testing.T) {
+	var abciReq *abci.QueryRequest
+	queryFn := func(_ context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+		abciReq = req
+
+		var batchReq storetypes.QueryBatchRequest
+		require.NoError(t, batchReq.Unmarshal(req.Data))
+		if len(batchReq.Keys) == 0 {
+			return sdkerrors.QueryResult(sdkerrors.ErrInvalidRequest, false), nil
+		}
+
+		res := &storetypes.QueryBatchResponse{Height: req.Height}
+		for _, key := range batchReq.Keys {
+			res.Results = append(res.Results, &storetypes.QueryBatchResult{StoreName: key.StoreName, Key: key.Key, Value: key.Key})
+		}
+		bz, err := res.Marshal()
+		require.NoError(t, err)
+
+		return &abci.QueryResponse{Height: req.Height, Value: bz}, nil
+	}
+	svr := NewQueryServer(client.Context{}, nil, queryFn)
+
+	res, err := svr.StoreQueryBatch(context.Background(), &storetypes.QueryBatchRequest{
+		Height: 5,
+		Prove:  true,
+		Keys:   []*storetypes.QueryBatchKey{{StoreName: "bank", Key: []byte("key")}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/store_batch", abciReq.Path)
+	require.True(t, abciReq.Prove)
+	require.Equal(t, int64(5), res.Height)
+	require.Equal(t, []*storetypes.QueryBatchResult{{StoreName: "bank", Key: []byte("key"), Value: []byte("key")}}, res.Results)
+
+	_, err = svr.StoreQueryBatch(context.Background(), &storetypes.QueryBatchRequest{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -1,19 +1,72 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/p2p"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	"cosmossdk.io/store/snapshots"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, opts ...Option) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, opts...))
+}
+
+// Option configures the application information reported by the node service.
+type Option func(*queryServer)
+
+// WithAppVersion sets the function used to retrieve the protocol version of
+// the application, typically BaseApp.AppVersion.
+func WithAppVersion(appVersion func(context.Context) (uint64, error)) Option {
+	return func(s *queryServer) {
+		s.appVersion = appVersion
+	}
+}
+
+// WithModuleVersions sets the consensus versions of the application modules.
+func WithModuleVersions(moduleVersions map[string]uint64) Option {
+	return func(s *queryServer) {
+		s.moduleVersions = moduleVersions
+	}
+}
+
+// WithSnapshotManager sets the snapshot manager used to report the available
+// state sync snapshots.
+func WithSnapshotManager(snapshotManager *snapshots.Manager) Option {
+	return func(s *queryServer) {
+		s.snapshotManager = snapshotManager
+	}
+}
+
+// WithAnteHandlerDescription sets the function used to retrieve the
+// composition of the application AnteHandler, typically
+// BaseApp.AnteHandlerDescription.
+func WithAnteHandlerDescription(anteHandlerDescription func() []sdk.AnteDecoratorDescription) Option {
+	return func(s *queryServer) {
+		s.anteHandlerDescription = anteHandlerDescription
+	}
+}
+
+// WithNodeKeyFile sets the path of the node key file, typically the
+// node_key_file of the CometBFT configuration, whose key signs the version
+// attestations. The key is loaded when queried.
+func WithNodeKeyFile(nodeKeyFile string) Option {
+	return func(s *queryServer) {
+		s.nodeKeyFile = nodeKeyFile
+	}
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -27,12 +80,25 @@ var _ ServiceServer = queryServer{}
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+
+	appVersion             func(context.Context) (uint64, error)
+	moduleVersions         map[string]uint64
+	snapshotManager        *snapshots.Manager
+	anteHandlerDescription func() []sdk.AnteDecoratorDescription
+	nodeKeyFile            string
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
-	return queryServer{
+func NewQueryServer(clientCtx client.Context, cfg config.Config, opts ...Option) ServiceServer {
+	s := queryServer{
 		clientCtx: clientCtx,
+		cfg:       cfg,
 	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
@@ -62,3 +128,162 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) Health(ctx context.Context, _ *HealthRequest) (*HealthResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	res := &HealthResponse{
+		Version:            version.NewInfo().Version,
+		Pruning:            s.cfg.Pruning,
+		PruningKeepRecent:  s.cfg.PruningKeepRecent,
+		PruningInterval:    s.cfg.PruningInterval,
+		SnapshotInterval:   s.cfg.StateSync.SnapshotInterval,
+		SnapshotKeepRecent: s.cfg.StateSync.SnapshotKeepRecent,
+		MinimumGasPrice:    sdkCtx.MinGasPrices().String(),
+		HaltHeight:         s.cfg.HaltHeight,
+	}
+
+	if s.appVersion != nil {
+		appVersion, err := s.appVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.AppVersion = appVersion
+	}
+
+	res.ModuleVersions = s.sortedModuleVersions()
+
+	if s.snapshotManager != nil {
+		list, err := s.snapshotManager.List()
+		if err != nil {
+			return nil, err
+		}
+
+		res.SnapshotCount = uint32(len(list))
+		for _, snapshot := range list {
+			if snapshot.Height > res.LatestSnapshotHeight {
+				res.LatestSnapshotHeight = snapshot.Height
+			}
+		}
+	}
+
+	if s.clientCtx.Client != nil {
+		status, err := s.clientCtx.Client.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		res.EarliestBlockHeight = uint64(status.SyncInfo.EarliestBlockHeight)
+		res.LatestBlockHeight = uint64(status.SyncInfo.LatestBlockHeight)
+		res.CatchingUp = status.SyncInfo.CatchingUp
+	}
+
+	return res, nil
+}
+
+func (s queryServer) AnteHandler(_ context.Context, _ *AnteHandlerRequest) (*AnteHandlerResponse, error) {
+	res := &AnteHandlerResponse{}
+	if s.anteHandlerDescription == nil {
+		return res, nil
+	}
+
+	for _, d := range s.anteHandlerDescription() {
+		res.Decorators = append(res.Decorators, &AnteDecorator{Name: d.Name, Config: d.Config})
+	}
+
+	return res, nil
+}
+
+func (s queryServer) Attestation(ctx context.Context, req *AttestationRequest) (*AttestationResponse, error) {
+	if s.nodeKeyFile == "" {
+		return nil, errors.New("version attestation is not supported by the node: no node key configured")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	info := version.NewInfo()
+	attestation := &VersionAttestation{
+		Name:           info.Name,
+		AppName:        info.AppName,
+		Version:        info.Version,
+		GitCommit:      info.GitCommit,
+		ModuleVersions: s.sortedModuleVersions(),
+		ChainId:        sdkCtx.ChainID(),
+		Height:         sdkCtx.BlockHeight(),
+		Nonce:          req.Nonce,
+	}
+
+	if s.appVersion != nil {
+		appVersion, err := s.appVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attestation.AppVersion = appVersion
+	}
+
+	bz, err := attestation.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeKey, err := p2p.LoadNodeKey(s.nodeKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the node key: %w", err)
+	}
+
+	signature, err := nodeKey.PrivKey.Sign(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := nodeKey.PubKey()
+	return &AttestationResponse{
+		Attestation: bz,
+		Signature:   signature,
+		PubKey:      pubKey.Bytes(),
+		PubKeyType:  pubKey.Type(),
+		NodeId:      string(nodeKey.ID()),
+	}, nil
+}
+
+// sortedModuleVersions returns the consensus versions of the application
+// modules, sorted by module name.
+func (s queryServer) sortedModuleVersions() []*ModuleVersion {
+	var versions []*ModuleVersion
+	for name, v := range s.moduleVersions {
+		versions = append(versions, &ModuleVersion{Name: name, Version: v})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Name < versions[j].Name
+	})
+
+	return versions
+}
+
+// VerifyAttestation verifies the signature of the attestation returned by the
+// Attestation query, and that it was made by the node with the given ID and
+// for the given nonce, and returns the attested versions.
+func VerifyAttestation(res *AttestationResponse, nodeID string, nonce []byte) (*VersionAttestation, error) {
+	pubKey, err := cryptoenc.PubKeyFromTypeAndBytes(res.PubKeyType, res.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if id := string(p2p.PubKeyToID(pubKey)); id != nodeID {
+		return nil, fmt.Errorf("attestation signed by node %s, expected %s", id, nodeID)
+	}
+
+	if !pubKey.VerifySignature(res.Attestation, res.Signature) {
+		return nil, errors.New("invalid attestation signature")
+	}
+
+	attestation := &VersionAttestation{}
+	if err := attestation.Unmarshal(res.Attestation); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(attestation.Nonce, nonce) {
+		return nil, errors.New("attestation nonce does not match")
+	}
+
+	return attestation, nil
+}
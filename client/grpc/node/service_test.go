@@ -1,8 +1,11 @@
 package node
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -21,3 +24,101 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_Health(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Pruning = "custom"
+	cfg.PruningKeepRecent = "100"
+	cfg.PruningInterval = "10"
+	cfg.HaltHeight = 1000
+	cfg.StateSync.SnapshotInterval = 500
+
+	svr := NewQueryServer(
+		client.Context{},
+		*cfg,
+		WithAppVersion(func(context.Context) (uint64, error) { return 2, nil }),
+		WithModuleVersions(map[string]uint64{"bank": 4, "auth": 5}),
+	)
+	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
+
+	resp, err := svr.Health(ctx, &HealthRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.AppVersion)
+	require.Equal(t, []*ModuleVersion{{Name: "auth", Version: 5}, {Name: "bank", Version: 4}}, resp.ModuleVersions)
+	require.Equal(t, "custom", resp.Pruning)
+	require.Equal(t, "100", resp.PruningKeepRecent)
+	require.Equal(t, "10", resp.PruningInterval)
+	require.Equal(t, uint64(500), resp.SnapshotInterval)
+	require.Equal(t, cfg.StateSync.SnapshotKeepRecent, resp.SnapshotKeepRecent)
+	require.Zero(t, resp.SnapshotCount)
+	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
+	require.Equal(t, uint64(1000), resp.HaltHeight)
+}
+
+func TestServiceServer_AnteHandler(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+
+	resp, err := svr.AnteHandler(context.Background(), &AnteHandlerRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Decorators)
+
+	svr = NewQueryServer(
+		client.Context{},
+		*config.DefaultConfig(),
+		WithAnteHandlerDescription(func() []sdk.AnteDecoratorDescription {
+			return []sdk.AnteDecoratorDescription{
+				{Name: "ante.SetUpContextDecorator"},
+				{Name: "ante.UnorderedTxDecorator", Config: "max_unordered_ttl=10"},
+			}
+		}),
+	)
+
+	resp, err = svr.AnteHandler(context.Background(), &AnteHandlerRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*AnteDecorator{
+		{Name: "ante.SetUpContextDecorator"},
+		{Name: "ante.UnorderedTxDecorator", Config: "max_unordered_ttl=10"},
+	}, resp.Decorators)
+}
+
+func TestServiceServer_Attestation(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+	ctx := sdk.Context{}.WithBlockHeight(10).WithChainID("test-chain")
+
+	_, err := svr.Attestation(ctx, &AttestationRequest{})
+	require.ErrorContains(t, err, "no node key configured")
+
+	nodeKeyFile := filepath.Join(t.TempDir(), "node_key.json")
+	nodeKey, err := p2p.LoadOrGenNodeKey(nodeKeyFile)
+	require.NoError(t, err)
+
+	svr = NewQueryServer(
+		client.Context{},
+		*config.DefaultConfig(),
+		WithAppVersion(func(context.Context) (uint64, error) { return 2, nil }),
+		WithModuleVersions(map[string]uint64{"bank": 4, "auth": 5}),
+		WithNodeKeyFile(nodeKeyFile),
+	)
+
+	nonce := []byte("nonce")
+	resp, err := svr.Attestation(ctx, &AttestationRequest{Nonce: nonce})
+	require.NoError(t, err)
+	require.Equal(t, string(nodeKey.ID()), resp.NodeId)
+
+	attestation, err := VerifyAttestation(resp, string(nodeKey.ID()), nonce)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), attestation.AppVersion)
+	require.Equal(t, []*ModuleVersion{{Name: "auth", Version: 5}, {Name: "bank", Version: 4}}, attestation.ModuleVersions)
+	require.Equal(t, "test-chain", attestation.ChainId)
+	require.Equal(t, int64(10), attestation.Height)
+
+	_, err = VerifyAttestation(resp, string(nodeKey.ID()), []byte("other nonce"))
+	require.ErrorContains(t, err, "nonce does not match")
+
+	_, err = VerifyAttestation(resp, "other", nonce)
+	require.ErrorContains(t, err, "expected other")
+
+	resp.Attestation[len(resp.Attestation)-1]++
+	_, err = VerifyAttestation(resp, string(nodeKey.ID()), nonce)
+	require.ErrorContains(t, err, "invalid attestation signature")
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+const (
+	// DefaultInitialBackoff is the default delay before the first retry of a
+	// failed query.
+	DefaultInitialBackoff = 100 * time.Millisecond
+	// DefaultMaxBackoff is the default maximum delay between two retries of a
+	// failed query.
+	DefaultMaxBackoff = 5 * time.Second
+)
+
+// broadcastTxMethod is the method of the tx service broadcasting a
+// transaction, which is never retried.
+const broadcastTxMethod = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+
+// RetryOptions configures the retries of the queries failing with a transient
+// error.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of retries of a query. Zero disables
+	// the retries.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry, doubled on each
+	// retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between two retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryOptions returns the default RetryOptions, with the given maximum
+// number of retries.
+func DefaultRetryOptions(maxRetries int) RetryOptions {
+	return RetryOptions{
+		MaxRetries:     maxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+	}
+}
+
+// WithQueryInterceptors returns a dial option installing on a gRPC connection
+// the interceptors pinning the queries of a batch to the same height, see
+// QueryBatchContext, and retrying the queries failing with a transient error.
+func WithQueryInterceptors(opts RetryOptions) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(HeightPinningUnaryInterceptor(), RetryUnaryInterceptor(opts))
+}
+
+// isTransientError returns true if the error is likely to be resolved by
+// retrying the call.
+func isTransientError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryUnaryInterceptor returns a gRPC interceptor retrying the calls failing
+// with a transient error, i.e. an unavailable or overloaded node, with an
+// exponential backoff. The broadcasts of transactions are not retried, since
+// a broadcast failing may have reached the node.
+func RetryUnaryInterceptor(opts RetryOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		backoff := opts.InitialBackoff
+		for retry := 0; ; retry++ {
+			err := invoker(ctx, method, req, reply, cc, callOpts...)
+			if err == nil || retry >= opts.MaxRetries || method == broadcastTxMethod || !isTransientError(err) {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+
+			backoff = min(2*backoff, opts.MaxBackoff)
+		}
+	}
+}
+
+// heightPin holds the height the queries of a batch are pinned to, 0 until
+// the first query of the batch returns.
+type heightPin struct {
+	mtx    sync.Mutex
+	height int64
+}
+
+type heightPinKey struct{}
+
+// QueryBatchContext returns a context pinning all the queries made with it to
+// the same block height, so that they return a consistent view of the state.
+// The height is the one of the first query response, unless a height is set
+// with the block height header of the context. The queries are pinned by the
+// HeightPinningUnaryInterceptor on gRPC connections, and by Context when
+// querying through CometBFT.
+func QueryBatchContext(ctx context.Context) context.Context {
+	pin := &heightPin{}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			pin.height, _ = strconv.ParseInt(heights[0], 10, 64)
+		}
+	}
+
+	return context.WithValue(ctx, heightPinKey{}, pin)
+}
+
+// QueryBatchHeight returns the height the queries made with a context returned
+// by QueryBatchContext are pinned to, or 0 if no query returned yet.
+func QueryBatchHeight(ctx context.Context) int64 {
+	pin, ok := ctx.Value(heightPinKey{}).(*heightPin)
+	if !ok {
+		return 0
+	}
+
+	pin.mtx.Lock()
+	defer pin.mtx.Unlock()
+	return pin.height
+}
+
+// HeightPinningUnaryInterceptor returns a gRPC interceptor pinning the queries
+// made with a context returned by QueryBatchContext to the same block height,
+// by propagating the block height header of the first query response to the
+// next queries. The other queries are left untouched.
+func HeightPinningUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		pin, ok := ctx.Value(heightPinKey{}).(*heightPin)
+		if !ok || method == broadcastTxMethod {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		pin.mtx.Lock()
+		if height := pin.height; height > 0 {
+			pin.mtx.Unlock()
+			if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		// the concurrent queries of the batch wait for the first one to
+		// return the height to pin.
+		defer pin.mtx.Unlock()
+		var md metadata.MD
+		if err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&md))...); err != nil {
+			return err
+		}
+
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			if height, err := strconv.ParseInt(heights[0], 10, 64); err == nil && height > 0 {
+				pin.height = height
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// heightNodeService is a node service producing a block on each query, and
+// reporting in the minimum gas price the height a query was served at. The
+// first queries, up to failures, fail as unavailable.
+type heightNodeService struct {
+	node.UnimplementedServiceServer
+
+	mtx      sync.Mutex
+	height   int64
+	failures int
+	calls    int
+}
+
+func (s *heightNodeService) Config(ctx context.Context, _ *node.ConfigRequest) (*node.ConfigResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.calls++
+	if s.failures > 0 {
+		s.failures--
+		return nil, status.Error(codes.Unavailable, "node unavailable")
+	}
+
+	s.height++
+	height := s.height
+	md, _ := metadata.FromIncomingContext(ctx)
+	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		height, _ = strconv.ParseInt(heights[0], 10, 64)
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))); err != nil {
+		return nil, err
+	}
+	return &node.ConfigResponse{MinimumGasPrice: strconv.FormatInt(height, 10)}, nil
+}
+
+func dialHeightNodeService(t *testing.T, svc *heightNodeService, opts ...grpc.DialOption) node.ServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	node.RegisterServiceServer(srv, svc)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", append(opts,
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return node.NewServiceClient(conn)
+}
+
+func TestHeightPinningUnaryInterceptor(t *testing.T) {
+	svc := &heightNodeService{}
+	queryClient := dialHeightNodeService(t, svc, client.WithQueryInterceptors(client.DefaultRetryOptions(0)))
+	ctx := context.Background()
+
+	// the queries outside of a batch are served at the latest height.
+	res, err := queryClient.Config(ctx, &node.ConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "1", res.MinimumGasPrice)
+
+	// the queries of a batch are pinned to the height of the first one.
+	batchCtx := client.QueryBatchContext(ctx)
+	require.Zero(t, client.QueryBatchHeight(batchCtx))
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := queryClient.Config(batchCtx, &node.ConfigRequest{})
+			require.NoError(t, err)
+			require.Equal(t, "2", res.MinimumGasPrice)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(2), client.QueryBatchHeight(batchCtx))
+
+	// the height set in the context is pinned.
+	batchCtx = client.QueryBatchContext(metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, "1"))
+	res, err = queryClient.Config(batchCtx, &node.ConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "1", res.MinimumGasPrice)
+	require.Equal(t, int64(1), client.QueryBatchHeight(batchCtx))
+}
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	opts := client.RetryOptions{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	ctx := context.Background()
+
+	// the transient failures are retried.
+	svc := &heightNodeService{failures: 2}
+	_, err := dialHeightNodeService(t, svc, client.WithQueryInterceptors(opts)).Config(ctx, &node.ConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, svc.calls)
+
+	// up to the maximum number of retries.
+	svc = &heightNodeService{failures: 3}
+	_, err = dialHeightNodeService(t, svc, client.WithQueryInterceptors(opts)).Config(ctx, &node.ConfigRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, 3, svc.calls)
+
+	// the other failures are not retried.
+	svc = &heightNodeService{}
+	_, err = dialHeightNodeService(t, svc, client.WithQueryInterceptors(opts)).Status(ctx, &node.StatusRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
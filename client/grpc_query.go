@@ -61,7 +61,14 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
 	}
 
-	// Case 2-2. Querying state via abci query.
+	// Case 2-2. Querying state via abci query, pinning the queries of a batch
+	// to the same height like the gRPC connections do.
+	return HeightPinningUnaryInterceptor()(grpcCtx, method, req, reply, nil, ctx.invokeABCIQuery, opts...)
+}
+
+// invokeABCIQuery queries the state via abci query, implementing
+// grpc.UnaryInvoker.
+func (ctx Context) invokeABCIQuery(grpcCtx gocontext.Context, method string, req, reply interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
 	reqBz, err := ctx.gRPCCodec().Marshal(req)
 	if err != nil {
 		return err
@@ -27,17 +27,23 @@ import (
 )
 
 const (
-	flagInteractive  = "interactive"
-	flagRecover      = "recover"
-	flagNoBackup     = "no-backup"
-	flagCoinType     = "coin-type"
-	flagAccount      = "account"
-	flagIndex        = "index"
-	flagMultisig     = "multisig"
-	flagNoSort       = "nosort"
-	flagHDPath       = "hd-path"
-	flagPubKeyBase64 = "pubkey-base64"
-	flagIndiscreet   = "indiscreet"
+	flagInteractive     = "interactive"
+	flagRecover         = "recover"
+	flagNoBackup        = "no-backup"
+	flagPurpose         = "purpose"
+	flagCoinType        = "coin-type"
+	flagAccount         = "account"
+	flagChange          = "change"
+	flagIndex           = "index"
+	flagMultisig        = "multisig"
+	flagNoSort          = "nosort"
+	flagHDPath          = "hd-path"
+	flagPubKeyBase64    = "pubkey-base64"
+	flagIndiscreet      = "indiscreet"
+	flagBIP39Passphrase = "bip39-passphrase"
+	flagSignerBackend   = "signer-backend"
+	flagSignerKeyID     = "signer-key-id"
+	flagLedgerApp       = "ledger-app"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -55,13 +61,27 @@ and encrypted with the given password. The only input that is required is the en
 
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
 The flag --recover allows one to recover a key from a seed passphrase.
+The flag --bip39-passphrase prompts for a BIP39 passphrase (the "25th word") combined with
+the new or recovered mnemonic to derive the seed. It is not stored, and is required with the
+mnemonic to recover the key.
+The key is derived with the HD path m/<purpose>'/<coin-type>'/<account>'/<change>/<index>
+set by the --purpose, --coin-type, --account, --change and --index flags, or with the
+custom HD path set by --hd-path. The HD path is stored with the key and shown by 'keys show'.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
-multisig transactions.
-
-You can create and store a multisig key by passing the list of key names stored in a keyring
-and the minimum number of signatures required through --multisig-threshold. The keys are
+multisig transactions, e.g. the public key of a key held in a Ledger device on another
+machine, as exported by 'keys show <name> --pubkey'.
+Use the --address flag to add a watch-only key storing only an address, which can be
+used to generate unsigned transactions with --generate-only.
+No private key material is stored for the keys added with --pubkey or --address.
+Use the --signer-backend and --signer-key-id flags to store a reference to a key held by
+a signer backend registered by the application, e.g. a HSM, a cloud KMS or a remote signer.
+With --ledger, the key is held by the Ledger app of its --key-type, e.g. the Cosmos app for
+secp256k1 keys, or by the Ledger app registered by the application and set by --ledger-app.
+
+You can create and store a multisig key by passing the list of key names or addresses stored
+in a keyring and the minimum number of signatures required through --multisig-threshold. The keys are
 sorted by address, unless the flag --nosort is set.
 Example:
 
@@ -71,22 +91,29 @@ Example:
 		RunE: runAddCmdPrepare,
 	}
 	f := cmd.Flags()
-	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
+	f.StringSlice(flagMultisig, nil, "List of key names or addresses stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
+	f.String(FlagAddress, "", "Store a watch-only key with the given address and no public key")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
+	f.Uint32(flagPurpose, 44, "Purpose number for HD derivation")
 	f.Uint32(flagCoinType, sdk.CoinType, "coin type number for HD derivation")
 	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
+	f.Bool(flagChange, false, "Derive an internal (change) address for HD derivation")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
 	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 	f.Bool(flagIndiscreet, false, "Print seed phrase directly on current terminal (only valid when --no-backup is false)")
+	f.Bool(flagBIP39Passphrase, false, "Prompt for a BIP39 passphrase combined with the mnemonic to derive the seed")
+	f.String(flagSignerBackend, "", "Store a local reference to a key held by the given signer backend")
+	f.String(flagSignerKeyID, "", "Identifier of the key in the signer backend (for use in conjunction with --signer-backend)")
+	f.String(flagLedgerApp, "", "Name of the Ledger app holding the key (for use in conjunction with --ledger)")
 
 	// support old flags name for backwards compatibility
 	f.SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -131,7 +158,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	kb := ctx.Keyring
 	outputFormat := ctx.OutputFormat
 
-	keyringAlgos, _ := kb.SupportedAlgorithms()
+	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
+	keyringAlgos, ledgerAlgos := kb.SupportedAlgorithms()
+	if useLedger {
+		keyringAlgos = ledgerAlgos
+	}
+
 	algoStr, _ := cmd.Flags().GetString(flags.FlagKeyType)
 	algo, err := keyring.NewSigningAlgoFromString(algoStr, keyringAlgos)
 	if err != nil {
@@ -175,14 +207,14 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				}
 				seenKeys[keyName] = struct{}{}
 
-				k, err := kb.Key(keyName)
+				k, err := fetchKey(kb, keyName, ctx.AddressCodec)
 				if err != nil {
 					return err
 				}
 
 				key, err := k.GetPubKey()
 				if err != nil {
-					return err
+					return fmt.Errorf("%s: %w", keyName, err)
 				}
 				pks[i] = key
 			}
@@ -203,11 +235,43 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		}
 	}
 
+	if signerBackend, _ := cmd.Flags().GetString(flagSignerBackend); signerBackend != "" {
+		keyID, _ := cmd.Flags().GetString(flagSignerKeyID)
+		if keyID == "" {
+			return fmt.Errorf("flag %s is required with %s", flagSignerKeyID, flagSignerBackend)
+		}
+
+		k, err := kb.SaveRemoteKey(name, signerBackend, keyID)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+
 	pubKey, _ := cmd.Flags().GetString(FlagPublicKey)
 	pubKeyBase64, _ := cmd.Flags().GetString(flagPubKeyBase64)
 	if pubKey != "" && pubKeyBase64 != "" {
 		return fmt.Errorf(`flags %s and %s cannot be used simultaneously`, FlagPublicKey, flagPubKeyBase64)
 	}
+
+	if address, _ := cmd.Flags().GetString(FlagAddress); address != "" {
+		if pubKey != "" || pubKeyBase64 != "" {
+			return fmt.Errorf(`flag %s cannot be used with %s or %s`, FlagAddress, FlagPublicKey, flagPubKeyBase64)
+		}
+
+		addr, err := ctx.AddressCodec.StringToBytes(address)
+		if err != nil {
+			return err
+		}
+
+		k, err := kb.SaveWatchKey(name, addr)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
 	if pubKey != "" {
 		var pk cryptotypes.PubKey
 		if err = ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
@@ -255,22 +319,43 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 
+	purpose, _ := cmd.Flags().GetUint32(flagPurpose)
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
+	change, _ := cmd.Flags().GetBool(flagChange)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
-	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
+	useBIP39Passphrase, _ := cmd.Flags().GetBool(flagBIP39Passphrase)
 
 	if len(hdPath) == 0 {
-		hdPath = hd.CreateHDPath(coinType, account, index).String()
+		hdPath = hd.NewParams(purpose, coinType, account, change, index).String()
 	} else if useLedger {
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
 
+	if useLedger && (purpose != 44 || change) {
+		return fmt.Errorf("cannot set %s or %s with ledger", flagPurpose, flagChange)
+	}
+
+	if useLedger && useBIP39Passphrase {
+		return fmt.Errorf("cannot set %s with ledger", flagBIP39Passphrase)
+	}
+
+	ledgerApp, _ := cmd.Flags().GetString(flagLedgerApp)
+	if ledgerApp != "" && !useLedger {
+		return fmt.Errorf("flag %s requires %s", flagLedgerApp, flags.FlagUseLedger)
+	}
+
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
 		bech32PrefixAccAddr := ctx.AddressPrefix
-		k, err := kb.SaveLedgerKey(name, hd.Secp256k1, bech32PrefixAccAddr, coinType, account, index)
+
+		var k *keyring.Record
+		if ledgerApp != "" {
+			k, err = kb.SaveLedgerAppKey(name, ledgerApp, bech32PrefixAccAddr, coinType, account, index)
+		} else {
+			k, err = kb.SaveLedgerKey(name, algo, bech32PrefixAccAddr, coinType, account, index)
+		}
 		if err != nil {
 			return err
 		}
@@ -316,7 +401,7 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	}
 
 	// override bip39 passphrase
-	if interactive {
+	if interactive || useBIP39Passphrase {
 		bip39Passphrase, err = input.GetSecretString(
 			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
 				"Most users should just hit enter to use the default, \"\"\n", inBuf)
@@ -218,6 +218,7 @@ func Test_runAddCmdDryRun(t *testing.T) {
 	pubkey1 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
 	pubkey2 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/se1vkqgdQ7VJQCM4mxN+L+ciGhnnJ4XYsQCRBMrdRi"}`
 	b64Pubkey := "QWhnOHhpdXBJcGZ2UlR2ak5la1ExclROUThTOW96YjdHK2RYQmFLVjl4aUo="
+	watchAddr := "cosmos1ejxy258qqz8hdl32nyqwsvsu5uafmcth3xkdg9"
 	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
 
 	testData := []struct {
@@ -295,6 +296,24 @@ func Test_runAddCmdDryRun(t *testing.T) {
 			},
 			added: false,
 		},
+		{
+			name: "watch-only account is added",
+			args: []string{
+				"testkey",
+				fmt.Sprintf("--%s=%s", flags.FlagDryRun, "false"),
+				fmt.Sprintf("--%s=%s", FlagAddress, watchAddr),
+			},
+			added: true,
+		},
+		{
+			name: "watch-only account is not added with dry run",
+			args: []string{
+				"testkey",
+				fmt.Sprintf("--%s=%s", flags.FlagDryRun, "true"),
+				fmt.Sprintf("--%s=%s", FlagAddress, watchAddr),
+			},
+			added: false,
+		},
 	}
 	for _, tt := range testData {
 		tt := tt
@@ -398,3 +417,131 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func TestAddRecoverBIP39PassphraseAndHDPath(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithInput(mockIn).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
+	require.NoError(t, err)
+
+	mnemonic, err := bip39.NewMnemonic(entropySeed)
+	require.NoError(t, err)
+
+	bip39Passphrase := "25th word"
+	hdPath := "m/49'/118'/1'/1/2"
+
+	cmd.SetArgs([]string{
+		"keyname1",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flagRecover),
+		fmt.Sprintf("--%s", flagBIP39Passphrase),
+		fmt.Sprintf("--%s=49", flagPurpose),
+		fmt.Sprintf("--%s=1", flagAccount),
+		fmt.Sprintf("--%s", flagChange),
+		fmt.Sprintf("--%s=2", flagIndex),
+	})
+
+	// the passphrases don't match
+	mockIn.Reset(fmt.Sprintf("%s\n%s\n%s\n", mnemonic, bip39Passphrase, "other"))
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "passphrases don't match")
+
+	mockIn.Reset(fmt.Sprintf("%s\n%s\n%s\n", mnemonic, bip39Passphrase, bip39Passphrase))
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, bip39Passphrase, hdPath)
+	require.NoError(t, err)
+	expectedAddr := sdk.AccAddress(hd.Secp256k1.Generate()(derivedPriv).PubKey().Address())
+
+	k, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, hdPath, k.GetHDPath())
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, expectedAddr, addr)
+
+	ko, err := MkAccKeyOutput(k, clientCtx.AddressCodec)
+	require.NoError(t, err)
+	require.Equal(t, hdPath, ko.HDPath)
+
+	// purpose and change cannot be set with ledger
+	cmd.SetArgs([]string{
+		"keyname2",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flags.FlagUseLedger),
+		fmt.Sprintf("--%s", flagChange),
+	})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "cannot set purpose or change with ledger")
+}
+
+func Test_runAddCmdLedgerApp(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kbHome := t.TempDir()
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			"ledger app without ledger",
+			[]string{fmt.Sprintf("--%s=Ethereum", flagLedgerApp)},
+			"flag ledger-app requires ledger",
+		},
+		{
+			"unknown ledger app",
+			[]string{fmt.Sprintf("--%s", flags.FlagUseLedger), fmt.Sprintf("--%s=Unknown", flagLedgerApp)},
+			"unknown Ledger app Unknown",
+		},
+		{
+			"key type not supported by ledger",
+			[]string{fmt.Sprintf("--%s", flags.FlagUseLedger), fmt.Sprintf("--%s=ed25519", flags.FlagKeyType)},
+			"unsupported signing algo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := AddKeyCommand()
+			cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(kbHome).
+				WithInput(mockIn).
+				WithCodec(cdc).
+				WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+				WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+				WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs(append([]string{
+				"keyname",
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			}, tc.args...))
+			require.ErrorContains(t, cmd.ExecuteContext(ctx), tc.expErr)
+		})
+	}
+}
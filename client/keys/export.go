@@ -3,11 +3,14 @@ package keys
 import (
 	"bufio"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -70,6 +73,57 @@ and export your keys in ASCII-armored encrypted format.`,
 	return cmd
 }
 
+// ExportAllKeysCommand exports all the keys of the key store.
+func ExportAllKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all the keys of the keyring",
+		Long: `Export all the keys of the local keyring, with their names and metadata, in a single
+ASCII-armored encrypted format, to migrate a keyring to another machine or backend with
+the import-all command. The public key references, e.g. of Ledger or offline keys, are
+exported along with the private keys.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			repeatPassword, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+
+			if encryptPassword != repeatPassword {
+				return errors.New("passphrases don't match")
+			}
+
+			armored, err := clientCtx.Keyring.ExportAllKeysArmor(encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				cmd.Println(armored)
+				return nil
+			}
+
+			return os.WriteFile(outputDoc, []byte(armored), 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The keys will be written to the given file instead of STDOUT")
+
+	return cmd
+}
+
 func exportUnsafeUnarmored(ctx client.Context, cmd *cobra.Command, uid string, buf *bufio.Reader) error {
 	// confirm export unarmored hex privkey, unless -y is passed
 	if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
@@ -109,6 +163,7 @@ func unsafeExportPrivKeyHex(ks unsafeExporter, uid string) (privkey string, err
 	if err != nil {
 		return "", err
 	}
+	defer types.DestroyPrivKey(priv)
 
 	return hex.EncodeToString(priv.Bytes()), nil
 }
@@ -49,6 +49,42 @@ func ImportKeyCommand() *cobra.Command {
 	}
 }
 
+// ImportAllKeysCommand imports the keys exported with the export-all command.
+func ImportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-all <keyfile>",
+		Short: "Import all the keys of a keyring export into the local keybase",
+		Long: `Import all the keys of an ASCII armored keyring export, created with the export-all
+command, into the local keybase. No key is imported if the name of one of them is
+already taken.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			records, err := clientCtx.Keyring.ImportAllKeysArmor(string(bz), passphrase)
+			if err != nil {
+				return err
+			}
+
+			return printKeyringRecords(clientCtx, cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		},
+	}
+}
+
 func ImportKeyHexCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import-hex <name> <hex>",
@@ -11,9 +11,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
@@ -212,3 +215,56 @@ func Test_runImportCmdWithEmptyName(t *testing.T) {
 
 	require.ErrorContains(t, cmd.ExecuteContext(ctx), "the provided name is invalid or empty after trimming whitespace")
 }
+
+func Test_runExportImportAllCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+	_, err = kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	exportCmd := ExportAllKeysCommand()
+	exportCmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(exportCmd)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithInput(mockIn).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos"))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// the passphrase must be confirmed
+	keyfile := filepath.Join(t.TempDir(), "keys.asc")
+	mockIn.Reset("123456789\n987654321\n")
+	exportCmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, keyfile)})
+	require.EqualError(t, exportCmd.ExecuteContext(ctx), "passphrases don't match")
+
+	mockIn.Reset("123456789\n123456789\n")
+	require.NoError(t, exportCmd.ExecuteContext(ctx))
+
+	kb2, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	importCmd := ImportAllKeysCommand()
+	importCmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn = testutil.ApplyMockIODiscardOutErr(importCmd)
+	clientCtx = clientCtx.WithKeyring(kb2).WithInput(mockIn)
+	ctx = context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	mockIn.Reset("987654321\n")
+	importCmd.SetArgs([]string{keyfile})
+	require.Error(t, importCmd.ExecuteContext(ctx))
+
+	mockIn.Reset("123456789\n")
+	require.NoError(t, importCmd.ExecuteContext(ctx))
+
+	k, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	imported, err := kb2.Key("keyname1")
+	require.NoError(t, err)
+	require.True(t, k.PubKey.Equal(imported.PubKey))
+}
@@ -19,6 +19,7 @@ type KeyOutput struct {
 	Address  string `json:"address" yaml:"address"`
 	PubKey   string `json:"pubkey" yaml:"pubkey"`
 	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	HDPath   string `json:"hd_path,omitempty" yaml:"hd_path"`
 }
 
 // NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
@@ -46,34 +47,79 @@ func NewKeyOutput(name string, keyType keyring.KeyType, addr []byte, pk cryptoty
 	}, nil
 }
 
+// newWatchKeyOutput creates a KeyOutput for a watch-only key, which has an
+// address but no public key.
+func newWatchKeyOutput(k *keyring.Record, addressCodec address.Codec) (KeyOutput, error) {
+	addr, err := k.GetAddress()
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	addrStr, err := addressCodec.BytesToString(addr)
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	return KeyOutput{
+		Name:    k.Name,
+		Type:    k.GetType().String(),
+		Address: addrStr,
+	}, nil
+}
+
+// newRecordKeyOutput creates a KeyOutput for a record with a public key,
+// including the HD path the key was derived with.
+func newRecordKeyOutput(k *keyring.Record, pk cryptotypes.PubKey, addressCodec address.Codec) (KeyOutput, error) {
+	ko, err := NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, addressCodec)
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	ko.HDPath = k.GetHDPath()
+
+	return ko, nil
+}
+
 // MkConsKeyOutput create a KeyOutput for consensus addresses.
 func MkConsKeyOutput(k *keyring.Record, consensusAddressCodec address.Codec) (KeyOutput, error) {
+	if k.GetWatch() != nil {
+		return newWatchKeyOutput(k, consensusAddressCodec)
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return KeyOutput{}, err
 	}
-	return NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, consensusAddressCodec)
+	return newRecordKeyOutput(k, pk, consensusAddressCodec)
 }
 
 // MkValKeyOutput create a KeyOutput for validator addresses.
 func MkValKeyOutput(k *keyring.Record, validatorAddressCodec address.Codec) (KeyOutput, error) {
+	if k.GetWatch() != nil {
+		return newWatchKeyOutput(k, validatorAddressCodec)
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return KeyOutput{}, err
 	}
 
-	return NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, validatorAddressCodec)
+	return newRecordKeyOutput(k, pk, validatorAddressCodec)
 }
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
 // public key is a multisig public key, then the threshold and constituent
 // public keys will be added.
 func MkAccKeyOutput(k *keyring.Record, addressCodec address.Codec) (KeyOutput, error) {
+	if k.GetWatch() != nil {
+		return newWatchKeyOutput(k, addressCodec)
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return KeyOutput{}, err
 	}
-	return NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, addressCodec)
+	return newRecordKeyOutput(k, pk, addressCodec)
 }
 
 // MkAccKeysOutput returns a slice of KeyOutput objects, each with the "acc"
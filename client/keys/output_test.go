@@ -42,7 +42,7 @@ func TestBech32KeysOutput(t *testing.T) {
 	out, err := MkAccKeyOutput(k, addresscodec.NewBech32Codec("cosmos"))
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, out)
-	require.Equal(t, "{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{\"@type\":\"/cosmos.crypto.multisig.LegacyAminoPubKey\",\"threshold\":1,\"public_keys\":[{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\",\"key\":\"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ\"}]} Mnemonic:}", fmt.Sprintf("%+v", out))
+	require.Equal(t, "{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{\"@type\":\"/cosmos.crypto.multisig.LegacyAminoPubKey\",\"threshold\":1,\"public_keys\":[{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\",\"key\":\"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ\"}]} Mnemonic: HDPath:}", fmt.Sprintf("%+v", out))
 }
 
 func TestProtoMarshalJSON(t *testing.T) {
@@ -76,3 +76,18 @@ func TestProtoMarshalJSON(t *testing.T) {
 	require.Equal(ko.Address, expectedOutput)
 	require.Equal(ko.PubKey, string(bz))
 }
+
+func TestWatchKeyOutput(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	k, err := keyring.NewWatchRecord("watch", addr)
+	require.NoError(t, err)
+
+	out, err := MkAccKeyOutput(k, addresscodec.NewBech32Codec("cosmos"))
+	require.NoError(t, err)
+	require.Equal(t, KeyOutput{Name: "watch", Type: "watch", Address: addr.String()}, out)
+
+	out, err = MkValKeyOutput(k, addresscodec.NewBech32Codec("cosmosvaloper"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(addr).String(), out.Address)
+	require.Empty(t, out.PubKey)
+}
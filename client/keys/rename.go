@@ -56,7 +56,7 @@ private keys stored in a ledger device cannot be renamed with the CLI.
 				return err
 			}
 
-			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypeRemote || k.GetType() == keyring.TypeWatch {
 				cmd.PrintErrln("Public key reference renamed")
 				return nil
 			}
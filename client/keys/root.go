@@ -40,8 +40,10 @@ The pass backend requires GnuPG: https://gnupg.org/
 		MnemonicKeyCommand(),
 		AddKeyCommand(),
 		ExportKeyCommand(),
+		ExportAllKeysCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
+		ImportAllKeysCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -87,7 +87,7 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 
 			key, err := k.GetPubKey()
 			if err != nil {
-				return err
+				return fmt.Errorf("%s: %w", keyRef, err)
 			}
 			pks[i] = key
 		}
@@ -124,6 +124,10 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("cannot use --output with --address or --pubkey")
 	}
 
+	if isShowPubKey && k.GetType() == keyring.TypeWatch {
+		return fmt.Errorf("%s: %w", k.Name, keyring.ErrPubKeyNotAvailable)
+	}
+
 	bechPrefix, _ := cmd.Flags().GetString(FlagBechPrefix)
 	ko, err := getKeyOutput(clientCtx, bechPrefix, k)
 	if err != nil {
@@ -175,7 +179,7 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		return ledger.ShowAddress(*ledgerItem.Path, pk, clientCtx.AddressPrefix)
+		return ledger.ShowAppAddress(ledgerItem.App, *ledgerItem.Path, pk, clientCtx.AddressPrefix)
 	}
 
 	return nil
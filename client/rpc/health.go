@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NodeHealthCmd returns a CLI command that queries an aggregated view of the
+// node status: application and module versions, sync status, retained heights,
+// pruning and snapshot settings, and minimum gas prices.
+func NodeHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "node-health",
+		Short:   "Query an aggregated view of the node application and consensus status",
+		Example: fmt.Sprintf("$ %s q node-health", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := node.NewServiceClient(clientCtx).Health(cmd.Context(), &node.HealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SigningData is a snapshot of the chain and account state required to sign
+// transactions offline, e.g. on an air-gapped machine. It is exported from a
+// live node with `query auth export-signing-data` and loaded with the
+// --signing-data flag in place of the --chain-id, --account-number and
+// --sequence flags.
+type SigningData struct {
+	// ChainID is the chain ID of the chain the snapshot was exported from.
+	ChainID string `json:"chain_id"`
+	// Height is the height the snapshot was exported at.
+	Height int64 `json:"height,string"`
+	// MinGasPrices are the minimum gas prices of the node the snapshot was
+	// exported from, used as the gas prices of the transactions providing
+	// neither fees nor gas prices.
+	MinGasPrices string `json:"min_gas_prices,omitempty"`
+	// Accounts are the signing data of the exported accounts.
+	Accounts []SigningAccount `json:"accounts"`
+}
+
+// SigningAccount is the signing data of an account.
+type SigningAccount struct {
+	Address       string `json:"address"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+}
+
+// Account returns the signing data of the account with the given address.
+func (d SigningData) Account(address string) (SigningAccount, bool) {
+	for _, acc := range d.Accounts {
+		if acc.Address == address {
+			return acc, true
+		}
+	}
+
+	return SigningAccount{}, false
+}
+
+// ReadSigningData reads a SigningData snapshot from a JSON file.
+func ReadSigningData(path string) (*SigningData, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var signingData SigningData
+	if err := json.Unmarshal(bz, &signingData); err != nil {
+		return nil, fmt.Errorf("failed to parse signing data %s: %w", path, err)
+	}
+
+	if signingData.ChainID == "" {
+		return nil, fmt.Errorf("signing data %s has no chain ID", path)
+	}
+
+	if _, err := sdk.ParseDecCoins(signingData.MinGasPrices); err != nil {
+		return nil, fmt.Errorf("invalid min gas prices in signing data %s: %w", path, err)
+	}
+
+	return &signingData, nil
+}
@@ -2,9 +2,11 @@ package tx
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -58,6 +60,17 @@ func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
 	b.auxSignerData.SignDoc.BodyBytes = nil
 }
 
+// SetTimeoutTimestamp sets a timeout timestamp in the tx.
+func (b *AuxTxBuilder) SetTimeoutTimestamp(timestamp time.Time) {
+	b.checkEmptyFields()
+
+	b.body.TimeoutTimestamp = nil
+	if !timestamp.IsZero() {
+		b.body.TimeoutTimestamp = timestamppb.New(timestamp)
+	}
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
 // SetMsgs sets an array of Msgs in the tx.
 func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
 	anys := make([]*anypb.Any, len(msgs))
@@ -204,6 +217,10 @@ func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
 		}
 	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 		{
+			if body.TimeoutTimestamp != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s does not support timeout timestamps", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+
 			handler := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{
 				FileResolver: proto.HybridResolver,
 			})
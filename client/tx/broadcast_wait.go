@@ -0,0 +1,154 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// DefaultBroadcastWaitTimeout is the default maximum time BroadcastAndWait
+	// waits for a transaction to be included and confirmed.
+	DefaultBroadcastWaitTimeout = time.Minute
+	// DefaultBroadcastWaitPollInterval is the default interval between two
+	// queries of the transaction by BroadcastAndWait.
+	DefaultBroadcastWaitPollInterval = time.Second
+)
+
+// BroadcastWaitOptions configures how BroadcastAndWait waits for the inclusion
+// of a transaction.
+type BroadcastWaitOptions struct {
+	// Timeout is the maximum time to wait for the transaction to be included
+	// and confirmed.
+	Timeout time.Duration
+	// PollInterval is the interval between two queries of the transaction.
+	PollInterval time.Duration
+	// ConfirmationDepth is the number of blocks to be committed on top of the
+	// block including the transaction before it is considered confirmed. Zero
+	// returns as soon as the transaction is included.
+	ConfirmationDepth int64
+	// Subscribe subscribes to the new blocks of the node over websocket, so
+	// that the transaction is queried as soon as a block is committed rather
+	// than every PollInterval. The transaction is still polled if the
+	// subscription fails.
+	Subscribe bool
+}
+
+// DefaultBroadcastWaitOptions returns the default BroadcastWaitOptions.
+func DefaultBroadcastWaitOptions() BroadcastWaitOptions {
+	return BroadcastWaitOptions{
+		Timeout:      DefaultBroadcastWaitTimeout,
+		PollInterval: DefaultBroadcastWaitPollInterval,
+	}
+}
+
+// BroadcastAndWait broadcasts the transaction in sync mode, then waits for it
+// to be included in a block and confirmed by opts.ConfirmationDepth blocks. It
+// returns the response of the included transaction, with its events, or the
+// response of the broadcast if the transaction failed CheckTx. An error is
+// returned if the transaction is not included before opts.Timeout.
+func BroadcastAndWait(clientCtx client.Context, txBytes []byte, opts BroadcastWaitOptions) (*sdk.TxResponse, error) {
+	res, err := clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, nil
+	}
+
+	ctx := clientCtx.CmdContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	var newBlocks <-chan struct{}
+	if opts.Subscribe {
+		// the transaction is polled in case of failure.
+		newBlocks, _ = subscribeNewBlocks(ctx, clientCtx.NodeURI)
+	}
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	txSvcClient := tx.NewServiceClient(clientCtx)
+	nodeClient := node.NewServiceClient(clientCtx)
+	for {
+		txRes, err := txSvcClient.GetTx(ctx, &tx.GetTxRequest{Hash: res.TxHash})
+		switch {
+		case status.Code(err) == codes.NotFound:
+		case err != nil && ctx.Err() == nil:
+			return nil, err
+		case err == nil && opts.ConfirmationDepth == 0:
+			return txRes.TxResponse, nil
+		case err == nil:
+			nodeStatus, err := nodeClient.Status(ctx, &node.StatusRequest{})
+			if err != nil && ctx.Err() == nil {
+				return nil, err
+			}
+			if err == nil && int64(nodeStatus.Height) >= txRes.TxResponse.Height+opts.ConfirmationDepth {
+				return txRes.TxResponse, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, sdkerrors.ErrLogic.Wrapf("timed out waiting for transaction %s to be included in a block: %v", res.TxHash, ctx.Err())
+		case <-ticker.C:
+		case <-newBlocks:
+		}
+	}
+}
+
+// subscribeNewBlocks subscribes to the new blocks of the node over websocket.
+// The returned channel receives a value on each new block, until the context
+// is done.
+func subscribeNewBlocks(ctx context.Context, nodeURI string) (<-chan struct{}, error) {
+	c, err := rpchttp.New(nodeURI)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+
+	const subscriber = "broadcast-and-wait"
+	query := fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventNewBlock)
+	eventCh, err := c.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		_ = c.Stop()
+		return nil, err
+	}
+
+	newBlocks := make(chan struct{}, 1)
+	go func() {
+		defer c.Stop()                                           //nolint:errcheck // ignore stop error
+		defer c.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck // ignore unsubscribe error
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-eventCh:
+				select {
+				case newBlocks <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return newBlocks, nil
+}
@@ -0,0 +1,131 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// fakeChain is a node including the broadcast transactions after a number of
+// queries, and producing a block on each status query.
+type fakeChain struct {
+	mtx        sync.Mutex
+	checkCode  uint32
+	height     uint64
+	pending    int
+	included   bool
+	inclHeight int64
+}
+
+type fakeChainRPC struct {
+	client.CometRPC
+	chain *fakeChain
+}
+
+func (c fakeChainRPC) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return &coretypes.ResultBroadcastTx{Code: c.chain.checkCode, Hash: tx.Hash()}, nil
+}
+
+type fakeChainTxService struct {
+	txtypes.UnimplementedServiceServer
+	chain *fakeChain
+}
+
+func (s fakeChainTxService) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	c := s.chain
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.pending > 0 {
+		c.pending--
+		return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+	}
+
+	if !c.included {
+		c.included = true
+		c.inclHeight = int64(c.height)
+	}
+	return &txtypes.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: req.Hash, Height: c.inclHeight}}, nil
+}
+
+type fakeChainNodeService struct {
+	node.UnimplementedServiceServer
+	chain *fakeChain
+}
+
+func (s fakeChainNodeService) Status(context.Context, *node.StatusRequest) (*node.StatusResponse, error) {
+	c := s.chain
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.height++
+	return &node.StatusResponse{Height: c.height}, nil
+}
+
+func newFakeChainContext(t *testing.T, chain *fakeChain) client.Context {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	txtypes.RegisterServiceServer(srv, &fakeChainTxService{chain: chain})
+	node.RegisterServiceServer(srv, &fakeChainNodeService{chain: chain})
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return client.Context{}.WithClient(fakeChainRPC{chain: chain}).WithGRPCClient(conn)
+}
+
+func TestBroadcastAndWait(t *testing.T) {
+	txBytes := []byte("tx")
+	opts := DefaultBroadcastWaitOptions()
+	opts.PollInterval = time.Millisecond
+
+	// the tx failing CheckTx is returned right away.
+	res, err := BroadcastAndWait(newFakeChainContext(t, &fakeChain{checkCode: 5}), txBytes, opts)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), res.Code)
+
+	// the tx is returned once included.
+	chain := &fakeChain{pending: 3}
+	res, err = BroadcastAndWait(newFakeChainContext(t, chain), txBytes, opts)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()), res.TxHash)
+	require.Zero(t, chain.pending)
+
+	// the tx is returned once confirmed.
+	chain = &fakeChain{pending: 1, height: 10}
+	opts.ConfirmationDepth = 3
+	res, err = BroadcastAndWait(newFakeChainContext(t, chain), txBytes, opts)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.Height)
+	require.GreaterOrEqual(t, chain.height, uint64(13))
+
+	// the tx not included in time times out.
+	opts.Timeout = 20 * time.Millisecond
+	_, err = BroadcastAndWait(newFakeChainContext(t, &fakeChain{pending: 1 << 30}), txBytes, opts)
+	require.ErrorContains(t, err, "timed out waiting for transaction")
+}
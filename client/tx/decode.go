@@ -0,0 +1,232 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+)
+
+const (
+	txFullName       protoreflect.FullName = "cosmos.tx.v1beta1.Tx"
+	txRawFullName    protoreflect.FullName = "cosmos.tx.v1beta1.TxRaw"
+	txBodyFullName   protoreflect.FullName = "cosmos.tx.v1beta1.TxBody"
+	authInfoFullName protoreflect.FullName = "cosmos.tx.v1beta1.AuthInfo"
+	anyFullName      protoreflect.FullName = "google.protobuf.Any"
+
+	// nonCriticalFieldBit is set in the number of the fields that can be
+	// ignored by a decoder not knowing them, see codec/unknownproto.
+	nonCriticalFieldBit = 1 << 10
+)
+
+// DescriptorDecoder decodes the transactions of an arbitrary chain into a
+// human-readable form. It resolves the messages of the transactions using only
+// the protobuf file descriptors of the chain, as returned by the
+// cosmos.reflection.v1 service, so that it does not depend on the module protos
+// compiled into the binary. It is meant for the `tx decode --pretty` command
+// and for wallets displaying transactions before signing them.
+type DescriptorDecoder struct {
+	types                                     *dynamicpb.Types
+	txDesc, txRawDesc, bodyDesc, authInfoDesc protoreflect.MessageDescriptor
+}
+
+// NewDescriptorDecoder returns a DescriptorDecoder resolving the messages of
+// the transactions from the given file descriptors. The files of the well-known
+// google.protobuf types are added if missing.
+func NewDescriptorDecoder(files []*descriptorpb.FileDescriptorProto) (*DescriptorDecoder, error) {
+	fdSet := &descriptorpb.FileDescriptorSet{File: withWellKnownFiles(files)}
+	registry, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptors: %w", err)
+	}
+
+	d := &DescriptorDecoder{types: dynamicpb.NewTypes(registry)}
+	for name, desc := range map[protoreflect.FullName]*protoreflect.MessageDescriptor{
+		txFullName:       &d.txDesc,
+		txRawFullName:    &d.txRawDesc,
+		txBodyFullName:   &d.bodyDesc,
+		authInfoFullName: &d.authInfoDesc,
+	} {
+		mt, err := d.types.FindMessageByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("file descriptors do not define %s: %w", name, err)
+		}
+		*desc = mt.Descriptor()
+	}
+
+	return d, nil
+}
+
+// NewDescriptorDecoderFromNode returns a DescriptorDecoder resolving the
+// messages of the transactions from the file descriptors served by the
+// cosmos.reflection.v1 service of the node behind the given connection.
+func NewDescriptorDecoderFromNode(ctx context.Context, conn grpc.ClientConnInterface) (*DescriptorDecoder, error) {
+	res, err := reflectionv1.NewReflectionServiceClient(conn).FileDescriptors(ctx, &reflectionv1.FileDescriptorsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the file descriptors of the node: %w", err)
+	}
+
+	return NewDescriptorDecoder(res.Files)
+}
+
+// Decode decodes the given transaction bytes, encoded as a
+// cosmos.tx.v1beta1.TxRaw, into a dynamic cosmos.tx.v1beta1.Tx message. Like
+// the transaction decoder of the SDK, it rejects the transactions with unknown
+// critical fields. It also rejects the transactions packing messages which
+// cannot be resolved, so that all their content can be displayed.
+func (d *DescriptorDecoder) Decode(txBytes []byte) (proto.Message, error) {
+	raw := dynamicpb.NewMessage(d.txRawDesc)
+	if err := d.unmarshal(txBytes, raw); err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	rawFields := d.txRawDesc.Fields()
+	body := dynamicpb.NewMessage(d.bodyDesc)
+	if err := d.unmarshal(raw.Get(rawFields.ByName("body_bytes")).Bytes(), body); err != nil {
+		return nil, fmt.Errorf("failed to decode tx body: %w", err)
+	}
+
+	authInfo := dynamicpb.NewMessage(d.authInfoDesc)
+	if err := d.unmarshal(raw.Get(rawFields.ByName("auth_info_bytes")).Bytes(), authInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode tx auth info: %w", err)
+	}
+
+	txFields := d.txDesc.Fields()
+	tx := dynamicpb.NewMessage(d.txDesc)
+	tx.Set(txFields.ByName("body"), protoreflect.ValueOfMessage(body))
+	tx.Set(txFields.ByName("auth_info"), protoreflect.ValueOfMessage(authInfo))
+	signatures, rawSignatures := tx.Mutable(txFields.ByName("signatures")).List(), raw.Get(rawFields.ByName("signatures")).List()
+	for i := 0; i < rawSignatures.Len(); i++ {
+		signatures.Append(rawSignatures.Get(i))
+	}
+
+	return tx, nil
+}
+
+// DecodeJSON decodes the given transaction bytes like Decode and renders the
+// transaction as indented JSON, with its packed messages expanded.
+func (d *DescriptorDecoder) DecodeJSON(txBytes []byte) ([]byte, error) {
+	tx, err := d.Decode(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return protojson.MarshalOptions{
+		Multiline: true,
+		Indent:    "  ",
+		Resolver:  d.types,
+	}.Marshal(tx)
+}
+
+// unmarshal unmarshals bz into msg and checks its content recursively.
+func (d *DescriptorDecoder) unmarshal(bz []byte, msg protoreflect.ProtoMessage) error {
+	if err := (proto.UnmarshalOptions{Resolver: d.types}).Unmarshal(bz, msg); err != nil {
+		return err
+	}
+
+	return d.check(msg.ProtoReflect())
+}
+
+// check rejects the unknown critical fields of msg and of its nested messages,
+// and resolves its packed messages.
+func (d *DescriptorDecoder) check(msg protoreflect.Message) error {
+	desc := msg.Descriptor()
+	for unknown := msg.GetUnknown(); len(unknown) > 0; {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if num&nonCriticalFieldBit == 0 {
+			return fmt.Errorf("unknown field %d in %s", num, desc.FullName())
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, unknown[n:])
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		unknown = unknown[n+m:]
+	}
+
+	if desc.FullName() == anyFullName {
+		return d.checkAny(msg)
+	}
+
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = d.check(v.Message())
+				return err == nil
+			})
+		case fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = d.check(list.Get(i).Message())
+			}
+		default:
+			err = d.check(v.Message())
+		}
+
+		return err == nil
+	})
+
+	return err
+}
+
+// checkAny resolves the message packed in the given google.protobuf.Any and
+// checks it.
+func (d *DescriptorDecoder) checkAny(anyMsg protoreflect.Message) error {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	mt, err := d.types.FindMessageByURL(typeURL)
+	if err != nil {
+		return fmt.Errorf("unable to resolve %s: %w", typeURL, err)
+	}
+
+	msg := mt.New().Interface()
+	if err := d.unmarshal(anyMsg.Get(fields.ByName("value")).Bytes(), msg); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", typeURL, err)
+	}
+
+	return nil
+}
+
+// withWellKnownFiles returns the given files, along with the files of the
+// well-known google.protobuf types they depend on but which are missing.
+func withWellKnownFiles(files []*descriptorpb.FileDescriptorProto) []*descriptorpb.FileDescriptorProto {
+	known := make(map[string]bool, len(files))
+	for _, file := range files {
+		known[file.GetName()] = true
+	}
+
+	for _, file := range files {
+		for _, dep := range file.Dependency {
+			if known[dep] || !strings.HasPrefix(dep, "google/protobuf/") {
+				continue
+			}
+
+			if fd, err := protoregistry.GlobalFiles.FindFileByPath(dep); err == nil {
+				files = append(files, protodesc.ToFileDescriptorProto(fd))
+				known[dep] = true
+			}
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,112 @@
+package tx_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestDescriptorDecoder(t *testing.T) {
+	fdSet, err := gogoproto.MergedGlobalFileDescriptors()
+	require.NoError(t, err)
+
+	decoder, err := tx.NewDescriptorDecoder(fdSet.File)
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg, err := codectypes.NewAnyWithValue(testdata.NewTestMsg(addr))
+	require.NoError(t, err)
+
+	encode := func(body *txtypes.TxBody, extraBodyBytes []byte) []byte {
+		bodyBytes, err := body.Marshal()
+		require.NoError(t, err)
+		authInfoBytes, err := (&txtypes.AuthInfo{Fee: &txtypes.Fee{GasLimit: 100_000}}).Marshal()
+		require.NoError(t, err)
+
+		txBytes, err := (&txtypes.TxRaw{
+			BodyBytes:     append(bodyBytes, extraBodyBytes...),
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    [][]byte{[]byte("signature")},
+		}).Marshal()
+		require.NoError(t, err)
+
+		return txBytes
+	}
+
+	unknownField := func(num protowire.Number) []byte {
+		return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), 1)
+	}
+
+	testCases := []struct {
+		name      string
+		txBytes   []byte
+		expErrMsg string
+	}{
+		{
+			name:    "valid",
+			txBytes: encode(&txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "hello"}, nil),
+		},
+		{
+			name:    "unknown non-critical field",
+			txBytes: encode(&txtypes.TxBody{Messages: []*codectypes.Any{msg}}, unknownField(1025)),
+		},
+		{
+			name:      "unknown critical field",
+			txBytes:   encode(&txtypes.TxBody{Messages: []*codectypes.Any{msg}}, unknownField(20)),
+			expErrMsg: "unknown field 20 in cosmos.tx.v1beta1.TxBody",
+		},
+		{
+			name:      "unresolvable message",
+			txBytes:   encode(&txtypes.TxBody{Messages: []*codectypes.Any{{TypeUrl: "/foo.MsgBar"}}}, nil),
+			expErrMsg: "unable to resolve /foo.MsgBar",
+		},
+		{
+			name:      "invalid bytes",
+			txBytes:   []byte{0xff},
+			expErrMsg: "failed to decode tx",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := decoder.DecodeJSON(tc.txBytes)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// protojson randomizes its whitespace, so the output is decoded.
+			var decoded struct {
+				Body struct {
+					Messages []struct {
+						Type    string   `json:"@type"`
+						Signers []string `json:"signers"`
+					} `json:"messages"`
+				} `json:"body"`
+				AuthInfo struct {
+					Fee struct {
+						GasLimit string `json:"gasLimit"`
+					} `json:"fee"`
+				} `json:"authInfo"`
+			}
+			require.NoError(t, json.Unmarshal(bz, &decoded))
+			require.Len(t, decoded.Body.Messages, 1)
+			require.Equal(t, "/testpb.TestMsg", decoded.Body.Messages[0].Type)
+			require.Equal(t, []string{addr.String()}, decoded.Body.Messages[0].Signers)
+			require.Equal(t, "100000", decoded.AuthInfo.Fee.GasLimit)
+		})
+	}
+
+	_, err = tx.NewDescriptorDecoder(nil)
+	require.ErrorContains(t, err, "file descriptors do not define")
+}
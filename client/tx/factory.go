@@ -3,15 +3,12 @@ package tx
 import (
 	"errors"
 	"fmt"
-	"math/big"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/pflag"
 
-	"cosmossdk.io/math"
-
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -33,6 +30,7 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	timeoutTimestamp   time.Time
 	gasAdjustment      float64
 	chainID            string
 	fromName           string
@@ -40,6 +38,7 @@ type Factory struct {
 	offline            bool
 	generateOnly       bool
 	memo               string
+	idempotencyKey     string
 	fees               sdk.Coins
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
@@ -47,6 +46,7 @@ type Factory struct {
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	autoFees           bool
 	preprocessTxHook   client.PreprocessTxFn
 }
 
@@ -76,10 +76,22 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 
 	var accNum, accSeq uint64
 	if clientCtx.Offline {
-		if flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence) {
+		switch {
+		case flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence):
 			accNum = clientCtx.Viper.GetUint64(flags.FlagAccountNumber)
 			accSeq = clientCtx.Viper.GetUint64(flags.FlagSequence)
-		} else {
+		case clientCtx.SigningData != nil:
+			from, err := clientCtx.AddressCodec.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return Factory{}, err
+			}
+
+			acc, ok := clientCtx.SigningData.Account(from)
+			if !ok {
+				return Factory{}, fmt.Errorf("account %s not found in the signing data; set account-number and sequence", from)
+			}
+			accNum, accSeq = acc.AccountNumber, acc.Sequence
+		default:
 			return Factory{}, errors.New("account-number and sequence must be set in offline mode")
 		}
 	}
@@ -87,7 +99,12 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasAdj := clientCtx.Viper.GetFloat64(flags.FlagGasAdjustment)
 	memo := clientCtx.Viper.GetString(flags.FlagNote)
 	timeoutHeight := clientCtx.Viper.GetUint64(flags.FlagTimeoutHeight)
+	var timeoutTimestamp time.Time
+	if timeoutDuration := clientCtx.Viper.GetDuration(flags.FlagTimeoutDuration); timeoutDuration > 0 {
+		timeoutTimestamp = time.Now().Add(timeoutDuration)
+	}
 	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+	idempotencyKey := clientCtx.Viper.GetString(flags.FlagIdempotencyKey)
 
 	gasStr := clientCtx.Viper.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -105,9 +122,11 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		timeoutTimestamp:   timeoutTimestamp,
 		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
+		idempotencyKey:     idempotencyKey,
 		signMode:           signMode,
 		feeGranter:         clientCtx.FeeGranter,
 		feePayer:           clientCtx.FeePayer,
@@ -119,6 +138,20 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	if clientCtx.SigningData != nil && f.fees.IsZero() && f.gasPrices.IsZero() {
+		f = f.WithGasPrices(clientCtx.SigningData.MinGasPrices)
+	}
+
+	if clientCtx.Viper.GetBool(flags.FlagAutoFees) {
+		if !f.simulateAndExecute && !clientCtx.Simulate {
+			return Factory{}, fmt.Errorf("--%s requires --%s=%s", flags.FlagAutoFees, flags.FlagGas, flags.GasFlagAuto)
+		}
+		if !f.fees.IsZero() || !f.gasPrices.IsZero() {
+			return Factory{}, fmt.Errorf("cannot provide --%s with fees or gas prices", flags.FlagAutoFees)
+		}
+		f = f.WithAutoFees(true)
+	}
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -131,10 +164,12 @@ func (f Factory) GasAdjustment() float64                    { return f.gasAdjust
 func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
+func (f Factory) IdempotencyKey() string                    { return f.idempotencyKey }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
 func (f Factory) Unordered() bool                           { return f.unordered }
 func (f Factory) FromName() string                          { return f.fromName }
 
@@ -142,6 +177,10 @@ func (f Factory) FromName() string                          { return f.fromName
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// AutoFees returns the option to set the fees to the fees suggested by the
+// node for the gas estimated by the simulation.
+func (f Factory) AutoFees() bool { return f.autoFees }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -213,6 +252,16 @@ func (f Factory) WithMemo(memo string) Factory {
 	return f
 }
 
+// WithIdempotencyKey returns a copy of the Factory with an updated idempotency
+// key. The key is stored in the memo of the built transactions, and
+// BroadcastTx checks that no transaction carrying it was already committed
+// before broadcasting, so that a broadcast can safely be retried with the same
+// key. See NewIdempotencyKey.
+func (f Factory) WithIdempotencyKey(key string) Factory {
+	f.idempotencyKey = key
+	return f
+}
+
 // WithAccountNumber returns a copy of the Factory with an updated account number.
 func (f Factory) WithAccountNumber(accnum uint64) Factory {
 	f.accountNumber = accnum
@@ -232,6 +281,13 @@ func (f Factory) WithSimulateAndExecute(sim bool) Factory {
 	return f
 }
 
+// WithAutoFees returns a copy of the Factory with an updated option to set the
+// fees suggested by the node after the gas simulation.
+func (f Factory) WithAutoFees(autoFees bool) Factory {
+	f.autoFees = autoFees
+	return f
+}
+
 // SignMode returns the sign mode configured in the Factory
 func (f Factory) SignMode() signing.SignMode {
 	return f.signMode
@@ -249,6 +305,12 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithTimeoutTimestamp returns a copy of the Factory with an updated timeout timestamp.
+func (f Factory) WithTimeoutTimestamp(timestamp time.Time) Factory {
+	f.timeoutTimestamp = timestamp
+	return f
+}
+
 // WithUnordered returns a copy of the Factory with an updated unordered field.
 func (f Factory) WithUnordered(v bool) Factory {
 	f.unordered = v
@@ -330,18 +392,8 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 			return nil, errors.New("cannot provide both fees and gas prices")
 		}
 
-		// f.gas is a uint64 and we should convert to LegacyDec
-		// without the risk of under/overflow via uint64->int64.
-		glDec := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(f.gas))
-
-		// Derive the fees based on the provided gas prices, where
-		// fee = ceil(gasPrice * gasLimit).
-		fees = make(sdk.Coins, len(f.gasPrices))
-
-		for i, gp := range f.gasPrices {
-			fee := gp.Amount.Mul(glDec)
-			fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-		}
+		// Derive the fees based on the provided gas prices.
+		fees = feesForGas(f.gasPrices, f.gas)
 	}
 
 	// Prevent simple inclusion of a valid mnemonic in the memo field
@@ -349,18 +401,27 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, errors.New("cannot provide a valid mnemonic seed in the memo field")
 	}
 
+	memo := f.memo
+	if f.idempotencyKey != "" {
+		if err := validateIdempotencyKey(f.idempotencyKey); err != nil {
+			return nil, err
+		}
+		memo = MemoWithIdempotencyKey(memo, f.idempotencyKey)
+	}
+
 	tx := f.txConfig.NewTxBuilder()
 
 	if err := tx.SetMsgs(msgs...); err != nil {
 		return nil, err
 	}
 
-	tx.SetMemo(f.memo)
+	tx.SetMemo(memo)
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(f.gas)
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetTimeoutTimestamp(f.TimeoutTimestamp())
 
 	if etx, ok := tx.(client.ExtendedTxBuilder); ok {
 		etx.SetExtensionOptions(f.extOptions...)
@@ -391,8 +452,10 @@ func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) erro
 			return err
 		}
 
-		f = f.WithGas(adjusted)
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: f.Gas()})
+		f, err = printGasEstimate(clientCtx, f.WithGas(adjusted))
+		if err != nil {
+			return err
+		}
 	}
 
 	unsignedTx, err := f.BuildUnsignedTx(msgs...)
@@ -463,6 +526,11 @@ func (f Factory) getSimPK() (cryptotypes.PubKey, error) {
 			return nil, err
 		}
 
+		// watch-only keys have no public key, the default one is used.
+		if record.GetWatch() != nil {
+			return pk, nil
+		}
+
 		pk, ok = record.PubKey.GetCachedValue().(cryptotypes.PubKey)
 		if !ok {
 			return nil, errors.New("cannot build signature for simulation, failed to convert proto Any to public key")
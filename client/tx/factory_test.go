@@ -1,12 +1,19 @@
 package tx
 
 import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -74,6 +81,15 @@ func TestFactory_getSimPKType(t *testing.T) {
 			},
 			wantType: (*multisig.LegacyAminoPubKey)(nil),
 		},
+		{
+			name:     "watch-only key",
+			fromName: "watchKey",
+			genKey: func(fromName string, k keyring.Keyring) error {
+				_, err := k.SaveWatchKey(fromName, secp256k1.GenPrivKey().PubKey().Address())
+				return err
+			},
+			wantType: (*secp256k1.PubKey)(nil),
+		},
 	}
 
 	for _, tt := range tests {
@@ -117,3 +133,65 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFactoryCLISigningData(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+	_, _, addr := testdata.KeyTestPubAddr()
+	addrStr, err := addressCodec.BytesToString(addr)
+	require.NoError(t, err)
+
+	signingData := client.SigningData{
+		ChainID:      "test-chain",
+		Height:       10,
+		MinGasPrices: "0.025stake",
+		Accounts:     []client.SigningAccount{{Address: addrStr, AccountNumber: 7, Sequence: 3}},
+	}
+	bz, err := json.Marshal(signingData)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "signing-data.json")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	newFactory := func(args ...string) (Factory, error) {
+		cmd := &cobra.Command{}
+		flags.AddTxFlagsToCmd(cmd)
+		require.NoError(t, cmd.ParseFlags(append(args, "--generate-only", "--from", addrStr)))
+		cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{AddressCodec: addressCodec}))
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return Factory{}, err
+		}
+		require.True(t, clientCtx.Offline)
+
+		return NewFactoryCLI(clientCtx, cmd.Flags())
+	}
+
+	// the chain ID, account number, sequence and gas prices are loaded from
+	// the signing data.
+	txf, err := newFactory("--signing-data", path)
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", txf.ChainID())
+	require.Equal(t, uint64(7), txf.AccountNumber())
+	require.Equal(t, uint64(3), txf.Sequence())
+	require.True(t, txf.Fees().IsZero())
+	require.Equal(t, "0.025000000000000000stake", txf.GasPrices().String())
+
+	// the flags take precedence.
+	txf, err = newFactory("--signing-data", path, "--account-number", "1", "--sequence", "2", "--fees", "10stake")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), txf.AccountNumber())
+	require.Equal(t, uint64(2), txf.Sequence())
+	require.True(t, txf.GasPrices().IsZero())
+
+	// the chain ID must match the signing data.
+	_, err = newFactory("--signing-data", path, "--chain-id", "other-chain")
+	require.ErrorContains(t, err, "doesn't match the chain ID test-chain of the signing data")
+
+	// the signer must be in the signing data.
+	signingData.Accounts = nil
+	bz, err = json.Marshal(signingData)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	_, err = newFactory("--signing-data", path)
+	require.ErrorContains(t, err, "not found in the signing data")
+}
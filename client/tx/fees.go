@@ -0,0 +1,65 @@
+package tx
+
+import (
+	"context"
+	"math/big"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SuggestGasPrices returns the gas prices a transaction must pay to be accepted
+// by the node: the min gas prices param of the chain if set, or else the
+// minimum gas prices configured by the node.
+func SuggestGasPrices(clientCtx gogogrpc.ClientConn) (sdk.DecCoins, error) {
+	ctx := context.Background()
+
+	// the chains without the min gas prices param fail the query or leave it
+	// empty, the node minimum gas prices applying.
+	params, err := authtypes.NewQueryClient(clientCtx).Params(ctx, &authtypes.QueryParamsRequest{})
+	if err == nil && !params.Params.MinGasPrices.IsZero() {
+		return params.Params.MinGasPrices, nil
+	}
+
+	config, err := node.NewServiceClient(clientCtx).Config(ctx, &node.ConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseDecCoins(config.MinimumGasPrice)
+}
+
+// SuggestFees returns the fees suggested for a transaction consuming the given
+// gas, i.e. the gas prices returned by SuggestGasPrices multiplied by the gas.
+// The fees are empty if the node accepts transactions without fees.
+func SuggestFees(clientCtx gogogrpc.ClientConn, gas uint64) (sdk.Coins, error) {
+	gasPrices, err := SuggestGasPrices(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return feesForGas(gasPrices, gas), nil
+}
+
+// feesForGas returns the fees paid for the gas at the given gas prices, where
+// fee = ceil(gasPrice * gasLimit).
+func feesForGas(gasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	// gas is a uint64 and we should convert to LegacyDec
+	// without the risk of under/overflow via uint64->int64.
+	glDec := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+
+	fees := make(sdk.Coins, 0, len(gasPrices))
+	for _, gp := range gasPrices {
+		fee := gp.Amount.Mul(glDec).Ceil().RoundInt()
+		if fee.IsPositive() {
+			fees = append(fees, sdk.NewCoin(gp.Denom, fee))
+		}
+	}
+
+	return fees
+}
@@ -0,0 +1,126 @@
+package tx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// IdempotencyKeyMemoPrefix prefixes the idempotency key of a transaction,
+	// stored on the last line of its memo.
+	IdempotencyKeyMemoPrefix = "idempotency-key:"
+
+	// IdempotencyKeyLookback is the number of sequences of the signer, preceding
+	// its current sequence, searched for a committed transaction carrying the
+	// idempotency key of a broadcast.
+	IdempotencyKeyLookback = 10
+
+	// maxIdempotencyKeyLen is the maximum length of an idempotency key, so that
+	// it fits in the memo along with a note.
+	maxIdempotencyKeyLen = 64
+)
+
+// NewIdempotencyKey returns a random idempotency key. The key must be kept by
+// the client and reused when retrying the broadcast of the same transaction.
+func NewIdempotencyKey() (string, error) {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
+
+// validateIdempotencyKey returns an error if the idempotency key is empty, too
+// long or contains whitespaces.
+func validateIdempotencyKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("idempotency key cannot be empty")
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return fmt.Errorf("idempotency key cannot be longer than %d characters", maxIdempotencyKeyLen)
+	}
+	if strings.ContainsAny(key, " \t\r\n") {
+		return fmt.Errorf("idempotency key cannot contain whitespaces")
+	}
+
+	return nil
+}
+
+// MemoWithIdempotencyKey appends the idempotency key to the memo, on its own
+// line.
+func MemoWithIdempotencyKey(memo, key string) string {
+	if memo == "" {
+		return IdempotencyKeyMemoPrefix + key
+	}
+
+	return memo + "\n" + IdempotencyKeyMemoPrefix + key
+}
+
+// IdempotencyKeyFromMemo returns the idempotency key stored on the last line of
+// the memo, if any.
+func IdempotencyKeyFromMemo(memo string) (string, bool) {
+	line := memo
+	if i := strings.LastIndexByte(memo, '\n'); i >= 0 {
+		line = memo[i+1:]
+	}
+
+	key, ok := strings.CutPrefix(line, IdempotencyKeyMemoPrefix)
+	if !ok || key == "" {
+		return "", false
+	}
+
+	return key, true
+}
+
+// QueryTxByIdempotencyKey searches the committed transactions signed by the
+// signer with a sequence in [fromSeq, toSeq), from the latest one, for one
+// carrying the idempotency key in its memo, and returns its response. It
+// returns nil if no transaction carries the key. The node must index the
+// acc_seq attribute of the tx events.
+func QueryTxByIdempotencyKey(conn gogogrpc.ClientConn, signer, key string, fromSeq, toSeq uint64) (*sdk.TxResponse, error) {
+	txSvcClient := tx.NewServiceClient(conn)
+	for seq := toSeq; seq > fromSeq; {
+		seq--
+		res, err := txSvcClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Query: fmt.Sprintf("%s.%s='%s/%d'", sdk.EventTypeTx, sdk.AttributeKeyAccountSequence, signer, seq),
+			Page:  1,
+			Limit: 1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the transaction of sequence %d of %s: %w", seq, signer, err)
+		}
+
+		for i, committedTx := range res.Txs {
+			if committedTx.Body == nil || i >= len(res.TxResponses) {
+				continue
+			}
+			if txKey, ok := IdempotencyKeyFromMemo(committedTx.Body.Memo); ok && txKey == key {
+				return res.TxResponses[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// queryCommittedTx returns the response of the transaction carrying the
+// idempotency key of the factory, if one of the last transactions of the
+// signer carries it. The factory must be prepared.
+func queryCommittedTx(conn gogogrpc.ClientConn, txf Factory, signer string) (*sdk.TxResponse, error) {
+	toSeq := txf.Sequence()
+	fromSeq := uint64(0)
+	if toSeq > IdempotencyKeyLookback {
+		fromSeq = toSeq - IdempotencyKeyLookback
+	}
+
+	return QueryTxByIdempotencyKey(conn, signer, txf.IdempotencyKey(), fromSeq, toSeq)
+}
@@ -0,0 +1,103 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// mockTxsEventContext is a mock client.Context returning the committed
+// transactions by tx.acc_seq query, used to unit test QueryTxByIdempotencyKey.
+type mockTxsEventContext struct {
+	memos   map[string]string
+	queries []string
+}
+
+func (m *mockTxsEventContext) Invoke(_ context.Context, _ string, req, reply interface{}, _ ...grpc.CallOption) error {
+	query := req.(*txtypes.GetTxsEventRequest).Query
+	m.queries = append(m.queries, query)
+
+	res := reply.(*txtypes.GetTxsEventResponse)
+	*res = txtypes.GetTxsEventResponse{}
+	if memo, ok := m.memos[query]; ok {
+		res.Txs = []*txtypes.Tx{{Body: &txtypes.TxBody{Memo: memo}}}
+		res.TxResponses = []*sdk.TxResponse{{TxHash: strings.ToUpper(memo)}}
+		res.Total = 1
+	}
+
+	return nil
+}
+
+func (*mockTxsEventContext) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestIdempotencyKeyMemo(t *testing.T) {
+	key, err := NewIdempotencyKey()
+	require.NoError(t, err)
+	require.NoError(t, validateIdempotencyKey(key))
+
+	for _, memo := range []string{"", "memo", "multi\nline memo"} {
+		got, ok := IdempotencyKeyFromMemo(MemoWithIdempotencyKey(memo, key))
+		require.True(t, ok)
+		require.Equal(t, key, got)
+	}
+
+	_, ok := IdempotencyKeyFromMemo("memo")
+	require.False(t, ok)
+	_, ok = IdempotencyKeyFromMemo(IdempotencyKeyMemoPrefix)
+	require.False(t, ok)
+
+	for _, invalid := range []string{"", "with space", strings.Repeat("k", maxIdempotencyKeyLen+1)} {
+		require.Error(t, validateIdempotencyKey(invalid), invalid)
+	}
+}
+
+func TestBuildUnsignedTxWithIdempotencyKey(t *testing.T) {
+	txConfig, _ := newTestTxConfig()
+	msg := &countertypes.MsgIncreaseCounter{Signer: sdk.AccAddress("from").String(), Count: 1}
+
+	tx, err := mockTxFactory(txConfig).WithIdempotencyKey("key").BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.Equal(t, "memo\nidempotency-key:key", tx.GetTx().GetMemo())
+
+	_, err = mockTxFactory(txConfig).WithIdempotencyKey("invalid key").BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "whitespaces")
+}
+
+func TestQueryTxByIdempotencyKey(t *testing.T) {
+	signer := sdk.AccAddress("from").String()
+	query := func(seq uint64) string {
+		return fmt.Sprintf("tx.acc_seq='%s/%d'", signer, seq)
+	}
+	conn := &mockTxsEventContext{memos: map[string]string{
+		query(3): MemoWithIdempotencyKey("memo", "other"),
+		query(4): MemoWithIdempotencyKey("memo", "key"),
+	}}
+
+	res, err := QueryTxByIdempotencyKey(conn, signer, "key", 0, 6)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	// the sequences are searched from the latest one.
+	require.Equal(t, []string{query(5), query(4)}, conn.queries)
+
+	res, err = QueryTxByIdempotencyKey(conn, signer, "key", 0, 4)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	txf := mockTxFactory(nil).WithSequence(IdempotencyKeyLookback + 5).WithIdempotencyKey("key")
+	conn.queries = nil
+	res, err = queryCommittedTx(conn, txf, signer)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Len(t, conn.queries, IdempotencyKeyLookback)
+	require.Equal(t, query(5), conn.queries[len(conn.queries)-1])
+}
@@ -3,10 +3,12 @@ package tx
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -76,6 +79,24 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	// a broadcast retried with the same idempotency key is not sent again once
+	// the transaction was committed, e.g. after a broadcast timeout.
+	if txf.IdempotencyKey() != "" && !txf.Unordered() && !clientCtx.Offline {
+		signer, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+		if err != nil {
+			return err
+		}
+
+		res, err := queryCommittedTx(clientCtx, txf, signer)
+		if err != nil {
+			return err
+		}
+		if res != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "transaction with idempotency key %s already committed\n", txf.IdempotencyKey())
+			return clientCtx.PrintProto(res)
+		}
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -86,8 +107,10 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 			return err
 		}
 
-		txf = txf.WithGas(adjusted)
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
+		txf, err = printGasEstimate(clientCtx, txf.WithGas(adjusted))
+		if err != nil {
+			return err
+		}
 	}
 
 	if clientCtx.Simulate {
@@ -327,12 +350,29 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		return err
 	}
 
+	// Enforce the signing policy of the keyring before signing and record the
+	// signing operation in its audit log once signed
+	auditor, hasAuditor := txf.keybase.(keyring.SigningAuditor)
+	var signOp keyring.SignOperation
+	if hasAuditor {
+		signOp = newSignOperation(name, txf.chainID, txBuilder.GetTx().GetMsgs(), bytesToSign)
+		if err := auditor.AuthorizeSign(signOp); err != nil {
+			return err
+		}
+	}
+
 	// Sign those bytes
 	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign, signMode)
 	if err != nil {
 		return err
 	}
 
+	if hasAuditor {
+		if err := auditor.AuditSign(signOp); err != nil {
+			return fmt.Errorf("unable to record the signing operation in the audit log: %w", err)
+		}
+	}
+
 	// Construct the SignatureV2 struct
 	sigData = signing.SingleSignatureData{
 		SignMode:  signMode,
@@ -360,13 +400,58 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 	return txf.PreprocessTx(name, txBuilder)
 }
 
+// newSignOperation returns the signing operation of the given sign bytes of a
+// transaction, for the signing policy and audit log of the keyring.
+func newSignOperation(name, chainID string, msgs []sdk.Msg, bytesToSign []byte) keyring.SignOperation {
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+	}
+	hash := sha256.Sum256(bytesToSign)
+
+	return keyring.SignOperation{
+		Key:           name,
+		ChainID:       chainID,
+		MsgTypes:      msgTypes,
+		SignBytesHash: hash[:],
+		Time:          time.Now().UTC(),
+	}
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
-	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
+	GasEstimate   uint64    `json:"gas_estimate" yaml:"gas_estimate"`
+	SuggestedFees sdk.Coins `json:"suggested_fees,omitempty" yaml:"suggested_fees,omitempty"`
 }
 
 func (gr GasEstimateResponse) String() string {
-	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	if gr.SuggestedFees.IsZero() {
+		return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	}
+	return fmt.Sprintf("gas estimate: %d\nsuggested fees: %s", gr.GasEstimate, gr.SuggestedFees)
+}
+
+// printGasEstimate prints the gas estimated for the transaction along with the
+// fees suggested by the node for this gas. If the auto fees option is set, it
+// returns a copy of the factory paying the suggested fees.
+func printGasEstimate(clientCtx client.Context, txf Factory) (Factory, error) {
+	res := GasEstimateResponse{GasEstimate: txf.Gas()}
+
+	// the fees are only suggested on a best effort basis, unless they are
+	// required to set the fees of the transaction.
+	fees, err := SuggestFees(clientCtx, txf.Gas())
+	if err != nil && txf.AutoFees() {
+		return txf, fmt.Errorf("failed to suggest fees: %w", err)
+	}
+	res.SuggestedFees = fees
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", res)
+
+	if txf.AutoFees() {
+		txf.fees = fees
+	}
+
+	return txf, nil
 }
 
 // makeAuxSignerData generates an AuxSignerData from the client inputs.
@@ -2,18 +2,24 @@ package tx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
+	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -371,6 +377,53 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignAuditAndPolicy(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	dir := t.TempDir()
+	kb, err := keyring.New(t.Name(), "test", dir, nil, cdc)
+	require.NoError(t, err)
+
+	k, _, err := kb.NewMnemonic("test_key", keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	txf := mockTxFactory(txConfig).WithKeybase(kb).WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	txb, err := txf.BuildUnsignedTx(&countertypes.MsgIncreaseCounter{Signer: addr.String(), Count: 1})
+	require.NoError(t, err)
+
+	// without policy file, the signing operations are only recorded
+	require.NoError(t, Sign(context.TODO(), txf, "test_key", txb, true))
+
+	bz, err := os.ReadFile(filepath.Join(dir, keyring.SigningAuditLogFileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 1)
+	var op keyring.SignOperation
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &op))
+	require.Equal(t, "test_key", op.Key)
+	require.Equal(t, "test-chain", op.ChainID)
+	require.Equal(t, []string{sdk.MsgTypeURL(&countertypes.MsgIncreaseCounter{})}, op.MsgTypes)
+	require.Len(t, op.SignBytesHash, 32)
+
+	policyFile := filepath.Join(dir, keyring.SigningPolicyFileName)
+	require.NoError(t, os.WriteFile(policyFile, []byte(`{"test_key": {"chain_ids": ["other-chain"]}}`), 0o600))
+	err = Sign(context.TODO(), txf, "test_key", txb, true)
+	require.ErrorIs(t, err, keyring.ErrSigningPolicy)
+
+	require.NoError(t, os.WriteFile(policyFile, []byte(`{"*": {"chain_ids": ["test-chain"], "msg_types": ["/cosmos.bank.v1beta1.MsgSend"]}}`), 0o600))
+	err = Sign(context.TODO(), txf, "test_key", txb, true)
+	require.ErrorIs(t, err, keyring.ErrSigningPolicy)
+
+	require.NoError(t, os.WriteFile(policyFile, []byte(`{"test_key": {"chain_ids": ["test-chain"]}}`), 0o600))
+	require.NoError(t, Sign(context.TODO(), txf, "test_key", txb, true))
+
+	// the refused signing operations are not recorded
+	bz, err = os.ReadFile(filepath.Join(dir, keyring.SigningAuditLogFileName))
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(bz)), "\n"), 2)
+}
+
 func TestPreprocessHook(t *testing.T) {
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -442,3 +495,64 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+// mockFeesContext is a mock client.Context returning the min gas prices param of
+// the chain and the minimum gas price of the node, used to unit test SuggestFees.
+type mockFeesContext struct {
+	paramsMinGasPrices sdk.DecCoins
+	nodeMinGasPrice    string
+}
+
+func (m mockFeesContext) Invoke(_ context.Context, method string, _, reply interface{}, _ ...grpc.CallOption) error {
+	switch reply := reply.(type) {
+	case *authtypes.QueryParamsResponse:
+		if m.paramsMinGasPrices == nil {
+			return fmt.Errorf("unknown method %s", method)
+		}
+		reply.Params.MinGasPrices = m.paramsMinGasPrices
+	case *node.ConfigResponse:
+		reply.MinimumGasPrice = m.nodeMinGasPrice
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+
+	return nil
+}
+
+func (mockFeesContext) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestSuggestFees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ctx     mockFeesContext
+		expFees sdk.Coins
+	}{
+		{
+			name:    "node minimum gas price",
+			ctx:     mockFeesContext{nodeMinGasPrice: "0.025stake,0.001atom"},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 2501), sdk.NewInt64Coin("atom", 101)),
+		},
+		{
+			name: "min gas prices param",
+			ctx: mockFeesContext{
+				paramsMinGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 2))),
+				nodeMinGasPrice:    "0.025stake",
+			},
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 5001)),
+		},
+		{
+			name: "no fees required",
+			ctx:  mockFeesContext{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := SuggestFees(tc.ctx, 100_010)
+			require.NoError(t, err)
+			require.True(t, tc.expFees.Equal(fees), "expected %s, got %s", tc.expFees, fees)
+		})
+	}
+}
@@ -227,7 +227,7 @@ func (pc *ProtoCodec) MarshalInterface(i gogoproto.Message) ([]byte, error) {
 	if err := assertNotNil(i); err != nil {
 		return nil, err
 	}
-	any, err := types.NewAnyWithValue(i)
+	any, err := pc.interfaceRegistry.PackAny(i)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressedAnyTypeURL is the type URL of CompressedAny.
+	CompressedAnyTypeURL = "/cosmos.codec.v1.CompressedAny"
+
+	// CompressionThreshold is the size above which the values of the types
+	// registered with RegisterCompressedImplementations are compressed.
+	CompressionThreshold = 1024
+
+	// MaxDecompressedSize is the maximum size of the value of a CompressedAny
+	// once decompressed.
+	MaxDecompressedSize = 4 << 20
+)
+
+var (
+	// zstd encoders and decoders are safe for concurrent use with EncodeAll and
+	// DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxDecompressedSize))
+)
+
+// CompressAny returns a CompressedAny packed into an Any, holding the
+// compressed value of the given Any. The cached value of the given Any is kept.
+func CompressAny(any *Any) (*Any, error) {
+	if any.TypeUrl == CompressedAnyTypeURL {
+		return nil, errors.New("any is already compressed")
+	}
+	if len(any.Value) > MaxDecompressedSize {
+		return nil, fmt.Errorf("any value size %d exceeds the maximum decompressed size %d", len(any.Value), MaxDecompressedSize)
+	}
+
+	bz, err := proto.Marshal(&CompressedAny{
+		TypeUrl:          any.TypeUrl,
+		Value:            zstdEncoder.EncodeAll(any.Value, nil),
+		UncompressedSize: uint64(len(any.Value)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compressed := &Any{}
+	if msg, ok := any.GetCachedValue().(proto.Message); ok {
+		if compressed, err = NewAnyWithValue(msg); err != nil {
+			return nil, err
+		}
+	}
+	compressed.TypeUrl = CompressedAnyTypeURL
+	compressed.Value = bz
+
+	return compressed, nil
+}
+
+// DecompressAny returns the Any compressed in the given CompressedAny packed
+// into an Any.
+func DecompressAny(any *Any) (*Any, error) {
+	var compressed CompressedAny
+	if err := proto.Unmarshal(any.Value, &compressed); err != nil {
+		return nil, err
+	}
+
+	if compressed.TypeUrl == CompressedAnyTypeURL {
+		return nil, errors.New("compressed any cannot be compressed again")
+	}
+	if compressed.UncompressedSize > MaxDecompressedSize {
+		return nil, fmt.Errorf("compressed any size %d exceeds the maximum decompressed size %d", compressed.UncompressedSize, MaxDecompressedSize)
+	}
+
+	value, err := zstdDecoder.DecodeAll(compressed.Value, make([]byte, 0, compressed.UncompressedSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress any: %w", err)
+	}
+	if uint64(len(value)) != compressed.UncompressedSize {
+		return nil, fmt.Errorf("decompressed any size %d does not match the uncompressed size %d", len(value), compressed.UncompressedSize)
+	}
+
+	return &Any{TypeUrl: compressed.TypeUrl, Value: value}, nil
+}
+
+// DecompressedSize returns the total uncompressed size of the compressed Anys
+// nested in x, which must have been unpacked, so that the decompression can be
+// charged gas.
+func DecompressedSize(x interface{}) (uint64, error) {
+	counter := &decompressedSizeCounter{}
+	if err := UnpackInterfaces(x, counter); err != nil {
+		return 0, err
+	}
+
+	return counter.size, nil
+}
+
+// decompressedSizeCounter is an AnyUnpacker walking through the cached values
+// of unpacked Anys to sum the uncompressed sizes of the compressed ones.
+type decompressedSizeCounter struct {
+	size uint64
+}
+
+func (c *decompressedSizeCounter) UnpackAny(any *Any, iface interface{}) error {
+	if any == nil {
+		return nil
+	}
+
+	if any.TypeUrl == CompressedAnyTypeURL {
+		var compressed CompressedAny
+		if err := proto.Unmarshal(any.Value, &compressed); err != nil {
+			return err
+		}
+		c.size += compressed.UncompressedSize
+	}
+
+	cachedValue := any.GetCachedValue()
+	if cachedValue == nil {
+		return nil
+	}
+
+	if rv := reflect.ValueOf(iface); rv.Kind() == reflect.Ptr && reflect.TypeOf(cachedValue).AssignableTo(rv.Elem().Type()) {
+		rv.Elem().Set(reflect.ValueOf(cachedValue))
+	}
+
+	return UnpackInterfaces(cachedValue, c)
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestPackAnyCompression(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	registry.RegisterInterface("testpb.Animal", (*testdata.Animal)(nil))
+	registry.RegisterCompressedImplementations((*testdata.Animal)(nil), &testdata.Dog{})
+	registry.RegisterImplementations((*testdata.Animal)(nil), &testdata.Cat{})
+
+	// small values are not compressed
+	smallDog := &testdata.Dog{Name: "Spot"}
+	any, err := registry.PackAny(smallDog)
+	require.NoError(t, err)
+	require.Equal(t, "/testpb.Dog", any.TypeUrl)
+
+	// large values of types registered as compressed are compressed
+	largeDog := &testdata.Dog{Name: strings.Repeat("Spot", types.CompressionThreshold)}
+	any, err = registry.PackAny(largeDog)
+	require.NoError(t, err)
+	require.Equal(t, types.CompressedAnyTypeURL, any.TypeUrl)
+	require.Less(t, len(any.Value), types.CompressionThreshold)
+	require.Equal(t, largeDog, any.GetCachedValue())
+
+	largeCat := &testdata.Cat{Moniker: strings.Repeat("Garfield", types.CompressionThreshold)}
+	catAny, err := registry.PackAny(largeCat)
+	require.NoError(t, err)
+	require.Equal(t, "/testpb.Cat", catAny.TypeUrl)
+
+	// compressed anys are transparently unpacked, and stay compressed
+	bz, err := proto.Marshal(&testdata.HasAnimal{Animal: any, X: 1})
+	require.NoError(t, err)
+	var hasAnimal testdata.HasAnimal
+	require.NoError(t, proto.Unmarshal(bz, &hasAnimal))
+	require.NoError(t, types.UnpackInterfaces(&hasAnimal, registry))
+	require.Equal(t, largeDog, hasAnimal.Animal.GetCachedValue())
+	require.Equal(t, types.CompressedAnyTypeURL, hasAnimal.Animal.TypeUrl)
+	require.Equal(t, any.Value, hasAnimal.Animal.Value)
+
+	size, err := types.DecompressedSize(&hasAnimal)
+	require.NoError(t, err)
+	require.Equal(t, uint64(largeDog.Size()), size)
+
+	// the types of compressed anys must be registered as compressed
+	compressedCat, err := types.CompressAny(catAny)
+	require.NoError(t, err)
+	var animal testdata.Animal
+	err = registry.UnpackAny(&types.Any{TypeUrl: compressedCat.TypeUrl, Value: compressedCat.Value}, &animal)
+	require.ErrorContains(t, err, "not registered as compressed")
+}
+
+func TestProtoCodecInterfaceCompression(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	registry.RegisterInterface("testpb.Animal", (*testdata.Animal)(nil))
+	registry.RegisterCompressedImplementations((*testdata.Animal)(nil), &testdata.Dog{})
+	cdc := codec.NewProtoCodec(registry)
+
+	largeDog := &testdata.Dog{Name: strings.Repeat("Spot", types.CompressionThreshold)}
+	bz, err := cdc.MarshalInterface(largeDog)
+	require.NoError(t, err)
+	require.Less(t, len(bz), largeDog.Size())
+
+	var animal testdata.Animal
+	require.NoError(t, cdc.UnmarshalInterface(bz, &animal))
+	require.Equal(t, largeDog, animal)
+
+	// compressed anys are encoded to JSON as CompressedAny
+	any, err := registry.PackAny(largeDog)
+	require.NoError(t, err)
+	jsonBz, err := cdc.MarshalJSON(&testdata.HasAnimal{Animal: any})
+	require.NoError(t, err)
+	require.Contains(t, string(jsonBz), types.CompressedAnyTypeURL)
+
+	var hasAnimal testdata.HasAnimal
+	require.NoError(t, cdc.UnmarshalJSON(jsonBz, &hasAnimal))
+	require.Equal(t, largeDog, hasAnimal.Animal.GetCachedValue())
+}
+
+func TestDecompressAny(t *testing.T) {
+	dog := &testdata.Dog{Name: strings.Repeat("Spot", types.CompressionThreshold)}
+	any, err := types.NewAnyWithValue(dog)
+	require.NoError(t, err)
+	compressed, err := types.CompressAny(any)
+	require.NoError(t, err)
+
+	decompressed, err := types.DecompressAny(compressed)
+	require.NoError(t, err)
+	require.Equal(t, any.TypeUrl, decompressed.TypeUrl)
+	require.Equal(t, any.Value, decompressed.Value)
+
+	_, err = types.CompressAny(compressed)
+	require.Error(t, err)
+
+	var compressedAny types.CompressedAny
+	require.NoError(t, proto.Unmarshal(compressed.Value, &compressedAny))
+
+	// the uncompressed size must match the decompressed value
+	compressedAny.UncompressedSize--
+	bz, err := proto.Marshal(&compressedAny)
+	require.NoError(t, err)
+	_, err = types.DecompressAny(&types.Any{TypeUrl: types.CompressedAnyTypeURL, Value: bz})
+	require.Error(t, err)
+
+	// the uncompressed size is bounded
+	compressedAny.UncompressedSize = types.MaxDecompressedSize + 1
+	bz, err = proto.Marshal(&compressedAny)
+	require.NoError(t, err)
+	_, err = types.DecompressAny(&types.Any{TypeUrl: types.CompressedAnyTypeURL, Value: bz})
+	require.ErrorContains(t, err, "exceeds the maximum decompressed size")
+}
@@ -80,6 +80,17 @@ type InterfaceRegistry interface {
 	// EnsureRegistered ensures there is a registered interface for the given concrete type.
 	EnsureRegistered(iface interface{}) error
 
+	// RegisterCompressedImplementations registers concrete proto Messages which
+	// implement the given interface, like RegisterImplementations, and whose
+	// values larger than CompressionThreshold are compressed by PackAny.
+	RegisterCompressedImplementations(iface interface{}, impls ...protoiface.MessageV1)
+
+	// PackAny packs the given proto Message into an Any, compressed into a
+	// CompressedAny if its type was registered with
+	// RegisterCompressedImplementations and its value is larger than
+	// CompressionThreshold.
+	PackAny(v proto.Message) (*Any, error)
+
 	protodesc.Resolver
 
 	// RangeFiles iterates over all registered files and calls f on each one. This
@@ -102,6 +113,8 @@ type interfaceRegistry struct {
 	implInterfaces map[reflect.Type]reflect.Type
 	typeURLMap     map[string]reflect.Type
 	signingCtx     *signing.Context
+
+	compressedTypeURLs map[string]struct{}
 }
 
 type interfaceMap = map[string]reflect.Type
@@ -143,12 +156,14 @@ func NewInterfaceRegistryWithOptions(options InterfaceRegistryOptions) (Interfac
 	}
 
 	return &interfaceRegistry{
-		interfaceNames:    map[string]reflect.Type{},
-		interfaceImpls:    map[reflect.Type]interfaceMap{},
-		implInterfaces:    map[reflect.Type]reflect.Type{},
-		typeURLMap:        map[string]reflect.Type{},
-		ProtoFileResolver: options.ProtoFiles,
-		signingCtx:        signingCtx,
+		interfaceNames: map[string]reflect.Type{},
+		interfaceImpls: map[reflect.Type]interfaceMap{},
+		implInterfaces: map[reflect.Type]reflect.Type{},
+		// CompressedAny is resolved to encode compressed Anys to JSON
+		typeURLMap:         map[string]reflect.Type{CompressedAnyTypeURL: reflect.TypeOf(&CompressedAny{})},
+		ProtoFileResolver:  options.ProtoFiles,
+		signingCtx:         signingCtx,
+		compressedTypeURLs: map[string]struct{}{},
 	}, nil
 }
 
@@ -189,6 +204,36 @@ func (registry *interfaceRegistry) RegisterImplementations(iface interface{}, im
 	}
 }
 
+// RegisterCompressedImplementations registers concrete proto Messages which
+// implement the given interface, and whose values are compressed when they are
+// packed with PackAny.
+//
+// This function PANICs if different concrete types are registered under the
+// same typeURL.
+func (registry *interfaceRegistry) RegisterCompressedImplementations(iface interface{}, impls ...protoiface.MessageV1) {
+	for _, impl := range impls {
+		typeURL := MsgTypeURL(impl)
+		registry.registerImpl(iface, typeURL, impl)
+		registry.compressedTypeURLs[typeURL] = struct{}{}
+	}
+}
+
+// PackAny packs the given proto Message into an Any, which is compressed if
+// its type was registered with RegisterCompressedImplementations and its value
+// is larger than CompressionThreshold.
+func (registry *interfaceRegistry) PackAny(v proto.Message) (*Any, error) {
+	any, err := NewAnyWithValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := registry.compressedTypeURLs[any.TypeUrl]; !ok || len(any.Value) <= CompressionThreshold {
+		return any, nil
+	}
+
+	return CompressAny(any)
+}
+
 // RegisterCustomTypeURL registers a concrete type which implements the given
 // interface under `typeURL`.
 //
@@ -292,6 +337,10 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 		}
 	}
 
+	if any.TypeUrl == CompressedAnyTypeURL {
+		return registry.unpackCompressedAny(any, iface)
+	}
+
 	imap, found := registry.interfaceImpls[rt]
 	if !found {
 		return fmt.Errorf("no registered implementations of type %+v", rt)
@@ -330,6 +379,29 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 	return nil
 }
 
+// unpackCompressedAny decompresses the given CompressedAny and unpacks its
+// value. The compressed encoding of the Any is kept, so that it is not
+// decompressed when it is encoded again.
+func (registry *interfaceRegistry) unpackCompressedAny(any *Any, iface interface{}) error {
+	decompressed, err := DecompressAny(any)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := registry.compressedTypeURLs[decompressed.TypeUrl]; !ok {
+		return fmt.Errorf("type URL %s is not registered as compressed", decompressed.TypeUrl)
+	}
+
+	if err := registry.UnpackAny(decompressed, iface); err != nil {
+		return err
+	}
+
+	typeURL, value := any.TypeUrl, any.Value
+	*any = *decompressed
+	any.TypeUrl, any.Value = typeURL, value
+	return nil
+}
+
 // Resolve returns the proto message given its typeURL. It works with types
 // registered with RegisterInterface/RegisterImplementations, as well as those
 // registered with RegisterWithCustomTypeURL.
@@ -103,6 +103,10 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context
 	return (UniqueIterator[ReferenceKey, PrimaryKey])(iter), nil
 }
 
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[ReferenceKey] {
+	return i.refKeys.KeyCodec()
+}
+
 // UniqueIterator is an Iterator wrapper, that exposes only the functionality needed to work with Unique keys.
 type UniqueIterator[ReferenceKey, PrimaryKey any] collections.Iterator[ReferenceKey, PrimaryKey]
 
@@ -0,0 +1,30 @@
+package genesis
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/core/appmodule"
+)
+
+// StreamWriter writes the genesis state of the modules of an app incrementally,
+// e.g. to a file, instead of building the genesis state of the whole app in
+// memory.
+type StreamWriter interface {
+	// ModuleTarget returns the genesis target the core API modules write the
+	// fields of their genesis state to.
+	ModuleTarget(moduleName string) appmodule.GenesisTarget
+	// WriteModuleJSON writes the genesis state of the other modules.
+	WriteModuleJSON(moduleName string, bz json.RawMessage) error
+}
+
+// StreamReader reads the genesis state of the modules of an app written by a
+// StreamWriter.
+type StreamReader interface {
+	// HasModule returns true if the genesis state of the module was written.
+	HasModule(moduleName string) bool
+	// ModuleSource returns the genesis source the core API modules read the
+	// fields of their genesis state from.
+	ModuleSource(moduleName string) (appmodule.GenesisSource, error)
+	// ModuleJSON returns the genesis state of the other modules.
+	ModuleJSON(moduleName string) (json.RawMessage, error)
+}
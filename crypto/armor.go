@@ -25,11 +25,15 @@ const (
 	blockTypePrivKey = "TENDERMINT PRIVATE KEY"
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
+	blockTypeKeyring = "COSMOS KEYRING"
 
 	defaultAlgo = "secp256k1"
 
 	headerVersion = "version"
 	headerType    = "type"
+
+	// keyringArmorVersion is the version of the format of the keyring armors.
+	keyringArmorVersion = "1"
 )
 
 var (
@@ -160,23 +164,82 @@ func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase, algo string) s
 }
 
 func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes, encBytes []byte) {
+	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
+	defer clear(privKeyBytes)
+
+	return encryptBytes(privKeyBytes, passphrase)
+}
+
+// encryptBytes encrypts the given bytes with a key derived from the passphrase
+// with argon2 and a random salt.
+func encryptBytes(bz []byte, passphrase string) (saltBytes, encBytes []byte) {
 	saltBytes = crypto.CRandBytes(16)
 
 	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
-	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
+	defer clear(key)
 
 	aead, err := chacha20poly1305.New(key)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "error generating cypher from key"))
 	}
 
-	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(privKeyBytes)+aead.Overhead()) // Nonce is fixed to maintain consistency, each key is generated  at every encryption using a random salt.
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(bz)+aead.Overhead()) // Nonce is fixed to maintain consistency, each key is generated  at every encryption using a random salt.
 
-	encBytes = aead.Seal(nil, nonce, privKeyBytes, nil)
+	encBytes = aead.Seal(nil, nonce, bz, nil)
 
 	return saltBytes, encBytes
 }
 
+// EncryptArmorKeyring encrypts and armors the serialized keys of a keyring.
+func EncryptArmorKeyring(bz []byte, passphrase string) string {
+	saltBytes, encBytes := encryptBytes(bz, passphrase)
+	header := map[string]string{
+		headerVersion: keyringArmorVersion,
+		kdfHeader:     kdfArgon2,
+		"salt":        fmt.Sprintf("%X", saltBytes),
+	}
+
+	return EncodeArmor(blockTypeKeyring, header, encBytes)
+}
+
+// UnarmorDecryptKeyring returns the serialized keys of a keyring armored with
+// EncryptArmorKeyring. The caller should zeroize them once decoded.
+func UnarmorDecryptKeyring(armorStr, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeKeyring)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != keyringArmorVersion {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+
+	if header[kdfHeader] != kdfArgon2 {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil || len(saltBytes) == 0 {
+		return nil, errors.New("missing or invalid salt bytes")
+	}
+
+	key := argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+	defer clear(key)
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Error generating aead cypher for key.")
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	bz, err := aead.Open(nil, nonce, encBytes, nil)
+	if err != nil {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	return bz, nil
+}
+
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
 func UnarmorDecryptPrivKey(armorStr, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
 	blockType, header, encBytes, err := DecodeArmor(armorStr)
@@ -216,6 +279,11 @@ func decryptPrivKey(saltBytes, encBytes []byte, passphrase, kdf string) (privKey
 		key          []byte
 		privKeyBytes []byte
 	)
+	// the key and the plaintext private key are zeroized once decoded.
+	defer func() {
+		clear(key)
+		clear(privKeyBytes)
+	}()
 
 	// Since the argon2 key derivation and chacha encryption was implemented together, it is not possible to have mixed kdf and encryption algorithms
 	switch kdf {
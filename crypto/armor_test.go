@@ -28,6 +28,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -76,6 +77,29 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorKeyring(t *testing.T) {
+	bz := []byte("serialized keys")
+	armored := crypto.EncryptArmorKeyring(bz, "passphrase")
+	require.NotContains(t, armored, string(bz))
+
+	_, err := crypto.UnarmorDecryptKeyring(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	decrypted, err := crypto.UnarmorDecryptKeyring(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, bz, decrypted)
+
+	// wrong armor type
+	armored = crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", "")
+	_, err = crypto.UnarmorDecryptKeyring(armored, "passphrase")
+	require.ErrorContains(t, err, "unrecognized armor type")
+
+	// wrong version
+	armored = crypto.EncodeArmor("COSMOS KEYRING", map[string]string{"version": "0", "kdf": "argon2", "salt": "00"}, bz)
+	_, err = crypto.UnarmorDecryptKeyring(armored, "passphrase")
+	require.EqualError(t, err, "unrecognized version: 0")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	var cdc codec.Codec
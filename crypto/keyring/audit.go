@@ -0,0 +1,151 @@
+package keyring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// SigningAuditLogFileName is the name of the signing audit log kept in the
+	// keyring directory.
+	SigningAuditLogFileName = "signing-audit.log"
+	// SigningPolicyFileName is the name of the signing policy file read from the
+	// keyring directory.
+	SigningPolicyFileName = "signing-policy.json"
+)
+
+// SigningAuditor is implemented by keyrings enforcing a local signing policy and
+// recording the signing operations in an audit log. The transaction signers
+// authorize a signing operation before signing and record it once signed.
+type SigningAuditor interface {
+	// AuthorizeSign returns an error wrapping ErrSigningPolicy if the signing
+	// policy does not allow the operation.
+	AuthorizeSign(op SignOperation) error
+	// AuditSign appends the operation to the signing audit log.
+	AuditSign(op SignOperation) error
+}
+
+// SignOperation describes the signing of a transaction with a key of the keyring.
+type SignOperation struct {
+	// Key is the name of the signing key.
+	Key string `json:"key"`
+	// ChainID is the chain-id the transaction is signed for.
+	ChainID string `json:"chain_id"`
+	// MsgTypes are the type URLs of the messages of the transaction.
+	MsgTypes []string `json:"msg_types"`
+	// SignBytesHash is the SHA-256 hash of the signed bytes.
+	SignBytesHash []byte `json:"sign_bytes_hash"`
+	// Time is the time of the signing operation.
+	Time time.Time `json:"time"`
+}
+
+// KeySigningPolicy restricts the chain-ids and message types a key may sign
+// transactions for. An empty list does not restrict the signing operations.
+type KeySigningPolicy struct {
+	ChainIDs []string `json:"chain_ids,omitempty"`
+	MsgTypes []string `json:"msg_types,omitempty"`
+}
+
+// SigningPolicy is the local signing policy of the keys of a keyring, keyed by
+// key name. The policy of the "*" key applies to the keys without a policy of
+// their own, the keys without any policy are not restricted.
+//
+// Example:
+//
+//	{
+//	  "validator": {"chain_ids": ["cosmoshub-4"], "msg_types": ["/cosmos.staking.v1beta1.MsgEditValidator"]},
+//	  "*": {"chain_ids": ["cosmoshub-4"]}
+//	}
+type SigningPolicy map[string]KeySigningPolicy
+
+// LoadSigningPolicy reads a signing policy from a JSON file.
+func LoadSigningPolicy(path string) (SigningPolicy, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy SigningPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		return nil, fmt.Errorf("invalid signing policy %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Check returns an error wrapping ErrSigningPolicy if the policy does not allow
+// the signing operation.
+func (p SigningPolicy) Check(op SignOperation) error {
+	policy, ok := p[op.Key]
+	if !ok {
+		if policy, ok = p["*"]; !ok {
+			return nil
+		}
+	}
+
+	if len(policy.ChainIDs) > 0 && !slices.Contains(policy.ChainIDs, op.ChainID) {
+		return errorsmod.Wrapf(ErrSigningPolicy, "key %s may not sign for chain-id %s", op.Key, op.ChainID)
+	}
+	if len(policy.MsgTypes) > 0 {
+		for _, msgType := range op.MsgTypes {
+			if !slices.Contains(policy.MsgTypes, msgType) {
+				return errorsmod.Wrapf(ErrSigningPolicy, "key %s may not sign %s messages", op.Key, msgType)
+			}
+		}
+	}
+	return nil
+}
+
+// AppendSignOperation appends the operation as a JSON line to the audit log at
+// path, creating it if needed. The log is only ever appended to.
+func AppendSignOperation(path string, op SignOperation) error {
+	bz, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// AuthorizeSign implements SigningAuditor. The policy file is read on each
+// operation so that edits apply to running processes, a missing file enforcing
+// no policy.
+func (ks keystore) AuthorizeSign(op SignOperation) error {
+	if ks.options.SigningPolicyFile == "" {
+		return nil
+	}
+
+	policy, err := LoadSigningPolicy(ks.options.SigningPolicyFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return policy.Check(op)
+}
+
+// AuditSign implements SigningAuditor.
+func (ks keystore) AuditSign(op SignOperation) error {
+	if ks.options.SigningAuditLog == "" {
+		return nil
+	}
+	return AppendSignOperation(ks.options.SigningAuditLog, op)
+}
@@ -0,0 +1,69 @@
+package keyring
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningPolicyCheck(t *testing.T) {
+	policy := SigningPolicy{
+		"validator": {ChainIDs: []string{"chain-1"}, MsgTypes: []string{"/cosmos.staking.v1beta1.MsgEditValidator"}},
+		"*":         {ChainIDs: []string{"chain-1", "chain-2"}},
+	}
+
+	testCases := []struct {
+		name    string
+		op      SignOperation
+		allowed bool
+	}{
+		{"allowed chain-id and msg type", SignOperation{Key: "validator", ChainID: "chain-1", MsgTypes: []string{"/cosmos.staking.v1beta1.MsgEditValidator"}}, true},
+		{"disallowed chain-id", SignOperation{Key: "validator", ChainID: "chain-2", MsgTypes: []string{"/cosmos.staking.v1beta1.MsgEditValidator"}}, false},
+		{"disallowed msg type", SignOperation{Key: "validator", ChainID: "chain-1", MsgTypes: []string{"/cosmos.staking.v1beta1.MsgEditValidator", "/cosmos.bank.v1beta1.MsgSend"}}, false},
+		{"default policy", SignOperation{Key: "other", ChainID: "chain-2", MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}}, true},
+		{"default policy disallowed chain-id", SignOperation{Key: "other", ChainID: "chain-3"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Check(tc.op)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrSigningPolicy)
+			}
+		})
+	}
+
+	require.NoError(t, SigningPolicy{}.Check(SignOperation{Key: "other", ChainID: "chain-3"}))
+}
+
+func TestLoadSigningPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SigningPolicyFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`{"validator": {"chain_ids": ["chain-1"]}}`), 0o600))
+
+	policy, err := LoadSigningPolicy(path)
+	require.NoError(t, err)
+	require.Equal(t, SigningPolicy{"validator": {ChainIDs: []string{"chain-1"}}}, policy)
+
+	require.NoError(t, os.WriteFile(path, []byte(`["chain-1"]`), 0o600))
+	_, err = LoadSigningPolicy(path)
+	require.ErrorContains(t, err, "invalid signing policy")
+}
+
+func TestAppendSignOperation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", SigningAuditLogFileName)
+	op := SignOperation{Key: "validator", ChainID: "chain-1", SignBytesHash: []byte{1, 2}, Time: time.Unix(0, 0).UTC()}
+
+	require.NoError(t, AppendSignOperation(path, op))
+	require.NoError(t, AppendSignOperation(path, op))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	line := `{"key":"validator","chain_id":"chain-1","msg_types":null,"sign_bytes_hash":"AQI=","time":"1970-01-01T00:00:00Z"}`
+	require.Equal(t, strings.Repeat(line+"\n", 2), string(bz))
+}
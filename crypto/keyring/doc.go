@@ -37,4 +37,21 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//
+// # Signing audit log and policy
+//
+// The keyrings returned by New implement SigningAuditor: the transactions signed with
+// their keys are recorded as JSON lines in the append-only signing-audit.log file of the
+// keyring directory, along with the chain-id, the type URLs of their messages and the
+// hash of the signed bytes. An optional signing-policy.json file in the same directory
+// restricts the chain-ids and message types each key may sign transactions for, see
+// SigningPolicy.
+//
+// # Signer backends
+//
+// The keys held outside of the keyring, e.g. in HSMs, cloud KMS or remote signer services,
+// are referenced by remote records, created with SaveRemoteKey, and signed with by the
+// SignerBackend registered under their backend name with the WithSignerBackend option.
+// A signer backend may be a remote signer exposing the SignerBackend gRPC service, dialed
+// with NewGRPCSignerBackend, or a Go plugin loaded with LoadSignerBackendPlugin.
 package keyring
@@ -35,4 +35,10 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrSigningPolicy is raised when the signing policy does not allow a signing operation.
+	ErrSigningPolicy = errors.New("signing not allowed by the signing policy")
+	// ErrUnknownSignerBackend is raised when a signer backend is not registered in the keyring options.
+	ErrUnknownSignerBackend = errors.New("unknown signer backend")
+	// ErrSignerBackendInvalidSignature is raised when a signer backend generates an invalid signature.
+	ErrSignerBackendInvalidSignature = errors.New("signer backend generated an invalid signature")
 )
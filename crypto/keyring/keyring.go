@@ -3,6 +3,7 @@ package keyring
 import (
 	"bufio"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -50,8 +51,9 @@ const (
 )
 
 var (
-	_                          Keyring = &keystore{}
-	maxPassphraseEntryAttempts         = 3
+	_                          Keyring        = &keystore{}
+	_                          SigningAuditor = &keystore{}
+	maxPassphraseEntryAttempts                = 3
 )
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
@@ -84,18 +86,30 @@ type Keyring interface {
 	NewMnemonic(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error)
 
 	// NewAccount converts a mnemonic to a private key and BIP-39 HD Path and persists it.
+	// The HD path is stored in the record, the BIP-39 passphrase is not.
 	// It fails if there is an existing key Info with the same address.
 	NewAccount(uid, mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (*Record, error)
 
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveLedgerAppKey is like SaveLedgerKey for a key held by the Ledger app
+	// with the given name, the default app if empty.
+	SaveLedgerAppKey(uid, app, hrp string, coinType, account, index uint32) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveRemoteKey retrieves the public key of a key held by a signer backend
+	// registered in the keyring options and persists a reference to it.
+	SaveRemoteKey(uid, backend, keyID string) (*Record, error)
+
+	// SaveWatchKey stores the address of a watch-only key, without public key.
+	SaveWatchKey(uid string, address []byte) (*Record, error)
+
 	Signer
 
 	Importer
@@ -121,6 +135,10 @@ type Importer interface {
 	ImportPrivKeyHex(uid, privKey, algoStr string) error
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid, armor string) error
+	// ImportAllKeysArmor imports the keys of an ASCII armored passphrase-encrypted
+	// keyring export. No key is imported if one of their names is already taken.
+	// The private keys of the returned records are zeroized.
+	ImportAllKeysArmor(armor, passphrase string) ([]*Record, error)
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -138,6 +156,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address []byte, encryptPassphrase string) (armor string, err error)
+
+	// ExportAllKeysArmor returns all the keys of the keyring, with their names and
+	// metadata, in a single ASCII armored passphrase-encrypted format.
+	ExportAllKeysArmor(encryptPassphrase string) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -158,6 +180,27 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// additional Ledger apps, e.g. holding the keys of other signing algorithms,
+	// whose algorithms must be in SupportedAlgosLedger
+	LedgerApps []ledger.App
+	// path of the append-only audit log of the signing operations, defaults to
+	// SigningAuditLogFileName in the keyring directory, disabled if empty
+	SigningAuditLog string
+	// path of the signing policy file, defaults to SigningPolicyFileName in the
+	// keyring directory, no policy is enforced if empty or missing
+	SigningPolicyFile string
+	// signer backends holding the keys of the remote records, by name
+	SignerBackends map[string]SignerBackend
+}
+
+// WithLedgerApp registers an additional Ledger app, e.g. the Ethereum app
+// holding the eth_secp256k1 keys of EVM chains, whose algorithm must be in the
+// supported Ledger algorithms. Creating the keyring panics if the app is
+// invalid.
+func WithLedgerApp(app ledger.App) Option {
+	return func(options *Options) {
+		options.LedgerApps = append(options.LedgerApps, app)
+	}
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -205,6 +248,13 @@ func New(
 		return nil, err
 	}
 
+	// the signing audit log and policy are kept in the keyring directory unless
+	// set by the options
+	opts = append([]Option{func(options *Options) {
+		options.SigningAuditLog = filepath.Join(rootDir, SigningAuditLogFileName)
+		options.SigningPolicyFile = filepath.Join(rootDir, SigningPolicyFileName)
+	}}, opts...)
+
 	return newKeystore(db, cdc, backend, opts...), nil
 }
 
@@ -243,6 +293,12 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 		ledger.SetSkipDERConversion()
 	}
 
+	for _, app := range options.LedgerApps {
+		if err := ledger.RegisterApp(app); err != nil {
+			panic(err)
+		}
+	}
+
 	return keystore{
 		db:      kr,
 		cdc:     cdc,
@@ -286,7 +342,13 @@ func (ks keystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
 
 // ExportPrivKeyArmor exports encrypted privKey
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
-	priv, err := ks.ExportPrivateKeyObject(uid)
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+	defer wipeLocalRecord(k.GetLocal())
+
+	priv, err := extractPrivKeyFromRecord(k)
 	if err != nil {
 		return "", err
 	}
@@ -294,7 +356,46 @@ func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor stri
 	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type()), nil
 }
 
-// ExportPrivateKeyObject exports an armored private key object.
+// keyringExport is the payload of the keyring armors, holding the serialized
+// records of the exported keys.
+type keyringExport struct {
+	Records [][]byte `json:"records"`
+}
+
+func (ks keystore) ExportAllKeysArmor(encryptPassphrase string) (string, error) {
+	records, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	var export keyringExport
+	defer func() {
+		for _, bz := range export.Records {
+			clear(bz)
+		}
+	}()
+
+	for _, k := range records {
+		bz, err := ks.cdc.Marshal(k)
+		wipeLocalRecord(k.GetLocal())
+		if err != nil {
+			return "", errorsmod.Wrap(ErrUnableToSerialize, err.Error())
+		}
+
+		export.Records = append(export.Records, bz)
+	}
+
+	bz, err := json.Marshal(export)
+	if err != nil {
+		return "", err
+	}
+	defer clear(bz)
+
+	return crypto.EncryptArmorKeyring(bz, encryptPassphrase), nil
+}
+
+// ExportPrivateKeyObject exports an armored private key object. The caller
+// should zeroize the key with types.DestroyPrivKey once done with it.
 func (ks keystore) ExportPrivateKeyObject(uid string) (types.PrivKey, error) {
 	k, err := ks.Key(uid)
 	if err != nil {
@@ -329,6 +430,7 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to decrypt private key")
 	}
+	defer types.DestroyPrivKey(privKey)
 
 	_, err = ks.writeLocalKey(uid, privKey)
 	if err != nil {
@@ -338,6 +440,55 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportAllKeysArmor(armor, passphrase string) ([]*Record, error) {
+	bz, err := crypto.UnarmorDecryptKeyring(armor, passphrase)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decrypt keyring")
+	}
+	defer clear(bz)
+
+	var export keyringExport
+	if err := json.Unmarshal(bz, &export); err != nil {
+		return nil, errorsmod.Wrap(ErrUnableToSerialize, err.Error())
+	}
+
+	records := make([]*Record, 0, len(export.Records))
+	defer func() {
+		for _, bz := range export.Records {
+			clear(bz)
+		}
+		for _, k := range records {
+			wipeLocalRecord(k.GetLocal())
+		}
+	}()
+
+	names := make(map[string]struct{}, len(export.Records))
+	for _, recordBz := range export.Records {
+		k, err := ks.protoUnmarshalRecord(recordBz)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrUnableToSerialize, err.Error())
+		}
+		records = append(records, k)
+
+		if _, ok := names[k.Name]; ok {
+			return nil, fmt.Errorf("duplicated key name %s in the keyring export", k.Name)
+		}
+		names[k.Name] = struct{}{}
+
+		if _, err := ks.Key(k.Name); err == nil {
+			return nil, errorsmod.Wrap(ErrOverwriteKey, k.Name)
+		}
+	}
+
+	for _, k := range records {
+		if err := ks.writeRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
 func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return errorsmod.Wrap(ErrOverwriteKey, uid)
@@ -349,11 +500,13 @@ func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 	if err != nil {
 		return err
 	}
+	defer clear(decodedPriv)
 	algo, err := NewSigningAlgoFromString(algoStr, ks.options.SupportedAlgos)
 	if err != nil {
 		return err
 	}
 	priv := algo.Generate()(decodedPriv)
+	defer types.DestroyPrivKey(priv)
 	_, err = ks.writeLocalKey(uid, priv)
 	if err != nil {
 		return err
@@ -392,6 +545,10 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 
 	switch {
 	case k.GetLocal() != nil:
+		// the record is only used to sign, its private key is zeroized once
+		// the message is signed.
+		defer wipeLocalRecord(k.GetLocal())
+
 		priv, err := extractPrivKeyFromLocal(k.GetLocal())
 		if err != nil {
 			return nil, nil, err
@@ -407,6 +564,12 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetRemote() != nil:
+		return ks.signWithBackend(k, msg, signMode)
+
+	case k.GetWatch() != nil:
+		return nil, nil, ErrOfflineSign
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -431,21 +594,49 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s is not defined in the keyring options", algo.Name()))
 	}
 
+	app, err := ledger.GetAppForAlgo(string(algo.Name()))
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, err.Error())
+	}
+
+	return ks.saveLedgerKey(uid, app, hrp, coinType, account, index)
+}
+
+func (ks keystore) SaveLedgerAppKey(uid, appName, hrp string, coinType, account, index uint32) (*Record, error) {
+	app, err := ledger.GetApp(appName)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrLedgerGenerateKey, err.Error())
+	}
+
+	if _, err := NewSigningAlgoFromString(app.Algo, ks.options.SupportedAlgosLedger); err != nil {
+		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s of the %s Ledger app is not defined in the keyring options", app.Algo, app.Name))
+	}
+
+	return ks.saveLedgerKey(uid, app, hrp, coinType, account, index)
+}
+
+func (ks keystore) saveLedgerKey(uid string, app ledger.App, hrp string, coinType, account, index uint32) (*Record, error) {
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
 
-	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
+	var appName string
+	if !app.IsDefault() {
+		appName = app.Name
+	}
+
+	priv, _, err := ledger.NewPrivKey(appName, *hdPath, hrp)
 	if err != nil {
 		return nil, errorsmod.Wrap(ErrLedgerGenerateKey, err.Error())
 	}
 
-	return ks.writeLedgerKey(uid, priv.PubKey(), hdPath)
+	return ks.writeLedgerKey(uid, priv.PubKey(), hdPath, appName)
 }
 
-func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Params) (*Record, error) {
+func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Params, app string) (*Record, error) {
 	k, err := NewLedgerRecord(name, pk, path)
 	if err != nil {
 		return nil, err
 	}
+	k.GetLedger().App = app
 
 	return k, ks.writeRecord(k)
 }
@@ -458,6 +649,15 @@ func (ks keystore) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, err
 	return ks.writeOfflineKey(uid, pubkey)
 }
 
+func (ks keystore) SaveWatchKey(uid string, address []byte) (*Record, error) {
+	k, err := NewWatchRecord(uid, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) DeleteByAddress(address []byte) error {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -587,6 +787,7 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 	if err != nil {
 		return nil, err
 	}
+	defer clear(derivedPriv)
 
 	privKey := algo.Generate()(derivedPriv)
 
@@ -597,7 +798,14 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 		return nil, ErrDuplicatedAddress
 	}
 
-	return ks.writeLocalKey(name, privKey)
+	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	// the HD path is kept to be able to derive the key again from the mnemonic.
+	k.GetLocal().HdPath = hdPath
+
+	return k, ks.writeRecord(k)
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
@@ -630,7 +838,7 @@ func SignWithLedger(k *Record, msg []byte, signMode signing.SignMode) (sig []byt
 
 	path := ledgerInfo.GetPath()
 
-	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
+	priv, err := ledger.NewPrivKeyUnsafe(ledgerInfo.App, *path)
 	if err != nil {
 		return nil, nil, err
 	}
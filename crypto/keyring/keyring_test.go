@@ -1063,11 +1063,80 @@ func TestAltKeyring_UnsafeExportPrivKeyHex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 64, len(hex.EncodeToString(privKey.Bytes())))
 
+	// destroying the exported key doesn't affect the key stored in the keyring
+	msg := []byte("some message")
+	types.DestroyPrivKey(privKey)
+	require.Empty(t, privKey.Bytes())
+	for i := 0; i < 2; i++ {
+		sig, pub, err := kr.Sign(uid, msg, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+	}
+
 	// test error on non existing key
 	_, err = kr.(keystore).ExportPrivateKeyObject("non-existing")
 	require.Error(t, err)
 }
 
+func TestAltKeyring_ExportImportAllKeysArmor(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offline, err := kr.SaveOfflineKey("offline", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	watch, err := kr.SaveWatchKey("watch", secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+
+	armor, err := kr.ExportAllKeysArmor("passphrase")
+	require.NoError(t, err)
+
+	// the exported keys can still be used
+	msg := []byte("some message")
+	_, _, err = kr.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	kr2, err := New(t.Name(), BackendMemory, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	_, err = kr2.ImportAllKeysArmor(armor, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	records, err := kr2.ImportAllKeysArmor(armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	for _, k := range []*Record{local, offline, watch} {
+		imported, err := kr2.Key(k.Name)
+		require.NoError(t, err)
+		require.Equal(t, k.GetType(), imported.GetType())
+
+		addr, err := k.GetAddress()
+		require.NoError(t, err)
+		importedAddr, err := imported.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, addr, importedAddr)
+	}
+
+	sig, pub, err := kr2.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// no key is imported if a name is already taken
+	kr3, err := New(t.Name(), BackendMemory, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, err = kr3.SaveOfflineKey("watch", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	_, err = kr3.ImportAllKeysArmor(armor, "passphrase")
+	require.ErrorIs(t, err, ErrOverwriteKey)
+	list, err := kr3.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
 func TestNewAccount(t *testing.T) {
 	cdc := getCodec()
 
@@ -1140,6 +1209,11 @@ func TestNewAccount(t *testing.T) {
 			if tt.expectedErr == nil {
 				require.NoError(t, err)
 				require.Equal(t, tt.uid, k1.Name)
+				require.Equal(t, tt.hdpath, k1.GetHDPath())
+
+				k2, err := kb.Key(tt.uid)
+				require.NoError(t, err)
+				require.Equal(t, tt.hdpath, k2.GetHDPath())
 			} else {
 				require.Error(t, err)
 				require.ErrorContains(t, err, err.Error())
@@ -1736,6 +1810,50 @@ func TestAltKeyring_SaveOfflineKey(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_SaveWatchKey(t *testing.T) {
+	cdc := getCodec()
+	for _, backend := range []string{BackendTest, BackendMemory} {
+		t.Run(backend, func(t *testing.T) {
+			kr, err := New(t.Name(), backend, t.TempDir(), nil, cdc)
+			require.NoError(t, err)
+
+			addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			k, err := kr.SaveWatchKey("watch", addr)
+			require.NoError(t, err)
+			require.Equal(t, TypeWatch, k.GetType())
+			require.Nil(t, k.PubKey)
+
+			_, err = k.GetPubKey()
+			require.ErrorIs(t, err, ErrPubKeyNotAvailable)
+
+			k, err = kr.KeyByAddress(addr)
+			require.NoError(t, err)
+			require.Equal(t, "watch", k.Name)
+			kAddr, err := k.GetAddress()
+			require.NoError(t, err)
+			require.Equal(t, addr, kAddr)
+
+			list, err := kr.List()
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+
+			_, _, err = kr.Sign("watch", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+			require.ErrorIs(t, err, ErrOfflineSign)
+
+			_, err = kr.ExportPrivKeyArmor("watch", "passphrase")
+			require.Error(t, err)
+
+			_, err = kr.SaveWatchKey("empty", nil)
+			require.Error(t, err)
+
+			require.NoError(t, kr.Delete("watch"))
+			list, err = kr.List()
+			require.NoError(t, err)
+			require.Empty(t, list)
+		})
+	}
+}
+
 func TestNonConsistentKeyring_SavePubKey(t *testing.T) {
 	cdc := getCodec()
 	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
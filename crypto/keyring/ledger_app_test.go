@@ -0,0 +1,114 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// secp256r1LedgerAlgo is the algorithm of the keys held by the fake secp256r1
+// Ledger app, which are never derived nor generated by the keyring.
+type secp256r1LedgerAlgo struct{}
+
+func (secp256r1LedgerAlgo) Name() hd.PubKeyType { return "secp256r1" }
+
+func (secp256r1LedgerAlgo) Derive() hd.DeriveFn { return hd.Secp256k1.Derive() }
+
+func (secp256r1LedgerAlgo) Generate() hd.GenerateFn { return hd.Secp256k1.Generate() }
+
+// fakeSecp256r1Device is a Ledger device running an app holding a secp256r1
+// key, returning uncompressed public keys and DER signatures.
+type fakeSecp256r1Device struct {
+	priv *ecdsa.PrivateKey
+}
+
+func (d fakeSecp256r1Device) Close() error { return nil }
+
+func (d fakeSecp256r1Device) GetPublicKeySECP256K1([]uint32) ([]byte, error) {
+	pub, err := d.priv.PublicKey.ECDH()
+	if err != nil {
+		return nil, err
+	}
+
+	return pub.Bytes(), nil
+}
+
+func (d fakeSecp256r1Device) GetAddressPubKeySECP256K1(path []uint32, _ string) ([]byte, string, error) {
+	pub, err := d.GetPublicKeySECP256K1(path)
+	return pub, "", err
+}
+
+func (d fakeSecp256r1Device) SignSECP256K1(_ []uint32, msg []byte, _ byte) ([]byte, error) {
+	h := sha256.Sum256(msg)
+	return ecdsa.SignASN1(rand.Reader, d.priv, h[:])
+}
+
+func TestLedgerApp(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	device := fakeSecp256r1Device{priv: priv}
+
+	app := ledger.App{
+		Name:           "Secp256r1",
+		Algo:           "secp256r1",
+		Discover:       func() (ledger.SECP256K1, error) { return device, nil },
+		ParsePubKey:    ledger.ParseSecp256r1PubKey,
+		ParseSignature: ledger.ParseSecp256r1Signature,
+		SignModes: map[signing.SignMode]byte{
+			signing.SignMode_SIGN_MODE_TEXTUAL: 1,
+		},
+	}
+
+	pub, err := priv.PublicKey.ECDH()
+	require.NoError(t, err)
+	expectedPubKey, err := secp256r1.NewPubKey(pub.Bytes())
+	require.NoError(t, err)
+
+	kr := NewInMemory(getCodec(), func(options *Options) {
+		options.SupportedAlgosLedger = SigningAlgoList{hd.Secp256k1, secp256r1LedgerAlgo{}}
+	}, WithLedgerApp(app))
+
+	// by app name
+	k, err := kr.SaveLedgerAppKey("byApp", app.Name, "cosmos", 118, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, app.Name, k.GetLedger().App)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, expectedPubKey.Equals(pubKey))
+
+	// by algorithm
+	k, err = kr.SaveLedgerKey("byAlgo", secp256r1LedgerAlgo{}, "cosmos", 118, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, app.Name, k.GetLedger().App)
+
+	// the app is stored in the record
+	k, err = kr.Key("byAlgo")
+	require.NoError(t, err)
+	require.Equal(t, app.Name, k.GetLedger().App)
+
+	msg := []byte("some message")
+	sig, signPubKey, err := kr.Sign("byApp", msg, signing.SignMode_SIGN_MODE_TEXTUAL)
+	require.NoError(t, err)
+	require.True(t, expectedPubKey.Equals(signPubKey))
+	require.True(t, signPubKey.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("byApp", msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorContains(t, err, "the Secp256r1 Ledger app does not support SIGN_MODE_LEGACY_AMINO_JSON")
+
+	_, err = kr.SaveLedgerAppKey("unknown", "Unknown", "cosmos", 118, 0, 0)
+	require.ErrorIs(t, err, ErrLedgerGenerateKey)
+
+	// the algorithm of the app must be supported by the keyring
+	kr = NewInMemory(getCodec(), WithLedgerApp(app))
+	_, err = kr.SaveLedgerAppKey("unsupported", app.Name, "cosmos", 118, 0, 0)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+}
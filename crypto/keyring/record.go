@@ -16,6 +16,8 @@ var (
 	ErrPrivKeyExtr = errors.New("private key extraction works only for Local")
 	// ErrPrivKeyNotAvailable is used when a Record_Local.PrivKey is nil.
 	ErrPrivKeyNotAvailable = errors.New("private key is not available")
+	// ErrPubKeyNotAvailable is used when the public key of a watch-only Record is requested.
+	ErrPubKeyNotAvailable = errors.New("public key is not available for watch-only keys")
 	// ErrCastAny is used to output an error if cast from types.Any fails.
 	ErrCastAny = errors.New("unable to cast to cryptotypes")
 )
@@ -36,7 +38,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 		return nil, err
 	}
 
-	recordLocal := &Record_Local{any}
+	recordLocal := &Record_Local{PrivKey: any}
 	recordLocalItem := &Record_Local_{recordLocal}
 
 	return newRecord(name, pk, recordLocalItem)
@@ -44,7 +46,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 
 // NewLedgerRecord creates a new Record with ledger item
 func NewLedgerRecord(name string, pk cryptotypes.PubKey, path *hd.BIP44Params) (*Record, error) {
-	recordLedger := &Record_Ledger{path}
+	recordLedger := &Record_Ledger{Path: path}
 	recordLedgerItem := &Record_Ledger_{recordLedger}
 	return newRecord(name, pk, recordLedgerItem)
 }
@@ -60,6 +62,25 @@ func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordOfflineItem)
 }
 
+// NewRemoteRecord creates a new Record with remote item
+func NewRemoteRecord(name string, pk cryptotypes.PubKey, backend, keyID string) (*Record, error) {
+	recordRemote := &Record_Remote{Backend: backend, KeyId: keyID}
+	recordRemoteItem := &Record_Remote_{recordRemote}
+	return newRecord(name, pk, recordRemoteItem)
+}
+
+// NewWatchRecord creates a new Record with watch item, storing the address of
+// a watch-only key without its public key.
+func NewWatchRecord(name string, addr types.AccAddress) (*Record, error) {
+	if len(addr) == 0 {
+		return nil, errors.New("empty address")
+	}
+
+	recordWatch := &Record_Watch{Address: addr}
+	recordWatchItem := &Record_Watch_{recordWatch}
+	return &Record{Name: name, Item: recordWatchItem}, nil
+}
+
 // NewMultiRecord creates a new Record with multi item
 func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordMulti := &Record_Multi{}
@@ -69,6 +90,10 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
+	if k.GetWatch() != nil {
+		return nil, ErrPubKeyNotAvailable
+	}
+
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
 		return nil, errorsmod.Wrap(ErrCastAny, "PubKey")
@@ -79,6 +104,10 @@ func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 
 // GetAddress fetches an address of the record
 func (k Record) GetAddress() (types.AccAddress, error) {
+	if w := k.GetWatch(); w != nil {
+		return w.Address, nil
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return nil, err
@@ -86,6 +115,19 @@ func (k Record) GetAddress() (types.AccAddress, error) {
 	return pk.Address().Bytes(), nil
 }
 
+// GetHDPath returns the HD path the key was derived with, empty if unknown or
+// if the key is not derived from a mnemonic or a Ledger device.
+func (k Record) GetHDPath() string {
+	switch {
+	case k.GetLocal() != nil:
+		return k.GetLocal().HdPath
+	case k.GetLedger() != nil && k.GetLedger().Path != nil:
+		return k.GetLedger().Path.String()
+	default:
+		return ""
+	}
+}
+
 // GetType fetches type of the record
 func (k Record) GetType() KeyType {
 	switch {
@@ -97,6 +139,10 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetRemote() != nil:
+		return TypeRemote
+	case k.GetWatch() != nil:
+		return TypeWatch
 	default:
 		panic("unrecognized record type")
 	}
@@ -138,3 +184,16 @@ func extractPrivKeyFromLocal(rl *Record_Local) (cryptotypes.PrivKey, error) {
 
 	return priv, nil
 }
+
+// wipeLocalRecord zeroizes the private key of a local record, both decoded
+// and serialized. The record can't be used to sign afterwards.
+func wipeLocalRecord(rl *Record_Local) {
+	if rl == nil || rl.PrivKey == nil {
+		return
+	}
+
+	if priv, ok := rl.PrivKey.GetCachedValue().(cryptotypes.PrivKey); ok {
+		cryptotypes.DestroyPrivKey(priv)
+	}
+	clear(rl.PrivKey.Value)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type RecordTestSuite struct {
@@ -46,6 +47,27 @@ func (s *RecordTestSuite) TestOfflineRecordMarshaling() {
 	s.Require().True(s.pub.Equals(pk2))
 }
 
+func (s *RecordTestSuite) TestWatchRecordMarshaling() {
+	addr := sdk.AccAddress(s.pub.Address())
+	k, err := NewWatchRecord("testrecord", addr)
+	s.Require().NoError(err)
+
+	bz, err := s.cdc.Marshal(k)
+	s.Require().NoError(err)
+
+	var k2 Record
+	s.Require().NoError(s.cdc.Unmarshal(bz, &k2))
+	s.Require().Equal(k.Name, k2.Name)
+	s.Require().Equal(TypeWatch, k2.GetType())
+
+	addr2, err := k2.GetAddress()
+	s.Require().NoError(err)
+	s.Require().Equal(addr, addr2)
+
+	_, err = k2.GetPubKey()
+	s.Require().ErrorIs(err, ErrPubKeyNotAvailable)
+}
+
 func (s *RecordTestSuite) TestLocalRecordMarshaling() {
 	dir := s.T().TempDir()
 	mockIn := strings.NewReader("")
@@ -77,6 +99,15 @@ func (s *RecordTestSuite) TestLocalRecordMarshaling() {
 	anyPrivKey, err := codectypes.NewAnyWithValue(s.priv)
 	s.Require().NoError(err)
 	s.Require().Equal(localRecord2.PrivKey, anyPrivKey)
+	s.Require().Empty(k2.GetHDPath())
+
+	k.GetLocal().HdPath = "m/44'/118'/0'/1/3"
+	bz, err = ks.cdc.Marshal(k)
+	s.Require().NoError(err)
+
+	k2, err = ks.protoUnmarshalRecord(bz)
+	s.Require().NoError(err)
+	s.Require().Equal("m/44'/118'/0'/1/3", k2.GetHDPath())
 }
 
 func (s *RecordTestSuite) TestLedgerRecordMarshaling() {
@@ -111,6 +142,7 @@ func (s *RecordTestSuite) TestLedgerRecordMarshaling() {
 	s.Require().Nil(k2.GetLocal())
 
 	s.Require().Equal(ledgerRecord2.Path.String(), path.String())
+	s.Require().Equal(path.String(), k2.GetHDPath())
 }
 
 func (s *RecordTestSuite) TestExtractPrivKeyFromLocalRecord() {
@@ -0,0 +1,210 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"plugin"
+
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignerBackendPluginSymbol is the name of the variable exported by the Go
+// plugins loaded with LoadSignerBackendPlugin, holding their SignerBackend.
+const SignerBackendPluginSymbol = "SignerBackend"
+
+// SignerBackend is implemented by the plugins holding keys outside of the
+// keyring, e.g. in HSMs, cloud KMS or remote signer services. The keyring
+// stores a reference to these keys in remote records, created with
+// SaveRemoteKey, and delegates the signing of the messages to the backend.
+type SignerBackend interface {
+	// ListKeys returns the identifiers of the keys held by the backend.
+	ListKeys(ctx context.Context) ([]string, error)
+	// PubKey returns the public key of a key held by the backend.
+	PubKey(ctx context.Context, keyID string) (types.PubKey, error)
+	// Sign signs a message with a key held by the backend.
+	Sign(ctx context.Context, keyID string, msg []byte, signMode signing.SignMode) ([]byte, error)
+}
+
+// WithSignerBackend registers a signer backend under the given name, to which
+// the keyring delegates the signing with the remote records of this backend.
+func WithSignerBackend(name string, backend SignerBackend) Option {
+	return func(options *Options) {
+		if options.SignerBackends == nil {
+			options.SignerBackends = make(map[string]SignerBackend)
+		}
+		options.SignerBackends[name] = backend
+	}
+}
+
+// LoadSignerBackendPlugin loads the signer backend of the Go plugin at the
+// given path, which must export a SignerBackendPluginSymbol variable of the
+// SignerBackend type.
+func LoadSignerBackendPlugin(path string) (SignerBackend, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open signer backend plugin %s: %w", path, err)
+	}
+
+	sym, err := p.Lookup(SignerBackendPluginSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signer backend plugin %s: %w", path, err)
+	}
+
+	backend, ok := sym.(*SignerBackend)
+	if !ok || *backend == nil {
+		return nil, fmt.Errorf("signer backend plugin %s: %s must be a non-nil variable of type keyring.SignerBackend, got %T", path, SignerBackendPluginSymbol, sym)
+	}
+
+	return *backend, nil
+}
+
+// SaveRemoteKey implements Keyring.
+func (ks keystore) SaveRemoteKey(uid, backend, keyID string) (*Record, error) {
+	b, err := ks.signerBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := b.PubKey(context.Background(), keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewRemoteRecord(uid, pk, backend, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
+// signWithBackend signs the message with the signer backend holding the key
+// of the remote record, and checks the signature against its public key.
+func (ks keystore) signWithBackend(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	remote := k.GetRemote()
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := ks.signerBackend(remote.Backend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := b.Sign(context.Background(), remote.KeyId, msg, signMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pub.VerifySignature(msg, sig) {
+		return nil, nil, errorsmod.Wrapf(ErrSignerBackendInvalidSignature, "key %s of backend %s", remote.KeyId, remote.Backend)
+	}
+
+	return sig, pub, nil
+}
+
+func (ks keystore) signerBackend(name string) (SignerBackend, error) {
+	b, ok := ks.options.SignerBackends[name]
+	if !ok {
+		return nil, errorsmod.Wrap(ErrUnknownSignerBackend, name)
+	}
+
+	return b, nil
+}
+
+// grpcSignerBackend is a SignerBackend dialing a remote signer over gRPC.
+type grpcSignerBackend struct {
+	client SignerBackendClient
+	cdc    codec.Codec
+}
+
+// NewGRPCSignerBackend returns a SignerBackend delegating to the remote signer
+// exposing the SignerBackend gRPC service on the given connection. The codec
+// unpacks the public keys returned by the signer.
+func NewGRPCSignerBackend(conn grpc1.ClientConn, cdc codec.Codec) SignerBackend {
+	return grpcSignerBackend{client: NewSignerBackendClient(conn), cdc: cdc}
+}
+
+func (b grpcSignerBackend) ListKeys(ctx context.Context) ([]string, error) {
+	res, err := b.client.ListKeys(ctx, &ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.KeyIds, nil
+}
+
+func (b grpcSignerBackend) PubKey(ctx context.Context, keyID string) (types.PubKey, error) {
+	res, err := b.client.PubKey(ctx, &PubKeyRequest{KeyId: keyID})
+	if err != nil {
+		return nil, err
+	}
+
+	var pk types.PubKey
+	if err := b.cdc.UnpackAny(res.PubKey, &pk); err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+func (b grpcSignerBackend) Sign(ctx context.Context, keyID string, msg []byte, signMode signing.SignMode) ([]byte, error) {
+	res, err := b.client.Sign(ctx, &SignRequest{KeyId: keyID, Msg: msg, SignMode: signMode})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Signature, nil
+}
+
+// signerBackendServer exposes a SignerBackend as a SignerBackend gRPC service.
+type signerBackendServer struct {
+	backend SignerBackend
+}
+
+// NewSignerBackendServer returns a SignerBackendServer exposing the given
+// backend, for the remote signer services written in Go.
+func NewSignerBackendServer(backend SignerBackend) SignerBackendServer {
+	return signerBackendServer{backend: backend}
+}
+
+func (s signerBackendServer) ListKeys(ctx context.Context, _ *ListKeysRequest) (*ListKeysResponse, error) {
+	keyIDs, err := s.backend.ListKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListKeysResponse{KeyIds: keyIDs}, nil
+}
+
+func (s signerBackendServer) PubKey(ctx context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	pk, err := s.backend.PubKey(ctx, req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKeyResponse{PubKey: any}, nil
+}
+
+func (s signerBackendServer) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, err := s.backend.Sign(ctx, req.KeyId, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
@@ -0,0 +1,118 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// memSignerBackend is a SignerBackend holding its keys in memory.
+type memSignerBackend map[string]types.PrivKey
+
+func (b memSignerBackend) ListKeys(context.Context) ([]string, error) {
+	keyIDs := make([]string, 0, len(b))
+	for keyID := range b {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+	return keyIDs, nil
+}
+
+func (b memSignerBackend) PubKey(_ context.Context, keyID string) (types.PubKey, error) {
+	priv, ok := b[keyID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return priv.PubKey(), nil
+}
+
+func (b memSignerBackend) Sign(_ context.Context, keyID string, msg []byte, _ signing.SignMode) ([]byte, error) {
+	priv, ok := b[keyID]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return priv.Sign(msg)
+}
+
+func TestRemoteKey(t *testing.T) {
+	backend := memSignerBackend{"hsm-1": secp256k1.GenPrivKey(), "hsm-2": secp256k1.GenPrivKey()}
+	kr := NewInMemory(getCodec(), WithSignerBackend("hsm", backend))
+
+	_, err := kr.SaveRemoteKey("remote", "kms", "hsm-1")
+	require.ErrorIs(t, err, ErrUnknownSignerBackend)
+	_, err = kr.SaveRemoteKey("remote", "hsm", "hsm-3")
+	require.ErrorContains(t, err, "key not found")
+
+	k, err := kr.SaveRemoteKey("remote", "hsm", "hsm-1")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, k.GetType())
+	require.Equal(t, "remote", k.GetType().String())
+
+	k, err = kr.Key("remote")
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, backend["hsm-1"].PubKey(), pub)
+
+	msg := []byte("message")
+	sig, signPub, err := kr.Sign("remote", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, pub, signPub)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the signatures of another key are rejected.
+	backend["hsm-1"] = backend["hsm-2"]
+	_, _, err = kr.Sign("remote", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrSignerBackendInvalidSignature)
+
+	// the remote keys can't be signed with if their backend is not registered.
+	_, _, err = NewInMemoryWithKeyring(kr.(keystore).db, getCodec()).Sign("remote", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrUnknownSignerBackend)
+}
+
+func TestGRPCSignerBackend(t *testing.T) {
+	backend := memSignerBackend{"kms-1": secp256k1.GenPrivKey()}
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	RegisterSignerBackendServer(srv, NewSignerBackendServer(backend))
+	go func() { _ = srv.Serve(listener) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	remote := NewGRPCSignerBackend(conn, getCodec())
+	ctx := context.Background()
+
+	keyIDs, err := remote.ListKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kms-1"}, keyIDs)
+
+	pub, err := remote.PubKey(ctx, "kms-1")
+	require.NoError(t, err)
+	require.Equal(t, backend["kms-1"].PubKey(), pub)
+
+	msg := []byte("message")
+	sig, err := remote.Sign(ctx, "kms-1", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = remote.Sign(ctx, "kms-2", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "key not found")
+}
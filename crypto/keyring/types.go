@@ -49,6 +49,8 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeRemote  KeyType = 4
+	TypeWatch   KeyType = 5
 )
 
 var keyTypes = map[KeyType]string{
@@ -56,6 +58,8 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeRemote:  "remote",
+	TypeWatch:   "watch",
 }
 
 // String implements the stringer interface for KeyType.
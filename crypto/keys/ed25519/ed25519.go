@@ -1,6 +1,7 @@
 package ed25519
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/subtle"
 	"errors"
@@ -95,6 +96,22 @@ func (privKey *PrivKey) Type() string {
 	return keyType
 }
 
+// String implements fmt.Stringer without printing the key material.
+func (privKey *PrivKey) String() string {
+	return "PrivKeyEd25519{-}"
+}
+
+// Destroy zeroizes the key material of the private key, which can't be used
+// afterwards. It implements the DestroyablePrivKey interface.
+func (privKey *PrivKey) Destroy() {
+	if privKey == nil {
+		return
+	}
+
+	clear(privKey.Key)
+	privKey.Key = nil
+}
+
 // MarshalAmino overrides Amino binary marshaling.
 func (privKey PrivKey) MarshalAmino() ([]byte, error) {
 	return privKey.Key, nil
@@ -105,7 +122,8 @@ func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
 	if len(bz) != PrivKeySize {
 		return errors.New("invalid privkey size")
 	}
-	privKey.Key = bz
+	// the key is copied so that the caller can zeroize its buffer.
+	privKey.Key = bytes.Clone(bz)
 
 	return nil
 }
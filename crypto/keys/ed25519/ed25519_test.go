@@ -3,6 +3,8 @@ package ed25519_test
 import (
 	stded25519 "crypto/ed25519"
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/cometbft/cometbft/crypto"
@@ -131,6 +133,22 @@ func TestPrivKeyEquals(t *testing.T) {
 	}
 }
 
+func TestPrivKeyStringAndDestroy(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	key := privKey.Key
+
+	require.Equal(t, "PrivKeyEd25519{-}", privKey.String())
+	require.NotContains(t, fmt.Sprintf("%v %+v", privKey, privKey), hex.EncodeToString(key))
+
+	cryptotypes.DestroyPrivKey(privKey)
+	require.Nil(t, privKey.Key)
+	require.Equal(t, make([]byte, ed25519.PrivKeySize), []byte(key))
+
+	// destroying a nil key is a no-op.
+	var nilKey *ed25519.PrivKey
+	nilKey.Destroy()
+}
+
 func TestMarshalAmino(t *testing.T) {
 	aminoCdc := codec.NewLegacyAmino()
 	privKey := ed25519.GenPrivKey()
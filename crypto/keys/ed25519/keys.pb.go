@@ -79,9 +79,8 @@ type PrivKey struct {
 	Key crypto_ed25519.PrivateKey `protobuf:"bytes,1,opt,name=key,proto3,casttype=crypto/ed25519.PrivateKey" json:"key,omitempty"`
 }
 
-func (m *PrivKey) Reset()         { *m = PrivKey{} }
-func (m *PrivKey) String() string { return proto.CompactTextString(m) }
-func (*PrivKey) ProtoMessage()    {}
+func (m *PrivKey) Reset()      { *m = PrivKey{} }
+func (*PrivKey) ProtoMessage() {}
 func (*PrivKey) Descriptor() ([]byte, []int) {
 	return fileDescriptor_48fe3336771e732d, []int{1}
 }
@@ -127,7 +126,7 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crypto/ed25519/keys.proto", fileDescriptor_48fe3336771e732d) }
 
 var fileDescriptor_48fe3336771e732d = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
+	// 276 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0x4f, 0x4d, 0x31, 0x32, 0x35, 0x35,
 	0xb4, 0xd4, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xa8,
@@ -138,14 +137,14 @@ var fileDescriptor_48fe3336771e732d = []byte{
 	0xd2, 0xa4, 0x9c, 0xcc, 0x64, 0xef, 0xd4, 0xca, 0x20, 0x90, 0x42, 0x2b, 0xfd, 0x19, 0x0b, 0xe4,
 	0x19, 0xba, 0x9e, 0x6f, 0xd0, 0x92, 0x28, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b,
 	0xd1, 0x87, 0x98, 0xe5, 0x0a, 0xd1, 0x31, 0xe9, 0xf9, 0x06, 0x2d, 0xce, 0xec, 0xd4, 0xca, 0xf8,
-	0xb4, 0xcc, 0xd4, 0x9c, 0x14, 0xa5, 0x0c, 0x2e, 0xf6, 0x80, 0xa2, 0xcc, 0x32, 0x90, 0x5d, 0xfa,
+	0xb4, 0xcc, 0xd4, 0x9c, 0x14, 0xa5, 0x1c, 0x2e, 0xf6, 0x80, 0xa2, 0xcc, 0x32, 0x90, 0x5d, 0xfa,
 	0xc8, 0x76, 0xc9, 0xfe, 0xba, 0x27, 0x2f, 0x89, 0x6e, 0x57, 0x51, 0x66, 0x59, 0x62, 0x49, 0x2a,
-	0xdc, 0x32, 0x1d, 0x90, 0x45, 0x92, 0xc8, 0x16, 0x41, 0x4c, 0xc2, 0x6a, 0x93, 0x93, 0xd7, 0x89,
+	0xdc, 0x32, 0x03, 0x98, 0x65, 0x92, 0xc8, 0x96, 0x41, 0x4c, 0xc3, 0x6a, 0x9b, 0x93, 0xd7, 0x89,
 	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
 	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0xc2, 0x16, 0x4c, 0xe9, 0x16, 0xa7, 0x64, 0xc3, 0x82, 0x19,
-	0x14, 0xbc, 0x30, 0x97, 0x24, 0xb1, 0x81, 0xc3, 0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x8e,
-	0xcd, 0xa4, 0x67, 0x8b, 0x01, 0x00, 0x00,
+	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0xc2, 0x17, 0x4c, 0xe9, 0x16, 0xa7, 0x64, 0xc3, 0x82, 0x1a,
+	0x14, 0xc4, 0x30, 0xd7, 0x24, 0xb1, 0x81, 0xc3, 0xca, 0x18, 0x30, 0x00, 0xca, 0xd5, 0xa1, 0x37,
+	0x8f, 0x01, 0x00, 0x00,
 }
 
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
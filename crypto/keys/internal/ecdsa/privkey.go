@@ -103,6 +103,15 @@ func (sk *PrivKey) String(name string) string {
 	return name + "{-}"
 }
 
+// Destroy zeroizes the secret number of the private key.
+func (sk *PrivKey) Destroy() {
+	if sk.D == nil {
+		return
+	}
+	clear(sk.D.Bits())
+	sk.D.SetInt64(0)
+}
+
 // MarshalTo implements proto.Marshaler interface.
 func (sk *PrivKey) MarshalTo(dAtA []byte) (int, error) {
 	bz := sk.Bytes()
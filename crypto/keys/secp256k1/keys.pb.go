@@ -77,9 +77,8 @@ type PrivKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *PrivKey) Reset()         { *m = PrivKey{} }
-func (m *PrivKey) String() string { return proto.CompactTextString(m) }
-func (*PrivKey) ProtoMessage()    {}
+func (m *PrivKey) Reset()      { *m = PrivKey{} }
+func (*PrivKey) ProtoMessage() {}
 func (*PrivKey) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0835e68ebdcb224, []int{1}
 }
@@ -127,7 +126,7 @@ func init() {
 }
 
 var fileDescriptor_e0835e68ebdcb224 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
+	// 241 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0x2f, 0x4e, 0x4d, 0x2e, 0x30, 0x32,
 	0x35, 0xcb, 0x36, 0xd4, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
@@ -137,13 +136,13 @@ var fileDescriptor_e0835e68ebdcb224 = []byte{
 	0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0x31, 0xad, 0x0c, 0x67, 0x2c, 0x90, 0x67,
 	0xe8, 0x7a, 0xbe, 0x41, 0x4b, 0xaa, 0x24, 0x35, 0x2f, 0x25, 0xb5, 0x28, 0x37, 0x33, 0xaf, 0x44,
 	0x1f, 0xa2, 0x3a, 0x18, 0x66, 0xd3, 0xa4, 0xe7, 0x1b, 0xb4, 0x38, 0xb3, 0x53, 0x2b, 0xe3, 0xd3,
-	0x32, 0x53, 0x73, 0x52, 0x94, 0xbc, 0xb9, 0xd8, 0x03, 0x8a, 0x32, 0xcb, 0xb0, 0x9b, 0xa7, 0x07,
-	0x32, 0x4b, 0x1a, 0xd9, 0x2c, 0x88, 0x52, 0x1c, 0x86, 0x39, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78,
+	0x32, 0x53, 0x73, 0x52, 0x94, 0xfc, 0xb9, 0xd8, 0x03, 0x8a, 0x32, 0xcb, 0xb0, 0x9b, 0x67, 0x04,
+	0x33, 0x4f, 0x1a, 0xd9, 0x3c, 0x88, 0x72, 0x1c, 0x06, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78,
 	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
 	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0x2c, 0x98, 0xc0, 0x94, 0x6e, 0x71, 0x4a, 0x36, 0x2c, 0xc4, 0x40, 0xe1, 0x84, 0x08,
-	0xb6, 0x24, 0x36, 0xb0, 0x87, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x42, 0xbc, 0x00,
-	0x58, 0x01, 0x00, 0x00,
+	0xae, 0x3e, 0x2c, 0xa8, 0xc0, 0x94, 0x6e, 0x71, 0x4a, 0x36, 0x2c, 0xd4, 0x40, 0x61, 0x85, 0x08,
+	0xba, 0x24, 0x36, 0xb0, 0xa7, 0x8d, 0x01, 0x03, 0x00, 0xa3, 0xe8, 0x34, 0x03, 0x5c, 0x01, 0x00,
+	0x00,
 }
 
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
@@ -59,6 +59,22 @@ func (privKey *PrivKey) Type() string {
 	return keyType
 }
 
+// String implements fmt.Stringer without printing the key material.
+func (privKey *PrivKey) String() string {
+	return "PrivKeySecp256k1{-}"
+}
+
+// Destroy zeroizes the key material of the private key, which can't be used
+// afterwards. It implements the DestroyablePrivKey interface.
+func (privKey *PrivKey) Destroy() {
+	if privKey == nil {
+		return
+	}
+
+	clear(privKey.Key)
+	privKey.Key = nil
+}
+
 // MarshalAmino overrides Amino binary marshaling.
 func (privKey PrivKey) MarshalAmino() ([]byte, error) {
 	return privKey.Key, nil
@@ -69,7 +85,8 @@ func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
 	if len(bz) != PrivKeySize {
 		return errors.New("invalid privkey size")
 	}
-	privKey.Key = bz
+	// the key is copied so that the caller can zeroize its buffer.
+	privKey.Key = bytes.Clone(bz)
 
 	return nil
 }
@@ -192,7 +209,7 @@ func (pubKey *PubKey) Type() string {
 }
 
 func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
-	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+	return pubKey.Type() == other.Type() && subtle.ConstantTimeCompare(pubKey.Bytes(), other.Bytes()) == 1
 }
 
 // MarshalAmino overrides Amino binary marshaling.
@@ -15,6 +15,7 @@ import (
 // The returned signature will be of the form R || S (in lower-S form).
 func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 	priv := secp256k1.PrivKeyFromBytes(privKey.Key)
+	defer priv.Zero()
 	sig := ecdsa.SignCompact(priv, crypto.Sha256(msg), false)
 
 	// remove the first byte which is compactSigRecoveryCode
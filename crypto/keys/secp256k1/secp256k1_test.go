@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -345,6 +346,22 @@ func TestPrivKeyEquals(t *testing.T) {
 	}
 }
 
+func TestPrivKeyStringAndDestroy(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	key := privKey.Key
+
+	require.Equal(t, "PrivKeySecp256k1{-}", privKey.String())
+	require.NotContains(t, fmt.Sprintf("%v %+v", privKey, privKey), hex.EncodeToString(key))
+
+	cryptotypes.DestroyPrivKey(privKey)
+	require.Nil(t, privKey.Key)
+	require.Equal(t, make([]byte, secp256k1.PrivKeySize), key)
+
+	// destroying a nil key is a no-op.
+	var nilKey *secp256k1.PrivKey
+	nilKey.Destroy()
+}
+
 func TestMarshalAmino(t *testing.T) {
 	aminoCdc := codec.NewLegacyAmino()
 	privKey := secp256k1.GenPrivKey()
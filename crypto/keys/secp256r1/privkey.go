@@ -25,6 +25,15 @@ func (m *PrivKey) String() string {
 	return m.Secret.String(name)
 }
 
+// Destroy zeroizes the key material of the private key, which can't be used
+// afterwards. It implements the DestroyablePrivKey interface.
+func (m *PrivKey) Destroy() {
+	if m == nil || m.Secret == nil {
+		return
+	}
+	m.Secret.Destroy()
+}
+
 // Type returns key type name. Implements SDK PrivKey interface.
 func (m *PrivKey) Type() string {
 	return name
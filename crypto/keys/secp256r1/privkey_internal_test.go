@@ -36,6 +36,20 @@ func (suite *SKSuite) TestEquals() {
 	require.True(skOther2.Equals(skOther), "Equals must be reflexive")
 }
 
+func (suite *SKSuite) TestDestroy() {
+	require := suite.Require()
+
+	sk, err := GenPrivKey()
+	require.NoError(err)
+	require.NotEqual(make([]byte, fieldSize), sk.Bytes())
+
+	cryptotypes.DestroyPrivKey(sk)
+	require.Zero(sk.Secret.D.Sign())
+
+	var nilKey *PrivKey
+	nilKey.Destroy()
+}
+
 func (suite *SKSuite) TestPubKey() {
 	pk := suite.sk.PubKey()
 	suite.True(suite.sk.(*PrivKey).Secret.PublicKey.Equal(&pk.(*PubKey).Key.PublicKey))
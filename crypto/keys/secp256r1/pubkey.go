@@ -1,13 +1,19 @@
 package secp256r1
 
 import (
+	"crypto/ecdh"
+	"crypto/elliptic"
 	"encoding/base64"
+	"math/big"
 
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/gogoproto/proto"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // customProtobufType is here to make sure that ecdsaPK and ecdsaSK implement the
@@ -24,6 +30,28 @@ type customProtobufType interface {
 
 var _ customProtobufType = (*ecdsaPK)(nil)
 
+// NewPubKey returns the secp256r1 public key with the given SEC 1 encoding,
+// either compressed or uncompressed.
+func NewPubKey(bz []byte) (*PubKey, error) {
+	if len(bz) != pubKeySize {
+		// validate the uncompressed key, and compress it.
+		if _, err := ecdh.P256().NewPublicKey(bz); err != nil {
+			return nil, errorsmod.Wrap(errors.ErrInvalidPubKey, err.Error())
+		}
+
+		x := new(big.Int).SetBytes(bz[1 : 1+fieldSize])
+		y := new(big.Int).SetBytes(bz[1+fieldSize:])
+		bz = elliptic.MarshalCompressed(secp256r1, x, y)
+	}
+
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &PubKey{Key: pk}, nil
+}
+
 // String implements proto.Message interface.
 func (m *PubKey) String() string {
 	return m.Key.String(name)
@@ -65,6 +65,28 @@ func (suite *PKSuite) TestEquals() {
 	require.True(pkOther.Equals(pkOther), "Equals must be reflexive") //nolint:gocritic // false positive
 }
 
+func (suite *PKSuite) TestNewPubKey() {
+	require := suite.Require()
+
+	pk, err := NewPubKey(suite.pk.Bytes())
+	require.NoError(err)
+	require.True(pk.Equals(suite.pk))
+
+	pub := suite.pk.Key.PublicKey
+	uncompressed := append([]byte{0x04}, append(pub.X.FillBytes(make([]byte, fieldSize)), pub.Y.FillBytes(make([]byte, fieldSize))...)...)
+	pk, err = NewPubKey(uncompressed)
+	require.NoError(err)
+	require.True(pk.Equals(suite.pk))
+
+	_, err = NewPubKey(uncompressed[:fieldSize*2])
+	require.Error(err)
+
+	invalid := append([]byte{}, uncompressed...)
+	invalid[len(invalid)-1] ^= 1
+	_, err = NewPubKey(invalid)
+	require.Error(err)
+}
+
 func (suite *PKSuite) TestMarshalProto() {
 	require := suite.Require()
 
@@ -0,0 +1,215 @@
+package ledger
+
+import (
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"gitlab.com/yawning/secp256k1-voi/secec"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// App describes a Ledger app holding keys, e.g. the Cosmos app holding
+// secp256k1 keys, or the Ethereum app holding the eth_secp256k1 keys of EVM
+// chains, and how its public keys, addresses and signatures are handled.
+//
+// The default app is the Cosmos app, customized by the keyring options. The
+// other apps are registered with RegisterApp, and the name of the app holding
+// a key is stored in its keyring record.
+type App struct {
+	// Name is the name of the app, e.g. "Ethereum".
+	Name string
+	// Algo is the signing algorithm of the keys held by the app, e.g.
+	// "eth_secp256k1".
+	Algo string
+	// Discover connects to the app on a device. When nil, the discovery
+	// function of the default app is used.
+	Discover func() (SECP256K1, error)
+	// ParsePubKey parses a public key returned by the app.
+	ParsePubKey func([]byte) (types.PubKey, error)
+	// ParseSignature converts a signature returned by the app into the format
+	// verified by its public keys. When nil, the signature is used as is.
+	ParseSignature func([]byte) ([]byte, error)
+	// NoHRP indicates that the app doesn't display bech32 addresses, e.g. the
+	// Ethereum app displaying hex addresses, so that no HRP is sent to it.
+	NoHRP bool
+	// SignModes are the sign modes supported by the app, mapped to the P2
+	// value of its sign APDU, which selects the format of the sign doc.
+	SignModes map[signing.SignMode]byte
+}
+
+// CosmosSignModes are the sign modes supported by the Cosmos app.
+var CosmosSignModes = map[signing.SignMode]byte{
+	signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON: 0,
+	signing.SignMode_SIGN_MODE_TEXTUAL:           1,
+}
+
+// apps are the registered apps, in registration order.
+var apps []App
+
+// RegisterApp registers a Ledger app, replacing the app registered with the
+// same name.
+func RegisterApp(app App) error {
+	switch {
+	case app.Name == "":
+		return errors.New("the Ledger app name cannot be empty")
+	case app.Name == options.appName:
+		return fmt.Errorf("%s is the default Ledger app, customized by the keyring options", app.Name)
+	case app.Algo == "":
+		return fmt.Errorf("the algorithm of the %s Ledger app cannot be empty", app.Name)
+	case app.ParsePubKey == nil:
+		return fmt.Errorf("the %s Ledger app must parse its public keys", app.Name)
+	case len(app.SignModes) == 0:
+		return fmt.Errorf("the %s Ledger app must support a sign mode", app.Name)
+	}
+
+	for i := range apps {
+		if apps[i].Name == app.Name {
+			apps[i] = app
+			return nil
+		}
+	}
+	apps = append(apps, app)
+
+	return nil
+}
+
+// DefaultApp returns the default Ledger app, i.e. the Cosmos app holding
+// secp256k1 keys, as customized by the keyring options.
+func DefaultApp() App {
+	app := App{
+		Name:     options.appName,
+		Algo:     string(hd.Secp256k1Type),
+		Discover: options.discoverLedger,
+		ParsePubKey: func(bz []byte) (types.PubKey, error) {
+			compressed, err := compressSecp256k1PubKey(bz)
+			if err != nil {
+				return nil, err
+			}
+
+			return options.createPubkey(compressed), nil
+		},
+		SignModes: CosmosSignModes,
+	}
+
+	if !options.skipDERConversion {
+		app.ParseSignature = convertDERtoBER
+	}
+
+	return app
+}
+
+// GetApp returns the Ledger app with the given name, or the default app if the
+// name is empty.
+func GetApp(name string) (App, error) {
+	if name == "" || name == options.appName {
+		return DefaultApp(), nil
+	}
+
+	for _, app := range apps {
+		if app.Name == name {
+			return app, nil
+		}
+	}
+
+	return App{}, fmt.Errorf("unknown Ledger app %s", name)
+}
+
+// GetAppForAlgo returns the Ledger app holding the keys of the given signing
+// algorithm: the default app for secp256k1, or else the first registered app.
+func GetAppForAlgo(algo string) (App, error) {
+	if algo == string(hd.Secp256k1Type) {
+		return DefaultApp(), nil
+	}
+
+	for _, app := range apps {
+		if app.Algo == algo {
+			return app, nil
+		}
+	}
+
+	return App{}, fmt.Errorf("no Ledger app holds %s keys", algo)
+}
+
+// IsDefault returns true if the app is the default app.
+func (app App) IsDefault() bool {
+	return app.Name == options.appName
+}
+
+// discover connects to the app on a device.
+func (app App) discover() (SECP256K1, error) {
+	if app.Discover != nil {
+		return app.Discover()
+	}
+
+	return getDevice()
+}
+
+// signP2 returns the P2 value of the sign APDU for the given sign mode.
+func (app App) signP2(signMode signing.SignMode) (byte, error) {
+	p2, ok := app.SignModes[signMode]
+	if !ok {
+		return 0, fmt.Errorf("the %s Ledger app does not support %s", app.Name, signMode)
+	}
+
+	return p2, nil
+}
+
+// ParseSecp256r1PubKey parses a secp256r1 public key returned by a Ledger app,
+// either compressed or uncompressed.
+func ParseSecp256r1PubKey(bz []byte) (types.PubKey, error) {
+	return secp256r1.NewPubKey(bz)
+}
+
+// ParseSecp256r1Signature converts a DER-encoded secp256r1 signature returned
+// by a Ledger app into the low-S R || S format verified by secp256r1 public
+// keys.
+func ParseSecp256r1Signature(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after the DER signature")
+	}
+
+	n := elliptic.P256().Params().N
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(n) >= 0 || sig.S.Cmp(n) >= 0 {
+		return nil, errors.New("invalid secp256r1 signature")
+	}
+
+	// normalize S to the lower half of the curve order.
+	if sig.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		sig.S = new(big.Int).Sub(n, sig.S)
+	}
+
+	sigBytes := make([]byte, 64)
+	sig.R.FillBytes(sigBytes[:32])
+	sig.S.FillBytes(sigBytes[32:])
+
+	return sigBytes, nil
+}
+
+// compressSecp256k1PubKey re-serializes a secp256k1 public key in the 33-byte
+// compressed format.
+func compressSecp256k1PubKey(bz []byte) ([]byte, error) {
+	cmp, err := secec.NewPublicKey(bz)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	compressedPublicKey := make([]byte, secp256k1.PubKeySize)
+	copy(compressedPublicKey, cmp.CompressedBytes())
+
+	return compressedPublicKey, nil
+}
@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestRegisterApp(t *testing.T) {
+	parsePubKey := func([]byte) (types.PubKey, error) { return nil, nil }
+
+	testCases := []struct {
+		name   string
+		app    App
+		expErr string
+	}{
+		{"empty name", App{Algo: "secp256r1", ParsePubKey: parsePubKey, SignModes: CosmosSignModes}, "name cannot be empty"},
+		{"default app", App{Name: options.appName, Algo: "secp256r1", ParsePubKey: parsePubKey, SignModes: CosmosSignModes}, "is the default Ledger app"},
+		{"no algo", App{Name: "Test", ParsePubKey: parsePubKey, SignModes: CosmosSignModes}, "algorithm of the Test Ledger app cannot be empty"},
+		{"no pubkey parser", App{Name: "Test", Algo: "secp256r1", SignModes: CosmosSignModes}, "must parse its public keys"},
+		{"no sign mode", App{Name: "Test", Algo: "secp256r1", ParsePubKey: parsePubKey}, "must support a sign mode"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorContains(t, RegisterApp(tc.app), tc.expErr)
+		})
+	}
+
+	app := App{Name: "TestRegisterApp", Algo: "test_algo", ParsePubKey: parsePubKey, SignModes: CosmosSignModes}
+	require.NoError(t, RegisterApp(app))
+
+	got, err := GetApp(app.Name)
+	require.NoError(t, err)
+	require.Equal(t, app.Algo, got.Algo)
+	require.False(t, got.IsDefault())
+
+	got, err = GetAppForAlgo(app.Algo)
+	require.NoError(t, err)
+	require.Equal(t, app.Name, got.Name)
+
+	// registering an app with the same name replaces it
+	app.SignModes = map[signing.SignMode]byte{signing.SignMode_SIGN_MODE_TEXTUAL: 2}
+	require.NoError(t, RegisterApp(app))
+	got, err = GetApp(app.Name)
+	require.NoError(t, err)
+	p2, err := got.signP2(signing.SignMode_SIGN_MODE_TEXTUAL)
+	require.NoError(t, err)
+	require.Equal(t, byte(2), p2)
+	_, err = got.signP2(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.Error(t, err)
+
+	// the default app
+	for _, name := range []string{"", options.appName} {
+		got, err = GetApp(name)
+		require.NoError(t, err)
+		require.True(t, got.IsDefault())
+	}
+	got, err = GetAppForAlgo("secp256k1")
+	require.NoError(t, err)
+	require.True(t, got.IsDefault())
+
+	_, err = GetApp("Unknown")
+	require.Error(t, err)
+	_, err = GetAppForAlgo("unknown_algo")
+	require.Error(t, err)
+}
+
+func TestParseSecp256r1Signature(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pub, err := priv.PublicKey.ECDH()
+	require.NoError(t, err)
+	pubKey, err := ParseSecp256r1PubKey(pub.Bytes())
+	require.NoError(t, err)
+
+	msg := []byte("some message")
+	h := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, priv, h[:])
+	require.NoError(t, err)
+
+	// both the low-S and high-S forms are normalized
+	n := elliptic.P256().Params().N
+	for _, s := range []*big.Int{s, new(big.Int).Sub(n, s)} {
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		require.NoError(t, err)
+
+		sig, err := ParseSecp256r1Signature(der)
+		require.NoError(t, err)
+		require.Len(t, sig, 64)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+	}
+
+	_, err = ParseSecp256r1Signature([]byte("invalid"))
+	require.Error(t, err)
+
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{r, n})
+	require.NoError(t, err)
+	_, err = ParseSecp256r1Signature(der)
+	require.ErrorContains(t, err, "invalid secp256r1 signature")
+}
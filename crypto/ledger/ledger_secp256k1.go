@@ -8,11 +8,11 @@ import (
 
 	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
-	"gitlab.com/yawning/secp256k1-voi/secec"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 // options stores the Ledger Options that can be used to customize Ledger usage
@@ -52,13 +52,17 @@ type (
 	}
 
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
-	// cache the PubKey from the first call to use it later.
+	// cache the PubKey from the first call to use it later. Despite its name,
+	// it holds the keys of any Ledger app, e.g. secp256r1 keys.
 	PrivKeyLedgerSecp256k1 struct {
 		// CachedPubKey should be private, but we want to encode it via
 		// go-amino so we can view the address later, even without having the
 		// ledger attached.
 		CachedPubKey types.PubKey
 		Path         hd.BIP44Params
+		// App is the name of the Ledger app holding the key, empty for the
+		// default app.
+		App string
 	}
 )
 
@@ -97,35 +101,66 @@ func SetSkipDERConversion() {
 // It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
 // please refer to NewPrivKeySecp256k1
 func NewPrivKeySecp256k1Unsafe(path hd.BIP44Params) (types.LedgerPrivKeyAminoJSON, error) {
-	device, err := getDevice()
+	return NewPrivKeyUnsafe("", path)
+}
+
+// NewPrivKeyUnsafe is like NewPrivKeySecp256k1Unsafe for a key held by the
+// Ledger app with the given name, the default app if empty.
+func NewPrivKeyUnsafe(appName string, path hd.BIP44Params) (types.LedgerPrivKeyAminoJSON, error) {
+	app, err := GetApp(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := app.discover()
 	if err != nil {
 		return nil, err
 	}
 	defer warnIfErrors(device.Close)
 
-	pubKey, err := getPubKeyUnsafe(device, path)
+	pubKey, err := getPubKeyUnsafe(device, app, path)
 	if err != nil {
 		return nil, err
 	}
 
-	return PrivKeyLedgerSecp256k1{pubKey, path}, nil
+	return newPrivKey(app, pubKey, path), nil
 }
 
 // NewPrivKeySecp256k1 will generate a new key and store the public key for later use.
 // The request will require user confirmation and will show account and index in the device
 func NewPrivKeySecp256k1(path hd.BIP44Params, hrp string) (types.LedgerPrivKey, string, error) {
-	device, err := getDevice()
+	return NewPrivKey("", path, hrp)
+}
+
+// NewPrivKey is like NewPrivKeySecp256k1 for a key held by the Ledger app with
+// the given name, the default app if empty.
+func NewPrivKey(appName string, path hd.BIP44Params, hrp string) (types.LedgerPrivKey, string, error) {
+	app, err := GetApp(appName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	device, err := app.discover()
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to retrieve device: %w", err)
 	}
 	defer warnIfErrors(device.Close)
 
-	pubKey, addr, err := getPubKeyAddrSafe(device, path, hrp)
+	pubKey, addr, err := getPubKeyAddrSafe(device, app, path, hrp)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to recover pubkey: %w", err)
 	}
 
-	return PrivKeyLedgerSecp256k1{pubKey, path}, addr, nil
+	return newPrivKey(app, pubKey, path), addr, nil
+}
+
+func newPrivKey(app App, pubKey types.PubKey, path hd.BIP44Params) PrivKeyLedgerSecp256k1 {
+	pkl := PrivKeyLedgerSecp256k1{CachedPubKey: pubKey, Path: path}
+	if !app.IsDefault() {
+		pkl.App = app.Name
+	}
+
+	return pkl
 }
 
 // PubKey returns the cached public key.
@@ -133,39 +168,60 @@ func (pkl PrivKeyLedgerSecp256k1) PubKey() types.PubKey {
 	return pkl.CachedPubKey
 }
 
-// Sign returns a secp256k1 signature for the corresponding message using
+// Sign returns a signature for the corresponding message using
 // SIGN_MODE_TEXTUAL.
 func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
-	device, err := getDevice()
+	return pkl.signMode(message, signing.SignMode_SIGN_MODE_TEXTUAL)
+}
+
+// SignLedgerAminoJSON returns a signature for the corresponding message using
+// SIGN_MODE_LEGACY_AMINO_JSON.
+func (pkl PrivKeyLedgerSecp256k1) SignLedgerAminoJSON(message []byte) ([]byte, error) {
+	return pkl.signMode(message, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+// signMode returns a signature for the corresponding message using the given
+// sign mode, which must be supported by the Ledger app holding the key.
+func (pkl PrivKeyLedgerSecp256k1) signMode(message []byte, mode signing.SignMode) ([]byte, error) {
+	app, err := GetApp(pkl.App)
 	if err != nil {
 		return nil, err
 	}
-	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message, 1)
-}
+	p2, err := app.signP2(mode)
+	if err != nil {
+		return nil, err
+	}
 
-// SignLedgerAminoJSON returns a secp256k1 signature for the corresponding message using
-// SIGN_MODE_LEGACY_AMINO_JSON.
-func (pkl PrivKeyLedgerSecp256k1) SignLedgerAminoJSON(message []byte) ([]byte, error) {
-	device, err := getDevice()
+	device, err := app.discover()
 	if err != nil {
 		return nil, err
 	}
 	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message, 0)
+	return sign(device, app, pkl, message, p2)
 }
 
 // ShowAddress triggers a ledger device to show the corresponding address.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
-	device, err := getDevice()
+	return ShowAppAddress("", path, expectedPubKey, accountAddressPrefix)
+}
+
+// ShowAppAddress triggers the Ledger app with the given name, the default app
+// if empty, to show the corresponding address.
+func ShowAppAddress(appName string, path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
+	app, err := GetApp(appName)
+	if err != nil {
+		return err
+	}
+
+	device, err := app.discover()
 	if err != nil {
 		return err
 	}
 	defer warnIfErrors(device.Close)
 
-	pubKey, err := getPubKeyUnsafe(device, path)
+	pubKey, err := getPubKeyUnsafe(device, app, path)
 	if err != nil {
 		return err
 	}
@@ -174,7 +230,7 @@ func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddres
 		return errors.New("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
 	}
 
-	pubKey2, _, err := getPubKeyAddrSafe(device, path, accountAddressPrefix)
+	pubKey2, _, err := getPubKeyAddrSafe(device, app, path, accountAddressPrefix)
 	if err != nil {
 		return err
 	}
@@ -189,13 +245,18 @@ func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddres
 // ValidateKey allows us to verify the sanity of a public key after loading it
 // from disk.
 func (pkl PrivKeyLedgerSecp256k1) ValidateKey() error {
-	device, err := getDevice()
+	app, err := GetApp(pkl.App)
+	if err != nil {
+		return err
+	}
+
+	device, err := app.discover()
 	if err != nil {
 		return err
 	}
 	defer warnIfErrors(device.Close)
 
-	return validateKey(device, pkl)
+	return validateKey(device, app, pkl)
 }
 
 // AssertIsPrivKeyInner implements the PrivKey interface. It performs a no-op.
@@ -267,8 +328,8 @@ func getDevice() (SECP256K1, error) {
 	return device, nil
 }
 
-func validateKey(device SECP256K1, pkl PrivKeyLedgerSecp256k1) error {
-	pub, err := getPubKeyUnsafe(device, pkl.Path)
+func validateKey(device SECP256K1, app App, pkl PrivKeyLedgerSecp256k1) error {
+	pub, err := getPubKeyUnsafe(device, app, pkl.Path)
 	if err != nil {
 		return err
 	}
@@ -287,9 +348,10 @@ func validateKey(device SECP256K1, pkl PrivKeyLedgerSecp256k1) error {
 // an error, so this should only trigger if the private key is held in memory
 // for a while before use.
 //
-// Last byte P2 is 0 for LEGACY_AMINO_JSON, and 1 for TEXTUAL.
-func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, p2 byte) ([]byte, error) {
-	err := validateKey(device, pkl)
+// Last byte P2 selects the sign mode, e.g. 0 for LEGACY_AMINO_JSON and 1 for
+// TEXTUAL in the Cosmos app.
+func sign(device SECP256K1, app App, pkl PrivKeyLedgerSecp256k1, msg []byte, p2 byte) ([]byte, error) {
+	err := validateKey(device, app, pkl)
 	if err != nil {
 		return nil, err
 	}
@@ -299,11 +361,11 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, p2 byte) ([]
 		return nil, err
 	}
 
-	if options.skipDERConversion {
+	if app.ParseSignature == nil {
 		return sig, nil
 	}
 
-	return convertDERtoBER(sig)
+	return app.ParseSignature(sig)
 }
 
 // getPubKeyUnsafe reads the pubkey from a ledger device
@@ -314,22 +376,13 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, p2 byte) ([]
 //
 // since this involves IO, it may return an error, which is not exposed
 // in the PubKey interface, so this function allows better error handling
-func getPubKeyUnsafe(device SECP256K1, path hd.BIP44Params) (types.PubKey, error) {
+func getPubKeyUnsafe(device SECP256K1, app App, path hd.BIP44Params) (types.PubKey, error) {
 	publicKey, err := device.GetPublicKeySECP256K1(path.DerivationPath())
 	if err != nil {
-		return nil, fmt.Errorf("please open the %v app on the Ledger device - error: %w", options.appName, err)
+		return nil, fmt.Errorf("please open the %v app on the Ledger device - error: %w", app.Name, err)
 	}
 
-	// re-serialize in the 33-byte compressed format
-	cmp, err := secec.NewPublicKey(publicKey)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing public key: %w", err)
-	}
-
-	compressedPublicKey := make([]byte, secp256k1.PubKeySize)
-	copy(compressedPublicKey, cmp.CompressedBytes())
-
-	return options.createPubkey(compressedPublicKey), nil
+	return app.ParsePubKey(publicKey)
 }
 
 // getPubKeyAddr reads the pubkey and the address from a ledger device.
@@ -338,20 +391,20 @@ func getPubKeyUnsafe(device SECP256K1, path hd.BIP44Params) (types.PubKey, error
 //
 // Since this involves IO, it may return an error, which is not exposed
 // in the PubKey interface, so this function allows better error handling.
-func getPubKeyAddrSafe(device SECP256K1, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
+func getPubKeyAddrSafe(device SECP256K1, app App, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
+	if app.NoHRP {
+		hrp = ""
+	}
+
 	publicKey, addr, err := device.GetAddressPubKeySECP256K1(path.DerivationPath(), hrp)
 	if err != nil {
 		return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path)
 	}
 
-	// re-serialize in the 33-byte compressed format
-	cmp, err := secec.NewPublicKey(publicKey)
+	pubKey, err := app.ParsePubKey(publicKey)
 	if err != nil {
-		return nil, "", fmt.Errorf("error parsing public key: %w", err)
+		return nil, "", err
 	}
 
-	compressedPublicKey := make([]byte, secp256k1.PubKeySize)
-	copy(compressedPublicKey, cmp.CompressedBytes())
-
-	return options.createPubkey(compressedPublicKey), addr, nil
+	return pubKey, addr, nil
 }
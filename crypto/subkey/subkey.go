@@ -0,0 +1,105 @@
+// Package subkey derives purpose-scoped keys from a parent private key, such as
+// a validator consensus key.
+//
+// Sidecar services running next to a validator (oracles, p2p networks, ...)
+// often need their own signing keys bound to the validator identity. Reusing
+// the consensus key directly is unsafe: a signature produced for a sidecar
+// could be replayed as a consensus signature, and a compromise of the sidecar
+// exposes the consensus key. Instead, this package derives independent keys
+// from the parent key material with HKDF-SHA256 (RFC 5869). Derivations are
+// domain separated by purpose and by the parent key type, so different
+// purposes always yield unrelated keys, and a derived key reveals nothing
+// about the parent key or its siblings.
+//
+// The derivation is deterministic: the same parent key and purpose always
+// yield the same subkey, so a sidecar does not need to persist its own keys.
+package subkey
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// Salt is the HKDF salt used for every derivation. It must never change, as
+// doing so would change all the derived keys.
+const Salt = "cosmos-sdk/subkey/v1"
+
+// SeedSize is the size, in bytes, of the seeds derived from the parent key.
+const SeedSize = 32
+
+// Well-known purposes. Any non-empty purpose is accepted, applications are
+// encouraged to namespace theirs, e.g. "myapp/price-feed".
+const (
+	PurposeOracle = "oracle"
+	PurposeP2P    = "p2p"
+)
+
+// ParentKey is the key material subkeys are derived from. It is implemented by
+// both the SDK and CometBFT private keys, so subkeys can be derived from the
+// key stored in priv_validator_key.json.
+type ParentKey interface {
+	Bytes() []byte
+	Type() string
+}
+
+// DeriveSeed derives a SeedSize bytes seed for the given purpose from the
+// parent key.
+func DeriveSeed(parent ParentKey, purpose string) ([]byte, error) {
+	if parent == nil || len(parent.Bytes()) == 0 {
+		return nil, errors.New("parent key cannot be empty")
+	}
+	if purpose == "" {
+		return nil, errors.New("purpose cannot be empty")
+	}
+
+	seed := make([]byte, SeedSize)
+	kdf := hkdf.New(sha256.New, parent.Bytes(), []byte(Salt), info(parent.Type(), purpose))
+	if _, err := io.ReadFull(kdf, seed); err != nil {
+		return nil, fmt.Errorf("failed to derive seed: %w", err)
+	}
+
+	return seed, nil
+}
+
+// DeriveEd25519 derives an ed25519 private key for the given purpose from the
+// parent key.
+func DeriveEd25519(parent ParentKey, purpose string) (*ed25519.PrivKey, error) {
+	seed, err := DeriveSeed(parent, purpose+"/ed25519")
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.GenPrivKeyFromSecret(seed), nil
+}
+
+// DeriveSecp256k1 derives a secp256k1 private key for the given purpose from
+// the parent key.
+func DeriveSecp256k1(parent ParentKey, purpose string) (*secp256k1.PrivKey, error) {
+	seed, err := DeriveSeed(parent, purpose+"/secp256k1")
+	if err != nil {
+		return nil, err
+	}
+
+	return secp256k1.GenPrivKeyFromSecret(seed), nil
+}
+
+// info returns the HKDF info binding a derivation to the parent key type and
+// the purpose. Both are length prefixed so that no two (type, purpose) pairs
+// share the same encoding.
+func info(keyType, purpose string) []byte {
+	bz := make([]byte, 0, 8+len(keyType)+len(purpose))
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(keyType)))
+	bz = append(bz, keyType...)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(purpose)))
+	bz = append(bz, purpose...)
+
+	return bz
+}
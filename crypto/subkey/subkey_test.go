@@ -0,0 +1,70 @@
+package subkey_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/subkey"
+)
+
+func TestDeriveSeed(t *testing.T) {
+	parent := ed25519.GenPrivKeyFromSecret([]byte("validator"))
+
+	seed, err := subkey.DeriveSeed(parent, subkey.PurposeOracle)
+	require.NoError(t, err)
+	// the derivation must never change, otherwise sidecars would lose their keys
+	require.Equal(t, "affd9fc05ca46befa51a6ff03f93291cf081850a61940fb5cfb33e51475b0b6d", hex.EncodeToString(seed))
+
+	again, err := subkey.DeriveSeed(parent, subkey.PurposeOracle)
+	require.NoError(t, err)
+	require.Equal(t, seed, again)
+
+	// purposes are domain separated
+	other, err := subkey.DeriveSeed(parent, subkey.PurposeP2P)
+	require.NoError(t, err)
+	require.NotEqual(t, seed, other)
+
+	// so are parent keys
+	other, err = subkey.DeriveSeed(ed25519.GenPrivKeyFromSecret([]byte("other")), subkey.PurposeOracle)
+	require.NoError(t, err)
+	require.NotEqual(t, seed, other)
+
+	// CometBFT keys with the same key material yield the same seeds
+	cmtSeed, err := subkey.DeriveSeed(cmted25519.PrivKey(parent.Bytes()), subkey.PurposeOracle)
+	require.NoError(t, err)
+	require.Equal(t, seed, cmtSeed)
+
+	_, err = subkey.DeriveSeed(parent, "")
+	require.ErrorContains(t, err, "purpose cannot be empty")
+	_, err = subkey.DeriveSeed(nil, subkey.PurposeOracle)
+	require.ErrorContains(t, err, "parent key cannot be empty")
+}
+
+func TestDeriveKeys(t *testing.T) {
+	parent := secp256k1.GenPrivKey()
+	msg := []byte("price:42")
+
+	edKey, err := subkey.DeriveEd25519(parent, subkey.PurposeOracle)
+	require.NoError(t, err)
+	sig, err := edKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, edKey.PubKey().VerifySignature(msg, sig))
+
+	secpKey, err := subkey.DeriveSecp256k1(parent, subkey.PurposeOracle)
+	require.NoError(t, err)
+	sig, err = secpKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, secpKey.PubKey().VerifySignature(msg, sig))
+
+	// keys of different types for the same purpose are unrelated
+	require.NotEqual(t, edKey.Bytes()[:32], secpKey.Bytes())
+
+	again, err := subkey.DeriveSecp256k1(parent, subkey.PurposeOracle)
+	require.NoError(t, err)
+	require.True(t, secpKey.Equals(again))
+}
@@ -49,6 +49,25 @@ type PrivKey interface {
 	LedgerPrivKey
 }
 
+// DestroyablePrivKey is a private key able to zeroize its key material in
+// memory. It is added as a non-breaking change, instead of directly on the
+// PrivKey interface.
+type DestroyablePrivKey interface {
+	LedgerPrivKey
+	// Destroy zeroizes the key material of the private key, which can't be
+	// used afterwards.
+	Destroy()
+}
+
+// DestroyPrivKey zeroizes the key material of the private key if it is a
+// DestroyablePrivKey, and is a no-op otherwise. Callers holding a private key
+// in memory, e.g. exported from a keyring, should destroy it once done with it.
+func DestroyPrivKey(privKey LedgerPrivKey) {
+	if privKey, ok := privKey.(DestroyablePrivKey); ok {
+		privKey.Destroy()
+	}
+}
+
 type (
 	Address = cmtcrypto.Address
 )
@@ -17,6 +17,7 @@ type container struct {
 	resolvers         map[string]resolver
 	interfaceBindings map[string]interfaceBinding
 	invokers          []invoker
+	decorators        map[reflect.Type][]*decorator
 
 	moduleKeyContext *ModuleKeyContext
 
@@ -85,7 +86,9 @@ func (c *container) call(provider *providerDescriptor, moduleKey *moduleKey) ([]
 	delete(c.callerMap, loc)
 	c.callerStack = c.callerStack[0 : len(c.callerStack)-1]
 
+	recordMemory := c.measureMemory(loc, moduleKey)
 	out, err := provider.Fn(inVals)
+	recordMemory()
 	if err != nil {
 		return nil, errors.Wrapf(err, "error calling provider %s", loc)
 	}
@@ -416,6 +419,12 @@ func (c *container) resolve(in providerInput, moduleKey *moduleKey, caller Locat
 		return reflect.Value{}, err
 	}
 
+	res, err = c.decorate(in.Type, vr, res, moduleKey)
+	if err != nil {
+		markGraphNodeAsFailed(typeGraphNode)
+		return reflect.Value{}, err
+	}
+
 	markGraphNodeAsUsed(typeGraphNode)
 
 	c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
@@ -200,6 +200,10 @@ type debugConfig struct {
 	visualizers   []func(string)
 	logVisualizer bool
 
+	// memory reporting
+	memoryReporters []func(MemoryReport)
+	memoryReport    MemoryReport
+
 	// extra processing
 	onError   DebugOption
 	onSuccess DebugOption
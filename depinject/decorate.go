@@ -0,0 +1,135 @@
+package depinject
+
+import (
+	"reflect"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Decorate defines a container configuration which registers the provided
+// decorators. A decorator takes the value of a type provided by the container,
+// along with other dependencies, and returns the value of the same type which is
+// provided instead to the dependents of the type, e.g. to wrap a keeper with
+// instrumentation:
+//
+//	Decorate(func(k Keeper, logger log.Logger) Keeper { return NewLoggingKeeper(k, logger) })
+//
+// Each decorator must return exactly one type along with an optional error, and
+// is called at most once, or at most once per module for module-scoped types.
+// The decorators of a type are applied in the order in which they were defined.
+// All decorator functions must be declared, exported functions not
+// internal packages and all of their input and output types must also be declared
+// and exported and not in internal packages.
+func Decorate(decorators ...interface{}) Config {
+	return containerConfig(func(ctr *container) error {
+		for _, d := range decorators {
+			desc, err := extractProviderDescriptor(d)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if err := ctr.addDecorator(&desc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type decorator struct {
+	provider *providerDescriptor
+	typ      reflect.Type
+	values   map[*moduleKey]reflect.Value
+	calling  bool
+}
+
+func (c *container) addDecorator(provider *providerDescriptor) error {
+	if len(provider.Outputs) != 1 {
+		return errors.Errorf("decorator %s should return exactly one type", provider.Location)
+	}
+
+	typ := provider.Outputs[0].Type
+	decorated := false
+	for _, in := range provider.Inputs {
+		if in.Type == typ {
+			decorated = true
+		}
+	}
+	if !decorated {
+		return errors.Errorf("decorator %s should take the type %v it decorates as an input", provider.Location, typ)
+	}
+
+	c.logf("Registering decorator %s for %v", provider.Location, typ)
+	decoratorGraphNode := c.locationGraphNode(provider.Location, nil)
+	c.addGraphEdge(decoratorGraphNode, c.typeGraphNode(typ))
+
+	if c.decorators == nil {
+		c.decorators = map[reflect.Type][]*decorator{}
+	}
+	c.decorators[typ] = append(c.decorators[typ], &decorator{
+		provider: provider,
+		typ:      typ,
+		values:   map[*moduleKey]reflect.Value{},
+	})
+
+	return nil
+}
+
+// decorate applies the decorators of the type to the value resolved by the
+// resolver for the module.
+func (c *container) decorate(typ reflect.Type, vr resolver, value reflect.Value, key *moduleKey) (reflect.Value, error) {
+	decorators := c.decorators[typ]
+	if len(decorators) == 0 {
+		return value, nil
+	}
+
+	// the values of the other resolvers are the same in all the modules.
+	if _, ok := vr.(*moduleDepResolver); !ok {
+		key = nil
+	}
+
+	for _, d := range decorators {
+		var err error
+		value, err = d.decorate(c, value, key)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return value, nil
+}
+
+func (d *decorator) decorate(c *container, value reflect.Value, key *moduleKey) (reflect.Value, error) {
+	if decorated, ok := d.values[key]; ok {
+		return decorated, nil
+	}
+
+	loc := d.provider.Location
+	if d.calling {
+		return reflect.Value{}, errors.Errorf("cyclic dependency: %s -> %s", loc.Name(), loc.Name())
+	}
+	d.calling = true
+	defer func() { d.calling = false }()
+
+	c.logf("Decorating %v with %s", d.typ, loc)
+	inVals := make([]reflect.Value, len(d.provider.Inputs))
+	for i, in := range d.provider.Inputs {
+		if in.Type == d.typ {
+			inVals[i] = value
+			continue
+		}
+
+		val, err := c.resolve(in, key, loc)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		inVals[i] = val
+	}
+
+	out, err := d.provider.Fn(inVals)
+	if err != nil {
+		return reflect.Value{}, errors.Wrapf(err, "error calling decorator %s", loc)
+	}
+
+	d.values[key] = out[0]
+	return out[0], nil
+}
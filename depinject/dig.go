@@ -0,0 +1,102 @@
+package depinject
+
+import (
+	"reflect"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DigContainer is a container exposing the Provide, Invoke and Decorate methods
+// of the go.uber.org/dig Container, so that the applications wired with dig can
+// migrate their wiring to depinject incrementally: the dig providers are
+// registered as is, while the providers already migrated are registered as a
+// Config with Apply.
+//
+// Unlike Inject, each Invoke resolves the dependencies of the function and
+// calls it immediately. The providers and decorators are called at most once
+// across all the calls to Invoke, and may be anonymous functions. The fields of
+// the parameter and result structs embedding In and Out are resolved like with
+// Inject, the dig name and group tags are not supported: use the
+// ManyPerContainerType and OnePerModuleType types instead.
+type DigContainer struct {
+	ctr *container
+}
+
+// NewDigContainer returns a new empty DigContainer.
+func NewDigContainer() *DigContainer {
+	cfg, _ := newDebugConfig()
+	return &DigContainer{ctr: newContainer(cfg)}
+}
+
+// Provide registers the constructor, whose results are provided to the
+// functions depending on them, like dig.Container.Provide.
+func (c *DigContainer) Provide(constructor interface{}) error {
+	desc, err := extractDigDescriptor(constructor)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.ctr.addNode(&desc, nil)
+	return err
+}
+
+// Invoke calls the function with its dependencies, like dig.Container.Invoke.
+// The error returned by the function, if any, is wrapped in the returned error.
+func (c *DigContainer) Invoke(function interface{}) error {
+	desc, err := extractDigDescriptor(function)
+	if err != nil {
+		return err
+	}
+
+	if len(desc.Outputs) > 0 {
+		return errors.Errorf("invoked function %s should not return any outputs", desc.Location)
+	}
+
+	_, err = c.ctr.call(&desc, nil)
+	return err
+}
+
+// Decorate registers the decorator, whose result is provided instead of the
+// type it decorates to the functions depending on it, like
+// dig.Container.Decorate. See Decorate.
+func (c *DigContainer) Decorate(decorator interface{}) error {
+	desc, err := extractDigDescriptor(decorator)
+	if err != nil {
+		return err
+	}
+
+	return c.ctr.addDecorator(&desc)
+}
+
+// Apply registers the providers, decorators and interface bindings of the
+// config in the container, and calls its invokers.
+func (c *DigContainer) Apply(config Config) error {
+	numInvokers := len(c.ctr.invokers)
+	if err := config.apply(c.ctr); err != nil {
+		return err
+	}
+
+	for _, inv := range c.ctr.invokers[numInvokers:] {
+		if _, err := c.ctr.call(inv.fn, inv.modKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractDigDescriptor builds the descriptor of a dig function, which may be
+// unexported or anonymous since dig applications are not wired by codegen.
+func extractDigDescriptor(fn interface{}) (providerDescriptor, error) {
+	val := reflect.ValueOf(fn)
+	if val.Kind() != reflect.Func {
+		return providerDescriptor{}, errors.Errorf("expected a Func type, got %T", fn)
+	}
+
+	desc, err := reflectProviderDescriptor(val, LocationFromPC(val.Pointer()))
+	if err != nil {
+		return providerDescriptor{}, err
+	}
+
+	return expandStructArgsProvider(desc)
+}
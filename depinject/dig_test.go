@@ -0,0 +1,105 @@
+package depinject_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+type DigConfig struct {
+	Name string
+}
+
+type DigServer struct {
+	Addr string
+}
+
+type DigParams struct {
+	depinject.In
+
+	Config DigConfig
+	Port   int `optional:"true"`
+}
+
+func DecorateDigConfig(cfg DigConfig, suffix string) DigConfig {
+	return DigConfig{Name: cfg.Name + suffix}
+}
+
+func TestDigContainer(t *testing.T) {
+	t.Parallel()
+
+	c := depinject.NewDigContainer()
+
+	calls := 0
+	require.NoError(t, c.Provide(func() DigConfig {
+		calls++
+		return DigConfig{Name: "app"}
+	}))
+	require.NoError(t, c.Provide(func(p DigParams) (*DigServer, error) {
+		return &DigServer{Addr: fmt.Sprintf("%s:%d", p.Config.Name, p.Port)}, nil
+	}))
+	require.ErrorContains(t, c.Provide(func() DigConfig { return DigConfig{} }), "duplicate provision")
+
+	var server *DigServer
+	require.NoError(t, c.Invoke(func(s *DigServer) { server = s }))
+	require.Equal(t, "app:0", server.Addr)
+
+	// the providers are called once across the invokes.
+	require.NoError(t, c.Invoke(func(s *DigServer, cfg DigConfig) {
+		require.Same(t, server, s)
+	}))
+	require.Equal(t, 1, calls)
+
+	errFailed := errors.New("failed")
+	require.ErrorIs(t, c.Invoke(func(DigConfig) error { return errFailed }), errFailed)
+	require.ErrorContains(t, c.Invoke(func(string) {}), "can't resolve type string")
+	require.ErrorContains(t, c.Invoke(func() int { return 1 }), "should not return any outputs")
+
+	// the providers migrated to depinject are applied as a config.
+	require.NoError(t, c.Apply(depinject.Configs(
+		depinject.Supply("-v2"),
+		depinject.Decorate(DecorateDigConfig),
+	)))
+	require.NoError(t, c.Invoke(func(cfg DigConfig) {
+		require.Equal(t, "app-v2", cfg.Name)
+	}))
+}
+
+func TestDigContainerDecorate(t *testing.T) {
+	t.Parallel()
+
+	c := depinject.NewDigContainer()
+	require.NoError(t, c.Provide(func() DigConfig { return DigConfig{Name: "app"} }))
+	require.NoError(t, c.Decorate(func(cfg DigConfig) DigConfig { return DigConfig{Name: cfg.Name + "-a"} }))
+	require.NoError(t, c.Decorate(func(cfg DigConfig) (DigConfig, error) { return DigConfig{Name: cfg.Name + "-b"}, nil }))
+	require.ErrorContains(t, c.Decorate(func(string) DigConfig { return DigConfig{} }), "should take the type")
+	require.ErrorContains(t, c.Decorate(func(cfg DigConfig) {}), "should return exactly one type")
+
+	// the decorators are applied in order.
+	require.NoError(t, c.Invoke(func(cfg DigConfig) {
+		require.Equal(t, "app-a-b", cfg.Name)
+	}))
+}
+
+func TestDecorate(t *testing.T) {
+	t.Parallel()
+
+	var cfg DigConfig
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			depinject.Supply(DigConfig{Name: "app"}, "-v2"),
+			depinject.Decorate(DecorateDigConfig),
+		),
+		&cfg,
+	))
+	require.Equal(t, "app-v2", cfg.Name)
+
+	require.ErrorContains(t, depinject.Inject(
+		depinject.Decorate(func(cfg DigConfig) DigConfig { return cfg }),
+		&cfg,
+	), "function must be exported")
+}
@@ -32,8 +32,9 @@ func inject(loc Location, debugOpt DebugOption, config Config, outputs ...interf
 		return err
 	}
 
-	// always generate graph on exit
+	// always generate graph and report memory on exit
 	defer cfg.generateGraph()
+	defer cfg.reportMemory()
 
 	// debug cleanup
 	defer func() {
@@ -0,0 +1,146 @@
+package depinject
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+)
+
+// ProviderMemoryStats holds the heap memory attributed to a single provider or
+// invoker call while building a container.
+type ProviderMemoryStats struct {
+	// Location is the fully qualified name of the provider or invoker.
+	Location string
+	// Module is the name of the module the provider was called for, or empty
+	// if it is not module-scoped.
+	Module string
+	// Allocs is the number of heap objects allocated by the call.
+	Allocs uint64
+	// AllocBytes is the number of heap bytes allocated by the call.
+	AllocBytes uint64
+	// RetainedBytes is the growth of the live heap caused by the call, which
+	// is mostly made of the values it provided. It may be negative if the call
+	// released memory.
+	RetainedBytes int64
+}
+
+// MemoryReport is the memory attributed to the providers and invokers of a
+// container, ordered by decreasing number of allocated bytes.
+type MemoryReport []ProviderMemoryStats
+
+// ByModule returns the report aggregated per module, with the calls which are
+// not module-scoped aggregated under an empty module name and no location.
+func (r MemoryReport) ByModule() MemoryReport {
+	idx := map[string]int{}
+	var res MemoryReport
+	for _, stats := range r {
+		i, ok := idx[stats.Module]
+		if !ok {
+			i = len(res)
+			idx[stats.Module] = i
+			res = append(res, ProviderMemoryStats{Module: stats.Module})
+		}
+
+		res[i].Allocs += stats.Allocs
+		res[i].AllocBytes += stats.AllocBytes
+		res[i].RetainedBytes += stats.RetainedBytes
+	}
+
+	res.sort()
+	return res
+}
+
+// String renders the report as a table.
+func (r MemoryReport) String() string {
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "MODULE\tLOCATION\tALLOCS\tALLOC BYTES\tRETAINED BYTES")
+	for _, stats := range r {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", stats.Module, stats.Location, stats.Allocs, stats.AllocBytes, stats.RetainedBytes)
+	}
+	_ = w.Flush()
+
+	return buf.String()
+}
+
+func (r MemoryReport) sort() {
+	sort.SliceStable(r, func(i, j int) bool {
+		return r[i].AllocBytes > r[j].AllocBytes
+	})
+}
+
+// MemoryReporter creates an option which measures the heap memory allocated
+// and retained by each provider and invoker while the container is built, and
+// passes the resulting report to the given function whenever the container
+// finishes building or fails due to an error. The memory allocated while
+// resolving the dependencies of a provider is attributed to the providers of
+// these dependencies.
+//
+// Measuring forces a garbage collection around every call, which slows down the
+// container significantly, so this option is meant for investigating the
+// startup memory of an app rather than for production use.
+func MemoryReporter(reporter func(MemoryReport)) DebugOption {
+	return debugOption(func(c *debugConfig) error {
+		c.memoryReporters = append(c.memoryReporters, reporter)
+		return nil
+	})
+}
+
+// LogMemoryReport is a debug option which logs the memory report of the
+// container, aggregated per module and then detailed per provider.
+func LogMemoryReport() DebugOption {
+	return debugOption(func(c *debugConfig) error {
+		c.memoryReporters = append(c.memoryReporters, func(report MemoryReport) {
+			c.logf("Memory by module:\n%s", report.ByModule())
+			c.logf("Memory by provider:\n%s", report)
+		})
+		return nil
+	})
+}
+
+// measureMemory starts measuring the memory attributed to a call of the
+// provider at loc and returns the function recording the measurement once the
+// call returns. It does nothing if no memory reporter is set.
+func (c *debugConfig) measureMemory(loc Location, key *moduleKey) func() {
+	if len(c.memoryReporters) == 0 {
+		return func() {}
+	}
+
+	before := readMemStats()
+	return func() {
+		after := readMemStats()
+		stats := ProviderMemoryStats{
+			Location:      loc.Name(),
+			Allocs:        after.Mallocs - before.Mallocs,
+			AllocBytes:    after.TotalAlloc - before.TotalAlloc,
+			RetainedBytes: int64(after.HeapAlloc) - int64(before.HeapAlloc),
+		}
+		if key != nil {
+			stats.Module = key.name
+		}
+
+		c.memoryReport = append(c.memoryReport, stats)
+	}
+}
+
+func (c *debugConfig) reportMemory() {
+	if len(c.memoryReporters) == 0 {
+		return
+	}
+
+	report := append(MemoryReport(nil), c.memoryReport...)
+	report.sort()
+	for _, reporter := range c.memoryReporters {
+		reporter(report)
+	}
+}
+
+// readMemStats returns the memory statistics of the runtime after a garbage
+// collection, so that the live heap only holds reachable objects.
+func readMemStats() (stats runtime.MemStats) {
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats
+}
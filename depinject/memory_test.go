@@ -0,0 +1,59 @@
+package depinject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+type BigValue struct {
+	data []byte
+}
+
+type SmallValue struct{}
+
+func ProvideBigValue() BigValue {
+	return BigValue{data: make([]byte, 1<<20)}
+}
+
+func ProvideSmallValue(BigValue) SmallValue {
+	return SmallValue{}
+}
+
+func TestMemoryReporter(t *testing.T) {
+	var report depinject.MemoryReport
+	var small SmallValue
+	require.NoError(t, depinject.InjectDebug(
+		depinject.MemoryReporter(func(r depinject.MemoryReport) { report = r }),
+		depinject.Configs(
+			depinject.ProvideInModule("big", ProvideBigValue),
+			depinject.Provide(ProvideSmallValue),
+		),
+		&small,
+	))
+
+	// the provider of BigValue, the provider of SmallValue and the outputs
+	require.Len(t, report, 3)
+	require.Equal(t, "big", report[0].Module)
+	require.Contains(t, report[0].Location, "ProvideBigValue")
+	require.GreaterOrEqual(t, report[0].AllocBytes, uint64(1<<20))
+	// the retained bytes also account for the unrelated objects released in
+	// the meantime
+	require.Greater(t, report[0].RetainedBytes, int64(1<<19))
+	for _, stats := range report[1:] {
+		require.Empty(t, stats.Module)
+		require.Less(t, stats.AllocBytes, uint64(1<<20))
+	}
+
+	byModule := report.ByModule()
+	require.Len(t, byModule, 2)
+	require.Equal(t, "big", byModule[0].Module)
+	require.Empty(t, byModule[0].Location)
+	require.Equal(t, report[0].AllocBytes, byModule[0].AllocBytes)
+	require.Equal(t, report[1].AllocBytes+report[2].AllocBytes, byModule[1].AllocBytes)
+
+	require.Contains(t, report.String(), "RETAINED BYTES")
+	require.Contains(t, report.String(), "ProvideBigValue")
+}
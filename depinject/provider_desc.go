@@ -89,6 +89,13 @@ func doExtractProviderDescriptor(ctr interface{}) (providerDescriptor, error) {
 		return providerDescriptor{}, errors.Errorf("function must not be in an internal package: %s", loc)
 	}
 
+	return reflectProviderDescriptor(val, loc)
+}
+
+// reflectProviderDescriptor builds the descriptor of the function value, without
+// checking that the function can be used by codegen.
+func reflectProviderDescriptor(val reflect.Value, loc Location) (providerDescriptor, error) {
+	typ := val.Type()
 	if typ.IsVariadic() {
 		return providerDescriptor{}, errors.Errorf("variadic function can't be used as a provider: %s", loc)
 	}
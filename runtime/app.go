@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
@@ -26,6 +27,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 // App is a wrapper around BaseApp and ModuleManager that can be used in hybrid
@@ -145,6 +147,10 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	a.ModuleManager.RegisterRecoveryHandlers(a.BaseApp)
+	a.ModuleManager.RegisterBlockSummaryMetrics(a.BaseApp)
+	a.ModuleManager.RegisterCustomQueryRoutes(a.BaseApp)
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
@@ -187,6 +193,16 @@ func (a *App) PrepareCheckStater(ctx sdk.Context) {
 
 // InitChainer initializes the chain.
 func (a *App) InitChainer(ctx sdk.Context, req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	if path, ok := genutiltypes.GenesisStreamPath(req.AppStateBytes); ok {
+		stream, err := genutiltypes.OpenGenesisStream(path)
+		if err != nil {
+			return nil, err
+		}
+		defer stream.Close()
+
+		return a.ModuleManager.InitGenesisStream(ctx, stream)
+	}
+
 	var genesisState map[string]json.RawMessage
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		return nil, err
@@ -229,7 +245,16 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(
+		clientCtx,
+		a.GRPCQueryRouter(),
+		cfg,
+		nodeservice.WithAppVersion(a.AppVersion),
+		nodeservice.WithModuleVersions(a.ModuleManager.GetVersionMap()),
+		nodeservice.WithSnapshotManager(a.SnapshotManager()),
+		nodeservice.WithAnteHandlerDescription(a.AnteHandlerDescription),
+		nodeservice.WithNodeKeyFile(cmtcfg.DefaultConfig().SetRoot(clientCtx.HomeDir).NodeKeyFile()),
+	)
 }
 
 // Configurator returns the app's configurator.
@@ -44,6 +44,17 @@ func EnvWithRouterService(
 	}
 }
 
+// EnvWithModuleQuerySafeRouterService sets a router service only allowing module query safe queries.
+// See NewModuleQuerySafeRouterService.
+func EnvWithModuleQuerySafeRouterService(
+	queryServiceRouter *baseapp.GRPCQueryRouter,
+	msgServiceRouter *baseapp.MsgServiceRouter,
+) EnvOption {
+	return func(env *appmodule.Environment) {
+		env.RouterService = NewModuleQuerySafeRouterService(env.KVStoreService, queryServiceRouter, msgServiceRouter)
+	}
+}
+
 func EnvWithMemStoreService(memStoreService store.MemoryStoreService) EnvOption {
 	return func(env *appmodule.Environment) {
 		env.MemStoreService = memStoreService
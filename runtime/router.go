@@ -30,6 +30,24 @@ func NewRouterService(storeService store.KVStoreService, queryRouter *baseapp.GR
 	}
 }
 
+// NewModuleQuerySafeRouterService creates a router.Service like NewRouterService, but whose query router
+// only invokes queries annotated with the cosmos.query.v1.module_query_safe option.
+// Such queries are deterministic and gas metered, they can safely be invoked from within state machine
+// execution: the gas they consume is charged to the gas meter of the calling context (e.g. the tx gas meter).
+func NewModuleQuerySafeRouterService(storeService store.KVStoreService, queryRouter *baseapp.GRPCQueryRouter, msgRouter baseapp.MessageRouter) router.Service {
+	return &routerService{
+		queryRouterService: &queryRouterService{
+			storeService:        storeService,
+			router:              queryRouter,
+			moduleQuerySafeOnly: true,
+		},
+		msgRouterService: &msgRouterService{
+			storeService: storeService,
+			router:       msgRouter,
+		},
+	}
+}
+
 var _ router.Service = (*routerService)(nil)
 
 type routerService struct {
@@ -109,6 +127,18 @@ var _ router.Router = (*queryRouterService)(nil)
 type queryRouterService struct {
 	storeService store.KVStoreService
 	router       *baseapp.GRPCQueryRouter
+	// moduleQuerySafeOnly restricts the router to queries marked as module query safe.
+	moduleQuerySafeOnly bool
+}
+
+// checkModuleQuerySafe returns an error if the router only allows module query safe
+// requests and the given request isn't one.
+func (m *queryRouterService) checkModuleQuerySafe(reqName string) error {
+	if m.moduleQuerySafeOnly && !m.router.IsModuleQuerySafe(reqName) {
+		return fmt.Errorf("request is not module query safe: %s", reqName)
+	}
+
+	return nil
 }
 
 // CanInvoke returns an error if the given request cannot be invoked.
@@ -126,7 +156,7 @@ func (m *queryRouterService) CanInvoke(ctx context.Context, typeURL string) erro
 		return fmt.Errorf("ambiguous request, query have multiple handlers: %s", typeURL)
 	}
 
-	return nil
+	return m.checkModuleQuerySafe(typeURL)
 }
 
 // InvokeTyped execute a message and fill-in a response.
@@ -141,6 +171,10 @@ func (m *queryRouterService) InvokeTyped(ctx context.Context, req, resp protoifa
 		return fmt.Errorf("ambiguous request, query have multiple handlers: %s", reqName)
 	}
 
+	if err := m.checkModuleQuerySafe(reqName); err != nil {
+		return err
+	}
+
 	return handlers[0](ctx, req, resp)
 }
 
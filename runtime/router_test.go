@@ -108,3 +108,33 @@ func TestRouterService(t *testing.T) {
 		require.Equal(t, int64(42), respVal.TotalCount)
 	})
 }
+
+func TestModuleQuerySafeRouterService(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(interfaceRegistry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(interfaceRegistry)
+	key := storetypes.NewKVStoreKey(countertypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	counterKeeper := counterkeeper.NewKeeper(runtime.NewEnvironment(storeService, log.NewNopLogger()))
+	countertypes.RegisterInterfaces(interfaceRegistry)
+	countertypes.RegisterQueryServer(queryRouter, counterKeeper)
+	bankv1beta1.RegisterQueryServer(queryRouter, bankv1beta1.UnimplementedQueryServer{})
+
+	routerService := runtime.NewModuleQuerySafeRouterService(storeService, queryRouter, msgRouter)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	t.Run("unsafe query", func(t *testing.T) {
+		err := routerService.QueryRouterService().CanInvoke(testCtx.Ctx, "/cosmos.counter.v1.QueryGetCountRequest")
+		require.ErrorContains(t, err, "request is not module query safe: cosmos.counter.v1.QueryGetCountRequest")
+
+		err = routerService.QueryRouterService().InvokeTyped(testCtx.Ctx, &countertypes.QueryGetCountRequest{}, &countertypes.QueryGetCountResponse{})
+		require.ErrorContains(t, err, "request is not module query safe")
+	})
+
+	t.Run("module query safe query", func(t *testing.T) {
+		err := routerService.QueryRouterService().CanInvoke(testCtx.Ctx, "/cosmos.bank.v1beta1.QueryBalanceRequest")
+		require.NoError(t, err)
+	})
+}
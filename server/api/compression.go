@@ -0,0 +1,213 @@
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	encodingGzip = "gzip"
+	encodingZstd = "zstd"
+
+	// compressionMinSize is the size under which the responses are not
+	// compressed, the compression overhead outweighing the bandwidth savings.
+	compressionMinSize = 1024
+)
+
+var (
+	gzipWriters = sync.Pool{New: func() interface{} {
+		w, _ := gzip.NewWriterLevel(nil, gzip.BestSpeed)
+		return w
+	}}
+	zstdWriters = sync.Pool{New: func() interface{} {
+		w, _ := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+		return w
+	}}
+)
+
+// compressionHandler compresses the responses of the handler with the zstd or
+// gzip encoding, whichever is preferred by the client in the Accept-Encoding
+// request header, zstd winning ties. The responses smaller than
+// compressionMinSize or already encoded are sent as is.
+func compressionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding, status: http.StatusOK}
+		defer cw.Close()
+
+		h.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding returns the supported encoding with the highest quality
+// value in the Accept-Encoding header, or "" if none is accepted.
+func negotiateEncoding(acceptEncoding string) string {
+	var (
+		best     string
+		bestQVal float64
+	)
+
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != encodingGzip && coding != encodingZstd {
+			continue
+		}
+
+		qVal := 1.0
+		if param, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if qVal, err = strconv.ParseFloat(param, 64); err != nil {
+				continue
+			}
+		}
+
+		if qVal > bestQVal || (qVal > 0 && qVal == bestQVal && coding == encodingZstd) {
+			best, bestQVal = coding, qVal
+		}
+	}
+
+	return best
+}
+
+// compressWriter buffers the response until compressionMinSize bytes are
+// written, then decides whether to compress it.
+type compressWriter struct {
+	http.ResponseWriter
+
+	encoding string
+	status   int
+	buf      []byte
+
+	// started is set once the response header is written, enc being nil if
+	// the response is not compressed.
+	started bool
+	enc     io.WriteCloser
+}
+
+var _ http.Flusher = (*compressWriter)(nil)
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.started {
+		return
+	}
+
+	cw.status = status
+	// the responses without a body are never compressed.
+	if status == http.StatusNoContent || status == http.StatusNotModified || status < http.StatusOK {
+		cw.start(false)
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.started {
+		cw.buf = append(cw.buf, p...)
+		if len(cw.buf) < compressionMinSize {
+			return len(p), nil
+		}
+
+		if err := cw.flushBuffer(true); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+
+	if cw.enc != nil {
+		return cw.enc.Write(p)
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+// Flush sends the buffered response to the client, compressing it if it is
+// not empty.
+func (cw *compressWriter) Flush() {
+	if !cw.started {
+		if err := cw.flushBuffer(len(cw.buf) > 0); err != nil {
+			return
+		}
+	}
+
+	switch enc := cw.enc.(type) {
+	case *gzip.Writer:
+		_ = enc.Flush()
+	case *zstd.Encoder:
+		_ = enc.Flush()
+	}
+
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close sends the rest of the response and returns the encoder to its pool.
+func (cw *compressWriter) Close() error {
+	if !cw.started {
+		return cw.flushBuffer(false)
+	}
+
+	if cw.enc == nil {
+		return nil
+	}
+
+	err := cw.enc.Close()
+	switch enc := cw.enc.(type) {
+	case *gzip.Writer:
+		gzipWriters.Put(enc)
+	case *zstd.Encoder:
+		zstdWriters.Put(enc)
+	}
+	cw.enc = nil
+
+	return err
+}
+
+func (cw *compressWriter) flushBuffer(compress bool) error {
+	cw.start(compress)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+
+	_, err := cw.Write(buf)
+	return err
+}
+
+// start writes the response header, setting up the encoder if compress is
+// true and the response is not already encoded.
+func (cw *compressWriter) start(compress bool) {
+	cw.started = true
+
+	header := cw.Header()
+	if compress && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", cw.encoding)
+		header.Del("Content-Length")
+
+		switch cw.encoding {
+		case encodingGzip:
+			enc := gzipWriters.Get().(*gzip.Writer)
+			enc.Reset(cw.ResponseWriter)
+			cw.enc = enc
+		case encodingZstd:
+			enc := zstdWriters.Get().(*zstd.Encoder)
+			enc.Reset(cw.ResponseWriter)
+			cw.enc = enc
+		}
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.status)
+}
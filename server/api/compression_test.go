@@ -0,0 +1,107 @@
+package api
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	testCases := map[string]string{
+		"":                          "",
+		"identity":                  "",
+		"gzip":                      "gzip",
+		"gzip, deflate, br":         "gzip",
+		"gzip, zstd":                "zstd",
+		"GZIP;q=0.5, zstd;q=0.4":    "gzip",
+		"zstd;q=0, gzip;q=0":        "",
+		"zstd;q=invalid, gzip;q=.1": "gzip",
+	}
+
+	for acceptEncoding, expected := range testCases {
+		require.Equal(t, expected, negotiateEncoding(acceptEncoding), acceptEncoding)
+	}
+}
+
+func TestCompressionHandler(t *testing.T) {
+	large := strings.Repeat("cosmos", compressionMinSize)
+	handler := compressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.URL.Query().Get("body"))
+	}))
+
+	serve := func(acceptEncoding, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/?body="+body, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+		return rec
+	}
+
+	rec := serve("gzip", large)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	r, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	bz, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, large, string(bz))
+
+	rec = serve("gzip, zstd", large)
+	require.Equal(t, "zstd", rec.Header().Get("Content-Encoding"))
+	dec, err := zstd.NewReader(rec.Body)
+	require.NoError(t, err)
+	defer dec.Close()
+	bz, err = io.ReadAll(dec)
+	require.NoError(t, err)
+	require.Equal(t, large, string(bz))
+	require.Less(t, rec.Body.Len(), len(large))
+
+	// the small responses and the responses to clients not accepting a
+	// supported encoding are not compressed.
+	rec = serve("gzip", "small")
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "small", rec.Body.String())
+
+	rec = serve("br", large)
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, large, rec.Body.String())
+}
+
+func TestForwardResponseHeaders(t *testing.T) {
+	ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{
+		HeaderMD: metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "42"),
+	})
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, forwardResponseHeaders(ctx, rec, &txtypes.GetTxsEventResponse{
+		Pagination: &query.PageResponse{NextKey: []byte{0xca, 0xfe}, Total: 7},
+	}))
+	require.Equal(t, "42", rec.Header().Get(BlockHeightHeader))
+	require.Equal(t, "yv4=", rec.Header().Get(PaginationNextKeyHeader))
+	require.Equal(t, "7", rec.Header().Get(PaginationTotalHeader))
+
+	// the last page has no next key, and the total is not always counted.
+	rec = httptest.NewRecorder()
+	require.NoError(t, forwardResponseHeaders(context.Background(), rec, &txtypes.GetTxsEventResponse{
+		Pagination: &query.PageResponse{},
+	}))
+	require.Empty(t, rec.Header())
+
+	rec = httptest.NewRecorder()
+	require.NoError(t, forwardResponseHeaders(context.Background(), rec, &txtypes.SimulateResponse{}))
+	require.Empty(t, rec.Header())
+}
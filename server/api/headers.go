@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck // required by the gRPC-Gateway forward response options
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// PaginationNextKeyHeader is the response header holding the base64
+	// encoded key of the next page of a paginated query, if any.
+	PaginationNextKeyHeader = "X-Cosmos-Pagination-Next-Key"
+
+	// PaginationTotalHeader is the response header holding the total number
+	// of results of a paginated query, when it is counted.
+	PaginationTotalHeader = "X-Cosmos-Pagination-Total"
+
+	// BlockHeightHeader is the response header holding the height at which
+	// the query was executed.
+	BlockHeightHeader = "X-Cosmos-Block-Height"
+)
+
+// paginatedResponse is implemented by the query responses holding a
+// query.PageResponse.
+type paginatedResponse interface {
+	GetPagination() *query.PageResponse
+}
+
+// forwardResponseHeaders sets the pagination hints and the queried height
+// headers of the gRPC-Gateway responses, so that clients can walk through
+// large lists and pin the height of the next queries without parsing the body.
+func forwardResponseHeaders(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if heights := md.HeaderMD.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			w.Header().Set(BlockHeightHeader, heights[0])
+		}
+	}
+
+	res, ok := resp.(paginatedResponse)
+	if !ok {
+		return nil
+	}
+
+	page := res.GetPagination()
+	if page == nil {
+		return nil
+	}
+
+	if len(page.NextKey) > 0 {
+		w.Header().Set(PaginationNextKeyHeader, base64.StdEncoding.EncodeToString(page.NextKey))
+	}
+	if page.Total > 0 {
+		w.Header().Set(PaginationTotalHeader, strconv.FormatUint(page.Total, 10))
+	}
+
+	return nil
+}
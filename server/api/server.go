@@ -83,6 +83,9 @@ func New(clientCtx client.Context, logger log.Logger, grpcSrv *grpc.Server) *Ser
 			// Custom header matcher for mapping request headers to
 			// GRPC metadata
 			runtime.WithIncomingHeaderMatcher(CustomGRPCHeaderMatcher),
+
+			// Pagination hints and queried height response headers
+			runtime.WithForwardResponseOption(forwardResponseHeaders),
 		),
 		GRPCSrv: grpcSrv,
 	}
@@ -121,16 +124,24 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	// Start the API in an external goroutine as Serve is blocking and will return
 	// an error upon failure, which we'll send on the error channel that will be
 	// consumed by the for block below.
-	go func(enableUnsafeCORS bool) {
+	go func(enableUnsafeCORS, enableCompression bool) {
 		s.logger.Info("starting API server...", "address", cfg.API.Address)
 
+		var handler http.Handler = s.Router
+		if enableCompression {
+			handler = compressionHandler(handler)
+		}
+
 		if enableUnsafeCORS {
-			allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(s.Router), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			allowAllCORS := handlers.CORS(
+				handlers.AllowedHeaders([]string{"Content-Type"}),
+				handlers.ExposedHeaders([]string{PaginationNextKeyHeader, PaginationTotalHeader, BlockHeightHeader}),
+			)
+			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(handler), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		} else {
-			errCh <- tmrpcserver.Serve(s.listener, s.Router, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, handler, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		}
-	}(cfg.API.EnableUnsafeCORS)
+	}(cfg.API.EnableUnsafeCORS, cfg.API.EnableCompression)
 
 	// Start a blocking select to wait for an indication to stop the server or that
 	// the server failed to start properly.
@@ -0,0 +1,340 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagReferenceHome     = "reference-home"
+	flagReferenceSnapshot = "reference-snapshot"
+	flagKeys              = "keys"
+	flagMaxKeys           = "max-keys"
+)
+
+// NewAppHashReportCmd creates a command reporting the commit root of each store
+// at a height, optionally compared against a reference node or snapshot.
+func NewAppHashReportCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apphash-report",
+		Short: "Report the commit root of each store at a height, to diagnose an AppHash mismatch",
+		Long: `
+Report the AppHash of the application at a height along with the commit root of
+each of its stores, e.g. when CometBFT reports that the node computed a
+different AppHash than the network.
+
+The commit roots can be compared against the application state of a reference
+node, with '--reference-home' set to the home directory of a stopped node, or
+against a state sync snapshot of the height, with '--reference-snapshot' set to
+the snapshots directory holding it (e.g. <home>/data/snapshots). The stores
+whose commit roots differ are reported and, with '--keys', the keys whose
+values differ.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+
+			referenceHome, _ := cmd.Flags().GetString(flagReferenceHome)
+			referenceSnapshot, _ := cmd.Flags().GetString(flagReferenceSnapshot)
+			if referenceHome != "" && referenceSnapshot != "" {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", flagReferenceHome, flagReferenceSnapshot)
+			}
+
+			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer app.Close()
+
+			rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("expected the commit multi store to be a %T, got %T", rs, app.CommitMultiStore())
+			}
+
+			height, _ := cmd.Flags().GetInt64(flags.FlagHeight)
+			if height <= 0 {
+				height = rs.LastCommitID().Version
+			}
+
+			var reference *rootmulti.Store
+			switch {
+			case referenceHome != "":
+				referenceDB, err := OpenDB(referenceHome, GetAppDBBackend(ctx.Viper))
+				if err != nil {
+					return err
+				}
+				defer referenceDB.Close()
+
+				reference, err = loadReferenceStore(ctx.Logger, rs, referenceDB)
+				if err != nil {
+					return err
+				}
+
+			case referenceSnapshot != "":
+				snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(ctx.Viper), referenceSnapshot)
+				if err != nil {
+					return err
+				}
+				defer snapshotDB.Close()
+
+				snapshotStore, err := snapshots.NewStore(snapshotDB, referenceSnapshot)
+				if err != nil {
+					return err
+				}
+
+				reference, err = restoreReferenceSnapshot(ctx.Logger, rs, snapshotStore, uint64(height))
+				if err != nil {
+					return err
+				}
+			}
+
+			withKeys, _ := cmd.Flags().GetBool(flagKeys)
+			maxKeys, _ := cmd.Flags().GetInt(flagMaxKeys)
+			return writeAppHashReport(cmd.OutOrStdout(), rs, reference, height, withKeys, maxKeys)
+		},
+	}
+
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height to report, defaults to the latest committed height")
+	cmd.Flags().String(flagReferenceHome, "", "Home directory of a reference node to compare the commit roots with")
+	cmd.Flags().String(flagReferenceSnapshot, "", "Snapshots directory holding a state sync snapshot of the height to compare the commit roots with")
+	cmd.Flags().Bool(flagKeys, false, "Report the keys whose values differ in the stores whose commit roots differ")
+	cmd.Flags().Int(flagMaxKeys, 100, "Maximum number of differing keys to report per store")
+	return cmd
+}
+
+// loadReferenceStore loads the application state of a reference node, mounting
+// the same stores as rs.
+func loadReferenceStore(logger log.Logger, rs *rootmulti.Store, db dbm.DB) (*rootmulti.Store, error) {
+	reference := rootmulti.NewStore(db, logger, metrics.NewNoOpMetrics())
+	for name, key := range rs.StoreKeysByName() {
+		reference.MountStoreWithDB(key, rs.GetStoreByName(name).GetStoreType(), nil)
+	}
+	if err := reference.LoadLatestVersion(); err != nil {
+		return nil, fmt.Errorf("failed to load the reference state: %w", err)
+	}
+	return reference, nil
+}
+
+// restoreReferenceSnapshot restores the snapshot of the given height in memory,
+// mounting the same stores as rs.
+func restoreReferenceSnapshot(logger log.Logger, rs *rootmulti.Store, snapshotStore *snapshots.Store, height uint64) (*rootmulti.Store, error) {
+	snapshotList, err := snapshotStore.List()
+	if err != nil {
+		return nil, err
+	}
+	var format uint32
+	for _, snapshot := range snapshotList {
+		if snapshot.Height == height && snapshottypes.IsSupportedFormat(snapshot.Format) {
+			format = snapshot.Format
+			break
+		}
+	}
+
+	snapshot, chunks, err := snapshotStore.Load(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("no snapshot found at height %d with a supported format", height)
+	}
+
+	streamReader, err := snapshots.NewStreamReaderWithFormat(chunks, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	defer streamReader.Close()
+
+	reference, err := loadReferenceStore(logger, rs, dbm.NewMemDB())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reference.Restore(height, snapshot.Format, streamReader); err != nil {
+		return nil, fmt.Errorf("failed to restore the reference snapshot: %w", err)
+	}
+	return reference, nil
+}
+
+// storeRoot is the commit root of a store at a height, in the diagnosed state
+// and in the reference state, if any.
+type storeRoot struct {
+	name          string
+	root          []byte
+	referenceRoot []byte
+}
+
+func (s storeRoot) differs() bool {
+	return !bytes.Equal(s.root, s.referenceRoot)
+}
+
+// compareStoreRoots returns the commit roots of the stores of info, along with
+// the ones of reference when not nil, sorted by store name.
+func compareStoreRoots(info, reference *storetypes.CommitInfo) []storeRoot {
+	roots := make(map[string]*storeRoot)
+	root := func(name string) *storeRoot {
+		if _, ok := roots[name]; !ok {
+			roots[name] = &storeRoot{name: name}
+		}
+		return roots[name]
+	}
+
+	for _, storeInfo := range info.StoreInfos {
+		root(storeInfo.Name).root = storeInfo.CommitId.Hash
+	}
+	if reference != nil {
+		for _, storeInfo := range reference.StoreInfos {
+			root(storeInfo.Name).referenceRoot = storeInfo.CommitId.Hash
+		}
+	}
+
+	res := make([]storeRoot, 0, len(roots))
+	for _, r := range roots {
+		res = append(res, *r)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].name < res[j].name })
+	return res
+}
+
+// keyDiff is a key whose value differs between the diagnosed and reference
+// state, a nil value meaning the key is missing.
+type keyDiff struct {
+	key            []byte
+	value          []byte
+	referenceValue []byte
+}
+
+// diffStoreKeys returns up to maxKeys keys whose values differ between store and
+// reference, in ascending key order, and whether more keys differ.
+func diffStoreKeys(store, reference storetypes.KVStore, maxKeys int) (diffs []keyDiff, truncated bool) {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	refIt := reference.Iterator(nil, nil)
+	defer refIt.Close()
+
+	for it.Valid() || refIt.Valid() {
+		var diff *keyDiff
+		switch {
+		case !refIt.Valid() || (it.Valid() && bytes.Compare(it.Key(), refIt.Key()) < 0):
+			diff = &keyDiff{key: it.Key(), value: it.Value()}
+			it.Next()
+
+		case !it.Valid() || bytes.Compare(it.Key(), refIt.Key()) > 0:
+			diff = &keyDiff{key: refIt.Key(), referenceValue: refIt.Value()}
+			refIt.Next()
+
+		default:
+			if !bytes.Equal(it.Value(), refIt.Value()) {
+				diff = &keyDiff{key: it.Key(), value: it.Value(), referenceValue: refIt.Value()}
+			}
+			it.Next()
+			refIt.Next()
+		}
+
+		if diff == nil {
+			continue
+		}
+		if len(diffs) == maxKeys {
+			return diffs, true
+		}
+		diffs = append(diffs, *diff)
+	}
+
+	return diffs, false
+}
+
+// writeAppHashReport writes the AppHash and store commit roots of rs at height,
+// compared against the ones of reference when not nil.
+func writeAppHashReport(w io.Writer, rs, reference *rootmulti.Store, height int64, withKeys bool, maxKeys int) error {
+	info, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+
+	var referenceInfo *storetypes.CommitInfo
+	if reference != nil {
+		referenceInfo, err = reference.GetCommitInfo(height)
+		if err != nil {
+			return fmt.Errorf("failed to get the reference commit info at height %d: %w", height, err)
+		}
+	}
+
+	fmt.Fprintf(w, "height: %d\n", height)
+	fmt.Fprintf(w, "app hash: %X\n", info.Hash())
+	if referenceInfo != nil {
+		fmt.Fprintf(w, "reference app hash: %X\n", referenceInfo.Hash())
+	}
+
+	var differing []string
+	fmt.Fprintln(w, "stores:")
+	for _, root := range compareStoreRoots(info, referenceInfo) {
+		if referenceInfo == nil || !root.differs() {
+			fmt.Fprintf(w, "  %s: %X\n", root.name, root.root)
+			continue
+		}
+		differing = append(differing, root.name)
+		fmt.Fprintf(w, "  %s: %X != reference %X\n", root.name, root.root, root.referenceRoot)
+	}
+
+	if referenceInfo == nil {
+		return nil
+	}
+	if len(differing) == 0 {
+		fmt.Fprintln(w, "all store commit roots match the reference")
+		return nil
+	}
+	if !withKeys {
+		return nil
+	}
+
+	cms, err := rs.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return err
+	}
+	referenceCMS, err := reference.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return err
+	}
+
+	keys, referenceKeys := rs.StoreKeysByName(), reference.StoreKeysByName()
+	for _, name := range differing {
+		key, ok := keys[name]
+		referenceKey, refOK := referenceKeys[name]
+		if !ok || !refOK {
+			fmt.Fprintf(w, "store %s: not mounted on both sides, skipping key diff\n", name)
+			continue
+		}
+
+		diffs, truncated := diffStoreKeys(cms.GetKVStore(key), referenceCMS.GetKVStore(referenceKey), maxKeys)
+		fmt.Fprintf(w, "store %s: differing keys:\n", name)
+		for _, diff := range diffs {
+			switch {
+			case diff.referenceValue == nil:
+				fmt.Fprintf(w, "  + %X: %X\n", diff.key, diff.value)
+			case diff.value == nil:
+				fmt.Fprintf(w, "  - %X: reference %X\n", diff.key, diff.referenceValue)
+			default:
+				fmt.Fprintf(w, "  ~ %X: %X != reference %X\n", diff.key, diff.value, diff.referenceValue)
+			}
+		}
+		if truncated {
+			fmt.Fprintf(w, "  ... more than %d keys differ\n", maxKeys)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	accKey  = storetypes.NewKVStoreKey("acc")
+	bankKey = storetypes.NewKVStoreKey("bank")
+)
+
+// newCommittedStore returns a store with the acc and bank stores committed at
+// height 1 with the given bank values.
+func newCommittedStore(t *testing.T, bankValues map[string]string) *rootmulti.Store {
+	t.Helper()
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	rs.GetKVStore(accKey).Set([]byte("a"), []byte("1"))
+	for key, value := range bankValues {
+		rs.GetKVStore(bankKey).Set([]byte(key), []byte(value))
+	}
+	rs.Commit()
+	return rs
+}
+
+func TestAppHashReport(t *testing.T) {
+	rs := newCommittedStore(t, map[string]string{"a": "1", "b": "2", "d": "4"})
+	reference := newCommittedStore(t, map[string]string{"a": "1", "b": "3", "c": "3"})
+
+	info, err := rs.GetCommitInfo(1)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeAppHashReport(&buf, rs, nil, 1, false, 0))
+	require.Contains(t, buf.String(), "height: 1\n")
+	require.Contains(t, buf.String(), "app hash: "+bytesHex(info.Hash())+"\n")
+	require.NotContains(t, buf.String(), "reference")
+
+	buf.Reset()
+	require.NoError(t, writeAppHashReport(&buf, rs, reference, 1, true, 10))
+	accRoot, bankRoot := rs.GetCommitKVStore(accKey).LastCommitID().Hash, rs.GetCommitKVStore(bankKey).LastCommitID().Hash
+	referenceBankRoot := reference.GetCommitKVStore(bankKey).LastCommitID().Hash
+	require.Contains(t, buf.String(), "  acc: "+bytesHex(accRoot)+"\n")
+	require.Contains(t, buf.String(), "  bank: "+bytesHex(bankRoot)+" != reference "+bytesHex(referenceBankRoot)+"\n")
+	require.Contains(t, buf.String(), `store bank: differing keys:
+  ~ 62: 32 != reference 33
+  - 63: reference 33
+  + 64: 34
+`)
+
+	buf.Reset()
+	require.NoError(t, writeAppHashReport(&buf, rs, reference, 1, true, 1))
+	require.Contains(t, buf.String(), `store bank: differing keys:
+  ~ 62: 32 != reference 33
+  ... more than 1 keys differ
+`)
+}
+
+func TestAppHashReportReferenceSnapshot(t *testing.T) {
+	rs := newCommittedStore(t, map[string]string{"a": "1"})
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(1, 1), rs, nil, log.NewNopLogger())
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+
+	reference, err := restoreReferenceSnapshot(log.NewNopLogger(), rs, snapshotStore, 1)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeAppHashReport(&buf, rs, reference, 1, true, 10))
+	require.Contains(t, buf.String(), "all store commit roots match the reference\n")
+
+	_, err = restoreReferenceSnapshot(log.NewNopLogger(), rs, snapshotStore, 2)
+	require.ErrorContains(t, err, "no snapshot found at height 2")
+}
+
+func bytesHex(bz []byte) string {
+	return fmt.Sprintf("%X", bz)
+}
@@ -3,10 +3,13 @@ package config
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,6 +49,10 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningStoreOverrides overrides the pruning strategy of specific stores,
+	// in the form {storeKey}:{strategy} or {storeKey}:{keepRecent}:{interval}.
+	PruningStoreOverrides []string `mapstructure:"pruning-store-overrides"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -59,6 +66,11 @@ type BaseConfig struct {
 	// Note: Commitment of state will be attempted on the corresponding block.
 	HaltTime uint64 `mapstructure:"halt-time"`
 
+	// HaltExport makes a node halting per halt-height or halt-time take a state
+	// snapshot of the last committed block before it shuts down, so that
+	// coordinated migrations get a deterministic final state artifact.
+	HaltExport bool `mapstructure:"halt-export"`
+
 	// MinRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that blocks past this offset may be pruned
 	// from CometBFT. It is used as part of the process of determining the
@@ -78,16 +90,35 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize sets the number of entries of the inter-block cache of
+	// each store.
+	InterBlockCacheSize uint `mapstructure:"inter-block-cache-size"`
+
+	// InterBlockCacheStoreSizes overrides the inter-block cache size of specific
+	// stores, in the form {storeKey}:{size}.
+	InterBlockCacheStoreSizes []string `mapstructure:"inter-block-cache-store-sizes"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
 
+	// IndexEventTypes defines the set of event types whose attributes are all
+	// indexed by CometBFT, in addition to IndexEvents and to the attributes
+	// marked to be indexed by the modules emitting them.
+	IndexEventTypes []string `mapstructure:"index-event-types"`
+
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// IAVLLazyLoading enables the fast nodes of IAVL, overriding
+	// IAVLDisableFastNode, and lazily loads the nodes traversed when iterating
+	// over past versions or exporting and importing snapshots instead of
+	// retaining them in the IAVL cache.
+	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -119,6 +150,10 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the CometBFT maximum request body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// EnableCompression defines if the responses should be compressed with the
+	// gzip or zstd encoding negotiated with the Accept-Encoding request header.
+	EnableCompression bool `mapstructure:"enable-compression"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -150,6 +185,13 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotCompression sets the compression algorithm of the state sync
+	// snapshots: zlib, zstd, snappy or none.
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
+
+	// SnapshotChunkSize sets the size in bytes of the state sync snapshot chunks.
+	SnapshotChunkSize uint64 `mapstructure:"snapshot-chunk-size"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -173,6 +215,9 @@ type (
 		Keys          []string `mapstructure:"keys"`
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+		Sinks         []string `mapstructure:"sinks"`
+		FileDir       string   `mapstructure:"file-dir"`
+		GRPCAddress   string   `mapstructure:"grpc-address"`
 	}
 )
 
@@ -181,12 +226,13 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Streaming StreamingConfig  `mapstructure:"streaming"`
-	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Telemetry telemetry.Config        `mapstructure:"telemetry"`
+	Tracing   telemetry.TracingConfig `mapstructure:"tracing"`
+	API       APIConfig               `mapstructure:"api"`
+	GRPC      GRPCConfig              `mapstructure:"grpc"`
+	StateSync StateSyncConfig         `mapstructure:"state-sync"`
+	Streaming StreamingConfig         `mapstructure:"streaming"`
+	Mempool   MempoolConfig           `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -212,22 +258,32 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:              defaultMinGasPrices,
+			QueryGasLimit:             0,
+			InterBlockCache:           true,
+			InterBlockCacheSize:       1000,
+			InterBlockCacheStoreSizes: make([]string, 0),
+			Pruning:                   pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:         "0",
+			PruningInterval:           "0",
+			PruningStoreOverrides:     make([]string, 0),
+			MinRetainBlocks:           0,
+			IndexEvents:               make([]string, 0),
+			IndexEventTypes:           make([]string, 0),
+			IAVLCacheSize:             781250,
+			IAVLDisableFastNode:       false,
+			IAVLLazyLoading:           false,
+			AppDBBackend:              "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		Tracing: telemetry.TracingConfig{
+			Enabled:      false,
+			OTLPEndpoint: "http://localhost:4318",
+			SampleRatio:  1,
+		},
 		API: APIConfig{
 			Enable:             false,
 			Swagger:            false,
@@ -235,6 +291,7 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			EnableCompression:  true,
 		},
 		GRPC: GRPCConfig{
 			Enable:         true,
@@ -243,13 +300,17 @@ func DefaultConfig() *Config {
 			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:    0,
+			SnapshotKeepRecent:  2,
+			SnapshotCompression: snapshottypes.CompressionZlib,
+			SnapshotChunkSize:   10e6,
 		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
+				Sinks:         []string{},
+				FileDir:       "data/streaming",
 			},
 		},
 		Mempool: MempoolConfig{
@@ -277,6 +338,93 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	storePruning, err := ParsePruningStoreOverrides(c.PruningStoreOverrides)
+	if err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+	for name, opts := range storePruning {
+		if opts.GetPruningStrategy() == pruningtypes.PruningEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, name,
+			)
+		}
+	}
+	if _, err := snapshottypes.CompressionFormat(c.StateSync.SnapshotCompression); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+	if c.InterBlockCache && c.InterBlockCacheSize == 0 {
+		return sdkerrors.ErrAppConfig.Wrap("inter-block cache size must be positive")
+	}
+	if _, err := ParseInterBlockCacheStoreSizes(c.InterBlockCacheStoreSizes); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
 
 	return nil
 }
+
+// ParseInterBlockCacheStoreSizes parses inter-block cache size overrides in the
+// form {storeKey}:{size} into a map of store key to cache size.
+func ParseInterBlockCacheStoreSizes(entries []string) (map[string]uint, error) {
+	sizes := make(map[string]uint, len(entries))
+	for _, entry := range entries {
+		name, sizeStr, ok := strings.Cut(entry, ":")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid inter-block cache store size %q, expected {storeKey}:{size}", entry)
+		}
+
+		size, err := strconv.ParseUint(sizeStr, 10, 32)
+		if err != nil || size == 0 {
+			return nil, fmt.Errorf("invalid inter-block cache size for store %s: %q", name, sizeStr)
+		}
+
+		sizes[name] = uint(size)
+	}
+
+	return sizes, nil
+}
+
+// ParsePruningStoreOverrides parses pruning strategy overrides in the form
+// {storeKey}:{strategy}, where strategy is one of default, nothing and
+// everything, or {storeKey}:{keepRecent}:{interval} for custom pruning, into a
+// map of store key to pruning options.
+func ParsePruningStoreOverrides(entries []string) (map[string]pruningtypes.PruningOptions, error) {
+	overrides := make(map[string]pruningtypes.PruningOptions, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid pruning store override %q, expected {storeKey}:{strategy} or {storeKey}:{keepRecent}:{interval}", entry)
+		}
+
+		name := parts[0]
+		if _, ok := overrides[name]; ok {
+			return nil, fmt.Errorf("duplicate pruning store override for store %s", name)
+		}
+
+		if len(parts) == 2 {
+			switch strategy := strings.ToLower(parts[1]); strategy {
+			case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+				overrides[name] = pruningtypes.NewPruningOptionsFromString(strategy)
+			default:
+				return nil, fmt.Errorf("unknown pruning strategy for store %s: %q", name, parts[1])
+			}
+			continue
+		}
+
+		keepRecent, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning keep-recent for store %s: %q", name, parts[1])
+		}
+		interval, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning interval for store %s: %q", name, parts[2])
+		}
+
+		opts := pruningtypes.NewCustomPruningOptions(keepRecent, interval)
+		if err := opts.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid custom pruning options for store %s: %w", name, err)
+		}
+		overrides[name] = opts
+	}
+
+	return overrides, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -51,6 +53,9 @@ func TestStreamingConfig(t *testing.T) {
 				Keys:          []string{"one", "two"},
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
+				Sinks:         []string{"file", "grpc"},
+				FileDir:       "streaming",
+				GRPCAddress:   "localhost:9999",
 			},
 		},
 	}
@@ -69,6 +74,9 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`sinks = ["file", "grpc", ]`,
+		`file-dir = "streaming"`,
+		`grpc-address = "localhost:9999"`,
 	}
 
 	for _, line := range expectedLines {
@@ -149,6 +157,77 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestInterBlockCacheStoreSizesWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.MinGasPrices = "0stake"
+	conf.InterBlockCacheSize = 2000
+	conf.InterBlockCacheStoreSizes = []string{"bank:10000", "staking:5000"}
+
+	err := WriteConfigFile(confFile, conf)
+	require.NoError(t, err)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.NoError(t, cfg.ValidateBasic())
+	require.Equal(t, uint(2000), cfg.InterBlockCacheSize)
+
+	sizes, err := ParseInterBlockCacheStoreSizes(cfg.InterBlockCacheStoreSizes)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint{"bank": 10000, "staking": 5000}, sizes)
+}
+
+func TestParseInterBlockCacheStoreSizes(t *testing.T) {
+	for _, entry := range []string{"bank", ":100", "bank:", "bank:0", "bank:-1", "bank:abc"} {
+		_, err := ParseInterBlockCacheStoreSizes([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
+
+func TestPruningStoreOverridesWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.MinGasPrices = "0stake"
+	conf.PruningStoreOverrides = []string{"staking:nothing", "bank:everything", "acc:1000:10"}
+
+	err := WriteConfigFile(confFile, conf)
+	require.NoError(t, err)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.NoError(t, cfg.ValidateBasic())
+
+	overrides, err := ParsePruningStoreOverrides(cfg.PruningStoreOverrides)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"staking": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		"bank":    pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+		"acc":     pruningtypes.NewCustomPruningOptions(1000, 10),
+	}, overrides)
+
+	// a store pruning everything cannot be snapshotted.
+	cfg.StateSync.SnapshotInterval = 1000
+	require.ErrorContains(t, cfg.ValidateBasic(), "pruning setting of store bank")
+}
+
+func TestParsePruningStoreOverrides(t *testing.T) {
+	for _, entry := range []string{"bank", ":nothing", "bank:", "bank:custom", "bank:100", "bank:100:abc", "bank:1:10", "bank:100:0", "bank:100:10:1"} {
+		_, err := ParsePruningStoreOverrides([]string{entry})
+		require.Error(t, err, entry)
+	}
+
+	_, err := ParsePruningStoreOverrides([]string{"bank:nothing", "bank:everything"})
+	require.ErrorContains(t, err, "duplicate")
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
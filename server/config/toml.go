@@ -35,6 +35,15 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningStoreOverrides overrides the pruning strategy of specific stores, in the form
+# {storeKey}:{strategy} where strategy is default, nothing or everything, or in the form
+# {storeKey}:{keepRecent}:{interval} for custom pruning. Stores that are not listed follow
+# the pruning strategy above.
+#
+# Example, keeping all the staking history while pruning bank aggressively:
+# ["staking:nothing", "bank:everything"]
+pruning-store-overrides = [{{ range .BaseConfig.PruningStoreOverrides }}{{ printf "%q, " . }}{{end}}]
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -48,6 +57,11 @@ halt-height = {{ .BaseConfig.HaltHeight }}
 # Note: Commitment of state will be attempted on the corresponding block.
 halt-time = {{ .BaseConfig.HaltTime }}
 
+# HaltExport makes a node halting per halt-height or halt-time take a state
+# snapshot of the last committed block before it shuts down. The state can also
+# be exported to a genesis file afterwards with the export command.
+halt-export = {{ .BaseConfig.HaltExport }}
+
 # MinRetainBlocks defines the minimum block height offset from the current
 # block being committed, such that all blocks past this offset are pruned
 # from CometBFT. It is used as part of the process of determining the
@@ -67,6 +81,17 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheSize sets the number of entries of the inter-block cache of each store.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+# InterBlockCacheStoreSizes overrides the inter-block cache size of specific stores, in the
+# form {storeKey}:{size}. Hot stores can use a larger cache on validators, while archive nodes
+# may prefer smaller caches. Cache hits and misses are reported per store through telemetry.
+#
+# Example:
+# ["bank:10000", "staking:5000"]
+inter-block-cache-store-sizes = [{{ range .BaseConfig.InterBlockCacheStoreSizes }}{{ printf "%q, " . }}{{end}}]
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs CometBFT what to index. If empty, all events will be indexed.
 #
@@ -74,6 +99,14 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 
+# IndexEventTypes defines the set of event types whose attributes are all indexed by CometBFT.
+# If index-events or index-event-types is set, only the attributes they select and the attributes
+# marked to be indexed by the modules emitting them are indexed, instead of all the events.
+#
+# Example:
+# ["transfer", "coin_spent"]
+index-event-types = [{{ range .BaseConfig.IndexEventTypes }}{{ printf "%q, " . }}{{end}}]
+
 # IavlCacheSize set the size of the iavl tree cache (in number of nodes).
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
@@ -81,6 +114,13 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# IAVLLazyLoading enables the fast node feature of IAVL, overriding iavl-disable-fastnode,
+# and lazily loads from disk the nodes traversed when iterating over past versions,
+# exporting or restoring state sync snapshots, instead of keeping them in the IAVL cache.
+# This reduces the memory used by state sync restores at the cost of more disk reads.
+# Default is false.
+iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -132,6 +172,26 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+###############################################################################
+###                          Tracing Configuration                          ###
+###############################################################################
+
+[tracing]
+
+# Enabled enables the tracing of the execution of blocks, txs and msgs with
+# OpenTelemetry.
+enabled = {{ .Tracing.Enabled }}
+
+# ServiceName is the name of the service reported with the spans.
+service-name = "{{ .Tracing.ServiceName }}"
+
+# OTLPEndpoint is the base URL of the OTLP/HTTP collector the spans are
+# exported to.
+otlp-endpoint = "{{ .Tracing.OTLPEndpoint }}"
+
+# SampleRatio is the ratio of the traces which are recorded, between 0 and 1.
+sample-ratio = {{ .Tracing.SampleRatio }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -162,6 +222,10 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# EnableCompression defines if the responses should be compressed with the gzip or zstd
+# encoding negotiated with the Accept-Encoding request header.
+enable-compression = {{ .API.EnableCompression }}
+
 ###############################################################################
 ###                           gRPC Configuration                            ###
 ###############################################################################
@@ -197,6 +261,17 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-compression specifies the compression algorithm of the snapshots: zlib, zstd, snappy
+# or none. zstd and snappy are faster to create and restore than zlib, and zstd yields smaller
+# snapshots. Nodes can only restore snapshots with a compression supported by their binary, and
+# the snapshots of a height are only identical across nodes using the same compression.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
+# snapshot-chunk-size specifies the size in bytes of the snapshot chunks transferred by state
+# sync. A chunk must fit in a CometBFT state sync message, and the snapshots of a height are
+# only identical across nodes using the same chunk size.
+snapshot-chunk-size = {{ .StateSync.SnapshotChunkSize }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -209,9 +284,12 @@ snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
 # List of kv store keys to stream out via gRPC.
 # The store key names MUST match the module's StoreKey name.
+# A store key can be followed by a hex-encoded key prefix, in the form {storeKey}:{hexPrefix},
+# to stream out the changes of the keys with the prefix only.
 #
 # Example:
 # ["acc", "bank", "gov", "staking", "mint"[,...]]
+# ["bank:02"] to expose the bank balances only.
 # ["*"] to expose all keys.
 keys = [{{ range .Streaming.ABCI.Keys }}{{ printf "%q, " . }}{{end}}]
 
@@ -223,6 +301,21 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# The built-in sinks to stream to, alongside the plugin if any.
+# Supported sinks: file, grpc, and the sinks registered by the app
+# (e.g. a Kafka publisher).
+#
+# Example:
+# ["file", "grpc"]
+sinks = [{{ range .Streaming.ABCI.Sinks }}{{ printf "%q, " . }}{{end}}]
+
+# The directory the file sink writes the data of each block to, relative to
+# the node home directory if not absolute.
+file-dir = "{{ .Streaming.ABCI.FileDir }}"
+
+# The address of the ABCIListenerService gRPC server the grpc sink streams to.
+grpc-address = "{{ .Streaming.ABCI.GRPCAddress }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
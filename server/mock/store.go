@@ -54,6 +54,10 @@ func (ms multiStore) AddListeners(keys []storetypes.StoreKey) {
 	panic("not implemented")
 }
 
+func (ms multiStore) AddPrefixListeners(key storetypes.StoreKey, prefixes [][]byte) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetMetrics(metrics.StoreMetrics) {
 	panic("not implemented")
 }
@@ -134,6 +138,10 @@ func (ms multiStore) SetInterBlockCache(_ storetypes.MultiStorePersistentCache)
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStorePruning(storeName string, opts pruningtypes.PruningOptions) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetIAVLCacheSize(size int) {
 	panic("not implemented")
 }
@@ -142,6 +150,10 @@ func (ms multiStore) SetIAVLDisableFastNode(disable bool) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetIAVLLazyLoading(lazyLoading bool) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
@@ -8,6 +8,7 @@ import (
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -37,3 +38,9 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// GetStorePruningOptionsFromFlags parses the pruning strategy overrides of
+// specific stores from the command flags and returns them by store key.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	return config.ParsePruningStoreOverrides(cast.ToStringSlice(appOpts.Get(FlagPruningStoreOverrides)))
+}
@@ -9,45 +9,83 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one height.
+// FlagRollbackNumBlocks is the flag for the number of heights to rollback.
+const FlagRollbackNumBlocks = "num-blocks"
+
+// NewRollbackCmd creates a command to rollback CometBFT and multistore state by one or more heights.
 func NewRollbackCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		numBlocks   int64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
 progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting CometBFT the transactions in block n will be re-executed against the
-application.
+The application also rolls back to height n - 1. If the --hard flag is not used,
+no blocks are removed, so upon restarting CometBFT the transactions in block n will
+be re-executed against the application. Using --hard also removes block n.
+
+With --hard, the state can be rolled back by several heights at once with --num-blocks,
+provided the application state at the target height has not been pruned.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
 
+			if numBlocks < 1 {
+				return fmt.Errorf("invalid number of blocks to rollback: %d", numBlocks)
+			}
+			if numBlocks > 1 && !removeBlock {
+				return fmt.Errorf("rolling back more than one height requires the --hard flag")
+			}
+
 			db, err := OpenDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
 			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
-			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(ctx.Config, removeBlock)
-			if err != nil {
-				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+
+			// check that the multistore can be rolled back before touching the CometBFT state
+			cms := app.CommitMultiStore()
+			target := cms.LatestVersion() - numBlocks
+			if target <= 0 {
+				return fmt.Errorf("cannot rollback %d heights from height %d", numBlocks, cms.LatestVersion())
+			}
+			if _, err := cms.CacheMultiStoreWithVersion(target); err != nil {
+				return fmt.Errorf("cannot rollback to height %d, the application state may have been pruned: %w", target, err)
+			}
+
+			// rollback CometBFT state, one height at a time
+			var (
+				height int64
+				hash   []byte
+			)
+			for i := int64(0); i < numBlocks; i++ {
+				height, hash, err = cmtcmd.RollbackState(ctx.Config, removeBlock)
+				if err != nil {
+					return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+				}
 			}
-			// rollback the multistore
 
-			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+			// rollback the multistore
+			if err := cms.RollbackToVersion(height); err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
-			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			if removeBlock {
+				fmt.Printf("Rolled back both state and block to height %d and hash %X\n", height, hash)
+			} else {
+				fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().Int64Var(&numBlocks, FlagRollbackNumBlocks, 1, "number of heights to rollback, more than one requires --hard")
 	return cmd
 }
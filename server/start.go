@@ -38,6 +38,7 @@ import (
 
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -54,33 +55,42 @@ import (
 
 const (
 	// CometBFT full-node start flags
-	flagWithComet          = "with-comet"
-	flagAddress            = "address"
-	flagTransport          = "transport"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagQueryGasLimit      = "query-gas-limit"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
-
-	FlagPruning             = "pruning"
-	FlagPruningKeepRecent   = "pruning-keep-recent"
-	FlagPruningInterval     = "pruning-interval"
-	FlagIndexEvents         = "index-events"
-	FlagMinRetainBlocks     = "min-retain-blocks"
-	FlagIAVLCacheSize       = "iavl-cache-size"
-	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
-	FlagShutdownGrace       = "shutdown-grace"
+	flagWithComet                 = "with-comet"
+	flagAddress                   = "address"
+	flagTransport                 = "transport"
+	flagTraceStore                = "trace-store"
+	flagCPUProfile                = "cpu-profile"
+	FlagMinGasPrices              = "minimum-gas-prices"
+	FlagQueryGasLimit             = "query-gas-limit"
+	FlagHaltHeight                = "halt-height"
+	FlagHaltTime                  = "halt-time"
+	FlagHaltExport                = "halt-export"
+	FlagInterBlockCache           = "inter-block-cache"
+	FlagInterBlockCacheSize       = "inter-block-cache-size"
+	FlagInterBlockCacheStoreSizes = "inter-block-cache-store-sizes"
+	FlagUnsafeSkipUpgrades        = "unsafe-skip-upgrades"
+	FlagUnsafeUpgradeTimeWarp     = "unsafe-upgrade-time-warp"
+	FlagTrace                     = "trace"
+	FlagInvCheckPeriod            = "inv-check-period"
+
+	FlagPruning               = "pruning"
+	FlagPruningKeepRecent     = "pruning-keep-recent"
+	FlagPruningInterval       = "pruning-interval"
+	FlagPruningStoreOverrides = "pruning-store-overrides"
+	FlagIndexEvents           = "index-events"
+	FlagIndexEventTypes       = "index-event-types"
+	FlagMinRetainBlocks       = "min-retain-blocks"
+	FlagIAVLCacheSize         = "iavl-cache-size"
+	FlagDisableIAVLFastNode   = "iavl-disable-fastnode"
+	FlagIAVLLazyLoading       = "iavl-lazy-loading"
+	FlagShutdownGrace         = "shutdown-grace"
 
 	// state sync-related flags
 
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotCompression = "state-sync.snapshot-compression"
+	FlagStateSyncSnapshotChunkSize   = "state-sync.snapshot-chunk-size"
 
 	// api-related flags
 
@@ -92,10 +102,12 @@ const (
 	FlagRPCWriteTimeout       = "api.rpc-write-timeout"
 	FlagRPCMaxBodyBytes       = "api.rpc-max-body-bytes"
 	FlagAPIEnableUnsafeCORS   = "api.enabled-unsafe-cors"
+	FlagAPIEnableCompression  = "api.enable-compression"
 
 	// gRPC-related flags
 
 	flagGRPCOnly    = "grpc-only"
+	FlagMaintenance = "maintenance"
 	flagGRPCEnable  = "grpc.enable"
 	flagGRPCAddress = "grpc.address"
 
@@ -166,7 +178,9 @@ Node halting configurations exist in the form of two flags: '--halt-height' and
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
-will not be able to commit subsequent blocks.
+will not be able to commit subsequent blocks. With '--halt-export', the node takes a state snapshot of
+the last committed block before shutting down, the state can also be exported to a genesis file
+afterwards with 'export --height <last committed height>'.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
@@ -175,6 +189,11 @@ The node may be started in a 'query only' mode where only the gRPC and JSON HTTP
 API services are enabled via the 'grpc-only' flag. In this mode, CometBFT is
 bypassed and can be used when legacy queries are needed after an on-chain upgrade
 is performed. Note, when enabled, gRPC will also be automatically enabled.
+
+The node may also be started in 'maintenance' mode via the '--maintenance' flag, e.g. to drain
+or debug a node. As in 'query only' mode, CometBFT is bypassed and the gRPC and JSON HTTP API
+services serve queries at the latest committed height, but the application also refuses to check
+transactions and finalize blocks.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -230,6 +249,16 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 		return err
 	}
 
+	stopTracing, err := telemetry.NewTracing(svrCfg.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stopTracing(context.Background()); err != nil {
+			svrCtx.Logger.Error("failed to stop tracing", "err", err)
+		}
+	}()
+
 	emitServerInfoMetrics()
 
 	if !withCmt {
@@ -288,6 +317,11 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		}
 	}
 
+	if svrCtx.Viper.GetBool(FlagMaintenance) {
+		svrCtx.Logger.Info("starting node in maintenance mode; the ABCI server is disabled", "height", app.CommitMultiStore().LastCommitID().Version)
+		return g.Wait()
+	}
+
 	g.Go(func() error {
 		if err := svr.Start(); err != nil {
 			svrCtx.Logger.Error("failed to start out-of-process ABCI server", "err", err)
@@ -312,11 +346,17 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 
 	g, ctx := getCtx(svrCtx, true)
 
-	if gRPCOnly {
+	switch {
+	case gRPCOnly:
 		// TODO: Generalize logic so that gRPC only is really in startStandAlone
 		svrCtx.Logger.Info("starting node in gRPC only mode; CometBFT is disabled")
 		svrCfg.GRPC.Enable = true
-	} else {
+
+	case svrCtx.Viper.GetBool(FlagMaintenance):
+		svrCtx.Logger.Info("starting node in maintenance mode; CometBFT is disabled", "height", app.CommitMultiStore().LastCommitID().Version)
+		svrCfg.GRPC.Enable = true
+
+	default:
 		svrCtx.Logger.Info("starting node with ABCI CometBFT in-process")
 		tmNode, cleanupFn, err := startCmtNode(ctx, cmtCfg, app, svrCtx)
 		if err != nil {
@@ -415,7 +455,7 @@ func getAndValidateConfig(svrCtx *Context) (serverconfig.Config, error) {
 // getGenDocProvider returns a function which returns the genesis doc from the genesis file.
 func getGenDocProvider(cfg *cmtcfg.Config) func() (node.ChecksummedGenesisDoc, error) {
 	return func() (node.ChecksummedGenesisDoc, error) {
-		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		appGenesis, err := genutiltypes.AppGenesisFromFileOrStream(cfg.GenesisFile())
 		if err != nil {
 			return node.ChecksummedGenesisDoc{
 				Sha256Checksum: []byte{},
@@ -984,14 +1024,19 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
+	cmd.Flags().Bool(FlagUnsafeUpgradeTimeWarp, false, "Reschedule the pending upgrade at the next block to rehearse it on a local single-node chain (development only)")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Bool(FlagHaltExport, false, "Take a state snapshot of the last committed block when halting per halt-height or halt-time")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint(FlagInterBlockCacheSize, 1000, "Number of entries of the inter-block cache of each store")
+	cmd.Flags().StringSlice(FlagInterBlockCacheStoreSizes, []string{}, "Inter-block cache size overrides per store, in the form {storeKey}:{size}")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().StringSlice(FlagPruningStoreOverrides, []string{}, "Pruning strategy overrides per store, in the form {storeKey}:{strategy} or {storeKey}:{keepRecent}:{interval}")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -1002,12 +1047,17 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Uint(FlagRPCWriteTimeout, 0, "Define the CometBFT RPC write timeout (in seconds)")
 	cmd.Flags().Uint(FlagRPCMaxBodyBytes, 1000000, "Define the CometBFT maximum request body (in bytes)")
 	cmd.Flags().Bool(FlagAPIEnableUnsafeCORS, false, "Define if CORS should be enabled (unsafe - use it at your own risk)")
+	cmd.Flags().Bool(FlagAPIEnableCompression, true, "Define if the API responses should be compressed with the encoding negotiated with the client")
+	cmd.Flags().Bool(FlagMaintenance, false, "Start the node in maintenance mode, serving queries at the latest committed height while refusing to process txs and blocks (CometBFT is bypassed)")
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no CometBFT process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().String(FlagStateSyncSnapshotCompression, snapshottypes.CompressionZlib, "State sync snapshot compression algorithm (zlib|zstd|snappy|none)")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotChunkSize, 10e6, "State sync snapshot chunk size in bytes")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagIAVLLazyLoading, false, "Enable fast node for IAVL tree and lazily load the nodes traversed by whole-tree iterations, e.g. on state sync restore")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
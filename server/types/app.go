@@ -9,6 +9,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/grpc"
 
+	"cosmossdk.io/core/genesis"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
@@ -72,6 +73,11 @@ type (
 	ExportedApp struct {
 		// AppState is the application state as JSON.
 		AppState json.RawMessage
+		// StreamAppState is set instead of AppState by the apps supporting
+		// streaming exports, when the export command is run with the streaming
+		// flag. It writes the application state to the genesis stream, see
+		// module.Manager.ExportGenesisStream.
+		StreamAppState func(w genesis.StreamWriter) error
 		// Validators is the exported validator set.
 		Validators []cmttypes.GenesisValidator
 		// Height is the app's latest block height.
@@ -26,6 +26,7 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	storecache "cosmossdk.io/store/cache"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -347,6 +348,7 @@ func AddCommands[T types.Application](rootCmd *cobra.Command, appCreator types.A
 		cometCmd,
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator),
+		NewAppHashReportCmd(appCreator),
 	)
 }
 
@@ -486,7 +488,17 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache storetypes.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		storeSizes, err := config.ParseInterBlockCacheStoreSizes(cast.ToStringSlice(appOpts.Get(FlagInterBlockCacheStoreSizes)))
+		if err != nil {
+			panic(err)
+		}
+
+		cacheSize := cast.ToUint(appOpts.Get(FlagInterBlockCacheSize))
+		if cacheSize == 0 {
+			cacheSize = storecache.DefaultCommitKVStoreCacheSize
+		}
+
+		cache = store.NewCommitKVStoreCacheManagerWithStoreSizes(cacheSize, storeSizes)
 	}
 
 	pruningOpts, err := GetPruningOptionsFromFlags(appOpts)
@@ -494,6 +506,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	storePruningOpts, err := GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -519,6 +536,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Compression = cast.ToString(appOpts.Get(FlagStateSyncSnapshotCompression))
+	snapshotOptions.ChunkSize = cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotChunkSize))
+	if _, err := snapshottypes.CompressionFormat(snapshotOptions.Compression); err != nil {
+		panic(err)
+	}
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
@@ -531,16 +553,21 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStorePruning(storePruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
+		baseapp.SetHaltSnapshot(cast.ToBool(appOpts.Get(FlagHaltExport))),
+		baseapp.SetMaintenance(cast.ToBool(appOpts.Get(FlagMaintenance))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
+		baseapp.SetIndexEventTypes(cast.ToStringSlice(appOpts.Get(FlagIndexEventTypes))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
+		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
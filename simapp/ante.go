@@ -21,6 +21,17 @@ type HandlerOptions struct {
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	anteDecorators, err := NewAnteDecorators(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// NewAnteDecorators returns the decorators chained by the AnteHandler returned
+// by NewAnteHandler.
+func NewAnteDecorators(options HandlerOptions) ([]sdk.AnteDecorator, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.New("account keeper is required for ante builder")
 	}
@@ -44,12 +55,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateBasicDecorator(options.Environment),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, options.TxManager, options.Environment),
+		ante.NewValidateMsgCountDecorator(options.AccountKeeper),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewValidateSignersDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper, ante.WithAuthenticationHandlers(options.AuthenticationHandlers)),
+		ante.NewAccountRateLimitDecorator(options.AccountKeeper),
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return anteDecorators, nil
 }
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cast"
@@ -127,10 +128,12 @@ var (
 		pooltypes.ModuleName:           nil,
 		pooltypes.StreamAccount:        nil,
 		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ReserveName:          {authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		banktypes.ModuleName:           nil,
 	}
 )
 
@@ -370,11 +373,25 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// register the sources composed with the balances by the bank TotalAccountValue query
+	app.BankKeeper.RegisterAccountValueSource("bonded", app.StakingKeeper.GetDelegatorBondedCoins)
+	app.BankKeeper.RegisterAccountValueSource("unbonding", app.StakingKeeper.GetDelegatorUnbondingCoins)
+	app.BankKeeper.RegisterAccountValueSource("rewards", app.DistrKeeper.GetDelegatorUnclaimedRewards)
+
 	app.CircuitKeeper = circuitkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[circuittypes.StoreKey]), logger.With(log.ModuleKey, "x/circuit")), appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), logger.With(log.ModuleKey, "x/authz"), runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())), appCodec, app.AuthKeeper)
 
+	// register the account hooks
+	// NOTE: the account keeper is shared by reference, so the hooks also apply to the copies held by other keepers
+	app.AuthKeeper.SetHooks(
+		authtypes.NewMultiAccountHooks(
+			app.AuthzKeeper.Hooks(),
+			app.FeeGrantKeeper.Hooks(),
+		),
+	)
+
 	groupConfig := group.DefaultConfig()
 	/*
 		Example of group params:
@@ -393,6 +410,8 @@ func NewSimApp(
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), logger.With(log.ModuleKey, "x/upgrade"), runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())), skipUpgradeHeights, appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the upgrade time warp is a development facility, see the --unsafe-upgrade-time-warp flag
+	app.UpgradeKeeper.SetTimeWarp(cast.ToBool(appOpts.Get(server.FlagUnsafeUpgradeTimeWarp)))
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -415,11 +434,11 @@ func NewSimApp(
 		),
 	)
 
-	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), logger.With(log.ModuleKey, "x/nft")), appCodec, app.AuthKeeper, app.BankKeeper)
+	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), logger.With(log.ModuleKey, "x/nft")), appCodec, app.AuthKeeper, app.BankKeeper, nftkeeper.DefaultConfig())
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), logger.With(log.ModuleKey, "x/evidence"), runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())), app.StakingKeeper, app.SlashingKeeper, app.AuthKeeper.AddressCodec(),
+		appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), logger.With(log.ModuleKey, "x/evidence"), runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())), app.StakingKeeper, app.SlashingKeeper, app.PoolKeeper, app.AuthKeeper.AddressCodec(), authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -483,6 +502,7 @@ func NewSimApp(
 		epochstypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
+		banktypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -514,6 +534,10 @@ func NewSimApp(
 		panic(err)
 	}
 
+	app.ModuleManager.RegisterRecoveryHandlers(app)
+	app.ModuleManager.RegisterBlockSummaryMetrics(app)
+	app.ModuleManager.RegisterCustomQueryRoutes(app)
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
 	app.RegisterUpgradeHandlers()
@@ -607,7 +631,7 @@ func NewSimApp(
 }
 
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
-	anteHandler, err := NewAnteHandler(
+	anteDecorators, err := NewAnteDecorators(
 		HandlerOptions{
 			ante.HandlerOptions{
 				Environment:              runtime.NewEnvironment(nil, app.logger, runtime.EnvWithRouterService(app.GRPCQueryRouter(), app.MsgServiceRouter())), // nil is set as the kvstoreservice to avoid module access
@@ -627,12 +651,16 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 	}
 
 	// Set the AnteHandler for the app
-	app.SetAnteHandler(anteHandler)
+	app.SetAnteDecorators(anteDecorators...)
 }
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			// Setting MaxFeeRefundRatio refunds the fee paid for unused gas,
+			// up to that ratio of the fee.
+			BankKeeper: app.BankKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -671,12 +699,24 @@ func (a *SimApp) Configurator() module.Configurator { // nolint:staticcheck // S
 
 // InitChainer application update at chain initialization
 func (app *SimApp) InitChainer(ctx sdk.Context, req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
-	var genesisState GenesisState
-	err := json.Unmarshal(req.AppStateBytes, &genesisState)
+	err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
 	if err != nil {
 		return nil, err
 	}
-	err = app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
+
+	// the genesis state of a node started from a genesis stream is read from the stream
+	if path, ok := genutiltypes.GenesisStreamPath(req.AppStateBytes); ok {
+		stream, err := genutiltypes.OpenGenesisStream(path)
+		if err != nil {
+			return nil, err
+		}
+		defer stream.Close()
+
+		return app.ModuleManager.InitGenesisStream(ctx, stream)
+	}
+
+	var genesisState GenesisState
+	err = json.Unmarshal(req.AppStateBytes, &genesisState)
 	if err != nil {
 		return nil, err
 	}
@@ -788,7 +828,16 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 }
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(
+		clientCtx,
+		app.GRPCQueryRouter(),
+		cfg,
+		nodeservice.WithAppVersion(app.AppVersion),
+		nodeservice.WithModuleVersions(app.ModuleManager.GetVersionMap()),
+		nodeservice.WithSnapshotManager(app.SnapshotManager()),
+		nodeservice.WithAnteHandlerDescription(app.AnteHandlerDescription),
+		nodeservice.WithNodeKeyFile(cmtcfg.DefaultConfig().SetRoot(clientCtx.HomeDir).NodeKeyFile()),
+	)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
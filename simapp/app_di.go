@@ -207,6 +207,11 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// register the sources composed with the balances by the bank TotalAccountValue query
+	app.BankKeeper.RegisterAccountValueSource("bonded", app.StakingKeeper.GetDelegatorBondedCoins)
+	app.BankKeeper.RegisterAccountValueSource("unbonding", app.StakingKeeper.GetDelegatorUnbondingCoins)
+	app.BankKeeper.RegisterAccountValueSource("rewards", app.DistrKeeper.GetDelegatorUnclaimedRewards)
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
@@ -309,7 +314,7 @@ func NewSimApp(
 // overwrite default ante handlers with custom ante handlers
 // set SkipAnteHandler to true in app config and set custom ante handler on baseapp
 func (app *SimApp) setCustomAnteHandler() {
-	anteHandler, err := NewAnteHandler(
+	anteDecorators, err := NewAnteDecorators(
 		HandlerOptions{
 			ante.HandlerOptions{
 				AccountKeeper:   app.AuthKeeper,
@@ -328,7 +333,7 @@ func (app *SimApp) setCustomAnteHandler() {
 	}
 
 	// Set the AnteHandler for the app
-	app.SetAnteHandler(anteHandler)
+	app.SetAnteDecorators(anteDecorators...)
 }
 
 // Close implements the Application interface and closes all necessary application
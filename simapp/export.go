@@ -8,6 +8,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/genesis"
 	storetypes "cosmossdk.io/store/types"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 	"cosmossdk.io/x/staking"
@@ -20,15 +21,9 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
-	// as if they could withdraw from the start of the next block
-	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
-
-	// We export at last height + 1, because that's the height at which
-	// CometBFT will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	ctx, exported, err := app.exportValidators(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
 	}
 
 	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, modulesToExport)
@@ -36,14 +31,46 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 		return servertypes.ExportedApp{}, err
 	}
 
-	appState, err := json.MarshalIndent(genState, "", "  ")
+	exported.AppState, err = json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return exported, nil
+}
+
+// StreamAppStateAndValidators exports the state of the application for a
+// genesis stream, the state of the modules is written incrementally to the
+// stream by the StreamAppState function of the exported app.
+func (app *SimApp) StreamAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	ctx, exported, err := app.exportValidators(forZeroHeight, jailAllowedAddrs)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
 
+	exported.StreamAppState = func(w genesis.StreamWriter) error {
+		return app.ModuleManager.ExportGenesisStream(ctx, modulesToExport, w)
+	}
+
+	return exported, nil
+}
+
+// exportValidators returns the context the state of the application is
+// exported from, and the exported app without its state.
+func (app *SimApp) exportValidators(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, servertypes.ExportedApp, error) {
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// CometBFT will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
-	return servertypes.ExportedApp{
-		AppState:        appState,
+	return ctx, servertypes.ExportedApp{
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -82,6 +83,7 @@ func queryCommand() *cobra.Command {
 
 	cmd.AddCommand(
 		rpc.WaitTxCmd(),
+		rpc.NodeHealthCmd(),
 		server.QueryBlockCmd(),
 		authcmd.QueryTxsByEventsCmd(),
 		server.QueryBlocksCmd(),
@@ -169,6 +171,10 @@ func appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, appOpts)
 	}
 
+	if cast.ToBool(appOpts.Get(genutilcli.FlagStreaming)) {
+		return simApp.StreamAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	}
+
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
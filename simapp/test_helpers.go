@@ -233,6 +233,7 @@ func NewTestNetworkFixture() network.TestFixture {
 			bam.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
 			bam.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
 			bam.SetChainID(val.GetCtx().Viper.GetString(flags.FlagChainID)),
+			network.SnapshotOption(val),
 		)
 	}
 
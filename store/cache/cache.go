@@ -3,6 +3,7 @@ package cache
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-metrics"
 	lru "github.com/hashicorp/golang-lru"
 
 	"cosmossdk.io/store/cachekv"
@@ -27,7 +28,8 @@ type (
 	// CommitKVStore and below is completely irrelevant to this layer.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+		cache  *lru.ARCCache
+		labels []metrics.Label
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -35,8 +37,9 @@ type (
 	// in an inter-block (persistent) manner and typically provided by a
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
-		cacheSize uint
-		caches    map[string]types.CommitKVStore
+		cacheSize  uint
+		storeSizes map[string]uint
+		caches     map[string]types.CommitKVStore
 	}
 )
 
@@ -52,19 +55,46 @@ func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreC
 	}
 }
 
+// newNamedCommitKVStoreCache returns a CommitKVStoreCache whose hit and miss
+// metrics are labeled with the given store name.
+func newNamedCommitKVStoreCache(store types.CommitKVStore, name string, size uint) *CommitKVStoreCache {
+	ckv := NewCommitKVStoreCache(store, size)
+	ckv.labels = []metrics.Label{{Name: "store", Value: name}}
+
+	return ckv
+}
+
 func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
+	return NewCommitKVStoreCacheManagerWithStoreSizes(size, nil)
+}
+
+// NewCommitKVStoreCacheManagerWithStoreSizes returns a CommitKVStoreCacheManager
+// whose caches hold size entries, except for the stores listed in storeSizes,
+// keyed by store name, which use their own cache size.
+func NewCommitKVStoreCacheManagerWithStoreSizes(size uint, storeSizes map[string]uint) *CommitKVStoreCacheManager {
 	return &CommitKVStoreCacheManager{
-		cacheSize: size,
-		caches:    make(map[string]types.CommitKVStore),
+		cacheSize:  size,
+		storeSizes: storeSizes,
+		caches:     make(map[string]types.CommitKVStore),
 	}
 }
 
+// CacheSize returns the size of the cache used for the store with the given
+// name.
+func (cmgr *CommitKVStoreCacheManager) CacheSize(name string) uint {
+	if size, ok := cmgr.storeSizes[name]; ok {
+		return size
+	}
+
+	return cmgr.cacheSize
+}
+
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cmgr.caches[key.Name()] = newNamedCommitKVStoreCache(store, key.Name(), cmgr.CacheSize(key.Name()))
 	}
 
 	return cmgr.caches[key.Name()]
@@ -104,10 +134,12 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	valueI, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
+		metrics.IncrCounterWithLabels([]string{"store", "inter_block_cache", "hit"}, 1, ckv.labels)
 		return valueI.([]byte)
 	}
 
 	// cache miss; write to cache
+	metrics.IncrCounterWithLabels([]string{"store", "inter_block_cache", "miss"}, 1, ckv.labels)
 	value := ckv.CommitKVStore.Get(key)
 	ckv.cache.Add(keyStr, value)
 
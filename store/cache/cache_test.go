@@ -42,6 +42,18 @@ func TestUnwrap(t *testing.T) {
 	require.Nil(t, mngr.Unwrap(types.NewKVStoreKey("test2")))
 }
 
+func TestStoreCacheSizes(t *testing.T) {
+	mngr := cache.NewCommitKVStoreCacheManagerWithStoreSizes(cache.DefaultCommitKVStoreCacheSize, map[string]uint{"bank": 5000})
+
+	require.Equal(t, uint(5000), mngr.CacheSize("bank"))
+	require.Equal(t, cache.DefaultCommitKVStoreCacheSize, mngr.CacheSize("staking"))
+
+	db := wrapper.NewDBWrapper(dbm.NewMemDB())
+	tree := iavl.NewMutableTree(db, 100, false, log.NewNopLogger())
+	store := iavlstore.UnsafeNewStore(tree)
+	require.NotNil(t, mngr.GetStoreCache(types.NewKVStoreKey("bank"), store))
+}
+
 func TestStoreCache(t *testing.T) {
 	db := wrapper.NewDBWrapper(dbm.NewMemDB())
 	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
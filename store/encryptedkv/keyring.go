@@ -0,0 +1,108 @@
+package encryptedkv
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Key is an AES key of 16, 24 or 32 bytes, identified by its ID. The ID of the
+// key encrypting a value is stored along with the value, so that the values
+// encrypted with the previous keys of a Keyring can still be decrypted.
+type Key struct {
+	ID     uint32 `json:"id"`
+	Secret []byte `json:"secret"`
+}
+
+// NewKey returns a new random 32 bytes key with the given ID.
+func NewKey(id uint32) (Key, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Key{}, err
+	}
+
+	return Key{ID: id, Secret: secret}, nil
+}
+
+// Keyring holds the node-local keys of the encrypted stores. The values are
+// encrypted with the key with the highest ID, the current key, and decrypted
+// with the key they were encrypted with. Keys are rotated by adding a key with
+// a higher ID, see Store.Reencrypt.
+type Keyring struct {
+	keys    []Key
+	current uint32
+	aeads   map[uint32]cipher.AEAD
+}
+
+// NewKeyring returns a Keyring holding the given keys, at least one key is
+// required.
+func NewKeyring(keys ...Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+
+	kr := &Keyring{aeads: make(map[uint32]cipher.AEAD, len(keys))}
+	for _, key := range keys {
+		if _, ok := kr.aeads[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key ID %d", key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d: %w", key.ID, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d: %w", key.ID, err)
+		}
+
+		kr.aeads[key.ID] = aead
+		kr.keys = append(kr.keys, key)
+		if len(kr.keys) == 1 || key.ID > kr.current {
+			kr.current = key.ID
+		}
+	}
+
+	return kr, nil
+}
+
+// CurrentKeyID returns the ID of the key the values are encrypted with.
+func (kr *Keyring) CurrentKeyID() uint32 {
+	return kr.current
+}
+
+// keyringFile is the JSON encoding of a Keyring file.
+type keyringFile struct {
+	Keys []Key `json:"keys"`
+}
+
+// LoadKeyring reads the Keyring from the JSON file at the given path, e.g. in
+// the config directory of the node.
+func LoadKeyring(path string) (*Keyring, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var file keyringFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("failed to read keyring %s: %w", path, err)
+	}
+
+	return NewKeyring(file.Keys...)
+}
+
+// Save writes the Keyring to a JSON file at the given path, readable by its
+// owner only.
+func (kr *Keyring) Save(path string) error {
+	bz, err := json.MarshalIndent(keyringFile{Keys: kr.keys}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
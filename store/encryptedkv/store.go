@@ -0,0 +1,215 @@
+package encryptedkv
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// valueVersion is the first byte of the encrypted values, the version of their
+// encoding: the ID of the key as a big endian uint32, the nonce and the
+// AES-GCM sealed value.
+const valueVersion byte = 1
+
+const headerLen = 1 + 4
+
+// reencryptBatchSize is the number of values re-encrypted per iteration of the
+// store by Reencrypt.
+const reencryptBatchSize = 1000
+
+// Store encrypts the values of an underlying KVStore with the current key of a
+// Keyring, keys are stored in clear so that the store can still be iterated.
+// Each value is bound to its key: a value moved to another key fails to be
+// decrypted.
+//
+// AES-GCM uses random nonces, so the values written to the underlying store are
+// not deterministic: only the stores holding node-local data, which are not part
+// of the consensus state, can be encrypted. The values written before the
+// encryption of a store was enabled cannot be read.
+type Store struct {
+	parent  types.KVStore
+	keyring *Keyring
+}
+
+// NewStore returns a reference to a new encrypted store.
+func NewStore(parent types.KVStore, keyring *Keyring) *Store {
+	return &Store{parent: parent, keyring: keyring}
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements KVStore, it panics if the value cannot be decrypted.
+func (s *Store) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+	if value == nil {
+		return nil
+	}
+
+	return s.mustDecrypt(key, value)
+}
+
+// Set implements KVStore.
+func (s *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	s.parent.Set(key, s.encrypt(key, value))
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Delete implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return &iterator{Iterator: s.parent.Iterator(start, end), store: s}
+}
+
+// ReverseIterator implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return &iterator{Iterator: s.parent.ReverseIterator(start, end), store: s}
+}
+
+// CacheWrap implements KVStore.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Reencrypt encrypts the values of the store which were encrypted with a
+// previous key of the Keyring with its current key, and returns the number of
+// values re-encrypted. The previous keys can be removed from the Keyring once
+// the values they encrypted have been re-encrypted.
+func (s *Store) Reencrypt() (int, error) {
+	var (
+		count int
+		start []byte
+	)
+	for {
+		var keys, values [][]byte
+		it := s.parent.Iterator(start, nil)
+		for ; it.Valid() && len(keys) < reencryptBatchSize; it.Next() {
+			key, value := it.Key(), it.Value()
+			keyID, err := valueKeyID(value)
+			if err != nil {
+				it.Close()
+				return count, fmt.Errorf("key %X: %w", key, err)
+			}
+			if keyID == s.keyring.current {
+				continue
+			}
+
+			plain, err := s.decrypt(key, value)
+			if err != nil {
+				it.Close()
+				return count, fmt.Errorf("key %X: %w", key, err)
+			}
+			keys = append(keys, bytes.Clone(key))
+			values = append(values, plain)
+		}
+		done := !it.Valid()
+		if !done {
+			start = bytes.Clone(it.Key())
+		}
+		if err := it.Close(); err != nil {
+			return count, err
+		}
+
+		// the values are written once the iterator is closed, as the
+		// underlying store may not support writes while iterating.
+		for i, key := range keys {
+			s.parent.Set(key, s.encrypt(key, values[i]))
+		}
+		count += len(keys)
+
+		if done {
+			return count, nil
+		}
+	}
+}
+
+func (s *Store) encrypt(key, value []byte) []byte {
+	aead := s.keyring.aeads[s.keyring.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(fmt.Errorf("failed to generate a nonce: %w", err))
+	}
+
+	out := make([]byte, headerLen, headerLen+len(nonce)+len(value)+aead.Overhead())
+	out[0] = valueVersion
+	binary.BigEndian.PutUint32(out[1:headerLen], s.keyring.current)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, value, key)
+}
+
+func (s *Store) decrypt(key, value []byte) ([]byte, error) {
+	keyID, err := valueKeyID(value)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, ok := s.keyring.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %d", keyID)
+	}
+
+	value = value[headerLen:]
+	if len(value) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted value too short")
+	}
+
+	return aead.Open(nil, value[:aead.NonceSize()], value[aead.NonceSize():], key)
+}
+
+func (s *Store) mustDecrypt(key, value []byte) []byte {
+	plain, err := s.decrypt(key, value)
+	if err != nil {
+		panic(fmt.Errorf("failed to decrypt the value of key %X: %w", key, err))
+	}
+
+	// the value of an empty slice is decrypted as nil.
+	if plain == nil {
+		return []byte{}
+	}
+
+	return plain
+}
+
+func valueKeyID(value []byte) (uint32, error) {
+	if len(value) < headerLen || value[0] != valueVersion {
+		return 0, fmt.Errorf("invalid encrypted value")
+	}
+
+	return binary.BigEndian.Uint32(value[1:headerLen]), nil
+}
+
+// iterator decrypts the values of the underlying iterator.
+type iterator struct {
+	types.Iterator
+	store *Store
+}
+
+// Value implements Iterator, it panics if the value cannot be decrypted.
+func (it *iterator) Value() []byte {
+	return it.store.mustDecrypt(it.Key(), it.Iterator.Value())
+}
@@ -0,0 +1,142 @@
+package encryptedkv_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/encryptedkv"
+	"cosmossdk.io/store/types"
+)
+
+func newKeyring(t *testing.T, ids ...uint32) *encryptedkv.Keyring {
+	t.Helper()
+
+	keys := make([]encryptedkv.Key, len(ids))
+	for i, id := range ids {
+		var err error
+		keys[i], err = encryptedkv.NewKey(id)
+		require.NoError(t, err)
+	}
+
+	keyring, err := encryptedkv.NewKeyring(keys...)
+	require.NoError(t, err)
+	return keyring
+}
+
+func TestStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	st := encryptedkv.NewStore(parent, newKeyring(t, 1))
+
+	require.Equal(t, types.StoreTypeDB, st.GetStoreType())
+	require.Panics(t, func() { st.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Panics(t, func() { st.Set([]byte("key"), nil) }, "setting a nil value should panic")
+
+	st.Set([]byte("key1"), []byte("value1"))
+	st.Set([]byte("key2"), []byte("value2"))
+	st.Set([]byte("key3"), []byte{})
+
+	// the values are encrypted in the parent store, not the keys.
+	require.True(t, parent.Has([]byte("key1")))
+	require.NotContains(t, string(parent.Get([]byte("key1"))), "value1")
+	require.Equal(t, []byte("value1"), st.Get([]byte("key1")))
+	require.Equal(t, []byte{}, st.Get([]byte("key3")))
+	require.Nil(t, st.Get([]byte("key4")))
+	require.True(t, st.Has([]byte("key2")))
+
+	it := st.ReverseIterator(nil, []byte("key3"))
+	require.Equal(t, []byte("key2"), it.Key())
+	require.Equal(t, []byte("value2"), it.Value())
+	it.Next()
+	require.Equal(t, []byte("value1"), it.Value())
+	it.Next()
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	st.Delete([]byte("key2"))
+	require.False(t, parent.Has([]byte("key2")))
+
+	// the values are bound to their key.
+	parent.Set([]byte("key2"), parent.Get([]byte("key1")))
+	require.Panics(t, func() { st.Get([]byte("key2")) })
+
+	// the values cannot be decrypted with another keyring.
+	other := encryptedkv.NewStore(parent, newKeyring(t, 1))
+	require.Panics(t, func() { other.Get([]byte("key1")) })
+
+	// the writes to a branch of the store are encrypted.
+	cache := st.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key5"), []byte("value5"))
+	cache.Write()
+	require.Equal(t, []byte("value5"), st.Get([]byte("key5")))
+	require.NotEqual(t, []byte("value5"), parent.Get([]byte("key5")))
+}
+
+func TestStoreReencrypt(t *testing.T) {
+	key1, err := encryptedkv.NewKey(1)
+	require.NoError(t, err)
+	key2, err := encryptedkv.NewKey(2)
+	require.NoError(t, err)
+
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	keyring, err := encryptedkv.NewKeyring(key1)
+	require.NoError(t, err)
+	st := encryptedkv.NewStore(parent, keyring)
+	for i := 0; i < 2500; i++ {
+		st.Set([]byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("value%05d", i)))
+	}
+
+	// rotate the key, the values encrypted with the previous key can be read.
+	keyring, err = encryptedkv.NewKeyring(key2, key1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), keyring.CurrentKeyID())
+	st = encryptedkv.NewStore(parent, keyring)
+	st.Set([]byte("key00000"), []byte("new"))
+	require.Equal(t, []byte("value00001"), st.Get([]byte("key00001")))
+
+	count, err := st.Reencrypt()
+	require.NoError(t, err)
+	require.Equal(t, 2499, count)
+
+	// the previous key can be removed once the values are re-encrypted.
+	keyring, err = encryptedkv.NewKeyring(key2)
+	require.NoError(t, err)
+	st = encryptedkv.NewStore(parent, keyring)
+	require.Equal(t, []byte("new"), st.Get([]byte("key00000")))
+	require.Equal(t, []byte("value02499"), st.Get([]byte("key02499")))
+
+	count, err = st.Reencrypt()
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestKeyring(t *testing.T) {
+	_, err := encryptedkv.NewKeyring()
+	require.ErrorContains(t, err, "at least one key is required")
+
+	_, err = encryptedkv.NewKeyring(encryptedkv.Key{ID: 1, Secret: []byte("short")})
+	require.ErrorContains(t, err, "invalid key 1")
+
+	key, err := encryptedkv.NewKey(1)
+	require.NoError(t, err)
+	_, err = encryptedkv.NewKeyring(key, key)
+	require.ErrorContains(t, err, "duplicate key ID 1")
+
+	keyring := newKeyring(t, 3, 7, 5)
+	require.Equal(t, uint32(7), keyring.CurrentKeyID())
+
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	require.NoError(t, keyring.Save(path))
+	loaded, err := encryptedkv.LoadKeyring(path)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), loaded.CurrentKeyID())
+
+	// the values encrypted with a keyring are decrypted with the loaded one.
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	encryptedkv.NewStore(parent, keyring).Set([]byte("key"), []byte("value"))
+	require.Equal(t, []byte("value"), encryptedkv.NewStore(parent, loaded).Get([]byte("key")))
+}
@@ -38,6 +38,11 @@ type Store struct {
 	tree    Tree
 	logger  log.Logger
 	metrics metrics.StoreMetrics
+
+	// lazyDB is the database of the tree when the nodes traversed to iterate
+	// over past versions, export or import the tree are loaded lazily from it,
+	// see LoadStoreWithLazyLoading.
+	lazyDB dbm.DB
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -84,6 +89,24 @@ func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKe
 	}, nil
 }
 
+// LoadStoreWithLazyLoading returns an IAVL Store as a CommitKVStore like
+// LoadStoreWithInitialVersion, with the fast nodes enabled, so that the
+// iterators over the latest version read the leaves from the fast index instead
+// of traversing the tree. The nodes traversed to iterate over the past
+// versions, to export the tree in snapshots or to import it from snapshots are
+// loaded from the DB when they are visited and are not retained in the node
+// cache, which bounds the memory used by whole-tree traversals, e.g. when
+// restoring a state sync snapshot, at the cost of more DB reads.
+func LoadStoreWithLazyLoading(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, initialVersion uint64, cacheSize int, metrics metrics.StoreMetrics) (types.CommitKVStore, error) {
+	store, err := LoadStoreWithInitialVersion(db, logger, key, id, initialVersion, cacheSize, false, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	store.(*Store).lazyDB = db
+	return store, nil
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
@@ -107,7 +130,15 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 		return nil, errors.New("version mismatch on immutable IAVL tree; version does not exist. Version has either been pruned, or is for a future block height")
 	}
 
-	iTree, err := st.tree.GetImmutable(version)
+	var (
+		iTree *iavl.ImmutableTree
+		err   error
+	)
+	if st.lazyDB != nil {
+		iTree, err = st.uncachedTree().GetImmutable(version)
+	} else {
+		iTree, err = st.tree.GetImmutable(version)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -279,9 +310,23 @@ func (st *Store) Import(version int64) (*iavl.Importer, error) {
 	if !ok {
 		return nil, errors.New("iavl import failed: unable to find mutable tree")
 	}
+	if st.lazyDB != nil {
+		// The importer loads the imported version in the tree once committed,
+		// upgrading its fast index by traversing the whole tree: the store uses
+		// the uncached tree until it is reloaded, which the multistore does
+		// after restoring a snapshot.
+		tree = st.uncachedTree()
+		st.tree = tree
+	}
 	return tree.Import(version)
 }
 
+// uncachedTree returns a new tree over the database of the store whose nodes
+// are loaded lazily without being cached.
+func (st *Store) uncachedTree() *iavl.MutableTree {
+	return iavl.NewMutableTree(wrapper.NewDBWrapper(st.lazyDB), 0, false, st.logger)
+}
+
 // Handle gatest the latest height, if height is 0
 func getHeight(tree Tree, req *types.RequestQuery) int64 {
 	height := req.Height
@@ -712,3 +712,57 @@ func TestChangeSets(t *testing.T) {
 	restoreCommitID := iavlStore.LastCommitID()
 	require.Equal(t, commitID, restoreCommitID)
 }
+
+func TestLazyLoading(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := types.NewKVStoreKey("test")
+	store, err := LoadStoreWithLazyLoading(db, log.NewNopLogger(), key, types.CommitID{}, 0, cacheSize, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	iavlStore := store.(*Store)
+
+	for i := 0; i < 100; i++ {
+		iavlStore.Set([]byte(fmt.Sprintf("key%02d", i)), []byte("v1"))
+	}
+	iavlStore.Commit()
+	iavlStore.Set([]byte("key00"), []byte("v2"))
+	cID := iavlStore.Commit()
+
+	// the past versions are iterated over lazily.
+	past, err := iavlStore.GetImmutable(1)
+	require.NoError(t, err)
+	iter := past.Iterator(nil, nil)
+	n := 0
+	for ; iter.Valid(); iter.Next() {
+		require.Equal(t, []byte("v1"), iter.Value())
+		n++
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, 100, n)
+
+	// the tree is exported and imported lazily, and the store is reloaded
+	// after the import.
+	exporter, err := iavlStore.Export(cID.Version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	importDB := dbm.NewMemDB()
+	store, err = LoadStoreWithLazyLoading(importDB, log.NewNopLogger(), key, types.CommitID{}, 0, cacheSize, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	importer, err := store.(*Store).Import(cID.Version)
+	require.NoError(t, err)
+	for {
+		node, err := exporter.Next()
+		if err == iavl.ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(node))
+	}
+	require.NoError(t, importer.Commit())
+
+	store, err = LoadStoreWithLazyLoading(importDB, log.NewNopLogger(), key, cID, 0, cacheSize, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	require.Equal(t, cID, store.LastCommitID())
+	require.Equal(t, []byte("v2"), store.(*Store).Get([]byte("key00")))
+	require.Equal(t, []byte("v1"), store.(*Store).Get([]byte("key99")))
+}
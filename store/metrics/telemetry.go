@@ -9,6 +9,22 @@ import (
 // StoreMetrics defines the set of metrics for the store package
 type StoreMetrics interface {
 	MeasureSince(keys ...string)
+	// RecordCommit records the statistics of the commit of the named store.
+	RecordCommit(storeName string, stats CommitStats)
+}
+
+// CommitStats are the statistics of the commit of a store.
+type CommitStats struct {
+	// Duration is the time spent committing the store.
+	Duration time.Duration
+	// KeysWritten is the number of keys written to the database, i.e. the
+	// nodes of an IAVL store, along with its fast nodes and metadata.
+	KeysWritten uint64
+	// KeysDeleted is the number of keys deleted from the database.
+	KeysDeleted uint64
+	// BytesWritten is the size of the keys and values written to the
+	// database, and of the keys deleted from it.
+	BytesWritten uint64
 }
 
 var (
@@ -44,6 +60,16 @@ func (m Metrics) MeasureSince(keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// RecordCommit emits the statistics of the commit of a store, labeled with the
+// store name along with the global labels (if any).
+func (m Metrics) RecordCommit(storeName string, stats CommitStats) {
+	labels := append([]metrics.Label{{Name: "store", Value: storeName}}, m.Labels...)
+	metrics.AddSampleWithLabels([]string{"store", "commit", "time"}, float32(stats.Duration.Nanoseconds())/float32(time.Millisecond), labels)
+	metrics.IncrCounterWithLabels([]string{"store", "commit", "keys_written"}, float32(stats.KeysWritten), labels)
+	metrics.IncrCounterWithLabels([]string{"store", "commit", "keys_deleted"}, float32(stats.KeysDeleted), labels)
+	metrics.IncrCounterWithLabels([]string{"store", "commit", "bytes_written"}, float32(stats.BytesWritten), labels)
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -54,3 +80,6 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(keys ...string) {}
+
+// RecordCommit is a no-op implementation of the StoreMetrics interface.
+func (m NoOpMetrics) RecordCommit(string, CommitStats) {}
@@ -16,9 +16,11 @@ import (
 // determining when to prune old heights of the store
 // based on the strategy described by the pruning options.
 type Manager struct {
-	db               dbm.DB
-	logger           log.Logger
-	opts             types.PruningOptions
+	db     dbm.DB
+	logger log.Logger
+	opts   types.PruningOptions
+	// storeOpts overrides the pruning strategy of specific stores, by store name.
+	storeOpts        map[string]types.PruningOptions
 	snapshotInterval uint64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleSnapshotHeight.
@@ -65,12 +67,44 @@ func (m *Manager) GetOptions() types.PruningOptions {
 	return m.opts
 }
 
+// SetStoreOptions overrides the pruning strategy of the store of the given name,
+// e.g. to keep all the heights of a store while pruning the others.
+func (m *Manager) SetStoreOptions(storeName string, opts types.PruningOptions) {
+	if m.storeOpts == nil {
+		m.storeOpts = make(map[string]types.PruningOptions)
+	}
+	m.storeOpts[storeName] = opts
+}
+
+// GetStoreOptions fetches the pruning strategy of the store of the given name,
+// which is the strategy of the manager unless it is overridden.
+func (m *Manager) GetStoreOptions(storeName string) types.PruningOptions {
+	if opts, ok := m.storeOpts[storeName]; ok {
+		return opts
+	}
+	return m.opts
+}
+
+// prunesNothing returns true if neither the strategy of the manager nor the
+// strategies of the stores prune any height.
+func (m *Manager) prunesNothing() bool {
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		return false
+	}
+	for _, opts := range m.storeOpts {
+		if opts.GetPruningStrategy() != types.PruningNothing {
+			return false
+		}
+	}
+	return true
+}
+
 // HandleSnapshotHeight persists the snapshot height to be pruned at the next appropriate
 // height defined by the pruning strategy. It flushes the update to disk and panics if the flush fails.
 // The input height must be greater than 0, and the pruning strategy must not be set to pruning nothing.
 // If either of these conditions is not met, this function does nothing.
 func (m *Manager) HandleSnapshotHeight(height int64) {
-	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
+	if m.prunesNothing() || height <= 0 {
 		return
 	}
 
@@ -101,19 +135,29 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 
 // GetPruningHeight returns the height which can prune up to if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	return m.getPruningHeight(m.opts, height)
+}
+
+// GetStorePruningHeight is like GetPruningHeight for the store of the given
+// name, following the pruning strategy of the store.
+func (m *Manager) GetStorePruningHeight(storeName string, height int64) int64 {
+	return m.getPruningHeight(m.GetStoreOptions(storeName), height)
+}
+
+func (m *Manager) getPruningHeight(opts types.PruningOptions, height int64) int64 {
+	if opts.GetPruningStrategy() == types.PruningNothing {
 		return 0
 	}
-	if m.opts.Interval <= 0 {
+	if opts.Interval <= 0 {
 		return 0
 	}
 
-	if height%int64(m.opts.Interval) != 0 || height <= int64(m.opts.KeepRecent) {
+	if height%int64(opts.Interval) != 0 || height <= int64(opts.KeepRecent) {
 		return 0
 	}
 
 	// Consider the snapshot height
-	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	pruneHeight := height - 1 - int64(opts.KeepRecent) // we should keep the current height at least
 
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
@@ -138,7 +182,7 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	if m.prunesNothing() {
 		return nil
 	}
 
@@ -196,6 +196,33 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestStorePruningHeight(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(100, 10))
+	manager.SetStoreOptions("staking", types.NewPruningOptions(types.PruningNothing))
+	manager.SetStoreOptions("bank", types.NewPruningOptions(types.PruningEverything))
+
+	require.Equal(t, types.NewCustomPruningOptions(100, 10), manager.GetStoreOptions("acc"))
+	require.Equal(t, types.NewPruningOptions(types.PruningNothing), manager.GetStoreOptions("staking"))
+
+	require.Equal(t, int64(99), manager.GetPruningHeight(200))
+	require.Equal(t, int64(99), manager.GetStorePruningHeight("acc", 200))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("staking", 200))
+	require.Equal(t, int64(197), manager.GetStorePruningHeight("bank", 200))
+}
+
+func TestHandleSnapshotHeight_StoreOverride(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetSnapshotInterval(10)
+	manager.SetStoreOptions("bank", types.NewPruningOptions(types.PruningEverything))
+
+	// the snapshot heights are tracked as soon as one of the stores is pruned.
+	require.Equal(t, int64(9), manager.GetStorePruningHeight("bank", 20))
+	manager.HandleSnapshotHeight(10)
+	require.Equal(t, int64(17), manager.GetStorePruningHeight("bank", 20))
+	require.Equal(t, int64(0), manager.GetPruningHeight(20))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
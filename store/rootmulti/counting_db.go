@@ -0,0 +1,134 @@
+package rootmulti
+
+import (
+	"sync/atomic"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/metrics"
+)
+
+// countingDB counts the keys and bytes written to the database of a store, the
+// writes of a batch are counted when the batch is written. The counters may be
+// updated concurrently, e.g. by the asynchronous pruning of an IAVL store.
+type countingDB struct {
+	dbm.DB
+
+	keysWritten  atomic.Uint64
+	keysDeleted  atomic.Uint64
+	bytesWritten atomic.Uint64
+}
+
+func newCountingDB(db dbm.DB) *countingDB {
+	return &countingDB{DB: db}
+}
+
+// stats returns the counters of the writes, with no duration.
+func (db *countingDB) stats() metrics.CommitStats {
+	return metrics.CommitStats{
+		KeysWritten:  db.keysWritten.Load(),
+		KeysDeleted:  db.keysDeleted.Load(),
+		BytesWritten: db.bytesWritten.Load(),
+	}
+}
+
+func (db *countingDB) countSet(key, value []byte) {
+	db.keysWritten.Add(1)
+	db.bytesWritten.Add(uint64(len(key) + len(value)))
+}
+
+func (db *countingDB) countDelete(key []byte) {
+	db.keysDeleted.Add(1)
+	db.bytesWritten.Add(uint64(len(key)))
+}
+
+func (db *countingDB) Set(key, value []byte) error {
+	if err := db.DB.Set(key, value); err != nil {
+		return err
+	}
+	db.countSet(key, value)
+	return nil
+}
+
+func (db *countingDB) SetSync(key, value []byte) error {
+	if err := db.DB.SetSync(key, value); err != nil {
+		return err
+	}
+	db.countSet(key, value)
+	return nil
+}
+
+func (db *countingDB) Delete(key []byte) error {
+	if err := db.DB.Delete(key); err != nil {
+		return err
+	}
+	db.countDelete(key)
+	return nil
+}
+
+func (db *countingDB) DeleteSync(key []byte) error {
+	if err := db.DB.DeleteSync(key); err != nil {
+		return err
+	}
+	db.countDelete(key)
+	return nil
+}
+
+func (db *countingDB) NewBatch() dbm.Batch {
+	return &countingBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+func (db *countingDB) NewBatchWithSize(size int) dbm.Batch {
+	return &countingBatch{Batch: db.DB.NewBatchWithSize(size), db: db}
+}
+
+// countingBatch counts the writes of a batch of a countingDB.
+type countingBatch struct {
+	dbm.Batch
+	db *countingDB
+
+	keysWritten  uint64
+	keysDeleted  uint64
+	bytesWritten uint64
+}
+
+func (b *countingBatch) Set(key, value []byte) error {
+	if err := b.Batch.Set(key, value); err != nil {
+		return err
+	}
+	b.keysWritten++
+	b.bytesWritten += uint64(len(key) + len(value))
+	return nil
+}
+
+func (b *countingBatch) Delete(key []byte) error {
+	if err := b.Batch.Delete(key); err != nil {
+		return err
+	}
+	b.keysDeleted++
+	b.bytesWritten += uint64(len(key))
+	return nil
+}
+
+func (b *countingBatch) Write() error {
+	if err := b.Batch.Write(); err != nil {
+		return err
+	}
+	b.flush()
+	return nil
+}
+
+func (b *countingBatch) WriteSync() error {
+	if err := b.Batch.WriteSync(); err != nil {
+		return err
+	}
+	b.flush()
+	return nil
+}
+
+func (b *countingBatch) flush() {
+	b.db.keysWritten.Add(b.keysWritten)
+	b.db.keysDeleted.Add(b.keysDeleted)
+	b.db.bytesWritten.Add(b.bytesWritten)
+	b.keysWritten, b.keysDeleted, b.bytesWritten = 0, 0, 0
+}
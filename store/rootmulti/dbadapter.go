@@ -1,7 +1,6 @@
 package rootmulti
 
 import (
-	"cosmossdk.io/store/dbadapter"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/types"
 )
@@ -17,9 +16,10 @@ var (
 // commitDBStoreWrapper should only be used for simulation/debugging,
 // as it doesn't compute any commit hash, and it cannot load older state.
 
-// Wrapper type for dbm.Db with implementation of KVStore
+// Wrapper type for dbm.Db with implementation of KVStore, the dbadapter.Store
+// may be wrapped by an encryptedkv.Store.
 type commitDBStoreAdapter struct {
-	dbadapter.Store
+	types.KVStore
 }
 
 func (cdsa commitDBStoreAdapter) Commit() types.CommitID {
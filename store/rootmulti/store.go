@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
@@ -20,6 +21,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/encryptedkv"
 	"cosmossdk.io/store/iavl"
 	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/mem"
@@ -62,6 +64,7 @@ type Store struct {
 	pruningManager      *pruning.Manager
 	iavlCacheSize       int
 	iavlDisableFastNode bool
+	iavlLazyLoading     bool
 	storesParams        map[types.StoreKey]storeParams
 	stores              map[types.StoreKey]types.CommitKVStore
 	keysByName          map[string]types.StoreKey
@@ -72,6 +75,8 @@ type Store struct {
 	traceContextMutex   sync.Mutex
 	interBlockCache     types.MultiStorePersistentCache
 	listeners           map[types.StoreKey]*types.MemoryListener
+	encryptedStores     map[types.StoreKey]*encryptedkv.Keyring
+	storeDBs            map[types.StoreKey]*countingDB
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
 }
@@ -95,6 +100,8 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		stores:              make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:          make(map[string]types.StoreKey),
 		listeners:           make(map[types.StoreKey]*types.MemoryListener),
+		encryptedStores:     make(map[types.StoreKey]*encryptedkv.Keyring),
+		storeDBs:            make(map[types.StoreKey]*countingDB),
 		removalMap:          make(map[types.StoreKey]bool),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
@@ -113,6 +120,17 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetStorePruning overrides the pruning strategy of the sub-store of the given
+// name, e.g. to keep all the heights of a store while pruning the others.
+func (rs *Store) SetStorePruning(storeName string, pruningOpts pruningtypes.PruningOptions) {
+	rs.pruningManager.SetStoreOptions(storeName, pruningOpts)
+}
+
+// GetStorePruning fetches the pruning strategy of the sub-store of the given name.
+func (rs *Store) GetStorePruning(storeName string) pruningtypes.PruningOptions {
+	return rs.pruningManager.GetStoreOptions(storeName)
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -132,6 +150,40 @@ func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
 
+// SetIAVLLazyLoading enables the fast nodes of the IAVL stores and the lazy
+// loading of the nodes traversed by their whole-tree iterations, see
+// iavl.LoadStoreWithLazyLoading. It overrides SetIAVLDisableFastNode.
+func (rs *Store) SetIAVLLazyLoading(lazyLoading bool) {
+	rs.iavlLazyLoading = lazyLoading
+}
+
+// SetStoreEncryption encrypts the values of the given stores with the keys of
+// the node-local keyring, see encryptedkv.Store. Only the stores of type
+// StoreTypeDB, which are not part of the consensus state, can be encrypted. It
+// must be called before the stores are loaded.
+func (rs *Store) SetStoreEncryption(keyring *encryptedkv.Keyring, keys ...types.StoreKey) {
+	for _, key := range keys {
+		rs.encryptedStores[key] = keyring
+	}
+}
+
+// ReencryptStore encrypts the values of the encrypted store which were
+// encrypted with a previous key of its keyring with its current key, and
+// returns the number of values re-encrypted, see encryptedkv.Store.Reencrypt.
+func (rs *Store) ReencryptStore(key types.StoreKey) (int, error) {
+	store, ok := rs.stores[key].(commitDBStoreAdapter)
+	if !ok {
+		return 0, fmt.Errorf("store %s is not encrypted", key.Name())
+	}
+
+	encrypted, ok := store.KVStore.(*encryptedkv.Store)
+	if !ok {
+		return 0, fmt.Errorf("store %s is not encrypted", key.Name())
+	}
+
+	return encrypted.Reencrypt()
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -401,10 +453,26 @@ func (rs *Store) AddListeners(keys []types.StoreKey) {
 		listener := rs.listeners[keys[i]]
 		if listener == nil {
 			rs.listeners[keys[i]] = types.NewMemoryListener()
+			continue
 		}
+		// the empty prefix extends a prefix listener to all the keys.
+		listener.AddPrefixes([]byte{})
 	}
 }
 
+// AddPrefixListeners adds a listener for the writes of the keys with one of the
+// given prefixes in the KVStore belonging to the provided StoreKey. The writes
+// of the other keys are filtered out before being accumulated, unless a
+// listener was added for the whole KVStore.
+func (rs *Store) AddPrefixListeners(key types.StoreKey, prefixes [][]byte) {
+	listener := rs.listeners[key]
+	if listener == nil {
+		rs.listeners[key] = types.NewPrefixMemoryListener(prefixes...)
+		return
+	}
+	listener.AddPrefixes(prefixes...)
+}
+
 // ListeningEnabled returns if listening is enabled for a specific KVStore
 func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 	if ls, ok := rs.listeners[key]; ok {
@@ -479,7 +547,7 @@ func (rs *Store) Commit() types.CommitID {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
-	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap, rs.commitStore)
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -489,6 +557,7 @@ func (rs *Store) Commit() types.CommitID {
 			delete(rs.stores, sk)
 			delete(rs.storesParams, sk)
 			delete(rs.keysByName, sk.Name())
+			delete(rs.storeDBs, sk)
 		}
 	}
 
@@ -664,10 +733,11 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 }
 
 func (rs *Store) handlePruning(version int64) error {
-	pruneHeight := rs.pruningManager.GetPruningHeight(version)
 	rs.logger.Debug("prune start", "height", version)
 	defer rs.logger.Debug("prune end", "height", version)
-	return rs.PruneStores(pruneHeight)
+	return rs.pruneStores(func(key types.StoreKey) int64 {
+		return rs.pruningManager.GetStorePruningHeight(key.Name(), version)
+	})
 }
 
 // PruneStores prunes all history up to the specific height of the multi store.
@@ -677,20 +747,29 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 		return nil
 	}
 
-	rs.logger.Debug("pruning store", "heights", pruningHeight)
+	return rs.pruneStores(func(types.StoreKey) int64 { return pruningHeight })
+}
 
+// pruneStores prunes the history of each IAVL store up to the height returned
+// by pruningHeight for its key.
+func (rs *Store) pruneStores(pruningHeight func(key types.StoreKey) int64) error {
 	for key, store := range rs.stores {
-		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
-
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
 		if store.GetStoreType() != types.StoreTypeIAVL {
 			continue
 		}
 
+		height := pruningHeight(key)
+		if height <= 0 {
+			continue
+		}
+
+		rs.logger.Debug("pruning store", "key", key, "heights", height) // Also log store.name (a private variable)?
+
 		store = rs.GetCommitKVStore(key)
 
-		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
+		err := store.(*iavl.Store).DeleteVersionsTo(height)
 		if err == nil {
 			continue
 		}
@@ -722,6 +801,13 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
 // TODO: add proof for `multistore -> substore`.
 func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
+	return rs.query(req, nil)
+}
+
+// query queries a store of the multistore. The commit infos loaded to build the
+// proofs are cached in commitInfos, if not nil, so that a batch of queries at
+// the same height loads it once.
+func (rs *Store) query(req *types.RequestQuery, commitInfos map[int64]*types.CommitInfo) (*types.ResponseQuery, error) {
 	path := req.Path
 	storeName, subpath, err := parsePath(path)
 	if err != nil {
@@ -753,16 +839,20 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 	// If the request's height is the latest height we've committed, then utilize
 	// the store's lastCommitInfo as this commit info may not be flushed to disk.
 	// Otherwise, we query for the commit info from disk.
-	var commitInfo *types.CommitInfo
-
-	if res.Height == rs.lastCommitInfo.Version {
+	commitInfo, ok := commitInfos[res.Height]
+	switch {
+	case ok:
+	case res.Height == rs.lastCommitInfo.Version:
 		commitInfo = rs.lastCommitInfo
-	} else {
+	default:
 		commitInfo, err = rs.GetCommitInfo(res.Height)
 		if err != nil {
 			return &types.ResponseQuery{}, err
 		}
 	}
+	if commitInfos != nil {
+		commitInfos[res.Height] = commitInfo
+	}
 
 	// Restore origin path and append proof op.
 	res.ProofOps.Ops = append(res.ProofOps.Ops, commitInfo.ProofOp(storeName))
@@ -770,6 +860,43 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 	return res, nil
 }
 
+// QueryBatch queries the values of a batch of keys, across multiple stores, at
+// the same height, along with their Merkle proofs up to the app hash if
+// requested. The height defaults to the latest version. The commit info of the
+// height is loaded once for the whole batch.
+func (rs *Store) QueryBatch(req *types.QueryBatchRequest) (*types.QueryBatchResponse, error) {
+	height := req.Height
+	if height == 0 {
+		height = rs.LatestVersion()
+	}
+
+	res := &types.QueryBatchResponse{
+		Height:  height,
+		Results: make([]*types.QueryBatchResult, 0, len(req.Keys)),
+	}
+	commitInfos := make(map[int64]*types.CommitInfo, 1)
+	for _, key := range req.Keys {
+		keyRes, err := rs.query(&types.RequestQuery{
+			Path:   "/" + key.StoreName + "/key",
+			Data:   key.Key,
+			Height: height,
+			Prove:  req.Prove,
+		}, commitInfos)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to query key %X of store %s", key.Key, key.StoreName)
+		}
+
+		res.Results = append(res.Results, &types.QueryBatchResult{
+			StoreName: key.StoreName,
+			Key:       key.Key,
+			Value:     keyRes.Value,
+			ProofOps:  keyRes.ProofOps,
+		})
+	}
+
+	return res, nil
+}
+
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
 // starting a new chain at an arbitrary height.
 func (rs *Store) SetInitialVersion(version int64) error {
@@ -1002,6 +1129,18 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
 	}
 
+	// count the writes to the database of the persistent stores for the commit
+	// metrics.
+	if params.typ == types.StoreTypeIAVL || params.typ == types.StoreTypeDB {
+		storeDB := newCountingDB(db)
+		rs.storeDBs[key] = storeDB
+		db = storeDB
+	}
+
+	if _, ok := rs.encryptedStores[key]; ok && params.typ != types.StoreTypeDB {
+		return nil, fmt.Errorf("store %s of type %v cannot be encrypted, only the stores of type %v can", key.Name(), params.typ, types.StoreTypeDB)
+	}
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -1010,9 +1149,12 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var store types.CommitKVStore
 		var err error
 
-		if params.initialVersion == 0 {
+		switch {
+		case rs.iavlLazyLoading:
+			store, err = iavl.LoadStoreWithLazyLoading(db, rs.logger, key, id, params.initialVersion, rs.iavlCacheSize, rs.metrics)
+		case params.initialVersion == 0:
 			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
-		} else {
+		default:
 			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
 		}
 
@@ -1030,7 +1172,12 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		return store, err
 
 	case types.StoreTypeDB:
-		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
+		var store types.KVStore = dbadapter.Store{DB: db}
+		if keyring, ok := rs.encryptedStores[key]; ok {
+			store = encryptedkv.NewStore(store, keyring)
+		}
+
+		return commitDBStoreAdapter{KVStore: store}, nil
 
 	case types.StoreTypeTransient:
 		_, ok := key.(*types.TransientStoreKey)
@@ -1077,6 +1224,19 @@ func (rs *Store) RollbackToVersion(target int64) error {
 	if target <= 0 {
 		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
+	if latest := rs.LatestVersion(); target > latest {
+		return fmt.Errorf("invalid rollback height target: %d, latest version is %d", target, latest)
+	}
+
+	// check that the target version of every store is available before
+	// overwriting any of them, so that a failed rollback leaves them untouched
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			if !rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(target) {
+				return fmt.Errorf("version %d of store %s does not exist or was pruned", target, key.Name())
+			}
+		}
+	}
 
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
@@ -1176,7 +1336,32 @@ func GetLatestVersion(db dbm.DB) int64 {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
+// commitStore commits the store, and records the time spent and the writes to
+// its database, if any, in the commit metrics.
+func (rs *Store) commitStore(key types.StoreKey, store types.CommitKVStore) types.CommitID {
+	db, ok := rs.storeDBs[key]
+	if !ok {
+		return store.Commit()
+	}
+
+	before := db.stats()
+	start := time.Now()
+	commitID := store.Commit()
+	after := db.stats()
+
+	rs.metrics.RecordCommit(key.Name(), metrics.CommitStats{
+		Duration:     time.Since(start),
+		KeysWritten:  after.KeysWritten - before.KeysWritten,
+		KeysDeleted:  after.KeysDeleted - before.KeysDeleted,
+		BytesWritten: after.BytesWritten - before.BytesWritten,
+	})
+
+	return commitID
+}
+
+// commitStores commits the stores with the commit function, and returns the
+// commit info of the version.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool, commit func(types.StoreKey, types.CommitKVStore) types.CommitID) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
 	storeKeys := keysFromStoreKeyMap(storeMap)
 
@@ -1192,7 +1377,7 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 			last.Version = version
 			commitID = last
 		} else {
-			commitID = store.Commit()
+			commitID = commit(key, store)
 		}
 
 		storeType := store.GetStoreType()
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/encryptedkv"
 	"cosmossdk.io/store/iavl"
 	sdkmaps "cosmossdk.io/store/internal/maps"
 	"cosmossdk.io/store/metrics"
@@ -504,6 +505,53 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultiStoreQueryBatch(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, multi.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	k2, v2 := []byte("water"), []byte("flows")
+	multi.GetStoreByName("store1").(types.KVStore).Set(k, v)
+	multi.GetStoreByName("store2").(types.KVStore).Set(k2, v2)
+	cid := multi.Commit()
+
+	// the values are overwritten at the latest height.
+	multi.GetStoreByName("store1").(types.KVStore).Set(k, []byte("changed"))
+	multi.Commit()
+
+	res, err := multi.QueryBatch(&types.QueryBatchRequest{
+		Height: cid.Version,
+		Prove:  true,
+		Keys: []*types.QueryBatchKey{
+			{StoreName: "store1", Key: k},
+			{StoreName: "store2", Key: k2},
+			{StoreName: "store2", Key: k},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, cid.Version, res.Height)
+	require.Len(t, res.Results, 3)
+
+	prt := DefaultProofRuntime()
+	require.Equal(t, v, res.Results[0].Value)
+	require.NoError(t, prt.VerifyValue(res.Results[0].ProofOps, cid.Hash, "/store1/wind", v))
+	require.Equal(t, v2, res.Results[1].Value)
+	require.NoError(t, prt.VerifyValue(res.Results[1].ProofOps, cid.Hash, "/store2/water", v2))
+	require.Nil(t, res.Results[2].Value)
+	require.NoError(t, prt.VerifyAbsence(res.Results[2].ProofOps, cid.Hash, "/store2/wind"))
+
+	// the latest height is queried by default.
+	res, err = multi.QueryBatch(&types.QueryBatchRequest{Keys: []*types.QueryBatchKey{{StoreName: "store1", Key: k}}})
+	require.NoError(t, err)
+	require.Equal(t, cid.Version+1, res.Height)
+	require.Equal(t, []byte("changed"), res.Results[0].Value)
+	require.Nil(t, res.Results[0].ProofOps)
+
+	_, err = multi.QueryBatch(&types.QueryBatchRequest{Keys: []*types.QueryBatchKey{{StoreName: "garbage", Key: k}}})
+	require.ErrorIs(t, err, types.ErrUnknownRequest)
+}
+
 func TestMultiStore_Pruning(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -544,6 +592,25 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ms.SetStorePruning(testStoreKey1.Name(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), ms.GetStorePruning(testStoreKey1.Name()))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 12; i++ {
+		ms.Commit()
+	}
+
+	store1 := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	store2 := ms.GetCommitKVStore(testStoreKey2).(*iavl.Store)
+	for v := int64(1); v <= 12; v++ {
+		require.True(t, store1.VersionExists(v), "expected height %d to be kept", v)
+		require.Equal(t, v >= 8, store2.VersionExists(v), "height %d", v)
+	}
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -625,6 +692,26 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
+func TestMultiStore_RollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i+1)))
+		ms.Commit()
+	}
+
+	// the pruned versions and the versions after the latest one cannot be rolled back to
+	require.Error(t, ms.RollbackToVersion(11))
+	require.Error(t, ms.RollbackToVersion(5))
+	require.Equal(t, int64(10), ms.LatestVersion())
+
+	require.NoError(t, ms.RollbackToVersion(8))
+	require.Equal(t, int64(8), ms.LatestVersion())
+	require.Equal(t, []byte("value8"), ms.GetStoreByName("store1").(types.KVStore).Get([]byte("key")))
+}
+
 func TestUnevenStoresHeightCheck(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -695,6 +782,90 @@ func TestCacheWraps(t *testing.T) {
 	require.IsType(t, cachemulti.Store{}, cacheWrappedWithTrace)
 }
 
+type commitMetrics struct {
+	metrics.NoOpMetrics
+	commits map[string][]metrics.CommitStats
+}
+
+func (m *commitMetrics) RecordCommit(storeName string, stats metrics.CommitStats) {
+	m.commits[storeName] = append(m.commits[storeName], stats)
+}
+
+func TestCommitMetrics(t *testing.T) {
+	m := &commitMetrics{commits: map[string][]metrics.CommitStats{}}
+	multi := NewStore(dbm.NewMemDB(), log.NewNopLogger(), m)
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	multi.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	multi.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	multi.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		multi.GetKVStore(key1).Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	multi.Commit()
+
+	// the stores without a database are not recorded.
+	require.Len(t, m.commits, 2)
+	require.Len(t, m.commits["store1"], 1)
+	stats1, stats2 := m.commits["store1"][0], m.commits["store2"][0]
+	require.Positive(t, stats1.Duration)
+	require.Greater(t, stats1.KeysWritten, stats2.KeysWritten)
+	require.Greater(t, stats1.BytesWritten, stats2.BytesWritten)
+
+	// the writes are counted per commit.
+	multi.GetKVStore(key2).Set([]byte("key"), []byte("value"))
+	multi.Commit()
+	require.Len(t, m.commits["store1"], 2)
+	require.Less(t, m.commits["store1"][1].KeysWritten, stats1.KeysWritten)
+	require.Greater(t, m.commits["store2"][1].KeysWritten, stats2.KeysWritten)
+}
+
+func TestStoreEncryption(t *testing.T) {
+	db := dbm.NewMemDB()
+	key, err := encryptedkv.NewKey(1)
+	require.NoError(t, err)
+	keyring, err := encryptedkv.NewKeyring(key)
+	require.NoError(t, err)
+
+	newStore := func() (*Store, types.StoreKey) {
+		multi := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		localKey := types.NewKVStoreKey("local")
+		multi.MountStoreWithDB(localKey, types.StoreTypeDB, nil)
+		multi.SetStoreEncryption(keyring, localKey)
+		require.NoError(t, multi.LoadLatestVersion())
+		return multi, localKey
+	}
+
+	multi, localKey := newStore()
+	multi.GetKVStore(localKey).Set([]byte("key"), []byte("value"))
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(localKey).Set([]byte("cached"), []byte("value"))
+	cms.Write()
+	multi.Commit()
+
+	// the values are encrypted in the database, and decrypted once reloaded.
+	raw := dbm.NewPrefixDB(db, []byte("s/k:local/"))
+	bz, err := raw.Get([]byte("key"))
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "value")
+
+	multi, localKey = newStore()
+	require.Equal(t, []byte("value"), multi.GetKVStore(localKey).Get([]byte("key")))
+	require.Equal(t, []byte("value"), multi.GetKVStore(localKey).Get([]byte("cached")))
+
+	count, err := multi.ReencryptStore(localKey)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// the consensus stores cannot be encrypted.
+	multi = NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	iavlKey := types.NewKVStoreKey("iavl")
+	multi.MountStoreWithDB(iavlKey, types.StoreTypeIAVL, nil)
+	multi.SetStoreEncryption(keyring, iavlKey)
+	require.ErrorContains(t, multi.LoadLatestVersion(), "cannot be encrypted")
+}
+
 func TestTraceConcurrency(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -915,6 +1086,29 @@ func TestStateListeners(t *testing.T) {
 	require.Empty(t, ms.PopStateCache())
 }
 
+func TestPrefixStateListeners(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+
+	ms.AddPrefixListeners(testStoreKey1, [][]byte{{1}})
+	ms.AddPrefixListeners(testStoreKey2, [][]byte{{1}})
+	// a listener of the whole store receives all its writes.
+	ms.AddListeners([]types.StoreKey{testStoreKey2})
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cacheMulti := ms.CacheMultiStore()
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2} {
+		store := cacheMulti.GetKVStore(key)
+		store.Set([]byte{1, 1}, []byte{1})
+		store.Set([]byte{2, 1}, []byte{1})
+	}
+	cacheMulti.Write()
+
+	changeSet := ms.PopStateCache()
+	require.Len(t, changeSet, 3)
+	require.Equal(t, &types.StoreKVPair{StoreKey: testStoreKey1.Name(), Key: []byte{1, 1}, Value: []byte{1}}, changeSet[0])
+}
+
 type commitKVStoreStub struct {
 	types.CommitKVStore
 	Committed int
@@ -981,7 +1175,9 @@ func TestCommitStores(t *testing.T) {
 			store.Committed = 0
 			var version int64 = 1
 			removalMap := map[types.StoreKey]bool{}
-			res := commitStores(version, storeMap, removalMap)
+			res := commitStores(version, storeMap, removalMap, func(_ types.StoreKey, store types.CommitKVStore) types.CommitID {
+				return store.Commit()
+			})
 			for _, s := range res.StoreInfos {
 				require.Equal(t, version, s.CommitId.Version)
 			}
@@ -63,7 +63,7 @@ func readChunks(chunks <-chan io.ReadCloser) [][]byte {
 }
 
 // snapshotItems serialize a array of bytes as SnapshotItem_ExtensionPayload, and return the chunks.
-func snapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]byte {
+func snapshotItems(items [][]byte, exts ...snapshottypes.ExtensionSnapshotter) [][]byte {
 	// copy the same parameters from the code
 	snapshotChunkSize := uint64(10e6)
 	snapshotBufferSize := int(snapshotChunkSize)
@@ -77,18 +77,20 @@ func snapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]b
 		for _, item := range items {
 			_ = snapshottypes.WriteExtensionPayload(protoWriter, item)
 		}
-		// write extension metadata
-		_ = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Extension{
-				Extension: &snapshottypes.SnapshotExtensionMeta{
-					Name:   ext.SnapshotName(),
-					Format: ext.SnapshotFormat(),
+		for _, ext := range exts {
+			// write extension metadata
+			_ = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Extension{
+					Extension: &snapshottypes.SnapshotExtensionMeta{
+						Name:   ext.SnapshotName(),
+						Format: ext.SnapshotFormat(),
+					},
 				},
-			},
-		})
-		_ = ext.SnapshotExtension(0, func(payload []byte) error {
-			return snapshottypes.WriteExtensionPayload(protoWriter, payload)
-		})
+			})
+			_ = ext.SnapshotExtension(0, func(payload []byte) error {
+				return snapshottypes.WriteExtensionPayload(protoWriter, payload)
+			})
+		}
 		_ = protoWriter.Close()
 		_ = bufWriter.Flush()
 		_ = chunkWriter.Close()
@@ -323,6 +325,30 @@ func (s *extSnapshotter) RestoreExtension(height uint64, format uint32, payloadR
 	return nil
 }
 
+// namedExtSnapshotter is an extSnapshotter registered under another name.
+type namedExtSnapshotter struct {
+	*extSnapshotter
+	name string
+}
+
+func (s *namedExtSnapshotter) SnapshotName() string {
+	return s.name
+}
+
+// partialExtSnapshotter is an extSnapshotter restoring only the first payload.
+type partialExtSnapshotter struct {
+	*extSnapshotter
+}
+
+func (s *partialExtSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	payload, err := payloadReader()
+	if err != nil {
+		return err
+	}
+	s.state = append(s.state, types.BigEndianToUint64(payload))
+	return nil
+}
+
 // GetTempDir returns a writable temporary director for the test to use.
 func GetTempDir(tb testing.TB) string {
 	tb.Helper()
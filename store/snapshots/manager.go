@@ -91,6 +91,9 @@ func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) e
 	}
 	for _, extension := range extensions {
 		name := extension.SnapshotName()
+		if name == "" {
+			return errors.New("snapshotter name cannot be empty")
+		}
 		if _, ok := m.extensions[name]; ok {
 			return fmt.Errorf("duplicated snapshotter name: %s", name)
 		}
@@ -181,17 +184,22 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	format, err := types.CompressionFormat(m.opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, format, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, format, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
+func (m *Manager) createSnapshot(height uint64, format uint32, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriterWithFormat(ch, format, m.opts.ChunkSize)
 	if streamWriter == nil {
 		return
 	}
@@ -275,7 +283,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -344,7 +352,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := NewStreamReaderWithFormat(chChunks, snapshot.Format)
 	if err != nil {
 		return err
 	}
@@ -368,6 +376,10 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrap(err, "multistore restore")
 	}
 
+	// the extensions are snapshotted in the order of their names, each extension
+	// must be restored exactly once and in that order.
+	var lastName string
+	restored := make(map[string]bool, len(m.extensions))
 	for {
 		if nextItem.Item == nil {
 			// end of stream
@@ -377,6 +389,12 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		if metadata == nil {
 			return errorsmod.Wrapf(storetypes.ErrLogic, "unknown snapshot item %T", nextItem.Item)
 		}
+		if restored[metadata.Name] {
+			return errorsmod.Wrapf(types.ErrInvalidExtension, "extension %s is duplicated", metadata.Name)
+		}
+		if metadata.Name < lastName {
+			return errorsmod.Wrapf(types.ErrInvalidExtension, "extension %s is not ordered after extension %s", metadata.Name, lastName)
+		}
 		extension, ok := m.extensions[metadata.Name]
 		if !ok {
 			return errorsmod.Wrapf(storetypes.ErrLogic, "unknown extension snapshotter %s", metadata.Name)
@@ -390,7 +408,18 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 
 		if nextItem.GetExtensionPayload() != nil {
-			return errorsmod.Wrapf(err, "extension %s don't exhausted payload stream", metadata.Name)
+			return errorsmod.Wrapf(types.ErrInvalidExtension, "extension %s did not exhaust its payload stream", metadata.Name)
+		}
+
+		restored[metadata.Name] = true
+		lastName = metadata.Name
+	}
+
+	// a snapshot missing the payloads of a registered extension would restore an
+	// incomplete state.
+	for _, name := range m.sortedExtensionNames() {
+		if !restored[name] {
+			return errorsmod.Wrapf(types.ErrInvalidExtension, "snapshot is missing extension %s", name)
 		}
 	}
 	return nil
@@ -247,6 +247,80 @@ func TestManager_Restore(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestManager_RestoreExtensions(t *testing.T) {
+	items := [][]byte{{1, 2, 3}}
+	mock, mock2 := newExtSnapshotter(2), &namedExtSnapshotter{newExtSnapshotter(2), "mock2"}
+
+	testCases := map[string]struct {
+		extensions []types.ExtensionSnapshotter
+		chunks     [][]byte
+		expErr     string
+	}{
+		"all extensions restored": {
+			extensions: []types.ExtensionSnapshotter{newExtSnapshotter(0), &namedExtSnapshotter{newExtSnapshotter(0), "mock2"}},
+			chunks:     snapshotItems(items, mock, mock2),
+		},
+		"missing extension": {
+			extensions: []types.ExtensionSnapshotter{newExtSnapshotter(0), &namedExtSnapshotter{newExtSnapshotter(0), "mock2"}},
+			chunks:     snapshotItems(items, mock),
+			expErr:     "snapshot is missing extension mock2",
+		},
+		"duplicated extension": {
+			extensions: []types.ExtensionSnapshotter{newExtSnapshotter(0)},
+			chunks:     snapshotItems(items, mock, mock),
+			expErr:     "extension mock is duplicated",
+		},
+		"unordered extensions": {
+			extensions: []types.ExtensionSnapshotter{newExtSnapshotter(0), &namedExtSnapshotter{newExtSnapshotter(0), "mock2"}},
+			chunks:     snapshotItems(items, mock2, mock),
+			expErr:     "extension mock is not ordered after extension mock2",
+		},
+		"payload stream not exhausted": {
+			extensions: []types.ExtensionSnapshotter{&partialExtSnapshotter{newExtSnapshotter(0)}},
+			chunks:     snapshotItems(items, mock),
+			expErr:     "extension mock did not exhaust its payload stream",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+			manager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+			require.NoError(t, manager.RegisterExtensions(tc.extensions...))
+
+			err := manager.Restore(types.Snapshot{
+				Height:   3,
+				Format:   types.CurrentFormat,
+				Hash:     []byte{1, 2, 3},
+				Chunks:   uint32(len(tc.chunks)),
+				Metadata: types.Metadata{ChunkHashes: checksums(tc.chunks)},
+			})
+			require.NoError(t, err)
+
+			for i, chunk := range tc.chunks[:len(tc.chunks)-1] {
+				_, err := manager.RestoreChunk(chunk)
+				require.NoError(t, err, "chunk %d", i)
+			}
+			done, err := manager.RestoreChunk(tc.chunks[len(tc.chunks)-1])
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, types.ErrInvalidExtension)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, done)
+		})
+	}
+}
+
+func TestManager_RegisterExtensions(t *testing.T) {
+	manager := snapshots.NewManager(setupStore(t), opts, &mockSnapshotter{}, nil, log.NewNopLogger())
+
+	require.ErrorContains(t, manager.RegisterExtensions(&namedExtSnapshotter{newExtSnapshotter(0), ""}), "snapshotter name cannot be empty")
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(0)))
+	require.ErrorContains(t, manager.RegisterExtensions(newExtSnapshotter(0)), "duplicated snapshotter name: mock")
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorSnapshotter{}
 	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
@@ -256,3 +330,59 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_Compression(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	for _, compression := range []string{types.CompressionZlib, types.CompressionZstd, types.CompressionSnappy, types.CompressionNone} {
+		t.Run(compression, func(t *testing.T) {
+			format, err := types.CompressionFormat(compression)
+			require.NoError(t, err)
+
+			opts := types.NewSnapshotOptions(1500, 2)
+			opts.Compression = compression
+			opts.ChunkSize = 16
+
+			source := &mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})}
+			manager := snapshots.NewManager(setupStore(t), opts, source, nil, log.NewNopLogger())
+			require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+			snapshot, err := manager.Create(5)
+			require.NoError(t, err)
+			require.Equal(t, format, snapshot.Format)
+			require.Greater(t, snapshot.Chunks, uint32(1))
+
+			var chunks [][]byte
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(chunk), 16)
+				chunks = append(chunks, chunk)
+			}
+
+			target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+			extSnapshotter := newExtSnapshotter(0)
+			targetManager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+			require.NoError(t, targetManager.RegisterExtensions(extSnapshotter))
+
+			require.NoError(t, targetManager.Restore(*snapshot))
+			for i, chunk := range chunks {
+				done, err := targetManager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				require.Equal(t, i == len(chunks)-1, done)
+			}
+			require.Equal(t, items, target.items)
+			require.Len(t, extSnapshotter.state, 10)
+		})
+	}
+
+	opts := types.NewSnapshotOptions(1500, 2)
+	opts.Compression = "lz4"
+	manager := snapshots.NewManager(setupStore(t), opts, &mockSnapshotter{prunedHeights: make(map[int64]struct{})}, nil, log.NewNopLogger())
+	_, err := manager.Create(5)
+	require.ErrorContains(t, err, `unknown snapshot compression "lz4"`)
+}
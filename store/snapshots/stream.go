@@ -7,36 +7,65 @@ import (
 
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 
 	"cosmossdk.io/errors"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 )
 
 const (
-	// Do not change chunk size without new snapshot format (must be uniform across nodes)
-	snapshotChunkSize  = uint64(10e6)
-	snapshotBufferSize = int(snapshotChunkSize)
+	// Default chunk size, the nodes of a network must use the same chunk size for their
+	// snapshots of a height to be identical
+	snapshotChunkSize = uint64(10e6)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
-// Exported Items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+// Exported Items -> delimited Protobuf -> compression -> buffer -> chunkWriter -> chan io.ReadCloser
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
+	zWriter     io.WriteCloser
 	protoWriter protoio.WriteCloser
 }
 
 // NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
 func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
-	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
-	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	return NewStreamWriterWithFormat(ch, snapshottypes.CurrentFormat, snapshotChunkSize)
+}
+
+// NewStreamWriterWithFormat set up a stream pipeline to serialize snapshot DB records, compressed
+// as per the snapshot format and split in chunks of chunkSize bytes, 10MB if zero.
+func NewStreamWriterWithFormat(ch chan<- io.ReadCloser, format uint32, chunkSize uint64) *StreamWriter {
+	if chunkSize == 0 {
+		chunkSize = snapshotChunkSize
+	}
+	chunkWriter := NewChunkWriter(ch, chunkSize)
+	bufWriter := bufio.NewWriterSize(chunkWriter, int(chunkSize))
+
+	var (
+		zWriter io.WriteCloser
+		err     error
+	)
+	switch format {
+	case snapshottypes.CurrentFormat:
+		zWriter, err = zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	case snapshottypes.FormatZstd:
+		zWriter, err = zstd.NewWriter(bufWriter, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	case snapshottypes.FormatSnappy:
+		zWriter = snappy.NewBufferedWriter(bufWriter)
+	case snapshottypes.FormatNone:
+		zWriter = nopWriteCloser{bufWriter}
+	default:
+		err = errors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
 	if err != nil {
-		chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
+		chunkWriter.CloseWithError(errors.Wrap(err, "compression failure"))
 		return nil
 	}
+
 	protoWriter := protoio.NewDelimitedWriter(zWriter)
 	return &StreamWriter{
 		chunkWriter: chunkWriter,
@@ -70,7 +99,7 @@ func (sw *StreamWriter) CloseWithError(err error) {
 }
 
 // StreamReader set up a restore stream pipeline
-// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+// chan io.ReadCloser -> chunkReader -> decompression -> delimited Protobuf -> ExportNode
 type StreamReader struct {
 	chunkReader *ChunkReader
 	zReader     io.ReadCloser
@@ -79,11 +108,36 @@ type StreamReader struct {
 
 // NewStreamReader set up a restore stream pipeline.
 func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
+	return NewStreamReaderWithFormat(chunks, snapshottypes.CurrentFormat)
+}
+
+// NewStreamReaderWithFormat set up a restore stream pipeline of a snapshot, decompressed as per
+// the snapshot format.
+func NewStreamReaderWithFormat(chunks <-chan io.ReadCloser, format uint32) (*StreamReader, error) {
 	chunkReader := NewChunkReader(chunks)
-	zReader, err := zlib.NewReader(chunkReader)
-	if err != nil {
-		return nil, errors.Wrap(err, "zlib failure")
+
+	var zReader io.ReadCloser
+	switch format {
+	case snapshottypes.CurrentFormat:
+		r, err := zlib.NewReader(chunkReader)
+		if err != nil {
+			return nil, errors.Wrap(err, "zlib failure")
+		}
+		zReader = r
+	case snapshottypes.FormatZstd:
+		r, err := zstd.NewReader(chunkReader)
+		if err != nil {
+			return nil, errors.Wrap(err, "zstd failure")
+		}
+		zReader = r.IOReadCloser()
+	case snapshottypes.FormatSnappy:
+		zReader = io.NopCloser(snappy.NewReader(chunkReader))
+	case snapshottypes.FormatNone:
+		zReader = io.NopCloser(chunkReader)
+	default:
+		return nil, errors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
+
 	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
 	return &StreamReader{
 		chunkReader: chunkReader,
@@ -111,3 +165,10 @@ func (sr *StreamReader) Close() error {
 	}
 	return err
 }
+
+// nopWriteCloser is the io.WriteCloser of uncompressed snapshot streams.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrInvalidExtension is returned when the extension payloads of a snapshot are invalid.
+	ErrInvalidExtension = errors.New("invalid snapshot extension")
 )
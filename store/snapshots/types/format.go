@@ -1,6 +1,52 @@
 package types
 
+import "fmt"
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// The formats of the snapshots whose stream of items is compressed with another algorithm than
+// the zlib compression of CurrentFormat, the items being the same.
+const (
+	FormatZstd   uint32 = 4
+	FormatSnappy uint32 = 5
+	FormatNone   uint32 = 6
+)
+
+// The compression algorithms of the snapshot stream.
+const (
+	CompressionZlib   = "zlib"
+	CompressionZstd   = "zstd"
+	CompressionSnappy = "snappy"
+	CompressionNone   = "none"
+)
+
+// CompressionFormat returns the snapshot format of a compression algorithm, an empty
+// compression defaulting to zlib.
+func CompressionFormat(compression string) (uint32, error) {
+	switch compression {
+	case "", CompressionZlib:
+		return CurrentFormat, nil
+	case CompressionZstd:
+		return FormatZstd, nil
+	case CompressionSnappy:
+		return FormatSnappy, nil
+	case CompressionNone:
+		return FormatNone, nil
+	default:
+		return 0, fmt.Errorf("unknown snapshot compression %q, expected one of %s, %s, %s or %s",
+			compression, CompressionZlib, CompressionZstd, CompressionSnappy, CompressionNone)
+	}
+}
+
+// IsSupportedFormat returns whether snapshots of the format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	switch format {
+	case CurrentFormat, FormatZstd, FormatSnappy, FormatNone:
+		return true
+	default:
+		return false
+	}
+}
@@ -8,6 +8,15 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Compression defines the compression algorithm of the snapshots, zlib if
+	// empty, see CompressionFormat.
+	Compression string
+
+	// ChunkSize defines the size in bytes of the snapshot chunks, 10MB if zero.
+	// The nodes of a network must use the same compression and chunk size for
+	// their snapshots of a height to be identical.
+	ChunkSize uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -17,3 +17,10 @@ func NewCommitMultiStore(db dbm.DB, logger log.Logger, metricGatherer metrics.St
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
+
+// NewCommitKVStoreCacheManagerWithStoreSizes returns an inter-block cache
+// manager using the given default cache size, overridden per store name by
+// storeSizes.
+func NewCommitKVStoreCacheManagerWithStoreSizes(size uint, storeSizes map[string]uint) types.MultiStorePersistentCache {
+	return cache.NewCommitKVStoreCacheManagerWithStoreSizes(size, storeSizes)
+}
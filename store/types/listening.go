@@ -1,8 +1,13 @@
 package types
 
+import "bytes"
+
 // MemoryListener listens to the state writes and accumulate the records in memory.
 type MemoryListener struct {
 	stateCache []*StoreKVPair
+	// prefixes restricts the accumulated writes to the keys with one of the
+	// prefixes, all the writes are accumulated if it is nil.
+	prefixes [][]byte
 }
 
 // NewMemoryListener creates a listener that accumulate the state writes in memory.
@@ -10,8 +15,56 @@ func NewMemoryListener() *MemoryListener {
 	return &MemoryListener{}
 }
 
+// NewPrefixMemoryListener creates a listener that accumulates in memory the
+// state writes of the keys with one of the given prefixes.
+func NewPrefixMemoryListener(prefixes ...[]byte) *MemoryListener {
+	fl := &MemoryListener{prefixes: make([][]byte, 0, len(prefixes))}
+	fl.AddPrefixes(prefixes...)
+	return fl
+}
+
+// Prefixes returns the key prefixes the listener is restricted to, or nil if
+// it accumulates all the writes.
+func (fl *MemoryListener) Prefixes() [][]byte {
+	return fl.prefixes
+}
+
+// AddPrefixes extends the writes accumulated by a prefix listener to the keys
+// with one of the given prefixes. An empty prefix extends them to all the keys.
+// It does nothing on a listener accumulating all the writes.
+func (fl *MemoryListener) AddPrefixes(prefixes ...[]byte) {
+	if fl.prefixes == nil {
+		return
+	}
+
+	for _, prefix := range prefixes {
+		if len(prefix) == 0 {
+			fl.prefixes = nil
+			return
+		}
+		fl.prefixes = append(fl.prefixes, bytes.Clone(prefix))
+	}
+}
+
+// Listens returns true if the listener accumulates the writes of the key.
+func (fl *MemoryListener) Listens(key []byte) bool {
+	if fl.prefixes == nil {
+		return true
+	}
+	for _, prefix := range fl.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // OnWrite implements MemoryListener interface
 func (fl *MemoryListener) OnWrite(storeKey StoreKey, key, value []byte, delete bool) {
+	if !fl.Listens(key) {
+		return
+	}
+
 	fl.stateCache = append(fl.stateCache, &StoreKVPair{
 		StoreKey: storeKey.Name(),
 		Delete:   delete,
@@ -26,3 +79,15 @@ func (fl *MemoryListener) PopStateCache() []*StoreKVPair {
 	fl.stateCache = nil
 	return res
 }
+
+// StorePrefix selects the state changes of the keys with a prefix in a store.
+// An empty prefix selects all the changes of the store.
+type StorePrefix struct {
+	Key    StoreKey
+	Prefix []byte
+}
+
+// Matches returns true if the state change is selected by the store prefix.
+func (p StorePrefix) Matches(pair *StoreKVPair) bool {
+	return pair.StoreKey == p.Key.Name() && bytes.HasPrefix(pair.Key, p.Prefix)
+}
@@ -40,3 +40,34 @@ func TestOnWrite(t *testing.T) {
 	}
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestPrefixMemoryListener(t *testing.T) {
+	testStoreKey := NewKVStoreKey("test_key")
+	listener := NewPrefixMemoryListener([]byte("balances/addr1"))
+
+	listener.OnWrite(testStoreKey, []byte("balances/addr1/stake"), []byte("1"), false)
+	listener.OnWrite(testStoreKey, []byte("balances/addr2/stake"), []byte("2"), false)
+	listener.OnWrite(testStoreKey, []byte("supply/stake"), []byte("3"), false)
+	require.Equal(t, []*StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("balances/addr1/stake"), Value: []byte("1")},
+	}, listener.PopStateCache())
+
+	listener.AddPrefixes([]byte("supply"))
+	require.Len(t, listener.Prefixes(), 2)
+	listener.OnWrite(testStoreKey, []byte("supply/stake"), []byte("3"), false)
+	require.Len(t, listener.PopStateCache(), 1)
+
+	// the empty prefix extends the listener to all the keys.
+	listener.AddPrefixes([]byte{})
+	require.Nil(t, listener.Prefixes())
+	listener.AddPrefixes([]byte("supply"))
+	require.Nil(t, listener.Prefixes())
+	listener.OnWrite(testStoreKey, []byte("balances/addr2/stake"), []byte("2"), false)
+	require.Len(t, listener.PopStateCache(), 1)
+
+	pair := &StoreKVPair{StoreKey: testStoreKey.Name(), Key: []byte("balances/addr1/stake")}
+	require.True(t, StorePrefix{Key: testStoreKey, Prefix: []byte("balances/")}.Matches(pair))
+	require.True(t, StorePrefix{Key: testStoreKey}.Matches(pair))
+	require.False(t, StorePrefix{Key: testStoreKey, Prefix: []byte("supply")}.Matches(pair))
+	require.False(t, StorePrefix{Key: NewKVStoreKey("other")}.Matches(pair))
+}
@@ -43,6 +43,12 @@ type Queryable interface {
 	Query(*RequestQuery) (*ResponseQuery, error)
 }
 
+// BatchQueryable allows a multistore to query a batch of keys, across multiple
+// stores, at the same height in a single call.
+type BatchQueryable interface {
+	QueryBatch(*QueryBatchRequest) (*QueryBatchResponse, error)
+}
+
 type RequestQuery struct {
 	Data   []byte
 	Path   string
@@ -204,12 +210,20 @@ type CommitMultiStore interface {
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
 
+	// SetStorePruning overrides the pruning strategy of the store of the given
+	// name.
+	SetStorePruning(storeName string, opts pruningtypes.PruningOptions)
+
 	// SetIAVLCacheSize sets the cache size of the IAVL tree.
 	SetIAVLCacheSize(size int)
 
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
+	// SetIAVLLazyLoading enables the fast nodes of iavl and the lazy loading of
+	// the nodes traversed when iterating over whole trees.
+	SetIAVLLazyLoading(lazyLoading bool)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
@@ -219,6 +233,10 @@ type CommitMultiStore interface {
 	// AddListeners adds a listener for the KVStore belonging to the provided StoreKey
 	AddListeners(keys []StoreKey)
 
+	// AddPrefixListeners adds a listener for the writes of the keys with one of
+	// the given prefixes in the KVStore belonging to the provided StoreKey
+	AddPrefixListeners(key StoreKey, prefixes [][]byte)
+
 	// PopStateCache returns the accumulated state change messages from the CommitMultiStore
 	PopStateCache() []*StoreKVPair
 
@@ -0,0 +1,209 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/migration"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+type (
+	// SSType defines the backend of the State Storage (SS).
+	SSType string
+	// SCType defines the backend of the State Commitment (SC).
+	SCType string
+)
+
+const (
+	SSTypeSQLite SSType = "sqlite"
+	SSTypePebble SSType = "pebble"
+	SSTypeRocks  SSType = "rocksdb"
+
+	SCTypeIavl SCType = "iavl"
+
+	// v1LatestVersionKey and v1StorePrefixTpl are the keys of the store/v1
+	// multistore, see store/rootmulti.
+	v1LatestVersionKey = "s/latest"
+	v1StorePrefixTpl   = "s/k:%s/" // s/k:<storeKey>/
+
+	// migrationSnapshotInterval and migrationSnapshotKeepRecent are the options
+	// of the snapshot manager used to stream the store/v1 state.
+	migrationSnapshotInterval   = 1500
+	migrationSnapshotKeepRecent = 2
+)
+
+// FactoryOptions are the options for creating a root store with CreateRootStore.
+type FactoryOptions struct {
+	Logger log.Logger
+	// RootDir is the directory of the SS and SC databases, and of the migration
+	// snapshots.
+	RootDir          string
+	SSType           SSType
+	SCType           SCType
+	SSPruningOptions *store.PruneOptions
+	SCPruningOptions *store.PruneOptions
+	IavlConfig       *iavl.Config
+	StoreKeys        []string
+	// SCRawDB is the database of the SC. It is created in RootDir if nil.
+	SCRawDB store.RawDB
+	// V1RawDB is the database of an existing store/v1 (IAVL-only) multistore. If
+	// set, the state of its latest version is migrated to the new SS and SC
+	// once StartMigration is called on the returned root store.
+	V1RawDB store.RawDB
+}
+
+// CreateRootStore creates a root store with the SS and SC backends selected in
+// the options. If opts.V1RawDB is set, the root store is backed by the IAVL
+// trees of the store/v1 multistore until the migration is done.
+func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+	if opts.IavlConfig == nil {
+		opts.IavlConfig = iavl.DefaultConfig()
+	}
+
+	ss, err := newStateStorage(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	scRawDB := opts.SCRawDB
+	if scRawDB == nil {
+		scRawDB, err = dbm.NewGoLevelDB("application", filepath.Join(opts.RootDir, "data"), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sc, err := newStateCommitment(opts, scRawDB, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.V1RawDB == nil {
+		return New(opts.Logger, ss, sc, nil, nil)
+	}
+
+	return newMigratingRootStore(opts, ss, sc, scRawDB)
+}
+
+func newStateStorage(opts *FactoryOptions) (*storage.StorageStore, error) {
+	dir := filepath.Join(opts.RootDir, "data", "ss")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var (
+		db  storage.Database
+		err error
+	)
+	switch opts.SSType {
+	case SSTypeSQLite:
+		db, err = sqlite.New(dir)
+	case SSTypePebble:
+		db, err = pebbledb.New(dir)
+	case SSTypeRocks:
+		db, err = newRocksDBStorage(dir)
+	default:
+		return nil, fmt.Errorf("unsupported SS type: %s", opts.SSType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SS database: %w", err)
+	}
+
+	return storage.NewStorageStore(db, opts.SSPruningOptions, opts.Logger), nil
+}
+
+// newStateCommitment creates a SC of one tree per store key, under the given
+// store prefix template, e.g. v1StorePrefixTpl for the trees of a store/v1
+// multistore. An empty template prefixes the trees with their store key.
+func newStateCommitment(opts *FactoryOptions, db store.RawDB, prefixTpl string) (*commitment.CommitStore, error) {
+	if opts.SCType != SCTypeIavl {
+		return nil, fmt.Errorf("unsupported SC type: %s", opts.SCType)
+	}
+
+	trees := make(map[string]commitment.Tree, len(opts.StoreKeys))
+	for _, key := range opts.StoreKeys {
+		prefix := []byte(key)
+		if prefixTpl != "" {
+			prefix = []byte(fmt.Sprintf(prefixTpl, key))
+		}
+		trees[key] = iavl.NewIavlTree(dbm.NewPrefixDB(db, prefix), opts.Logger, opts.IavlConfig)
+	}
+
+	return commitment.NewCommitStore(trees, db, opts.SCPruningOptions, opts.Logger)
+}
+
+// newMigratingRootStore creates a root store backed by the trees of the store/v1
+// multistore, which migrates the store/v1 state to ss and sc.
+func newMigratingRootStore(opts *FactoryOptions, ss *storage.StorageStore, sc *commitment.CommitStore, scRawDB store.RawDB) (store.RootStore, error) {
+	v1Version, err := getV1LatestVersion(opts.V1RawDB)
+	if err != nil {
+		return nil, err
+	}
+
+	orgSC, err := newStateCommitment(opts, opts.V1RawDB, v1StorePrefixTpl)
+	if err != nil {
+		return nil, err
+	}
+
+	// the store/v1 multistore has no SC metadata, load the trees at its latest
+	// version to record it
+	scVersion, err := orgSC.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if scVersion == 0 && v1Version > 0 {
+		if err := orgSC.LoadVersion(v1Version); err != nil {
+			return nil, fmt.Errorf("failed to load store/v1 version %d: %w", v1Version, err)
+		}
+	}
+
+	snapshotsStore, err := snapshots.NewStore(filepath.Join(opts.RootDir, "data", "migration"))
+	if err != nil {
+		return nil, err
+	}
+	snapshotsManager := snapshots.NewManager(
+		snapshotsStore,
+		snapshots.NewSnapshotOptions(migrationSnapshotInterval, migrationSnapshotKeepRecent),
+		orgSC, nil, nil, opts.Logger,
+	)
+	migrationManager := migration.NewManager(scRawDB, snapshotsManager, ss, sc, opts.Logger)
+
+	return New(opts.Logger, ss, orgSC, migrationManager, nil)
+}
+
+// getV1LatestVersion returns the latest version of a store/v1 multistore.
+func getV1LatestVersion(db store.RawDB) (uint64, error) {
+	bz, err := db.Get([]byte(v1LatestVersionKey))
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+
+	var version int64
+	if err := gogotypes.StdInt64Unmarshal(&version, bz); err != nil {
+		return 0, err
+	}
+	if version < 0 {
+		return 0, errors.New("invalid store/v1 latest version")
+	}
+
+	return uint64(version), nil
+}
@@ -0,0 +1,13 @@
+//go:build rocksdb
+// +build rocksdb
+
+package root
+
+import (
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/rocksdb"
+)
+
+func newRocksDBStorage(dir string) (storage.Database, error) {
+	return rocksdb.New(dir)
+}
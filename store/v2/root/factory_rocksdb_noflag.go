@@ -0,0 +1,14 @@
+//go:build !rocksdb
+// +build !rocksdb
+
+package root
+
+import (
+	"errors"
+
+	"cosmossdk.io/store/v2/storage"
+)
+
+func newRocksDBStorage(string) (storage.Database, error) {
+	return nil, errors.New("rocksdb SS requires building with the rocksdb build tag")
+}
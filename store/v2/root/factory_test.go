@@ -0,0 +1,82 @@
+package root
+
+import (
+	"fmt"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCreateRootStore(t *testing.T) {
+	opts := &FactoryOptions{
+		Logger:    log.NewTestLogger(t),
+		RootDir:   t.TempDir(),
+		SSType:    SSTypeSQLite,
+		SCType:    SCTypeIavl,
+		StoreKeys: storeKeys,
+		SCRawDB:   dbm.NewMemDB(),
+	}
+	rs, err := CreateRootStore(opts)
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	cs := corestore.NewChangeset()
+	cs.Add([]byte(storeKeys[0]), []byte("key"), []byte("value"), false)
+	_, err = rs.WorkingHash(cs)
+	require.NoError(t, err)
+	_, err = rs.Commit(cs)
+	require.NoError(t, err)
+
+	_, reader, err := rs.StateLatest()
+	require.NoError(t, err)
+	store, err := reader.GetReader([]byte(storeKeys[0]))
+	require.NoError(t, err)
+	value, err := store.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.NoError(t, rs.Close())
+
+	opts.SSType = "unknown"
+	_, err = CreateRootStore(opts)
+	require.Error(t, err)
+}
+
+func TestCreateRootStoreFromV1(t *testing.T) {
+	// write a store/v1 multistore of IAVL trees at version 10
+	v1DB := dbm.NewMemDB()
+	for _, storeKey := range storeKeys {
+		tree := iavl.NewIavlTree(dbm.NewPrefixDB(v1DB, []byte(fmt.Sprintf(v1StorePrefixTpl, storeKey))), log.NewNopLogger(), iavl.DefaultConfig())
+		for version := 1; version <= 10; version++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version))))
+			_, _, err := tree.Commit()
+			require.NoError(t, err)
+		}
+	}
+	bz, err := gogotypes.StdInt64Marshal(10)
+	require.NoError(t, err)
+	batch := v1DB.NewBatch()
+	require.NoError(t, batch.Set([]byte(v1LatestVersionKey), bz))
+	require.NoError(t, batch.WriteSync())
+
+	rs, err := CreateRootStore(&FactoryOptions{
+		Logger:    log.NewTestLogger(t),
+		RootDir:   t.TempDir(),
+		SSType:    SSTypeSQLite,
+		SCType:    SCTypeIavl,
+		StoreKeys: storeKeys,
+		SCRawDB:   dbm.NewMemDB(),
+		V1RawDB:   v1DB,
+	})
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	version, err := rs.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), version)
+}
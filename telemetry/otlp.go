@@ -0,0 +1,247 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+var _ SpanExporter = (*OTLPExporter)(nil)
+
+// OTLPExporter exports spans to an OpenTelemetry collector with the OTLP/HTTP
+// protocol, using its JSON encoding.
+type OTLPExporter struct {
+	url      string
+	resource otlpResource
+	client   *http.Client
+}
+
+// NewOTLPExporter creates an OTLPExporter sending the spans of the given
+// service to the collector served at the given base URL, e.g.
+// http://localhost:4318.
+func NewOTLPExporter(endpoint, serviceName string) *OTLPExporter {
+	return &OTLPExporter{
+		url:      strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		resource: otlpResource{Attributes: otlpKeyValues([]attribute.KeyValue{semconv.ServiceName(serviceName)})},
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ExportSpans implements SpanExporter.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []SpanData) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	bz, err := json.Marshal(e.otlpTraces(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("failed to export %d spans to %s: %s: %s", len(spans), e.url, res.Status, body)
+	}
+
+	return nil
+}
+
+// Shutdown implements SpanExporter.
+func (e *OTLPExporter) Shutdown(context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// The types below are the JSON encoding of the OTLP ExportTraceServiceRequest.
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// OTLP status codes, which differ from the codes of the OpenTelemetry API.
+const (
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+func (e *OTLPExporter) otlpTraces(spans []SpanData) otlpExportRequest {
+	// spans are grouped by instrumentation scope
+	var scopes []string
+	scopeSpans := map[string][]otlpSpan{}
+	for _, span := range spans {
+		if _, ok := scopeSpans[span.Scope]; !ok {
+			scopes = append(scopes, span.Scope)
+		}
+		scopeSpans[span.Scope] = append(scopeSpans[span.Scope], otlpSpanOf(span))
+	}
+
+	resourceSpans := otlpResourceSpans{Resource: e.resource}
+	for _, scope := range scopes {
+		resourceSpans.ScopeSpans = append(resourceSpans.ScopeSpans, otlpScopeSpans{
+			Scope: otlpScope{Name: scope},
+			Spans: scopeSpans[scope],
+		})
+	}
+
+	return otlpExportRequest{ResourceSpans: []otlpResourceSpans{resourceSpans}}
+}
+
+func otlpSpanOf(span SpanData) otlpSpan {
+	s := otlpSpan{
+		TraceID:           span.SpanContext.TraceID().String(),
+		SpanID:            span.SpanContext.SpanID().String(),
+		Name:              span.Name,
+		Kind:              int(span.Kind),
+		StartTimeUnixNano: otlpTime(span.StartTime),
+		EndTimeUnixNano:   otlpTime(span.EndTime),
+		Attributes:        otlpKeyValues(span.Attributes),
+	}
+	if span.Parent.HasSpanID() {
+		s.ParentSpanID = span.Parent.SpanID().String()
+	}
+
+	for _, event := range span.Events {
+		s.Events = append(s.Events, otlpEvent{
+			TimeUnixNano: otlpTime(event.Time),
+			Name:         event.Name,
+			Attributes:   otlpKeyValues(event.Attributes),
+		})
+	}
+
+	switch span.Status {
+	case codes.Ok:
+		s.Status = otlpStatus{Code: otlpStatusOK}
+	case codes.Error:
+		s.Status = otlpStatus{Code: otlpStatusError, Message: span.StatusMessage}
+	}
+
+	return s
+}
+
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpKeyValues(attrs []attribute.KeyValue) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, len(attrs))
+	for i, attr := range attrs {
+		kvs[i] = otlpKeyValue{Key: string(attr.Key), Value: otlpValue(attr.Value)}
+	}
+
+	return kvs
+}
+
+func otlpValue(v attribute.Value) otlpAnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return otlpAnyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return otlpAnyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return otlpAnyValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		return otlpArray(v.AsBoolSlice(), func(b bool) attribute.Value { return attribute.BoolValue(b) })
+	case attribute.INT64SLICE:
+		return otlpArray(v.AsInt64Slice(), func(i int64) attribute.Value { return attribute.Int64Value(i) })
+	case attribute.FLOAT64SLICE:
+		return otlpArray(v.AsFloat64Slice(), func(f float64) attribute.Value { return attribute.Float64Value(f) })
+	case attribute.STRINGSLICE:
+		return otlpArray(v.AsStringSlice(), func(s string) attribute.Value { return attribute.StringValue(s) })
+	default:
+		s := v.Emit()
+		return otlpAnyValue{StringValue: &s}
+	}
+}
+
+func otlpArray[T any](values []T, toValue func(T) attribute.Value) otlpAnyValue {
+	array := &otlpArrayValue{Values: make([]otlpAnyValue, len(values))}
+	for i, value := range values {
+		array.Values[i] = otlpValue(toValue(value))
+	}
+
+	return otlpAnyValue{ArrayValue: array}
+}
@@ -0,0 +1,355 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+const (
+	// maxQueuedSpans is the number of ended spans queued for export, the spans
+	// ended while the queue is full being dropped.
+	maxQueuedSpans = 2048
+	// maxExportBatchSize is the maximum number of spans exported at once.
+	maxExportBatchSize = 512
+	// exportInterval is the maximum delay before an ended span is exported.
+	exportInterval = 5 * time.Second
+)
+
+// SpanData is the data of an ended span.
+type SpanData struct {
+	Name          string
+	Scope         string
+	SpanContext   trace.SpanContext
+	Parent        trace.SpanContext
+	Kind          trace.SpanKind
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    []attribute.KeyValue
+	Events        []SpanEvent
+	Status        codes.Code
+	StatusMessage string
+}
+
+// SpanEvent is an event recorded during a span.
+type SpanEvent struct {
+	Name       string
+	Time       time.Time
+	Attributes []attribute.KeyValue
+}
+
+// SpanExporter exports ended spans, e.g. to an OpenTelemetry collector.
+type SpanExporter interface {
+	// ExportSpans exports a batch of spans.
+	ExportSpans(ctx context.Context, spans []SpanData) error
+	// Shutdown releases the resources of the exporter.
+	Shutdown(ctx context.Context) error
+}
+
+var _ trace.TracerProvider = (*TracerProvider)(nil)
+
+// TracerProvider is an OpenTelemetry tracer provider recording the spans of a
+// ratio of the traces, and exporting them in batches in the background.
+type TracerProvider struct {
+	embedded.TracerProvider
+
+	exporter    SpanExporter
+	sampleRatio float64
+
+	queue    chan SpanData
+	done     chan struct{}
+	shutdown sync.Once
+	stopped  chan struct{}
+}
+
+// NewTracerProvider creates a TracerProvider recording the given ratio of the
+// traces, between 0 and 1, and exporting their spans with the exporter.
+func NewTracerProvider(exporter SpanExporter, sampleRatio float64) (*TracerProvider, error) {
+	if sampleRatio < 0 || sampleRatio > 1 {
+		return nil, fmt.Errorf("the tracing sample ratio must be between 0 and 1, got %v", sampleRatio)
+	}
+
+	p := &TracerProvider{
+		exporter:    exporter,
+		sampleRatio: sampleRatio,
+		queue:       make(chan SpanData, maxQueuedSpans),
+		done:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+	go p.exportLoop()
+
+	return p, nil
+}
+
+// Tracer implements trace.TracerProvider.
+func (p *TracerProvider) Tracer(name string, _ ...trace.TracerOption) trace.Tracer {
+	return &tracer{provider: p, scope: name}
+}
+
+// Shutdown exports the spans left and shuts the exporter down. The spans
+// ended afterwards are dropped.
+func (p *TracerProvider) Shutdown(ctx context.Context) error {
+	p.shutdown.Do(func() { close(p.done) })
+
+	select {
+	case <-p.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return p.exporter.Shutdown(ctx)
+}
+
+func (p *TracerProvider) enqueue(span SpanData) {
+	select {
+	case <-p.done:
+	case p.queue <- span:
+	default:
+		// the queue is full, the span is dropped rather than slowing the
+		// execution down.
+	}
+}
+
+func (p *TracerProvider) exportLoop() {
+	defer close(p.stopped)
+
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+
+	batch := make([]SpanData, 0, maxExportBatchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		// export errors are not fatal, the spans are lost.
+		_ = p.exporter.ExportSpans(context.Background(), batch)
+		batch = make([]SpanData, 0, maxExportBatchSize)
+	}
+
+	for {
+		select {
+		case span := <-p.queue:
+			batch = append(batch, span)
+			if len(batch) == maxExportBatchSize {
+				export()
+			}
+
+		case <-ticker.C:
+			export()
+
+		case <-p.done:
+			for {
+				select {
+				case span := <-p.queue:
+					batch = append(batch, span)
+					if len(batch) == maxExportBatchSize {
+						export()
+					}
+				default:
+					export()
+					return
+				}
+			}
+		}
+	}
+}
+
+// sampled returns whether a trace without sampled parent is recorded, based on
+// its ID like the TraceIDRatioBased sampler of the OpenTelemetry SDK.
+func (p *TracerProvider) sampled(traceID trace.TraceID) bool {
+	bound := uint64(p.sampleRatio * (1 << 63))
+	return binary.BigEndian.Uint64(traceID[8:16])>>1 < bound
+}
+
+type tracer struct {
+	embedded.Tracer
+
+	provider *TracerProvider
+	scope    string
+}
+
+// Start implements trace.Tracer.
+func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+
+	var parent trace.SpanContext
+	if !cfg.NewRoot() {
+		parent = trace.SpanContextFromContext(ctx)
+	}
+
+	scCfg := trace.SpanContextConfig{SpanID: newSpanID()}
+	if parent.IsValid() {
+		scCfg.TraceID = parent.TraceID()
+		scCfg.TraceFlags = parent.TraceFlags()
+	} else {
+		scCfg.TraceID = newTraceID()
+		if t.provider.sampled(scCfg.TraceID) {
+			scCfg.TraceFlags = trace.FlagsSampled
+		}
+	}
+	sc := trace.NewSpanContext(scCfg)
+
+	if !sc.IsSampled() {
+		ctx = trace.ContextWithSpanContext(ctx, sc)
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	startTime := cfg.Timestamp()
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+
+	s := &span{
+		tracer: t,
+		data: SpanData{
+			Name:        name,
+			Scope:       t.scope,
+			SpanContext: sc,
+			Parent:      parent,
+			Kind:        cfg.SpanKind(),
+			StartTime:   startTime,
+			Attributes:  cfg.Attributes(),
+		},
+	}
+
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type span struct {
+	embedded.Span
+
+	tracer *tracer
+
+	mu    sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+// End implements trace.Span.
+func (s *span) End(opts ...trace.SpanEndOption) {
+	cfg := trace.NewSpanEndConfig(opts...)
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = cfg.Timestamp()
+	if s.data.EndTime.IsZero() {
+		s.data.EndTime = time.Now()
+	}
+	data := s.data
+	s.mu.Unlock()
+
+	s.tracer.provider.enqueue(data)
+}
+
+// AddEvent implements trace.Span.
+func (s *span) AddEvent(name string, opts ...trace.EventOption) {
+	cfg := trace.NewEventConfig(opts...)
+	eventTime := cfg.Timestamp()
+	if eventTime.IsZero() {
+		eventTime = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ended {
+		s.data.Events = append(s.data.Events, SpanEvent{Name: name, Time: eventTime, Attributes: cfg.Attributes()})
+	}
+}
+
+// AddLink implements trace.Span. Links are not recorded.
+func (s *span) AddLink(trace.Link) {}
+
+// IsRecording implements trace.Span.
+func (s *span) IsRecording() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return !s.ended
+}
+
+// RecordError implements trace.Span.
+func (s *span) RecordError(err error, opts ...trace.EventOption) {
+	if err == nil {
+		return
+	}
+
+	opts = append(opts, trace.WithAttributes(
+		semconv.ExceptionType(reflect.TypeOf(err).String()),
+		semconv.ExceptionMessage(err.Error()),
+	))
+	s.AddEvent(semconv.ExceptionEventName, opts...)
+}
+
+// SpanContext implements trace.Span.
+func (s *span) SpanContext() trace.SpanContext {
+	return s.data.SpanContext
+}
+
+// SetStatus implements trace.Span.
+func (s *span) SetStatus(code codes.Code, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended || code < s.data.Status {
+		// an Ok status overrides an Error status, which overrides an Unset
+		// status.
+		return
+	}
+
+	s.data.Status = code
+	if code == codes.Error {
+		s.data.StatusMessage = description
+	} else {
+		s.data.StatusMessage = ""
+	}
+}
+
+// SetName implements trace.Span.
+func (s *span) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.ended {
+		s.data.Name = name
+	}
+}
+
+// SetAttributes implements trace.Span.
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.ended {
+		s.data.Attributes = append(s.data.Attributes, kv...)
+	}
+}
+
+// TracerProvider implements trace.Span.
+func (s *span) TracerProvider() trace.TracerProvider {
+	return s.tracer.provider
+}
+
+func newTraceID() (id trace.TraceID) {
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() (id trace.SpanID) {
+	_, _ = rand.Read(id[:])
+	return id
+}
@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingConfig defines the configuration options for the tracing of the
+// execution of blocks, txs and msgs with OpenTelemetry.
+type TracingConfig struct {
+	// Enabled enables the tracing.
+	Enabled bool `mapstructure:"enabled"`
+
+	// ServiceName is the name of the service reported with the spans.
+	ServiceName string `mapstructure:"service-name"`
+
+	// OTLPEndpoint is the base URL of the OTLP/HTTP collector the spans are
+	// exported to, e.g. http://localhost:4318.
+	OTLPEndpoint string `mapstructure:"otlp-endpoint"`
+
+	// SampleRatio is the ratio of the traces which are recorded, between 0 and 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+// Tracer returns the OpenTelemetry tracer of the given instrumentation scope,
+// e.g. a package. Its spans are only recorded once a global tracer provider is
+// registered, e.g. by NewTracing.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// NewTracing enables the tracing by registering a global TracerProvider
+// exporting the spans to the configured OTLP endpoint. The returned function
+// exports the spans left and stops the exporter.
+func NewTracing(cfg TracingConfig) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if cfg.OTLPEndpoint == "" {
+		return nil, fmt.Errorf("an OTLP endpoint must be set to enable tracing")
+	}
+
+	provider, err := NewTracerProvider(NewOTLPExporter(cfg.OTLPEndpoint, cfg.ServiceName), cfg.SampleRatio)
+	if err != nil {
+		return nil, err
+	}
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// EndSpan ends the span, recording the error if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,109 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewTracing(t *testing.T) {
+	requests := make(chan otlpExportRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var req otlpExportRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests <- req
+	}))
+	defer server.Close()
+
+	stop, err := NewTracing(TracingConfig{Enabled: true, ServiceName: "simd", OTLPEndpoint: server.URL, SampleRatio: 1})
+	require.NoError(t, err)
+
+	ctx, parent := Tracer("test").Start(context.Background(), "parent")
+	_, child := Tracer("test").Start(ctx, "child")
+	EndSpan(child, errors.New("failure"))
+	EndSpan(parent, nil)
+	require.NoError(t, stop(context.Background()))
+
+	req := <-requests
+	require.Len(t, req.ResourceSpans, 1)
+	require.Contains(t, req.ResourceSpans[0].Resource.Attributes, otlpKeyValue{Key: "service.name", Value: otlpValue(attribute.StringValue("simd"))})
+	require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+
+	scopeSpans := req.ResourceSpans[0].ScopeSpans[0]
+	require.Equal(t, "test", scopeSpans.Scope.Name)
+	require.Len(t, scopeSpans.Spans, 2)
+
+	childSpan, parentSpan := scopeSpans.Spans[0], scopeSpans.Spans[1]
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, "parent", parentSpan.Name)
+	require.Equal(t, parentSpan.TraceID, childSpan.TraceID)
+	require.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	require.Empty(t, parentSpan.ParentSpanID)
+	require.Equal(t, otlpStatus{Code: otlpStatusError, Message: "failure"}, childSpan.Status)
+	require.Equal(t, otlpStatus{}, parentSpan.Status)
+	require.Len(t, childSpan.Events, 1)
+}
+
+func TestNewTracing_InvalidConfig(t *testing.T) {
+	_, err := NewTracing(TracingConfig{Enabled: true, SampleRatio: 1})
+	require.ErrorContains(t, err, "an OTLP endpoint must be set")
+
+	_, err = NewTracing(TracingConfig{Enabled: true, OTLPEndpoint: "http://localhost:4318", SampleRatio: 2})
+	require.ErrorContains(t, err, "sample ratio must be between 0 and 1")
+
+	stop, err := NewTracing(TracingConfig{})
+	require.NoError(t, err)
+	require.NoError(t, stop(context.Background()))
+}
+
+func TestTracerProvider_Sampling(t *testing.T) {
+	exporter := &recordingExporter{}
+	provider, err := NewTracerProvider(exporter, 0)
+	require.NoError(t, err)
+
+	tracer := provider.Tracer("test")
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	require.False(t, parent.IsRecording())
+	_, child := tracer.Start(ctx, "child")
+	require.False(t, child.IsRecording())
+	require.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	child.End()
+	parent.End()
+
+	// the children of a sampled remote parent are recorded.
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	_, sampled := tracer.Start(remote, "sampled")
+	require.True(t, sampled.IsRecording())
+	sampled.End()
+	require.False(t, sampled.IsRecording())
+
+	require.NoError(t, provider.Shutdown(context.Background()))
+	require.Len(t, exporter.spans, 1)
+	require.Equal(t, "sampled", exporter.spans[0].Name)
+	require.Equal(t, trace.SpanID{1}, exporter.spans[0].Parent.SpanID())
+}
+
+type recordingExporter struct {
+	spans []SpanData
+}
+
+func (e *recordingExporter) ExportSpans(_ context.Context, spans []SpanData) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordingExporter) Shutdown(context.Context) error { return nil }
@@ -100,6 +100,34 @@ func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
 
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), withGlobalLabels(labels))
+}
+
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric with global labels (if any) along with the provided labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(keys, val, withGlobalLabels(labels))
+}
+
+// withGlobalLabels returns the given labels followed by the global labels, in
+// a new slice so that the given labels are never modified.
+func withGlobalLabels(labels []metrics.Label) []metrics.Label {
+	all := make([]metrics.Label, 0, len(labels)+len(globalLabels))
+	all = append(all, labels...)
+	return append(all, globalLabels...)
+}
+
 // Now return the current time if telemetry is enabled or a zero time if it's not
 func Now() time.Time {
 	if !IsTelemetryEnabled() {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,24 @@ func TestIsTelemetryEnabled(t *testing.T) {
 	initTelemetry(false)
 	assert.False(t, IsTelemetryEnabled(), "IsTelemetryEnabled() should return false when globalTelemetryEnabled is set to false")
 }
+
+// TestWithGlobalLabels tests that withGlobalLabels does not write into the
+// backing array of the given labels.
+func TestWithGlobalLabels(t *testing.T) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	prevGlobalLabels := globalLabels
+	globalLabels = []metrics.Label{NewLabel("chain_id", "test")}
+	defer func() { globalLabels = prevGlobalLabels }()
+
+	backing := make([]metrics.Label, 1, 2)
+	backing[0] = NewLabel("module", "bank")
+	labels := backing[:1]
+
+	all := withGlobalLabels(labels)
+	assert.Equal(t, []metrics.Label{NewLabel("module", "bank"), NewLabel("chain_id", "test")}, all)
+
+	// the spare capacity of the given labels is left untouched.
+	assert.Equal(t, metrics.Label{}, backing[:2][1])
+}
@@ -0,0 +1,74 @@
+//go:build e2e
+// +build e2e
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+)
+
+func TestFaultInjection(t *testing.T) {
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 4
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	cfg.SnapshotInterval = 5
+
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer net.Cleanup()
+
+	n := net.(*network.Network)
+
+	// faults cannot be injected in the validator serving the network endpoints
+	require.Error(t, n.StopValidator(0))
+	require.Error(t, n.StopValidator(cfg.NumValidators))
+
+	// the network keeps producing blocks with one validator down
+	require.NoError(t, n.StopValidator(1))
+	require.Error(t, n.StopValidator(1))
+	waitForBlocks(t, n, 2)
+	require.NoError(t, n.StartValidator(1))
+	require.Error(t, n.StartValidator(1))
+	require.NoError(t, n.WaitForValidatorSync(1, 30*time.Second))
+
+	require.NoError(t, n.DisconnectValidator(2))
+	waitForBlocks(t, n, 2)
+	require.NoError(t, n.ReconnectValidator(2))
+	require.NoError(t, n.WaitForValidatorSync(2, 30*time.Second))
+
+	_, err = n.WaitForHeight(int64(cfg.SnapshotInterval) + 1)
+	require.NoError(t, err)
+	require.NoError(t, n.RestartValidatorWithStateSync(3))
+	require.NoError(t, n.WaitForValidatorSync(3, 30*time.Second))
+
+	// the validator restored with state sync is required for a quorum
+	require.NoError(t, n.StopValidator(1))
+	waitForBlocks(t, n, 2)
+
+	// the network halts without a quorum
+	require.NoError(t, n.DisconnectValidator(2))
+	height, err := n.LatestHeight()
+	require.NoError(t, err)
+	_, err = n.WaitForHeightWithTimeout(height+2, 5*time.Second)
+	require.Error(t, err)
+
+	require.NoError(t, n.ReconnectValidator(2))
+	require.NoError(t, n.StartValidator(1))
+	waitForBlocks(t, n, 2)
+}
+
+func waitForBlocks(t *testing.T, n *network.Network, blocks int64) {
+	t.Helper()
+
+	height, err := n.LatestHeight()
+	require.NoError(t, err)
+	_, err = n.WaitForHeightWithTimeout(height+blocks, 30*time.Second)
+	require.NoError(t, err)
+}
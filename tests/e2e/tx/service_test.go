@@ -437,6 +437,33 @@ func (s *E2ETestSuite) TestGetTx_GRPC() {
 	}
 }
 
+func (s *E2ETestSuite) TestGetTxMsgResponses_GRPC() {
+	testCases := []struct {
+		name      string
+		req       *tx.GetTxMsgResponsesRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.GetTxMsgResponsesRequest{}, true, "tx hash cannot be empty"},
+		{"request with dummy hash", &tx.GetTxMsgResponsesRequest{Hash: "deadbeef"}, true, "code = NotFound desc = tx not found: deadbeef"},
+		{"good request", &tx.GetTxMsgResponsesRequest{Hash: s.goodTxHash}, false, ""},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			grpcRes, err := s.queryClient.GetTxMsgResponses(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Len(grpcRes.MsgResponses, 1)
+				s.Require().IsType(&banktypes.MsgSendResponse{}, grpcRes.MsgResponses[0].GetCachedValue())
+			}
+		})
+	}
+}
+
 func (s *E2ETestSuite) TestGetTx_GRPCGateway() {
 	val := s.network.GetValidators()[0]
 	testCases := []struct {
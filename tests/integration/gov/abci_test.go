@@ -473,6 +473,140 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestProposalDependenciesAndConflicts(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+	suite.AccountKeeper.SetAccount(ctx, acc)
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	_, err := suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	addr0Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	initialCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	submit := func(ctx sdk.Context, deposit sdk.Coins, dependsOn, conflictsWith []uint64) (uint64, error) {
+		// signaling proposals, without messages, always execute successfully
+		msg, err := v1.NewMsgSubmitProposal(nil, deposit, addr0Str, "metadata", "title", "summary", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		require.NoError(t, err)
+		msg.DependsOn = dependsOn
+		msg.ConflictsWith = conflictsWith
+
+		res, err := govMsgSvr.SubmitProposal(ctx, msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+	advance := func(ctx sdk.Context, d time.Duration) sdk.Context {
+		header := ctx.HeaderInfo()
+		header.Time = header.Time.Add(d)
+		return ctx.WithHeaderInfo(header)
+	}
+	status := func(ctx sdk.Context, id uint64) v1.ProposalStatus {
+		proposal, err := suite.GovKeeper.Proposals.Get(ctx, id)
+		require.NoError(t, err)
+		return proposal.Status
+	}
+
+	// proposal A stays in deposit period until voting on B has started
+	proposalA, err := submit(ctx, initialCoins, nil, nil)
+	require.NoError(t, err)
+
+	// proposal C is submitted first so that B can conflict with it
+	proposalC, err := submit(ctx, initialCoins, nil, nil)
+	require.NoError(t, err)
+
+	// referenced proposals must exist and cannot be referenced twice
+	_, err = submit(ctx, proposalCoins, []uint64{100}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidProposalRelation)
+	_, err = submit(ctx, proposalCoins, []uint64{proposalA}, []uint64{proposalA})
+	require.ErrorIs(t, err, types.ErrInvalidProposalRelation)
+
+	// proposal B depends on A and conflicts with C
+	proposalB, err := submit(ctx, proposalCoins, []uint64{proposalA}, []uint64{proposalC})
+	require.NoError(t, err)
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalB, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	ctx = advance(ctx, time.Hour)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addr0Str, proposalA, proposalCoins))
+	require.NoError(t, err)
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalA, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	ctx = advance(ctx, time.Hour)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addr0Str, proposalC, proposalCoins))
+	require.NoError(t, err)
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalC, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	// B passes before A: its execution is deferred
+	ctx = advance(ctx, *params.VotingPeriod-2*time.Hour)
+	require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+	require.Equal(t, v1.StatusDeferred, status(ctx, proposalB))
+	require.Equal(t, v1.StatusVotingPeriod, status(ctx, proposalA))
+	has, err := suite.GovKeeper.DeferredProposals.Has(ctx, proposalB)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// A passes, then B is executed and C is rejected
+	ctx = advance(ctx, time.Hour)
+	require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+	require.Equal(t, v1.StatusPassed, status(ctx, proposalA))
+	require.Equal(t, v1.StatusPassed, status(ctx, proposalB))
+	require.Equal(t, v1.StatusRejected, status(ctx, proposalC))
+	has, err = suite.GovKeeper.DeferredProposals.Has(ctx, proposalB)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	proposal, err := suite.GovKeeper.Proposals.Get(ctx, proposalC)
+	require.NoError(t, err)
+	require.Contains(t, proposal.FailedReason, "conflicting proposal")
+	_, err = suite.GovKeeper.Votes.Get(ctx, collections.Join(proposalC, addrs[0]))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = suite.GovKeeper.Deposits.Get(ctx, collections.Join(proposalC, addrs[0]))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// C is no longer tallied at the end of its voting period
+	ctx = advance(ctx, time.Hour)
+	require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+	require.Equal(t, v1.StatusRejected, status(ctx, proposalC))
+
+	// new proposals cannot conflict with a passed proposal nor depend on a rejected one
+	_, err = submit(ctx, proposalCoins, nil, []uint64{proposalB})
+	require.ErrorIs(t, err, types.ErrInvalidProposalRelation)
+	_, err = submit(ctx, proposalCoins, []uint64{proposalC}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidProposalRelation)
+
+	// a deferred proposal fails when one of its dependencies is rejected
+	proposalD, err := submit(ctx, proposalCoins, nil, nil)
+	require.NoError(t, err)
+	ctx = advance(ctx, time.Hour)
+	proposalE, err := submit(ctx, proposalCoins, []uint64{proposalD}, nil)
+	require.NoError(t, err)
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalE, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	ctx = advance(ctx, *params.VotingPeriod-time.Hour)
+	require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+	require.Equal(t, v1.StatusRejected, status(ctx, proposalD))
+	require.Equal(t, v1.StatusVotingPeriod, status(ctx, proposalE))
+
+	ctx = advance(ctx, time.Hour)
+	require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+	require.Equal(t, v1.StatusFailed, status(ctx, proposalE))
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -0,0 +1,402 @@
+// Package conformance provides a golden-file based ABCI conformance test kit,
+// detecting the consensus-visible changes of an application, e.g. when a chain
+// upgrades the SDK.
+//
+// A scenario drives the application through a Recorder, which records the
+// requests sent to the application, the consensus-visible parts of its
+// responses and the hashes of its stores after each commit into a golden file.
+// The recorded requests are then replayed against the modified application,
+// whose responses and store hashes must match the recorded ones:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.AssertGolden(t, newApp(t), "scenario.golden", func(r *conformance.Recorder) error {
+//			if _, err := r.InitChain(initChainReq); err != nil {
+//				return err
+//			}
+//			...
+//		})
+//	}
+//
+// The golden files are recorded by running the tests with the -update flag.
+package conformance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// The ABCI methods recorded by a Recorder.
+const (
+	MethodInitChain       = "InitChain"
+	MethodPrepareProposal = "PrepareProposal"
+	MethodProcessProposal = "ProcessProposal"
+	MethodFinalizeBlock   = "FinalizeBlock"
+	MethodCommit          = "Commit"
+)
+
+// App is the part of an ABCI application whose behavior is visible to
+// consensus.
+//
+// When the application exposes its CommitMultiStore, like BaseApp does, the
+// hashes of its stores are recorded after each commit, so that a change is
+// reported with the store it affects.
+type App interface {
+	InitChain(*abci.InitChainRequest) (*abci.InitChainResponse, error)
+	PrepareProposal(*abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error)
+	ProcessProposal(*abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error)
+	FinalizeBlock(*abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error)
+	Commit() (*abci.CommitResponse, error)
+}
+
+// Scenario drives an application through a Recorder.
+type Scenario func(r *Recorder) error
+
+// Step is a recorded ABCI call.
+type Step struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response"`
+	// StoreHashes are the hex-encoded hashes of the stores of the application
+	// by store name, recorded after a commit.
+	StoreHashes map[string]string `json:"store_hashes,omitempty"`
+}
+
+type goldenFile struct {
+	Steps []Step `json:"steps"`
+}
+
+// Recorder records the ABCI calls made to an application.
+type Recorder struct {
+	app   App
+	steps []Step
+}
+
+// NewRecorder creates a Recorder of the calls made to the given application.
+func NewRecorder(app App) *Recorder {
+	return &Recorder{app: app}
+}
+
+// InitChain calls InitChain on the application and records the call.
+func (r *Recorder) InitChain(req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	res, err := r.call(MethodInitChain, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*abci.InitChainResponse), nil
+}
+
+// PrepareProposal calls PrepareProposal on the application and records the
+// call.
+func (r *Recorder) PrepareProposal(req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+	res, err := r.call(MethodPrepareProposal, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*abci.PrepareProposalResponse), nil
+}
+
+// ProcessProposal calls ProcessProposal on the application and records the
+// call.
+func (r *Recorder) ProcessProposal(req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+	res, err := r.call(MethodProcessProposal, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*abci.ProcessProposalResponse), nil
+}
+
+// FinalizeBlock calls FinalizeBlock on the application and records the call.
+func (r *Recorder) FinalizeBlock(req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	res, err := r.call(MethodFinalizeBlock, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*abci.FinalizeBlockResponse), nil
+}
+
+// Commit calls Commit on the application and records the call, along with the
+// hashes of the stores of the application.
+func (r *Recorder) Commit() (*abci.CommitResponse, error) {
+	res, err := r.call(MethodCommit, &abci.CommitRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*abci.CommitResponse), nil
+}
+
+// Steps returns the recorded calls.
+func (r *Recorder) Steps() []Step {
+	return r.steps
+}
+
+// Golden returns the content of the golden file holding the recorded calls.
+func (r *Recorder) Golden() ([]byte, error) {
+	bz, err := json.MarshalIndent(goldenFile{Steps: r.steps}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, '\n'), nil
+}
+
+func (r *Recorder) call(method string, req proto.Message) (proto.Message, error) {
+	res, err := call(r.app, method, req)
+	if err != nil {
+		return nil, fmt.Errorf("step %d (%s): %w", len(r.steps)+1, method, err)
+	}
+
+	step, err := newStep(r.app, method, req, res)
+	if err != nil {
+		return nil, fmt.Errorf("step %d (%s): %w", len(r.steps)+1, method, err)
+	}
+	r.steps = append(r.steps, step)
+
+	return res, nil
+}
+
+// Record runs the scenario against the application and returns the content of
+// the golden file holding the recorded calls.
+func Record(app App, scenario Scenario) ([]byte, error) {
+	r := NewRecorder(app)
+	if err := scenario(r); err != nil {
+		return nil, err
+	}
+
+	return r.Golden()
+}
+
+// Replay replays the calls recorded in a golden file against the application,
+// and returns an error describing the first call whose response or resulting
+// store hashes differ from the recorded ones.
+func Replay(app App, goldenBz []byte) error {
+	var file goldenFile
+	if err := json.Unmarshal(goldenBz, &file); err != nil {
+		return fmt.Errorf("invalid golden file: %w", err)
+	}
+
+	for i, expected := range file.Steps {
+		req, err := newRequest(expected.Method)
+		if err != nil {
+			return fmt.Errorf("step %d: %w", i+1, err)
+		}
+		if err := (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(expected.Request), req); err != nil {
+			return fmt.Errorf("step %d (%s): invalid request: %w", i+1, expected.Method, err)
+		}
+
+		res, err := call(app, expected.Method, req)
+		if err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, expected.Method, err)
+		}
+
+		actual, err := newStep(app, expected.Method, req, res)
+		if err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, expected.Method, err)
+		}
+
+		if err := compareSteps(expected, actual); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, expected.Method, err)
+		}
+	}
+
+	return nil
+}
+
+// AssertGolden replays the calls recorded in the given golden file of the
+// testdata directory against the application, failing the test on the first
+// call whose outcome differs. When the tests are run with the -update flag,
+// the golden file is recorded by running the scenario instead.
+func AssertGolden(t *testing.T, app App, filename string, scenario Scenario) {
+	t.Helper()
+
+	if golden.FlagUpdate() {
+		bz, err := Record(app, scenario)
+		require.NoError(t, err)
+		golden.AssertBytes(t, bz, filename)
+		return
+	}
+
+	require.NoError(t, Replay(app, golden.Get(t, filename)))
+}
+
+func call(app App, method string, req proto.Message) (proto.Message, error) {
+	switch method {
+	case MethodInitChain:
+		return app.InitChain(req.(*abci.InitChainRequest))
+	case MethodPrepareProposal:
+		return app.PrepareProposal(req.(*abci.PrepareProposalRequest))
+	case MethodProcessProposal:
+		return app.ProcessProposal(req.(*abci.ProcessProposalRequest))
+	case MethodFinalizeBlock:
+		return app.FinalizeBlock(req.(*abci.FinalizeBlockRequest))
+	case MethodCommit:
+		return app.Commit()
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+}
+
+func newRequest(method string) (proto.Message, error) {
+	switch method {
+	case MethodInitChain:
+		return &abci.InitChainRequest{}, nil
+	case MethodPrepareProposal:
+		return &abci.PrepareProposalRequest{}, nil
+	case MethodProcessProposal:
+		return &abci.ProcessProposalRequest{}, nil
+	case MethodFinalizeBlock:
+		return &abci.FinalizeBlockRequest{}, nil
+	case MethodCommit:
+		return &abci.CommitRequest{}, nil
+	default:
+		return nil, fmt.Errorf("unknown method %q", method)
+	}
+}
+
+func newStep(app App, method string, req, res proto.Message) (Step, error) {
+	reqBz, err := codec.ProtoMarshalJSON(req, nil)
+	if err != nil {
+		return Step{}, err
+	}
+
+	resBz, err := codec.ProtoMarshalJSON(consensusResponse(res), nil)
+	if err != nil {
+		return Step{}, err
+	}
+
+	step := Step{Method: method, Request: reqBz, Response: resBz}
+	if method == MethodCommit {
+		step.StoreHashes, err = storeHashes(app)
+		if err != nil {
+			return Step{}, err
+		}
+	}
+
+	return step, nil
+}
+
+// consensusResponse returns the part of the response which is visible to
+// consensus. Only the fields of the tx results hashed by CometBFT are kept, and
+// the events are dropped.
+func consensusResponse(res proto.Message) proto.Message {
+	finalizeBlock, ok := res.(*abci.FinalizeBlockResponse)
+	if !ok {
+		return res
+	}
+
+	txResults := make([]*abci.ExecTxResult, len(finalizeBlock.TxResults))
+	for i, txResult := range finalizeBlock.TxResults {
+		txResults[i] = &abci.ExecTxResult{
+			Code:      txResult.Code,
+			Data:      txResult.Data,
+			GasWanted: txResult.GasWanted,
+			GasUsed:   txResult.GasUsed,
+		}
+	}
+
+	return &abci.FinalizeBlockResponse{
+		TxResults:             txResults,
+		ValidatorUpdates:      finalizeBlock.ValidatorUpdates,
+		ConsensusParamUpdates: finalizeBlock.ConsensusParamUpdates,
+		AppHash:               finalizeBlock.AppHash,
+	}
+}
+
+// storeHashes returns the hashes of the stores of the application at the last
+// committed version, if the application exposes them.
+func storeHashes(app App) (map[string]string, error) {
+	cmsApp, ok := app.(interface {
+		CommitMultiStore() storetypes.CommitMultiStore
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	cms := cmsApp.CommitMultiStore()
+	commitInfoStore, ok := cms.(interface {
+		GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	commitInfo, err := commitInfoStore.GetCommitInfo(cms.LastCommitID().Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the store hashes: %w", err)
+	}
+
+	hashes := make(map[string]string, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		hashes[storeInfo.Name] = hex.EncodeToString(storeInfo.CommitId.Hash)
+	}
+
+	return hashes, nil
+}
+
+func compareSteps(expected, actual Step) error {
+	equal, err := jsonEqual(expected.Response, actual.Response)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		return fmt.Errorf("the response differs from the golden file\nexpected: %s\nactual:   %s", compact(expected.Response), compact(actual.Response))
+	}
+
+	var changed []string
+	for name, hash := range expected.StoreHashes {
+		if actual.StoreHashes[name] != hash {
+			changed = append(changed, name)
+		}
+	}
+	for name := range actual.StoreHashes {
+		if _, ok := expected.StoreHashes[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		return fmt.Errorf("the hashes of the stores %v differ from the golden file", changed)
+	}
+
+	return nil
+}
+
+func jsonEqual(a, b json.RawMessage) (bool, error) {
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(va, vb), nil
+}
+
+func compact(bz json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, bz); err != nil {
+		return string(bz)
+	}
+
+	return buf.String()
+}
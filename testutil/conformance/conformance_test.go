@@ -0,0 +1,90 @@
+package conformance_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newApp returns an app writing the given value at the end of each block.
+func newApp(t *testing.T, value []byte) *baseapp.BaseApp {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("test")
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, baseapp.SetChainID("conformance"))
+	app.MountStores(key)
+	app.SetInitChainer(func(ctx sdk.Context, req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+		ctx.KVStore(key).Set([]byte("genesis"), req.AppStateBytes)
+		return &abci.InitChainResponse{}, nil
+	})
+	app.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+		ctx.KVStore(key).Set(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), value)
+		return sdk.EndBlock{}, nil
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	return app
+}
+
+func scenario(r *conformance.Recorder) error {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := r.InitChain(&abci.InitChainRequest{
+		Time:          genesisTime,
+		ChainId:       "conformance",
+		AppStateBytes: []byte("{}"),
+		InitialHeight: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	for height := int64(1); height <= 2; height++ {
+		blockTime := genesisTime.Add(time.Duration(height) * time.Second)
+		if _, err := r.PrepareProposal(&abci.PrepareProposalRequest{Height: height, Time: blockTime, MaxTxBytes: 1 << 20}); err != nil {
+			return err
+		}
+		if _, err := r.ProcessProposal(&abci.ProcessProposalRequest{Height: height, Time: blockTime}); err != nil {
+			return err
+		}
+		if _, err := r.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Time: blockTime}); err != nil {
+			return err
+		}
+		if _, err := r.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAssertGolden(t *testing.T) {
+	conformance.AssertGolden(t, newApp(t, []byte("value")), "scenario.golden", scenario)
+}
+
+func TestRecord(t *testing.T) {
+	bz, err := conformance.Record(newApp(t, []byte("value")), scenario)
+	require.NoError(t, err)
+	require.Equal(t, string(golden.Get(t, "scenario.golden")), string(bz))
+}
+
+func TestReplay(t *testing.T) {
+	goldenBz := golden.Get(t, "scenario.golden")
+	require.NoError(t, conformance.Replay(newApp(t, []byte("value")), goldenBz))
+
+	err := conformance.Replay(newApp(t, []byte("changed")), goldenBz)
+	require.ErrorContains(t, err, "step 4 (FinalizeBlock): the response differs from the golden file")
+
+	err = conformance.Replay(newApp(t, []byte("value")), []byte(`{"steps":[{"method":"Query","request":{}}]}`))
+	require.ErrorContains(t, err, `step 1: unknown method "Query"`)
+}
@@ -0,0 +1,530 @@
+// Package gasgolden provides a golden-file based test harness detecting the
+// changes of the gas consumed by transactions, e.g. when a chain upgrades the
+// SDK, before they break consensus.
+//
+// A Meter measures the gas consumed by each ante and post decorator and by each
+// message of the transactions executed by FinalizeBlock. It is wired into the
+// application under test:
+//
+//	meter := gasgolden.NewMeter()
+//	app.SetAnteDecorators(meter.AnteDecorators(anteDecorators...)...)
+//	app.SetPostHandler(sdk.ChainPostDecorators(meter.PostDecorators(postDecorators...)...))
+//	app.MsgServiceRouter().SetPreMsgHandler(meter.PreMsgHandler)
+//	app.MsgServiceRouter().SetPostMsgHandler(meter.PostMsgHandler)
+//
+// A corpus of canonical transactions is then executed in a block, and the gas
+// they consume is compared with the golden file:
+//
+//	func TestGas(t *testing.T) {
+//		gasgolden.AssertGolden(t, app, meter, "gas.golden", &abci.FinalizeBlockRequest{Height: 2}, txs)
+//	}
+//
+// The golden files are recorded by running the tests with the -update flag.
+package gasgolden
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// App is the part of an application executing blocks.
+type App interface {
+	FinalizeBlock(*abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error)
+	Commit() (*abci.CommitResponse, error)
+}
+
+// Tx is a transaction of a corpus.
+type Tx struct {
+	// Name identifies the transaction in the golden file.
+	Name string
+	// Bytes is the encoded transaction.
+	Bytes []byte
+}
+
+// DecoratorGas is the gas consumed by an ante or post decorator, excluding the
+// gas consumed by the decorators it wraps.
+type DecoratorGas struct {
+	Name string `json:"name"`
+	Gas  uint64 `json:"gas"`
+}
+
+// MsgGas is the gas consumed by the execution of a message. The gas consumed
+// by a message which fails is not measured.
+type MsgGas struct {
+	TypeURL string `json:"type_url"`
+	// Depth is the nesting depth of a message executed by another message, e.g.
+	// 1 for the messages executed by an authz MsgExec.
+	Depth int    `json:"depth,omitempty"`
+	Gas   uint64 `json:"gas"`
+}
+
+// TxGas is the gas consumed by a transaction.
+type TxGas struct {
+	Name      string         `json:"name"`
+	Code      uint32         `json:"code"`
+	GasWanted int64          `json:"gas_wanted"`
+	GasUsed   int64          `json:"gas_used"`
+	Ante      []DecoratorGas `json:"ante,omitempty"`
+	Msgs      []MsgGas       `json:"msgs,omitempty"`
+	Post      []DecoratorGas `json:"post,omitempty"`
+}
+
+// Report is the gas consumed by the transactions of a corpus.
+type Report struct {
+	Txs []TxGas `json:"txs"`
+}
+
+// Golden returns the content of the golden file holding the report.
+func (r Report) Golden() ([]byte, error) {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, '\n'), nil
+}
+
+// Meter measures the gas consumed by the decorators and messages of the
+// transactions executed by FinalizeBlock. The transactions executed in the
+// other modes, e.g. by CheckTx, or speculatively are not measured.
+type Meter struct {
+	mu  sync.Mutex
+	txs map[string]*txGas
+}
+
+// txGas is the gas measured for a transaction, identified by its bytes.
+type txGas struct {
+	ante []DecoratorGas
+	post []DecoratorGas
+	msgs []MsgGas
+	// pendingMsgs are the messages being executed, outermost first.
+	pendingMsgs []pendingMsg
+}
+
+type pendingMsg struct {
+	index   int
+	counter gasCounter
+}
+
+// NewMeter creates a Meter.
+func NewMeter() *Meter {
+	return &Meter{txs: make(map[string]*txGas)}
+}
+
+// AnteDecorators returns the given decorators, measuring the gas they consume.
+func (m *Meter) AnteDecorators(decorators ...sdk.AnteDecorator) []sdk.AnteDecorator {
+	wrapped := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		wrapped[i] = anteDecorator{meter: m, name: decoratorName(decorator), decorator: decorator}
+	}
+
+	return wrapped
+}
+
+// PostDecorators returns the given decorators, measuring the gas they consume.
+func (m *Meter) PostDecorators(decorators ...sdk.PostDecorator) []sdk.PostDecorator {
+	wrapped := make([]sdk.PostDecorator, len(decorators))
+	for i, decorator := range decorators {
+		wrapped[i] = postDecorator{meter: m, name: decoratorName(decorator), decorator: decorator}
+	}
+
+	return wrapped
+}
+
+// PreMsgHandler starts measuring the gas consumed by a message. It must be set
+// as the PreMsgHandler of the MsgServiceRouter.
+func (m *Meter) PreMsgHandler(ctx sdk.Context, msg sdk.Msg) error {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || sdk.InvalidateSpeculation(ctx) {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := m.tx(ctx.TxBytes())
+	pending := pendingMsg{index: len(tx.msgs)}
+	pending.counter.start(ctx)
+	tx.msgs = append(tx.msgs, MsgGas{TypeURL: sdk.MsgTypeURL(msg), Depth: len(tx.pendingMsgs)})
+	tx.pendingMsgs = append(tx.pendingMsgs, pending)
+
+	return nil
+}
+
+// PostMsgHandler records the gas consumed by a message. It must be set as the
+// PostMsgHandler of the MsgServiceRouter.
+func (m *Meter) PostMsgHandler(ctx sdk.Context, _ sdk.Msg, _ proto.Message) error {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || sdk.InvalidateSpeculation(ctx) {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := m.tx(ctx.TxBytes())
+	if len(tx.pendingMsgs) == 0 {
+		return nil
+	}
+
+	pending := tx.pendingMsgs[len(tx.pendingMsgs)-1]
+	tx.pendingMsgs = tx.pendingMsgs[:len(tx.pendingMsgs)-1]
+	pending.counter.pause(ctx)
+	tx.msgs[pending.index].Gas = pending.counter.total
+
+	return nil
+}
+
+// Run executes the transactions in a block, whose other fields are set by the
+// given request, commits it and returns the gas consumed by the transactions.
+func (m *Meter) Run(app App, block *abci.FinalizeBlockRequest, txs []Tx) (Report, error) {
+	names := make(map[string]bool, len(txs))
+	txNames := make(map[string]string, len(txs))
+	rawTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		if names[tx.Name] {
+			return Report{}, fmt.Errorf("duplicate tx %s", tx.Name)
+		}
+		names[tx.Name] = true
+
+		// the gas is measured by tx bytes.
+		if name, ok := txNames[string(tx.Bytes)]; ok {
+			return Report{}, fmt.Errorf("txs %s and %s are identical", name, tx.Name)
+		}
+		txNames[string(tx.Bytes)] = tx.Name
+
+		rawTxs[i] = tx.Bytes
+	}
+
+	req := *block
+	req.Txs = rawTxs
+
+	m.mu.Lock()
+	m.txs = make(map[string]*txGas)
+	m.mu.Unlock()
+
+	res, err := app.FinalizeBlock(&req)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if len(res.TxResults) != len(txs) {
+		return Report{}, fmt.Errorf("expected %d tx results, got %d", len(txs), len(res.TxResults))
+	}
+
+	if _, err := app.Commit(); err != nil {
+		return Report{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	report := Report{Txs: make([]TxGas, len(txs))}
+	for i, tx := range txs {
+		measured := m.tx(tx.Bytes)
+		report.Txs[i] = TxGas{
+			Name:      tx.Name,
+			Code:      res.TxResults[i].Code,
+			GasWanted: res.TxResults[i].GasWanted,
+			GasUsed:   res.TxResults[i].GasUsed,
+			Ante:      measured.ante,
+			Msgs:      measured.msgs,
+			Post:      measured.post,
+		}
+	}
+
+	return report, nil
+}
+
+// AssertGolden executes the transactions in a block, whose other fields are
+// set by the given request, and fails the test if the gas they consume differs
+// from the given golden file of the testdata directory. When the tests are run
+// with the -update flag, the golden file is recorded instead.
+func AssertGolden(t *testing.T, app App, meter *Meter, filename string, block *abci.FinalizeBlockRequest, txs []Tx) {
+	t.Helper()
+
+	report, err := meter.Run(app, block, txs)
+	require.NoError(t, err)
+
+	bz, err := report.Golden()
+	require.NoError(t, err)
+
+	if golden.FlagUpdate() {
+		golden.AssertBytes(t, bz, filename)
+		return
+	}
+
+	var expected Report
+	require.NoError(t, json.Unmarshal(golden.Get(t, filename), &expected), "invalid golden file")
+
+	if diffs := Diff(expected, report); len(diffs) > 0 {
+		t.Fatalf("the gas consumed differs from the golden file %s:\n%s", filename, strings.Join(diffs, "\n"))
+	}
+}
+
+// Diff returns the differences between the expected and actual reports, one
+// per line, or nil if they are equal.
+func Diff(expected, actual Report) []string {
+	var diffs []string
+
+	actualTxs := make(map[string]TxGas, len(actual.Txs))
+	for _, tx := range actual.Txs {
+		actualTxs[tx.Name] = tx
+	}
+
+	expectedTxs := make(map[string]bool, len(expected.Txs))
+	for _, e := range expected.Txs {
+		expectedTxs[e.Name] = true
+
+		a, ok := actualTxs[e.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("tx %s: missing", e.Name))
+			continue
+		}
+
+		if e.Code != a.Code {
+			diffs = append(diffs, fmt.Sprintf("tx %s: code %d -> %d", e.Name, e.Code, a.Code))
+		}
+		if e.GasWanted != a.GasWanted {
+			diffs = append(diffs, fmt.Sprintf("tx %s: gas wanted %s", e.Name, gasChange(uint64(e.GasWanted), uint64(a.GasWanted))))
+		}
+		if e.GasUsed != a.GasUsed {
+			diffs = append(diffs, fmt.Sprintf("tx %s: gas used %s", e.Name, gasChange(uint64(e.GasUsed), uint64(a.GasUsed))))
+		}
+
+		diffs = append(diffs, diffDecorators(e.Name, "ante", e.Ante, a.Ante)...)
+		diffs = append(diffs, diffMsgs(e.Name, e.Msgs, a.Msgs)...)
+		diffs = append(diffs, diffDecorators(e.Name, "post", e.Post, a.Post)...)
+	}
+
+	for _, a := range actual.Txs {
+		if !expectedTxs[a.Name] {
+			diffs = append(diffs, fmt.Sprintf("tx %s: not in the golden file", a.Name))
+		}
+	}
+
+	return diffs
+}
+
+func diffDecorators(txName, kind string, expected, actual []DecoratorGas) []string {
+	if !sameNames(expected, actual, func(d DecoratorGas) string { return d.Name }) {
+		return []string{fmt.Sprintf("tx %s: %s decorators %v -> %v", txName, kind, decoratorNames(expected), decoratorNames(actual))}
+	}
+
+	var diffs []string
+	for i := range expected {
+		if expected[i].Gas != actual[i].Gas {
+			diffs = append(diffs, fmt.Sprintf("tx %s: %s decorator %s: gas %s", txName, kind, expected[i].Name, gasChange(expected[i].Gas, actual[i].Gas)))
+		}
+	}
+
+	return diffs
+}
+
+func diffMsgs(txName string, expected, actual []MsgGas) []string {
+	msgKey := func(m MsgGas) string { return fmt.Sprintf("%s@%d", m.TypeURL, m.Depth) }
+	if !sameNames(expected, actual, msgKey) {
+		return []string{fmt.Sprintf("tx %s: msgs %v -> %v", txName, msgTypeURLs(expected), msgTypeURLs(actual))}
+	}
+
+	var diffs []string
+	for i := range expected {
+		if expected[i].Gas != actual[i].Gas {
+			diffs = append(diffs, fmt.Sprintf("tx %s: msg %d (%s): gas %s", txName, i, expected[i].TypeURL, gasChange(expected[i].Gas, actual[i].Gas)))
+		}
+	}
+
+	return diffs
+}
+
+func sameNames[T any](expected, actual []T, name func(T) string) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	for i := range expected {
+		if name(expected[i]) != name(actual[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func decoratorNames(decorators []DecoratorGas) []string {
+	names := make([]string, len(decorators))
+	for i, d := range decorators {
+		names[i] = d.Name
+	}
+
+	return names
+}
+
+func msgTypeURLs(msgs []MsgGas) []string {
+	typeURLs := make([]string, len(msgs))
+	for i, m := range msgs {
+		typeURLs[i] = m.TypeURL
+	}
+
+	return typeURLs
+}
+
+func gasChange(expected, actual uint64) string {
+	return fmt.Sprintf("%d -> %d (%+d)", expected, actual, int64(actual-expected))
+}
+
+// tx returns the gas measured for the transaction with the given bytes. The
+// caller must hold the lock.
+func (m *Meter) tx(txBytes []byte) *txGas {
+	tx, ok := m.txs[string(txBytes)]
+	if !ok {
+		tx = &txGas{}
+		m.txs[string(txBytes)] = tx
+	}
+
+	return tx
+}
+
+// startDecorator records a decorator of a transaction and returns its index.
+func (m *Meter) startDecorator(txBytes []byte, post bool, name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := m.tx(txBytes)
+	if post {
+		tx.post = append(tx.post, DecoratorGas{Name: name})
+		return len(tx.post) - 1
+	}
+
+	tx.ante = append(tx.ante, DecoratorGas{Name: name})
+	return len(tx.ante) - 1
+}
+
+// endDecorator records the gas consumed by a decorator of a transaction.
+func (m *Meter) endDecorator(txBytes []byte, post bool, index int, gas uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := m.tx(txBytes)
+	if post {
+		tx.post[index].Gas = gas
+		return
+	}
+
+	tx.ante[index].Gas = gas
+}
+
+type anteDecorator struct {
+	meter     *Meter
+	name      string
+	decorator sdk.AnteDecorator
+}
+
+func (d anteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || sdk.InvalidateSpeculation(ctx) {
+		return d.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	txBytes := ctx.TxBytes()
+	index := d.meter.startDecorator(txBytes, false, d.name)
+
+	var counter gasCounter
+	counter.start(ctx)
+	newCtx, err := d.decorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		counter.pause(ctx)
+		newCtx, err := next(ctx, tx, simulate)
+		counter.start(newCtx)
+		return newCtx, err
+	})
+	counter.pause(newCtx)
+
+	d.meter.endDecorator(txBytes, false, index, counter.total)
+
+	return newCtx, err
+}
+
+type postDecorator struct {
+	meter     *Meter
+	name      string
+	decorator sdk.PostDecorator
+}
+
+func (d postDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || sdk.InvalidateSpeculation(ctx) {
+		return d.decorator.PostHandle(ctx, tx, simulate, success, next)
+	}
+
+	txBytes := ctx.TxBytes()
+	index := d.meter.startDecorator(txBytes, true, d.name)
+
+	var counter gasCounter
+	counter.start(ctx)
+	newCtx, err := d.decorator.PostHandle(ctx, tx, simulate, success, func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		counter.pause(ctx)
+		newCtx, err := next(ctx, tx, simulate, success)
+		counter.start(newCtx)
+		return newCtx, err
+	})
+	counter.pause(newCtx)
+
+	d.meter.endDecorator(txBytes, true, index, counter.total)
+
+	return newCtx, err
+}
+
+// decoratorName returns the fully qualified name of the type of a decorator,
+// e.g. "cosmossdk.io/x/auth/ante.DeductFeeDecorator".
+func decoratorName(decorator any) string {
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.PkgPath() == "" || t.Name() == "" {
+		return strings.TrimLeft(t.String(), "*")
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
+// gasCounter counts the gas consumed between its starts and pauses, following
+// the gas meter of the context when it is replaced, e.g. by the decorator
+// setting up the gas meter of the transaction.
+type gasCounter struct {
+	meter    storetypes.GasMeter
+	consumed uint64
+	total    uint64
+}
+
+func (c *gasCounter) start(ctx sdk.Context) {
+	c.meter = ctx.GasMeter()
+	if c.meter != nil {
+		c.consumed = c.meter.GasConsumed()
+	}
+}
+
+func (c *gasCounter) pause(ctx sdk.Context) {
+	meter := ctx.GasMeter()
+	if c.meter == nil || meter == nil {
+		return
+	}
+
+	if meter != c.meter {
+		// the gas meter was replaced while counting.
+		c.total += meter.GasConsumed()
+	} else {
+		c.total += meter.GasConsumed() - c.consumed
+	}
+	c.meter = nil
+}
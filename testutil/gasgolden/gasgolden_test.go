@@ -0,0 +1,184 @@
+package gasgolden_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/gasgolden"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setUpGasDecorator sets the gas meter of the tx and consumes a fixed amount
+// of gas from it.
+type setUpGasDecorator struct{}
+
+func (setUpGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(tx.(sdk.FeeTx).GetGas()))
+	ctx.GasMeter().ConsumeGas(100, "set up")
+	return next(ctx, tx, simulate)
+}
+
+// consumeGasDecorator consumes gas before and after the decorators it wraps.
+type consumeGasDecorator struct {
+	before, after uint64
+}
+
+func (d consumeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(d.before, "before")
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	newCtx.GasMeter().ConsumeGas(d.after, "after")
+	return newCtx, nil
+}
+
+type consumeGasPostDecorator struct{}
+
+func (consumeGasPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(7, "post")
+	return next(ctx, tx, simulate, success)
+}
+
+// keyValueServer consumes 10 gas per byte of value, and fails on the "fail"
+// value.
+type keyValueServer struct{}
+
+func (keyValueServer) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(uint64(len(msg.Value))*10, "set")
+	if string(msg.Value) == "fail" {
+		return nil, errors.New("failed")
+	}
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+func setup(t *testing.T) (*baseapp.BaseApp, *gasgolden.Meter, []gasgolden.Tx) {
+	t.Helper()
+
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder())
+	app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+	app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
+	app.MountStores(storetypes.NewKVStoreKey("test"))
+	baseapptestutil.RegisterKeyValueServer(app.MsgServiceRouter(), keyValueServer{})
+
+	meter := gasgolden.NewMeter()
+	app.SetAnteDecorators(meter.AnteDecorators(setUpGasDecorator{}, consumeGasDecorator{before: 10, after: 5})...)
+	app.SetPostHandler(sdk.ChainPostDecorators(meter.PostDecorators(consumeGasPostDecorator{})...))
+	app.MsgServiceRouter().SetPreMsgHandler(meter.PreMsgHandler)
+	app.MsgServiceRouter().SetPostMsgHandler(meter.PostMsgHandler)
+	require.NoError(t, app.LoadLatestVersion())
+
+	signer, err := signingCtx.AddressCodec().BytesToString(sdk.AccAddress("signer"))
+	require.NoError(t, err)
+
+	newTx := func(name string, values ...string) gasgolden.Tx {
+		msgs := make([]sdk.Msg, len(values))
+		for i, value := range values {
+			msgs[i] = &baseapptestutil.MsgKeyValue{Key: []byte(name), Value: []byte(value), Signer: signer}
+		}
+
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetGasLimit(100_000)
+
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		return gasgolden.Tx{Name: name, Bytes: bz}
+	}
+
+	return app, meter, []gasgolden.Tx{
+		newTx("single", "value"),
+		newTx("multi", "a", "abc"),
+		newTx("failing", "ok", "fail"),
+	}
+}
+
+func TestRun(t *testing.T) {
+	app, meter, txs := setup(t)
+
+	report, err := meter.Run(app, &abci.FinalizeBlockRequest{Height: 1}, txs)
+	require.NoError(t, err)
+	require.Len(t, report.Txs, 3)
+
+	ante := []gasgolden.DecoratorGas{
+		{Name: "github.com/cosmos/cosmos-sdk/testutil/gasgolden_test.setUpGasDecorator", Gas: 100},
+		{Name: "github.com/cosmos/cosmos-sdk/testutil/gasgolden_test.consumeGasDecorator", Gas: 15},
+	}
+	post := []gasgolden.DecoratorGas{
+		{Name: "github.com/cosmos/cosmos-sdk/testutil/gasgolden_test.consumeGasPostDecorator", Gas: 7},
+	}
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})
+
+	single := report.Txs[0]
+	require.Equal(t, "single", single.Name)
+	require.Equal(t, uint32(0), single.Code)
+	require.Equal(t, int64(100_000), single.GasWanted)
+	require.Equal(t, int64(100+15+50+7), single.GasUsed)
+	require.Equal(t, ante, single.Ante)
+	require.Equal(t, []gasgolden.MsgGas{{TypeURL: msgTypeURL, Gas: 50}}, single.Msgs)
+	require.Equal(t, post, single.Post)
+
+	multi := report.Txs[1]
+	require.Equal(t, int64(100+15+10+30+7), multi.GasUsed)
+	require.Equal(t, []gasgolden.MsgGas{{TypeURL: msgTypeURL, Gas: 10}, {TypeURL: msgTypeURL, Gas: 30}}, multi.Msgs)
+
+	// the gas of the failing message is not measured.
+	failing := report.Txs[2]
+	require.NotEqual(t, uint32(0), failing.Code)
+	require.Equal(t, []gasgolden.MsgGas{{TypeURL: msgTypeURL, Gas: 20}, {TypeURL: msgTypeURL}}, failing.Msgs)
+
+	// the txs of a corpus must be distinct.
+	_, err = meter.Run(app, &abci.FinalizeBlockRequest{Height: 2}, []gasgolden.Tx{txs[0], txs[0]})
+	require.ErrorContains(t, err, "duplicate tx single")
+
+	_, err = meter.Run(app, &abci.FinalizeBlockRequest{Height: 2}, []gasgolden.Tx{txs[0], {Name: "copy", Bytes: txs[0].Bytes}})
+	require.ErrorContains(t, err, "txs single and copy are identical")
+}
+
+func TestAssertGolden(t *testing.T) {
+	app, meter, txs := setup(t)
+	gasgolden.AssertGolden(t, app, meter, "gas.golden", &abci.FinalizeBlockRequest{Height: 1}, txs)
+}
+
+func TestDiff(t *testing.T) {
+	app, meter, txs := setup(t)
+
+	expected, err := meter.Run(app, &abci.FinalizeBlockRequest{Height: 1}, txs)
+	require.NoError(t, err)
+	require.Empty(t, gasgolden.Diff(expected, expected))
+
+	actual, err := meter.Run(app, &abci.FinalizeBlockRequest{Height: 2}, txs)
+	require.NoError(t, err)
+	require.Empty(t, gasgolden.Diff(expected, actual))
+
+	actual.Txs[0].GasUsed += 3
+	actual.Txs[0].Ante[1].Gas += 3
+	actual.Txs[1].Msgs = actual.Txs[1].Msgs[:1]
+	actual.Txs[2].Name = "renamed"
+
+	require.Equal(t, []string{
+		"tx single: gas used 172 -> 175 (+3)",
+		"tx single: ante decorator github.com/cosmos/cosmos-sdk/testutil/gasgolden_test.consumeGasDecorator: gas 15 -> 18 (+3)",
+		"tx multi: msgs [/MsgKeyValue /MsgKeyValue] -> [/MsgKeyValue]",
+		"tx failing: missing",
+		"tx renamed: not in the golden file",
+	}, gasgolden.Diff(expected, actual))
+}
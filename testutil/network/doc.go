@@ -19,6 +19,13 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Faults can be injected in the other validators to test the liveness of an
+application: they can be stopped and started again (StopValidator,
+StartValidator), disconnected from their peers (DisconnectValidator,
+ReconnectValidator) or restored with state sync when the network is configured
+with a SnapshotInterval (RestartValidatorWithStateSync). Latency and dropped
+messages can be injected in all the connections with the P2PFuzz configuration.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
@@ -0,0 +1,258 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// The functions below inject faults in the network, to test how an application
+// behaves when validators crash, are partitioned from the network or catch up
+// with state sync. Latency and dropped messages can be injected in all the
+// validator connections with the P2PFuzz configuration.
+//
+// Faults cannot be injected in the first validator, as it serves the RPC, API
+// and gRPC endpoints used by the network.
+
+// StopValidator stops the CometBFT node and the application of the validator
+// at the given index, as if it crashed. Its data is kept, so that it can be
+// started again with StartValidator.
+func (n *Network) StopValidator(i int) error {
+	val, err := n.faultyValidator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode == nil {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	val.cancelFn()
+	if err := val.errGroup.Wait(); err != nil {
+		n.Logger.Log("unexpected error waiting for validator gRPC and API processes to exit", "err", err)
+	}
+
+	if val.tmNode.IsRunning() {
+		if err := val.tmNode.Stop(); err != nil {
+			return fmt.Errorf("failed to stop validator %d CometBFT node: %w", i, err)
+		}
+	}
+	val.tmNode = nil
+
+	if err := val.app.Close(); err != nil {
+		return fmt.Errorf("failed to stop validator %d ABCI application: %w", i, err)
+	}
+	val.app = nil
+
+	n.Logger.Log("stopped validator", i)
+	return nil
+}
+
+// StartValidator starts the validator at the given index, stopped with
+// StopValidator. A new application is created, which replays the blocks
+// stored by the node before catching up with the network.
+func (n *Network) StartValidator(i int) error {
+	val, err := n.faultyValidator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode != nil {
+		return fmt.Errorf("validator %d is already running", i)
+	}
+
+	if err := startInProcess(n.Config, val); err != nil {
+		return fmt.Errorf("failed to start validator %d: %w", i, err)
+	}
+
+	n.Logger.Log("started validator", i)
+	return nil
+}
+
+// RestartValidator stops and starts again the validator at the given index.
+func (n *Network) RestartValidator(i int) error {
+	if err := n.StopValidator(i); err != nil {
+		return err
+	}
+
+	return n.StartValidator(i)
+}
+
+// RestartValidatorWithStateSync stops the validator at the given index, wipes
+// its blocks and state and starts it again, restoring the state of the network with state
+// sync from the snapshots of the other validators. The network must have been
+// created with a positive SnapshotInterval and at least one snapshot must have
+// been taken. The private validator state is kept, so that the validator does
+// not sign conflicting votes.
+func (n *Network) RestartValidatorWithStateSync(i int) error {
+	if n.Config.SnapshotInterval == 0 {
+		return errors.New("state sync requires a positive snapshot interval")
+	}
+
+	if err := n.StopValidator(i); err != nil {
+		return err
+	}
+
+	// trust the latest block of the first validator
+	rpcServer := n.Validators[0]
+	block, err := rpcServer.rPCClient.Block(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get trusted block: %w", err)
+	}
+
+	val := n.Validators[i]
+	cmtCfg := val.ctx.Config
+
+	if err := val.resetDBs(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Dir(cmtCfg.Consensus.WalFile())); err != nil {
+		return err
+	}
+
+	cmtCfg.StateSync.Enable = true
+	// the light client requires two RPC servers, which may be the same
+	cmtCfg.StateSync.RPCServers = []string{rpcServer.rPCAddress, rpcServer.rPCAddress}
+	cmtCfg.StateSync.TrustHeight = block.Block.Height
+	cmtCfg.StateSync.TrustHash = block.BlockID.Hash.String()
+	cmtCfg.StateSync.DiscoveryTime = 5 * time.Second
+
+	return n.StartValidator(i)
+}
+
+// DisconnectValidator drops the connections of the validator at the given
+// index to its peers and rejects new ones, until ReconnectValidator is called.
+// The validator keeps running, but can neither receive nor propose blocks.
+func (n *Network) DisconnectValidator(i int) error {
+	val, err := n.faultyValidator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode == nil {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	val.disconnected.Store(true)
+
+	sw := val.tmNode.Switch()
+	for _, peer := range sw.Peers().Copy() {
+		sw.StopPeerGracefully(peer)
+	}
+
+	n.Logger.Log("disconnected validator", i)
+	return nil
+}
+
+// ReconnectValidator reconnects the validator at the given index, disconnected
+// with DisconnectValidator, to its peers.
+func (n *Network) ReconnectValidator(i int) error {
+	val, err := n.faultyValidator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode == nil {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	val.disconnected.Store(false)
+
+	peers := val.ctx.Config.P2P.PersistentPeers
+	if peers != "" {
+		if err := val.tmNode.Switch().DialPeersAsync(strings.Split(peers, ",")); err != nil {
+			return err
+		}
+	}
+
+	n.Logger.Log("reconnected validator", i)
+	return nil
+}
+
+// WaitForValidatorSync waits for the validator at the given index to catch up
+// with the latest height of the network, e.g. after it is started again or
+// reconnected, returning an error if it does not within the given timeout.
+func (n *Network) WaitForValidatorSync(i int, timeout time.Duration) error {
+	val, err := n.faultyValidator(i)
+	if err != nil {
+		return err
+	}
+
+	height, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return fmt.Errorf("timeout exceeded waiting for validator %d to reach height %d", i, height)
+		case <-ticker.C:
+			if val.tmNode != nil && val.tmNode.BlockStore().Height() >= height && !val.tmNode.ConsensusReactor().WaitSync() {
+				return nil
+			}
+		}
+	}
+}
+
+// faultyValidator returns the validator at the given index, if faults can be
+// injected in it.
+func (n *Network) faultyValidator(i int) (*Validator, error) {
+	if i <= 0 || i >= len(n.Validators) {
+		return nil, fmt.Errorf("cannot inject faults in validator %d, valid indexes are 1 to %d", i, len(n.Validators)-1)
+	}
+
+	return n.Validators[i], nil
+}
+
+// SnapshotOption returns the BaseApp option setting up the state sync snapshots
+// of the given validator, or a no-op option when its snapshot interval is zero.
+// Application constructors must use it for RestartValidatorWithStateSync to
+// work.
+func SnapshotOption(val ValidatorI) func(*baseapp.BaseApp) {
+	cfg := val.GetAppConfig().StateSync
+	if cfg.SnapshotInterval == 0 {
+		return func(*baseapp.BaseApp) {}
+	}
+
+	store, err := snapshots.NewStore(dbm.NewMemDB(), filepath.Join(val.GetCtx().Config.RootDir, "data", "snapshots"))
+	if err != nil {
+		panic(err)
+	}
+
+	return baseapp.SetSnapshot(store, snapshottypes.NewSnapshotOptions(cfg.SnapshotInterval, cfg.SnapshotKeepRecent))
+}
+
+// peerFilterApp rejects all the peers of a disconnected validator through the
+// ABCI peer filtering queries.
+type peerFilterApp struct {
+	abci.Application
+
+	val *Validator
+}
+
+func (app peerFilterApp) Query(ctx context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	if app.val.disconnected.Load() && strings.HasPrefix(req.Path, "/p2p/filter/") {
+		return &abci.QueryResponse{Code: 1, Log: "validator is disconnected"}, nil
+	}
+
+	return app.Application.Query(ctx, req)
+}
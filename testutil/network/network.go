@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 
@@ -128,6 +129,8 @@ type Config struct {
 	APIAddress       string                     // REST API listen address (including port)
 	GRPCAddress      string                     // GRPC server listen address (including port)
 	PrintMnemonic    bool                       // print the mnemonic of first validator as log output for testing
+	SnapshotInterval uint64                     // the state sync snapshot interval of each validator, see SnapshotOption
+	P2PFuzz          *cmtcfg.FuzzConnConfig     // if set, injects latency and drops messages in the validator connections
 
 	// Address codecs
 	AddressCodec          address.Codec                 // address codec
@@ -234,6 +237,7 @@ func DefaultConfigWithAppConfig(appConfig depinject.Config) (Config, error) {
 			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
 			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
 			baseapp.SetChainID(cfg.ChainID),
+			SnapshotOption(val),
 		)
 
 		testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -339,6 +343,10 @@ func New(l Logger, baseDir string, cfg Config) (NetworkI, error) {
 		appCfg.API.Enable = true
 		appCfg.API.Swagger = false
 		appCfg.Telemetry.Enabled = false
+		appCfg.StateSync.SnapshotInterval = cfg.SnapshotInterval
+		// all the snapshots are kept, so that they are not pruned while being
+		// fetched by a validator restarted with state sync.
+		appCfg.StateSync.SnapshotKeepRecent = 0
 
 		ctx := server.NewDefaultContext()
 		cmtCfg := ctx.Config
@@ -431,6 +439,13 @@ func New(l Logger, baseDir string, cfg Config) (NetworkI, error) {
 		cmtCfg.P2P.ListenAddress = p2pAddr
 		cmtCfg.P2P.AddrBookStrict = false
 		cmtCfg.P2P.AllowDuplicateIP = true
+		if cfg.P2PFuzz != nil {
+			cmtCfg.P2P.TestFuzz = true
+			cmtCfg.P2P.TestFuzzConfig = cfg.P2PFuzz
+		}
+		// peers are filtered through the application while the validator is
+		// disconnected, see Network.DisconnectValidator.
+		cmtCfg.FilterPeers = true
 
 		var mnemonic string
 		if i < len(cfg.Mnemonics) {
@@ -796,6 +811,8 @@ func (n *Network) Cleanup() {
 				n.Logger.Log("failed to stop validator ABCI application", "err", err)
 			}
 		}
+
+		v.closeDBs()
 	}
 
 	time.Sleep(100 * time.Millisecond)
@@ -8,7 +8,6 @@ import (
 	"os"
 	"path/filepath"
 
-	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -63,7 +62,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		return node.ChecksummedGenesisDoc{GenesisDoc: gen, Sha256Checksum: make([]byte, 0)}, nil
 	}
 
-	cmtApp := server.NewCometABCIWrapper(app)
+	cmtApp := peerFilterApp{Application: server.NewCometABCIWrapper(app), val: val}
 	tmNode, err := node.NewNode( //resleak:notresource
 		context.TODO(),
 		cmtCfg,
@@ -71,7 +70,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		nodeKey,
 		proxy.NewLocalClientCreator(cmtApp),
 		appGenesisProvider,
-		cmtcfg.DefaultDBProvider,
+		val.dbProvider,
 		node.DefaultMetricsProvider(cmtCfg.Instrumentation),
 		servercmtlog.CometLoggerWrapper{Logger: logger.With("module", val.moniker)},
 	)
@@ -3,7 +3,10 @@ package network
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 
+	cmtdbm "github.com/cometbft/cometbft-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	cmtclient "github.com/cometbft/cometbft/rpc/client"
 	"golang.org/x/sync/errgroup"
@@ -43,6 +46,12 @@ type Validator struct {
 	grpcWeb  *http.Server
 	errGroup *errgroup.Group
 	cancelFn context.CancelFunc
+
+	// dbs are the databases of the CometBFT node by name, see dbProvider.
+	dbs map[string]cmtdbm.DB
+	// disconnected is set while the validator is disconnected from its peers,
+	// see Network.DisconnectValidator.
+	disconnected atomic.Bool
 }
 
 var _ ValidatorI = &Validator{}
@@ -82,3 +91,71 @@ func (v *Validator) GetPubKey() cryptotypes.PubKey {
 func (v *Validator) GetMoniker() string {
 	return v.moniker
 }
+
+// dbProvider opens the CometBFT node databases. They are kept open when the
+// node is stopped and reused when it is started again, as goroutines of the
+// stopped node may still read them.
+func (v *Validator) dbProvider(ctx *cmtcfg.DBContext) (cmtdbm.DB, error) {
+	db, ok := v.dbs[ctx.ID]
+	if !ok {
+		var err error
+		db, err = cmtcfg.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.dbs == nil {
+			v.dbs = make(map[string]cmtdbm.DB)
+		}
+		v.dbs[ctx.ID] = db
+	}
+
+	return nopCloseDB{db}, nil
+}
+
+// resetDBs deletes all the data of the CometBFT node databases.
+func (v *Validator) resetDBs() error {
+	for _, db := range v.dbs {
+		it, err := db.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		batch := db.NewBatch()
+		for ; it.Valid(); it.Next() {
+			if err := batch.Delete(it.Key()); err != nil {
+				it.Close()
+				return err
+			}
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+
+		if err := batch.WriteSync(); err != nil {
+			return err
+		}
+		if err := batch.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeDBs closes the CometBFT node databases.
+func (v *Validator) closeDBs() {
+	for _, db := range v.dbs {
+		_ = db.Close()
+	}
+	v.dbs = nil
+}
+
+// nopCloseDB is a database which is not closed by the CometBFT node.
+type nopCloseDB struct {
+	cmtdbm.DB
+}
+
+func (nopCloseDB) Close() error {
+	return nil
+}
@@ -0,0 +1,124 @@
+package testdata
+
+import (
+	"math/big"
+
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// The generators below draw the core types of the SDK for property-based tests
+// written with rapid, e.g. against keeper logic. Their values are drawn from
+// byte slices and small integers, so that rapid shrinks failing cases towards
+// zero amounts, short denoms and addresses.
+
+// IntGenerator returns a generator of math.Int values of any sign, within the
+// range of math.Int.
+func IntGenerator() *rapid.Generator[math.Int] {
+	return rapid.Custom(func(t *rapid.T) math.Int {
+		i := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 0, math.MaxBitLen/8).Draw(t, "int"))
+		if rapid.Bool().Draw(t, "negative") {
+			i.Neg(i)
+		}
+		return math.NewIntFromBigInt(i)
+	})
+}
+
+// PositiveIntGenerator returns a generator of strictly positive math.Int values.
+func PositiveIntGenerator() *rapid.Generator[math.Int] {
+	return rapid.Custom(func(t *rapid.T) math.Int {
+		i := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 0, math.MaxBitLen/8-1).Draw(t, "int"))
+		return math.NewIntFromBigInt(i.Add(i, big.NewInt(1)))
+	})
+}
+
+// LegacyDecGenerator returns a generator of math.LegacyDec values of any sign.
+// Their integer part is smaller than 2^128, leaving room for arithmetic
+// without overflowing.
+func LegacyDecGenerator() *rapid.Generator[math.LegacyDec] {
+	return rapid.Custom(func(t *rapid.T) math.LegacyDec {
+		i := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 0, 24).Draw(t, "dec"))
+		if rapid.Bool().Draw(t, "negative") {
+			i.Neg(i)
+		}
+		return math.LegacyNewDecFromBigIntWithPrec(i, math.LegacyPrecision)
+	})
+}
+
+// DenomGenerator returns a generator of denoms matching the default coin denom
+// regex.
+func DenomGenerator() *rapid.Generator[string] {
+	return rapid.StringMatching(`[a-z][a-z0-9/:._-]{2,15}`)
+}
+
+// CoinGenerator returns a generator of coins with a strictly positive amount.
+func CoinGenerator() *rapid.Generator[sdk.Coin] {
+	return rapid.Custom(func(t *rapid.T) sdk.Coin {
+		return sdk.NewCoin(DenomGenerator().Draw(t, "denom"), PositiveIntGenerator().Draw(t, "amount"))
+	})
+}
+
+// CoinsGenerator returns a generator of valid, i.e. sorted and positive, coins
+// of at most maxDenoms denoms.
+func CoinsGenerator(maxDenoms int) *rapid.Generator[sdk.Coins] {
+	return rapid.Custom(func(t *rapid.T) sdk.Coins {
+		coins := rapid.SliceOfNDistinct(CoinGenerator(), 0, maxDenoms, func(c sdk.Coin) string { return c.Denom }).Draw(t, "coins")
+		return sdk.NewCoins(coins...)
+	})
+}
+
+// AccAddressGenerator returns a generator of account addresses of 20 or 32
+// bytes, the lengths of the addresses derived from public keys and of the
+// module and derived accounts.
+func AccAddressGenerator() *rapid.Generator[sdk.AccAddress] {
+	return rapid.Custom(func(t *rapid.T) sdk.AccAddress {
+		length := rapid.SampledFrom([]int{20, 32}).Draw(t, "length")
+		return rapid.SliceOfN(rapid.Byte(), length, length).Draw(t, "address")
+	})
+}
+
+// Bech32AddressGenerator returns a generator of the bech32 encoding, with the
+// given human readable prefix, of the addresses of AccAddressGenerator.
+func Bech32AddressGenerator(prefix string) *rapid.Generator[string] {
+	return rapid.Custom(func(t *rapid.T) string {
+		addr, err := bech32.ConvertAndEncode(prefix, AccAddressGenerator().Draw(t, "address"))
+		if err != nil {
+			t.Fatalf("failed to encode address: %v", err)
+		}
+		return addr
+	})
+}
+
+// PrivKeyGenerator returns a generator of secp256k1 private keys.
+func PrivKeyGenerator() *rapid.Generator[cryptotypes.PrivKey] {
+	return rapid.Custom(func(t *rapid.T) cryptotypes.PrivKey {
+		return secp256k1.GenPrivKeyFromSecret(rapid.SliceOfN(rapid.Byte(), 1, 32).Draw(t, "secret"))
+	})
+}
+
+// SignedMsg is a message signed by a key, drawn by SignedMsgGenerator.
+type SignedMsg struct {
+	PubKey    cryptotypes.PubKey
+	Msg       []byte
+	Signature []byte
+}
+
+// SignedMsgGenerator returns a generator of messages signed by the keys of
+// PrivKeyGenerator, whose signatures are valid.
+func SignedMsgGenerator() *rapid.Generator[SignedMsg] {
+	return rapid.Custom(func(t *rapid.T) SignedMsg {
+		priv := PrivKeyGenerator().Draw(t, "key")
+		msg := rapid.SliceOfN(rapid.Byte(), 0, 256).Draw(t, "msg")
+		sig, err := priv.Sign(msg)
+		if err != nil {
+			t.Fatalf("failed to sign message: %v", err)
+		}
+		return SignedMsg{PubKey: priv.PubKey(), Msg: msg, Signature: sig}
+	})
+}
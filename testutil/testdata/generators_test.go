@@ -0,0 +1,42 @@
+package testdata_test
+
+import (
+	"testing"
+
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestGenerators(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		if i := testdata.PositiveIntGenerator().Draw(t, "positive"); !i.IsPositive() {
+			t.Fatalf("expected a positive int, got %s", i)
+		}
+
+		i := testdata.IntGenerator().Draw(t, "int")
+		if j, ok := math.NewIntFromString(i.String()); !ok || !j.Equal(i) {
+			t.Fatalf("invalid int %s", i)
+		}
+
+		d := testdata.LegacyDecGenerator().Draw(t, "dec")
+		if !d.Add(d).Sub(d).Equal(d) {
+			t.Fatalf("dec arithmetic failed for %s", d)
+		}
+
+		if coins := testdata.CoinsGenerator(5).Draw(t, "coins"); !coins.IsValid() {
+			t.Fatalf("invalid coins %s", coins)
+		}
+
+		if hrp, _, err := bech32.DecodeAndConvert(testdata.Bech32AddressGenerator("cosmos").Draw(t, "bech32")); err != nil || hrp != "cosmos" {
+			t.Fatalf("invalid bech32 address: %v", err)
+		}
+
+		if signed := testdata.SignedMsgGenerator().Draw(t, "signed"); !signed.PubKey.VerifySignature(signed.Msg, signed.Signature) {
+			t.Fatalf("invalid signature")
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package types
+
+// EventTypeBlockSummary is the type of the event emitted by BaseApp at the end
+// of each block, summarizing the state transitions reported by the modules so
+// that light consumers do not need to process the events of every transaction.
+const EventTypeBlockSummary = "block_summary"
+
+// BlockSummaryMetric aggregates the events of a given type emitted during a
+// block, e.g. the fees collected or the validators jailed, into an attribute of
+// the block summary event.
+type BlockSummaryMetric struct {
+	// Name is the key of the attribute of the block summary event. The metrics
+	// sharing a name are aggregated together.
+	Name string
+	// EventType is the type of the aggregated events.
+	EventType string
+	// Attribute is the key of the aggregated attribute. If set, the events
+	// without this attribute are ignored.
+	Attribute string
+	// Coins sums the values of Attribute, parsed as coins. Otherwise, the metric
+	// counts the events.
+	Coins bool
+}
+
+// BlockSummaryRegistry is the expected interface for registering block summary
+// metrics, implemented by BaseApp.
+type BlockSummaryRegistry interface {
+	RegisterBlockSummaryMetrics(metrics ...BlockSummaryMetric)
+}
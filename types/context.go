@@ -61,6 +61,8 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	storeAccessPolicy    *StoreAccessPolicy
+	executingModule      string
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -89,6 +91,8 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) StoreAccessPolicy() *StoreAccessPolicy         { return c.storeAccessPolicy }
+func (c Context) ExecutingModule() string                       { return c.executingModule }
 
 // BlockHeader returns the header by value.
 func (c Context) BlockHeader() cmtproto.Header {
@@ -314,6 +318,20 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// WithStoreAccessPolicy returns a Context with the policy governing the stores
+// the executing module can obtain from it.
+func (c Context) WithStoreAccessPolicy(policy *StoreAccessPolicy) Context {
+	c.storeAccessPolicy = policy
+	return c
+}
+
+// WithExecutingModule returns a Context with the name of the module being
+// executed, whose store accesses are checked against the StoreAccessPolicy.
+func (c Context) WithExecutingModule(module string) Context {
+	c.executingModule = module
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -337,12 +355,18 @@ func (c Context) Value(key interface{}) interface{} {
 // ----------------------------------------------------------------------------
 
 // KVStore fetches a KVStore from the MultiStore.
+// It panics if the StoreAccessPolicy does not allow the executing module to
+// obtain the store.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
+	c.assertStoreAccess(key)
 	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
+// It panics if the StoreAccessPolicy does not allow the executing module to
+// obtain the store.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
+	c.assertStoreAccess(key)
 	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
 }
 
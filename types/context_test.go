@@ -215,6 +215,34 @@ func (s *contextTestSuite) TestContextHeaderClone() {
 	}
 }
 
+func (s *contextTestSuite) TestStoreAccessPolicy() {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	stakingKey := storetypes.NewKVStoreKey("staking")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"bank": bankKey, "staking": stakingKey},
+		map[string]*storetypes.TransientStoreKey{},
+		nil,
+	)
+
+	policy := types.NewStoreAccessPolicy().Grant("staking", "bank")
+	s.Require().True(policy.CanAccess("bank", "bank"))
+	s.Require().True(policy.CanAccess("staking", "bank"))
+	s.Require().False(policy.CanAccess("bank", "staking"))
+
+	// the contexts without an executing module can obtain any store.
+	ctx = ctx.WithStoreAccessPolicy(policy)
+	s.Require().NotPanics(func() { ctx.KVStore(stakingKey) })
+
+	stakingCtx := ctx.WithExecutingModule("staking")
+	s.Require().NotPanics(func() { stakingCtx.KVStore(stakingKey).Set([]byte("key"), []byte("value")) })
+	s.Require().NotPanics(func() { stakingCtx.KVStore(bankKey) })
+
+	bankCtx := ctx.WithExecutingModule("bank")
+	s.Require().Equal("bank", bankCtx.ExecutingModule())
+	s.Require().PanicsWithError("module bank cannot access the staking store: unauthorized", func() { bankCtx.KVStore(stakingKey) })
+	s.Require().Panics(func() { bankCtx.TransientStore(stakingKey) })
+}
+
 func (s *contextTestSuite) TestUnwrapSDKContext() {
 	sdkCtx := types.NewContext(nil, false, nil)
 	ctx := types.WrapSDKContext(sdkCtx)
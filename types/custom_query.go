@@ -0,0 +1,36 @@
+package types
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// CustomQueryHandler handles the ABCI queries of a custom query route, e.g. for
+// the compatibility with legacy query paths or binary query formats. path holds
+// the components of the query path following the route. The handler is given a
+// read-only view of the state at the height of the query and returns the raw
+// value of the response.
+type CustomQueryHandler func(ctx Context, path []string, req *abci.QueryRequest) ([]byte, error)
+
+// CustomQueryRoute routes the ABCI queries of path "/custom/{Route}/..." to a
+// handler.
+type CustomQueryRoute struct {
+	// Route is the first component of the query path after "/custom".
+	Route string
+	// Handler handles the queries of the route.
+	Handler CustomQueryHandler
+	// GasLimit is the maximum gas consumed by a query, capped by the query gas
+	// limit of the application. Zero means the query gas limit of the
+	// application.
+	GasLimit uint64
+	// Timeout is the maximum duration of a query, checked on every gas
+	// consumption. Zero means no timeout.
+	Timeout time.Duration
+}
+
+// CustomQueryRegistry is the expected interface for registering custom query
+// routes, implemented by BaseApp.
+type CustomQueryRegistry interface {
+	RegisterCustomQueryRoutes(routes ...CustomQueryRoute)
+}
@@ -140,6 +140,26 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTooManyMsgs defines an error when a transaction contains more messages
+	// than allowed.
+	ErrTooManyMsgs = errorsmod.Register(RootCodespace, 42, "too many messages")
+
+	// ErrTxRateLimited defines an error when an account exceeds the number of
+	// transactions it is allowed to sign within a period.
+	ErrTxRateLimited = errorsmod.Register(RootCodespace, 43, "transaction rate limit exceeded")
+
+	// ErrTxTimeout defines an error for when a tx is rejected out due to an
+	// explicitly set timeout timestamp.
+	ErrTxTimeout = errorsmod.Register(RootCodespace, 44, "tx timeout")
+
+	// ErrMaintenance defines an error for when a node in maintenance mode is
+	// asked to process a transaction or a block.
+	ErrMaintenance = errorsmod.Register(RootCodespace, 45, "node in maintenance mode")
+
+	// ErrQueryTimeout defines an error for when a query exceeds the timeout of
+	// its route.
+	ErrQueryTimeout = errorsmod.Register(RootCodespace, 46, "query timeout")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
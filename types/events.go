@@ -85,6 +85,14 @@ func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
 	return nil
 }
 
+// IndexedTypedEvent is implemented by the typed events declaring the
+// attributes, by JSON field name, which should be indexed by CometBFT.
+type IndexedTypedEvent interface {
+	proto.Message
+
+	IndexedAttributes() []string
+}
+
 // TypedEventToEvent takes typed event and converts to Event object
 func TypedEventToEvent(tev proto.Message) (Event, error) {
 	evtType := proto.MessageName(tev)
@@ -112,10 +120,15 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 		})
 	}
 
-	return Event{
+	event := Event{
 		Type:       evtType,
 		Attributes: attrs,
-	}, nil
+	}
+	if indexed, ok := tev.(IndexedTypedEvent); ok {
+		event = event.MarkAttributesToIndex(indexed.IndexedAttributes()...)
+	}
+
+	return event, nil
 }
 
 // ParseTypedEvent converts abci.Event back to a typed event.
@@ -198,6 +211,21 @@ func (a Attribute) ToKVPair() abci.EventAttribute {
 	return abci.EventAttribute{Key: a.Key, Value: a.Value}
 }
 
+// MarkAttributesToIndex marks the attributes of an Event with the given keys to
+// be indexed by CometBFT, even when the node only indexes a subset of the
+// events, see MarkEventsToIndexWithTypes.
+func (e Event) MarkAttributesToIndex(keys ...string) Event {
+	attrs := make([]abci.EventAttribute, len(e.Attributes))
+	for i, attr := range e.Attributes {
+		attrs[i] = attr
+		if slices.Contains(keys, attr.Key) {
+			attrs[i].Index = true
+		}
+	}
+	e.Attributes = attrs
+	return e
+}
+
 // AppendAttributes adds one or more attributes to an Event.
 func (e Event) AppendAttributes(attrs ...Attribute) Event {
 	for _, attr := range attrs {
@@ -316,7 +344,16 @@ func StringifyEvents(events []abci.Event) StringEvents {
 // MarkEventsToIndex returns the set of ABCI events, where each event's attribute
 // has it's index value marked based on the provided set of events to index.
 func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci.Event {
-	indexAll := len(indexSet) == 0
+	return MarkEventsToIndexWithTypes(events, indexSet, nil)
+}
+
+// MarkEventsToIndexWithTypes is like MarkEventsToIndex, but also marks all the
+// attributes of the events whose type is in indexTypes. If both indexSet and
+// indexTypes are empty, all the attributes are marked. Otherwise, the
+// attributes already marked by the modules emitting them, see
+// Event.MarkAttributesToIndex and IndexedTypedEvent, are marked too.
+func MarkEventsToIndexWithTypes(events []abci.Event, indexSet, indexTypes map[string]struct{}) []abci.Event {
+	indexAll := len(indexSet) == 0 && len(indexTypes) == 0
 	updatedEvents := make([]abci.Event, len(events))
 
 	for i, e := range events {
@@ -325,12 +362,13 @@ func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci
 			Attributes: make([]abci.EventAttribute, len(e.Attributes)),
 		}
 
+		_, indexType := indexTypes[e.Type]
 		for j, attr := range e.Attributes {
 			_, index := indexSet[fmt.Sprintf("%s.%s", e.Type, attr.Key)]
 			updatedAttr := abci.EventAttribute{
 				Key:   attr.Key,
 				Value: attr.Value,
-				Index: index || indexAll,
+				Index: index || indexType || attr.Index || indexAll,
 			}
 
 			updatedEvent.Attributes[j] = updatedAttr
@@ -274,3 +274,44 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 		})
 	}
 }
+
+func (s *eventsTestSuite) TestMarkEventsToIndexWithTypes() {
+	events := []abci.Event{
+		abci.Event(sdk.NewEvent("message", sdk.NewAttribute("sender", "foo"), sdk.NewAttribute("recipient", "bar")).
+			MarkAttributesToIndex("recipient")),
+		abci.Event(sdk.NewEvent("staking", sdk.NewAttribute("deposit", "5"), sdk.NewAttribute("unbond", "10"))),
+	}
+
+	// all the attributes are indexed without index set nor index types
+	for _, e := range sdk.MarkEventsToIndexWithTypes(events, nil, nil) {
+		for _, attr := range e.Attributes {
+			s.Require().True(attr.Index)
+		}
+	}
+
+	expected := []abci.Event{
+		{
+			Type: "message",
+			Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: "foo", Index: true},
+				{Key: "recipient", Value: "bar", Index: true},
+			},
+		},
+		{
+			Type: "staking",
+			Attributes: []abci.EventAttribute{
+				{Key: "deposit", Value: "5"},
+				{Key: "unbond", Value: "10"},
+			},
+		},
+	}
+	s.Require().Equal(expected, sdk.MarkEventsToIndexWithTypes(events, map[string]struct{}{"message.sender": {}}, nil))
+
+	expected[1].Attributes[0].Index = true
+	expected[1].Attributes[1].Index = true
+	expected[0].Attributes[0].Index = false
+	s.Require().Equal(expected, sdk.MarkEventsToIndexWithTypes(events, nil, map[string]struct{}{"staking": {}}))
+
+	// marking attributes does not alter the original event
+	s.Require().False(events[0].Attributes[0].Index)
+}
@@ -3,4 +3,6 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+	// GRPCQueryGasUsedHeader is the gRPC trailer for the gas consumed by a query.
+	GRPCQueryGasUsedHeader = "x-cosmos-query-gas-used"
 )
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"reflect"
+	"strings"
+)
+
 // AnteHandler authenticates transactions, before their internal messages are
 // executed. The provided ctx is expected to contain all relevant information
 // needed to process the transaction, e.g. fee payment information. If new data
@@ -59,6 +64,51 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	return handlerChain[0]
 }
 
+// AnteDecoratorDescriber is implemented by the AnteDecorators describing their
+// configuration, e.g. the options they were built with.
+type AnteDecoratorDescriber interface {
+	Describe() string
+}
+
+// AnteDecoratorDescription describes an AnteDecorator of an AnteHandler chain.
+type AnteDecoratorDescription struct {
+	// Name is the fully qualified name of the type of the decorator.
+	Name string
+	// Config is the configuration of the decorator, if it implements
+	// AnteDecoratorDescriber.
+	Config string
+}
+
+// DescribeAnteDecorators returns the descriptions of the given AnteDecorators,
+// in the order they are chained by ChainAnteDecorators, so that the composition
+// of an AnteHandler can be reported.
+func DescribeAnteDecorators(chain ...AnteDecorator) []AnteDecoratorDescription {
+	descriptions := make([]AnteDecoratorDescription, len(chain))
+	for i, decorator := range chain {
+		descriptions[i].Name = AnteDecoratorName(decorator)
+		if describer, ok := decorator.(AnteDecoratorDescriber); ok {
+			descriptions[i].Config = describer.Describe()
+		}
+	}
+
+	return descriptions
+}
+
+// AnteDecoratorName returns the fully qualified name of the type of the given
+// AnteDecorator, e.g. "cosmossdk.io/x/auth/ante.DeductFeeDecorator".
+func AnteDecoratorName(decorator AnteDecorator) string {
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.PkgPath() == "" || t.Name() == "" {
+		return strings.TrimLeft(t.String(), "*")
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
 // ChainPostDecorators chains PostDecorators together with each PostDecorator
 // wrapping over the decorators further along chain and returns a single PostHandler.
 //
@@ -34,6 +34,25 @@ func TestChainAnteDecorators(t *testing.T) {
 	require.NoError(t, err)
 }
 
+type describedAnteDecorator struct{}
+
+func (describedAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func (describedAnteDecorator) Describe() string { return "foo=bar" }
+
+func TestDescribeAnteDecorators(t *testing.T) {
+	require.Empty(t, sdk.DescribeAnteDecorators())
+
+	mockAnteDecorator := mock.NewMockAnteDecorator(gomock.NewController(t))
+	require.Equal(t, []sdk.AnteDecoratorDescription{
+		{Name: "github.com/cosmos/cosmos-sdk/testutil/mock.MockAnteDecorator"},
+		{Name: "github.com/cosmos/cosmos-sdk/types_test.describedAnteDecorator", Config: "foo=bar"},
+		{Name: "github.com/cosmos/cosmos-sdk/types_test.describedAnteDecorator", Config: "foo=bar"},
+	}, sdk.DescribeAnteDecorators(mockAnteDecorator, describedAnteDecorator{}, &describedAnteDecorator{}))
+}
+
 func TestChainPostDecorators(t *testing.T) {
 	// test panic when passing an empty sclice of PostDecorators
 	require.Nil(t, sdk.ChainPostDecorators([]sdk.PostDecorator{}...))
@@ -58,6 +58,9 @@ type configurator struct {
 	// migrations is a map of moduleName -> fromVersion -> migration script handler
 	migrations map[string]map[uint64]MigrationHandler
 
+	// moduleName is the name of the module whose services are being registered.
+	moduleName string
+
 	err error
 }
 
@@ -70,7 +73,7 @@ func (c *configurator) RegisterService(sd *googlegrpc.ServiceDesc, ss interface{
 	}
 
 	if protobuf.HasExtension(desc.Options(), cosmosmsg.E_Service) {
-		c.msgServer.RegisterService(sd, ss)
+		c.MsgServer().RegisterService(sd, ss)
 	} else {
 		c.queryServer.RegisterService(sd, ss)
 	}
@@ -95,9 +98,32 @@ var _ Configurator = &configurator{}
 
 // MsgServer implements the Configurator.MsgServer method
 func (c *configurator) MsgServer() grpc.Server {
+	if server, ok := c.msgServer.(moduleServiceRegistrar); ok && c.moduleName != "" {
+		return moduleMsgServer{moduleServiceRegistrar: server, moduleName: c.moduleName}
+	}
+
 	return c.msgServer
 }
 
+// moduleServiceRegistrar is implemented by the Msg servers registering the
+// services on behalf of a module, e.g. the baseapp.MsgServiceRouter.
+type moduleServiceRegistrar interface {
+	grpc.Server
+	RegisterModuleService(moduleName string, sd *googlegrpc.ServiceDesc, handler interface{})
+}
+
+// moduleMsgServer registers the services on behalf of the module whose
+// services are being registered by the configurator.
+type moduleMsgServer struct {
+	moduleServiceRegistrar
+	moduleName string
+}
+
+// RegisterService implements the grpc.Server interface.
+func (s moduleMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, handler interface{}) {
+	s.RegisterModuleService(s.moduleName, sd, handler)
+}
+
 // QueryServer implements the Configurator.QueryServer method
 func (c *configurator) QueryServer() grpc.Server {
 	return c.queryServer
@@ -0,0 +1,147 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rawJSONGenesisReader is the genesis.StreamReader of a genesis state held in
+// memory.
+type rawJSONGenesisReader map[string]json.RawMessage
+
+func (r rawJSONGenesisReader) HasModule(moduleName string) bool {
+	return r[moduleName] != nil
+}
+
+func (r rawJSONGenesisReader) ModuleSource(moduleName string) (appmodule.GenesisSource, error) {
+	return genesis.SourceFromRawJSON(r[moduleName])
+}
+
+func (r rawJSONGenesisReader) ModuleJSON(moduleName string) (json.RawMessage, error) {
+	return r[moduleName], nil
+}
+
+// InitGenesisStream performs init genesis functionality for modules like
+// InitGenesis, reading the genesis state of each module from the reader in
+// turn.
+func (m *Manager) InitGenesisStream(ctx sdk.Context, r genesis.StreamReader) (*abci.InitChainResponse, error) {
+	var validatorUpdates []ValidatorUpdate
+	for _, moduleName := range m.OrderInitGenesis {
+		if !r.HasModule(moduleName) {
+			continue
+		}
+
+		mod := m.Modules[moduleName]
+		// we might get an adapted module, a native core API module or a legacy module
+		if module, ok := mod.(appmodule.HasGenesisAuto); ok {
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			// core API genesis
+			source, err := r.ModuleSource(moduleName)
+			if err != nil {
+				return &abci.InitChainResponse{}, err
+			}
+
+			err = module.InitGenesis(ctx, source)
+			if err != nil {
+				return &abci.InitChainResponse{}, err
+			}
+			continue
+		}
+
+		genesisData, err := r.ModuleJSON(moduleName)
+		if err != nil {
+			return &abci.InitChainResponse{}, err
+		}
+
+		if module, ok := mod.(HasGenesis); ok {
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			if err := module.InitGenesis(ctx, genesisData); err != nil {
+				return &abci.InitChainResponse{}, err
+			}
+		} else if module, ok := mod.(HasABCIGenesis); ok {
+			ctx.Logger().Debug("running initialization for module", "module", moduleName)
+			moduleValUpdates, err := module.InitGenesis(ctx, genesisData)
+			if err != nil {
+				return &abci.InitChainResponse{}, err
+			}
+
+			// use these validator updates if provided, the module manager assumes
+			// only one module will update the validator set
+			if len(moduleValUpdates) > 0 {
+				if len(validatorUpdates) > 0 {
+					return &abci.InitChainResponse{}, errors.New("validator InitGenesis updates already set by a previous module")
+				}
+				validatorUpdates = moduleValUpdates
+			}
+		}
+	}
+
+	// a chain must initialize with a non-empty validator set
+	if len(validatorUpdates) == 0 {
+		return &abci.InitChainResponse{}, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
+	}
+
+	cometValidatorUpdates := make([]abci.ValidatorUpdate, len(validatorUpdates))
+	for i, v := range validatorUpdates {
+		cometValidatorUpdates[i] = abci.ValidatorUpdate{
+			PubKeyBytes: v.PubKey,
+			Power:       v.Power,
+			PubKeyType:  v.PubKeyType,
+		}
+	}
+
+	return &abci.InitChainResponse{
+		Validators: cometValidatorUpdates,
+	}, nil
+}
+
+// ExportGenesisStream performs export genesis functionality for modules like
+// ExportGenesisForModules, writing the genesis state of each module to the
+// writer in turn so that at most the genesis state of one module is held in
+// memory. The core API modules write their genesis state field by field.
+func (m *Manager) ExportGenesisStream(ctx sdk.Context, modulesToExport []string, w genesis.StreamWriter) error {
+	if len(modulesToExport) == 0 {
+		modulesToExport = m.OrderExportGenesis
+	}
+	// verify modules exists in app, so that we don't panic in the middle of an export
+	if err := m.checkModulesExists(modulesToExport); err != nil {
+		return err
+	}
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, moduleName := range modulesToExport {
+		var (
+			bz  json.RawMessage
+			err error
+		)
+
+		switch module := m.Modules[moduleName].(type) {
+		case appmodule.HasGenesisAuto:
+			// core API genesis
+			err = module.ExportGenesis(ctx, w.ModuleTarget(moduleName))
+		case HasGenesis:
+			bz, err = module.ExportGenesis(ctx)
+		case HasABCIGenesis:
+			bz, err = module.ExportGenesis(ctx)
+		default:
+			continue
+		}
+		if err == nil && bz != nil {
+			err = w.WriteModuleJSON(moduleName, bz)
+		}
+		if err != nil {
+			return fmt.Errorf("genesis export error in %s: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
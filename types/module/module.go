@@ -103,6 +103,28 @@ type HasInvariants interface {
 	RegisterInvariants(sdk.InvariantRegistry)
 }
 
+// HasRecoveryHandlers is the interface for modules converting the known panics
+// raised while running transactions, e.g. by their keepers, into errors.
+type HasRecoveryHandlers interface {
+	// RegisterRecoveryHandlers registers the module recovery handlers.
+	RegisterRecoveryHandlers(sdk.RecoveryHandlerRegistry)
+}
+
+// HasBlockSummaryMetrics is the interface for modules reporting their state
+// transitions in the block summary event, e.g. the tokens minted or the
+// validators jailed during the block.
+type HasBlockSummaryMetrics interface {
+	// RegisterBlockSummaryMetrics registers the module block summary metrics.
+	RegisterBlockSummaryMetrics(sdk.BlockSummaryRegistry)
+}
+
+// HasCustomQueryRoutes is the interface for modules to register the handlers
+// of custom ABCI query paths.
+type HasCustomQueryRoutes interface {
+	// RegisterCustomQueryRoutes registers the module custom query routes.
+	RegisterCustomQueryRoutes(sdk.CustomQueryRegistry)
+}
+
 // HasServices is the interface for modules to register services.
 type HasServices interface {
 	// RegisterServices allows a module to register services.
@@ -393,9 +415,59 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
-// RegisterServices registers all module services
+// RegisterRecoveryHandlers registers all module recovery handlers, in the
+// alphabetical order of the module names so that the registration is
+// deterministic.
+func (m *Manager) RegisterRecoveryHandlers(registry sdk.RecoveryHandlerRegistry) {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		if module, ok := m.Modules[moduleName].(HasRecoveryHandlers); ok {
+			module.RegisterRecoveryHandlers(registry)
+		}
+	}
+}
+
+// RegisterBlockSummaryMetrics registers all module block summary metrics, in
+// the alphabetical order of the module names so that the attributes of the
+// block summary event are deterministic.
+func (m *Manager) RegisterBlockSummaryMetrics(registry sdk.BlockSummaryRegistry) {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		if module, ok := m.Modules[moduleName].(HasBlockSummaryMetrics); ok {
+			module.RegisterBlockSummaryMetrics(registry)
+		}
+	}
+}
+
+// RegisterCustomQueryRoutes registers all module custom query routes, in the
+// alphabetical order of the module names so that the registration is
+// deterministic.
+func (m *Manager) RegisterCustomQueryRoutes(registry sdk.CustomQueryRegistry) {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		if module, ok := m.Modules[moduleName].(HasCustomQueryRoutes); ok {
+			module.RegisterCustomQueryRoutes(registry)
+		}
+	}
+}
+
+// RegisterServices registers all module services. The Msg services are
+// registered on behalf of their module if the Msg server of the configurator
+// supports it, e.g. the baseapp.MsgServiceRouter, see sdk.StoreAccessPolicy.
 func (m *Manager) RegisterServices(cfg Configurator) error {
-	for _, module := range m.Modules {
+	c, isConfigurator := cfg.(*configurator)
+	if isConfigurator {
+		defer func() { c.moduleName = "" }()
+	}
+
+	for moduleName, module := range m.Modules {
+		if isConfigurator {
+			c.moduleName = moduleName
+		}
+
 		if module, ok := module.(HasServices); ok {
 			module.RegisterServices(cfg)
 		}
@@ -426,67 +498,8 @@ func (m *Manager) RegisterServices(cfg Configurator) error {
 // module must return a non-empty validator set update to correctly initialize
 // the chain.
 func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) (*abci.InitChainResponse, error) {
-	var validatorUpdates []ValidatorUpdate
 	ctx.Logger().Info("initializing blockchain state from genesis.json")
-	for _, moduleName := range m.OrderInitGenesis {
-		if genesisData[moduleName] == nil {
-			continue
-		}
-
-		mod := m.Modules[moduleName]
-		// we might get an adapted module, a native core API module or a legacy module
-		if module, ok := mod.(appmodule.HasGenesisAuto); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			// core API genesis
-			source, err := genesis.SourceFromRawJSON(genesisData[moduleName])
-			if err != nil {
-				return &abci.InitChainResponse{}, err
-			}
-
-			err = module.InitGenesis(ctx, source)
-			if err != nil {
-				return &abci.InitChainResponse{}, err
-			}
-		} else if module, ok := mod.(HasGenesis); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			if err := module.InitGenesis(ctx, genesisData[moduleName]); err != nil {
-				return &abci.InitChainResponse{}, err
-			}
-		} else if module, ok := mod.(HasABCIGenesis); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			moduleValUpdates, err := module.InitGenesis(ctx, genesisData[moduleName])
-			if err != nil {
-				return &abci.InitChainResponse{}, err
-			}
-
-			// use these validator updates if provided, the module manager assumes
-			// only one module will update the validator set
-			if len(moduleValUpdates) > 0 {
-				if len(validatorUpdates) > 0 {
-					return &abci.InitChainResponse{}, errors.New("validator InitGenesis updates already set by a previous module")
-				}
-				validatorUpdates = moduleValUpdates
-			}
-		}
-	}
-
-	// a chain must initialize with a non-empty validator set
-	if len(validatorUpdates) == 0 {
-		return &abci.InitChainResponse{}, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
-	}
-
-	cometValidatorUpdates := make([]abci.ValidatorUpdate, len(validatorUpdates))
-	for i, v := range validatorUpdates {
-		cometValidatorUpdates[i] = abci.ValidatorUpdate{
-			PubKeyBytes: v.PubKey,
-			Power:       v.Power,
-			PubKeyType:  v.PubKeyType,
-		}
-	}
-
-	return &abci.InitChainResponse{
-		Validators: cometValidatorUpdates,
-	}, nil
+	return m.InitGenesisStream(ctx, rawJSONGenesisReader(genesisData))
 }
 
 // ExportGenesis performs export genesis functionality for modules
@@ -720,11 +733,13 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM Ver
 // PreBlock performs begin block functionality for upgrade module.
 // It takes the current context as a parameter and returns a boolean value
 // indicating whether the migration was successfully executed or not.
+// Each module is executed as the executing module of the context, see
+// sdk.StoreAccessPolicy.
 func (m *Manager) PreBlock(ctx sdk.Context) error {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	for _, moduleName := range m.OrderPreBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasPreBlocker); ok {
-			if err := module.PreBlock(ctx); err != nil {
+			if err := module.PreBlock(ctx.WithExecutingModule(moduleName)); err != nil {
 				return err
 			}
 		}
@@ -734,12 +749,13 @@ func (m *Manager) PreBlock(ctx sdk.Context) error {
 
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. Each module is executed as the executing module of the context, see
+// sdk.StoreAccessPolicy.
 func (m *Manager) BeginBlock(ctx sdk.Context) (sdk.BeginBlock, error) {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	for _, moduleName := range m.OrderBeginBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasBeginBlocker); ok {
-			if err := module.BeginBlock(ctx); err != nil {
+			if err := module.BeginBlock(ctx.WithExecutingModule(moduleName)); err != nil {
 				return sdk.BeginBlock{}, err
 			}
 		}
@@ -752,19 +768,20 @@ func (m *Manager) BeginBlock(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlock performs end block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. Each module is executed as the executing module of the context, see
+// sdk.StoreAccessPolicy.
 func (m *Manager) EndBlock(ctx sdk.Context) (sdk.EndBlock, error) {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasEndBlocker); ok {
-			err := module.EndBlock(ctx)
+			err := module.EndBlock(ctx.WithExecutingModule(moduleName))
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
 		} else if module, ok := m.Modules[moduleName].(HasABCIEndBlock); ok {
-			moduleValUpdates, err := module.EndBlock(ctx)
+			moduleValUpdates, err := module.EndBlock(ctx.WithExecutingModule(moduleName))
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
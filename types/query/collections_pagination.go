@@ -120,7 +120,8 @@ func CollectionFilteredPaginate[K, V any, C Collection[K, V], T any](
 }
 
 // collFilteredPaginateNoKey applies the provided pagination on the collection when the starting key is not set.
-// If predicateFunc is nil no filtering is applied.
+// If predicateFunc is nil no filtering is applied. The offset skips the results
+// matching the predicate only, like FilteredPaginate.
 func collFilteredPaginateNoKey[K, V any, C Collection[K, V], T any](
 	ctx context.Context,
 	coll C,
@@ -137,114 +138,8 @@ func collFilteredPaginateNoKey[K, V any, C Collection[K, V], T any](
 		return nil, nil, err
 	}
 	defer iterator.Close()
-	// we advance the iter equal to the provided offset
-	if !advanceIter(iterator, offset) {
-		return nil, nil, collections.ErrInvalidIterator
-	}
-
-	var (
-		count   uint64
-		nextKey []byte
-		results []T
-	)
-
-	for ; iterator.Valid(); iterator.Next() {
-		switch {
-		// first case, we still haven't found all the results up to the limit
-		case count < limit:
-			kv, err := iterator.KeyValue()
-			if err != nil {
-				return nil, nil, err
-			}
-			// if no predicate function is specified then we just include the result
-			if predicateFunc == nil {
-				transformed, err := transformFunc(kv.Key, kv.Value)
-				if err != nil {
-					return nil, nil, err
-				}
-				results = append(results, transformed)
-				count++
 
-				// if predicate function is defined we check if the result matches the filtering criteria
-			} else {
-				include, err := predicateFunc(kv.Key, kv.Value)
-				if err != nil {
-					return nil, nil, err
-				}
-				if include {
-					transformed, err := transformFunc(kv.Key, kv.Value)
-					if err != nil {
-						return nil, nil, err
-					}
-					results = append(results, transformed)
-					count++
-				}
-			}
-		// second case, we found all the objects specified within the limit
-		case count == limit:
-			key, err := iterator.Key()
-			if err != nil {
-				return nil, nil, err
-			}
-			nextKey, err = encodeCollKey[K, V](coll, key)
-			if err != nil {
-				return nil, nil, err
-			}
-			// if count total was not specified, we return the next key only
-			if !countTotal {
-				return results, &PageResponse{
-					NextKey: nextKey,
-				}, nil
-			}
-			// otherwise we fallthrough the third case
-			fallthrough
-		// this is the case in which we found all the required results
-		// but we need to count how many possible results exist in total.
-		// so we keep increasing the count until the iterator is fully consumed.
-		case count > limit:
-			if predicateFunc == nil {
-				count++
-
-				// if predicate function is defined we check if the result matches the filtering criteria
-			} else {
-				kv, err := iterator.KeyValue()
-				if err != nil {
-					return nil, nil, err
-				}
-
-				include, err := predicateFunc(kv.Key, kv.Value)
-				if err != nil {
-					return nil, nil, err
-				}
-				if include {
-					count++
-				}
-			}
-		}
-	}
-
-	resp := &PageResponse{
-		NextKey: nextKey,
-	}
-
-	if countTotal {
-		resp.Total = count + offset
-	}
-	return results, resp, nil
-}
-
-func advanceIter[I interface {
-	Next()
-	Valid() bool
-}](iter I, offset uint64,
-) bool {
-	for i := uint64(0); i < offset; i++ {
-		if !iter.Valid() {
-			return false
-		}
-		iter.Next()
-	}
-	return true
+	return collFilteredPaginate(coll, iterator, reverse, offset, limit, countTotal, predicateFunc, transformFunc)
 }
 
 // collFilteredPaginateByKey paginates a collection when a starting key
@@ -265,59 +160,82 @@ func collFilteredPaginateByKey[K, V any, C Collection[K, V], T any](
 	}
 	defer iterator.Close()
 
+	return collFilteredPaginate(coll, iterator, reverse, 0, limit, false, predicateFunc, transformFunc)
+}
+
+// collFilteredPaginate collects the results matching the predicate within the
+// offset and limit, and counts them all if countTotal is set. Only the results
+// matching the predicate count towards the offset, the limit and the total.
+func collFilteredPaginate[K, V any, C Collection[K, V], T any](
+	coll C,
+	iterator collections.Iterator[K, V],
+	reverse bool,
+	offset uint64,
+	limit uint64,
+	countTotal bool,
+	predicateFunc func(K, V) (bool, error),
+	transformFunc func(K, V) (T, error),
+) ([]T, *PageResponse, error) {
 	var (
 		count   uint64
+		lastKey K
 		nextKey []byte
+		results []T
 	)
 
+	end := offset + limit
 	for ; iterator.Valid(); iterator.Next() {
-		// if we reached the specified limit
-		// then we get the next key, and we exit the iteration.
-		if count == limit {
-			concreteKey, err := iterator.Key()
-			if err != nil {
-				return nil, nil, err
-			}
-
-			nextKey, err = encodeCollKey[K, V](coll, concreteKey)
-			if err != nil {
-				return nil, nil, err
-			}
-			break
-		}
-
 		kv, err := iterator.KeyValue()
 		if err != nil {
 			return nil, nil, err
 		}
-		// if no predicate is specified then we just append the result
-		if predicateFunc == nil {
-			transformed, err := transformFunc(kv.Key, kv.Value)
-			if err != nil {
-				return nil, nil, err
-			}
-			results = append(results, transformed)
-			// if predicate is applied we execute the predicate function
-			// and append only if predicateFunc yields true.
-		} else {
-			include, err := predicateFunc(kv.Key, kv.Value)
+
+		// if no predicate function is specified then we just include the result
+		include := true
+		if predicateFunc != nil {
+			include, err = predicateFunc(kv.Key, kv.Value)
 			if err != nil {
 				return nil, nil, err
 			}
-			if include {
+		}
+
+		if include {
+			switch {
+			// we still haven't found all the results up to the limit
+			case count >= offset && count < end:
 				transformed, err := transformFunc(kv.Key, kv.Value)
 				if err != nil {
 					return nil, nil, err
 				}
 				results = append(results, transformed)
+
+			// we found all the objects specified within the limit, the
+			// next page begins after the last key of this one
+			case count == end:
+				lastKeyBytes, err := encodeCollKey[K, V](coll, lastKey)
+				if err != nil {
+					return nil, nil, err
+				}
+				nextKey = nextPageKey(lastKeyBytes, reverse)
+				// if count total was not specified, we return the next key only
+				if !countTotal {
+					return results, &PageResponse{NextKey: nextKey}, nil
+				}
 			}
+			count++
 		}
-		count++
+
+		lastKey = kv.Key
 	}
 
-	return results, &PageResponse{
+	resp := &PageResponse{
 		NextKey: nextKey,
-	}, nil
+	}
+
+	if countTotal {
+		resp.Total = count
+	}
+	return results, resp, nil
 }
 
 // todo maybe move to collections?
@@ -328,18 +246,23 @@ func encodeCollKey[K, V any, C Collection[K, V]](coll C, key K) ([]byte, error)
 }
 
 func getCollIter[K, V any, C Collection[K, V]](ctx context.Context, coll C, prefix, start []byte, reverse bool) (collections.Iterator[K, V], error) {
-	// TODO: maybe can be simplified
+	if start != nil {
+		start = append(append([]byte{}, prefix...), start...)
+	}
 	if reverse {
-		var end []byte
-		if prefix != nil {
-			start = storetypes.PrefixEndBytes(append(prefix, start...))
-			end = prefix
+		// the start key is the exclusive end of the reverse iteration, see
+		// nextPageKey.
+		end := start
+		if end == nil && prefix != nil {
+			end = storetypes.PrefixEndBytes(prefix)
 		}
-		return coll.IterateRaw(ctx, end, start, collections.OrderDescending)
+		return coll.IterateRaw(ctx, prefix, end, collections.OrderDescending)
+	}
+	if start == nil {
+		start = prefix
 	}
 	var end []byte
 	if prefix != nil {
-		start = append(prefix, start...)
 		end = storetypes.PrefixEndBytes(prefix)
 	}
 	return coll.IterateRaw(ctx, start, end, collections.OrderAscending)
@@ -46,6 +46,11 @@ func TestCollectionPagination(t *testing.T) {
 		return b
 	}
 
+	// the next page begins right after the last key of the previous page.
+	nextKey := func(lastKey uint64) []byte {
+		return append(encodeKey(lastKey), 0)
+	}
+
 	type test struct {
 		req        *PageRequest
 		expResp    *PageResponse
@@ -58,7 +63,7 @@ func TestCollectionPagination(t *testing.T) {
 		"nil pagination": {
 			req: nil,
 			expResp: &PageResponse{
-				NextKey: encodeKey(100),
+				NextKey: nextKey(99),
 				Total:   300,
 			},
 			expResults: createResults(0, 99),
@@ -69,7 +74,7 @@ func TestCollectionPagination(t *testing.T) {
 				Limit: 149,
 			},
 			expResp: &PageResponse{
-				NextKey: encodeKey(249),
+				NextKey: nextKey(248),
 			},
 			expResults: createResults(100, 248),
 		},
@@ -78,11 +83,22 @@ func TestCollectionPagination(t *testing.T) {
 				Reverse: true,
 			},
 			expResp: &PageResponse{
-				NextKey: encodeKey(199),
+				NextKey: encodeKey(200),
 				Total:   300,
 			},
 			expResults: createResults(299, 200),
 		},
+		"with reverse and key": {
+			req: &PageRequest{
+				Key:     encodeKey(200),
+				Limit:   100,
+				Reverse: true,
+			},
+			expResp: &PageResponse{
+				NextKey: encodeKey(100),
+			},
+			expResults: createResults(199, 100),
+		},
 		"with offset and count total": {
 			req: &PageRequest{
 				Offset:     50,
@@ -90,7 +106,7 @@ func TestCollectionPagination(t *testing.T) {
 				CountTotal: true,
 			},
 			expResp: &PageResponse{
-				NextKey: encodeKey(150),
+				NextKey: nextKey(149),
 				Total:   300,
 			},
 			expResults: createResults(50, 149),
@@ -100,7 +116,7 @@ func TestCollectionPagination(t *testing.T) {
 				Limit: 3,
 			},
 			expResp: &PageResponse{
-				NextKey: encodeKey(5),
+				NextKey: nextKey(5),
 			},
 			filter: func(key, value uint64) (bool, error) {
 				return key%2 == 0, nil
@@ -117,7 +133,7 @@ func TestCollectionPagination(t *testing.T) {
 				Limit: 3,
 			},
 			expResp: &PageResponse{
-				NextKey: encodeKey(5),
+				NextKey: nextKey(7),
 			},
 			filter: func(key, value uint64) (bool, error) {
 				return key%2 == 0, nil
@@ -125,8 +141,36 @@ func TestCollectionPagination(t *testing.T) {
 			expResults: []collections.KeyValue[uint64, uint64]{
 				{Key: 2, Value: 2},
 				{Key: 4, Value: 4},
+				{Key: 6, Value: 6},
+			},
+		},
+		"filtered with offset and count total": {
+			req: &PageRequest{
+				Offset:     2,
+				Limit:      2,
+				CountTotal: true,
+			},
+			expResp: &PageResponse{
+				NextKey: nextKey(7),
+				Total:   150,
+			},
+			filter: func(key, value uint64) (bool, error) {
+				return key%2 == 0, nil
+			},
+			expResults: []collections.KeyValue[uint64, uint64]{
+				{Key: 4, Value: 4},
+				{Key: 6, Value: 6},
 			},
 		},
+		"skip count total": {
+			req: &PageRequest{
+				SkipCountTotal: true,
+			},
+			expResp: &PageResponse{
+				NextKey: nextKey(99),
+			},
+			expResults: createResults(0, 99),
+		},
 	}
 
 	for name, tc := range tcs {
@@ -152,6 +196,39 @@ func TestCollectionPagination(t *testing.T) {
 	}
 }
 
+func TestCollectionPaginationInsertions(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	m := collections.NewMap(sb, collections.NewPrefix(0), "_", collections.Uint64Key, collections.Uint64Value)
+
+	for i := uint64(0); i < 10; i += 2 {
+		require.NoError(t, m.Set(ctx, i, i))
+	}
+
+	paginate := func(req *PageRequest) ([]uint64, *PageResponse) {
+		results, res, err := CollectionPaginate(ctx, m, req, func(key, _ uint64) (uint64, error) {
+			return key, nil
+		})
+		require.NoError(t, err)
+		return results, res
+	}
+
+	// the keys inserted right after the last key of a page are returned in the
+	// next page, in both orders.
+	results, res := paginate(&PageRequest{Limit: 2})
+	require.Equal(t, []uint64{0, 2}, results)
+	require.NoError(t, m.Set(ctx, 3, 3))
+	results, _ = paginate(&PageRequest{Key: res.NextKey, Limit: 2})
+	require.Equal(t, []uint64{3, 4}, results)
+
+	results, res = paginate(&PageRequest{Limit: 2, Reverse: true})
+	require.Equal(t, []uint64{8, 6}, results)
+	require.NoError(t, m.Set(ctx, 5, 5))
+	require.NoError(t, m.Remove(ctx, 4))
+	results, _ = paginate(&PageRequest{Key: res.NextKey, Limit: 2, Reverse: true})
+	require.Equal(t, []uint64{5, 3}, results)
+}
+
 type testStore struct {
 	db db.DB
 }
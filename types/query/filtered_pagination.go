@@ -31,6 +31,7 @@ func FilteredPaginate(
 
 	var (
 		numHits uint64
+		lastKey []byte
 		nextKey []byte
 		err     error
 	)
@@ -42,7 +43,7 @@ func FilteredPaginate(
 		accumulateFn := func(_ uint64) bool { return true }
 		for ; iterator.Valid(); iterator.Next() {
 			if numHits == pageRequest.Limit {
-				nextKey = iterator.Key()
+				nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 				break
 			}
 
@@ -50,6 +51,7 @@ func FilteredPaginate(
 			if err != nil {
 				return nil, err
 			}
+			lastKey = iterator.Key()
 		}
 
 		return &PageResponse{
@@ -67,13 +69,14 @@ func FilteredPaginate(
 		}
 		if numHits == end+1 {
 			if nextKey == nil {
-				nextKey = iterator.Key()
+				nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 			}
 
 			if !pageRequest.CountTotal {
 				break
 			}
 		}
+		lastKey = iterator.Key()
 	}
 
 	res := &PageResponse{NextKey: nextKey}
@@ -156,6 +159,7 @@ func GenericFilteredPaginate[T, F proto.Message](
 
 	var (
 		numHits uint64
+		lastKey []byte
 		nextKey []byte
 		err     error
 	)
@@ -167,7 +171,7 @@ func GenericFilteredPaginate[T, F proto.Message](
 		accumulateFn := func(_ uint64) bool { return true }
 		for ; iterator.Valid(); iterator.Next() {
 			if numHits == pageRequest.Limit {
-				nextKey = iterator.Key()
+				nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 				break
 			}
 
@@ -175,6 +179,7 @@ func GenericFilteredPaginate[T, F proto.Message](
 			if err != nil {
 				return nil, nil, err
 			}
+			lastKey = iterator.Key()
 		}
 
 		return results, &PageResponse{
@@ -193,13 +198,14 @@ func GenericFilteredPaginate[T, F proto.Message](
 
 		if numHits == end+1 {
 			if nextKey == nil {
-				nextKey = iterator.Key()
+				nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 			}
 
 			if !pageRequest.CountTotal {
 				break
 			}
 		}
+		lastKey = iterator.Key()
 	}
 
 	res := &PageResponse{NextKey: nextKey}
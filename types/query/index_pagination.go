@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+)
+
+// IndexedValues defines the minimum required API of the collection referenced by a
+// secondary index, e.g. a collections.IndexedMap, to resolve the values of the index
+// entries being paginated.
+type IndexedValues[PK, V any] interface {
+	Get(ctx context.Context, key PK) (V, error)
+}
+
+// CollectionMultiIndexPaginate paginates the values of a collection over one of its
+// indexes.Multi secondary indexes.
+//
+// The index entries are ordered by the encoded (reference key, primary key) pair,
+// which defines a stable total ordering: entries sharing the same reference key are
+// ordered by their encoded primary key, and a PageRequest.Reverse request returns the
+// exact reverse ordering. The PageResponse.NextKey encodes the whole pair, so resuming
+// from it never skips nor repeats an entry sharing the reference key of the last
+// returned one.
+//
+// The reference key of the paginated entries can be restricted with the
+// WithCollectionPaginationPairPrefix option.
+func CollectionMultiIndexPaginate[RK, PK, V any, T any](
+	ctx context.Context,
+	index *indexes.Multi[RK, PK, V],
+	values IndexedValues[PK, V],
+	pageReq *PageRequest,
+	transformFunc func(refKey RK, primaryKey PK, value V) (T, error),
+	opts ...func(opt *CollectionsPaginateOptions[collections.Pair[RK, PK]]),
+) ([]T, *PageResponse, error) {
+	return CollectionPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[RK, PK], _ collections.NoValue) (T, error) {
+			value, err := values.Get(ctx, key.K2())
+			if err != nil {
+				return *new(T), err
+			}
+			return transformFunc(key.K1(), key.K2(), value)
+		},
+		opts...,
+	)
+}
+
+// CollectionUniqueIndexPaginate paginates the values of a collection over one of its
+// indexes.Unique secondary indexes.
+//
+// The index entries are ordered by their encoded reference key, which is unique and
+// thus defines a stable total ordering, a PageRequest.Reverse request returns the
+// exact reverse ordering.
+func CollectionUniqueIndexPaginate[RK, PK, V any, T any](
+	ctx context.Context,
+	index *indexes.Unique[RK, PK, V],
+	values IndexedValues[PK, V],
+	pageReq *PageRequest,
+	transformFunc func(refKey RK, primaryKey PK, value V) (T, error),
+	opts ...func(opt *CollectionsPaginateOptions[RK]),
+) ([]T, *PageResponse, error) {
+	return CollectionPaginate(
+		ctx,
+		uniqueIndexCollection[RK, PK, V]{index},
+		pageReq,
+		func(refKey RK, primaryKey PK) (T, error) {
+			value, err := values.Get(ctx, primaryKey)
+			if err != nil {
+				return *new(T), err
+			}
+			return transformFunc(refKey, primaryKey, value)
+		},
+		opts...,
+	)
+}
+
+// uniqueIndexCollection adapts an indexes.Unique to the Collection interface.
+type uniqueIndexCollection[RK, PK, V any] struct {
+	index *indexes.Unique[RK, PK, V]
+}
+
+func (u uniqueIndexCollection[RK, PK, V]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[RK, PK], error) {
+	iter, err := u.index.IterateRaw(ctx, start, end, order)
+	return (collections.Iterator[RK, PK])(iter), err
+}
+
+func (u uniqueIndexCollection[RK, PK, V]) KeyCodec() collcodec.KeyCodec[RK] {
+	return u.index.KeyCodec()
+}
@@ -0,0 +1,89 @@
+package query_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/collections/indexes"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/query/querytest"
+)
+
+type account struct {
+	Name string `json:"name"`
+	City string `json:"city"`
+}
+
+type accountIndexes struct {
+	City *indexes.Multi[string, uint64, account]
+	Name *indexes.Unique[string, uint64, account]
+}
+
+func (a accountIndexes) IndexesList() []collections.Index[uint64, account] {
+	return []collections.Index[uint64, account]{a.City, a.Name}
+}
+
+func newAccounts(t *testing.T) (*collections.IndexedMap[uint64, account, accountIndexes], context.Context) {
+	t.Helper()
+
+	sk, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(sk)
+	accounts := collections.NewIndexedMap(sb, collections.NewPrefix(0), "accounts", collections.Uint64Key, collections.NewJSONValueCodec[account](), accountIndexes{
+		City: indexes.NewMulti(sb, collections.NewPrefix(1), "accounts_by_city", collections.StringKey, collections.Uint64Key, func(_ uint64, a account) (string, error) {
+			return a.City, nil
+		}),
+		Name: indexes.NewUnique(sb, collections.NewPrefix(2), "accounts_by_name", collections.StringKey, collections.Uint64Key, func(_ uint64, a account) (string, error) {
+			return a.Name, nil
+		}),
+	})
+
+	// the primary keys are set in an order differing from both indexes orderings,
+	// the milan accounts share the same reference key.
+	cities := []string{"milan", "berlin", "milan", "zurich", "milan", "berlin", "amsterdam", "milan"}
+	for i := len(cities) - 1; i >= 0; i-- {
+		require.NoError(t, accounts.Set(ctx, uint64(i), account{Name: fmt.Sprintf("name-%d", len(cities)-i), City: cities[i]}))
+	}
+	return accounts, ctx
+}
+
+func TestCollectionMultiIndexPaginate(t *testing.T) {
+	accounts, ctx := newAccounts(t)
+
+	transform := func(city string, pk uint64, a account) (string, error) {
+		require.Equal(t, city, a.City)
+		return fmt.Sprintf("%s/%d", city, pk), nil
+	}
+
+	// accounts sharing a city are ordered by primary key
+	querytest.TestPagination(t, []string{
+		"amsterdam/6", "berlin/1", "berlin/5", "milan/0", "milan/2", "milan/4", "milan/7", "zurich/3",
+	}, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		return query.CollectionMultiIndexPaginate(ctx, accounts.Indexes.City, accounts, pageReq, transform)
+	})
+
+	querytest.TestPagination(t, []string{
+		"milan/0", "milan/2", "milan/4", "milan/7",
+	}, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		return query.CollectionMultiIndexPaginate(ctx, accounts.Indexes.City, accounts, pageReq, transform,
+			query.WithCollectionPaginationPairPrefix[string, uint64]("milan"))
+	})
+}
+
+func TestCollectionUniqueIndexPaginate(t *testing.T) {
+	accounts, ctx := newAccounts(t)
+
+	querytest.TestPagination(t, []string{
+		"name-1/7", "name-2/6", "name-3/5", "name-4/4", "name-5/3", "name-6/2", "name-7/1", "name-8/0",
+	}, func(pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+		return query.CollectionUniqueIndexPaginate(ctx, accounts.Indexes.Name, accounts, pageReq, func(name string, pk uint64, a account) (string, error) {
+			require.Equal(t, name, a.Name)
+			return fmt.Sprintf("%s/%d", name, pk), nil
+		})
+	})
+}
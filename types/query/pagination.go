@@ -63,13 +63,16 @@ func Paginate(
 	iterator := getIterator(prefixStore, pageRequest.Key, pageRequest.Reverse)
 	defer iterator.Close()
 
-	var count uint64
-	var nextKey []byte
+	var (
+		count   uint64
+		lastKey []byte
+		nextKey []byte
+	)
 
 	if len(pageRequest.Key) != 0 {
 		for ; iterator.Valid(); iterator.Next() {
 			if count == pageRequest.Limit {
-				nextKey = iterator.Key()
+				nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 				break
 			}
 			if iterator.Error() != nil {
@@ -80,6 +83,7 @@ func Paginate(
 				return nil, err
 			}
 
+			lastKey = iterator.Key()
 			count++
 		}
 
@@ -94,6 +98,7 @@ func Paginate(
 		count++
 
 		if count <= pageRequest.Offset {
+			lastKey = iterator.Key()
 			continue
 		}
 		if count <= end {
@@ -101,8 +106,9 @@ func Paginate(
 			if err != nil {
 				return nil, err
 			}
+			lastKey = iterator.Key()
 		} else if count == end+1 {
-			nextKey = iterator.Key()
+			nextKey = nextPageKey(lastKey, pageRequest.Reverse)
 
 			if !pageRequest.CountTotal {
 				break
@@ -121,22 +127,30 @@ func Paginate(
 	return res, nil
 }
 
+// getIterator returns the iterator over the results of a page starting at the
+// key of the page, see nextPageKey.
 func getIterator(prefixStore types.KVStore, start []byte, reverse bool) db.Iterator {
 	if reverse {
-		var end []byte
-		if start != nil {
-			itr := prefixStore.Iterator(start, nil)
-			defer itr.Close()
-			if itr.Valid() {
-				itr.Next()
-				end = itr.Key()
-			}
-		}
-		return prefixStore.ReverseIterator(nil, end)
+		return prefixStore.ReverseIterator(nil, start)
 	}
 	return prefixStore.Iterator(start, nil)
 }
 
+// nextPageKey returns the key of the page following the given last key of the
+// current page. The next page begins right after the last key rather than at
+// the first key of the next page, so that the keys inserted in between since
+// are not skipped: in ascending order it is the smallest key following the last
+// key, i.e. the last key suffixed with a zero byte, and in descending order it
+// is the last key itself, the exclusive end of the reverse iteration.
+func nextPageKey(lastKey []byte, reverse bool) []byte {
+	nextKey := make([]byte, len(lastKey), len(lastKey)+1)
+	copy(nextKey, lastKey)
+	if reverse {
+		return nextKey
+	}
+	return append(nextKey, 0)
+}
+
 // initPageRequestDefaults initializes a PageRequest's defaults when those are not set.
 func initPageRequestDefaults(pageRequest *PageRequest) *PageRequest {
 	// if the PageRequest is nil, use default PageRequest
@@ -152,8 +166,9 @@ func initPageRequestDefaults(pageRequest *PageRequest) *PageRequest {
 	if pageRequestCopy.Limit == 0 {
 		pageRequestCopy.Limit = DefaultLimit
 
-		// count total results when the limit is zero/not supplied
-		pageRequestCopy.CountTotal = true
+		// count total results when the limit is zero/not supplied, unless
+		// explicitly skipped
+		pageRequestCopy.CountTotal = pageRequestCopy.CountTotal || !pageRequestCopy.SkipCountTotal
 	}
 
 	return &pageRequestCopy
@@ -33,7 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PageRequest struct {
 	// key is a value returned in PageResponse.next_key to begin
 	// querying the next page most efficiently. Only one of offset or key
-	// should be set.
+	// should be set. The next page begins right after the last result of the
+	// previous page, including the results inserted since.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// offset is a numeric offset that can be used when key is unavailable.
 	// It is less efficient than using key. Only one of offset or key should
@@ -49,6 +50,10 @@ type PageRequest struct {
 	CountTotal bool `protobuf:"varint,4,opt,name=count_total,json=countTotal,proto3" json:"count_total,omitempty"`
 	// reverse is set to true if results are to be returned in the descending order.
 	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// skip_count_total is set to true to skip counting the total number of
+	// results when the limit is left empty, which is done by default, so that the
+	// page is returned without iterating over all the results.
+	SkipCountTotal bool `protobuf:"varint,6,opt,name=skip_count_total,json=skipCountTotal,proto3" json:"skip_count_total,omitempty"`
 }
 
 func (m *PageRequest) Reset()         { *m = PageRequest{} }
@@ -119,6 +124,13 @@ func (m *PageRequest) GetReverse() bool {
 	return false
 }
 
+func (m *PageRequest) GetSkipCountTotal() bool {
+	if m != nil {
+		return m.SkipCountTotal
+	}
+	return false
+}
+
 // PageResponse is to be embedded in gRPC response messages where the
 // corresponding request message has used PageRequest.
 //
@@ -129,7 +141,10 @@ func (m *PageRequest) GetReverse() bool {
 type PageResponse struct {
 	// next_key is the key to be passed to PageRequest.key to
 	// query the next page most efficiently. It will be empty if
-	// there are no more results.
+	// there are no more results. It must be passed as is: it is the
+	// last key of the page suffixed with a zero byte in ascending
+	// order and the last key of the page, excluded from the next page,
+	// in reverse order, rather than the first key of the next page.
 	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
 	// total is total number of results available if PageRequest.count_total
 	// was set, its value is undefined otherwise
@@ -193,27 +208,28 @@ func init() {
 }
 
 var fileDescriptor_53d6d609fe6828af = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x3b, 0xf6, 0x97, 0x69, 0x41, 0x19, 0x45, 0x52, 0x17, 0x31, 0x74, 0x15, 0x84, 0xce,
-	0x58, 0xea, 0x5e, 0xe8, 0xd6, 0x8d, 0x04, 0x57, 0x6e, 0xca, 0xa4, 0xde, 0xd6, 0xd0, 0x36, 0x93,
-	0x66, 0x6e, 0x8a, 0x79, 0x0b, 0x5f, 0xc0, 0xb7, 0xf0, 0x21, 0x5c, 0x76, 0x29, 0xae, 0xa4, 0x7d,
-	0x11, 0x99, 0x99, 0x48, 0x77, 0x73, 0xce, 0xfd, 0x60, 0x3e, 0x0e, 0xbd, 0x99, 0x29, 0xbd, 0x56,
-	0x5a, 0xc4, 0x52, 0x83, 0xd8, 0x14, 0x90, 0x97, 0x62, 0x3b, 0x8a, 0x01, 0xe5, 0x48, 0x64, 0x72,
-	0x91, 0xa4, 0x12, 0x13, 0x95, 0xf2, 0x2c, 0x57, 0xa8, 0x58, 0xdf, 0xb1, 0xdc, 0xb0, 0xdc, 0xb2,
-	0xbc, 0x62, 0xaf, 0xaa, 0xd3, 0xd4, 0x82, 0xa2, 0xe2, 0x6c, 0x18, 0x7c, 0x10, 0xda, 0x7d, 0x94,
-	0x0b, 0x88, 0x60, 0x53, 0x80, 0x46, 0x76, 0x46, 0xeb, 0x4b, 0x28, 0x3d, 0x12, 0x90, 0xb0, 0x17,
-	0x99, 0x27, 0xbb, 0xa4, 0x2d, 0x35, 0x9f, 0x6b, 0x40, 0xef, 0x24, 0x20, 0x61, 0x23, 0xaa, 0x12,
-	0xbb, 0xa0, 0xcd, 0x55, 0xb2, 0x4e, 0xd0, 0xab, 0xdb, 0xda, 0x05, 0x76, 0x4d, 0xbb, 0x33, 0x55,
-	0xa4, 0x38, 0x45, 0x85, 0x72, 0xe5, 0x35, 0x02, 0x12, 0x76, 0x22, 0x6a, 0xab, 0x27, 0xd3, 0xb0,
-	0x21, 0x6d, 0xe7, 0xb0, 0x85, 0x5c, 0x83, 0xd7, 0x34, 0xc7, 0xc9, 0xf9, 0xcf, 0xe7, 0xf0, 0xd4,
-	0x39, 0x0d, 0xf5, 0xcb, 0x32, 0xb8, 0xe5, 0x77, 0xe3, 0xe8, 0x9f, 0x19, 0xdc, 0xd3, 0x9e, 0xd3,
-	0xd3, 0x99, 0x4a, 0x35, 0xb0, 0x3e, 0xed, 0xa4, 0xf0, 0x86, 0xd3, 0xa3, 0x64, 0xdb, 0xe4, 0x07,
-	0x28, 0x8d, 0x90, 0xfb, 0xd4, 0x79, 0xba, 0x30, 0x99, 0x7c, 0xed, 0x7d, 0xb2, 0xdb, 0xfb, 0xe4,
-	0x77, 0xef, 0x93, 0xf7, 0x83, 0x5f, 0xdb, 0x1d, 0xfc, 0xda, 0xf7, 0xc1, 0xaf, 0x3d, 0x87, 0x8b,
-	0x04, 0x5f, 0x8b, 0x98, 0xcf, 0xd4, 0xba, 0xda, 0x44, 0x1c, 0x35, 0x04, 0x96, 0x19, 0x68, 0xb7,
-	0x79, 0xdc, 0xb2, 0x5b, 0x8d, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa6, 0x93, 0x6e, 0x8f,
-	0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x6b, 0xfa, 0x57, 0x6e, 0x05, 0x95, 0x41, 0x28, 0x65, 0x08, 0x51, 0xa7, 0x08, 0xa9,
+	0x31, 0x55, 0x61, 0x44, 0x48, 0x65, 0x64, 0x41, 0x11, 0x13, 0x4b, 0x94, 0x94, 0x6b, 0x89, 0xda,
+	0xc6, 0x69, 0x7c, 0xa9, 0xc8, 0x5b, 0xf0, 0x30, 0x3c, 0x04, 0x63, 0x47, 0x84, 0x18, 0x50, 0xf3,
+	0x22, 0xc8, 0x71, 0xa0, 0x2c, 0x6c, 0xfe, 0xbe, 0xfb, 0xf9, 0x7c, 0xdf, 0x99, 0x9e, 0x4d, 0x84,
+	0x5c, 0x0a, 0xc9, 0x03, 0x5f, 0x02, 0x5f, 0xa5, 0x90, 0x64, 0x7c, 0x3d, 0x0c, 0x00, 0xfd, 0x21,
+	0x8f, 0xfd, 0x59, 0x18, 0xf9, 0x18, 0x8a, 0xc8, 0x89, 0x13, 0x81, 0x82, 0xf5, 0x34, 0xeb, 0x28,
+	0xd6, 0x29, 0x58, 0xa7, 0x64, 0x4f, 0xca, 0x92, 0x57, 0x80, 0xbc, 0xe4, 0x0a, 0xd1, 0xff, 0x24,
+	0xb4, 0x7d, 0xe7, 0xcf, 0xc0, 0x85, 0x55, 0x0a, 0x12, 0x59, 0x97, 0x56, 0xe7, 0x90, 0x19, 0xc4,
+	0x22, 0x76, 0xc7, 0x55, 0x47, 0x76, 0x4c, 0x1b, 0x62, 0x3a, 0x95, 0x80, 0xc6, 0x9e, 0x45, 0xec,
+	0x9a, 0x5b, 0x2a, 0x76, 0x44, 0xeb, 0x8b, 0x70, 0x19, 0xa2, 0x51, 0x2d, 0x6c, 0x2d, 0xd8, 0x29,
+	0x6d, 0x4f, 0x44, 0x1a, 0xa1, 0x87, 0x02, 0xfd, 0x85, 0x51, 0xb3, 0x88, 0xdd, 0x72, 0x69, 0x61,
+	0xdd, 0x2b, 0x87, 0x0d, 0x68, 0x33, 0x81, 0x35, 0x24, 0x12, 0x8c, 0xba, 0x2a, 0x8e, 0x0f, 0x3f,
+	0x5e, 0x07, 0x07, 0x7a, 0xa6, 0x81, 0x7c, 0x9c, 0x5b, 0xe7, 0xce, 0xc5, 0xc8, 0xfd, 0x61, 0xd8,
+	0x15, 0xed, 0xca, 0x79, 0x18, 0x7b, 0x7f, 0x9b, 0x36, 0xfe, 0xb9, 0x77, 0x39, 0x74, 0xf7, 0x15,
+	0x7c, 0xf3, 0xfb, 0x5a, 0xff, 0x9a, 0x76, 0x74, 0x3a, 0x19, 0x8b, 0x48, 0x02, 0xeb, 0xd1, 0x56,
+	0x04, 0xcf, 0xe8, 0xed, 0x32, 0x36, 0x95, 0xbe, 0x85, 0x4c, 0xe5, 0xd1, 0xed, 0x75, 0x4c, 0x2d,
+	0xc6, 0xe3, 0xb7, 0xad, 0x49, 0x36, 0x5b, 0x93, 0x7c, 0x6d, 0x4d, 0xf2, 0x92, 0x9b, 0x95, 0x4d,
+	0x6e, 0x56, 0xde, 0x73, 0xb3, 0xf2, 0x60, 0xcf, 0x42, 0x7c, 0x4a, 0x03, 0x67, 0x22, 0x96, 0xe5,
+	0x4a, 0xf9, 0x6e, 0x1a, 0x8e, 0x59, 0x0c, 0x52, 0x7f, 0x59, 0xd0, 0x28, 0x56, 0x3d, 0xfa, 0x1e,
+	0x00, 0x83, 0xb4, 0x8a, 0x0f, 0xce, 0x01, 0x00, 0x00,
 }
 
 func (m *PageRequest) Marshal() (dAtA []byte, err error) {
@@ -236,6 +252,16 @@ func (m *PageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SkipCountTotal {
+		i--
+		if m.SkipCountTotal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Reverse {
 		i--
 		if m.Reverse {
@@ -344,6 +370,9 @@ func (m *PageRequest) Size() (n int) {
 	if m.Reverse {
 		n += 2
 	}
+	if m.SkipCountTotal {
+		n += 2
+	}
 	return n
 }
 
@@ -510,6 +539,26 @@ func (m *PageRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Reverse = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCountTotal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPagination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCountTotal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPagination(dAtA[iNdEx:])
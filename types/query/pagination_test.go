@@ -0,0 +1,78 @@
+package query
+
+import (
+	"testing"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+)
+
+func TestPaginateInsertions(t *testing.T) {
+	store := dbadapter.Store{DB: db.NewMemDB()}
+	for _, key := range []string{"a", "c", "e", "g"} {
+		store.Set([]byte(key), []byte(key))
+	}
+
+	paginate := func(req *PageRequest) ([]string, *PageResponse) {
+		var results []string
+		res, err := Paginate(store, req, func(key, _ []byte) error {
+			results = append(results, string(key))
+			return nil
+		})
+		require.NoError(t, err)
+		return results, res
+	}
+
+	// the keys inserted right after the last key of a page are returned in the
+	// next page, in both orders.
+	results, res := paginate(&PageRequest{Limit: 2, CountTotal: true})
+	require.Equal(t, []string{"a", "c"}, results)
+	require.Equal(t, uint64(4), res.Total)
+	store.Set([]byte("d"), []byte("d"))
+	results, res = paginate(&PageRequest{Key: res.NextKey, Limit: 2})
+	require.Equal(t, []string{"d", "e"}, results)
+	results, res = paginate(&PageRequest{Key: res.NextKey, Limit: 2})
+	require.Equal(t, []string{"g"}, results)
+	require.Nil(t, res.NextKey)
+
+	results, res = paginate(&PageRequest{Offset: 1, Limit: 2, Reverse: true})
+	require.Equal(t, []string{"e", "d"}, results)
+	store.Set([]byte("cc"), []byte("cc"))
+	store.Delete([]byte("c"))
+	results, _ = paginate(&PageRequest{Key: res.NextKey, Limit: 2, Reverse: true})
+	require.Equal(t, []string{"cc", "a"}, results)
+
+	// the total is counted by default when the limit is not set, unless skipped.
+	_, res = paginate(nil)
+	require.Equal(t, uint64(5), res.Total)
+	_, res = paginate(&PageRequest{SkipCountTotal: true})
+	require.Zero(t, res.Total)
+}
+
+func TestPaginateCursorsBeforeUpgrade(t *testing.T) {
+	store := dbadapter.Store{DB: db.NewMemDB()}
+	for _, key := range []string{"a", "b", "c", "d"} {
+		store.Set([]byte(key), []byte(key))
+	}
+
+	paginate := func(req *PageRequest) []string {
+		var results []string
+		_, err := Paginate(store, req, func(key, _ []byte) error {
+			results = append(results, string(key))
+			return nil
+		})
+		require.NoError(t, err)
+		return results
+	}
+
+	// the cursors returned before the upgrade were the first key of the next
+	// page: "c" after the ascending page [a b] and "b" after the reverse page
+	// [d c].
+	require.Equal(t, []string{"c", "d"}, paginate(&PageRequest{Key: []byte("c"), Limit: 2}))
+
+	// the reverse cursors are exclusive, the first result of the next page is
+	// skipped.
+	require.Equal(t, []string{"a"}, paginate(&PageRequest{Key: []byte("b"), Limit: 2, Reverse: true}))
+}
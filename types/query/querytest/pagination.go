@@ -0,0 +1,103 @@
+// Package querytest provides conformance tests for the pagination of the gRPC
+// queries of modules.
+package querytest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Paginate paginates the results of a query given a PageRequest, e.g. a closure
+// calling query.CollectionPaginate or a gRPC query handler.
+type Paginate[T any] func(pageReq *query.PageRequest) ([]T, *query.PageResponse, error)
+
+// TestPagination asserts that paginate orders its results in a stable total
+// ordering, expected being all the results in that ordering. It asserts that:
+//   - paginating by key or by offset, forward or in reverse, with different page
+//     limits, never skips nor repeats a result and always returns them in the
+//     same ordering;
+//   - a reverse pagination returns the exact reverse ordering;
+//   - the total count matches the number of results.
+func TestPagination[T any](t *testing.T, expected []T, paginate Paginate[T]) {
+	t.Helper()
+
+	reversed := make([]T, len(expected))
+	for i, result := range expected {
+		reversed[len(expected)-1-i] = result
+	}
+
+	total := uint64(len(expected))
+	for _, reverse := range []bool{false, true} {
+		want := expected
+		if reverse {
+			want = reversed
+		}
+
+		results, pageRes, err := paginate(&query.PageRequest{Limit: total + 1, CountTotal: true, Reverse: reverse})
+		require.NoError(t, err)
+		require.Equal(t, total, pageRes.Total, "reverse %t: total count", reverse)
+		require.Empty(t, pageRes.NextKey, "reverse %t: next key of the last page", reverse)
+		requireResults(t, want, results, fmt.Sprintf("reverse %t: single page", reverse))
+
+		for _, limit := range []uint64{1, 2, 3, total} {
+			if limit == 0 {
+				continue
+			}
+			requireResults(t, want, paginateByKey(t, paginate, limit, reverse), fmt.Sprintf("reverse %t: by key with limit %d", reverse, limit))
+			requireResults(t, want, paginateByOffset(t, paginate, limit, reverse), fmt.Sprintf("reverse %t: by offset with limit %d", reverse, limit))
+		}
+	}
+}
+
+// paginateByKey collects all the results by following the next key of each page.
+func paginateByKey[T any](t *testing.T, paginate Paginate[T], limit uint64, reverse bool) []T {
+	t.Helper()
+
+	var (
+		all []T
+		key []byte
+	)
+	for {
+		results, pageRes, err := paginate(&query.PageRequest{Key: key, Limit: limit, Reverse: reverse})
+		require.NoError(t, err)
+		require.LessOrEqual(t, uint64(len(results)), limit)
+		all = append(all, results...)
+
+		if len(pageRes.NextKey) == 0 {
+			return all
+		}
+		require.Len(t, results, int(limit), "only the last page may contain fewer results than the limit")
+		key = pageRes.NextKey
+	}
+}
+
+// paginateByOffset collects all the results by increasing the offset of each page.
+func paginateByOffset[T any](t *testing.T, paginate Paginate[T], limit uint64, reverse bool) []T {
+	t.Helper()
+
+	var all []T
+	for offset := uint64(0); ; offset += limit {
+		results, _, err := paginate(&query.PageRequest{Offset: offset, Limit: limit, Reverse: reverse})
+		require.NoError(t, err)
+		require.LessOrEqual(t, uint64(len(results)), limit)
+		all = append(all, results...)
+
+		if uint64(len(results)) < limit {
+			return all
+		}
+	}
+}
+
+func requireResults[T any](t *testing.T, expected, got []T, msg string) {
+	t.Helper()
+
+	if len(expected) == 0 {
+		require.Empty(t, got, msg)
+		return
+	}
+	require.Equal(t, expected, got, msg)
+}
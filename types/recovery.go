@@ -0,0 +1,26 @@
+package types
+
+// RecoveryHandler converts a panic raised while running a transaction into an
+// error. It returns nil if the recovered value is not handled, in which case it
+// is passed to the next handler.
+type RecoveryHandler func(recoveryObj interface{}) error
+
+// RecoveryHandlerRegistry is the expected interface for registering recovery
+// handlers, implemented by BaseApp.
+type RecoveryHandlerRegistry interface {
+	AddRunTxRecoveryHandler(handlers ...RecoveryHandler)
+}
+
+// NewTypedRecoveryHandler returns a RecoveryHandler handling the recovered
+// values of type T with the given function, e.g. to convert a known panic value
+// into an error with a specific code. Values of other types are not handled.
+func NewTypedRecoveryHandler[T any](handler func(recoveryObj T) error) RecoveryHandler {
+	return func(recoveryObj interface{}) error {
+		obj, ok := recoveryObj.(T)
+		if !ok {
+			return nil
+		}
+
+		return handler(obj)
+	}
+}
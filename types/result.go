@@ -192,6 +192,22 @@ func (r TxResponse) GetTx() HasMsgs {
 	return nil
 }
 
+// GetTxMsgData decodes the TxMsgData from the hex encoded data of a TxResponse,
+// which holds the responses of the Msg handlers of the tx.
+func (r TxResponse) GetTxMsgData() (*TxMsgData, error) {
+	bz, err := hex.DecodeString(r.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var txMsgData TxMsgData
+	if err := txMsgData.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &txMsgData, nil
+}
+
 // calculate total pages in an overflow safe manner
 func calcTotalPages(totalCount, limit int64) int64 {
 	totalPages := int64(0)
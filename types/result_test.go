@@ -13,6 +13,7 @@ import (
 	cmt "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/suite"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -136,6 +137,24 @@ txhash: "74657374"
 	s.Require().Equal((*sdk.TxResponse)(nil), sdk.NewResponseFormatBroadcastTx(nil))
 }
 
+func (s *resultTestSuite) TestTxResponseGetTxMsgData() {
+	msgResponse, err := codectypes.NewAnyWithValue(&sdk.Result{Log: "log"})
+	s.Require().NoError(err)
+	txMsgData := &sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}}
+	bz, err := txMsgData.Marshal()
+	s.Require().NoError(err)
+
+	res := sdk.NewResponseResultTx(&coretypes.ResultTx{TxResult: abci.ExecTxResult{Data: bz}}, nil, "")
+	got, err := res.GetTxMsgData()
+	s.Require().NoError(err)
+	s.Require().Len(got.MsgResponses, 1)
+	s.Require().Equal(msgResponse.TypeUrl, got.MsgResponses[0].TypeUrl)
+	s.Require().Equal(msgResponse.Value, got.MsgResponses[0].Value)
+
+	_, err = sdk.TxResponse{Data: "not hex"}.GetTxMsgData()
+	s.Require().Error(err)
+}
+
 func (s *resultTestSuite) TestNewSearchBlocksResult() {
 	got := sdk.NewSearchBlocksResult(150, 20, 2, 20, []*cmtt.Block{})
 	s.Require().Equal(&sdk.SearchBlocksResult{
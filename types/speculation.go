@@ -0,0 +1,47 @@
+package types
+
+// speculationKey is the Context key of the Speculation of a tx execution.
+type speculationKey struct{}
+
+// Speculation is the speculative execution of a tx, run concurrently with the
+// other txs of a block before it is known whether its result is the same as
+// when executing the txs sequentially, see baseapp.SetParallelExecution.
+//
+// The accesses of a speculative execution to the stores are tracked, but not
+// its accesses to the state kept outside of the stores, e.g. in memory: the
+// components keeping such state must call InvalidateSpeculation before
+// accessing it.
+type Speculation struct {
+	invalid bool
+}
+
+// WithSpeculation returns a Context executing a tx speculatively, and its
+// Speculation.
+func WithSpeculation(ctx Context) (Context, *Speculation) {
+	s := &Speculation{}
+	return ctx.WithValue(speculationKey{}, s), s
+}
+
+// Invalid returns true if the result of the speculative execution must be
+// discarded, the tx being executed again sequentially.
+func (s *Speculation) Invalid() bool {
+	return s.invalid
+}
+
+// InvalidateSpeculation must be called before accessing state kept outside of
+// the stores while executing a tx. It returns true if the tx is executed
+// speculatively, in which case its result is discarded and the state must not
+// be modified: the tx is executed again sequentially.
+func InvalidateSpeculation(ctx Context) bool {
+	if ctx.Context() == nil {
+		return false
+	}
+
+	s, ok := ctx.Value(speculationKey{}).(*Speculation)
+	if !ok {
+		return false
+	}
+
+	s.invalid = true
+	return true
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StoreAccessPolicy is the capability map governing the stores a module can
+// obtain from the Context while it executes, see Context.WithExecutingModule.
+// A module is always granted the stores named after it, any other store must be
+// granted explicitly.
+//
+// The modules executing a Msg or an ABCI method call the keepers of other
+// modules with their own Context: the stores accessed by these keepers must be
+// granted to the calling module too, e.g. the bank store to the staking module.
+type StoreAccessPolicy struct {
+	grants map[string]map[string]struct{}
+}
+
+// NewStoreAccessPolicy returns a StoreAccessPolicy granting each module the
+// stores named after it only.
+func NewStoreAccessPolicy() *StoreAccessPolicy {
+	return &StoreAccessPolicy{grants: map[string]map[string]struct{}{}}
+}
+
+// Grant allows the module to obtain the stores with the given store key names.
+func (p *StoreAccessPolicy) Grant(module string, storeKeys ...string) *StoreAccessPolicy {
+	grants, ok := p.grants[module]
+	if !ok {
+		grants = map[string]struct{}{}
+		p.grants[module] = grants
+	}
+
+	for _, storeKey := range storeKeys {
+		grants[storeKey] = struct{}{}
+	}
+
+	return p
+}
+
+// CanAccess returns true if the module can obtain the store with the given
+// store key name.
+func (p *StoreAccessPolicy) CanAccess(module, storeKey string) bool {
+	if module == storeKey {
+		return true
+	}
+
+	_, ok := p.grants[module][storeKey]
+	return ok
+}
+
+// assertStoreAccess panics if the policy of the Context does not allow the
+// executing module to obtain the store of the key. The Contexts without a
+// policy or an executing module, e.g. in the ante handler or in queries, can
+// obtain any store.
+func (c Context) assertStoreAccess(key storetypes.StoreKey) {
+	if c.storeAccessPolicy == nil || c.executingModule == "" {
+		return
+	}
+
+	if !c.storeAccessPolicy.CanAccess(c.executingModule, key.Name()) {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s cannot access the %s store", c.executingModule, key.Name()))
+	}
+}
@@ -52,6 +52,20 @@ func GetMsgs(anys []*types.Any, name string) ([]sdk.Msg, error) {
 	return msgs, nil
 }
 
+var _ types.UnpackInterfacesMessage = &GetTxMsgResponsesResponse{}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *GetTxMsgResponsesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range m.MsgResponses {
+		var msgResponse MsgResponse
+		if err := unpacker.UnpackAny(any, &msgResponse); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // UnpackInterfaces unpacks Any's to sdk.Msg's.
 func UnpackInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
 	for _, any := range anys {
@@ -12,6 +12,7 @@ import (
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -598,6 +599,101 @@ func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
 	return nil
 }
 
+// GetTxMsgResponsesRequest is the request type for the Service.GetTxMsgResponses
+// RPC method.
+type GetTxMsgResponsesRequest struct {
+	// hash is the tx hash to query, encoded as a hex string.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetTxMsgResponsesRequest) Reset()         { *m = GetTxMsgResponsesRequest{} }
+func (m *GetTxMsgResponsesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxMsgResponsesRequest) ProtoMessage()    {}
+func (*GetTxMsgResponsesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *GetTxMsgResponsesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxMsgResponsesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxMsgResponsesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxMsgResponsesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxMsgResponsesRequest.Merge(m, src)
+}
+func (m *GetTxMsgResponsesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxMsgResponsesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxMsgResponsesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxMsgResponsesRequest proto.InternalMessageInfo
+
+func (m *GetTxMsgResponsesRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// GetTxMsgResponsesResponse is the response type for the Service.GetTxMsgResponses
+// method.
+type GetTxMsgResponsesResponse struct {
+	// msg_responses contains the responses of the Msg handlers of the tx, in the
+	// order of the messages of the tx. It is empty if the tx failed.
+	MsgResponses []*any.Any `protobuf:"bytes,1,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *GetTxMsgResponsesResponse) Reset()         { *m = GetTxMsgResponsesResponse{} }
+func (m *GetTxMsgResponsesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxMsgResponsesResponse) ProtoMessage()    {}
+func (*GetTxMsgResponsesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *GetTxMsgResponsesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxMsgResponsesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxMsgResponsesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxMsgResponsesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxMsgResponsesResponse.Merge(m, src)
+}
+func (m *GetTxMsgResponsesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxMsgResponsesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxMsgResponsesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxMsgResponsesResponse proto.InternalMessageInfo
+
+func (m *GetTxMsgResponsesResponse) GetMsgResponses() []*any.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
 // GetBlockWithTxsRequest is the request type for the Service.GetBlockWithTxs
 // RPC method.
 type GetBlockWithTxsRequest struct {
@@ -611,7 +707,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +765,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +833,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +880,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +927,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +974,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1020,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1066,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1112,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1158,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,6 +1205,8 @@ func init() {
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*GetTxMsgResponsesRequest)(nil), "cosmos.tx.v1beta1.GetTxMsgResponsesRequest")
+	proto.RegisterType((*GetTxMsgResponsesResponse)(nil), "cosmos.tx.v1beta1.GetTxMsgResponsesResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
 	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
 	proto.RegisterType((*TxDecodeRequest)(nil), "cosmos.tx.v1beta1.TxDecodeRequest")
@@ -1124,90 +1222,96 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xc7, 0xb3, 0x9b, 0x5f, 0xce, 0x73, 0x42, 0xcc, 0x24, 0x80, 0x31, 0xe0, 0x98, 0x85, 0x10,
-	0x13, 0x89, 0x5d, 0x62, 0x88, 0xa8, 0x50, 0xab, 0xca, 0x6b, 0x9b, 0x34, 0x50, 0x30, 0x5a, 0xa7,
-	0xaa, 0xa8, 0x2a, 0x59, 0x6b, 0x7b, 0xb0, 0xb7, 0xd8, 0xbb, 0xc6, 0x3b, 0x89, 0xd6, 0xa2, 0xa8,
-	0x55, 0x4f, 0x6d, 0xd5, 0xaa, 0x95, 0xfa, 0x17, 0xf4, 0xde, 0x23, 0x7f, 0x44, 0xc5, 0x09, 0xd1,
-	0x4b, 0xd5, 0x53, 0x05, 0x3d, 0xf5, 0xaf, 0xa8, 0x76, 0x66, 0xd6, 0xde, 0xb5, 0x77, 0x49, 0xe2,
-	0xde, 0x66, 0x76, 0xde, 0xbc, 0xf7, 0x99, 0xef, 0xcc, 0xbc, 0x37, 0x0b, 0x6b, 0x75, 0xcb, 0xee,
-	0x58, 0xb6, 0x42, 0x1c, 0xe5, 0x60, 0xab, 0x86, 0x89, 0xbe, 0xa5, 0xd8, 0xb8, 0x77, 0x60, 0xd4,
-	0xb1, 0xdc, 0xed, 0x59, 0xc4, 0x42, 0x27, 0x99, 0x81, 0x4c, 0x1c, 0x99, 0x1b, 0xa4, 0xce, 0x37,
-	0x2d, 0xab, 0xd9, 0xc6, 0x8a, 0xde, 0x35, 0x14, 0xdd, 0x34, 0x2d, 0xa2, 0x13, 0xc3, 0x32, 0x6d,
-	0x36, 0x21, 0x75, 0x89, 0x7b, 0xac, 0xe9, 0x36, 0x56, 0xf4, 0x5a, 0xdd, 0x18, 0x38, 0x76, 0x3b,
-	0xdc, 0x28, 0x35, 0x1e, 0x96, 0x38, 0x7c, 0x6c, 0xd3, 0xef, 0xe0, 0xe9, 0x3e, 0xee, 0xf5, 0x07,
-	0x36, 0x5d, 0xbd, 0x69, 0x98, 0x34, 0x1a, 0xb7, 0xbd, 0x50, 0xb7, 0x3a, 0x98, 0xd4, 0x1e, 0x13,
-	0x85, 0xf4, 0xbb, 0xd8, 0x56, 0x0e, 0xb6, 0x94, 0x5a, 0xdb, 0xaa, 0x3f, 0x89, 0x1e, 0xa6, 0x0d,
-	0x3e, 0x7c, 0x96, 0x45, 0xaa, 0xd2, 0x9e, 0xc2, 0x17, 0x4a, 0x3b, 0xd2, 0xb7, 0x22, 0xa0, 0x1d,
-	0x4c, 0xf6, 0x1c, 0xbb, 0x74, 0x80, 0x4d, 0xa2, 0xe1, 0xa7, 0xfb, 0xd8, 0x26, 0x28, 0x05, 0x73,
-	0xd8, 0xed, 0xdb, 0x49, 0x21, 0x33, 0x9d, 0x5d, 0x50, 0xc5, 0xa4, 0xa0, 0xf1, 0x2f, 0xe8, 0x2e,
-	0xc0, 0x90, 0x2f, 0x29, 0x66, 0x84, 0x6c, 0x3c, 0x77, 0x45, 0xe6, 0x5e, 0xdd, 0xc5, 0xc8, 0x74,
-	0x31, 0x9e, 0x8c, 0xf2, 0x43, 0xbd, 0x89, 0xb9, 0x5f, 0xea, 0xc7, 0x37, 0x1b, 0x6d, 0x43, 0xcc,
-	0xea, 0x35, 0x70, 0xaf, 0x5a, 0xeb, 0x27, 0xa7, 0x33, 0x42, 0xf6, 0x44, 0x2e, 0x25, 0x8f, 0x6d,
-	0x84, 0x5c, 0x76, 0x4d, 0xd4, 0xbe, 0x36, 0x6f, 0xb1, 0x06, 0x42, 0x30, 0xd3, 0xd5, 0x9b, 0x38,
-	0x39, 0x93, 0x11, 0xb2, 0x33, 0x1a, 0x6d, 0xa3, 0x55, 0x98, 0x6d, 0x1b, 0x1d, 0x83, 0x24, 0x67,
-	0xe9, 0x47, 0xd6, 0x41, 0x57, 0x61, 0x96, 0xd2, 0x24, 0xe7, 0x32, 0x42, 0x76, 0x41, 0x5d, 0xf9,
-	0xeb, 0xc5, 0xb5, 0x65, 0x16, 0xe0, 0x9a, 0xdd, 0x78, 0x92, 0xb9, 0x2e, 0x6f, 0x5f, 0xd7, 0x98,
-	0x85, 0xf4, 0xaf, 0x00, 0x2b, 0x01, 0x29, 0xec, 0xae, 0x65, 0xda, 0x18, 0x6d, 0xc0, 0x34, 0x71,
-	0x98, 0x10, 0xf1, 0xdc, 0xa9, 0x10, 0xbc, 0x3d, 0x47, 0x73, 0x2d, 0xd0, 0x0e, 0x2c, 0x12, 0xa7,
-	0xda, 0xe3, 0xf3, 0xec, 0xa4, 0x48, 0x67, 0x5c, 0x0e, 0x48, 0x43, 0xcf, 0x86, 0x6f, 0x22, 0x37,
-	0xd6, 0xe2, 0x64, 0xd0, 0xb6, 0xd1, 0xbd, 0x80, 0xc2, 0xd3, 0x54, 0xe1, 0x8d, 0x43, 0x15, 0x66,
-	0xb3, 0xc7, 0x24, 0x5e, 0x85, 0x59, 0x62, 0x11, 0xbd, 0xcd, 0xc5, 0x62, 0x1d, 0x09, 0x03, 0x52,
-	0x7b, 0x96, 0xde, 0xa8, 0xeb, 0x36, 0x71, 0x31, 0xd8, 0xb6, 0x9f, 0x85, 0x18, 0x71, 0xaa, 0xb5,
-	0x3e, 0xc1, 0xee, 0x7a, 0x85, 0xec, 0xa2, 0x36, 0x4f, 0x1c, 0xd5, 0xed, 0xa2, 0x9b, 0x30, 0xd3,
-	0xb1, 0x1a, 0x98, 0xee, 0xf7, 0x89, 0x5c, 0x26, 0x44, 0x86, 0x81, 0xbf, 0xfb, 0x56, 0x03, 0x6b,
-	0xd4, 0x5a, 0xfa, 0x1c, 0x56, 0x02, 0x61, 0xb8, 0xa4, 0x25, 0x88, 0xfb, 0x94, 0xa2, 0xa1, 0x8e,
-	0x2a, 0x14, 0x0c, 0x85, 0x92, 0xda, 0xb0, 0x5c, 0x31, 0x3a, 0xfb, 0x6d, 0x9d, 0x78, 0x07, 0x0c,
-	0x5d, 0x05, 0x91, 0x38, 0xdc, 0x61, 0xf8, 0x5e, 0x51, 0x81, 0x44, 0xe2, 0x20, 0xd9, 0xb7, 0x58,
-	0x77, 0x55, 0x8b, 0x21, 0xa7, 0xe3, 0xe6, 0x8d, 0x81, 0x02, 0xd2, 0xf7, 0x02, 0x24, 0x86, 0xe1,
-	0xf8, 0x4a, 0xde, 0x87, 0x58, 0x53, 0xb7, 0xab, 0x86, 0xf9, 0xd8, 0xe2, 0x51, 0x2f, 0x46, 0x2f,
-	0x63, 0x47, 0xb7, 0x77, 0xcd, 0xc7, 0x96, 0x36, 0xdf, 0x64, 0x0d, 0xf4, 0x1e, 0xcc, 0xf5, 0xb0,
-	0xbd, 0xdf, 0x26, 0xfc, 0x1a, 0x65, 0xa2, 0xe7, 0x6a, 0xd4, 0x4e, 0xe3, 0xf6, 0x92, 0x04, 0x8b,
-	0xf4, 0xac, 0x7a, 0xeb, 0x46, 0x30, 0xd3, 0xd2, 0xed, 0x16, 0x65, 0x58, 0xd0, 0x68, 0x5b, 0x7a,
-	0x0e, 0x4b, 0xdc, 0x86, 0xc3, 0xae, 0x1f, 0x2a, 0x0e, 0x15, 0x66, 0x64, 0x77, 0xc4, 0x09, 0x77,
-	0xe7, 0x27, 0x01, 0x4e, 0xef, 0x60, 0xa2, 0xba, 0x79, 0xea, 0x53, 0x83, 0xb4, 0xf6, 0x1c, 0xdb,
-	0xa3, 0x3d, 0x0d, 0x73, 0x2d, 0x6c, 0x34, 0x5b, 0x84, 0xc2, 0x4c, 0x6b, 0xbc, 0x87, 0xee, 0x4c,
-	0x9e, 0x5a, 0xfc, 0x67, 0xfe, 0xf6, 0xa9, 0xd7, 0x2f, 0xae, 0x9d, 0x0c, 0x6e, 0xe4, 0xb6, 0x9c,
-	0x93, 0xbe, 0x13, 0xe1, 0xcc, 0x18, 0xd1, 0x71, 0x6f, 0xf9, 0x36, 0xc4, 0x68, 0xea, 0xad, 0x1a,
-	0x0d, 0x4e, 0xe8, 0xa6, 0x2c, 0x96, 0x7e, 0x65, 0x96, 0x75, 0x0f, 0xb6, 0x64, 0x1a, 0x63, 0xb7,
-	0xa8, 0xcd, 0x53, 0xdb, 0xdd, 0x06, 0x92, 0x61, 0x96, 0x36, 0xf9, 0x75, 0x4e, 0x46, 0xcd, 0xd1,
-	0x98, 0x19, 0xda, 0x09, 0x48, 0x31, 0x73, 0xac, 0x1c, 0x70, 0x14, 0x2d, 0xf2, 0xb0, 0xbc, 0xe7,
-	0x14, 0x71, 0xdd, 0xbd, 0xab, 0x87, 0xde, 0xfe, 0xdb, 0x2b, 0xaf, 0xc7, 0x6e, 0xc6, 0x2d, 0xe9,
-	0x01, 0x24, 0x86, 0x2e, 0x8e, 0x75, 0xc4, 0xc2, 0xfd, 0xdd, 0x77, 0x91, 0x4a, 0xa6, 0x1f, 0xe9,
-	0xff, 0xb8, 0x53, 0x5d, 0x3c, 0xcf, 0x1d, 0xc7, 0x3b, 0xee, 0x12, 0xef, 0xc2, 0xaa, 0xe7, 0x23,
-	0xdf, 0x31, 0x4c, 0xcb, 0xe3, 0xba, 0x00, 0xa0, 0xbb, 0xfd, 0xea, 0x17, 0xb6, 0x65, 0xf2, 0x4b,
-	0xb7, 0x40, 0xbf, 0xdc, 0xb5, 0x2d, 0x33, 0xdc, 0x57, 0x19, 0x4e, 0x8d, 0xf8, 0xe2, 0x50, 0x17,
-	0x61, 0x91, 0x39, 0xab, 0x19, 0xa6, 0xde, 0xeb, 0x73, 0xb0, 0x38, 0xfd, 0xa6, 0xd2, 0x4f, 0x51,
-	0xfa, 0xaf, 0x7a, 0xfa, 0x07, 0xe0, 0x26, 0xf5, 0x77, 0xcf, 0x05, 0x0c, 0xf8, 0xe3, 0x80, 0x13,
-	0xac, 0x76, 0xf3, 0x23, 0x98, 0xe7, 0x65, 0x1b, 0x25, 0x61, 0xb5, 0xac, 0x15, 0x4b, 0x5a, 0x55,
-	0x7d, 0x54, 0xfd, 0xe4, 0x41, 0xe5, 0x61, 0xa9, 0xb0, 0x7b, 0x67, 0xb7, 0x54, 0x4c, 0x4c, 0xa1,
-	0x04, 0x2c, 0x0e, 0x46, 0xf2, 0x95, 0x42, 0x42, 0x40, 0x27, 0x61, 0x69, 0xf0, 0xa5, 0x58, 0xaa,
-	0x14, 0x12, 0xe2, 0xe6, 0xd7, 0x02, 0x2c, 0x05, 0x6a, 0x0b, 0x4a, 0x43, 0x4a, 0xd5, 0xca, 0xf9,
-	0x62, 0x21, 0x5f, 0xd9, 0xab, 0xde, 0x2f, 0x17, 0x4b, 0x23, 0x6e, 0xcf, 0xc3, 0xea, 0xc8, 0xb8,
-	0xfa, 0x71, 0xb9, 0x70, 0x2f, 0x21, 0xa4, 0xc4, 0x98, 0x80, 0xce, 0xc0, 0xca, 0xc8, 0x68, 0xe5,
-	0xd1, 0x83, 0x42, 0x42, 0x74, 0x39, 0x47, 0x06, 0xf2, 0x74, 0x64, 0x3a, 0xf7, 0x23, 0xc0, 0x7c,
-	0x85, 0x3d, 0x17, 0xd1, 0x33, 0x88, 0x79, 0x55, 0x00, 0x49, 0x21, 0x47, 0x72, 0xa4, 0x22, 0xa5,
-	0x2e, 0xbd, 0xd3, 0x86, 0xe7, 0xca, 0x2b, 0xdf, 0xfc, 0xf1, 0xcf, 0x2f, 0x62, 0xe6, 0xb6, 0xb0,
-	0x29, 0x9d, 0x53, 0x42, 0x9e, 0xaa, 0x5e, 0xc0, 0xa7, 0x30, 0x4b, 0x53, 0x3a, 0x5a, 0x0b, 0xf1,
-	0xea, 0x2f, 0x08, 0xa9, 0x4c, 0xb4, 0x01, 0x8f, 0xb9, 0x4e, 0x63, 0xae, 0xa1, 0x0b, 0x4a, 0xd8,
-	0x23, 0xd5, 0x56, 0x9e, 0xb9, 0x45, 0xe4, 0x39, 0xfa, 0x0a, 0xe2, 0xbe, 0x12, 0x8e, 0xd6, 0xdf,
-	0x55, 0xf9, 0x87, 0xe1, 0xaf, 0x1c, 0x66, 0xc6, 0x21, 0x2e, 0x52, 0x88, 0x73, 0xee, 0xc2, 0x4f,
-	0x87, 0x73, 0xa0, 0x2f, 0x21, 0xee, 0x7b, 0x96, 0x85, 0x02, 0x8c, 0xbf, 0x60, 0x43, 0x01, 0x42,
-	0x5e, 0x77, 0x52, 0x9a, 0x02, 0x24, 0x51, 0x54, 0xf4, 0xdf, 0x04, 0x58, 0x1e, 0xa9, 0x19, 0xe8,
-	0x6a, 0xb8, 0xef, 0x90, 0x4a, 0x97, 0xda, 0x3c, 0x8a, 0x29, 0x47, 0x51, 0x5f, 0x86, 0x65, 0x6a,
-	0xca, 0xb7, 0x81, 0xd6, 0x23, 0x76, 0x89, 0x56, 0x0b, 0xe5, 0x19, 0x2b, 0xa0, 0xcf, 0xd1, 0x0f,
-	0x02, 0xc4, 0xbc, 0x4b, 0x1c, 0x7a, 0x3c, 0x47, 0x92, 0x7e, 0xe8, 0xf1, 0x1c, 0xcd, 0xea, 0xd2,
-	0xad, 0x97, 0xe3, 0x37, 0x9c, 0x72, 0xa5, 0xdd, 0x8d, 0x3b, 0x1b, 0x82, 0xd6, 0x60, 0x04, 0x0c,
-	0x87, 0x25, 0xbd, 0x08, 0x9c, 0x40, 0xc2, 0x8f, 0xc0, 0x09, 0x66, 0xf1, 0x09, 0x70, 0x30, 0x23,
-	0xf8, 0x55, 0x80, 0xa5, 0x40, 0x0e, 0x46, 0x1b, 0xef, 0x88, 0xe7, 0x4f, 0xaa, 0xa9, 0xec, 0xe1,
-	0x86, 0x9c, 0xee, 0x83, 0x28, 0xba, 0xcb, 0x2e, 0xdd, 0x5a, 0x24, 0x9d, 0x42, 0x33, 0x2a, 0x67,
-	0xf4, 0xa5, 0xe1, 0x08, 0xc6, 0xf1, 0xc4, 0x1f, 0xc1, 0x18, 0x92, 0xd1, 0x27, 0x60, 0x64, 0x1b,
-	0xca, 0x18, 0xd5, 0x0f, 0x7f, 0x7f, 0x93, 0x16, 0x5e, 0xbd, 0x49, 0x0b, 0x7f, 0xbf, 0x49, 0x0b,
-	0x3f, 0xbf, 0x4d, 0x4f, 0xbd, 0x7a, 0x9b, 0x9e, 0xfa, 0xf3, 0x6d, 0x7a, 0xea, 0xb3, 0xf5, 0xa6,
-	0x41, 0x5a, 0xfb, 0x35, 0xf7, 0x75, 0xe3, 0x39, 0x19, 0x86, 0xe1, 0x7f, 0xa7, 0xc4, 0xa9, 0xcd,
-	0xd1, 0xbf, 0xcf, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x1d, 0xd5, 0xa1, 0x97, 0x0f,
-	0x00, 0x00,
+	// 1417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0x5d, 0x6f, 0x1b, 0x45,
+	0x17, 0xc7, 0xb3, 0xce, 0x9b, 0x73, 0xec, 0x34, 0xce, 0xc4, 0x6d, 0x1d, 0xb7, 0x75, 0xdc, 0x6d,
+	0xd3, 0xb8, 0xe9, 0x93, 0xdd, 0x26, 0x6d, 0xd4, 0x87, 0x08, 0x84, 0xbc, 0xb6, 0x1b, 0xd2, 0x92,
+	0xa6, 0x5a, 0x07, 0xa1, 0x22, 0x24, 0x6b, 0x6d, 0x4f, 0x9c, 0xa5, 0xf6, 0x6e, 0xea, 0x9d, 0x44,
+	0x6b, 0x95, 0x0a, 0xc4, 0x15, 0x20, 0x24, 0x90, 0xf8, 0x04, 0xdc, 0x73, 0x59, 0xf1, 0x11, 0x10,
+	0xea, 0x55, 0x55, 0x6e, 0x10, 0x57, 0xa8, 0xe1, 0x8a, 0x4f, 0x81, 0x76, 0x76, 0xd6, 0xde, 0xb5,
+	0x67, 0xf3, 0xc6, 0xdd, 0xcc, 0xce, 0x99, 0x73, 0x7e, 0x73, 0x66, 0xe6, 0xfc, 0xc7, 0x86, 0xb9,
+	0x9a, 0x69, 0xb5, 0x4c, 0x4b, 0x26, 0xb6, 0x7c, 0xb0, 0x5c, 0xc5, 0x44, 0x5b, 0x96, 0x2d, 0xdc,
+	0x3e, 0xd0, 0x6b, 0x58, 0xda, 0x6b, 0x9b, 0xc4, 0x44, 0xd3, 0xae, 0x81, 0x44, 0x6c, 0x89, 0x19,
+	0xa4, 0x2f, 0x37, 0x4c, 0xb3, 0xd1, 0xc4, 0xb2, 0xb6, 0xa7, 0xcb, 0x9a, 0x61, 0x98, 0x44, 0x23,
+	0xba, 0x69, 0x58, 0xee, 0x84, 0xf4, 0x2c, 0x1b, 0xa5, 0xbd, 0xea, 0xfe, 0x8e, 0xac, 0x19, 0x1d,
+	0x36, 0x74, 0x8d, 0x05, 0xab, 0x6a, 0x16, 0x96, 0xb5, 0x6a, 0x4d, 0xef, 0xc6, 0x74, 0x3a, 0xcc,
+	0x28, 0x3d, 0x48, 0x44, 0x6c, 0x36, 0xb6, 0xe8, 0x77, 0xf0, 0x6c, 0x1f, 0xb7, 0x3b, 0x5d, 0x9b,
+	0x3d, 0xad, 0xa1, 0x1b, 0x14, 0x84, 0xd9, 0x5e, 0xa9, 0x99, 0x2d, 0x4c, 0xaa, 0x3b, 0x44, 0x26,
+	0x9d, 0x3d, 0x6c, 0xc9, 0x07, 0xcb, 0x72, 0xb5, 0x69, 0xd6, 0x9e, 0x86, 0x0f, 0xd3, 0x86, 0xb7,
+	0x0a, 0x37, 0x52, 0x85, 0xf6, 0x64, 0x96, 0x03, 0xda, 0x11, 0xbf, 0x8e, 0x00, 0x5a, 0xc7, 0x64,
+	0xdb, 0xb6, 0x4a, 0x07, 0xd8, 0x20, 0x2a, 0x7e, 0xb6, 0x8f, 0x2d, 0x82, 0xd2, 0x30, 0x86, 0x9d,
+	0xbe, 0x95, 0x12, 0xb2, 0xc3, 0xb9, 0x09, 0x25, 0x92, 0x12, 0x54, 0xf6, 0x05, 0x3d, 0x00, 0xe8,
+	0xf1, 0xa5, 0x22, 0x59, 0x21, 0x17, 0x5b, 0xb9, 0x21, 0x31, 0xaf, 0xce, 0x62, 0x24, 0xba, 0x18,
+	0x2f, 0xc3, 0xd2, 0x63, 0xad, 0x81, 0x99, 0x5f, 0xea, 0xc7, 0x37, 0x1b, 0xad, 0x42, 0xd4, 0x6c,
+	0xd7, 0x71, 0xbb, 0x52, 0xed, 0xa4, 0x86, 0xb3, 0x42, 0xee, 0xdc, 0x4a, 0x5a, 0x1a, 0xd8, 0x23,
+	0x69, 0xcb, 0x31, 0x51, 0x3a, 0xea, 0xb8, 0xe9, 0x36, 0x10, 0x82, 0x91, 0x3d, 0xad, 0x81, 0x53,
+	0x23, 0x59, 0x21, 0x37, 0xa2, 0xd2, 0x36, 0x4a, 0xc2, 0x68, 0x53, 0x6f, 0xe9, 0x24, 0x35, 0x4a,
+	0x3f, 0xba, 0x1d, 0x74, 0x13, 0x46, 0x29, 0x4d, 0x6a, 0x2c, 0x2b, 0xe4, 0x26, 0x94, 0x99, 0x3f,
+	0x5f, 0x2e, 0x4d, 0xb9, 0x01, 0x96, 0xac, 0xfa, 0xd3, 0xec, 0x6d, 0x69, 0xf5, 0xb6, 0xea, 0x5a,
+	0x88, 0xff, 0x08, 0x30, 0x13, 0x48, 0x85, 0xb5, 0x67, 0x1a, 0x16, 0x46, 0x0b, 0x30, 0x4c, 0x6c,
+	0x37, 0x11, 0xb1, 0x95, 0xf3, 0x1c, 0xbc, 0x6d, 0x5b, 0x75, 0x2c, 0xd0, 0x3a, 0xc4, 0x89, 0x5d,
+	0x69, 0xb3, 0x79, 0x56, 0x2a, 0x42, 0x67, 0x5c, 0x0f, 0xa4, 0x86, 0x9e, 0x0d, 0xdf, 0x44, 0x66,
+	0xac, 0xc6, 0x48, 0xb7, 0x6d, 0xa1, 0x87, 0x81, 0x0c, 0x0f, 0xd3, 0x0c, 0x2f, 0x1c, 0x9b, 0x61,
+	0x77, 0xf6, 0x40, 0x8a, 0x93, 0x30, 0x4a, 0x4c, 0xa2, 0x35, 0x59, 0xb2, 0xdc, 0x8e, 0x88, 0x01,
+	0x29, 0x6d, 0x53, 0xab, 0xd7, 0x34, 0x8b, 0x38, 0x18, 0xee, 0xb6, 0xcf, 0x42, 0x94, 0xd8, 0x95,
+	0x6a, 0x87, 0x60, 0x67, 0xbd, 0x42, 0x2e, 0xae, 0x8e, 0x13, 0x5b, 0x71, 0xba, 0xe8, 0x2e, 0x8c,
+	0xb4, 0xcc, 0x3a, 0xa6, 0xfb, 0x7d, 0x6e, 0x25, 0xcb, 0x49, 0x43, 0xd7, 0xdf, 0xa6, 0x59, 0xc7,
+	0x2a, 0xb5, 0x16, 0x3f, 0x85, 0x99, 0x40, 0x18, 0x96, 0xd2, 0x12, 0xc4, 0x7c, 0x99, 0xa2, 0xa1,
+	0x4e, 0x9a, 0x28, 0xe8, 0x25, 0x4a, 0x6c, 0xc2, 0x54, 0x59, 0x6f, 0xed, 0x37, 0x35, 0xe2, 0x1d,
+	0x30, 0x74, 0x13, 0x22, 0xc4, 0x66, 0x0e, 0xf9, 0x7b, 0x45, 0x13, 0x14, 0x21, 0x36, 0x92, 0x7c,
+	0x8b, 0x75, 0x56, 0x15, 0xe7, 0x9c, 0x8e, 0xbb, 0x77, 0xba, 0x19, 0x10, 0xbf, 0x15, 0x20, 0xd1,
+	0x0b, 0xc7, 0x56, 0xf2, 0x2e, 0x44, 0x1b, 0x9a, 0x55, 0xd1, 0x8d, 0x1d, 0x93, 0x45, 0xbd, 0x1a,
+	0xbe, 0x8c, 0x75, 0xcd, 0xda, 0x30, 0x76, 0x4c, 0x75, 0xbc, 0xe1, 0x36, 0xd0, 0xff, 0x61, 0xac,
+	0x8d, 0xad, 0xfd, 0x26, 0x61, 0xd7, 0x28, 0x1b, 0x3e, 0x57, 0xa5, 0x76, 0x2a, 0xb3, 0x17, 0x45,
+	0x88, 0xd3, 0xb3, 0xea, 0xad, 0x1b, 0xc1, 0xc8, 0xae, 0x66, 0xed, 0x52, 0x86, 0x09, 0x95, 0xb6,
+	0xc5, 0x17, 0x30, 0xc9, 0x6c, 0x18, 0xec, 0xfc, 0xb1, 0xc9, 0xa1, 0x89, 0xe9, 0xdb, 0x9d, 0xc8,
+	0x19, 0x77, 0xa7, 0x00, 0x29, 0x1a, 0x7e, 0xd3, 0x6a, 0x74, 0x8f, 0xf6, 0x11, 0xb8, 0x6b, 0x33,
+	0x6f, 0x06, 0xee, 0xe6, 0xb2, 0xf8, 0x14, 0x66, 0x39, 0x4e, 0xd8, 0x7a, 0xde, 0x81, 0xc9, 0x96,
+	0xd5, 0xf0, 0xdd, 0x38, 0xf7, 0x8e, 0x26, 0x25, 0xb7, 0x6a, 0x4b, 0x5e, 0xd5, 0x96, 0xf2, 0x46,
+	0x47, 0x8d, 0xb7, 0x7c, 0x2e, 0xf8, 0xc1, 0xbe, 0x17, 0xe0, 0xc2, 0x3a, 0x26, 0x8a, 0x53, 0x59,
+	0x3f, 0xd6, 0xc9, 0xee, 0xb6, 0xdd, 0x05, 0xbe, 0x00, 0x63, 0xbb, 0x58, 0x6f, 0xec, 0x12, 0x8a,
+	0x3c, 0xac, 0xb2, 0x1e, 0xba, 0x7f, 0xf6, 0x62, 0xe8, 0xbf, 0xa5, 0x6b, 0xe7, 0xdf, 0xbc, 0x5c,
+	0x9a, 0x0e, 0x1e, 0xbd, 0x55, 0x69, 0x45, 0xfc, 0x26, 0x02, 0x17, 0x07, 0x88, 0x4e, 0x5b, 0x97,
+	0x56, 0x21, 0x4a, 0xc5, 0xa2, 0xa2, 0xd7, 0x19, 0xa1, 0x53, 0x64, 0x5d, 0xc1, 0x90, 0x5c, 0x9d,
+	0x38, 0x58, 0x96, 0x68, 0x8c, 0x8d, 0xa2, 0x3a, 0x4e, 0x6d, 0x37, 0xea, 0x48, 0x82, 0x51, 0xda,
+	0x64, 0x05, 0x28, 0x15, 0x36, 0x47, 0x75, 0xcd, 0xd0, 0x7a, 0x20, 0x15, 0x23, 0xa7, 0xaa, 0x5a,
+	0x27, 0xc9, 0x45, 0x1e, 0xa6, 0xb6, 0xed, 0x22, 0xae, 0x39, 0xd5, 0xe5, 0xd8, 0x7a, 0xc5, 0xd9,
+	0xe0, 0xbb, 0xf7, 0xc4, 0x47, 0x90, 0xe8, 0xb9, 0x38, 0xd5, 0xa5, 0xe0, 0xfb, 0xdb, 0x74, 0x90,
+	0x4a, 0x86, 0x1f, 0xe9, 0xbf, 0xb8, 0x53, 0x1c, 0x3c, 0xcf, 0x1d, 0xc3, 0x3b, 0xed, 0x12, 0x1f,
+	0x40, 0xd2, 0xf3, 0x91, 0x6f, 0xe9, 0x86, 0xe9, 0x71, 0x5d, 0x01, 0xd0, 0x9c, 0x7e, 0xe5, 0x33,
+	0xcb, 0x34, 0xd8, 0xbd, 0x9b, 0xa0, 0x5f, 0x1e, 0x58, 0xa6, 0xc1, 0xf7, 0xb5, 0x05, 0xe7, 0xfb,
+	0x7c, 0x31, 0xa8, 0xab, 0x10, 0x77, 0x9d, 0x55, 0x75, 0x43, 0x6b, 0x77, 0x18, 0x58, 0x8c, 0x7e,
+	0x53, 0xe8, 0xa7, 0xb0, 0xfc, 0x27, 0xbd, 0xfc, 0x07, 0xe0, 0xce, 0xea, 0xef, 0xa1, 0x03, 0x18,
+	0xf0, 0xc7, 0x00, 0xcf, 0xb0, 0xda, 0xc5, 0x0f, 0x60, 0x9c, 0x3d, 0x34, 0x50, 0x0a, 0x92, 0x5b,
+	0x6a, 0xb1, 0xa4, 0x56, 0x94, 0x27, 0x95, 0x8f, 0x1e, 0x95, 0x1f, 0x97, 0x0a, 0x1b, 0xf7, 0x37,
+	0x4a, 0xc5, 0xc4, 0x10, 0x4a, 0x40, 0xbc, 0x3b, 0x92, 0x2f, 0x17, 0x12, 0x02, 0x9a, 0x86, 0xc9,
+	0xee, 0x97, 0x62, 0xa9, 0x5c, 0x48, 0x44, 0x16, 0xbf, 0x14, 0x60, 0x32, 0xa0, 0x86, 0x28, 0x03,
+	0x69, 0x45, 0xdd, 0xca, 0x17, 0x0b, 0xf9, 0xf2, 0x76, 0x65, 0x73, 0xab, 0x58, 0xea, 0x73, 0x7b,
+	0x19, 0x92, 0x7d, 0xe3, 0xca, 0x87, 0x5b, 0x85, 0x87, 0x09, 0x21, 0x1d, 0x89, 0x0a, 0xe8, 0x22,
+	0xcc, 0xf4, 0x8d, 0x96, 0x9f, 0x3c, 0x2a, 0x24, 0x22, 0x0e, 0x67, 0xdf, 0x40, 0x9e, 0x8e, 0x0c,
+	0xaf, 0xfc, 0x1a, 0x83, 0xf1, 0xb2, 0xfb, 0xf6, 0x45, 0xcf, 0x21, 0xea, 0xe9, 0x16, 0x12, 0x39,
+	0x47, 0xb2, 0x4f, 0x43, 0xd3, 0xd7, 0x8e, 0xb4, 0x61, 0xd5, 0xfd, 0xc6, 0x57, 0xbf, 0xff, 0xfd,
+	0x63, 0x24, 0x2b, 0x5e, 0x92, 0x39, 0x8f, 0x6e, 0x66, 0xbc, 0x26, 0x2c, 0xa2, 0x67, 0x30, 0x4a,
+	0x0b, 0x38, 0x9a, 0xe3, 0x78, 0xf5, 0x4b, 0x58, 0x3a, 0x1b, 0x6e, 0xc0, 0x62, 0xce, 0xd3, 0x98,
+	0x73, 0xe8, 0x8a, 0xcc, 0x7b, 0x56, 0x5b, 0xf2, 0x73, 0x47, 0x47, 0x5e, 0xa0, 0x5f, 0x04, 0x98,
+	0x1e, 0x10, 0x0d, 0x74, 0x2b, 0xcc, 0x3d, 0x47, 0x9f, 0xd2, 0xff, 0x3b, 0x99, 0x31, 0xe3, 0xba,
+	0xff, 0x6a, 0x50, 0x4c, 0x28, 0xea, 0x12, 0xba, 0x75, 0x24, 0xaa, 0x1c, 0x90, 0x2f, 0xf4, 0x05,
+	0xc4, 0x7c, 0xaf, 0x25, 0x34, 0x7f, 0xd4, 0x23, 0xab, 0x97, 0xb7, 0x1b, 0xc7, 0x99, 0x31, 0xca,
+	0xab, 0x14, 0xe9, 0xd2, 0x9a, 0xb0, 0x28, 0x5e, 0xe0, 0x53, 0xa1, 0xcf, 0x21, 0xe6, 0x7b, 0x01,
+	0x73, 0x01, 0x06, 0x7f, 0x2c, 0x70, 0x01, 0x38, 0x0f, 0x69, 0x31, 0x43, 0x01, 0x52, 0x28, 0x2c,
+	0xfa, 0xcf, 0x02, 0x4c, 0xf5, 0x89, 0x1d, 0xba, 0xc9, 0xf7, 0xcd, 0x91, 0xe8, 0xf4, 0xe2, 0x49,
+	0x4c, 0x19, 0x8a, 0xf2, 0x8a, 0x27, 0x31, 0x94, 0x6f, 0x01, 0xcd, 0x87, 0xec, 0x19, 0x95, 0x39,
+	0xf9, 0xb9, 0xab, 0xfc, 0x2f, 0xd0, 0x77, 0x02, 0x44, 0xbd, 0xea, 0xc3, 0xbd, 0x57, 0x7d, 0x6a,
+	0xc5, 0xbd, 0x57, 0xfd, 0x72, 0x24, 0xde, 0x7b, 0x35, 0x58, 0x9a, 0x28, 0x57, 0x46, 0x9c, 0xe5,
+	0x70, 0xd5, 0xe9, 0x6c, 0xe7, 0xa2, 0xb9, 0x38, 0x6e, 0xb5, 0x0e, 0xc1, 0x09, 0x28, 0x55, 0x08,
+	0x4e, 0x50, 0x7e, 0x4e, 0x8b, 0x83, 0x0d, 0x0f, 0xe7, 0x27, 0x01, 0x26, 0x03, 0xe2, 0x81, 0x16,
+	0x8e, 0x88, 0xe7, 0x57, 0x83, 0x74, 0xee, 0x78, 0x43, 0x46, 0xf7, 0x5e, 0x18, 0xdd, 0x75, 0x71,
+	0x2e, 0x94, 0x4e, 0xa6, 0x3a, 0xd0, 0x63, 0xf4, 0xe9, 0x47, 0x08, 0xe3, 0xa0, 0x62, 0x85, 0x30,
+	0x72, 0xa4, 0xe8, 0xb4, 0x8c, 0xee, 0x86, 0x76, 0x19, 0x95, 0xf7, 0x7f, 0x7b, 0x9b, 0x11, 0x5e,
+	0xbf, 0xcd, 0x08, 0x7f, 0xbd, 0xcd, 0x08, 0x3f, 0x1c, 0x66, 0x86, 0x5e, 0x1f, 0x66, 0x86, 0xfe,
+	0x38, 0xcc, 0x0c, 0x7d, 0x32, 0xdf, 0xd0, 0xc9, 0xee, 0x7e, 0xd5, 0x79, 0x96, 0x79, 0x7e, 0x7a,
+	0x61, 0xd8, 0x1f, 0x01, 0xc4, 0xae, 0x8e, 0xd1, 0x57, 0xf0, 0x9d, 0x7f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x75, 0x4c, 0xf5, 0xae, 0x1d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1226,6 +1330,8 @@ type ServiceClient interface {
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	// GetTxMsgResponses fetches the responses of the Msg handlers of a tx by hash.
+	GetTxMsgResponses(ctx context.Context, in *GetTxMsgResponsesRequest, opts ...grpc.CallOption) (*GetTxMsgResponsesResponse, error)
 	// BroadcastTx broadcast transaction.
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
@@ -1268,6 +1374,15 @@ func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grp
 	return out, nil
 }
 
+func (c *serviceClient) GetTxMsgResponses(ctx context.Context, in *GetTxMsgResponsesRequest, opts ...grpc.CallOption) (*GetTxMsgResponsesResponse, error) {
+	out := new(GetTxMsgResponsesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTxMsgResponses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
 	out := new(BroadcastTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/BroadcastTx", in, out, opts...)
@@ -1337,6 +1452,8 @@ type ServiceServer interface {
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
+	// GetTxMsgResponses fetches the responses of the Msg handlers of a tx by hash.
+	GetTxMsgResponses(context.Context, *GetTxMsgResponsesRequest) (*GetTxMsgResponsesResponse, error)
 	// BroadcastTx broadcast transaction.
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
@@ -1363,6 +1480,9 @@ func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRe
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
+func (*UnimplementedServiceServer) GetTxMsgResponses(ctx context.Context, req *GetTxMsgResponsesRequest) (*GetTxMsgResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxMsgResponses not implemented")
+}
 func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
 }
@@ -1425,6 +1545,24 @@ func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetTxMsgResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxMsgResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTxMsgResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetTxMsgResponses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTxMsgResponses(ctx, req.(*GetTxMsgResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BroadcastTxRequest)
 	if err := dec(in); err != nil {
@@ -1563,6 +1701,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
 		},
+		{
+			MethodName: "GetTxMsgResponses",
+			Handler:    _Service_GetTxMsgResponses_Handler,
+		},
 		{
 			MethodName: "BroadcastTx",
 			Handler:    _Service_BroadcastTx_Handler,
@@ -1966,6 +2108,73 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetTxMsgResponsesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxMsgResponsesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxMsgResponsesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxMsgResponsesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxMsgResponsesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxMsgResponsesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2493,6 +2702,34 @@ func (m *GetTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetTxMsgResponsesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxMsgResponsesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetBlockWithTxsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3661,6 +3898,172 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetTxMsgResponsesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxMsgResponsesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxMsgResponsesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxMsgResponsesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxMsgResponsesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxMsgResponsesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &any.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package tx
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -13,16 +14,20 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -374,6 +379,10 @@ type TxBody struct {
 	// will be used to correspond to a height in which the transaction is deemed
 	// valid.
 	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain. It complements timeout_height, allowing clients
+	// to bound the validity of a transaction without estimating block heights.
+	TimeoutTimestamp *time.Time `protobuf:"bytes,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -445,6 +454,13 @@ func (m *TxBody) GetUnordered() bool {
 	return false
 }
 
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
 func (m *TxBody) GetExtensionOptions() []*any.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -817,6 +833,11 @@ type Fee struct {
 	// payer's own balance. If an appropriate fee grant does not exist or the
 	// chain does not support fee grants, this will fail
 	Granter string `protobuf:"bytes,4,opt,name=granter,proto3" json:"granter,omitempty"`
+	// if set, the fee is split between several payers, each paying its share of
+	// every fee coin. The payers are required signers of the transaction, the
+	// shares must sum to one, and payer and granter must be unset. Transactions
+	// splitting their fee must be signed with SIGN_MODE_DIRECT.
+	PayerShares []FeePayerShare `protobuf:"bytes,5,rep,name=payer_shares,json=payerShares,proto3" json:"payer_shares"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -880,6 +901,61 @@ func (m *Fee) GetGranter() string {
 	return ""
 }
 
+func (m *Fee) GetPayerShares() []FeePayerShare {
+	if m != nil {
+		return m.PayerShares
+	}
+	return nil
+}
+
+// FeePayerShare is the share of the fee of a transaction paid by a payer.
+type FeePayerShare struct {
+	// payer is the address of the account paying the share of the fee.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// share is the fraction of the fee paid by the payer.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *FeePayerShare) Reset()         { *m = FeePayerShare{} }
+func (m *FeePayerShare) String() string { return proto.CompactTextString(m) }
+func (*FeePayerShare) ProtoMessage()    {}
+func (*FeePayerShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{9}
+}
+func (m *FeePayerShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayerShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayerShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayerShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayerShare.Merge(m, src)
+}
+func (m *FeePayerShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayerShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayerShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayerShare proto.InternalMessageInfo
+
+func (m *FeePayerShare) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
 // Tip is the tip used for meta-transactions.
 //
 // Deprecated: Do not use.
@@ -894,7 +970,7 @@ func (m *Tip) Reset()         { *m = Tip{} }
 func (m *Tip) String() string { return proto.CompactTextString(m) }
 func (*Tip) ProtoMessage()    {}
 func (*Tip) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{9}
+	return fileDescriptor_96d1575ffde80842, []int{10}
 }
 func (m *Tip) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,7 +1036,7 @@ func (m *AuxSignerData) Reset()         { *m = AuxSignerData{} }
 func (m *AuxSignerData) String() string { return proto.CompactTextString(m) }
 func (*AuxSignerData) ProtoMessage()    {}
 func (*AuxSignerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{10}
+	return fileDescriptor_96d1575ffde80842, []int{11}
 }
 func (m *AuxSignerData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,6 +1105,7 @@ func init() {
 	proto.RegisterType((*ModeInfo_Single)(nil), "cosmos.tx.v1beta1.ModeInfo.Single")
 	proto.RegisterType((*ModeInfo_Multi)(nil), "cosmos.tx.v1beta1.ModeInfo.Multi")
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
+	proto.RegisterType((*FeePayerShare)(nil), "cosmos.tx.v1beta1.FeePayerShare")
 	proto.RegisterType((*Tip)(nil), "cosmos.tx.v1beta1.Tip")
 	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
 }
@@ -1036,77 +1113,85 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1106 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xfa, 0x63, 0xbe, 0xed, 0x57, 0x8e, 0x4b, 0xdd, 0xe0,
-	0xaa, 0x60, 0x55, 0x64, 0xb7, 0x4d, 0x11, 0x94, 0x08, 0x51, 0xec, 0x86, 0x28, 0x55, 0x29, 0x48,
-	0x9b, 0x9c, 0x7a, 0x59, 0x8d, 0x77, 0x27, 0xeb, 0x51, 0xbd, 0x33, 0xcb, 0xce, 0x2c, 0x78, 0x8f,
-	0x9c, 0x38, 0x21, 0x55, 0x5c, 0x90, 0xf8, 0x0b, 0x10, 0xa7, 0x4a, 0x54, 0xe2, 0x5f, 0x28, 0xb7,
-	0x2a, 0x27, 0xc4, 0x01, 0xaa, 0xe4, 0xd0, 0x3f, 0x03, 0xb4, 0xb3, 0xb3, 0x9b, 0xb4, 0x75, 0x1d,
-	0x10, 0x48, 0x5c, 0x56, 0x33, 0x6f, 0x3f, 0xef, 0xcd, 0x67, 0xde, 0xfb, 0xcc, 0x7b, 0xd0, 0xf1,
-	0xb8, 0x08, 0xb9, 0xb0, 0xe5, 0xd4, 0xfe, 0xfc, 0xda, 0x88, 0x48, 0x7c, 0xcd, 0x96, 0x53, 0x2b,
-	0x8a, 0xb9, 0xe4, 0xe8, 0x4c, 0xfe, 0xcf, 0x92, 0x53, 0x4b, 0xff, 0xeb, 0x2c, 0xe7, 0x26, 0x57,
-	0x01, 0x6c, 0xfd, 0x5f, 0x6d, 0x3a, 0x67, 0x70, 0x48, 0x19, 0xb7, 0xd5, 0x57, 0x9b, 0xce, 0x06,
-	0x3c, 0xe0, 0x39, 0x34, 0x5b, 0x69, 0xeb, 0xaa, 0x3e, 0xd2, 0x8b, 0xd3, 0x48, 0x72, 0x3b, 0x4c,
-	0x26, 0x92, 0x0a, 0x1a, 0x94, 0xe7, 0x17, 0x06, 0x0d, 0xef, 0x6a, 0xf8, 0x08, 0x0b, 0x52, 0x62,
-	0x3c, 0x4e, 0x99, 0xfe, 0xff, 0xe6, 0xe1, 0x0d, 0x04, 0x0d, 0x18, 0x65, 0x87, 0x91, 0xf4, 0x5e,
-	0x03, 0x97, 0x03, 0xce, 0x83, 0x09, 0xb1, 0xd5, 0x6e, 0x94, 0xec, 0xda, 0x98, 0xa5, 0xf9, 0xaf,
-	0xde, 0xd7, 0x06, 0x54, 0x77, 0xa6, 0x68, 0x15, 0x6a, 0x23, 0xee, 0xa7, 0x6d, 0x63, 0xc5, 0xe8,
-	0x9f, 0x58, 0x5b, 0xb6, 0x5e, 0xba, 0xbf, 0xb5, 0x33, 0x1d, 0x72, 0x3f, 0x75, 0x14, 0x0c, 0xdd,
-	0x80, 0x16, 0x4e, 0xe4, 0xd8, 0xa5, 0x6c, 0x97, 0xb7, 0xab, 0xca, 0xe7, 0xfc, 0x0c, 0x9f, 0x41,
-	0x22, 0xc7, 0xb7, 0xd9, 0x2e, 0x77, 0x9a, 0x58, 0xaf, 0x50, 0x17, 0x20, 0xe3, 0x86, 0x65, 0x12,
-	0x13, 0xd1, 0x36, 0x57, 0xcc, 0xfe, 0xa2, 0x73, 0xc4, 0xd2, 0x63, 0x50, 0xdf, 0x99, 0x3a, 0xf8,
-	0x0b, 0x74, 0x01, 0x20, 0x3b, 0xca, 0x1d, 0xa5, 0x92, 0x08, 0xc5, 0x6b, 0xd1, 0x69, 0x65, 0x96,
-	0x61, 0x66, 0x40, 0x6f, 0xc0, 0xa9, 0x92, 0x81, 0xc6, 0x54, 0x15, 0x66, 0xa9, 0x38, 0x2a, 0xc7,
-	0x1d, 0x77, 0xde, 0x37, 0x06, 0x2c, 0x6c, 0xd3, 0x80, 0x6d, 0x70, 0xef, 0xdf, 0x3a, 0x72, 0x19,
-	0x9a, 0xde, 0x18, 0x53, 0xe6, 0x52, 0xbf, 0x6d, 0xae, 0x18, 0xfd, 0x96, 0xb3, 0xa0, 0xf6, 0xb7,
-	0x7d, 0x74, 0x19, 0x4e, 0x62, 0xcf, 0xe3, 0x09, 0x93, 0x2e, 0x4b, 0xc2, 0x11, 0x89, 0xdb, 0xb5,
-	0x15, 0xa3, 0x5f, 0x73, 0x96, 0xb4, 0xf5, 0x13, 0x65, 0xec, 0x7d, 0x55, 0x85, 0xd3, 0x9a, 0xd4,
-	0x06, 0x8d, 0x89, 0x27, 0x07, 0xc9, 0xf4, 0x38, 0x76, 0xd7, 0x01, 0xa2, 0x64, 0x34, 0xa1, 0x9e,
-	0x7b, 0x9f, 0xa4, 0xba, 0x26, 0x67, 0xad, 0xbc, 0xf0, 0x56, 0x51, 0x78, 0x6b, 0xc0, 0x52, 0xa7,
-	0x95, 0xe3, 0xee, 0x90, 0xf4, 0x9f, 0x53, 0x45, 0x1d, 0x68, 0x0a, 0xf2, 0x59, 0x42, 0x98, 0x47,
-	0xda, 0x75, 0x05, 0x28, 0xf7, 0xe8, 0x2d, 0x30, 0x25, 0x8d, 0xda, 0x0d, 0xc5, 0xe5, 0xff, 0xb3,
-	0x34, 0x45, 0xa3, 0x61, 0xb5, 0x6d, 0x38, 0x19, 0x6c, 0xfd, 0x7f, 0x7b, 0x8f, 0x56, 0x4f, 0xe5,
-	0x98, 0x55, 0xe1, 0xdf, 0x5f, 0xb9, 0x6a, 0xbd, 0xfd, 0x4e, 0xef, 0xc7, 0x2a, 0x34, 0x72, 0xe5,
-	0xa1, 0xab, 0xd0, 0x0c, 0x89, 0x10, 0x38, 0x50, 0xb7, 0x37, 0x5f, 0x79, 0xbd, 0x12, 0x85, 0x10,
-	0xd4, 0x42, 0x12, 0xe6, 0x02, 0x6d, 0x39, 0x6a, 0x9d, 0x5d, 0x4b, 0xd2, 0x90, 0xf0, 0x44, 0xba,
-	0x63, 0x42, 0x83, 0xb1, 0x54, 0xf7, 0xae, 0x39, 0x4b, 0xda, 0xba, 0xa5, 0x8c, 0xe8, 0x35, 0x68,
-	0x25, 0x8c, 0xc7, 0x3e, 0x89, 0x89, 0xaf, 0x2e, 0xde, 0x74, 0x0e, 0x0d, 0x68, 0x08, 0x67, 0xc8,
-	0x54, 0x12, 0x26, 0x28, 0x67, 0x2e, 0x8f, 0x24, 0xe5, 0x4c, 0xb4, 0xff, 0x58, 0x98, 0x43, 0xea,
-	0x74, 0x89, 0xff, 0x34, 0x87, 0xa3, 0x7b, 0xd0, 0x65, 0x9c, 0xb9, 0x5e, 0x4c, 0x25, 0xf5, 0xf0,
-	0xc4, 0x9d, 0x11, 0xf0, 0xd4, 0x9c, 0x80, 0xe7, 0x19, 0x67, 0xb7, 0xb4, 0xef, 0x47, 0x2f, 0xc4,
-	0xee, 0xfd, 0x64, 0x40, 0xb3, 0x78, 0x7b, 0xe8, 0x43, 0x58, 0xcc, 0xf4, 0x4e, 0x62, 0x25, 0xdc,
-	0x22, 0x77, 0x17, 0x66, 0x94, 0x63, 0x5b, 0xc1, 0xd4, 0x83, 0x3d, 0x21, 0xca, 0xb5, 0x40, 0x7d,
-	0x30, 0x77, 0x09, 0xd1, 0x9a, 0x9a, 0x55, 0xc7, 0x4d, 0x42, 0x9c, 0x0c, 0x82, 0x6e, 0xe6, 0x15,
-	0x37, 0xe7, 0x56, 0xfc, 0xdc, 0xaf, 0x2f, 0x17, 0x5a, 0x8b, 0xa0, 0xf7, 0xad, 0x01, 0x70, 0x48,
-	0xe3, 0x05, 0x51, 0x1b, 0x7f, 0x4d, 0xd4, 0x37, 0xa0, 0x15, 0x72, 0x9f, 0x1c, 0xd7, 0x9c, 0xee,
-	0x72, 0x9f, 0xe4, 0xcd, 0x29, 0xd4, 0xab, 0xe7, 0xc4, 0x6c, 0x3e, 0x2f, 0xe6, 0xde, 0xd3, 0x2a,
-	0x34, 0x0b, 0x17, 0xf4, 0x3e, 0x34, 0x04, 0x65, 0xc1, 0x84, 0x68, 0x4e, 0xbd, 0x39, 0xf1, 0xad,
-	0x6d, 0x85, 0xdc, 0xaa, 0x38, 0xda, 0x07, 0xbd, 0x07, 0x75, 0xd5, 0xe9, 0x35, 0xb9, 0xd7, 0xe7,
-	0x39, 0xdf, 0xcd, 0x80, 0x5b, 0x15, 0x27, 0xf7, 0xe8, 0x0c, 0xa0, 0x91, 0x87, 0x43, 0xef, 0x42,
-	0x2d, 0xe3, 0xad, 0x08, 0x9c, 0x5c, 0xbb, 0x74, 0x24, 0x46, 0xd1, 0xfb, 0x8f, 0x96, 0x35, 0x8b,
-	0xe7, 0x28, 0x87, 0xce, 0x03, 0x03, 0xea, 0x2a, 0x2a, 0xba, 0x03, 0xcd, 0x11, 0x95, 0x38, 0x8e,
-	0x71, 0x91, 0x5b, 0xbb, 0x08, 0x93, 0x4f, 0x28, 0xab, 0x1c, 0x48, 0x45, 0xac, 0x5b, 0x3c, 0x8c,
-	0xb0, 0x27, 0x87, 0x54, 0x0e, 0x32, 0x37, 0xa7, 0x0c, 0x80, 0xd6, 0x01, 0xca, 0xac, 0x67, 0x8d,
-	0xd1, 0x3c, 0x2e, 0xed, 0xad, 0x22, 0xed, 0x62, 0x58, 0x07, 0x53, 0x24, 0x61, 0xef, 0xcb, 0x2a,
-	0x98, 0x9b, 0x84, 0xa0, 0x14, 0x1a, 0x38, 0xcc, 0x7a, 0x8c, 0xd6, 0x6a, 0x39, 0x8e, 0xb2, 0x41,
-	0x78, 0x84, 0x0a, 0x65, 0xc3, 0xcd, 0xc7, 0xbf, 0x5d, 0xac, 0xfc, 0xf0, 0xfb, 0xc5, 0x7e, 0x40,
-	0xe5, 0x38, 0x19, 0x59, 0x1e, 0x0f, 0xed, 0x62, 0xc8, 0x96, 0x0a, 0xb3, 0x65, 0x1a, 0x11, 0xa1,
-	0x1c, 0xc4, 0x77, 0xcf, 0x1e, 0x5e, 0x59, 0x9c, 0x90, 0x00, 0x7b, 0xa9, 0x9b, 0x8d, 0x52, 0xf1,
-	0xfd, 0xb3, 0x87, 0x57, 0x0c, 0x47, 0x1f, 0x88, 0xce, 0x43, 0x2b, 0xc0, 0xc2, 0x9d, 0xd0, 0x90,
-	0x4a, 0x55, 0x9e, 0x9a, 0xd3, 0x0c, 0xb0, 0xf8, 0x38, 0xdb, 0x23, 0x0b, 0xea, 0x11, 0x4e, 0x49,
-	0x9c, 0xb7, 0xca, 0x61, 0x7b, 0xef, 0xd1, 0xea, 0x59, 0xcd, 0x6c, 0xe0, 0xfb, 0x31, 0x11, 0x62,
-	0x5b, 0xc6, 0x94, 0x05, 0x4e, 0x0e, 0x43, 0x6b, 0xb0, 0x10, 0xc4, 0x98, 0x49, 0xdd, 0x3b, 0xe7,
-	0x79, 0x14, 0xc0, 0xde, 0xcf, 0x06, 0x98, 0x3b, 0x34, 0xfa, 0x2f, 0x73, 0x70, 0x15, 0x1a, 0x92,
-	0x46, 0x11, 0x89, 0xf3, 0xc6, 0x39, 0x87, 0xb5, 0xc6, 0xad, 0x9f, 0xdb, 0x9b, 0xf5, 0xa2, 0x7b,
-	0x07, 0x06, 0x2c, 0x0d, 0x92, 0x69, 0xfe, 0x9e, 0x37, 0xb0, 0xc4, 0x59, 0x46, 0x70, 0x1e, 0x41,
-	0x09, 0x6e, 0x6e, 0x46, 0x34, 0x10, 0x7d, 0x00, 0xcd, 0x4c, 0xd1, 0xae, 0xcf, 0x3d, 0xfd, 0x60,
-	0x2e, 0xbd, 0xa2, 0x77, 0x1d, 0x1d, 0x97, 0xce, 0x82, 0xd0, 0x53, 0xbd, 0x78, 0x28, 0xe6, 0xdf,
-	0x7c, 0x28, 0xe8, 0x34, 0x98, 0x82, 0x06, 0xaa, 0x74, 0x8b, 0x4e, 0xb6, 0x9c, 0x39, 0xa2, 0x86,
-	0x37, 0x1f, 0xef, 0x77, 0x8d, 0x27, 0xfb, 0x5d, 0xe3, 0xe9, 0x7e, 0xd7, 0x78, 0x70, 0xd0, 0xad,
-	0x3c, 0x39, 0xe8, 0x56, 0x7e, 0x39, 0xe8, 0x56, 0xee, 0x5d, 0x3e, 0xbe, 0x20, 0xb6, 0x9c, 0x8e,
-	0x1a, 0xaa, 0x91, 0x5d, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xc2, 0x59, 0x2a, 0x91, 0x0a,
-	0x00, 0x00,
+	// 1246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd7, 0xbb, 0x9b, 0xdd, 0x97, 0xa4, 0x4d, 0x86, 0x16, 0x36, 0x1b, 0xba, 0x09, 0x5b,
+	0x15, 0xa2, 0x8a, 0xd8, 0x4d, 0x4a, 0xa1, 0x54, 0x88, 0xb2, 0xdb, 0x50, 0x5a, 0xb5, 0x05, 0xe4,
+	0xe4, 0x54, 0x21, 0x59, 0xb3, 0xf6, 0xc4, 0x3b, 0xea, 0xda, 0x63, 0x3c, 0x63, 0xd8, 0xfd, 0x05,
+	0x9c, 0x90, 0x2a, 0x2e, 0x08, 0x24, 0xee, 0x88, 0x53, 0x0f, 0x95, 0xf8, 0x0b, 0xe5, 0x56, 0xe5,
+	0x84, 0x7a, 0x68, 0xab, 0xe6, 0xd0, 0x9f, 0x01, 0x9a, 0xf1, 0xd8, 0x49, 0xd3, 0x4d, 0x52, 0x04,
+	0x12, 0x17, 0x6b, 0xe6, 0xcd, 0xf7, 0xde, 0xbc, 0xf7, 0xe6, 0xbd, 0xef, 0x19, 0x9a, 0x1e, 0xe3,
+	0x21, 0xe3, 0xb6, 0x18, 0xda, 0xdf, 0xac, 0xf6, 0x88, 0xc0, 0xab, 0xb6, 0x18, 0x5a, 0x71, 0xc2,
+	0x04, 0x43, 0x73, 0xd9, 0x99, 0x25, 0x86, 0x96, 0x3e, 0x6b, 0xce, 0x67, 0x22, 0x57, 0x01, 0x6c,
+	0x7d, 0xae, 0x36, 0xcd, 0x39, 0x1c, 0xd2, 0x88, 0xd9, 0xea, 0xab, 0x45, 0x27, 0x02, 0x16, 0xb0,
+	0x0c, 0x2a, 0x57, 0x5a, 0xba, 0xa2, 0xaf, 0xf4, 0x92, 0x51, 0x2c, 0x98, 0x1d, 0xa6, 0x03, 0x41,
+	0x39, 0x0d, 0x8a, 0xfb, 0x73, 0x81, 0x86, 0xb7, 0x34, 0xbc, 0x87, 0x39, 0x29, 0x30, 0x1e, 0xa3,
+	0x91, 0x3e, 0x7f, 0x67, 0x37, 0x02, 0x4e, 0x83, 0x88, 0x46, 0xbb, 0x96, 0xf4, 0x5e, 0x03, 0xe7,
+	0x03, 0xc6, 0x82, 0x01, 0xb1, 0xd5, 0xae, 0x97, 0x6e, 0xd9, 0x38, 0x1a, 0xe9, 0xa3, 0xc5, 0xfd,
+	0x47, 0x82, 0x86, 0x84, 0x0b, 0x1c, 0xc6, 0x19, 0xa0, 0xfd, 0xbd, 0x01, 0xa5, 0xcd, 0x21, 0x5a,
+	0x81, 0x72, 0x8f, 0xf9, 0xa3, 0x86, 0xb1, 0x64, 0x2c, 0x4f, 0xad, 0xcd, 0x5b, 0x2f, 0x25, 0xc8,
+	0xda, 0x1c, 0x76, 0x99, 0x3f, 0x72, 0x14, 0x0c, 0x5d, 0x84, 0x3a, 0x4e, 0x45, 0xdf, 0xa5, 0xd1,
+	0x16, 0x6b, 0x94, 0x94, 0xce, 0xc2, 0x18, 0x9d, 0x4e, 0x2a, 0xfa, 0xd7, 0xa3, 0x2d, 0xe6, 0xd4,
+	0xb0, 0x5e, 0xa1, 0x16, 0x80, 0x74, 0x1e, 0x8b, 0x34, 0x21, 0xbc, 0x61, 0x2e, 0x99, 0xcb, 0xd3,
+	0xce, 0x1e, 0x49, 0x3b, 0x82, 0xca, 0xe6, 0xd0, 0xc1, 0xdf, 0xa2, 0x53, 0x00, 0xf2, 0x2a, 0xb7,
+	0x37, 0x12, 0x84, 0x2b, 0xbf, 0xa6, 0x9d, 0xba, 0x94, 0x74, 0xa5, 0x00, 0xbd, 0x0d, 0xc7, 0x0b,
+	0x0f, 0x34, 0xa6, 0xa4, 0x30, 0x33, 0xf9, 0x55, 0x19, 0xee, 0xa8, 0xfb, 0x7e, 0x30, 0x60, 0x72,
+	0x83, 0x06, 0xd1, 0x3a, 0xf3, 0xfe, 0xab, 0x2b, 0xe7, 0xa1, 0xe6, 0xf5, 0x31, 0x8d, 0x5c, 0xea,
+	0x37, 0xcc, 0x25, 0x63, 0xb9, 0xee, 0x4c, 0xaa, 0xfd, 0x75, 0x1f, 0x9d, 0x81, 0x63, 0xd8, 0xf3,
+	0x58, 0x1a, 0x09, 0x37, 0x4a, 0xc3, 0x1e, 0x49, 0x1a, 0xe5, 0x25, 0x63, 0xb9, 0xec, 0xcc, 0x68,
+	0xe9, 0xe7, 0x4a, 0xd8, 0xfe, 0xae, 0x04, 0xb3, 0xda, 0xa9, 0x75, 0x9a, 0x10, 0x4f, 0x74, 0xd2,
+	0xe1, 0x51, 0xde, 0x9d, 0x07, 0x88, 0xd3, 0xde, 0x80, 0x7a, 0xee, 0x1d, 0x32, 0xd2, 0x6f, 0x72,
+	0xc2, 0xca, 0x9e, 0xdf, 0xca, 0x9f, 0xdf, 0xea, 0x44, 0x23, 0xa7, 0x9e, 0xe1, 0x6e, 0x90, 0xd1,
+	0xbf, 0x77, 0x15, 0x35, 0xa1, 0xc6, 0xc9, 0xd7, 0x29, 0x89, 0x3c, 0xd2, 0xa8, 0x28, 0x40, 0xb1,
+	0x47, 0xef, 0x82, 0x29, 0x68, 0xdc, 0xa8, 0x2a, 0x5f, 0x5e, 0x1f, 0x57, 0x53, 0x34, 0xee, 0x96,
+	0x1a, 0x86, 0x23, 0x61, 0x97, 0x5e, 0xdb, 0xbe, 0xbf, 0x72, 0x3c, 0xc3, 0xac, 0x70, 0xff, 0xce,
+	0xd2, 0x39, 0xeb, 0xbd, 0xf7, 0xdb, 0x3f, 0x99, 0x50, 0xcd, 0x2a, 0x0f, 0x9d, 0x83, 0x5a, 0x48,
+	0x38, 0xc7, 0x81, 0x8a, 0xde, 0x3c, 0x30, 0xbc, 0x02, 0x85, 0x10, 0x94, 0x43, 0x12, 0x66, 0x05,
+	0x5a, 0x77, 0xd4, 0x5a, 0x86, 0x25, 0x5b, 0x80, 0xa5, 0xc2, 0xed, 0x13, 0x1a, 0xf4, 0x85, 0x8a,
+	0xbb, 0xec, 0xcc, 0x68, 0xe9, 0x35, 0x25, 0x44, 0x6f, 0x42, 0x3d, 0x8d, 0x58, 0xe2, 0x93, 0x84,
+	0xf8, 0x2a, 0xf0, 0x9a, 0xb3, 0x2b, 0x40, 0x3e, 0xcc, 0xe5, 0x46, 0x8a, 0x7e, 0x52, 0xd1, 0x4f,
+	0xad, 0x35, 0x5f, 0xf2, 0x69, 0x33, 0x47, 0x74, 0x17, 0x1e, 0x3c, 0x5e, 0x34, 0xee, 0x3e, 0x59,
+	0x34, 0x1e, 0xed, 0x8f, 0xf4, 0xc2, 0xaa, 0x33, 0xab, 0x2d, 0x16, 0x70, 0xd4, 0x85, 0x39, 0x32,
+	0x14, 0x24, 0xe2, 0x94, 0x45, 0x2e, 0x8b, 0x05, 0x65, 0x11, 0x6f, 0xfc, 0x35, 0x79, 0x48, 0xe8,
+	0xb3, 0x05, 0xfe, 0x8b, 0x0c, 0x8e, 0x6e, 0x43, 0x2b, 0x62, 0x91, 0xeb, 0x25, 0x54, 0x50, 0x0f,
+	0x0f, 0xdc, 0x31, 0x06, 0x8f, 0x1f, 0x62, 0x70, 0x21, 0x62, 0xd1, 0x15, 0xad, 0xfb, 0xe9, 0x3e,
+	0xdb, 0xed, 0xdf, 0x0d, 0xa8, 0xe5, 0x1d, 0x8e, 0x3e, 0x81, 0x69, 0xd9, 0x55, 0x24, 0x51, 0xed,
+	0x91, 0xbf, 0xd0, 0xa9, 0x31, 0x8f, 0xbe, 0xa1, 0x60, 0x8a, 0x16, 0xa6, 0x78, 0xb1, 0xe6, 0x68,
+	0x19, 0xcc, 0x2d, 0x42, 0x74, 0xe5, 0x8e, 0xab, 0x96, 0xab, 0x84, 0x38, 0x12, 0x82, 0x2e, 0x67,
+	0x75, 0x65, 0x1e, 0x5a, 0x57, 0x27, 0x1f, 0xbd, 0x5c, 0x4e, 0xba, 0xd4, 0xda, 0x3f, 0x1a, 0x00,
+	0xbb, 0x6e, 0xec, 0x6b, 0x1d, 0xe3, 0xd5, 0x5a, 0xe7, 0x22, 0xd4, 0x43, 0xe6, 0x93, 0xa3, 0x28,
+	0xf0, 0x16, 0xf3, 0x49, 0x46, 0x81, 0xa1, 0x5e, 0xbd, 0xd0, 0x32, 0xe6, 0x8b, 0x2d, 0xd3, 0x7e,
+	0x5a, 0x82, 0x5a, 0xae, 0x82, 0x3e, 0x82, 0x2a, 0xa7, 0x51, 0x30, 0x20, 0xda, 0xa7, 0xf6, 0x21,
+	0xf6, 0xad, 0x0d, 0x85, 0xbc, 0x36, 0xe1, 0x68, 0x1d, 0xf4, 0x21, 0x54, 0xd4, 0xc0, 0xd1, 0xce,
+	0xbd, 0x75, 0x98, 0xf2, 0x2d, 0x09, 0xbc, 0x36, 0xe1, 0x64, 0x1a, 0xcd, 0x0e, 0x54, 0x33, 0x73,
+	0xe8, 0x03, 0x28, 0x4b, 0xbf, 0x95, 0x03, 0xc7, 0xd6, 0x4e, 0xef, 0xb1, 0x91, 0x8f, 0xa0, 0xbd,
+	0xcf, 0x2a, 0xed, 0x39, 0x4a, 0xa1, 0x79, 0xd7, 0x80, 0x8a, 0xb2, 0x8a, 0x6e, 0x40, 0xad, 0x47,
+	0x05, 0x4e, 0x12, 0x9c, 0xe7, 0xd6, 0xce, 0xcd, 0x64, 0x83, 0xd2, 0x2a, 0xe6, 0x62, 0x6e, 0xeb,
+	0x0a, 0x0b, 0x63, 0xec, 0x89, 0x2e, 0x15, 0x1d, 0xa9, 0xe6, 0x14, 0x06, 0xd0, 0x25, 0x80, 0x22,
+	0xeb, 0x92, 0x7e, 0xcd, 0xa3, 0xd2, 0x5e, 0xcf, 0xd3, 0xce, 0xbb, 0x15, 0x30, 0x79, 0x1a, 0xb6,
+	0x9f, 0x94, 0xc0, 0xbc, 0x4a, 0x08, 0x1a, 0x41, 0x15, 0x87, 0x92, 0xc9, 0x74, 0xad, 0x16, 0x43,
+	0x4f, 0xce, 0xe3, 0x3d, 0xae, 0xd0, 0xa8, 0x7b, 0xf5, 0xc1, 0xe3, 0xc5, 0x89, 0xdf, 0x9e, 0x2c,
+	0x2e, 0x07, 0x54, 0xf4, 0xd3, 0x9e, 0xe5, 0xb1, 0xd0, 0xce, 0x67, 0x7d, 0x51, 0x61, 0xb6, 0x18,
+	0xc5, 0x84, 0x2b, 0x05, 0xfe, 0xf3, 0xf3, 0x7b, 0x67, 0xa7, 0x07, 0x24, 0xc0, 0xde, 0xc8, 0x95,
+	0x13, 0x9d, 0xff, 0xfa, 0xfc, 0xde, 0x59, 0xc3, 0xd1, 0x17, 0xa2, 0x05, 0xa8, 0x07, 0x98, 0xbb,
+	0x03, 0x1a, 0x52, 0xa1, 0x9e, 0xa7, 0xec, 0xd4, 0x02, 0xcc, 0x6f, 0xca, 0x3d, 0xb2, 0xa0, 0x12,
+	0xe3, 0x11, 0x49, 0x32, 0x42, 0xee, 0x36, 0xb6, 0xef, 0xaf, 0x9c, 0xd0, 0x9e, 0x75, 0x7c, 0x3f,
+	0x21, 0x9c, 0x6f, 0x88, 0x84, 0x46, 0x81, 0x93, 0xc1, 0xd0, 0x1a, 0x4c, 0x06, 0x09, 0x8e, 0x84,
+	0x66, 0xe8, 0xc3, 0x34, 0x72, 0x20, 0xfa, 0x0a, 0xa6, 0x95, 0xb2, 0xcb, 0xfb, 0x58, 0xce, 0xc5,
+	0x8a, 0xca, 0xc0, 0xd2, 0xf8, 0xa6, 0xfb, 0x52, 0x22, 0x37, 0x24, 0xb0, 0xfb, 0x86, 0x4c, 0xc4,
+	0x38, 0xf6, 0x9a, 0x8a, 0x0b, 0x10, 0x6f, 0xff, 0x62, 0xc0, 0xcc, 0x0b, 0x7a, 0xbb, 0x31, 0x19,
+	0xaf, 0x16, 0xd3, 0x67, 0x50, 0x51, 0x9e, 0x65, 0xd4, 0xdd, 0x5d, 0x55, 0xd7, 0x3e, 0x5e, 0x5c,
+	0xc8, 0x74, 0xb8, 0x7f, 0xc7, 0xa2, 0xcc, 0x0e, 0xb1, 0xe8, 0x5b, 0x37, 0x55, 0x92, 0xd7, 0x89,
+	0xb7, 0x7d, 0x7f, 0x05, 0xb4, 0xc9, 0x75, 0xe2, 0x39, 0x99, 0xfe, 0x98, 0xa1, 0x72, 0x61, 0xb5,
+	0xfd, 0x87, 0x01, 0xe6, 0x26, 0x8d, 0xff, 0xcf, 0x0a, 0x38, 0x07, 0x55, 0x41, 0xe3, 0x98, 0x24,
+	0x3a, 0xc2, 0x83, 0x33, 0xa2, 0x71, 0x97, 0x4e, 0x6e, 0x8f, 0xe3, 0xb3, 0xf6, 0x8e, 0x01, 0x33,
+	0x9d, 0x74, 0x98, 0xb1, 0xd9, 0x3a, 0x16, 0x58, 0xd6, 0x03, 0xce, 0x2c, 0x1c, 0x99, 0xed, 0x1c,
+	0x88, 0x3e, 0x86, 0x9a, 0xec, 0x67, 0xd7, 0x67, 0x9e, 0xa6, 0x8b, 0xd3, 0x07, 0x30, 0xf7, 0xde,
+	0x5f, 0x12, 0x67, 0x92, 0xeb, 0x3f, 0xa7, 0x9c, 0x26, 0xcc, 0x7f, 0x48, 0x13, 0x68, 0x16, 0x4c,
+	0x4e, 0x03, 0x55, 0xb8, 0xd3, 0x8e, 0x5c, 0x8e, 0xfd, 0x0d, 0xe8, 0x5e, 0x7e, 0xf0, 0xac, 0x65,
+	0x3c, 0x7c, 0xd6, 0x32, 0x9e, 0x3e, 0x6b, 0x19, 0x77, 0x77, 0x5a, 0x13, 0x0f, 0x77, 0x5a, 0x13,
+	0x7f, 0xee, 0xb4, 0x26, 0x6e, 0x9f, 0x39, 0xfa, 0x41, 0x6c, 0x31, 0xec, 0x55, 0x15, 0x8d, 0x9f,
+	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x91, 0x13, 0xef, 0x72, 0x16, 0x0c, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1383,6 +1468,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.TimeoutTimestamp != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Unordered {
 		i--
 		if m.Unordered {
@@ -1706,6 +1801,20 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayerShares) > 0 {
+		for iNdEx := len(m.PayerShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayerShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Granter) > 0 {
 		i -= len(m.Granter)
 		copy(dAtA[i:], m.Granter)
@@ -1742,6 +1851,46 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeePayerShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayerShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayerShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Tip) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1974,6 +2123,10 @@ func (m *TxBody) Size() (n int) {
 	if m.Unordered {
 		n += 2
 	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2122,6 +2275,27 @@ func (m *Fee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.PayerShares) > 0 {
+		for _, e := range m.PayerShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePayerShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -3007,6 +3181,42 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Unordered = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -3848,6 +4058,156 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			}
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayerShares = append(m.PayerShares, FeePayerShare{})
+			if err := m.PayerShares[len(m.PayerShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePayerShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayerShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayerShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package ante
 import (
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/types"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -23,12 +24,39 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// AuthenticationHandlers are the custom authentication handlers, keyed by
+	// credential type URL, see AuthenticationHandler.
+	AuthenticationHandlers map[string]AuthenticationHandler
+	// GaslessMsgTypeURLs are the type URLs of the Msgs for which transactions
+	// are gasless when all their signers pass the GaslessSignerCheck, see
+	// WithGaslessMsgs.
+	GaslessMsgTypeURLs []string
+	GaslessSignerCheck GaslessSignerCheck
+	// DecoratorTelemetry enables recording the gas consumed and the time spent
+	// by each decorator, see InstrumentedDecorator.
+	DecoratorTelemetry bool
+	// SimulationGasAdjustment, when set, multiplies the gas consumed in
+	// simulation by the decorators charging for the tx size and the signature
+	// verification, see SimulationGasPaddingDecorator. It must be at least 1.
+	SimulationGasAdjustment math.LegacyDec
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	anteDecorators, err := NewAnteDecorators(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// NewAnteDecorators returns the decorators chained by the AnteHandler returned
+// by NewAnteHandler. They can be set with BaseApp.SetAnteDecorators, so that
+// the app reports the composition of its AnteHandler.
+func NewAnteDecorators(options HandlerOptions) ([]sdk.AnteDecorator, error) {
 	if options.AccountKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -41,17 +69,42 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if len(options.GaslessMsgTypeURLs) > 0 && options.GaslessSignerCheck == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "gasless signer check is required for gasless msgs")
+	}
+
+	if !options.SimulationGasAdjustment.IsNil() && options.SimulationGasAdjustment.LT(math.LegacyOneDec()) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "simulation gas adjustment must be at least 1, got %s", options.SimulationGasAdjustment)
+	}
+
+	// padSimulationGas wraps the decorators whose gas consumption is only
+	// approximated in simulation.
+	padSimulationGas := func(decorator sdk.AnteDecorator) sdk.AnteDecorator {
+		if options.SimulationGasAdjustment.IsNil() || options.SimulationGasAdjustment.Equal(math.LegacyOneDec()) {
+			return decorator
+		}
+
+		return NewSimulationGasPaddingDecorator(options.SimulationGasAdjustment, decorator)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
-		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		NewSkipOnReCheckDecorator(options.Environment, NewExtensionOptionsDecorator(options.ExtensionOptionChecker)),
 		NewValidateBasicDecorator(options.Environment),
 		NewTxTimeoutHeightDecorator(),
+		NewValidateMsgCountDecorator(options.AccountKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
-		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewValidateSignersDecorator(options.AccountKeeper),
+		padSimulationGas(NewConsumeGasForTxSizeDecorator(options.AccountKeeper)),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker, WithGaslessMsgs(options.GaslessMsgTypeURLs, options.GaslessSignerCheck)),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		padSimulationGas(NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper, WithAuthenticationHandlers(options.AuthenticationHandlers))),
+		NewAccountRateLimitDecorator(options.AccountKeeper),
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	if options.DecoratorTelemetry {
+		anteDecorators = InstrumentDecorators(anteDecorators...)
+	}
+
+	return anteDecorators, nil
 }
@@ -0,0 +1,84 @@
+package ante
+
+import (
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ AuthenticationHandler = SigVerificationDecorator{}
+
+// AuthenticationRequest holds the information about a transaction signer that
+// an AuthenticationHandler needs to authenticate it.
+type AuthenticationRequest struct {
+	Tx authsigning.Tx
+	// Account is the signer account. Changes made to it by the handler are
+	// persisted once the signer is authenticated.
+	Account sdk.AccountI
+	// NewlyCreated is true when the account does not exist in state yet, which
+	// is the case for the first transaction sent by an account.
+	NewlyCreated bool
+	// Signature is the signature provided by the signer.
+	Signature signing.SignatureV2
+	// TxPubKey is the public key provided by the signer in the transaction,
+	// it may be nil.
+	TxPubKey cryptotypes.PubKey
+	// SignerIndex is the index of the signer in the transaction signers.
+	SignerIndex int
+}
+
+// AuthenticationHandler authenticates the signer of a transaction. A handler
+// is registered for a credential type, which is the type URL of the public key
+// set on the signer account, or provided in the transaction for accounts
+// without a public key yet.
+//
+// A handler fully replaces the default authentication flow: it is responsible
+// for setting the account public key when needed, consuming gas, verifying the
+// signature and protecting against replays, for instance by increasing the
+// account sequence.
+type AuthenticationHandler interface {
+	Authenticate(ctx sdk.Context, req AuthenticationRequest) error
+}
+
+// AuthenticationHandlerFunc is a function implementing AuthenticationHandler.
+type AuthenticationHandlerFunc func(ctx sdk.Context, req AuthenticationRequest) error
+
+// Authenticate implements AuthenticationHandler.
+func (f AuthenticationHandlerFunc) Authenticate(ctx sdk.Context, req AuthenticationRequest) error {
+	return f(ctx, req)
+}
+
+// SigVerificationOption configures a SigVerificationDecorator.
+type SigVerificationOption func(*SigVerificationDecorator)
+
+// WithAuthenticationHandlers registers the given authentication handlers,
+// keyed by credential type URL. Signers with other credential types are
+// authenticated by the default handler, the SigVerificationDecorator itself.
+func WithAuthenticationHandlers(handlers map[string]AuthenticationHandler) SigVerificationOption {
+	return func(svd *SigVerificationDecorator) {
+		if svd.authHandlers == nil {
+			svd.authHandlers = make(map[string]AuthenticationHandler, len(handlers))
+		}
+		for credentialType, handler := range handlers {
+			svd.authHandlers[credentialType] = handler
+		}
+	}
+}
+
+// authenticationHandler returns the authentication handler for the given signer.
+func (svd SigVerificationDecorator) authenticationHandler(acc sdk.AccountI, txPubKey cryptotypes.PubKey) AuthenticationHandler {
+	credential := acc.GetPubKey()
+	if credential == nil {
+		credential = txPubKey
+	}
+
+	if credential != nil {
+		if handler, ok := svd.authHandlers[sdk.MsgTypeURL(credential)]; ok {
+			return handler
+		}
+	}
+
+	return svd
+}
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	"cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
@@ -9,6 +11,7 @@ import (
 	authsigning "cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -79,10 +82,42 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool,
 	return next(ctx, tx, false)
 }
 
+// ValidateMsgCountDecorator will validate the number of messages in the tx
+// given the parameters passed in. If the tx contains more messages than allowed
+// the decorator returns with an error, otherwise it calls the next AnteHandler.
+// A zero MaxMsgsPerTx parameter disables the check.
+type ValidateMsgCountDecorator struct {
+	ak AccountKeeper
+}
+
+func NewValidateMsgCountDecorator(ak AccountKeeper) ValidateMsgCountDecorator {
+	return ValidateMsgCountDecorator{
+		ak: ak,
+	}
+}
+
+func (vmcd ValidateMsgCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := vmcd.ak.GetParams(ctx)
+	if params.MaxMsgsPerTx == 0 {
+		return next(ctx, tx, false)
+	}
+
+	msgCount := len(tx.GetMsgs())
+	if uint64(msgCount) > params.MaxMsgsPerTx {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrTooManyMsgs,
+			"maximum number of messages is %d but received %d messages",
+			params.MaxMsgsPerTx, msgCount,
+		)
+	}
+
+	return next(ctx, tx, false)
+}
+
 // ConsumeTxSizeGasDecorator will take in parameters and consume gas proportional
 // to the size of tx before calling next AnteHandler. Note, the gas costs will be
 // slightly over estimated due to the fact that any given signing account may need
-// to be retrieved from state.
+// to be retrieved from state. The compressed Anys of the messages are charged
+// the same cost per byte of their decompressed value.
 //
 // CONTRACT: If exec mode = simulate, then signatures must either be completely filled
 // in or empty.
@@ -107,6 +142,16 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ b
 
 	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*storetypes.Gas(len(ctx.TxBytes())), "txSize")
 
+	for _, msg := range tx.GetMsgs() {
+		size, err := codectypes.DecompressedSize(msg)
+		if err != nil {
+			return ctx, err
+		}
+		if size > 0 {
+			ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*size, "decompression")
+		}
+	}
+
 	// simulate gas cost for signatures in simulate mode
 	txService := cgts.ak.GetEnvironment().TransactionService
 	if txService.ExecMode(ctx) == transaction.ExecModeSimulate {
@@ -179,7 +224,7 @@ func isIncompleteSignature(data signing.SignatureData) bool {
 
 type (
 	// TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
-	// tx height timeout.
+	// tx height or timestamp timeout.
 	TxTimeoutHeightDecorator struct{}
 
 	// TxWithTimeoutHeight defines the interface a tx must implement in order for
@@ -189,10 +234,18 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithTimeoutTimestamp defines the interface a tx may implement in order
+	// for TxHeightTimeoutDecorator to check its timeout timestamp.
+	TxWithTimeoutTimestamp interface {
+		sdk.Tx
+
+		GetTimeoutTimestamp() time.Time
+	}
 )
 
 // TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
-// tx height timeout.
+// tx height or timestamp timeout.
 func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 	return TxTimeoutHeightDecorator{}
 }
@@ -200,7 +253,9 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx implements
+// TxWithTimeoutTimestamp and a timestamp timeout is provided (non-zero) and is
+// before the current block time, then an error is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -214,5 +269,15 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 		)
 	}
 
+	if timestampTx, ok := tx.(TxWithTimeoutTimestamp); ok {
+		timeoutTimestamp := timestampTx.GetTimeoutTimestamp()
+		blockTime := ctx.HeaderInfo().Time
+		if !timeoutTimestamp.IsZero() && timeoutTimestamp.Before(blockTime) {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeoutTimestamp,
+			)
+		}
+	}
+
 	return next(ctx, tx, false)
 }
@@ -3,9 +3,11 @@ package ante_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/ante"
 
@@ -94,6 +96,45 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestValidateMsgCount(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1)}
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	vmcd := ante.NewValidateMsgCountDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(vmcd)
+
+	// no limit by default
+	_, err = antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.MaxMsgsPerTx = 2
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	// require that txs with too many msgs get rejected
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrTooManyMsgs)
+
+	params.MaxMsgsPerTx = 3
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	_, err = antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 
@@ -192,16 +233,23 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
 
+	now := time.Now()
+
 	testCases := []struct {
-		name        string
-		timeout     uint64
-		height      int64
-		expectedErr error
+		name             string
+		timeout          uint64
+		height           int64
+		timeoutTimestamp time.Time
+		blockTime        time.Time
+		expectedErr      error
 	}{
-		{"default value", 0, 10, nil},
-		{"no timeout (greater height)", 15, 10, nil},
-		{"no timeout (same height)", 10, 10, nil},
-		{"timeout (smaller height)", 9, 10, sdkerrors.ErrTxTimeoutHeight},
+		{"default value", 0, 10, time.Time{}, now, nil},
+		{"no timeout (greater height)", 15, 10, time.Time{}, now, nil},
+		{"no timeout (same height)", 10, 10, time.Time{}, now, nil},
+		{"timeout (smaller height)", 9, 10, time.Time{}, now, sdkerrors.ErrTxTimeoutHeight},
+		{"no timeout (later timestamp)", 0, 10, now.Add(time.Minute), now, nil},
+		{"no timeout (same timestamp)", 0, 10, now, now, nil},
+		{"timeout (earlier timestamp)", 0, 10, now.Add(-time.Minute), now, sdkerrors.ErrTxTimeout},
 	}
 
 	for _, tc := range testCases {
@@ -216,12 +264,13 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 			suite.txBuilder.SetGasLimit(gasLimit)
 			suite.txBuilder.SetMemo(strings.Repeat("01234567890", 10))
 			suite.txBuilder.SetTimeoutHeight(tc.timeout)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeoutTimestamp)
 
 			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
 			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
 			require.NoError(t, err)
 
-			ctx := suite.ctx.WithBlockHeight(tc.height)
+			ctx := suite.ctx.WithBlockHeight(tc.height).WithHeaderInfo(header.Info{Height: tc.height, Time: tc.blockTime})
 			_, err = antehandler(ctx, tx, true)
 			require.ErrorIs(t, err, tc.expectedErr)
 		})
@@ -21,6 +21,7 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() address.Codec
 	GetEnvironment() appmodule.Environment
+	IncrementTxCount(ctx context.Context, addr sdk.AccAddress, window uint64) (uint64, error)
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -3,9 +3,11 @@ package ante
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,8 +18,50 @@ import (
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// FeePayerShare is the share of the fee of a transaction paid by a fee payer.
+type FeePayerShare struct {
+	Payer []byte
+	Share math.LegacyDec
+}
+
+// HasFeePayerSharesTx is implemented by the transactions able to split their
+// fee between several fee payers.
+type HasFeePayerSharesTx interface {
+	// FeePayerShares returns the shares of the fee paid by each fee payer, or
+	// nil if the fee is paid by the fee payer alone. The shares are expected to
+	// be positive and to sum to one.
+	FeePayerShares() []FeePayerShare
+}
+
+// DeductedFee is the fee deducted from the fee payers of a transaction by the
+// DeductFeeDecorator, e.g. for a post handler to refund a part of it.
+type DeductedFee struct {
+	// Fee is the total fee deducted.
+	Fee sdk.Coins
+	// Payers are the accounts the fee was deducted from, i.e. the fee granter
+	// instead of the fee payer when a fee grant is used, with their share.
+	Payers []FeePayerShare
+}
+
+type deductedFeeKey struct{}
+
+// SetDeductedFee returns the context of the transaction holding the deducted
+// fee. It allows apps deducting fees with their own decorator to have them
+// refunded by the FeeRefundDecorator post handler.
+func SetDeductedFee(ctx sdk.Context, deducted DeductedFee) sdk.Context {
+	return ctx.WithValue(deductedFeeKey{}, deducted)
+}
+
+// GetDeductedFee returns the fee deducted by the DeductFeeDecorator from the
+// context of the transaction, if any.
+func GetDeductedFee(ctx sdk.Context) (DeductedFee, bool) {
+	deducted, ok := ctx.Value(deductedFeeKey{}).(DeductedFee)
+	return deducted, ok
+}
+
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
+// If the tx splits its fee between several fee payers, each of them pays its share of the fees.
 // Call next AnteHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
@@ -25,19 +69,36 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
+
+	gaslessMsgs        map[string]struct{}
+	gaslessSignerCheck GaslessSignerCheck
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker, opts ...DeductFeeOption) DeductFeeDecorator {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = checkTxFeeWithMinGasPrices(ak)
 	}
 
-	return DeductFeeDecorator{
+	dfd := DeductFeeDecorator{
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		txFeeChecker:   tfc,
 	}
+	for _, opt := range opts {
+		opt(&dfd)
+	}
+
+	return dfd
+}
+
+// Describe implements sdk.AnteDecoratorDescriber.
+func (dfd DeductFeeDecorator) Describe() string {
+	if len(dfd.gaslessMsgs) == 0 {
+		return ""
+	}
+
+	return "gasless_msgs=" + strings.Join(sortedKeys(dfd.gaslessMsgs), ",")
 }
 
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
@@ -52,10 +113,15 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
 	}
 
-	var (
-		priority int64
-		err      error
-	)
+	gasless, err := dfd.isGasless(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if gasless {
+		return next(ctx, tx, false)
+	}
+
+	var priority int64
 
 	fee := feeTx.GetFee()
 	if execMode != transaction.ExecModeSimulate {
@@ -64,23 +130,34 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	deducted, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := SetDeductedFee(ctx.WithPriority(priority), deducted)
 
 	return next(newCtx, tx, false)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (DeductedFee, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return DeductedFee{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+		return DeductedFee{}, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+	}
+
+	if sharesTx, ok := sdkTx.(HasFeePayerSharesTx); ok {
+		if shares := sharesTx.FeePayerShares(); len(shares) > 0 {
+			if err := dfd.deductSplitFee(ctx, feeTx, fee, shares); err != nil {
+				return DeductedFee{}, err
+			}
+
+			return DeductedFee{Fee: fee, Payers: shares}, nil
+		}
 	}
 
 	feePayer := feeTx.FeePayer()
@@ -93,11 +170,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return DeductedFee{}, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return DeductedFee{}, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -108,7 +185,7 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	if !fee.IsZero() {
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee)
 		if err != nil {
-			return err
+			return DeductedFee{}, err
 		}
 	}
 
@@ -121,9 +198,62 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
+	return DeductedFee{
+		Fee:    fee,
+		Payers: []FeePayerShare{{Payer: deductFeesFrom, Share: math.LegacyOneDec()}},
+	}, nil
+}
+
+// deductSplitFee deducts from each fee payer its share of the fees.
+func (dfd DeductFeeDecorator) deductSplitFee(ctx sdk.Context, feeTx sdk.FeeTx, fee sdk.Coins, shares []FeePayerShare) error {
+	if feeTx.FeeGranter() != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("fee grants cannot be used to split fees")
+	}
+
+	amounts := SplitFee(fee, shares)
+	events := make(sdk.Events, len(shares))
+	for i, share := range shares {
+		if !amounts[i].IsZero() {
+			if err := DeductFees(dfd.bankKeeper, ctx, share.Payer, amounts[i]); err != nil {
+				return errorsmod.Wrapf(err, "fee payer %s", sdk.AccAddress(share.Payer))
+			}
+		}
+
+		events[i] = sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, amounts[i].String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(share.Payer).String()),
+		)
+	}
+	ctx.EventManager().EmitEvents(events)
+
 	return nil
 }
 
+// SplitFee returns the fees paid by each fee payer for the given shares. The
+// share of each fee coin is truncated, the remainder being paid by the first fee
+// payer, so that the fees are paid in full.
+func SplitFee(fee sdk.Coins, shares []FeePayerShare) []sdk.Coins {
+	amounts := make([]sdk.Coins, len(shares))
+	if len(shares) == 0 {
+		return amounts
+	}
+
+	remainder := fee
+	for i := 1; i < len(shares); i++ {
+		for _, coin := range fee {
+			amount := shares[i].Share.MulInt(coin.Amount).TruncateInt()
+			if amount.IsPositive() {
+				amounts[i] = amounts[i].Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		remainder = remainder.Sub(amounts[i]...)
+	}
+	amounts[0] = remainder
+
+	return amounts
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
+	authtx "cosmossdk.io/x/auth/tx"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -133,7 +134,103 @@ func TestDeductFees(t *testing.T) {
 	require.NotNil(t, err, "Tx did not error when fee payer had insufficient funds")
 
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
-	_, err = antehandler(s.ctx, tx, false)
+	newCtx, err := antehandler(s.ctx, tx, false)
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
+
+	// the deducted fee is recorded for the post handlers
+	deducted, ok := ante.GetDeductedFee(newCtx)
+	require.True(t, ok)
+	require.Equal(t, feeAmount, deducted.Fee)
+	require.Equal(t, []ante.FeePayerShare{{Payer: accs[0].acc.GetAddress(), Share: math.LegacyOneDec()}}, deducted.Payers)
+}
+
+func TestDeductFeeDecorator_FeePayerShares(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(3)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 7))
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	s.txBuilder.(authtx.FeePayerSharesTxBuilder).SetFeePayerShares(
+		ante.FeePayerShare{Payer: accs[1].acc.GetAddress(), Share: math.LegacyNewDecWithPrec(5, 1)},
+		ante.FeePayerShare{Payer: accs[2].acc.GetAddress(), Share: math.LegacyNewDecWithPrec(3, 1)},
+		ante.FeePayerShare{Payer: accs[0].acc.GetAddress(), Share: math.LegacyNewDecWithPrec(2, 1)},
+	)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv, accs[1].priv, accs[2].priv}, []uint64{0, 1, 2}, []uint64{0, 0, 0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// the shares are truncated, the remainder being paid by the first fee payer
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 75), sdk.NewInt64Coin("stake", 4))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[2].acc.GetAddress(), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 45), sdk.NewInt64Coin("stake", 2))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 30), sdk.NewInt64Coin("stake", 1))).Return(nil)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Len(t, ctx.EventManager().Events(), 3)
+
+	// a fee payer without sufficient funds fails the tx
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestDeductFeeDecorator_ParamsMinGasPrices(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := uint64(15)
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	setMinGasPrices := func(minGasPrices sdk.DecCoins) {
+		params := authtypes.DefaultParams()
+		params.MinGasPrices = minGasPrices
+		require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	}
+
+	// the min gas prices of the params are enforced in DeliverTx
+	setMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(20))))
+	finalizeCtx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	_, err = antehandler(finalizeCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// fee denoms must be accepted
+	setMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2))))
+	_, err = antehandler(finalizeCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	// the min gas prices of the params replace the validator min gas prices
+	setMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(10)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2)),
+	))
+	checkCtx := s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(20))))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(2)
+	_, err = antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+	_, err = antehandler(finalizeCtx, tx, false)
+	require.NoError(t, err)
 }
@@ -0,0 +1,74 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GaslessSignerCheck returns an error if the given signer is not allowed to
+// send gasless transactions, e.g. because it is not a bonded validator.
+type GaslessSignerCheck func(ctx sdk.Context, signer []byte) error
+
+// DeductFeeOption configures a DeductFeeDecorator.
+type DeductFeeOption func(*DeductFeeDecorator)
+
+// WithGaslessMsgs makes transactions consisting only of Msgs with the given
+// type URLs gasless: the min gas prices check and the fee deduction are
+// skipped for them, and the fee they set is not charged. The gas limit is
+// still enforced.
+//
+// As such transactions are free, the signer check guards against spam: all
+// the signers of a transaction must pass it for the transaction to be gasless,
+// otherwise it pays fees as usual. No transaction is gasless without a signer
+// check.
+func WithGaslessMsgs(msgTypeURLs []string, signerCheck GaslessSignerCheck) DeductFeeOption {
+	return func(dfd *DeductFeeDecorator) {
+		if dfd.gaslessMsgs == nil {
+			dfd.gaslessMsgs = make(map[string]struct{}, len(msgTypeURLs))
+		}
+		for _, msgTypeURL := range msgTypeURLs {
+			dfd.gaslessMsgs[msgTypeURL] = struct{}{}
+		}
+		dfd.gaslessSignerCheck = signerCheck
+	}
+}
+
+// isGasless returns true if the given transaction only contains gasless Msgs
+// and all its signers pass the gasless signer check.
+func (dfd DeductFeeDecorator) isGasless(ctx sdk.Context, tx sdk.Tx) (bool, error) {
+	if len(dfd.gaslessMsgs) == 0 || dfd.gaslessSignerCheck == nil {
+		return false, nil
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false, nil
+	}
+
+	for _, msg := range msgs {
+		if _, ok := dfd.gaslessMsgs[sdk.MsgTypeURL(msg)]; !ok {
+			return false, nil
+		}
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return false, err
+	}
+
+	for _, signer := range signers {
+		if dfd.gaslessSignerCheck(ctx, signer) != nil {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,69 @@
+package ante_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestDeductFeeDecorator_GaslessMsgs(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(2)
+	validator := accs[0].acc.GetAddress()
+	signerCheck := func(ctx sdk.Context, signer []byte) error {
+		if !bytes.Equal(signer, validator) {
+			return errors.New("not a validator")
+		}
+		return nil
+	}
+
+	msg := testdata.NewTestMsg(validator)
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// set high gas price so the test fee is too low
+	s.ctx = s.ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(20))})
+
+	// no fee is deducted, the bank keeper mock has no expectation
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, ante.WithGaslessMsgs([]string{sdk.MsgTypeURL(msg)}, signerCheck))
+	_, err = sdk.ChainAnteDecorators(dfd)(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// other msgs are not gasless
+	dfd = ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, ante.WithGaslessMsgs([]string{"/cosmos.bank.v1beta1.MsgSend"}, signerCheck))
+	_, err = sdk.ChainAnteDecorators(dfd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// gasless msgs require a signer check
+	dfd = ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, ante.WithGaslessMsgs([]string{sdk.MsgTypeURL(msg)}, nil))
+	_, err = sdk.ChainAnteDecorators(dfd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// all signers must pass the signer check
+	require.NoError(t, s.txBuilder.SetMsgs(msg, testdata.NewTestMsg(accs[1].acc.GetAddress())))
+	privs, accNums, accSeqs = []cryptotypes.PrivKey{accs[0].priv, accs[1].priv}, []uint64{0, 1}, []uint64{0, 0}
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	dfd = ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, ante.WithGaslessMsgs([]string{sdk.MsgTypeURL(msg)}, signerCheck))
+	_, err = sdk.ChainAnteDecorators(dfd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
@@ -0,0 +1,74 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AccountRateLimitDecorator limits the number of transactions each account can
+// sign within a window of blocks, as set by the MaxTxsPerAccount and
+// TxRateLimitWindow parameters. A zero MaxTxsPerAccount disables the check.
+//
+// Windows are aligned on block heights: a window of N blocks covers the heights
+// from k*N to (k+1)*N-1. The counters are kept in the x/auth store, as they must
+// outlive a single block. During CheckTx they are tracked in the check state,
+// so the limit also applies to the transactions waiting in the mempool.
+//
+// The decorator should be placed after signature verification, so that only
+// transactions actually signed by an account count against its limit.
+type AccountRateLimitDecorator struct {
+	ak AccountKeeper
+}
+
+func NewAccountRateLimitDecorator(ak AccountKeeper) AccountRateLimitDecorator {
+	return AccountRateLimitDecorator{
+		ak: ak,
+	}
+}
+
+func (arld AccountRateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := arld.ak.GetParams(ctx)
+	if params.MaxTxsPerAccount == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	windowLength := params.TxRateLimitWindow
+	if windowLength == 0 {
+		windowLength = 1
+	}
+	window := uint64(ctx.BlockHeight()) / windowLength
+
+	for _, signer := range signers {
+		count, err := arld.ak.IncrementTxCount(ctx, signer, window)
+		if err != nil {
+			return ctx, err
+		}
+
+		if count > params.MaxTxsPerAccount {
+			addr, err := arld.ak.AddressCodec().BytesToString(signer)
+			if err != nil {
+				return ctx, err
+			}
+
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrTxRateLimited,
+				"account %s has reached the limit of %d transactions per %d blocks",
+				addr, params.MaxTxsPerAccount, windowLength,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
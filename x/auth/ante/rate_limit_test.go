@@ -0,0 +1,68 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestAccountRateLimit(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+
+	createTx := func(priv cryptotypes.PrivKey, addr sdk.AccAddress) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+	tx1, tx2 := createTx(priv1, addr1), createTx(priv2, addr2)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewAccountRateLimitDecorator(suite.accountKeeper))
+
+	// no limit by default
+	ctx := suite.ctx.WithBlockHeight(3)
+	for i := 0; i < 5; i++ {
+		_, err := antehandler(ctx, tx1, false)
+		require.NoError(t, err)
+	}
+
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.MaxTxsPerAccount = 2
+	params.TxRateLimitWindow = 3
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	// at most 2 txs per account within the window covering heights 3 to 5
+	for i := 0; i < 2; i++ {
+		_, err := antehandler(ctx, tx1, false)
+		require.NoError(t, err)
+	}
+	_, err := antehandler(ctx, tx1, false)
+	require.ErrorIs(t, err, sdkerrors.ErrTxRateLimited)
+
+	// other accounts are not affected
+	_, err = antehandler(ctx, tx2, false)
+	require.NoError(t, err)
+
+	// the limit applies until the end of the window
+	_, err = antehandler(ctx.WithBlockHeight(5), tx1, false)
+	require.ErrorIs(t, err, sdkerrors.ErrTxRateLimited)
+
+	_, err = antehandler(ctx.WithBlockHeight(6), tx1, false)
+	require.NoError(t, err)
+}
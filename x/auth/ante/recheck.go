@@ -0,0 +1,88 @@
+package ante
+
+import (
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/transaction"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ sdk.AnteDecorator = SkipOnReCheckDecorator{}
+
+// SkipOnReCheckDecorator wraps an AnteDecorator which is skipped on ReCheckTx.
+// It marks decorators which only depend on the transaction itself, and not on
+// the state, as their checks cannot fail when the transaction is checked again
+// after a block is committed.
+type SkipOnReCheckDecorator struct {
+	env       appmodule.Environment
+	decorator sdk.AnteDecorator
+}
+
+// NewSkipOnReCheckDecorator returns a SkipOnReCheckDecorator wrapping the given
+// decorator.
+func NewSkipOnReCheckDecorator(env appmodule.Environment, decorator sdk.AnteDecorator) SkipOnReCheckDecorator {
+	return SkipOnReCheckDecorator{
+		env:       env,
+		decorator: decorator,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d SkipOnReCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeReCheck {
+		return next(ctx, tx, simulate)
+	}
+
+	return d.decorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// Describe implements sdk.AnteDecoratorDescriber.
+func (d SkipOnReCheckDecorator) Describe() string {
+	return describeWrapped("skipped on recheck", d.decorator)
+}
+
+// reCheckSequences is the fast path of the SigVerificationDecorator on
+// ReCheckTx. The signatures were verified when the transaction was first
+// checked and remain valid as long as the sequences of the signers are
+// unchanged: in that case, the sequences are increased without consuming gas
+// nor verifying the signatures again, and true is returned.
+//
+// Otherwise, or if a signer is not authenticated by the default flow, nothing
+// is changed and false is returned, so that the transaction goes through the
+// full authentication.
+func (svd SigVerificationDecorator) reCheckSequences(ctx sdk.Context, tx authsigning.Tx, signers [][]byte, signatures []signing.SignatureV2) (bool, error) {
+	accs := make([]sdk.AccountI, len(signers))
+	for i, signer := range signers {
+		if svd.aaKeeper != nil {
+			isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
+			if err != nil {
+				return false, err
+			}
+			if isAa {
+				return false, nil
+			}
+		}
+
+		acc := GetSignerAcc(ctx, svd.ak, signer)
+		if acc == nil || acc.GetPubKey() == nil || acc.GetSequence() != signatures[i].Sequence {
+			return false, nil
+		}
+
+		if _, ok := svd.authHandlers[sdk.MsgTypeURL(acc.GetPubKey())]; ok {
+			return false, nil
+		}
+
+		accs[i] = acc
+	}
+
+	for _, acc := range accs {
+		if err := svd.increaseSequence(tx, acc); err != nil {
+			return false, err
+		}
+		svd.ak.SetAccount(ctx, acc)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type failingDecorator struct{}
+
+func (failingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errors.New("decorator called")
+}
+
+func TestSkipOnReCheckDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	decorator := ante.NewSkipOnReCheckDecorator(suite.env, failingDecorator{})
+	antehandler := sdk.ChainAnteDecorators(decorator)
+
+	_, err := antehandler(suite.ctx, nil, false)
+	require.EqualError(t, err, "decorator called")
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), nil, false)
+	require.EqualError(t, err, "decorator called")
+
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), nil, false)
+	require.NoError(t, err)
+}
+
+func TestSigVerificationReCheckFastPath(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := suite.CreateTestAccounts(1)
+	priv, acc := accs[0].priv, accs[0].acc
+
+	msg := testdata.NewTestMsg(acc.GetAddress())
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	gasConsumed := 0
+	gasConsumer := func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error {
+		gasConsumed++
+		return ante.DefaultSigVerificationGasConsumer(meter, sig, params)
+	}
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), gasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the transaction is fully verified on CheckTx, which sets the public key
+	// and increases the sequence of the account
+	checkCtx := suite.ctx.WithIsCheckTx(true)
+	_, err = antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, gasConsumed)
+	require.Equal(t, uint64(1), suite.accountKeeper.GetAccount(suite.ctx, acc.GetAddress()).GetSequence())
+
+	// the next transaction is rechecked with an invalid signature: as the
+	// sequence is unchanged, the signature is neither verified nor charged
+	accSeqs = []uint64{1}
+	tx, err = suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	badSig, err := priv.Sign([]byte("unrelated message"))
+	require.NoError(t, err)
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: badSig},
+		Sequence: 1,
+	}))
+	tx = suite.txBuilder.GetTx()
+
+	reCheckCtx := suite.ctx.WithIsReCheckTx(true).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	cacheCtx, _ := reCheckCtx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, gasConsumed)
+	require.Equal(t, uint64(2), suite.accountKeeper.GetAccount(cacheCtx, acc.GetAddress()).GetSequence())
+
+	// the full verification is run when the sequence changed
+	acc = suite.accountKeeper.GetAccount(suite.ctx, acc.GetAddress())
+	require.NoError(t, acc.SetSequence(2))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	_, err = antehandler(reCheckCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	require.Equal(t, 2, gasConsumed)
+}
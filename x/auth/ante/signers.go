@@ -0,0 +1,124 @@
+package ante
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// ValidateSignersDecorator rejects the transactions whose signatures don't
+// match their required signers one to one: signatures provided for signers
+// which are not required, a signer signing multiple times, possibly with
+// conflicting sequences, a signature made by a required signer in the position
+// of another one, or the same signature provided multiple times.
+//
+// The decorator is stateless and should be placed before the fee deduction, so
+// that such transactions are rejected before consuming gas or paying fees.
+type ValidateSignersDecorator struct {
+	ak AccountKeeper
+}
+
+func NewValidateSignersDecorator(ak AccountKeeper) ValidateSignersDecorator {
+	return ValidateSignersDecorator{
+		ak: ak,
+	}
+}
+
+func (vsd ValidateSignersDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(signatures) > len(signers) {
+		extra := make([]string, 0, len(signatures)-len(signers))
+		for _, sig := range signatures[len(signers):] {
+			if sig.PubKey == nil {
+				extra = append(extra, "<unknown>")
+				continue
+			}
+
+			addr, err := vsd.ak.AddressCodec().BytesToString(sig.PubKey.Address())
+			if err != nil {
+				return ctx, err
+			}
+			extra = append(extra, addr)
+		}
+
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signatures provided for signers which are not required: %s; the tx requires %d signers", strings.Join(extra, ", "), len(signers))
+	}
+
+	signerIndexes := make(map[string]int, len(signers))
+	for i, signer := range signers {
+		signerIndexes[string(signer)] = i
+	}
+
+	pubKeyIndexes := make(map[string]int, len(signatures))
+	sigIndexes := make(map[string]int, len(signatures))
+	for i, sig := range signatures {
+		if sig.PubKey != nil {
+			if j, ok := pubKeyIndexes[string(sig.PubKey.Bytes())]; ok {
+				addr, err := vsd.ak.AddressCodec().BytesToString(sig.PubKey.Address())
+				if err != nil {
+					return ctx, err
+				}
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signer %s signs multiple times: signatures %d and %d with sequences %d and %d", addr, j, i, signatures[j].Sequence, sig.Sequence)
+			}
+			pubKeyIndexes[string(sig.PubKey.Bytes())] = i
+
+			if j, ok := signerIndexes[string(sig.PubKey.Address())]; ok && j != i {
+				addr, err := vsd.ak.AddressCodec().BytesToString(signers[j])
+				if err != nil {
+					return ctx, err
+				}
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature %d is made by signer %s, required to sign at index %d", i, addr, j)
+			}
+		}
+
+		for _, sigBz := range leafSignatures(sig.Data) {
+			// the signatures are empty in simulation.
+			if len(sigBz) == 0 {
+				continue
+			}
+
+			if j, ok := sigIndexes[string(sigBz)]; ok && j != i {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signatures %d and %d are identical", j, i)
+			}
+			sigIndexes[string(sigBz)] = i
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// leafSignatures returns the raw signatures of the signature data, i.e. the
+// signatures of the members of a multisig.
+func leafSignatures(data signing.SignatureData) [][]byte {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return [][]byte{data.Signature}
+	case *signing.MultiSignatureData:
+		var sigs [][]byte
+		for _, d := range data.Signatures {
+			sigs = append(sigs, leafSignatures(d)...)
+		}
+		return sigs
+	default:
+		return nil
+	}
+}
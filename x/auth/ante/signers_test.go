@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestValidateSigners(t *testing.T) {
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	priv3, _, addr3 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name    string
+		privs   []cryptotypes.PrivKey
+		accSeqs []uint64
+		sigs    func(sigs []signing.SignatureV2) []signing.SignatureV2
+		expErr  string
+	}{
+		{
+			name:    "valid",
+			privs:   []cryptotypes.PrivKey{priv1, priv2},
+			accSeqs: []uint64{0, 0},
+		},
+		{
+			name:    "non-required signer",
+			privs:   []cryptotypes.PrivKey{priv1, priv2, priv3},
+			accSeqs: []uint64{0, 0, 0},
+			expErr:  "signatures provided for signers which are not required: " + addr3.String() + "; the tx requires 2 signers",
+		},
+		{
+			name:    "duplicate signer with conflicting sequences",
+			privs:   []cryptotypes.PrivKey{priv1, priv1},
+			accSeqs: []uint64{0, 1},
+			expErr:  "signer " + addr1.String() + " signs multiple times: signatures 0 and 1 with sequences 0 and 1",
+		},
+		{
+			name:    "signers out of order",
+			privs:   []cryptotypes.PrivKey{priv2, priv1},
+			accSeqs: []uint64{0, 0},
+			expErr:  "signature 0 is made by signer " + addr2.String() + ", required to sign at index 1",
+		},
+		{
+			name:    "identical signatures",
+			privs:   []cryptotypes.PrivKey{priv1, priv2},
+			accSeqs: []uint64{0, 0},
+			sigs: func(sigs []signing.SignatureV2) []signing.SignatureV2 {
+				sigs[1].Data = sigs[0].Data
+				return sigs
+			},
+			expErr: "signatures 0 and 1 are identical",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			accNums := make([]uint64, len(tc.privs))
+			tx, err := suite.CreateTestTx(suite.ctx, tc.privs, accNums, tc.accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			if tc.sigs != nil {
+				sigs, err := tx.GetSignaturesV2()
+				require.NoError(t, err)
+				require.NoError(t, suite.txBuilder.SetSignatures(tc.sigs(sigs)...))
+				tx = suite.txBuilder.GetTx()
+			}
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewValidateSignersDecorator(suite.accountKeeper))
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
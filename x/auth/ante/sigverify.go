@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -73,15 +74,21 @@ type SigVerificationDecorator struct {
 	aaKeeper        AccountAbstractionKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+	authHandlers    map[string]AuthenticationHandler
 }
 
-func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
-	return SigVerificationDecorator{
+func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper, opts ...SigVerificationOption) SigVerificationDecorator {
+	svd := SigVerificationDecorator{
 		aaKeeper:        aaKeeper,
 		ak:              ak,
 		signModeHandler: signModeHandler,
 		sigGasConsumer:  sigGasConsumer,
 	}
+	for _, opt := range opts {
+		opt(&svd)
+	}
+
+	return svd
 }
 
 // OnlyLegacyAminoSigners checks SignatureData to see if all
@@ -150,6 +157,15 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 	return nil
 }
 
+// Describe implements sdk.AnteDecoratorDescriber.
+func (svd SigVerificationDecorator) Describe() string {
+	if len(svd.authHandlers) == 0 {
+		return ""
+	}
+
+	return "authentication_handlers=" + strings.Join(sortedKeys(svd.authHandlers), ",")
+}
+
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	sigTx, ok := tx.(authsigning.Tx)
 	if !ok {
@@ -173,6 +189,18 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(signatures))
 	}
 
+	// on ReCheckTx, the signatures are not verified again as long as the
+	// sequences of the signers are unchanged.
+	if svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeReCheck {
+		ok, err := svd.reCheckSequences(ctx, sigTx, signers, signatures)
+		if err != nil {
+			return ctx, err
+		}
+		if ok {
+			return next(ctx, tx, false)
+		}
+	}
+
 	pubKeys, err := sigTx.GetPubKeys()
 	if err != nil {
 		return ctx, err
@@ -247,32 +275,52 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		newlyCreated = true
 	}
 
+	// dispatch to the authentication handler registered for the signer credential type.
+	handler := svd.authenticationHandler(acc, txPubKey)
+	err := handler.Authenticate(ctx, AuthenticationRequest{
+		Tx:           tx,
+		Account:      acc,
+		NewlyCreated: newlyCreated,
+		Signature:    sig,
+		TxPubKey:     txPubKey,
+		SignerIndex:  signerIndex,
+	})
+	if err != nil {
+		return err
+	}
+
+	// update account changes in state.
+	svd.ak.SetAccount(ctx, acc)
+	return nil
+}
+
+// Authenticate implements AuthenticationHandler. It is the default
+// authentication flow: it sets the account public key if missing, consumes the
+// signature verification gas, verifies the signature against the account
+// public key and sequence, and increases the account sequence.
+func (svd SigVerificationDecorator) Authenticate(ctx sdk.Context, req AuthenticationRequest) error {
+	acc := req.Account
+
 	// the account is without a pubkey, let's attempt to check if in the
 	// tx we were correctly provided a valid pubkey.
 	if acc.GetPubKey() == nil {
-		err := svd.setPubKey(ctx, acc, txPubKey)
+		err := svd.setPubKey(ctx, acc, req.TxPubKey)
 		if err != nil {
 			return err
 		}
 	}
 
-	err := svd.consumeSignatureGas(ctx, acc.GetPubKey(), sig)
+	err := svd.consumeSignatureGas(ctx, acc.GetPubKey(), req.Signature)
 	if err != nil {
 		return err
 	}
 
-	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
+	err = svd.verifySig(ctx, req.Tx, acc, req.Signature, req.NewlyCreated)
 	if err != nil {
 		return err
 	}
 
-	err = svd.increaseSequence(tx, acc)
-	if err != nil {
-		return err
-	}
-	// update account changes in state.
-	svd.ak.SetAccount(ctx, acc)
-	return nil
+	return svd.increaseSequence(req.Tx, acc)
 }
 
 // consumeSignatureGas will consume gas according to the pub-key being verified.
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -401,3 +402,88 @@ func TestAnteHandlerChecks(t *testing.T) {
 		})
 	}
 }
+
+func TestSigVerificationAuthenticationHandlers(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// signed with a wrong account number, the default handler rejects it
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv1}, []uint64{7}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	noOpGasConsume := func(_ storetypes.GasMeter, _ signing.SignatureV2, _ types.Params) error { return nil }
+
+	var requests []ante.AuthenticationRequest
+	handler := ante.AuthenticationHandlerFunc(func(ctx sdk.Context, req ante.AuthenticationRequest) error {
+		requests = append(requests, req)
+		if req.Account.GetPubKey() == nil {
+			if err := req.Account.SetPubKey(req.TxPubKey); err != nil {
+				return err
+			}
+		}
+		return req.Account.SetSequence(req.Account.GetSequence() + 1)
+	})
+
+	testCases := []struct {
+		name      string
+		handlers  map[string]ante.AuthenticationHandler
+		expCalled bool
+		expErr    error
+	}{
+		{
+			name:   "default handler",
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:     "handler for another credential type",
+			handlers: map[string]ante.AuthenticationHandler{sdk.MsgTypeURL(&ed25519.PubKey{}): handler},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:      "custom handler",
+			handlers:  map[string]ante.AuthenticationHandler{sdk.MsgTypeURL(&secp256k1.PubKey{}): handler},
+			expCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = nil
+			ctx, _ := suite.ctx.CacheContext()
+
+			svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), noOpGasConsume, nil, ante.WithAuthenticationHandlers(tc.handlers))
+			_, err := sdk.ChainAnteDecorators(svd)(ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if !tc.expCalled {
+				require.Empty(t, requests)
+				return
+			}
+
+			require.Len(t, requests, 1)
+			require.Equal(t, 0, requests[0].SignerIndex)
+			require.False(t, requests[0].NewlyCreated)
+			require.True(t, priv1.PubKey().Equals(requests[0].TxPubKey))
+
+			// changes made by the handler are persisted
+			acc, err := suite.accountKeeper.Accounts.Get(ctx, addr1)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), acc.GetSequence())
+			require.True(t, priv1.PubKey().Equals(acc.GetPubKey()))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ante
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = SimulationGasPaddingDecorator{}
+
+// SimulationGasPaddingDecorator wraps an AnteDecorator and, when simulating a
+// transaction, pads the gas consumed by the decorator itself by the given
+// adjustment. It is meant for decorators whose gas consumption can only be
+// approximated in simulation, such as the tx size and the signature
+// verification costs, which are computed with placeholder signatures, so that
+// the gas estimated through simulation does not fall short of the gas consumed
+// by the signed transaction.
+//
+// The padding is consumed before calling the next decorator and is computed
+// with decimal arithmetic, so that estimates are deterministic. Outside of
+// simulation, the wrapped decorator is called as is.
+type SimulationGasPaddingDecorator struct {
+	adjustment math.LegacyDec
+	decorator  sdk.AnteDecorator
+}
+
+// NewSimulationGasPaddingDecorator returns a SimulationGasPaddingDecorator
+// wrapping the given decorator. The adjustment multiplies the gas consumed by
+// the decorator in simulation and must be at least one.
+func NewSimulationGasPaddingDecorator(adjustment math.LegacyDec, decorator sdk.AnteDecorator) SimulationGasPaddingDecorator {
+	if adjustment.IsNil() || adjustment.LT(math.LegacyOneDec()) {
+		panic(fmt.Sprintf("simulation gas adjustment must be at least 1, got %s", adjustment))
+	}
+
+	return SimulationGasPaddingDecorator{
+		adjustment: adjustment,
+		decorator:  decorator,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d SimulationGasPaddingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !simulate {
+		return d.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	gasMeter, startGas := ctx.GasMeter(), ctx.GasMeter().GasConsumed()
+	return d.decorator.AnteHandle(ctx, tx, simulate, func(nextCtx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		// the decorator may have replaced the gas meter, in which case all the
+		// gas consumed by the decorator is accounted in the new meter.
+		if nextCtx.GasMeter() != gasMeter {
+			gasMeter = nextCtx.GasMeter()
+			startGas = 0
+		}
+
+		gasMeter.ConsumeGas(SimulationGasPadding(gasMeter.GasConsumed()-startGas, d.adjustment), "simulation gas padding")
+
+		return next(nextCtx, tx, simulate)
+	})
+}
+
+// Describe implements sdk.AnteDecoratorDescriber.
+func (d SimulationGasPaddingDecorator) Describe() string {
+	return describeWrapped(fmt.Sprintf("simulation gas adjustment %s", d.adjustment), d.decorator)
+}
+
+// SimulationGasPadding returns the gas to add to the given amount of gas for
+// it to be multiplied by the adjustment, rounded up.
+func SimulationGasPadding(gas uint64, adjustment math.LegacyDec) uint64 {
+	padding := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas)).Mul(adjustment.Sub(math.LegacyOneDec())).Ceil()
+	if !padding.IsPositive() {
+		return 0
+	}
+
+	return padding.TruncateInt().Uint64()
+}
@@ -0,0 +1,54 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSimulationGasPaddingDecorator(t *testing.T) {
+	adjustment := math.LegacyMustNewDecFromStr("1.5")
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSimulationGasPaddingDecorator(adjustment, gasMeterDecorator{}),
+		ante.NewSimulationGasPaddingDecorator(adjustment, gasDecorator{before: 20, after: 5}),
+		gasDecorator{before: 7},
+	)
+
+	// gas consumed after calling the next decorator is not padded
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	newCtx, err := anteHandler(ctx.WithExecMode(sdk.ExecModeSimulate), nil, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5+30+7+5), newCtx.GasMeter().GasConsumed())
+
+	newCtx, err = anteHandler(ctx.WithExecMode(sdk.ExecModeFinalize), nil, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3+20+7+5), newCtx.GasMeter().GasConsumed())
+
+	require.Equal(t, "simulation gas adjustment 1.500000000000000000; wraps cosmossdk.io/x/auth/ante_test.gasMeterDecorator",
+		ante.NewSimulationGasPaddingDecorator(adjustment, gasMeterDecorator{}).Describe())
+	require.Panics(t, func() { ante.NewSimulationGasPaddingDecorator(math.LegacyMustNewDecFromStr("0.9"), gasMeterDecorator{}) })
+}
+
+func TestSimulationGasPadding(t *testing.T) {
+	testCases := []struct {
+		gas        uint64
+		adjustment string
+		expPadding uint64
+	}{
+		{gas: 1000, adjustment: "1", expPadding: 0},
+		{gas: 1000, adjustment: "1.1", expPadding: 100},
+		{gas: 1001, adjustment: "1.1", expPadding: 101},
+		{gas: 0, adjustment: "2", expPadding: 0},
+		{gas: 3, adjustment: "1.000000000000000001", expPadding: 1},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPadding, ante.SimulationGasPadding(tc.gas, math.LegacyMustNewDecFromStr(tc.adjustment)), "gas %d, adjustment %s", tc.gas, tc.adjustment)
+	}
+}
@@ -0,0 +1,147 @@
+package ante
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MetricKeyAnteDecorator is the metric key under which the measurements
+	// of instrumented decorators are emitted.
+	MetricKeyAnteDecorator = "ante_decorator"
+	// MetricLabelNameDecorator is the label holding the decorator name.
+	MetricLabelNameDecorator = "decorator"
+)
+
+var _ sdk.AnteDecorator = InstrumentedDecorator{}
+
+// InstrumentedDecorator wraps an AnteDecorator and records, for every
+// transaction, the gas consumed and the wall time spent in the decorator
+// itself. The gas and time spent in the decorators further along the chain
+// are excluded, so the measurements of a fully instrumented chain add up to
+// the cost of the whole AnteHandler.
+//
+// Measurements are emitted through the telemetry package as samples keyed by
+// MetricKeyAnteDecorator and labelled with the decorator name. Nothing is
+// recorded when telemetry is disabled.
+type InstrumentedDecorator struct {
+	name      string
+	decorator sdk.AnteDecorator
+}
+
+// NewInstrumentedDecorator returns an InstrumentedDecorator wrapping the given
+// decorator and reporting its measurements under the given name.
+func NewInstrumentedDecorator(name string, decorator sdk.AnteDecorator) InstrumentedDecorator {
+	return InstrumentedDecorator{
+		name:      name,
+		decorator: decorator,
+	}
+}
+
+// InstrumentDecorators wraps each of the given decorators into an
+// InstrumentedDecorator named after the decorator type.
+func InstrumentDecorators(decorators ...sdk.AnteDecorator) []sdk.AnteDecorator {
+	instrumented := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		instrumented[i] = NewInstrumentedDecorator(decoratorName(decorator), decorator)
+	}
+
+	return instrumented
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d InstrumentedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !telemetry.IsTelemetryEnabled() {
+		return d.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	var (
+		start     = time.Now()
+		gasMeter  = ctx.GasMeter()
+		startGas  = gasMeter.GasConsumed()
+		innerTime time.Duration
+		innerGas  uint64
+	)
+
+	newCtx, err := d.decorator.AnteHandle(ctx, tx, simulate, func(nextCtx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		// the decorator may have replaced the gas meter, in which case all the
+		// gas consumed by the decorator is accounted in the new meter.
+		if nextCtx.GasMeter() != gasMeter {
+			gasMeter = nextCtx.GasMeter()
+			startGas = 0
+		}
+
+		nextStart, nextStartGas := time.Now(), gasMeter.GasConsumed()
+		newCtx, err := next(nextCtx, tx, simulate)
+		innerTime += time.Since(nextStart)
+		innerGas += gasMeter.GasConsumed() - nextStartGas
+
+		return newCtx, err
+	})
+
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelNameDecorator, d.name)}
+	telemetry.AddSampleWithLabels(
+		[]string{MetricKeyAnteDecorator, "time"},
+		float32(time.Since(start)-innerTime)/float32(time.Millisecond),
+		labels,
+	)
+	telemetry.AddSampleWithLabels(
+		[]string{MetricKeyAnteDecorator, "gas"},
+		float32(gasMeter.GasConsumed()-startGas-innerGas),
+		labels,
+	)
+
+	return newCtx, err
+}
+
+// Describe implements sdk.AnteDecoratorDescriber.
+func (d InstrumentedDecorator) Describe() string {
+	return describeWrapped("instrumented", d.decorator)
+}
+
+// describeWrapped describes a decorator wrapping the given decorator.
+func describeWrapped(description string, decorator sdk.AnteDecorator) string {
+	description = fmt.Sprintf("%s; wraps %s", description, sdk.AnteDecoratorName(decorator))
+	if describer, ok := decorator.(sdk.AnteDecoratorDescriber); ok {
+		if config := describer.Describe(); config != "" {
+			description = fmt.Sprintf("%s (%s)", description, config)
+		}
+	}
+
+	return description
+}
+
+// sortedKeys returns the keys of the given map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// decoratorName returns the name of the decorator type, dereferencing pointers.
+func decoratorName(decorator sdk.AnteDecorator) string {
+	switch wrapper := decorator.(type) {
+	case SkipOnReCheckDecorator:
+		decorator = wrapper.decorator
+	case SimulationGasPaddingDecorator:
+		decorator = wrapper.decorator
+	}
+
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
@@ -0,0 +1,101 @@
+package ante_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gasDecorator consumes gas before and after calling the next decorator.
+type gasDecorator struct {
+	before, after uint64
+}
+
+func (d gasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(d.before, "before")
+	newCtx, err := next(ctx, tx, simulate)
+	ctx.GasMeter().ConsumeGas(d.after, "after")
+	return newCtx, err
+}
+
+// gasMeterDecorator replaces the gas meter of the context, like SetUpContextDecorator does.
+type gasMeterDecorator struct{}
+
+func (gasMeterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+	ctx.GasMeter().ConsumeGas(3, "setup")
+	return next(ctx, tx, simulate)
+}
+
+func TestInstrumentedDecorator(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{
+		MetricsSink: telemetry.MetricSinkInMem,
+		Enabled:     true,
+		ServiceName: "test",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+	})
+
+	decorators := ante.InstrumentDecorators(gasMeterDecorator{}, &gasDecorator{before: 20, after: 5})
+	decorators = append(decorators, ante.NewInstrumentedDecorator("inner", gasDecorator{before: 7}))
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	newCtx, err := anteHandler(ctx, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(35), newCtx.GasMeter().GasConsumed())
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var res struct {
+		Samples []struct {
+			Name   string
+			Sum    float64
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &res))
+
+	gas := make(map[string]float64)
+	for _, sample := range res.Samples {
+		if sample.Name == "test.ante_decorator.gas" {
+			gas[sample.Labels[ante.MetricLabelNameDecorator]] = sample.Sum
+		}
+	}
+
+	require.Equal(t, map[string]float64{
+		"gasMeterDecorator": 3,
+		"gasDecorator":      25,
+		"inner":             7,
+	}, gas)
+}
+
+func TestDescribeDecorators(t *testing.T) {
+	decorators := ante.InstrumentDecorators(
+		gasMeterDecorator{},
+		ante.NewSkipOnReCheckDecorator(appmodule.Environment{}, ante.NewUnorderedTxDecorator(10, nil, appmodule.Environment{})),
+	)
+
+	require.Equal(t, []sdk.AnteDecoratorDescription{
+		{
+			Name:   "cosmossdk.io/x/auth/ante.InstrumentedDecorator",
+			Config: "instrumented; wraps cosmossdk.io/x/auth/ante_test.gasMeterDecorator",
+		},
+		{
+			Name:   "cosmossdk.io/x/auth/ante.InstrumentedDecorator",
+			Config: "instrumented; wraps cosmossdk.io/x/auth/ante.SkipOnReCheckDecorator (skipped on recheck; wraps cosmossdk.io/x/auth/ante.UnorderedTxDecorator (max_unordered_ttl=10))",
+		},
+	}, sdk.DescribeAnteDecorators(decorators...))
+}
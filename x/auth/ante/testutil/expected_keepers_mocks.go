@@ -109,6 +109,21 @@ func (mr *MockAccountKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockAccountKeeper)(nil).GetParams), ctx)
 }
 
+// IncrementTxCount mocks base method.
+func (m *MockAccountKeeper) IncrementTxCount(ctx context.Context, addr types1.AccAddress, window uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTxCount", ctx, addr, window)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementTxCount indicates an expected call of IncrementTxCount.
+func (mr *MockAccountKeeperMockRecorder) IncrementTxCount(ctx, addr, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTxCount", reflect.TypeOf((*MockAccountKeeper)(nil).IncrementTxCount), ctx, addr, window)
+}
+
 // NewAccountWithAddress mocks base method.
 func (m *MockAccountKeeper) NewAccountWithAddress(ctx context.Context, addr types1.AccAddress) types1.AccountI {
 	m.ctrl.T.Helper()
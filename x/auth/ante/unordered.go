@@ -2,6 +2,7 @@ package ante
 
 import (
 	"crypto/sha256"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/transaction"
@@ -41,6 +42,11 @@ func NewUnorderedTxDecorator(maxTTL uint64, m *unorderedtx.Manager, env appmodul
 	}
 }
 
+// Describe implements sdk.AnteDecoratorDescriber.
+func (d *UnorderedTxDecorator) Describe() string {
+	return fmt.Sprintf("max_unordered_ttl=%d", d.maxUnOrderedTTL)
+}
+
 func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	unorderedTx, ok := tx.(sdk.TxWithUnordered)
 	if !ok || !unorderedTx.GetUnordered() {
@@ -64,12 +70,16 @@ func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, ne
 
 	txHash := sha256.Sum256(ctx.TxBytes())
 
+	// the unordered tx manager keeps its state in memory, a speculative
+	// execution of the tx must not modify it.
+	speculative := sdk.InvalidateSpeculation(ctx)
+
 	// check for duplicates
 	if d.txManager.Contains(txHash) {
 		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx %X is duplicated")
 	}
 
-	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
+	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize && !speculative {
 		// a new tx included in the block, add the hash to the unordered tx manager
 		d.txManager.Add(txHash, ttl)
 	}
@@ -27,16 +27,7 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 	if ctx.ExecMode() == sdk.ExecModeCheck { // NOTE: using environment here breaks the API of fee logic, an alternative must be found for server/v2. ref: https://github.com/cosmos/cosmos-sdk/issues/19640
 		minGasPrices := ctx.MinGasPrices()
 		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdkmath.LegacyNewDec(int64(gas))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-			}
-
+			requiredFees := getRequiredFees(minGasPrices, gas)
 			if !feeCoins.IsAnyGTE(requiredFees) {
 				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
@@ -47,6 +38,58 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 	return feeCoins, priority, nil
 }
 
+// checkTxFeeWithMinGasPrices returns the default fee logic. When the auth params
+// set min gas prices, they are the registry of the denoms accepted to pay fees:
+// the fee must only contain accepted denoms and meet the min gas price of one of
+// them. Unlike the validator min gas prices they replace, they are checked in
+// all execution modes, so that the fee requirements are enforced by consensus.
+func checkTxFeeWithMinGasPrices(ak AccountKeeper) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		minGasPrices := ak.GetParams(ctx).MinGasPrices
+		if minGasPrices.Empty() {
+			return checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// genesis transactions are not charged fees
+		if ctx.BlockHeight() > 0 {
+			for _, coin := range feeCoins {
+				if minGasPrices.AmountOf(coin.Denom).IsZero() {
+					return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s is not accepted; accepted denoms: %s", coin.Denom, minGasPrices)
+				}
+			}
+
+			requiredFees := getRequiredFees(minGasPrices, gas)
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+
+		priority := getTxPriority(feeCoins, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
+
+// getRequiredFees determines the required fees by multiplying each minimum gas
+// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+func getRequiredFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdkmath.LegacyNewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
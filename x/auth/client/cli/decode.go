@@ -8,9 +8,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 )
 
-const flagHex = "hex"
+const (
+	flagHex    = "hex"
+	flagPretty = "pretty"
+)
 
 // GetDecodeCommand returns the decode command to take serialized bytes and turn
 // it into a JSON-encoded transaction.
@@ -32,6 +36,20 @@ func GetDecodeCommand() *cobra.Command {
 				return err
 			}
 
+			if pretty, _ := cmd.Flags().GetBool(flagPretty); pretty {
+				decoder, err := clienttx.NewDescriptorDecoderFromNode(cmd.Context(), clientCtx)
+				if err != nil {
+					return err
+				}
+
+				json, err := decoder.DecodeJSON(txBytes)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintBytes(json)
+			}
+
 			tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
 			if err != nil {
 				return err
@@ -47,6 +65,7 @@ func GetDecodeCommand() *cobra.Command {
 	}
 
 	cmd.Flags().BoolP(flagHex, "x", false, "Treat input as hexadecimal instead of base64")
+	cmd.Flags().Bool(flagPretty, false, "Decode the transaction using the protobuf descriptors served by the node, so that the messages of any chain can be rendered")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.Flags().MarkHidden(flags.FlagOutput) // decoding makes sense to output only json
 
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetQueryCmd returns the custom query commands of the auth module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "auth",
+		Short:                      "Querying commands for the auth module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ExportSigningDataCmd(),
+	)
+
+	return cmd
+}
+
+// ExportSigningDataCmd returns a command exporting the chain and account state
+// required to sign transactions offline.
+func ExportSigningDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-signing-data [address...]",
+		Short: "Export the chain ID, account numbers and sequences required to sign transactions offline",
+		Long: `Export a snapshot of the chain ID, the minimum gas prices of the node, and the
+account number and sequence of the given accounts, all queried at the same height.
+The snapshot can be copied to an air-gapped machine and passed to the tx commands
+with the --signing-data flag, which signs offline without setting --chain-id,
+--account-number and --sequence. The signer of a multisig must still set
+--account-number and --sequence.`,
+		Example: fmt.Sprintf(`$ %[1]s query auth export-signing-data cosmos1... --output-document signing-data.json
+$ %[1]s tx sign unsigned-tx.json --from mykey --signing-data signing-data.json`, version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signingData := client.SigningData{
+				Accounts: make([]client.SigningAccount, 0, len(args)),
+			}
+
+			for _, address := range args {
+				addr, err := clientCtx.AddressCodec.StringToBytes(address)
+				if err != nil {
+					return fmt.Errorf("invalid address %s: %w", address, err)
+				}
+
+				acc, height, err := clientCtx.AccountRetriever.GetAccountWithHeight(clientCtx, addr)
+				if err != nil {
+					return fmt.Errorf("failed to query account %s: %w", address, err)
+				}
+
+				// the next accounts are queried at the height of the first one.
+				if signingData.Height == 0 {
+					signingData.Height = height
+					clientCtx = clientCtx.WithHeight(height)
+				}
+
+				signingData.Accounts = append(signingData.Accounts, client.SigningAccount{
+					Address:       address,
+					AccountNumber: acc.GetAccountNumber(),
+					Sequence:      acc.GetSequence(),
+				})
+			}
+
+			nodeInfo, err := cmtservice.NewServiceClient(clientCtx).GetNodeInfo(cmd.Context(), &cmtservice.GetNodeInfoRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to query the chain ID: %w", err)
+			}
+			signingData.ChainID = nodeInfo.DefaultNodeInfo.Network
+
+			nodeConfig, err := node.NewServiceClient(clientCtx).Config(cmd.Context(), &node.ConfigRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to query the minimum gas prices: %w", err)
+			}
+			signingData.MinGasPrices = nodeConfig.MinimumGasPrice
+
+			bz, err := json.MarshalIndent(signingData, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				return clientCtx.PrintString(string(bz) + "\n")
+			}
+
+			return os.WriteFile(outputDoc, bz, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signing data will be written to the given file instead of STDOUT")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
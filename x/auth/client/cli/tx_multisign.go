@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -37,8 +40,14 @@ func GetMultiSignCommand() *cobra.Command {
 Read one or more signatures from one or more [signature] file, generate a multisig signature compliant to the
 multisig key [name], and attach the key name to the transaction read from [file].
 
+A [signature] may also be a directory, in which case the signatures of all its .json files are read.
+Each signature is checked to be a valid signature of the transaction by a member of the multisig key.
+If the --skip-invalid flag is on, the invalid signatures are reported and skipped instead of failing,
+which allows collecting the signatures from a directory shared by the signers.
+
 Example:
 $ %s tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
+$ %s tx multisign transaction.json k1k2k3 ./signatures
 
 If --signature-only flag is on, output a JSON representation
 of only the generated signature.
@@ -47,10 +56,11 @@ If the --offline flag is on, the client will not reach out to an external node.
 Account number or sequence number lookups are not performed so you must
 set these parameters manually.
 
-The current multisig implementation defaults to amino-json sign mode.
-The SIGN_MODE_DIRECT sign mode is not supported.'
+The current multisig implementation defaults to amino-json sign mode. The signers may use different
+sign modes, e.g. amino-json and textual, except SIGN_MODE_DIRECT which signs over the final multisig
+signer info and is therefore not supported.
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: makeMultiSignCmd(),
@@ -58,6 +68,7 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	}
 
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
+	cmd.Flags().Bool(flagSkipInvalid, false, "Skip the invalid signatures instead of failing")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
@@ -69,9 +80,14 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 	return func(cmd *cobra.Command, args []string) (err error) {
 		file := args[0]
 		name := args[1]
-		sigsRaw := args[2:]
 		_ = cmd.Flags().Set(flags.FlagFrom, args[1])
 
+		sigsRaw, err := collectSignatureFiles(args[2:])
+		if err != nil {
+			return err
+		}
+		skipInvalid, _ := cmd.Flags().GetBool(flagSkipInvalid)
+
 		clientCtx, err := client.GetClientTxContext(cmd)
 		if err != nil {
 			return err
@@ -120,18 +136,31 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
 		}
 
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
 		// read each signature and add it to the multisig if valid
+		signed := make(map[string]bool)
 		for i := 0; i < len(sigsRaw); i++ {
 			sigs, err := unmarshalSignatureJSON(clientCtx, sigsRaw[i])
 			if err != nil {
-				return err
-			}
-
-			if txFactory.ChainID() == "" {
-				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+				if skipInvalid {
+					cmd.PrintErrf("skipping %s: %v\n", sigsRaw[i], err)
+					continue
+				}
+				return errorsmod.Wrapf(err, "failed to read signatures from %s", sigsRaw[i])
 			}
 
 			for _, sig := range sigs {
+				if err := checkMultisigMemberSignature(sig, multisigPub.GetPubKeys()); err != nil {
+					if skipInvalid {
+						cmd.PrintErrf("skipping signature from %s: %v\n", sigsRaw[i], err)
+						continue
+					}
+					return errorsmod.Wrapf(err, "invalid signature in %s", sigsRaw[i])
+				}
+
 				anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
 				if err != nil {
 					return err
@@ -157,15 +186,24 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 					txCfg.SignModeHandler(), txData)
 				if err != nil {
 					addr, _ := sdk.AccAddressFromHexUnsafe(sig.PubKey.Address().String())
+					if skipInvalid {
+						cmd.PrintErrf("skipping signature from %s: couldn't verify signature for address %s\n", sigsRaw[i], addr)
+						continue
+					}
 					return fmt.Errorf("couldn't verify signature for address %s", addr)
 				}
 
 				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
 					return err
 				}
+				signed[string(sig.PubKey.Bytes())] = true
 			}
 		}
 
+		if len(signed) < int(multisigPub.Threshold) {
+			cmd.PrintErrf("warning: collected %d of the %d signatures required by the multisig key %s\n", len(signed), multisigPub.Threshold, name)
+		}
+
 		sigV2 := signingtypes.SignatureV2{
 			PubKey:   multisigPub,
 			Data:     multisigSig,
@@ -378,6 +416,70 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// collectSignatureFiles returns the signature files at the given paths, each
+// directory being replaced by the .json files it contains, sorted by name.
+func collectSignatureFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		var found bool
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("no signature file found in directory %s", path)
+		}
+	}
+
+	return files, nil
+}
+
+// checkMultisigMemberSignature checks that the signature is a single signature
+// made by one of the members of a multisig key, in a sign mode allowing to
+// combine it with the signatures of the other members.
+func checkMultisigMemberSignature(sig signingtypes.SignatureV2, pubKeys []cryptotypes.PubKey) error {
+	if sig.PubKey == nil {
+		return errors.New("signature without public key")
+	}
+
+	member := false
+	for _, pk := range pubKeys {
+		if pk.Equals(sig.PubKey) {
+			member = true
+			break
+		}
+	}
+	if !member {
+		return fmt.Errorf("signer %s is not a member of the multisig key", sdk.AccAddress(sig.PubKey.Address()))
+	}
+
+	data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("expected a single signature from %s, got %T", sdk.AccAddress(sig.PubKey.Address()), sig.Data)
+	}
+	if data.SignMode == signingtypes.SignMode_SIGN_MODE_DIRECT {
+		return fmt.Errorf("signature from %s uses %s, which is not supported by multisig", sdk.AccAddress(sig.PubKey.Address()), data.SignMode)
+	}
+
+	return nil
+}
+
 func unmarshalSignatureJSON(clientCtx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
 	var bytes []byte
 	if bytes, err = os.ReadFile(filename); err != nil {
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestCollectSignatureFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.json", "a.json", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub.json"), 0o700))
+	sig := filepath.Join(t.TempDir(), "sig")
+	require.NoError(t, os.WriteFile(sig, []byte("{}"), 0o600))
+
+	files, err := collectSignatureFiles([]string{sig, dir})
+	require.NoError(t, err)
+	require.Equal(t, []string{sig, filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json")}, files)
+
+	_, err = collectSignatureFiles([]string{t.TempDir()})
+	require.ErrorContains(t, err, "no signature file found")
+
+	_, err = collectSignatureFiles([]string{filepath.Join(dir, "missing.json")})
+	require.Error(t, err)
+}
+
+func TestCheckMultisigMemberSignature(t *testing.T) {
+	member, other := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	pubKeys := []cryptotypes.PubKey{member, secp256k1.GenPrivKey().PubKey()}
+
+	sigV2 := func(pk cryptotypes.PubKey, data signingtypes.SignatureData) signingtypes.SignatureV2 {
+		return signingtypes.SignatureV2{PubKey: pk, Data: data}
+	}
+	single := func(mode signingtypes.SignMode) signingtypes.SignatureData {
+		return &signingtypes.SingleSignatureData{SignMode: mode, Signature: []byte("sig")}
+	}
+
+	require.NoError(t, checkMultisigMemberSignature(sigV2(member, single(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)), pubKeys))
+	require.NoError(t, checkMultisigMemberSignature(sigV2(member, single(signingtypes.SignMode_SIGN_MODE_TEXTUAL)), pubKeys))
+	require.ErrorContains(t, checkMultisigMemberSignature(sigV2(member, single(signingtypes.SignMode_SIGN_MODE_DIRECT)), pubKeys), "not supported by multisig")
+	require.ErrorContains(t, checkMultisigMemberSignature(sigV2(other, single(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)), pubKeys), "not a member")
+	require.ErrorContains(t, checkMultisigMemberSignature(sigV2(member, &signingtypes.MultiSignatureData{}), pubKeys), "expected a single signature")
+	require.ErrorContains(t, checkMultisigMemberSignature(sigV2(nil, single(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)), pubKeys), "without public key")
+}
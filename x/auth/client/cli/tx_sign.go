@@ -23,6 +23,7 @@ const (
 	flagSigOnly         = "signature-only"
 	flagNoAutoIncrement = "no-auto-increment"
 	flagAppend          = "append"
+	flagSkipInvalid     = "skip-invalid"
 )
 
 // GetSignBatchCommand returns the transaction sign-batch command.
@@ -295,8 +296,10 @@ be generated via the 'multisign' command.
 
 func preSignCmd(cmd *cobra.Command, _ []string) {
 	// Conditionally mark the account and sequence numbers required as no RPC
-	// query will be done.
-	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+	// query will be done, unless they are loaded from the signing data.
+	offline, _ := cmd.Flags().GetBool(flags.FlagOffline)
+	signingData, _ := cmd.Flags().GetString(flags.FlagSigningData)
+	if offline && signingData == "" {
 		err := cmd.MarkFlagRequired(flags.FlagAccountNumber)
 		if err != nil {
 			panic(err)
@@ -1,6 +1,10 @@
 package auth
 
 import (
+	"slices"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -22,6 +26,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetAccountHooks),
 	)
 }
 
@@ -75,3 +80,22 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+// InvokeSetAccountHooks sets the account hooks provided by the other modules,
+// invoked in the alphabetical order of the module names.
+func InvokeSetAccountHooks(keeper keeper.AccountKeeper, accountHooks map[string]types.AccountHooksWrapper) error {
+	if len(accountHooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(accountHooks)
+	slices.Sort(modNames)
+
+	var multiHooks types.MultiAccountHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, accountHooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
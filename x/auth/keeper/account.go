@@ -46,10 +46,21 @@ func (ak AccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk
 
 // SetAccount implements AccountKeeperI.
 func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
+	var prev sdk.AccountI
+	if ak.hasHooks() {
+		prev = ak.GetAccount(ctx, acc.GetAddress())
+	}
+
 	err := ak.Accounts.Set(ctx, acc.GetAddress(), acc)
 	if err != nil {
 		panic(err)
 	}
+
+	if ak.hasHooks() {
+		if err := ak.afterAccountSet(ctx, prev, acc); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -59,4 +70,10 @@ func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
 	if err != nil {
 		panic(err)
 	}
+
+	if ak.hasHooks() {
+		if err := ak.hooks.AfterAccountRemoved(ctx, acc.GetAddress()); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountHooks holds the hooks of the account keeper.
+type accountHooks struct {
+	types.AccountHooks
+}
+
+// SetHooks sets the account hooks, invoked when the public key of an account
+// is changed or cleared, or when an account is removed. It panics if the hooks
+// are already set.
+func (ak AccountKeeper) SetHooks(hooks types.AccountHooks) {
+	if ak.hooks.AccountHooks != nil {
+		panic("cannot set account hooks twice")
+	}
+
+	ak.hooks.AccountHooks = hooks
+}
+
+// hasHooks returns true if the account hooks are set.
+func (ak AccountKeeper) hasHooks() bool {
+	return ak.hooks != nil && ak.hooks.AccountHooks != nil
+}
+
+// afterAccountSet invokes the AfterAccountPubKeyChanged hook if the account
+// had a public key which differs from the public key of acc.
+func (ak AccountKeeper) afterAccountSet(ctx context.Context, prev, acc sdk.AccountI) error {
+	if prev == nil || prev.GetPubKey() == nil {
+		return nil
+	}
+
+	if newPubKey := acc.GetPubKey(); newPubKey != nil && prev.GetPubKey().Equals(newPubKey) {
+		return nil
+	}
+
+	return ak.hooks.AfterAccountPubKeyChanged(ctx, acc.GetAddress(), prev.GetPubKey(), acc.GetPubKey())
+}
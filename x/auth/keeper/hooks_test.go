@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.AccountHooks = &mockAccountHooks{}
+
+type mockAccountHooks struct {
+	pubKeyChanged []sdk.AccAddress
+	removed       []sdk.AccAddress
+}
+
+func (h *mockAccountHooks) AfterAccountPubKeyChanged(_ context.Context, addr sdk.AccAddress, _, _ cryptotypes.PubKey) error {
+	h.pubKeyChanged = append(h.pubKeyChanged, addr)
+	return nil
+}
+
+func (h *mockAccountHooks) AfterAccountRemoved(_ context.Context, addr sdk.AccAddress) error {
+	h.removed = append(h.removed, addr)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestAccountHooks() {
+	suite.SetupTest() // reset
+
+	hooks := &mockAccountHooks{}
+	// the hooks are shared with the copies of the keeper
+	keeperCopy := suite.accountKeeper
+	keeperCopy.SetHooks(hooks)
+	suite.Require().Panics(func() { suite.accountKeeper.SetHooks(hooks) })
+
+	ctx := suite.ctx
+	pubKey1 := ed25519.GenPrivKey().PubKey()
+	pubKey2 := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey1.Address())
+
+	// setting the first public key does not invoke the hooks
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(acc.SetPubKey(pubKey1))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Empty(hooks.pubKeyChanged)
+
+	// storing the same public key again does not invoke the hooks
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.GetAccount(ctx, addr))
+	suite.Require().Empty(hooks.pubKeyChanged)
+
+	// rotating the public key invokes the hooks
+	acc = suite.accountKeeper.GetAccount(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pubKey2))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Equal([]sdk.AccAddress{addr}, hooks.pubKeyChanged)
+
+	// clearing the public key invokes the hooks
+	acc = suite.accountKeeper.GetAccount(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(nil))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().Equal([]sdk.AccAddress{addr, addr}, hooks.pubKeyChanged)
+
+	// removing the account invokes the hooks
+	suite.accountKeeper.RemoveAccount(ctx, acc)
+	suite.Require().Equal([]sdk.AccAddress{addr}, hooks.removed)
+}
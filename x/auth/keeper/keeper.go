@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -102,6 +103,12 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// TxCounts key: AccAddr | value: rate limit window + number of txs signed in the window
+	TxCounts collections.Map[sdk.AccAddress, collections.Pair[uint64, uint64]]
+
+	// hooks are shared by the copies of the keeper, so that they can be set
+	// after the keeper has been provided to the other modules.
+	hooks *accountHooks
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -135,6 +142,8 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		TxCounts:          collections.NewMap(sb, types.TxCountsKeyPrefix, "tx_counts", sdk.AccAddressKey, collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key))),
+		hooks:             &accountHooks{},
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -189,6 +198,29 @@ func (ak AccountKeeper) NextAccountNumber(ctx context.Context) uint64 {
 	return n
 }
 
+// IncrementTxCount increments the number of transactions signed by an account
+// within the given rate limit window and returns the updated count. The count
+// restarts from zero when the window changes.
+func (ak AccountKeeper) IncrementTxCount(ctx context.Context, addr sdk.AccAddress, window uint64) (uint64, error) {
+	var count uint64
+	txCount, err := ak.TxCounts.Get(ctx, addr)
+	switch {
+	case err == nil:
+		if txCount.K1() == window {
+			count = txCount.K2()
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return 0, err
+	}
+
+	count++
+	if err := ak.TxCounts.Set(ctx, addr, collections.Join(window, count)); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // GetModulePermissions fetches per-module account permissions.
 func (ak AccountKeeper) GetModulePermissions() map[string]types.PermissionsForAddress {
 	return ak.permAddrs
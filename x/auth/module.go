@@ -6,16 +6,19 @@ import (
 	"fmt"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
+	"cosmossdk.io/x/auth/client/cli"
 	"cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/simulation"
 	"cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -27,8 +30,9 @@ const (
 )
 
 var (
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasName             = AppModule{}
+	_ module.AppModuleSimulation    = AppModule{}
+	_ module.HasName                = AppModule{}
+	_ module.HasBlockSummaryMetrics = AppModule{}
 
 	_ appmodule.HasGenesis    = AppModule{}
 	_ appmodule.AppModule     = AppModule{}
@@ -74,11 +78,24 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
+// GetQueryCmd returns the custom query commands for the auth module.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // RegisterInterfaces registers interfaces and implementations of the auth module.
 func (AppModule) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	types.RegisterInterfaces(registrar)
 }
 
+// RegisterBlockSummaryMetrics registers the fees collected during the block in
+// the block summary event.
+func (am AppModule) RegisterBlockSummaryMetrics(registry sdk.BlockSummaryRegistry) {
+	registry.RegisterBlockSummaryMetrics(
+		sdk.BlockSummaryMetric{Name: types.BlockSummaryKeyFeesCollected, EventType: sdk.EventTypeTx, Attribute: sdk.AttributeKeyFee, Coins: true},
+	)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.accountKeeper))
@@ -0,0 +1,88 @@
+package posthandler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeEventDecorator emits a structured fee event for each account the fee of a
+// transaction was deducted from by the DeductFeeDecorator and each denom of the
+// fee, so that indexers do not have to parse the fee attribute of the tx event.
+// The events hold the fee payer, the fee granter if any, the amount deducted in
+// the denom, the gas wanted and used, and the effective gas price of the
+// transaction in the denom, i.e. its fee in the denom divided by its gas limit.
+// Fee refunds are reported by the FeeRefundDecorator.
+//
+// BaseApp discards the post handler events of failed transactions, whose fees
+// are only reported by the fee attribute of the tx event.
+type FeeEventDecorator struct{}
+
+func NewFeeEventDecorator() FeeEventDecorator {
+	return FeeEventDecorator{}
+}
+
+func (fed FeeEventDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	deducted, ok := ante.GetDeductedFee(ctx)
+	if !ok || deducted.Fee.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	ctx.EventManager().EmitEvents(FeeEvents(feeTx, deducted, ctx.GasMeter().GasConsumedToLimit()))
+
+	return next(ctx, tx, simulate, success)
+}
+
+// FeeEvents returns the fee events of the fee deducted for the transaction,
+// which used the given amount of gas.
+func FeeEvents(feeTx sdk.FeeTx, deducted ante.DeductedFee, gasUsed uint64) sdk.Events {
+	gasWanted := feeTx.GetGas()
+	amounts := ante.SplitFee(deducted.Fee, deducted.Payers)
+
+	events := make(sdk.Events, 0, len(deducted.Payers)*len(deducted.Fee))
+	for i, share := range deducted.Payers {
+		// the fee of a granted transaction is deducted from the fee granter on
+		// behalf of the fee payer.
+		attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyPayer, sdk.AccAddress(share.Payer).String())}
+		if granter := feeTx.FeeGranter(); granter != nil {
+			attrs = []sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyPayer, sdk.AccAddress(feeTx.FeePayer()).String()),
+				sdk.NewAttribute(types.AttributeKeyGranter, sdk.AccAddress(granter).String()),
+			}
+		}
+
+		for _, coin := range deducted.Fee {
+			amount := amounts[i].AmountOf(coin.Denom)
+			if amount.IsZero() {
+				continue
+			}
+
+			gasPrice := math.LegacyZeroDec()
+			if gasWanted > 0 {
+				gasPrice = math.LegacyNewDecFromInt(coin.Amount).QuoInt(math.NewIntFromUint64(gasWanted))
+			}
+
+			events = append(events, sdk.NewEvent(
+				types.EventTypeFee,
+				append(attrs,
+					sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+					sdk.NewAttribute(types.AttributeKeyGasWanted, math.NewIntFromUint64(gasWanted).String()),
+					sdk.NewAttribute(types.AttributeKeyGasUsed, math.NewIntFromUint64(gasUsed).String()),
+					sdk.NewAttribute(types.AttributeKeyGasPrice, gasPrice.String()),
+				)...,
+			))
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,98 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/posthandler"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestFeeEventDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, otherPayer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 3))
+	newTx := func(granter sdk.AccAddress) sdk.FeeTx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(400)
+		txBuilder.SetFeeGranter(granter)
+		return txBuilder.GetTx()
+	}
+
+	postHandle := func(tx sdk.Tx, deducted *ante.DeductedFee) sdk.Events {
+		ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(400))
+		ctx.GasMeter().ConsumeGas(250, "test")
+		if deducted != nil {
+			ctx = ante.SetDeductedFee(ctx, *deducted)
+		}
+
+		ctx, err := sdk.ChainPostDecorators(posthandler.NewFeeEventDecorator())(ctx, tx, false, true)
+		require.NoError(t, err)
+		return ctx.EventManager().Events()
+	}
+
+	feeEvent := func(payer, granter sdk.AccAddress, denom, amount, gasPrice string) sdk.Event {
+		attrs := []sdk.Attribute{sdk.NewAttribute(authtypes.AttributeKeyPayer, payer.String())}
+		if granter != nil {
+			attrs = append(attrs, sdk.NewAttribute(authtypes.AttributeKeyGranter, granter.String()))
+		}
+		return sdk.NewEvent(authtypes.EventTypeFee, append(attrs,
+			sdk.NewAttribute(authtypes.AttributeKeyDenom, denom),
+			sdk.NewAttribute(authtypes.AttributeKeyAmount, amount),
+			sdk.NewAttribute(authtypes.AttributeKeyGasWanted, "400"),
+			sdk.NewAttribute(authtypes.AttributeKeyGasUsed, "250"),
+			sdk.NewAttribute(authtypes.AttributeKeyGasPrice, gasPrice),
+		)...)
+	}
+
+	t.Run("single payer", func(t *testing.T) {
+		events := postHandle(newTx(nil), &ante.DeductedFee{Fee: fee, Payers: []ante.FeePayerShare{{Payer: payer, Share: math.LegacyOneDec()}}})
+		require.Equal(t, sdk.Events{
+			feeEvent(payer, nil, "atom", "1000", "2.500000000000000000"),
+			feeEvent(payer, nil, "stake", "3", "0.007500000000000000"),
+		}, events)
+	})
+
+	t.Run("fee granter", func(t *testing.T) {
+		events := postHandle(newTx(granter), &ante.DeductedFee{Fee: fee, Payers: []ante.FeePayerShare{{Payer: granter, Share: math.LegacyOneDec()}}})
+		require.Equal(t, sdk.Events{
+			feeEvent(payer, granter, "atom", "1000", "2.500000000000000000"),
+			feeEvent(payer, granter, "stake", "3", "0.007500000000000000"),
+		}, events)
+	})
+
+	t.Run("split fee", func(t *testing.T) {
+		events := postHandle(newTx(nil), &ante.DeductedFee{Fee: fee, Payers: []ante.FeePayerShare{
+			{Payer: payer, Share: math.LegacyNewDecWithPrec(8, 1)},
+			{Payer: otherPayer, Share: math.LegacyNewDecWithPrec(2, 1)},
+		}})
+		// the share of the stake fee of the other payer is truncated to zero.
+		require.Equal(t, sdk.Events{
+			feeEvent(payer, nil, "atom", "800", "2.500000000000000000"),
+			feeEvent(payer, nil, "stake", "3", "0.007500000000000000"),
+			feeEvent(otherPayer, nil, "atom", "200", "2.500000000000000000"),
+		}, events)
+	})
+
+	t.Run("no deducted fee", func(t *testing.T) {
+		require.Empty(t, postHandle(newTx(nil), nil))
+		require.Empty(t, postHandle(newTx(nil), &ante.DeductedFee{Fee: sdk.NewCoins()}))
+	})
+}
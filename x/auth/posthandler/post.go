@@ -1,15 +1,40 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// BankKeeper is used to refund the fees, it is only required when
+	// MaxFeeRefundRatio is set.
+	BankKeeper BankKeeper
+
+	// MaxFeeRefundRatio caps the ratio of the fee of a transaction refunded for
+	// the gas it did not use. Fees are not refunded when it is nil or zero.
+	MaxFeeRefundRatio math.LegacyDec
+}
+
+// NewPostHandler returns a PostHandler chain emitting the fee events of the
+// transactions, and refunding the fees paid for unused gas when enabled.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	postDecorators := []sdk.PostDecorator{NewFeeEventDecorator()}
+
+	if !options.MaxFeeRefundRatio.IsNil() && !options.MaxFeeRefundRatio.IsZero() {
+		if options.MaxFeeRefundRatio.IsNegative() || options.MaxFeeRefundRatio.GT(math.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "max fee refund ratio must be between 0 and 1, got %s", options.MaxFeeRefundRatio)
+		}
+
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for fee refunds")
+		}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
-	postDecorators := []sdk.PostDecorator{}
+		postDecorators = append(postDecorators, NewFeeRefundDecorator(options.BankKeeper, options.MaxFeeRefundRatio))
+	}
 
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -0,0 +1,94 @@
+package posthandler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AttributeKeyFeeRefund is the attribute of the tx event holding the fee
+// refunded to a fee payer.
+const AttributeKeyFeeRefund = "fee_refund"
+
+// FeeRefundDecorator refunds the fee payers of a successful transaction the part
+// of the fee paid for the gas it did not use, up to a maximum ratio of the fee.
+// The refund is sent from the fee collector to the accounts the fee was
+// deducted from by the DeductFeeDecorator, in proportion of their share. A fee
+// grant is not restored by a refund, the refund goes to the fee granter.
+type FeeRefundDecorator struct {
+	bankKeeper     BankKeeper
+	maxRefundRatio math.LegacyDec
+}
+
+func NewFeeRefundDecorator(bk BankKeeper, maxRefundRatio math.LegacyDec) FeeRefundDecorator {
+	return FeeRefundDecorator{
+		bankKeeper:     bk,
+		maxRefundRatio: maxRefundRatio,
+	}
+}
+
+func (frd FeeRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// the state of a failed transaction is reverted, including the refund.
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	deducted, ok := ante.GetDeductedFee(ctx)
+	if !ok || deducted.Fee.IsZero() || feeTx.GetGas() == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refund := FeeRefund(deducted.Fee, feeTx.GetGas(), ctx.GasMeter().GasConsumedToLimit(), frd.maxRefundRatio)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	amounts := ante.SplitFee(refund, deducted.Payers)
+	events := make(sdk.Events, 0, len(amounts))
+	for i, payer := range deducted.Payers {
+		if amounts[i].IsZero() {
+			continue
+		}
+
+		if err := frd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, payer.Payer, amounts[i]); err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to refund fee payer %s", sdk.AccAddress(payer.Payer))
+		}
+
+		events = append(events, sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(AttributeKeyFeeRefund, amounts[i].String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(payer.Payer).String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// FeeRefund returns the part of the fee paid for the gas not used out of the
+// gas limit, capped to the given ratio of the fee. Amounts are truncated, in
+// favor of the fee collector.
+func FeeRefund(fee sdk.Coins, gasLimit, gasUsed uint64, maxRefundRatio math.LegacyDec) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.NewCoins()
+	}
+
+	ratio := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit - gasUsed)).QuoInt(math.NewIntFromUint64(gasLimit))
+	ratio = math.LegacyMinDec(ratio, maxRefundRatio)
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, ratio.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return refund
+}
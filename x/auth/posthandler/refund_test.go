@@ -0,0 +1,145 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/posthandler"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type refund struct {
+	to     sdk.AccAddress
+	amount sdk.Coins
+}
+
+type bankKeeper struct {
+	refunds []refund
+}
+
+func (bk *bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule != authtypes.FeeCollectorName {
+		panic("refunds must be sent from the fee collector")
+	}
+
+	bk.refunds = append(bk.refunds, refund{to: recipientAddr, amount: amt})
+	return nil
+}
+
+func TestFeeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 15))
+
+	testCases := []struct {
+		name           string
+		gasLimit       uint64
+		gasUsed        uint64
+		maxRefundRatio math.LegacyDec
+		expRefund      sdk.Coins
+	}{
+		{"all gas used", 100, 100, math.LegacyOneDec(), sdk.NewCoins()},
+		{"gas limit exceeded", 100, 150, math.LegacyOneDec(), sdk.NewCoins()},
+		{"quarter of the gas unused", 100, 75, math.LegacyOneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("stake", 3))},
+		{"refund capped", 100, 10, math.LegacyNewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 7))},
+		{"no gas limit", 0, 0, math.LegacyOneDec(), sdk.NewCoins()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRefund, posthandler.FeeRefund(fee, tc.gasLimit, tc.gasUsed, tc.maxRefundRatio))
+		})
+	}
+}
+
+func TestFeeRefundDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, otherPayer := testdata.KeyTestPubAddr()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(1000)
+	tx := txBuilder.GetTx()
+
+	newCtx := func(gasUsed uint64, payers ...ante.FeePayerShare) sdk.Context {
+		ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+		ctx.GasMeter().ConsumeGas(gasUsed, "test")
+		return ante.SetDeductedFee(ctx, ante.DeductedFee{Fee: fee, Payers: payers})
+	}
+
+	t.Run("refund", func(t *testing.T) {
+		bk := &bankKeeper{}
+		postHandler := sdk.ChainPostDecorators(posthandler.NewFeeRefundDecorator(bk, math.LegacyNewDecWithPrec(9, 1)))
+
+		ctx, err := postHandler(newCtx(400, ante.FeePayerShare{Payer: payer, Share: math.LegacyOneDec()}), tx, false, true)
+		require.NoError(t, err)
+		require.Equal(t, []refund{{to: payer, amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 600))}}, bk.refunds)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		attr, ok := events[0].GetAttribute(posthandler.AttributeKeyFeeRefund)
+		require.True(t, ok)
+		require.Equal(t, "600atom", attr.Value)
+	})
+
+	t.Run("split refund", func(t *testing.T) {
+		bk := &bankKeeper{}
+		postHandler := sdk.ChainPostDecorators(posthandler.NewFeeRefundDecorator(bk, math.LegacyOneDec()))
+
+		_, err := postHandler(newCtx(500,
+			ante.FeePayerShare{Payer: payer, Share: math.LegacyNewDecWithPrec(7, 1)},
+			ante.FeePayerShare{Payer: otherPayer, Share: math.LegacyNewDecWithPrec(3, 1)},
+		), tx, false, true)
+		require.NoError(t, err)
+		require.Equal(t, []refund{
+			{to: payer, amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 350))},
+			{to: otherPayer, amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 150))},
+		}, bk.refunds)
+	})
+
+	t.Run("failed tx", func(t *testing.T) {
+		bk := &bankKeeper{}
+		postHandler := sdk.ChainPostDecorators(posthandler.NewFeeRefundDecorator(bk, math.LegacyOneDec()))
+
+		_, err := postHandler(newCtx(400, ante.FeePayerShare{Payer: payer, Share: math.LegacyOneDec()}), tx, false, false)
+		require.NoError(t, err)
+		require.Empty(t, bk.refunds)
+	})
+
+	t.Run("no deducted fee", func(t *testing.T) {
+		bk := &bankKeeper{}
+		postHandler := sdk.ChainPostDecorators(posthandler.NewFeeRefundDecorator(bk, math.LegacyOneDec()))
+
+		ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+		_, err := postHandler(ctx.WithGasMeter(storetypes.NewGasMeter(1000)), tx, false, true)
+		require.NoError(t, err)
+		require.Empty(t, bk.refunds)
+	})
+}
+
+func TestNewPostHandler(t *testing.T) {
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{MaxFeeRefundRatio: math.LegacyOneDec()})
+	require.ErrorContains(t, err, "bank keeper is required for fee refunds")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: &bankKeeper{}, MaxFeeRefundRatio: math.LegacyNewDec(2)})
+	require.ErrorContains(t, err, "max fee refund ratio must be between 0 and 1")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: &bankKeeper{}, MaxFeeRefundRatio: math.LegacyNewDecWithPrec(5, 1)})
+	require.NoError(t, err)
+}
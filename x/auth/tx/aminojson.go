@@ -0,0 +1,30 @@
+package tx
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ txsigning.SignModeHandler = aminoJSONSignModeHandler{}
+
+// aminoJSONSignModeHandler wraps the SIGN_MODE_LEGACY_AMINO_JSON handler to
+// reject the transactions with a timeout timestamp, as it is not part of the
+// amino JSON sign doc and would not be signed over.
+type aminoJSONSignModeHandler struct {
+	aminojson.SignModeHandler
+}
+
+// GetSignBytes implements txsigning.SignModeHandler.
+func (h aminoJSONSignModeHandler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	if txData.Body.TimeoutTimestamp != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support timeout timestamps", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	return h.SignModeHandler.GetSignBytes(ctx, signerData, txData)
+}
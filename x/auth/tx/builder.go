@@ -2,15 +2,18 @@ package tx
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	multisigv1beta1 "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/auth/ante"
 	authsign "cosmossdk.io/x/auth/signing"
 	"cosmossdk.io/x/tx/decode"
 
@@ -26,6 +29,7 @@ import (
 var (
 	_ client.TxBuilder          = &builder{}
 	_ ExtensionOptionsTxBuilder = &builder{}
+	_ FeePayerSharesTxBuilder   = &builder{}
 )
 
 func newBuilder(addressCodec address.Codec, decoder *decode.Decoder, codec codec.BinaryCodec) *builder {
@@ -48,7 +52,7 @@ func newBuilderFromDecodedTx(addrCodec address.Codec, decoder *decode.Decoder, c
 	}
 
 	var payer []byte
-	if decoded.feePayer != nil {
+	if decoded.feePayer != nil && len(decoded.feePayerShares) == 0 {
 		payer = decoded.feePayer
 	}
 
@@ -58,8 +62,10 @@ func newBuilderFromDecodedTx(addrCodec address.Codec, decoder *decode.Decoder, c
 		codec:                       codec,
 		msgs:                        decoded.msgsV1,
 		timeoutHeight:               decoded.GetTimeoutHeight(),
+		timeoutTimestamp:            decoded.GetTimeoutTimestamp(),
 		granter:                     decoded.FeeGranter(),
 		payer:                       payer,
+		feePayerShares:              decoded.FeePayerShares(),
 		unordered:                   decoded.GetUnordered(),
 		memo:                        decoded.GetMemo(),
 		gasLimit:                    decoded.GetGas(),
@@ -76,16 +82,18 @@ type builder struct {
 	decoder      *decode.Decoder
 	codec        codec.BinaryCodec
 
-	msgs          []sdk.Msg
-	timeoutHeight uint64
-	granter       []byte
-	payer         []byte
-	unordered     bool
-	memo          string
-	gasLimit      uint64
-	fees          sdk.Coins
-	signerInfos   []*tx.SignerInfo
-	signatures    [][]byte
+	msgs             []sdk.Msg
+	timeoutHeight    uint64
+	timeoutTimestamp time.Time
+	granter          []byte
+	payer            []byte
+	feePayerShares   []ante.FeePayerShare
+	unordered        bool
+	memo             string
+	gasLimit         uint64
+	fees             sdk.Coins
+	signerInfos      []*tx.SignerInfo
+	signatures       [][]byte
 
 	extensionOptions            []*codectypes.Any
 	nonCriticalExtensionOptions []*codectypes.Any
@@ -116,6 +124,9 @@ func (w *builder) getTx() (*gogoTxWrapper, error) {
 		ExtensionOptions:            intoAnyV2(w.extensionOptions),
 		NonCriticalExtensionOptions: intoAnyV2(w.nonCriticalExtensionOptions),
 	}
+	if !w.timeoutTimestamp.IsZero() {
+		body.TimeoutTimestamp = timestamppb.New(w.timeoutTimestamp)
+	}
 
 	fee, err := w.getFee()
 	if err != nil {
@@ -186,6 +197,9 @@ func (w *builder) SetMsgs(msgs ...sdk.Msg) error {
 // SetTimeoutHeight sets the transaction's height timeout.
 func (w *builder) SetTimeoutHeight(height uint64) { w.timeoutHeight = height }
 
+// SetTimeoutTimestamp sets the transaction's timestamp timeout.
+func (w *builder) SetTimeoutTimestamp(timestamp time.Time) { w.timeoutTimestamp = timestamp }
+
 func (w *builder) SetUnordered(v bool) { w.unordered = v }
 
 func (w *builder) SetMemo(memo string) { w.memo = memo }
@@ -198,6 +212,9 @@ func (w *builder) SetFeePayer(feePayer sdk.AccAddress) { w.payer = feePayer }
 
 func (w *builder) SetFeeGranter(feeGranter sdk.AccAddress) { w.granter = feeGranter }
 
+// SetFeePayerShares splits the fee between the given fee payers.
+func (w *builder) SetFeePayerShares(shares ...ante.FeePayerShare) { w.feePayerShares = shares }
+
 func (w *builder) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -257,11 +274,25 @@ func (w *builder) getFee() (fee *txv1beta1.Fee, err error) {
 			return nil, err
 		}
 	}
+	payerShares := make([]*txv1beta1.FeePayerShare, len(w.feePayerShares))
+	for i, share := range w.feePayerShares {
+		payer, err := w.addressCodec.BytesToString(share.Payer)
+		if err != nil {
+			return nil, err
+		}
+		shareBz, err := share.Share.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		payerShares[i] = &txv1beta1.FeePayerShare{Payer: payer, Share: string(shareBz)}
+	}
+
 	fee = &txv1beta1.Fee{
-		Amount:   intoV2Fees(w.fees),
-		GasLimit: w.gasLimit,
-		Payer:    payerStr,
-		Granter:  granterStr,
+		Amount:      intoV2Fees(w.fees),
+		GasLimit:    w.gasLimit,
+		Payer:       payerStr,
+		Granter:     granterStr,
+		PayerShares: payerShares,
 	}
 
 	return fee, nil
@@ -103,11 +103,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
 		if !in.Config.SkipAnteHandler {
-			anteHandler, err := newAnteHandler(txConfig, in)
+			anteDecorators, err := newAnteDecorators(txConfig, in)
 			if err != nil {
 				panic(err)
 			}
-			app.SetAnteHandler(anteHandler)
+			app.SetAnteDecorators(anteDecorators...)
 		}
 
 		// PostHandlers
@@ -142,12 +142,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	return ModuleOutputs{TxConfig: txConfig, TxConfigOptions: txConfigOptions, BaseAppOption: baseAppOption}
 }
 
-func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler, error) {
+func newAnteDecorators(txConfig client.TxConfig, in ModuleInputs) ([]sdk.AnteDecorator, error) {
 	if in.BankKeeper == nil {
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
-	anteHandler, err := ante.NewAnteHandler(
+	anteDecorators, err := ante.NewAnteDecorators(
 		ante.HandlerOptions{
 			AccountKeeper:   in.AccountKeeper,
 			BankKeeper:      in.BankKeeper,
@@ -161,7 +161,7 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("failed to create ante handler: %w", err)
 	}
 
-	return anteHandler, nil
+	return anteDecorators, nil
 }
 
 // NewBankKeeperCoinMetadataQueryFn creates a new Textual struct using the given
@@ -1,14 +1,20 @@
 package tx
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -71,26 +77,89 @@ func newWrapperFromDecodedTx(addrCodec address.Codec, cdc codec.BinaryCodec, dec
 			return nil, err
 		}
 	}
+
+	// fee payer shares, the fee payers being required signers of the tx
+	signers := decodedTx.Signers
+	feePayerShares, err := decodeFeePayerShares(addrCodec, decodedTx.Tx.AuthInfo.Fee)
+	if err != nil {
+		return nil, err
+	}
+	if len(feePayerShares) > 0 {
+		feePayer = feePayerShares[0].Payer
+		signers = slices.Clone(signers)
+		for _, share := range feePayerShares {
+			if !slices.ContainsFunc(signers, func(signer []byte) bool { return bytes.Equal(signer, share.Payer) }) {
+				signers = append(signers, share.Payer)
+			}
+		}
+	}
+
 	return &gogoTxWrapper{
-		cdc:        cdc,
-		decodedTx:  decodedTx,
-		msgsV1:     msgv1,
-		fees:       fees,
-		feePayer:   feePayer,
-		feeGranter: feeGranter,
+		cdc:            cdc,
+		decodedTx:      decodedTx,
+		msgsV1:         msgv1,
+		signers:        signers,
+		fees:           fees,
+		feePayer:       feePayer,
+		feeGranter:     feeGranter,
+		feePayerShares: feePayerShares,
 	}, nil
 }
 
+// decodeFeePayerShares decodes and validates the fee payer shares of the given
+// fee. The shares must be positive and sum to one, their payers must be unique,
+// and the fee payer and granter must be unset.
+func decodeFeePayerShares(addrCodec address.Codec, fee *txv1beta1.Fee) ([]ante.FeePayerShare, error) {
+	if len(fee.PayerShares) == 0 {
+		return nil, nil
+	}
+	if fee.Payer != "" || fee.Granter != "" {
+		return nil, errors.New("fee payer shares cannot be set along with a fee payer or granter")
+	}
+
+	shares := make([]ante.FeePayerShare, len(fee.PayerShares))
+	total := math.LegacyZeroDec()
+	for i, s := range fee.PayerShares {
+		payer, err := addrCodec.StringToBytes(s.Payer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee payer at index %d: %w", i, err)
+		}
+		for _, share := range shares[:i] {
+			if bytes.Equal(share.Payer, payer) {
+				return nil, fmt.Errorf("duplicate fee payer %s", s.Payer)
+			}
+		}
+
+		var share math.LegacyDec
+		if err := share.Unmarshal([]byte(s.Share)); err != nil {
+			return nil, fmt.Errorf("invalid fee payer share at index %d: %w", i, err)
+		}
+		if !share.IsPositive() {
+			return nil, fmt.Errorf("fee payer share must be positive at index %d: %s", i, share)
+		}
+
+		shares[i] = ante.FeePayerShare{Payer: payer, Share: share}
+		total = total.Add(share)
+	}
+	if !total.Equal(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("fee payer shares must sum to one: %s", total)
+	}
+
+	return shares, nil
+}
+
 // gogoTxWrapper is a gogoTxWrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type gogoTxWrapper struct {
 	decodedTx *decode.DecodedTx
 	cdc       codec.BinaryCodec
 
-	msgsV1     []proto.Message
-	fees       sdk.Coins
-	feePayer   []byte
-	feeGranter []byte
+	msgsV1         []proto.Message
+	signers        [][]byte
+	fees           sdk.Coins
+	feePayer       []byte
+	feeGranter     []byte
+	feePayerShares []ante.FeePayerShare
 }
 
 func (w *gogoTxWrapper) String() string { return w.decodedTx.Tx.String() }
@@ -98,6 +167,7 @@ func (w *gogoTxWrapper) String() string { return w.decodedTx.Tx.String() }
 var (
 	_ authsigning.Tx             = &gogoTxWrapper{}
 	_ ante.HasExtensionOptionsTx = &gogoTxWrapper{}
+	_ ante.HasFeePayerSharesTx   = &gogoTxWrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -108,6 +178,14 @@ type ExtensionOptionsTxBuilder interface {
 	SetNonCriticalExtensionOptions(...*codectypes.Any)
 }
 
+// FeePayerSharesTxBuilder defines a TxBuilder that can also split the fee
+// between several fee payers.
+type FeePayerSharesTxBuilder interface {
+	client.TxBuilder
+
+	SetFeePayerShares(...ante.FeePayerShare)
+}
+
 func (w *gogoTxWrapper) GetMsgs() []sdk.Msg {
 	if w.msgsV1 == nil {
 		panic("fill in msgs")
@@ -123,14 +201,40 @@ func (w *gogoTxWrapper) ValidateBasic() error {
 	if len(w.decodedTx.Tx.Signatures) == 0 {
 		return sdkerrors.ErrNoSignatures.Wrapf("empty signatures")
 	}
-	if len(w.decodedTx.Signers) != len(w.decodedTx.Tx.Signatures) {
-		return sdkerrors.ErrUnauthorized.Wrapf("invalid number of signatures: got %d signatures and %d signers", len(w.decodedTx.Tx.Signatures), len(w.decodedTx.Signers))
+	if len(w.signers) != len(w.decodedTx.Tx.Signatures) {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid number of signatures: got %d signatures and %d signers", len(w.decodedTx.Tx.Signatures), len(w.signers))
+	}
+	// the fee payer shares are only covered by the signatures of SIGN_MODE_DIRECT
+	if len(w.feePayerShares) > 0 {
+		for _, si := range w.decodedTx.Tx.AuthInfo.SignerInfos {
+			if !isDirectModeInfo(si.ModeInfo) {
+				return sdkerrors.ErrUnauthorized.Wrap("transactions splitting their fee must be signed with SIGN_MODE_DIRECT")
+			}
+		}
 	}
 	return nil
 }
 
+// isDirectModeInfo returns whether the given mode info, and those of all the
+// signers of a multisig, use SIGN_MODE_DIRECT.
+func isDirectModeInfo(modeInfo *txv1beta1.ModeInfo) bool {
+	switch mi := modeInfo.GetSum().(type) {
+	case *txv1beta1.ModeInfo_Single_:
+		return mi.Single.Mode == signingv1beta1.SignMode_SIGN_MODE_DIRECT
+	case *txv1beta1.ModeInfo_Multi_:
+		for _, m := range mi.Multi.ModeInfos {
+			if !isDirectModeInfo(m) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
 func (w *gogoTxWrapper) GetSigners() ([][]byte, error) {
-	return w.decodedTx.Signers, nil
+	return w.signers, nil
 }
 
 func (w *gogoTxWrapper) GetPubKeys() ([]cryptotypes.PubKey, error) {
@@ -168,11 +272,22 @@ func (w *gogoTxWrapper) FeePayer() []byte { return w.feePayer }
 
 func (w *gogoTxWrapper) FeeGranter() []byte { return w.feeGranter }
 
+// FeePayerShares implements ante.HasFeePayerSharesTx.
+func (w *gogoTxWrapper) FeePayerShares() []ante.FeePayerShare { return w.feePayerShares }
+
 func (w *gogoTxWrapper) GetMemo() string { return w.decodedTx.Tx.Body.Memo }
 
 // GetTimeoutHeight returns the transaction's timeout height (if set).
 func (w *gogoTxWrapper) GetTimeoutHeight() uint64 { return w.decodedTx.Tx.Body.TimeoutHeight }
 
+// GetTimeoutTimestamp returns the transaction's timeout timestamp (if set).
+func (w *gogoTxWrapper) GetTimeoutTimestamp() time.Time {
+	if w.decodedTx.Tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+	return w.decodedTx.Tx.Body.TimeoutTimestamp.AsTime()
+}
+
 // GetUnordered returns the transaction's unordered field (if set).
 func (w *gogoTxWrapper) GetUnordered() bool { return w.decodedTx.Tx.Body.Unordered }
 
@@ -1 +1,143 @@
 package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestDecodeFeePayerShares(t *testing.T) {
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	addr1Str, err := addrCodec.BytesToString(addr1)
+	require.NoError(t, err)
+	addr2Str, err := addrCodec.BytesToString(addr2)
+	require.NoError(t, err)
+
+	share := func(payer string, share math.LegacyDec) *txv1beta1.FeePayerShare {
+		bz, err := share.Marshal()
+		require.NoError(t, err)
+		return &txv1beta1.FeePayerShare{Payer: payer, Share: string(bz)}
+	}
+
+	testCases := []struct {
+		name   string
+		fee    *txv1beta1.Fee
+		expErr string
+		exp    []ante.FeePayerShare
+	}{
+		{
+			name: "no shares",
+			fee:  &txv1beta1.Fee{Payer: addr1Str},
+		},
+		{
+			name: "valid shares",
+			fee: &txv1beta1.Fee{PayerShares: []*txv1beta1.FeePayerShare{
+				share(addr1Str, math.LegacyNewDecWithPrec(25, 2)),
+				share(addr2Str, math.LegacyNewDecWithPrec(75, 2)),
+			}},
+			exp: []ante.FeePayerShare{
+				{Payer: addr1, Share: math.LegacyNewDecWithPrec(25, 2)},
+				{Payer: addr2, Share: math.LegacyNewDecWithPrec(75, 2)},
+			},
+		},
+		{
+			name: "payer set",
+			fee: &txv1beta1.Fee{Payer: addr1Str, PayerShares: []*txv1beta1.FeePayerShare{
+				share(addr1Str, math.LegacyOneDec()),
+			}},
+			expErr: "along with a fee payer or granter",
+		},
+		{
+			name: "duplicate payer",
+			fee: &txv1beta1.Fee{PayerShares: []*txv1beta1.FeePayerShare{
+				share(addr1Str, math.LegacyNewDecWithPrec(5, 1)),
+				share(addr1Str, math.LegacyNewDecWithPrec(5, 1)),
+			}},
+			expErr: "duplicate fee payer",
+		},
+		{
+			name: "zero share",
+			fee: &txv1beta1.Fee{PayerShares: []*txv1beta1.FeePayerShare{
+				share(addr1Str, math.LegacyOneDec()),
+				share(addr2Str, math.LegacyZeroDec()),
+			}},
+			expErr: "must be positive",
+		},
+		{
+			name: "shares not summing to one",
+			fee: &txv1beta1.Fee{PayerShares: []*txv1beta1.FeePayerShare{
+				share(addr1Str, math.LegacyNewDecWithPrec(5, 1)),
+				share(addr2Str, math.LegacyNewDecWithPrec(4, 1)),
+			}},
+			expErr: "must sum to one",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shares, err := decodeFeePayerShares(addrCodec, tc.fee)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, shares)
+		})
+	}
+}
+
+func TestFeePayerSharesTx(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	cdc.InterfaceRegistry().RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), DefaultSignModes)
+
+	_, pk1, addr1 := testdata.KeyTestPubAddr()
+	_, pk2, addr2 := testdata.KeyTestPubAddr()
+
+	builder := txConfig.NewTxBuilder().(FeePayerSharesTxBuilder)
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr1)))
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetFeePayerShares(
+		ante.FeePayerShare{Payer: addr2, Share: math.LegacyNewDecWithPrec(6, 1)},
+		ante.FeePayerShare{Payer: addr1, Share: math.LegacyNewDecWithPrec(4, 1)},
+	)
+
+	setSignatures := func(signMode signing.SignMode) {
+		require.NoError(t, builder.SetSignatures(
+			signing.SignatureV2{PubKey: pk1, Data: &signing.SingleSignatureData{SignMode: signMode, Signature: []byte("sig1")}},
+			signing.SignatureV2{PubKey: pk2, Data: &signing.SingleSignatureData{SignMode: signMode, Signature: []byte("sig2")}},
+		))
+	}
+	setSignatures(signing.SignMode_SIGN_MODE_DIRECT)
+
+	// the fee payers are required signers, the first of them being the fee payer
+	tx := builder.GetTx()
+	signers, err := tx.GetSigners()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{addr1, addr2}, signers)
+	require.Equal(t, []byte(addr2), tx.FeePayer())
+	require.NoError(t, tx.(sdk.HasValidateBasic).ValidateBasic())
+
+	// the shares are preserved by the tx encoding
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	decoded, err := txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx.(ante.HasFeePayerSharesTx).FeePayerShares(), decoded.(ante.HasFeePayerSharesTx).FeePayerShares())
+
+	// the shares are only covered by the signatures of SIGN_MODE_DIRECT
+	setSignatures(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorContains(t, builder.GetTx().(sdk.HasValidateBasic).ValidateBasic(), "SIGN_MODE_DIRECT")
+}
@@ -136,6 +136,35 @@ func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtype
 	}, nil
 }
 
+// GetTxMsgResponses implements the ServiceServer.GetTxMsgResponses RPC method.
+func (s txServer) GetTxMsgResponses(ctx context.Context, req *txtypes.GetTxMsgResponsesRequest) (*txtypes.GetTxMsgResponsesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+
+	result, err := QueryTx(s.clientCtx, req.Hash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+		}
+
+		return nil, err
+	}
+
+	txMsgData, err := result.GetTxMsgData()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode tx msg data: %v", err)
+	}
+
+	return &txtypes.GetTxMsgResponsesResponse{
+		MsgResponses: txMsgData.MsgResponses,
+	}, nil
+}
+
 // GetBlockWithTxs returns a block with decoded txs.
 func (s txServer) GetBlockWithTxs(ctx context.Context, req *txtypes.GetBlockWithTxsRequest) (*txtypes.GetBlockWithTxsResponse, error) {
 	if req == nil {
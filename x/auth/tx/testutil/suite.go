@@ -3,6 +3,7 @@ package testutil
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -62,6 +63,32 @@ func (s *TxConfigTestSuite) TestTxBuilderSetMemo() {
 	s.Require().Equal(txWithMemo.GetMemo(), newMemo)
 }
 
+func (s *TxConfigTestSuite) TestTxBuilderSetTimeoutTimestamp() {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	timeoutTimestamp := time.Unix(1700000000, 0).UTC()
+	txBuilder := s.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetTimeoutTimestamp(timeoutTimestamp)
+	tx := txBuilder.GetTx()
+
+	txWithTimeout, ok := tx.(interface{ GetTimeoutTimestamp() time.Time })
+	s.Require().True(ok)
+	s.Require().Equal(timeoutTimestamp, txWithTimeout.GetTimeoutTimestamp())
+
+	// the timeout timestamp is not part of the amino JSON sign doc
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test",
+		AccountNumber: 1,
+		Sequence:      1,
+		PubKey:        pubkey,
+	}
+	_, err := signing.GetSignBytesAdapter(context.Background(), s.TxConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, tx)
+	s.Require().NoError(err)
+	_, err = signing.GetSignBytesAdapter(context.Background(), s.TxConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
+	s.Require().ErrorContains(err, "does not support timeout timestamps")
+}
+
 func (s *TxConfigTestSuite) TestTxBuilderSetMsgs() {
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
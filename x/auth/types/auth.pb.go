@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -172,6 +174,19 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// max_msgs_per_tx is the maximum number of messages a transaction can contain.
+	// Zero means no limit.
+	MaxMsgsPerTx uint64 `protobuf:"varint,6,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
+	// max_txs_per_account is the maximum number of transactions an account can
+	// sign within a rate limit window. Zero means no limit.
+	MaxTxsPerAccount uint64 `protobuf:"varint,7,opt,name=max_txs_per_account,json=maxTxsPerAccount,proto3" json:"max_txs_per_account,omitempty"`
+	// tx_rate_limit_window is the length, in blocks, of the window over which
+	// max_txs_per_account is enforced. Zero is equivalent to a single block.
+	TxRateLimitWindow uint64 `protobuf:"varint,8,opt,name=tx_rate_limit_window,json=txRateLimitWindow,proto3" json:"tx_rate_limit_window,omitempty"`
+	// min_gas_prices is the registry of the denoms accepted to pay fees, with
+	// their minimum gas price. When it is not empty, it replaces the validator
+	// min gas prices and is enforced in all execution modes.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,6 +257,34 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxMsgsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxsPerAccount() uint64 {
+	if m != nil {
+		return m.MaxTxsPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetTxRateLimitWindow() uint64 {
+	if m != nil {
+		return m.TxRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -252,54 +295,65 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xf3, 0x46,
-	0x10, 0x8e, 0x93, 0x94, 0xbf, 0x6c, 0xf8, 0xa1, 0x98, 0x94, 0x9a, 0xa8, 0x8a, 0x4d, 0xa4, 0x8a,
-	0x08, 0x35, 0x0e, 0x09, 0xa5, 0x15, 0xb9, 0x91, 0xb4, 0xaa, 0x10, 0x85, 0x22, 0x47, 0xe5, 0xc0,
-	0xc5, 0x5a, 0xdb, 0x8b, 0x59, 0x25, 0xf6, 0xba, 0xde, 0x35, 0x8a, 0x79, 0x02, 0xd4, 0x53, 0xd5,
-	0x4b, 0xaf, 0xb4, 0x4f, 0xc0, 0x81, 0x87, 0xa8, 0x7a, 0x42, 0x5c, 0xda, 0x53, 0x54, 0x85, 0x03,
-	0xa8, 0xea, 0x43, 0x54, 0xde, 0x75, 0x48, 0x82, 0x72, 0x89, 0x3c, 0xdf, 0xf7, 0xcd, 0xcc, 0x37,
-	0x93, 0xb1, 0x41, 0xd9, 0x26, 0xd4, 0x23, 0xb4, 0x0e, 0x23, 0x76, 0x59, 0xbf, 0x6a, 0x58, 0x88,
-	0xc1, 0x06, 0x0f, 0xf4, 0x20, 0x24, 0x8c, 0xc8, 0x6b, 0x82, 0xd7, 0x39, 0x94, 0xf2, 0xa5, 0x55,
-	0xe8, 0x61, 0x9f, 0xd4, 0xf9, 0xaf, 0xd0, 0x95, 0x36, 0x84, 0xce, 0xe4, 0x51, 0x3d, 0x4d, 0x12,
-	0x54, 0xd1, 0x25, 0x2e, 0x11, 0x78, 0xf2, 0x34, 0x4e, 0x70, 0x09, 0x71, 0xfb, 0xa8, 0xce, 0x23,
-	0x2b, 0xba, 0xa8, 0x43, 0x3f, 0x16, 0x54, 0xe5, 0xb7, 0x2c, 0x28, 0xb4, 0x21, 0x45, 0x07, 0xb6,
-	0x4d, 0x22, 0x9f, 0xc9, 0x4d, 0xf0, 0x0e, 0x3a, 0x4e, 0x88, 0x28, 0x55, 0x24, 0x4d, 0xaa, 0x2e,
-	0xb6, 0x95, 0xc7, 0xfb, 0x5a, 0x31, 0xed, 0x71, 0x20, 0x98, 0x2e, 0x0b, 0xb1, 0xef, 0x1a, 0x63,
-	0xa1, 0x7c, 0x06, 0xde, 0x05, 0x91, 0x65, 0xf6, 0x50, 0xac, 0x64, 0x35, 0xa9, 0x5a, 0x68, 0x16,
-	0x75, 0xd1, 0x50, 0x1f, 0x37, 0xd4, 0x0f, 0xfc, 0xb8, 0xbd, 0xf5, 0xef, 0x50, 0x2d, 0x06, 0x91,
-	0xd5, 0xc7, 0x76, 0xa2, 0xfd, 0x9c, 0x78, 0x98, 0x21, 0x2f, 0x60, 0xf1, 0xef, 0xcf, 0x77, 0xdb,
-	0x60, 0x42, 0x18, 0x0b, 0x41, 0x64, 0x1d, 0xa1, 0x58, 0xfe, 0x0c, 0x2c, 0x43, 0x61, 0xcb, 0xf4,
-	0x23, 0xcf, 0x42, 0xa1, 0x92, 0xd3, 0xa4, 0x6a, 0xde, 0x78, 0x9f, 0xa2, 0x27, 0x1c, 0x94, 0x4b,
-	0xe0, 0x43, 0x8a, 0x7e, 0x8c, 0x90, 0x6f, 0x23, 0x25, 0xcf, 0x05, 0xaf, 0x71, 0xab, 0x73, 0x73,
-	0xab, 0x66, 0x5e, 0x6e, 0xd5, 0xcc, 0x9f, 0xf7, 0xb5, 0x4f, 0xe7, 0xac, 0x57, 0x4f, 0xe7, 0x3e,
-	0xfc, 0xe9, 0xf9, 0x6e, 0x7b, 0x5d, 0x08, 0x6a, 0xd4, 0xe9, 0xd5, 0xa7, 0x76, 0x52, 0xf9, 0x4f,
-	0x02, 0xef, 0x8f, 0x89, 0x13, 0xf5, 0x5f, 0xb7, 0x74, 0x08, 0x96, 0x2c, 0x48, 0x91, 0x99, 0x1a,
-	0xe1, 0xab, 0x2a, 0x34, 0x35, 0x7d, 0x5e, 0x87, 0xa9, 0x4a, 0xed, 0xfc, 0xc3, 0x50, 0x95, 0x8c,
-	0x82, 0x35, 0xb5, 0x70, 0x19, 0xe4, 0x7d, 0xe8, 0x21, 0xbe, 0xb9, 0x45, 0x83, 0x3f, 0xcb, 0x1a,
-	0x28, 0x04, 0x28, 0xf4, 0x30, 0xa5, 0x98, 0xf8, 0x54, 0xc9, 0x69, 0xb9, 0xea, 0xa2, 0x31, 0x0d,
-	0xb5, 0xce, 0x6f, 0xc4, 0x4c, 0x95, 0x79, 0x1d, 0x67, 0xbc, 0xf2, 0xc9, 0x94, 0xa9, 0xc9, 0x66,
-	0xd8, 0x5f, 0x9e, 0xef, 0xb6, 0x97, 0x3d, 0x8e, 0x8c, 0x87, 0xa9, 0xfc, 0x2a, 0x81, 0x8f, 0x84,
-	0xa8, 0x13, 0x22, 0x07, 0xf9, 0x0c, 0xc3, 0xbe, 0xac, 0x82, 0x42, 0x2a, 0xe3, 0x6e, 0xf9, 0x6d,
-	0x18, 0x40, 0x40, 0x27, 0x89, 0xe7, 0x2d, 0xb0, 0xe2, 0xa0, 0x10, 0x5f, 0x41, 0x86, 0x89, 0x9f,
-	0xfc, 0x8d, 0x54, 0xc9, 0x6a, 0xb9, 0xea, 0x92, 0xb1, 0x3c, 0x81, 0x8f, 0x50, 0x4c, 0x5b, 0xfb,
-	0x8f, 0xf7, 0xb5, 0x95, 0x89, 0x1f, 0x6d, 0x47, 0xff, 0xe2, 0xab, 0xc4, 0xe3, 0xe6, 0x94, 0xc7,
-	0x6f, 0x43, 0x12, 0x05, 0xa9, 0xc5, 0x89, 0x89, 0xca, 0x5f, 0x59, 0xb0, 0x70, 0x0a, 0x43, 0xe8,
-	0x51, 0x59, 0x07, 0x6b, 0x1e, 0x1c, 0x98, 0x1e, 0xf2, 0x88, 0x69, 0x5f, 0xc2, 0x10, 0xda, 0x0c,
-	0x85, 0xe2, 0x66, 0xf3, 0xc6, 0xaa, 0x07, 0x07, 0xc7, 0xc8, 0x23, 0x9d, 0x57, 0x42, 0xd6, 0xc0,
-	0x12, 0x1b, 0x98, 0x14, 0xbb, 0x66, 0x1f, 0x7b, 0x98, 0xf1, 0x75, 0xe7, 0x0d, 0xc0, 0x06, 0x5d,
-	0xec, 0x7e, 0x97, 0x20, 0xf2, 0x0e, 0xf8, 0x98, 0x2b, 0xae, 0x91, 0x69, 0x13, 0xca, 0xcc, 0x00,
-	0x85, 0xa6, 0x15, 0x33, 0x94, 0x1e, 0xdd, 0x6a, 0x22, 0xbd, 0x46, 0x1d, 0x42, 0xd9, 0x29, 0x0a,
-	0xdb, 0x31, 0x43, 0xf2, 0xf7, 0xe0, 0x93, 0xa4, 0xe0, 0x15, 0x0a, 0xf1, 0x45, 0x2c, 0x92, 0x90,
-	0xd3, 0xdc, 0xdb, 0x6b, 0xec, 0x8b, 0x3b, 0x6c, 0x2b, 0xa3, 0xa1, 0x5a, 0xec, 0x62, 0xf7, 0x8c,
-	0x2b, 0x92, 0xd4, 0x6f, 0xbe, 0xe6, 0xbc, 0x51, 0xa4, 0x33, 0xa8, 0xc8, 0x92, 0x7f, 0x00, 0x1b,
-	0x6f, 0x0b, 0x52, 0x64, 0x07, 0xcd, 0xbd, 0x2f, 0x7b, 0x0d, 0xe5, 0x03, 0x5e, 0xb2, 0x34, 0x1a,
-	0xaa, 0xeb, 0x33, 0x25, 0xbb, 0x63, 0x85, 0xb1, 0x4e, 0xe7, 0xe2, 0xad, 0xcd, 0x97, 0x5b, 0x55,
-	0x7a, 0x7b, 0x06, 0x03, 0xf1, 0x19, 0x12, 0xeb, 0x6c, 0xef, 0xfe, 0x31, 0x2a, 0x4b, 0x0f, 0xa3,
-	0xb2, 0xf4, 0xcf, 0xa8, 0x2c, 0xfd, 0xfc, 0x54, 0xce, 0x3c, 0x3c, 0x95, 0x33, 0x7f, 0x3f, 0x95,
-	0x33, 0xe7, 0xe9, 0xc7, 0x86, 0x3a, 0x3d, 0x1d, 0x93, 0x71, 0x16, 0x8b, 0x03, 0x44, 0xad, 0x05,
-	0xfe, 0x7a, 0xef, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x84, 0x7c, 0x64, 0xd8, 0x04, 0x00,
-	0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x6d, 0xc5, 0x8e, 0x57, 0x8a, 0x1d, 0xd3, 0xaa, 0xcb, 0x18, 0x81, 0xc8, 0x08, 0x28,
+	0x22, 0xb8, 0x35, 0x15, 0x29, 0x75, 0x8b, 0xe8, 0x66, 0xc9, 0x45, 0x10, 0xb8, 0x49, 0x0d, 0x3a,
+	0x4d, 0x81, 0x5c, 0x88, 0x25, 0xb9, 0xa1, 0x17, 0xd6, 0x72, 0x59, 0xee, 0xd2, 0x21, 0xf3, 0x05,
+	0x41, 0x2f, 0x2d, 0x7a, 0xe9, 0xd5, 0xed, 0xa9, 0xe8, 0xa5, 0x3e, 0xf8, 0x23, 0x82, 0x9e, 0x8c,
+	0x9c, 0x8a, 0x1e, 0xd4, 0x42, 0x3e, 0x38, 0x28, 0xfa, 0x11, 0x05, 0x77, 0x49, 0x59, 0x36, 0x94,
+	0x0b, 0xc1, 0x9d, 0x79, 0x33, 0xf3, 0xe6, 0xed, 0xec, 0x80, 0xba, 0x4b, 0x19, 0xa1, 0xac, 0x05,
+	0x63, 0xbe, 0xdf, 0x3a, 0x6c, 0x3b, 0x88, 0xc3, 0xb6, 0x38, 0x98, 0x61, 0x44, 0x39, 0x55, 0x57,
+	0xa4, 0xdf, 0x14, 0xa6, 0xdc, 0xbf, 0xb6, 0x0c, 0x09, 0x0e, 0x68, 0x4b, 0x7c, 0x25, 0x6e, 0xed,
+	0x96, 0xc4, 0xd9, 0xe2, 0xd4, 0xca, 0x83, 0xa4, 0xab, 0x28, 0xe1, 0x40, 0x86, 0xc6, 0x25, 0x5c,
+	0x8a, 0x83, 0xdc, 0x5f, 0xf3, 0xa9, 0x4f, 0x65, 0x5c, 0xf6, 0x57, 0x24, 0xf4, 0x29, 0xf5, 0x07,
+	0xa8, 0x25, 0x4e, 0x4e, 0xfc, 0xa2, 0x05, 0x83, 0x54, 0xba, 0x1a, 0x3f, 0xcf, 0x80, 0x4a, 0x0f,
+	0x32, 0xb4, 0xe5, 0xba, 0x34, 0x0e, 0xb8, 0xda, 0x01, 0xf3, 0xd0, 0xf3, 0x22, 0xc4, 0x98, 0xa6,
+	0x18, 0x4a, 0x73, 0xa1, 0xa7, 0xbd, 0x3d, 0xd9, 0xa8, 0xe5, 0x1c, 0xb6, 0xa4, 0x67, 0x8f, 0x47,
+	0x38, 0xf0, 0xad, 0x02, 0xa8, 0x3e, 0x03, 0xf3, 0x61, 0xec, 0xd8, 0x07, 0x28, 0xd5, 0x66, 0x0c,
+	0xa5, 0x59, 0xe9, 0xd4, 0x4c, 0x59, 0xd0, 0x2c, 0x0a, 0x9a, 0x5b, 0x41, 0xda, 0xbb, 0xfb, 0xef,
+	0x50, 0xaf, 0x85, 0xb1, 0x33, 0xc0, 0x6e, 0x86, 0xfd, 0x84, 0x12, 0xcc, 0x11, 0x09, 0x79, 0xfa,
+	0xcb, 0xf9, 0xf1, 0x3a, 0xb8, 0x70, 0x58, 0x73, 0x61, 0xec, 0xec, 0xa0, 0x54, 0xfd, 0x08, 0x2c,
+	0x42, 0x49, 0xcb, 0x0e, 0x62, 0xe2, 0xa0, 0x48, 0x9b, 0x35, 0x94, 0x66, 0xd9, 0xba, 0x91, 0x5b,
+	0x9f, 0x08, 0xa3, 0xba, 0x06, 0xae, 0x33, 0xf4, 0x6d, 0x8c, 0x02, 0x17, 0x69, 0x65, 0x01, 0x18,
+	0x9f, 0xbb, 0xfd, 0xd7, 0x47, 0x7a, 0xe9, 0xdd, 0x91, 0x5e, 0xfa, 0xe3, 0x64, 0xe3, 0xf6, 0x14,
+	0xf9, 0xcd, 0xbc, 0xef, 0x47, 0xdf, 0x9d, 0x1f, 0xaf, 0xaf, 0x4a, 0xc0, 0x06, 0xf3, 0x0e, 0x5a,
+	0x13, 0x9a, 0x34, 0xfe, 0x53, 0xc0, 0x8d, 0xc7, 0xd4, 0x8b, 0x07, 0x63, 0x95, 0x1e, 0x81, 0x6a,
+	0x76, 0x03, 0x76, 0x4e, 0x44, 0x48, 0x55, 0xe9, 0x18, 0xe6, 0xb4, 0x0a, 0x13, 0x99, 0x7a, 0xe5,
+	0xd3, 0xa1, 0xae, 0x58, 0x15, 0x67, 0x42, 0x70, 0x15, 0x94, 0x03, 0x48, 0x90, 0x50, 0x6e, 0xc1,
+	0x12, 0xff, 0xaa, 0x01, 0x2a, 0x21, 0x8a, 0x08, 0x66, 0x0c, 0xd3, 0x80, 0x69, 0xb3, 0xc6, 0x6c,
+	0x73, 0xc1, 0x9a, 0x34, 0x75, 0x9f, 0xbf, 0x96, 0x3d, 0x35, 0xa6, 0x55, 0xbc, 0xc4, 0x55, 0x74,
+	0xa6, 0x4d, 0x74, 0x76, 0xc9, 0xfb, 0xe3, 0xf9, 0xf1, 0xfa, 0x22, 0x11, 0x96, 0xa2, 0x99, 0xc6,
+	0x4f, 0x0a, 0xb8, 0x29, 0x41, 0xfd, 0x08, 0x79, 0x28, 0xe0, 0x18, 0x0e, 0x54, 0x1d, 0x54, 0x72,
+	0x98, 0x60, 0x2b, 0x66, 0xc3, 0x02, 0xd2, 0xf4, 0x24, 0xe3, 0x7c, 0x17, 0x2c, 0x79, 0x28, 0xc2,
+	0x87, 0x90, 0x63, 0x1a, 0x64, 0xd7, 0xc8, 0xb4, 0x19, 0x63, 0xb6, 0x59, 0xb5, 0x16, 0x2f, 0xcc,
+	0x3b, 0x28, 0x65, 0xdd, 0x07, 0x6f, 0x4f, 0x36, 0x96, 0x2e, 0xf8, 0x18, 0xf7, 0xcc, 0x4f, 0x3f,
+	0xcf, 0x38, 0xde, 0x99, 0xe0, 0xf8, 0x30, 0xa2, 0x71, 0x98, 0x53, 0xbc, 0x20, 0xd1, 0xf8, 0xfd,
+	0x1a, 0x98, 0xdb, 0x85, 0x11, 0x24, 0x4c, 0x35, 0xc1, 0x0a, 0x81, 0x89, 0x4d, 0x10, 0xa1, 0xb6,
+	0xbb, 0x0f, 0x23, 0xe8, 0x72, 0x14, 0xc9, 0x99, 0x2d, 0x5b, 0xcb, 0x04, 0x26, 0x8f, 0x11, 0xa1,
+	0xfd, 0xb1, 0x43, 0x35, 0x40, 0x95, 0x27, 0x36, 0xc3, 0xbe, 0x3d, 0xc0, 0x04, 0x73, 0x21, 0x77,
+	0xd9, 0x02, 0x3c, 0xd9, 0xc3, 0xfe, 0x97, 0x99, 0x45, 0xbd, 0x07, 0x3e, 0x10, 0x88, 0x57, 0xc8,
+	0x76, 0x29, 0xe3, 0x76, 0x88, 0x22, 0xdb, 0x49, 0x39, 0xca, 0x87, 0x6e, 0x39, 0x83, 0xbe, 0x42,
+	0x7d, 0xca, 0xf8, 0x2e, 0x8a, 0x7a, 0x29, 0x47, 0xea, 0x57, 0xe0, 0xc3, 0x2c, 0xe1, 0x21, 0x8a,
+	0xf0, 0x8b, 0x54, 0x06, 0x21, 0xaf, 0xb3, 0xb9, 0xd9, 0x7e, 0x20, 0xe7, 0xb0, 0xa7, 0x8d, 0x86,
+	0x7a, 0x6d, 0x0f, 0xfb, 0xcf, 0x04, 0x22, 0x0b, 0xfd, 0x62, 0x5b, 0xf8, 0xad, 0x1a, 0xbb, 0x64,
+	0x95, 0x51, 0xea, 0xd7, 0xe0, 0xd6, 0xd5, 0x84, 0x0c, 0xb9, 0x61, 0x67, 0xf3, 0xb3, 0x83, 0xb6,
+	0x76, 0x4d, 0xa4, 0x5c, 0x1b, 0x0d, 0xf5, 0xd5, 0x4b, 0x29, 0xf7, 0x0a, 0x84, 0xb5, 0xca, 0xa6,
+	0xda, 0xd5, 0x2e, 0x58, 0x12, 0x5a, 0x31, 0x9f, 0x89, 0xae, 0x78, 0xa2, 0xcd, 0x89, 0x64, 0x2b,
+	0x7f, 0x5d, 0xbd, 0x8a, 0xcd, 0xb6, 0x55, 0xcd, 0xc4, 0x63, 0x3e, 0xdb, 0x45, 0xd1, 0xd3, 0x44,
+	0xed, 0x49, 0x9d, 0x79, 0x22, 0x43, 0x8b, 0x81, 0x9f, 0x7f, 0x7f, 0xfc, 0x4d, 0x02, 0x93, 0xa7,
+	0x49, 0x16, 0x5e, 0x8c, 0xf8, 0x36, 0xa8, 0xf1, 0xc4, 0x8e, 0x20, 0x47, 0x52, 0x7c, 0xfb, 0x25,
+	0x0e, 0x3c, 0xfa, 0x52, 0xbb, 0xfe, 0xfe, 0x24, 0xcb, 0x3c, 0xb1, 0x20, 0x47, 0xe2, 0x66, 0xbe,
+	0x11, 0x68, 0xf5, 0x7b, 0x05, 0x2c, 0x12, 0x1c, 0xd8, 0x3e, 0xcc, 0x36, 0x23, 0x76, 0x11, 0xd3,
+	0x16, 0x8c, 0xd9, 0x66, 0xa5, 0x73, 0xbb, 0x78, 0x76, 0xd9, 0xb3, 0x1a, 0x3f, 0x82, 0x6d, 0xe4,
+	0xf6, 0x29, 0x0e, 0x7a, 0x3b, 0x6f, 0x86, 0x7a, 0xe9, 0xb7, 0xbf, 0xf5, 0x8f, 0x7d, 0xcc, 0xf7,
+	0x63, 0xc7, 0x74, 0x29, 0xc9, 0x57, 0x6a, 0x6b, 0x62, 0xe0, 0x78, 0x1a, 0x22, 0x56, 0xc4, 0xb0,
+	0x29, 0x8c, 0x7e, 0x3d, 0x3f, 0x5e, 0x57, 0xac, 0x2a, 0xc1, 0xc1, 0x43, 0xc8, 0x76, 0x45, 0xf9,
+	0xee, 0x9d, 0x77, 0x47, 0xba, 0x72, 0xf5, 0x79, 0x25, 0x72, 0xfd, 0xcb, 0x31, 0xed, 0xdd, 0x7f,
+	0x33, 0xaa, 0x2b, 0xa7, 0xa3, 0xba, 0xf2, 0xcf, 0xa8, 0xae, 0xfc, 0x70, 0x56, 0x2f, 0x9d, 0x9e,
+	0xd5, 0x4b, 0x7f, 0x9e, 0xd5, 0x4b, 0xcf, 0xf3, 0x25, 0xcf, 0xbc, 0x03, 0x13, 0xd3, 0x22, 0x4a,
+	0xd0, 0x70, 0xe6, 0xc4, 0xda, 0xbc, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x2f, 0x7a,
+	0xb6, 0x50, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +390,23 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.MaxMsgsPerTx != that1.MaxMsgsPerTx {
+		return false
+	}
+	if this.MaxTxsPerAccount != that1.MaxTxsPerAccount {
+		return false
+	}
+	if this.TxRateLimitWindow != that1.TxRateLimitWindow {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +571,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TxRateLimitWindow != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TxRateLimitWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTxsPerAccount != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxTxsPerAccount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -625,6 +725,21 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMsgsPerTx))
+	}
+	if m.MaxTxsPerAccount != 0 {
+		n += 1 + sovAuth(uint64(m.MaxTxsPerAccount))
+	}
+	if m.TxRateLimitWindow != 0 {
+		n += 1 + sovAuth(uint64(m.TxRateLimitWindow))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1178,6 +1293,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerAccount", wireType)
+			}
+			m.MaxTxsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRateLimitWindow", wireType)
+			}
+			m.TxRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,23 @@
+package types
+
+// auth module block summary attributes
+const (
+	// BlockSummaryKeyFeesCollected is the block summary attribute totalling
+	// the fees deducted from the transactions of the block.
+	BlockSummaryKeyFeesCollected = "fees_collected"
+)
+
+// auth module event types
+const (
+	// EventTypeFee is the event of the fee paid by a fee payer of a
+	// transaction in a denom, emitted once per fee payer and denom.
+	EventTypeFee = "fee"
+
+	AttributeKeyPayer     = "payer"
+	AttributeKeyGranter   = "granter"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyGasWanted = "gas_wanted"
+	AttributeKeyGasUsed   = "gas_used"
+	AttributeKeyGasPrice  = "gas_price"
+)
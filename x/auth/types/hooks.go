@@ -0,0 +1,58 @@
+package types
+
+import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountHooks defines the hooks invoked by the account keeper when the public
+// key of an account is changed or cleared, or when an account is removed. They
+// let modules revoke the permissions granted by the previous owner of the key.
+type AccountHooks interface {
+	// AfterAccountPubKeyChanged is called when an account having a public key
+	// is stored with another public key, or without public key.
+	AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error
+	// AfterAccountRemoved is called when an account is removed.
+	AfterAccountRemoved(ctx context.Context, addr sdk.AccAddress) error
+}
+
+// AccountHooksWrapper is a wrapper for modules to inject AccountHooks using depinject.
+type AccountHooksWrapper struct{ AccountHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AccountHooksWrapper) IsOnePerModuleType() {}
+
+var _ AccountHooks = MultiAccountHooks{}
+
+// MultiAccountHooks combines multiple account hooks, all hook functions are run
+// in array sequence.
+type MultiAccountHooks []AccountHooks
+
+// NewMultiAccountHooks returns a MultiAccountHooks running the given hooks.
+func NewMultiAccountHooks(hooks ...AccountHooks) MultiAccountHooks {
+	return hooks
+}
+
+// AfterAccountPubKeyChanged implements AccountHooks.
+func (h MultiAccountHooks) AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterAccountPubKeyChanged(ctx, addr, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterAccountRemoved implements AccountHooks.
+func (h MultiAccountHooks) AfterAccountRemoved(ctx context.Context, addr sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterAccountRemoved(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,10 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// TxCountsKeyPrefix prefix for the per account transaction counters used
+	// for rate limiting.
+	TxCountsKeyPrefix = collections.NewPrefix(3)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -11,6 +11,9 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultMaxMsgsPerTx           uint64 = 0
+	DefaultMaxTxsPerAccount       uint64 = 0
+	DefaultTxRateLimitWindow      uint64 = 1
 )
 
 // NewParams creates a new Params object
@@ -32,6 +35,9 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMsgsPerTx:           DefaultMaxMsgsPerTx,
+		MaxTxsPerAccount:       DefaultMaxTxsPerAccount,
+		TxRateLimitWindow:      DefaultTxRateLimitWindow,
 	}
 }
 
@@ -129,6 +135,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
+	}
 
 	return nil
 }
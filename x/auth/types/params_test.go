@@ -6,7 +6,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,6 +22,9 @@ func TestParamsEqual(t *testing.T) {
 }
 
 func TestParams_Validate(t *testing.T) {
+	invalidMinGasPrices := types.DefaultParams()
+	invalidMinGasPrices.MinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyZeroDec())}
+
 	tests := []struct {
 		name    string
 		params  types.Params
@@ -35,6 +41,7 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid min gas prices", invalidMinGasPrices, fmt.Errorf("invalid min gas prices: %w", fmt.Errorf("coin 0.000000000000000000atom amount is not positive"))},
 	}
 	for _, tt := range tests {
 		tt := tt
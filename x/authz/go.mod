@@ -169,7 +169,14 @@ require (
 	sigs.k8s.io/yaml v1.4.0 // indirect
 )
 
-require cosmossdk.io/x/consensus v0.0.0-00010101000000-000000000000 // indirect
+require (
+	cosmossdk.io/x/consensus v0.0.0-00010101000000-000000000000 // indirect
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/otel v1.25.0 // indirect
+	go.opentelemetry.io/otel/metric v1.25.0 // indirect
+	go.opentelemetry.io/otel/trace v1.25.0 // indirect
+)
 
 replace github.com/cosmos/cosmos-sdk => ../../.
 
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/authz"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ authtypes.AccountHooks = Hooks{}
+
+// Hooks implements the account hooks of the authz module, revoking all the
+// grants of a granter when the public key of its account is changed or cleared,
+// or when its account is removed, so that the grants do not outlive the key
+// which issued them.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the account hooks of the authz module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterAccountPubKeyChanged implements authtypes.AccountHooks.
+func (h Hooks) AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, _, _ cryptotypes.PubKey) error {
+	return h.revokeGranterGrants(ctx, addr)
+}
+
+// AfterAccountRemoved implements authtypes.AccountHooks.
+func (h Hooks) AfterAccountRemoved(ctx context.Context, addr sdk.AccAddress) error {
+	return h.revokeGranterGrants(ctx, addr)
+}
+
+// revokeGranterGrants revokes all the grants of the granter, emitting an
+// EventRevokeAll event if any grant was revoked.
+func (h Hooks) revokeGranterGrants(ctx context.Context, granter sdk.AccAddress) error {
+	deleted, err := h.k.deleteGranterGrants(ctx, granter)
+	if err != nil || deleted == 0 {
+		return err
+	}
+
+	return h.k.EventService.EventManager(ctx).Emit(&authz.EventRevokeAll{
+		Granter: granter.String(),
+	})
+}
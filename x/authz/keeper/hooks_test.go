@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestAccountHooks() {
+	ctx, addrs := s.ctx, s.addrs
+	granterAddr := addrs[0]
+	granter2Addr := addrs[1]
+	granteeAddr := addrs[2]
+	grantee2Addr := addrs[3]
+	e := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	sendAuthz := banktypes.NewSendAuthorization(coins100, nil, s.accountKeeper.AddressCodec())
+
+	s.Require().NoError(s.authzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, &e))
+	s.Require().NoError(s.authzKeeper.SaveGrant(ctx, grantee2Addr, granterAddr, sendAuthz, &e))
+	s.Require().NoError(s.authzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, sendAuthz, &e))
+
+	countGrants := func(granter sdk.AccAddress) int {
+		count := 0
+		err := s.authzKeeper.IterateGranterGrants(ctx, granter, func(sdk.AccAddress, string) (bool, error) {
+			count++
+			return false, nil
+		})
+		s.Require().NoError(err)
+		return count
+	}
+
+	hooks := s.authzKeeper.Hooks()
+
+	// rotating the public key of the granter revokes all its grants
+	oldPubKey, newPubKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	s.Require().NoError(hooks.AfterAccountPubKeyChanged(ctx, granterAddr, oldPubKey, newPubKey))
+	s.Require().Zero(countGrants(granterAddr))
+	s.Require().Equal(1, countGrants(granter2Addr))
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal("cosmos.authz.v1beta1.EventRevokeAll", events[len(events)-1].Type)
+
+	// revoking again is a no-op
+	numEvents := len(ctx.EventManager().Events())
+	s.Require().NoError(hooks.AfterAccountPubKeyChanged(ctx, granterAddr, newPubKey, nil))
+	s.Require().Len(ctx.EventManager().Events(), numEvents)
+
+	// removing the account of the granter revokes all its grants
+	s.Require().NoError(hooks.AfterAccountRemoved(ctx, granter2Addr))
+	s.Require().Zero(countGrants(granter2Addr))
+}
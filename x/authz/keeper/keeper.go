@@ -258,6 +258,21 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 
 // DeleteAllGrants revokes all authorizations granted to the grantee by the granter.
 func (k Keeper) DeleteAllGrants(ctx context.Context, granter sdk.AccAddress) error {
+	deleted, err := k.deleteGranterGrants(ctx, granter)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "no grants found for granter %s", granter)
+	}
+	return k.EventService.EventManager(ctx).Emit(&authz.EventRevokeAll{
+		Granter: granter.String(),
+	})
+}
+
+// deleteGranterGrants deletes all the grants of the granter and returns the
+// number of deleted grants.
+func (k Keeper) deleteGranterGrants(ctx context.Context, granter sdk.AccAddress) (int, error) {
 	var keysToDelete [][]byte
 
 	err := k.IterateGranterGrants(ctx, granter, func(grantee sdk.AccAddress, msgType string) (stop bool, err error) {
@@ -265,20 +280,15 @@ func (k Keeper) DeleteAllGrants(ctx context.Context, granter sdk.AccAddress) err
 		return false, nil
 	})
 	if err != nil {
-		return err
-	}
-	if len(keysToDelete) == 0 {
-		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "no grants found for granter %s", granter)
+		return 0, err
 	}
 	for _, key := range keysToDelete {
 		_, granteeAddr, msgType := parseGrantStoreKey(key)
 		if err := k.DeleteGrant(ctx, granteeAddr, granter, msgType); err != nil {
-			return err
+			return 0, err
 		}
 	}
-	return k.EventService.EventManager(ctx).Emit(&authz.EventRevokeAll{
-		Granter: granter.String(),
-	})
+	return len(keysToDelete), nil
 }
 
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
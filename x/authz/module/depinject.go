@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
 
@@ -37,12 +38,13 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	AuthzKeeper keeper.Keeper
-	Module      appmodule.AppModule
+	AuthzKeeper  keeper.Keeper
+	Module       appmodule.AppModule
+	AccountHooks authtypes.AccountHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
-	return ModuleOutputs{AuthzKeeper: k, Module: m}
+	return ModuleOutputs{AuthzKeeper: k, Module: m, AccountHooks: authtypes.AccountHooksWrapper{AccountHooks: k.Hooks()}}
 }
@@ -27,3 +27,23 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) ScheduleSend(ctx context.Context, req *bank.MsgScheduleSend) (*bank.MsgScheduleSendResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CancelScheduledSend(ctx context.Context, req *bank.MsgCancelScheduledSend) (*bank.MsgCancelScheduledSendResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) AuthorizePullPayment(ctx context.Context, req *bank.MsgAuthorizePullPayment) (*bank.MsgAuthorizePullPaymentResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RevokePullPayment(ctx context.Context, req *bank.MsgRevokePullPayment) (*bank.MsgRevokePullPaymentResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) PullPayment(ctx context.Context, req *bank.MsgPullPayment) (*bank.MsgPullPaymentResponse, error) {
+	return nil, nil
+}
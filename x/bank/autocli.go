@@ -87,6 +87,30 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "ScheduledSends",
+					Use:            "scheduled-sends [address]",
+					Short:          "Query the pending scheduled sends of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "PullPaymentAllowances",
+					Use:            "pull-payment-allowances [payer]",
+					Short:          "Query the pull payment allowances granted by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SupplyHistory",
+					Use:            "supply-history [denom]",
+					Short:          "Query the recorded supply checkpoints of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "TotalAccountValue",
+					Use:            "total-account-value [address]",
+					Short:          "Query the total value of an account per denom, including its balances, stake and rewards",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -109,6 +133,44 @@ Note: multiple coins can be send by space separated.`,
 					Short:          "Burns the amount specified from the given account.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "amount", Varargs: true}},
 				},
+				{
+					RpcMethod: "ScheduleSend",
+					Use:       "schedule-send [from_key_or_address] [to_address] [amount]",
+					Short:     "Escrow funds to be sent to another account at a future block time or height.",
+					Long: `Escrow funds to be sent to another account at a future block time or height.
+Exactly one of the '--execute-time' and '--execute-height' flags must be provided.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+					Example:        fmt.Sprintf(`%s tx bank schedule-send [from] [to] 10stake --execute-height 1000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "to_address"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CancelScheduledSend",
+					Use:            "cancel-scheduled-send [from_key_or_address] [id]",
+					Short:          "Cancel a pending scheduled send and return the escrowed funds to the sender.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "AuthorizePullPayment",
+					Use:       "authorize-pull-payment [payer_key_or_address] [payee] [spend_limit]",
+					Short:     "Allow a payee to pull up to a spend limit from an account every period.",
+					Long: `Allow a payee to pull up to a spend limit from an account every period.
+An existing allowance for the same payee is replaced.
+Note, the '--from' flag is ignored as it is implied from [payer_key_or_address].`,
+					Example:        fmt.Sprintf(`%s tx bank authorize-pull-payment [payer] [payee] 10stake --period 720h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payer"}, {ProtoField: "payee"}, {ProtoField: "spend_limit", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RevokePullPayment",
+					Use:            "revoke-pull-payment [payer_key_or_address] [payee]",
+					Short:          "Revoke the pull payment allowance granted to a payee.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payer"}, {ProtoField: "payee"}},
+				},
+				{
+					RpcMethod:      "PullPayment",
+					Use:            "pull-payment [payee_key_or_address] [payer] [amount]",
+					Short:          "Pull funds from an account that granted a pull payment allowance.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payee"}, {ProtoField: "payer"}, {ProtoField: "amount", Varargs: true}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountValueSources houses the registered AccountValueSourceFn.
+// It exists so that the sources can be registered in the Keeper without needing to have a pointer receiver.
+type accountValueSources struct {
+	names   []string
+	sources map[string]types.AccountValueSourceFn
+}
+
+// newAccountValueSources creates a new accountValueSources without sources.
+func newAccountValueSources() *accountValueSources {
+	return &accountValueSources{
+		sources: make(map[string]types.AccountValueSourceFn),
+	}
+}
+
+// RegisterAccountValueSource registers a source of value of the accounts,
+// composed with their balances by the TotalAccountValue query in the order of
+// registration. It panics if a source is already registered with the same
+// name.
+func (k BaseKeeper) RegisterAccountValueSource(name string, source types.AccountValueSourceFn) {
+	if name == types.AccountValueSourceBalances {
+		panic(fmt.Errorf("account value source name %s is reserved", name))
+	}
+	if _, ok := k.accountValueSources.sources[name]; ok {
+		panic(fmt.Errorf("account value source %s is already registered", name))
+	}
+
+	k.accountValueSources.names = append(k.accountValueSources.names, name)
+	k.accountValueSources.sources[name] = source
+}
+
+// GetAccountValues returns the value of an account per source, starting with
+// its balances, followed by the registered sources.
+func (k BaseKeeper) GetAccountValues(ctx context.Context, addr sdk.AccAddress) ([]types.AccountValue, error) {
+	values := []types.AccountValue{{
+		Source: types.AccountValueSourceBalances,
+		Amount: k.GetAllBalances(ctx, addr),
+	}}
+
+	for _, name := range k.accountValueSources.names {
+		amount, err := k.accountValueSources.sources[name](ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("account value source %s: %w", name, err)
+		}
+
+		values = append(values, types.AccountValue{Source: name, Amount: amount})
+	}
+
+	return values, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryTotalAccountValue() {
+	require := suite.Require()
+
+	_, err := suite.queryClient.TotalAccountValue(suite.ctx, &banktypes.QueryTotalAccountValueRequest{})
+	require.Error(err)
+
+	addrStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	req := &banktypes.QueryTotalAccountValueRequest{Address: addrStr}
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// only the balances without registered sources
+	res, err := suite.queryClient.TotalAccountValue(suite.ctx, req)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), res.Total)
+	require.Equal([]banktypes.AccountValue{{Source: banktypes.AccountValueSourceBalances, Amount: sdk.NewCoins(newFooCoin(100), newBarCoin(50))}}, res.Values)
+
+	suite.bankKeeper.RegisterAccountValueSource("bonded", func(context.Context, sdk.AccAddress) (sdk.Coins, error) {
+		return sdk.NewCoins(newFooCoin(30)), nil
+	})
+	suite.bankKeeper.RegisterAccountValueSource("rewards", func(context.Context, sdk.AccAddress) (sdk.Coins, error) {
+		return sdk.NewCoins(newFooCoin(5), newBarCoin(1)), nil
+	})
+	require.Panics(func() {
+		suite.bankKeeper.RegisterAccountValueSource("bonded", func(context.Context, sdk.AccAddress) (sdk.Coins, error) { return nil, nil })
+	})
+	require.Panics(func() {
+		suite.bankKeeper.RegisterAccountValueSource(banktypes.AccountValueSourceBalances, func(context.Context, sdk.AccAddress) (sdk.Coins, error) { return nil, nil })
+	})
+
+	// the sources are composed with the balances in the order of registration
+	res, err = suite.queryClient.TotalAccountValue(suite.ctx, req)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(135), newBarCoin(51)), res.Total)
+	require.Equal([]banktypes.AccountValue{
+		{Source: banktypes.AccountValueSourceBalances, Amount: sdk.NewCoins(newFooCoin(100), newBarCoin(50))},
+		{Source: "bonded", Amount: sdk.NewCoins(newFooCoin(30))},
+		{Source: "rewards", Amount: sdk.NewCoins(newFooCoin(5), newBarCoin(1))},
+	}, res.Values)
+
+	// a failing source fails the query
+	suite.bankKeeper.RegisterAccountValueSource("failing", func(context.Context, sdk.AccAddress) (sdk.Coins, error) {
+		return nil, errors.New("source failure")
+	})
+	_, err = suite.queryClient.TotalAccountValue(suite.ctx, req)
+	require.ErrorContains(err, "account value source failing: source failure")
+}
@@ -57,6 +57,36 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	var nextScheduledSendID uint64
+	for _, scheduledSend := range genState.ScheduledSends {
+		if err := k.setScheduledSend(ctx, scheduledSend); err != nil {
+			return err
+		}
+
+		if scheduledSend.Id >= nextScheduledSendID {
+			nextScheduledSendID = scheduledSend.Id + 1
+		}
+	}
+
+	if nextScheduledSendID > 0 {
+		if err := k.ScheduledSendSeq.Set(ctx, nextScheduledSendID); err != nil {
+			return err
+		}
+	}
+
+	for _, allowance := range genState.PullPaymentAllowances {
+		if err := k.setPullPaymentAllowance(ctx, allowance); err != nil {
+			return err
+		}
+	}
+
+	for _, checkpoint := range genState.SupplyHistory {
+		if err := k.BaseViewKeeper.SupplyHistory.Set(ctx, collections.Join(checkpoint.Denom, checkpoint.Height), checkpoint); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -74,5 +104,21 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.ScheduledSends, err = k.GetAllScheduledSends(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch scheduled sends %v", err)
+	}
+
+	rv.PullPaymentAllowances, err = k.GetAllPullPaymentAllowances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pull payment allowances %v", err)
+	}
+
+	rv.SupplyHistory, err = k.GetAllSupplyCheckpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch supply history %v", err)
+	}
+
 	return rv, nil
 }
@@ -351,3 +351,115 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// ScheduledSends implements the Query/ScheduledSends gRPC method
+func (k BaseKeeper) ScheduledSends(ctx context.Context, req *types.QueryScheduledSendsRequest) (*types.QueryScheduledSendsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	scheduledSends, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ScheduledSendsBySender,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.ScheduledSend, error) {
+			return k.BaseViewKeeper.ScheduledSends.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledSendsResponse{ScheduledSends: scheduledSends, Pagination: pageRes}, nil
+}
+
+// PullPaymentAllowances implements the Query/PullPaymentAllowances gRPC method
+func (k BaseKeeper) PullPaymentAllowances(ctx context.Context, req *types.QueryPullPaymentAllowancesRequest) (*types.QueryPullPaymentAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	allowances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.PullPaymentAllowances,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], allowance types.PullPaymentAllowance) (types.PullPaymentAllowance, error) {
+			return allowance, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPullPaymentAllowancesResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
+
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(ctx context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is lower than start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	checkpoints, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.BaseViewKeeper.SupplyHistory,
+		req.Pagination,
+		func(key collections.Pair[string, int64], _ types.SupplyCheckpoint) (bool, error) {
+			height := key.K2()
+			return height >= req.StartHeight && (req.EndHeight == 0 || height <= req.EndHeight), nil
+		},
+		func(_ collections.Pair[string, int64], checkpoint types.SupplyCheckpoint) (types.SupplyCheckpoint, error) {
+			return checkpoint, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
+
+// TotalAccountValue implements the Query/TotalAccountValue gRPC method
+func (k BaseKeeper) TotalAccountValue(ctx context.Context, req *types.QueryTotalAccountValueRequest) (*types.QueryTotalAccountValueResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	values, err := k.GetAccountValues(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	total := sdk.NewCoins()
+	for _, value := range values {
+		total = total.Add(value.Amount...)
+	}
+
+	return &types.QueryTotalAccountValueResponse{Total: total, Values: values}, nil
+}
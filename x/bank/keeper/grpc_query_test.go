@@ -741,7 +741,7 @@ func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 					{Denom: "falsestcoin", Enabled: false},
 				},
 				Pagination: &query.PageResponse{
-					NextKey: []byte("truestcoin"),
+					NextKey: []byte("falsestcoin\x00"),
 					Total:   2,
 				},
 			},
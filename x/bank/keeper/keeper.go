@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
@@ -47,6 +48,24 @@ type Keeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
 
+	ScheduleSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, executeTime *time.Time, executeHeight int64) (uint64, error)
+	CancelScheduledSend(ctx context.Context, fromAddr sdk.AccAddress, id uint64) error
+	GetScheduledSend(ctx context.Context, id uint64) (types.ScheduledSend, error)
+	GetAllScheduledSends(ctx context.Context) ([]types.ScheduledSend, error)
+	ProcessScheduledSends(ctx context.Context) error
+
+	AuthorizePullPayment(ctx context.Context, payer, payee sdk.AccAddress, spendLimit sdk.Coins, period time.Duration, expiration *time.Time) error
+	RevokePullPayment(ctx context.Context, payer, payee sdk.AccAddress) error
+	PullPayment(ctx context.Context, payee, payer sdk.AccAddress, amt sdk.Coins) error
+	GetPullPaymentAllowance(ctx context.Context, payer, payee sdk.AccAddress) (types.PullPaymentAllowance, error)
+	GetAllPullPaymentAllowances(ctx context.Context) ([]types.PullPaymentAllowance, error)
+
+	RecordSupplyCheckpoints(ctx context.Context) error
+	GetAllSupplyCheckpoints(ctx context.Context) ([]types.SupplyCheckpoint, error)
+
+	RegisterAccountValueSource(name string, source types.AccountValueSourceFn)
+	GetAccountValues(ctx context.Context, addr sdk.AccAddress) ([]types.AccountValue, error)
+
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -61,6 +80,7 @@ type BaseKeeper struct {
 	ak                     types.AccountKeeper
 	cdc                    codec.BinaryCodec
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+	accountValueSources    *accountValueSources
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -101,6 +121,7 @@ func NewBaseKeeper(
 		ak:                     ak,
 		cdc:                    cdc,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
+		accountValueSources:    newAccountValueSources(),
 	}
 }
 
@@ -212,3 +212,162 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) ScheduleSend(ctx context.Context, msg *types.MsgScheduleSend) (*types.MsgScheduleSendResponse, error) {
+	var (
+		from, to []byte
+		err      error
+	)
+
+	if base, ok := k.Keeper.(BaseKeeper); ok {
+		from, err = base.ak.AddressCodec().StringToBytes(msg.FromAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+		}
+		to, err = base.ak.AddressCodec().StringToBytes(msg.ToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+		}
+	} else {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if !msg.Amount.IsValid() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	id, err := k.Keeper.ScheduleSend(ctx, from, to, msg.Amount, msg.ExecuteTime, msg.ExecuteHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleSendResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelScheduledSend(ctx context.Context, msg *types.MsgCancelScheduledSend) (*types.MsgCancelScheduledSendResponse, error) {
+	var (
+		from []byte
+		err  error
+	)
+
+	if base, ok := k.Keeper.(BaseKeeper); ok {
+		from, err = base.ak.AddressCodec().StringToBytes(msg.FromAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+		}
+	} else {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if err := k.Keeper.CancelScheduledSend(ctx, from, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduledSendResponse{}, nil
+}
+
+func (k msgServer) AuthorizePullPayment(ctx context.Context, msg *types.MsgAuthorizePullPayment) (*types.MsgAuthorizePullPaymentResponse, error) {
+	var (
+		payer, payee []byte
+		err          error
+	)
+
+	if base, ok := k.Keeper.(BaseKeeper); ok {
+		payer, err = base.ak.AddressCodec().StringToBytes(msg.Payer)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+		}
+		payee, err = base.ak.AddressCodec().StringToBytes(msg.Payee)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payee address: %s", err)
+		}
+	} else {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if !msg.SpendLimit.IsValid() || !msg.SpendLimit.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.SpendLimit.String())
+	}
+
+	if err := k.Keeper.AuthorizePullPayment(ctx, payer, payee, msg.SpendLimit, msg.Period, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAuthorizePullPaymentResponse{}, nil
+}
+
+func (k msgServer) RevokePullPayment(ctx context.Context, msg *types.MsgRevokePullPayment) (*types.MsgRevokePullPaymentResponse, error) {
+	var (
+		payer, payee []byte
+		err          error
+	)
+
+	if base, ok := k.Keeper.(BaseKeeper); ok {
+		payer, err = base.ak.AddressCodec().StringToBytes(msg.Payer)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+		}
+		payee, err = base.ak.AddressCodec().StringToBytes(msg.Payee)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payee address: %s", err)
+		}
+	} else {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if err := k.Keeper.RevokePullPayment(ctx, payer, payee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokePullPaymentResponse{}, nil
+}
+
+func (k msgServer) PullPayment(ctx context.Context, msg *types.MsgPullPayment) (*types.MsgPullPaymentResponse, error) {
+	var (
+		payer, payee []byte
+		err          error
+	)
+
+	if base, ok := k.Keeper.(BaseKeeper); ok {
+		payee, err = base.ak.AddressCodec().StringToBytes(msg.Payee)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payee address: %s", err)
+		}
+		payer, err = base.ak.AddressCodec().StringToBytes(msg.Payer)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+		}
+	} else {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(payee) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Payee)
+	}
+
+	if err := k.Keeper.PullPayment(ctx, payee, payer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPullPaymentResponse{}, nil
+}
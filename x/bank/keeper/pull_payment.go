@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AuthorizePullPayment allows the payee to pull up to spendLimit coins from the
+// payer's account during every period, until the optional expiration. Any
+// existing allowance from the payer to the payee is replaced and its usage is
+// reset.
+func (k BaseKeeper) AuthorizePullPayment(
+	ctx context.Context, payer, payee sdk.AccAddress, spendLimit sdk.Coins, period time.Duration, expiration *time.Time,
+) error {
+	if err := types.ValidatePullPaymentLimit(spendLimit, period); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if payer.Equals(payee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payer and payee cannot be the same")
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if expiration != nil && !expiration.After(now) {
+		return errorsmod.Wrapf(types.ErrPullPaymentExpired, "expiration %s must be after the current block time %s", expiration, now)
+	}
+
+	payerString, err := k.ak.AddressCodec().BytesToString(payer)
+	if err != nil {
+		return err
+	}
+
+	payeeString, err := k.ak.AddressCodec().BytesToString(payee)
+	if err != nil {
+		return err
+	}
+
+	allowance := types.PullPaymentAllowance{
+		Payer:       payerString,
+		Payee:       payeeString,
+		SpendLimit:  spendLimit,
+		Period:      period,
+		PeriodReset: now.Add(period),
+		Expiration:  expiration,
+	}
+
+	if err := k.BaseViewKeeper.PullPaymentAllowances.Set(ctx, collections.Join(payer, payee), allowance); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAuthorizePullPayment,
+		event.NewAttribute(types.AttributeKeyPayer, payerString),
+		event.NewAttribute(types.AttributeKeyPayee, payeeString),
+		event.NewAttribute(types.AttributeKeySpendLimit, spendLimit.String()),
+	)
+}
+
+// RevokePullPayment removes the pull payment allowance granted by the payer to
+// the payee.
+func (k BaseKeeper) RevokePullPayment(ctx context.Context, payer, payee sdk.AccAddress) error {
+	allowance, err := k.GetPullPaymentAllowance(ctx, payer, payee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.PullPaymentAllowances.Remove(ctx, collections.Join(payer, payee)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRevokePullPayment,
+		event.NewAttribute(types.AttributeKeyPayer, allowance.Payer),
+		event.NewAttribute(types.AttributeKeyPayee, allowance.Payee),
+	)
+}
+
+// PullPayment transfers amt from the payer to the payee, charging it against
+// the usage of the current period of the allowance granted by the payer. The
+// usage is reset once the block time reaches the end of the period.
+func (k BaseKeeper) PullPayment(ctx context.Context, payee, payer sdk.AccAddress, amt sdk.Coins) error {
+	allowance, err := k.GetPullPaymentAllowance(ctx, payer, payee)
+	if err != nil {
+		return err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if allowance.Expiration != nil && !now.Before(*allowance.Expiration) {
+		return errorsmod.Wrapf(types.ErrPullPaymentExpired, "allowance from %s to %s expired at %s", allowance.Payer, allowance.Payee, allowance.Expiration)
+	}
+
+	resetPullPaymentPeriod(&allowance, now)
+
+	spent := allowance.PeriodSpent.Add(amt...)
+	if !spent.IsAllLTE(allowance.SpendLimit) {
+		return errorsmod.Wrapf(types.ErrPullPaymentExceeded, "%s spent of %s in the current period, cannot pull %s", allowance.PeriodSpent, allowance.SpendLimit, amt)
+	}
+
+	allowance.PeriodSpent = spent
+	if err := k.BaseViewKeeper.PullPaymentAllowances.Set(ctx, collections.Join(payer, payee), allowance); err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, payer, payee, amt); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypePullPayment,
+		event.NewAttribute(types.AttributeKeyPayer, allowance.Payer),
+		event.NewAttribute(types.AttributeKeyPayee, allowance.Payee),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// GetPullPaymentAllowance returns the pull payment allowance granted by the
+// payer to the payee.
+func (k BaseKeeper) GetPullPaymentAllowance(ctx context.Context, payer, payee sdk.AccAddress) (types.PullPaymentAllowance, error) {
+	allowance, err := k.BaseViewKeeper.PullPaymentAllowances.Get(ctx, collections.Join(payer, payee))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PullPaymentAllowance{}, errorsmod.Wrapf(types.ErrPullPaymentNotFound, "from %s to %s", payer, payee)
+		}
+
+		return types.PullPaymentAllowance{}, err
+	}
+
+	return allowance, nil
+}
+
+// GetAllPullPaymentAllowances returns all the pull payment allowances.
+func (k BaseKeeper) GetAllPullPaymentAllowances(ctx context.Context) ([]types.PullPaymentAllowance, error) {
+	iter, err := k.BaseViewKeeper.PullPaymentAllowances.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// resetPullPaymentPeriod clears the usage of the allowance once the block time
+// has reached the end of its period. If more than one period has elapsed, the
+// next period starts at the current block time.
+func resetPullPaymentPeriod(allowance *types.PullPaymentAllowance, now time.Time) {
+	if now.Before(allowance.PeriodReset) {
+		return
+	}
+
+	allowance.PeriodSpent = nil
+	allowance.PeriodReset = allowance.PeriodReset.Add(allowance.Period)
+	if now.After(allowance.PeriodReset) {
+		allowance.PeriodReset = now.Add(allowance.Period)
+	}
+}
+
+func (k BaseKeeper) setPullPaymentAllowance(ctx context.Context, allowance types.PullPaymentAllowance) error {
+	payer, err := k.ak.AddressCodec().StringToBytes(allowance.Payer)
+	if err != nil {
+		return err
+	}
+
+	payee, err := k.ak.AddressCodec().StringToBytes(allowance.Payee)
+	if err != nil {
+		return err
+	}
+
+	return k.BaseViewKeeper.PullPaymentAllowances.Set(ctx, collections.Join(sdk.AccAddress(payer), sdk.AccAddress(payee)), allowance)
+}
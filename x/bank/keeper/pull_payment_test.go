@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgAuthorizePullPayment() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1_000_000, 0).UTC()})
+	require := suite.Require()
+
+	payer, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	payee, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	pastTime := ctx.HeaderInfo().Time.Add(-time.Hour)
+	limit := sdk.NewCoins(newFooCoin(30))
+
+	testCases := []struct {
+		name      string
+		msg       *banktypes.MsgAuthorizePullPayment
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "empty spend limit",
+			msg:       banktypes.NewMsgAuthorizePullPayment(payer, payee, sdk.NewCoins(), time.Hour, nil),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name:      "zero period",
+			msg:       banktypes.NewMsgAuthorizePullPayment(payer, payee, limit, 0, nil),
+			expErr:    true,
+			expErrMsg: "period must be positive",
+		},
+		{
+			name:      "self payee",
+			msg:       banktypes.NewMsgAuthorizePullPayment(payer, payer, limit, time.Hour, nil),
+			expErr:    true,
+			expErrMsg: "payer and payee cannot be the same",
+		},
+		{
+			name:      "expiration in the past",
+			msg:       banktypes.NewMsgAuthorizePullPayment(payer, payee, limit, time.Hour, &pastTime),
+			expErr:    true,
+			expErrMsg: "must be after the current block time",
+		},
+		{
+			name: "valid",
+			msg:  banktypes.NewMsgAuthorizePullPayment(payer, payee, limit, time.Hour, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.AuthorizePullPayment(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	res, err := suite.mockQueryClient(ctx).PullPaymentAllowances(ctx, &banktypes.QueryPullPaymentAllowancesRequest{Address: payer})
+	require.NoError(err)
+	require.Len(res.Allowances, 1)
+	require.Equal(payee, res.Allowances[0].Payee)
+	require.Equal(limit, res.Allowances[0].SpendLimit)
+	require.Equal(ctx.HeaderInfo().Time.Add(time.Hour), res.Allowances[0].PeriodReset)
+
+	_, err = suite.msgServer.RevokePullPayment(ctx, banktypes.NewMsgRevokePullPayment(payer, payee))
+	require.NoError(err)
+
+	_, err = suite.msgServer.RevokePullPayment(ctx, banktypes.NewMsgRevokePullPayment(payer, payee))
+	require.ErrorIs(err, banktypes.ErrPullPaymentNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgPullPayment() {
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: now})
+	require := suite.Require()
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	payer, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	payee, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	other, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	expiration := now.Add(6 * time.Hour)
+	_, err = suite.msgServer.AuthorizePullPayment(ctx, banktypes.NewMsgAuthorizePullPayment(payer, payee, sdk.NewCoins(newFooCoin(30)), time.Hour, &expiration))
+	require.NoError(err)
+
+	pull := func(ctx sdk.Context, payee string, amt int64) error {
+		_, err := suite.msgServer.PullPayment(ctx, banktypes.NewMsgPullPayment(payee, payer, sdk.NewCoins(newFooCoin(amt))))
+		return err
+	}
+
+	// only the authorized payee can pull
+	require.ErrorIs(pull(ctx, other, 10), banktypes.ErrPullPaymentNotFound)
+
+	// usage is tracked within a period
+	require.NoError(pull(ctx, payee, 20))
+	require.NoError(pull(ctx, payee, 10))
+	require.ErrorIs(pull(ctx, payee, 1), banktypes.ErrPullPaymentExceeded)
+
+	_, err = suite.msgServer.PullPayment(ctx, banktypes.NewMsgPullPayment(payee, payer, sdk.NewCoins(newBarCoin(1))))
+	require.ErrorIs(err, banktypes.ErrPullPaymentExceeded)
+
+	// usage is reset once the period has elapsed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: now.Add(time.Hour)})
+	require.NoError(pull(ctx, payee, 30))
+
+	allowance, err := suite.bankKeeper.GetPullPaymentAllowance(ctx, accAddrs[0], accAddrs[1])
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(30)), allowance.PeriodSpent)
+	require.Equal(now.Add(2*time.Hour), allowance.PeriodReset)
+
+	// skipping several periods starts the next period at the block time
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: now.Add(250 * time.Minute)})
+	require.NoError(pull(ctx, payee, 5))
+
+	allowance, err = suite.bankKeeper.GetPullPaymentAllowance(ctx, accAddrs[0], accAddrs[1])
+	require.NoError(err)
+	require.Equal(now.Add(310*time.Minute), allowance.PeriodReset)
+
+	// the allowance cannot be used after its expiration
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 13, Time: expiration})
+	require.ErrorIs(pull(ctx, payee, 5), banktypes.ErrPullPaymentExpired)
+
+	require.Equal(sdk.NewCoins(newFooCoin(35)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(65)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ScheduleSend escrows amt from the sender into the bank module account and
+// schedules its transfer to the recipient at the given block time or height.
+// Exactly one of executeTime and executeHeight must be set. It returns the id
+// of the newly scheduled send.
+func (k BaseKeeper) ScheduleSend(
+	ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, executeTime *time.Time, executeHeight int64,
+) (uint64, error) {
+	if err := types.ValidateSchedule(executeTime != nil, executeHeight); err != nil {
+		return 0, err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if executeTime != nil && !executeTime.After(headerInfo.Time) {
+		return 0, types.ErrInvalidSchedule.Wrapf("execute time %s must be after the current block time %s", executeTime, headerInfo.Time)
+	}
+
+	if executeHeight != 0 && executeHeight <= headerInfo.Height {
+		return 0, types.ErrInvalidSchedule.Wrapf("execute height %d must be after the current block height %d", executeHeight, headerInfo.Height)
+	}
+
+	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	toAddrString, err := k.ak.AddressCodec().BytesToString(toAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, amt); err != nil {
+		return 0, err
+	}
+
+	id, err := k.ScheduledSendSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	scheduledSend := types.ScheduledSend{
+		Id:            id,
+		FromAddress:   fromAddrString,
+		ToAddress:     toAddrString,
+		Amount:        amt,
+		ExecuteTime:   executeTime,
+		ExecuteHeight: executeHeight,
+	}
+
+	if err := k.setScheduledSend(ctx, scheduledSend); err != nil {
+		return 0, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleSend,
+		event.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, fromAddrString),
+		event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelScheduledSend removes a pending scheduled send and returns the escrowed
+// coins to its sender. Only the original sender can cancel a scheduled send.
+func (k BaseKeeper) CancelScheduledSend(ctx context.Context, fromAddr sdk.AccAddress, id uint64) error {
+	scheduledSend, err := k.BaseViewKeeper.ScheduledSends.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrScheduledSendNotFound, "id %d", id)
+		}
+
+		return err
+	}
+
+	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	if scheduledSend.FromAddress != fromAddrString {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of scheduled send %d", fromAddrString, id)
+	}
+
+	if err := k.removeScheduledSend(ctx, scheduledSend); err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, k.ak.GetModuleAddress(types.ModuleName), fromAddr, scheduledSend.Amount); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelScheduledSend,
+		event.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, fromAddrString),
+	)
+}
+
+// GetScheduledSend returns the scheduled send with the given id.
+func (k BaseKeeper) GetScheduledSend(ctx context.Context, id uint64) (types.ScheduledSend, error) {
+	return k.BaseViewKeeper.ScheduledSends.Get(ctx, id)
+}
+
+// GetAllScheduledSends returns all the pending scheduled sends.
+func (k BaseKeeper) GetAllScheduledSends(ctx context.Context) ([]types.ScheduledSend, error) {
+	iter, err := k.BaseViewKeeper.ScheduledSends.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// ProcessScheduledSends executes all the scheduled sends whose execution time
+// or height has been reached. A scheduled send which cannot be delivered to its
+// recipient, for instance because the denom has since been disabled, is
+// refunded to its sender.
+func (k BaseKeeper) ProcessScheduledSends(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	var due []uint64
+
+	timeIter, err := k.ScheduledSendTimeQueue.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](headerInfo.Time))
+	if err != nil {
+		return err
+	}
+
+	timeKeys, err := timeIter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range timeKeys {
+		due = append(due, key.K2())
+	}
+
+	heightIter, err := k.ScheduledSendHeightQueue.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, uint64](headerInfo.Height))
+	if err != nil {
+		return err
+	}
+
+	heightKeys, err := heightIter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range heightKeys {
+		due = append(due, key.K2())
+	}
+
+	for _, id := range due {
+		scheduledSend, err := k.BaseViewKeeper.ScheduledSends.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.executeScheduledSend(ctx, scheduledSend); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k BaseKeeper) executeScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	if err := k.removeScheduledSend(ctx, scheduledSend); err != nil {
+		return err
+	}
+
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+
+	toAddr, err := k.ak.AddressCodec().StringToBytes(scheduledSend.ToAddress)
+	if err != nil {
+		return err
+	}
+
+	result := "executed"
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		if err := k.IsSendEnabledCoins(ctx, scheduledSend.Amount...); err != nil {
+			return err
+		}
+
+		return k.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, scheduledSend.Amount)
+	}); err != nil {
+		k.Logger.Info("scheduled send failed, refunding sender", "id", scheduledSend.Id, "err", err)
+
+		fromAddr, err := k.ak.AddressCodec().StringToBytes(scheduledSend.FromAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SendCoins(ctx, moduleAddr, fromAddr, scheduledSend.Amount); err != nil {
+			return fmt.Errorf("failed to refund scheduled send %d: %w", scheduledSend.Id, err)
+		}
+
+		result = "refunded"
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeExecuteScheduledSend,
+		event.NewAttribute(types.AttributeKeyScheduledSendID, strconv.FormatUint(scheduledSend.Id, 10)),
+		event.NewAttribute(types.AttributeKeyResult, result),
+	)
+}
+
+func (k BaseKeeper) setScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(scheduledSend.FromAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.ScheduledSends.Set(ctx, scheduledSend.Id, scheduledSend); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSendsBySender.Set(ctx, collections.Join(sdk.AccAddress(fromAddr), scheduledSend.Id)); err != nil {
+		return err
+	}
+
+	if scheduledSend.ExecuteTime != nil {
+		return k.ScheduledSendTimeQueue.Set(ctx, collections.Join(*scheduledSend.ExecuteTime, scheduledSend.Id))
+	}
+
+	return k.ScheduledSendHeightQueue.Set(ctx, collections.Join(scheduledSend.ExecuteHeight, scheduledSend.Id))
+}
+
+func (k BaseKeeper) removeScheduledSend(ctx context.Context, scheduledSend types.ScheduledSend) error {
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(scheduledSend.FromAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.ScheduledSends.Remove(ctx, scheduledSend.Id); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledSendsBySender.Remove(ctx, collections.Join(sdk.AccAddress(fromAddr), scheduledSend.Id)); err != nil {
+		return err
+	}
+
+	if scheduledSend.ExecuteTime != nil {
+		return k.ScheduledSendTimeQueue.Remove(ctx, collections.Join(*scheduledSend.ExecuteTime, scheduledSend.Id))
+	}
+
+	return k.ScheduledSendHeightQueue.Remove(ctx, collections.Join(scheduledSend.ExecuteHeight, scheduledSend.Id))
+}
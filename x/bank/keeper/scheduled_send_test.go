@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var escrowAcc = authtypes.NewEmptyModuleAccount(banktypes.ModuleName)
+
+func (suite *KeeperTestSuite) mockScheduledSends() {
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), banktypes.ModuleName).Return(escrowAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ModuleName).Return(escrowAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func (suite *KeeperTestSuite) TestMsgScheduleSend() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: time.Now()})
+	require := suite.Require()
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	balances := sdk.NewCoins(newFooCoin(100))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], balances))
+	suite.mockScheduledSends()
+
+	from, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	to, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	blocked, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[4])
+	require.NoError(err)
+
+	pastTime := ctx.HeaderInfo().Time.Add(-time.Hour)
+	futureTime := ctx.HeaderInfo().Time.Add(time.Hour)
+	amt := sdk.NewCoins(newFooCoin(30))
+
+	testCases := []struct {
+		name      string
+		msg       *banktypes.MsgScheduleSend
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "no schedule",
+			msg:       banktypes.NewMsgScheduleSend(from, to, amt, nil, 0),
+			expErr:    true,
+			expErrMsg: "one of execute time and execute height must be set",
+		},
+		{
+			name:      "both time and height",
+			msg:       banktypes.NewMsgScheduleSend(from, to, amt, &futureTime, 20),
+			expErr:    true,
+			expErrMsg: "only one of execute time and execute height can be set",
+		},
+		{
+			name:      "height in the past",
+			msg:       banktypes.NewMsgScheduleSend(from, to, amt, nil, 10),
+			expErr:    true,
+			expErrMsg: "must be after the current block height",
+		},
+		{
+			name:      "time in the past",
+			msg:       banktypes.NewMsgScheduleSend(from, to, amt, &pastTime, 0),
+			expErr:    true,
+			expErrMsg: "must be after the current block time",
+		},
+		{
+			name:      "blocked recipient",
+			msg:       banktypes.NewMsgScheduleSend(from, blocked, amt, nil, 20),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name:      "insufficient funds",
+			msg:       banktypes.NewMsgScheduleSend(from, to, sdk.NewCoins(newFooCoin(1000)), nil, 20),
+			expErr:    true,
+			expErrMsg: "insufficient funds",
+		},
+		{
+			name: "at height",
+			msg:  banktypes.NewMsgScheduleSend(from, to, amt, nil, 20),
+		},
+		{
+			name: "at time",
+			msg:  banktypes.NewMsgScheduleSend(from, to, amt, &futureTime, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.ScheduleSend(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	require.Equal(sdk.NewCoins(newFooCoin(40)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(60)), suite.bankKeeper.GetAllBalances(ctx, escrowAcc.GetAddress()))
+
+	res, err := suite.mockQueryClient(ctx).ScheduledSends(ctx, &banktypes.QueryScheduledSendsRequest{Address: from})
+	require.NoError(err)
+	require.Len(res.ScheduledSends, 2)
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledSend() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: time.Now()})
+	require := suite.Require()
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.mockScheduledSends()
+
+	id, err := suite.bankKeeper.ScheduleSend(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(40)), nil, 20)
+	require.NoError(err)
+
+	from, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	other, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(other, id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(from, id+1))
+	require.ErrorIs(err, banktypes.ErrScheduledSendNotFound)
+
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(from, id))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, escrowAcc.GetAddress()).IsZero())
+
+	_, err = suite.bankKeeper.GetScheduledSend(ctx, id)
+	require.Error(err)
+
+	// the send cannot be cancelled twice
+	_, err = suite.msgServer.CancelScheduledSend(ctx, banktypes.NewMsgCancelScheduledSend(from, id))
+	require.ErrorIs(err, banktypes.ErrScheduledSendNotFound)
+}
+
+func (suite *KeeperTestSuite) TestProcessScheduledSends() {
+	now := time.Now()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: now})
+	require := suite.Require()
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	suite.mockScheduledSends()
+
+	executeTime := now.Add(time.Hour)
+	_, err := suite.bankKeeper.ScheduleSend(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), nil, 12)
+	require.NoError(err)
+	_, err = suite.bankKeeper.ScheduleSend(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(20)), &executeTime, 0)
+	require.NoError(err)
+	refundID, err := suite.bankKeeper.ScheduleSend(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newBarCoin(30)), nil, 12)
+	require.NoError(err)
+
+	// nothing is due yet
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: now.Add(time.Minute)})
+	require.NoError(suite.bankKeeper.ProcessScheduledSends(ctx))
+	sends, err := suite.bankKeeper.GetAllScheduledSends(ctx)
+	require.NoError(err)
+	require.Len(sends, 3)
+
+	// disabling bar makes the last send fail, so its funds are returned
+	suite.bankKeeper.SetSendEnabled(ctx, barDenom, false)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: now.Add(2 * time.Minute)})
+	require.NoError(suite.bankKeeper.ProcessScheduledSends(ctx))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	_, err = suite.bankKeeper.GetScheduledSend(ctx, refundID)
+	require.Error(err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 13, Time: executeTime})
+	require.NoError(suite.bankKeeper.ProcessScheduledSends(ctx))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, escrowAcc.GetAddress()).IsZero())
+
+	sends, err = suite.bankKeeper.GetAllScheduledSends(ctx)
+	require.NoError(err)
+	require.Empty(sends)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+)
+
+// RecordSupplyCheckpoints stores a checkpoint of the total supply of every
+// denom when the current block height is a multiple of the
+// supply_history_interval parameter. It is a no-op when the parameter is 0.
+func (k BaseKeeper) RecordSupplyCheckpoints(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if params.SupplyHistoryInterval == 0 {
+		return nil
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if headerInfo.Height <= 0 || uint64(headerInfo.Height)%params.SupplyHistoryInterval != 0 {
+		return nil
+	}
+
+	return k.Supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		checkpoint := types.SupplyCheckpoint{
+			Denom:  denom,
+			Height: headerInfo.Height,
+			Time:   headerInfo.Time,
+			Amount: amount,
+		}
+
+		return false, k.BaseViewKeeper.SupplyHistory.Set(ctx, collections.Join(denom, headerInfo.Height), checkpoint)
+	})
+}
+
+// GetAllSupplyCheckpoints returns all the recorded supply checkpoints, ordered
+// by denom and height.
+func (k BaseKeeper) GetAllSupplyCheckpoints(ctx context.Context) ([]types.SupplyCheckpoint, error) {
+	iter, err := k.BaseViewKeeper.SupplyHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
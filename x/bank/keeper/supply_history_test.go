@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordSupplyCheckpoints() {
+	require := suite.Require()
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// supply history is disabled by default
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10, Time: blockTime})
+	require.NoError(suite.bankKeeper.RecordSupplyCheckpoints(ctx))
+	checkpoints, err := suite.bankKeeper.GetAllSupplyCheckpoints(ctx)
+	require.NoError(err)
+	require.Empty(checkpoints)
+
+	params := banktypes.DefaultParams()
+	params.SupplyHistoryInterval = 5
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: blockTime.Add(time.Duration(height) * time.Second)})
+		if height == 7 {
+			suite.mockFundAccount(accAddrs[1])
+			require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+		}
+		require.NoError(suite.bankKeeper.RecordSupplyCheckpoints(ctx))
+	}
+
+	checkpoints, err = suite.bankKeeper.GetAllSupplyCheckpoints(ctx)
+	require.NoError(err)
+	require.Len(checkpoints, 4)
+
+	res, err := suite.queryClient.SupplyHistory(ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Len(res.Checkpoints, 2)
+	require.Equal(int64(5), res.Checkpoints[0].Height)
+	require.Equal(blockTime.Add(5*time.Second), res.Checkpoints[0].Time)
+	require.Equal(int64(100), res.Checkpoints[0].Amount.Int64())
+	require.Equal(int64(10), res.Checkpoints[1].Height)
+	require.Equal(int64(120), res.Checkpoints[1].Amount.Int64())
+
+	res, err = suite.queryClient.SupplyHistory(ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, StartHeight: 6})
+	require.NoError(err)
+	require.Len(res.Checkpoints, 1)
+	require.Equal(int64(10), res.Checkpoints[0].Height)
+
+	res, err = suite.queryClient.SupplyHistory(ctx, &banktypes.QuerySupplyHistoryRequest{Denom: barDenom, EndHeight: 5})
+	require.NoError(err)
+	require.Len(res.Checkpoints, 1)
+	require.Equal(int64(50), res.Checkpoints[0].Amount.Int64())
+
+	_, err = suite.queryClient.SupplyHistory(ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, StartHeight: 10, EndHeight: 5})
+	require.ErrorContains(err, "end height 5 is lower than start height 10")
+
+	exported, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(exported.SupplyHistory, 4)
+	require.NoError(exported.Validate())
+}
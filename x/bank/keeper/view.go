@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -66,6 +67,16 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+
+	ScheduledSends           collections.Map[uint64, types.ScheduledSend]
+	ScheduledSendsBySender   collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	ScheduledSendSeq         collections.Sequence
+	ScheduledSendTimeQueue   collections.KeySet[collections.Pair[time.Time, uint64]]
+	ScheduledSendHeightQueue collections.KeySet[collections.Pair[int64, uint64]]
+
+	PullPaymentAllowances collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.PullPaymentAllowance]
+
+	SupplyHistory collections.Map[collections.Pair[string, int64], types.SupplyCheckpoint]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -80,6 +91,16 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		ScheduledSends:           collections.NewMap(sb, types.ScheduledSendsPrefix, "scheduled_sends", collections.Uint64Key, codec.CollValue[types.ScheduledSend](cdc)),
+		ScheduledSendsBySender:   collections.NewKeySet(sb, types.ScheduledSendsBySenderPrefix, "scheduled_sends_by_sender", collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.Uint64Key)), //nolint:staticcheck // sender addresses are length prefixed so that they can be used as a key prefix
+		ScheduledSendSeq:         collections.NewSequence(sb, types.ScheduledSendSequenceKey, "scheduled_send_seq"),
+		ScheduledSendTimeQueue:   collections.NewKeySet(sb, types.ScheduledSendTimeQueuePrefix, "scheduled_send_time_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		ScheduledSendHeightQueue: collections.NewKeySet(sb, types.ScheduledSendHeightQueuePrefix, "scheduled_send_height_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+
+		PullPaymentAllowances: collections.NewMap(sb, types.PullPaymentAllowancesPrefix, "pull_payment_allowances", collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.AccAddressKey), codec.CollValue[types.PullPaymentAllowance](cdc)), //nolint:staticcheck // payer addresses are length prefixed so that they can be used as a key prefix
+
+		SupplyHistory: collections.NewMap(sb, types.SupplyHistoryPrefix, "supply_history", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.SupplyCheckpoint](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -27,13 +27,15 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasInvariants       = AppModule{}
+	_ module.HasName                = AppModule{}
+	_ module.HasAminoCodec          = AppModule{}
+	_ module.HasGRPCGateway         = AppModule{}
+	_ module.AppModuleSimulation    = AppModule{}
+	_ module.HasInvariants          = AppModule{}
+	_ module.HasBlockSummaryMetrics = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 	_ appmodule.HasServices           = AppModule{}
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
@@ -111,6 +113,15 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	return nil
 }
 
+// RegisterBlockSummaryMetrics registers the tokens minted and burned during the
+// block in the block summary event.
+func (am AppModule) RegisterBlockSummaryMetrics(registry sdk.BlockSummaryRegistry) {
+	registry.RegisterBlockSummaryMetrics(
+		sdk.BlockSummaryMetric{Name: types.BlockSummaryKeyTokensMinted, EventType: types.EventTypeCoinMint, Attribute: sdk.AttributeKeyAmount, Coins: true},
+		sdk.BlockSummaryMetric{Name: types.BlockSummaryKeyTokensBurned, EventType: types.EventTypeCoinBurn, Attribute: sdk.AttributeKeyAmount, Coins: true},
+	)
+}
+
 // RegisterInvariants registers the bank module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
@@ -154,6 +165,16 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock executes the scheduled sends that are due at the current block and
+// records the supply checkpoints when supply history tracking is enabled.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.ProcessScheduledSends(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.RecordSupplyCheckpoints(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
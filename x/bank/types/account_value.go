@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountValueSourceBalances is the name of the source of the balances of an
+// account, which always comes first in its total value.
+const AccountValueSourceBalances = "balances"
+
+// An AccountValueSourceFn returns the value held by a module for an account,
+// e.g. its bonded stake or its unclaimed rewards, composed with its balances
+// by the TotalAccountValue query. It is only called by queries, so it may
+// write to the state of the query.
+type AccountValueSourceFn func(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, error)
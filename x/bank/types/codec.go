@@ -16,6 +16,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleSend{}, "cosmos-sdk/MsgScheduleSend")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledSend{}, "cosmos-sdk/MsgCancelScheduledSend")
+	legacy.RegisterAminoMsg(cdc, &MsgAuthorizePullPayment{}, "cosmos-sdk/MsgAuthorizePullPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokePullPayment{}, "cosmos-sdk/MsgRevokePullPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgPullPayment{}, "cosmos-sdk/MsgPullPayment")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -28,6 +33,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgScheduleSend{},
+		&MsgCancelScheduledSend{},
+		&MsgAuthorizePullPayment{},
+		&MsgRevokePullPayment{},
+		&MsgPullPayment{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
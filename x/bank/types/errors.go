@@ -13,4 +13,9 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrScheduledSendNotFound = errors.Register(ModuleName, 11, "scheduled send not found")
+	ErrInvalidSchedule       = errors.Register(ModuleName, 12, "invalid scheduled send execution time or height")
+	ErrPullPaymentNotFound   = errors.Register(ModuleName, 13, "pull payment allowance not found")
+	ErrPullPaymentExceeded   = errors.Register(ModuleName, 14, "pull payment allowance exceeded")
+	ErrPullPaymentExpired    = errors.Register(ModuleName, 15, "pull payment allowance expired")
 )
@@ -17,8 +17,29 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	// scheduled send events name and attributes
+	EventTypeScheduleSend         = "schedule_send"
+	EventTypeCancelScheduledSend  = "cancel_scheduled_send"
+	EventTypeExecuteScheduledSend = "execute_scheduled_send"
+
+	AttributeKeyScheduledSendID = "scheduled_send_id"
+	AttributeKeyResult          = "result"
+
+	// pull payment events name and attributes
+	EventTypeAuthorizePullPayment = "authorize_pull_payment"
+	EventTypeRevokePullPayment    = "revoke_pull_payment"
+	EventTypePullPayment          = "pull_payment"
+
+	AttributeKeyPayer      = "payer"
+	AttributeKeyPayee      = "payee"
+	AttributeKeySpendLimit = "spend_limit"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// block summary attributes
+	BlockSummaryKeyTokensMinted = "tokens_minted"
+	BlockSummaryKeyTokensBurned = "tokens_burned"
 )
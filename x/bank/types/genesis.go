@@ -62,6 +62,47 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenScheduledSends := make(map[uint64]bool)
+	for _, ss := range gs.ScheduledSends {
+		if seenScheduledSends[ss.Id] {
+			return fmt.Errorf("duplicate scheduled send id %d", ss.Id)
+		}
+
+		if err := ss.Validate(); err != nil {
+			return err
+		}
+
+		seenScheduledSends[ss.Id] = true
+	}
+
+	seenPullPayments := make(map[string]bool)
+	for _, a := range gs.PullPaymentAllowances {
+		key := a.Payer + "/" + a.Payee
+		if seenPullPayments[key] {
+			return fmt.Errorf("duplicate pull payment allowance from %s to %s", a.Payer, a.Payee)
+		}
+
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		seenPullPayments[key] = true
+	}
+
+	seenCheckpoints := make(map[string]bool)
+	for _, c := range gs.SupplyHistory {
+		key := fmt.Sprintf("%s/%d", c.Denom, c.Height)
+		if seenCheckpoints[key] {
+			return fmt.Errorf("duplicate supply checkpoint for denom %s at height %d", c.Denom, c.Height)
+		}
+
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		seenCheckpoints[key] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
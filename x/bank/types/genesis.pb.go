@@ -40,6 +40,12 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// scheduled_sends defines the pending scheduled sends.
+	ScheduledSends []ScheduledSend `protobuf:"bytes,6,rep,name=scheduled_sends,json=scheduledSends,proto3" json:"scheduled_sends"`
+	// pull_payment_allowances defines the pull payment allowances.
+	PullPaymentAllowances []PullPaymentAllowance `protobuf:"bytes,7,rep,name=pull_payment_allowances,json=pullPaymentAllowances,proto3" json:"pull_payment_allowances"`
+	// supply_history defines the recorded supply checkpoints.
+	SupplyHistory []SupplyCheckpoint `protobuf:"bytes,8,rep,name=supply_history,json=supplyHistory,proto3" json:"supply_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +116,27 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledSends() []ScheduledSend {
+	if m != nil {
+		return m.ScheduledSends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPullPaymentAllowances() []PullPaymentAllowance {
+	if m != nil {
+		return m.PullPaymentAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyHistory() []SupplyCheckpoint {
+	if m != nil {
+		return m.SupplyHistory
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +187,44 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0xf6, 0xd7, 0xb4, 0xbd, 0xa6, 0xa9, 0x7e, 0xa6, 0x08, 0xb7, 0x14, 0x27, 0x44,
+	0x42, 0x0a, 0x95, 0x62, 0x93, 0x14, 0x84, 0xc4, 0x80, 0x54, 0x47, 0xbc, 0x2c, 0x88, 0xaa, 0xd9,
+	0x58, 0xac, 0xb3, 0x7d, 0x72, 0x4c, 0xec, 0x3b, 0x2b, 0xcf, 0x85, 0xe2, 0x89, 0x95, 0x91, 0xb9,
+	0x53, 0x47, 0xc4, 0xd4, 0xa1, 0x7f, 0x00, 0x63, 0xc7, 0x8a, 0x09, 0x31, 0x00, 0x4a, 0x86, 0xf2,
+	0x67, 0x20, 0xdf, 0x39, 0x2f, 0x12, 0x46, 0xdd, 0x58, 0x92, 0x28, 0xdf, 0x97, 0xcf, 0x3d, 0xf1,
+	0x93, 0x43, 0xb7, 0x3d, 0x06, 0x31, 0x03, 0xcb, 0xc5, 0xb4, 0x6f, 0xbd, 0x69, 0xb9, 0x84, 0xe3,
+	0x96, 0x15, 0x10, 0x4a, 0x20, 0x04, 0x33, 0x19, 0x30, 0xce, 0xb4, 0x6b, 0xd2, 0x62, 0x66, 0x16,
+	0x33, 0xb7, 0x6c, 0x6f, 0x06, 0x2c, 0x60, 0x42, 0xb7, 0xb2, 0x4f, 0xd2, 0xba, 0x6d, 0x4c, 0xdb,
+	0x80, 0x4c, 0xdb, 0x3c, 0x16, 0xd2, 0x3f, 0xf4, 0x39, 0x9a, 0xe8, 0x95, 0xfa, 0x96, 0xd4, 0x1d,
+	0x59, 0x9c, 0x73, 0xa5, 0xf4, 0x3f, 0x8e, 0x43, 0xca, 0x2c, 0xf1, 0x2a, 0xbf, 0xaa, 0x1f, 0x97,
+	0x50, 0xf9, 0x99, 0x3c, 0x6a, 0x97, 0x63, 0x4e, 0xb4, 0xc7, 0xa8, 0x94, 0xe0, 0x01, 0x8e, 0x41,
+	0x57, 0x6b, 0x6a, 0x63, 0xad, 0x7d, 0xd3, 0x2c, 0x38, 0xba, 0x79, 0x20, 0x2c, 0xf6, 0xea, 0xf9,
+	0xf7, 0xaa, 0xf2, 0xf1, 0xf2, 0x74, 0x57, 0x3d, 0xcc, 0x53, 0x5a, 0x07, 0xad, 0xb8, 0x38, 0xc2,
+	0xd4, 0x23, 0xa0, 0x2f, 0xd4, 0x16, 0x1b, 0x6b, 0xed, 0x9d, 0xc2, 0x06, 0x5b, 0x9a, 0xe6, 0x2b,
+	0xa6, 0x41, 0x2d, 0x45, 0x25, 0x18, 0x26, 0x49, 0x94, 0xea, 0x8b, 0xa2, 0x62, 0x6b, 0x56, 0x01,
+	0x64, 0x5a, 0xd1, 0x61, 0x21, 0xb5, 0x9f, 0x66, 0xf9, 0x4f, 0x3f, 0xaa, 0x8d, 0x20, 0xe4, 0xbd,
+	0xa1, 0x6b, 0x7a, 0x2c, 0xce, 0x87, 0xce, 0xdf, 0x9a, 0xe0, 0xf7, 0x2d, 0x9e, 0x26, 0x04, 0x44,
+	0x00, 0x8e, 0x2f, 0x4f, 0x77, 0xcb, 0x11, 0x09, 0xb0, 0x97, 0x3a, 0xd9, 0xcf, 0x0a, 0xf9, 0xf9,
+	0x25, 0x50, 0x7b, 0x89, 0x2a, 0x3e, 0xa1, 0x2c, 0x76, 0x62, 0xc2, 0xb1, 0x8f, 0x39, 0xd6, 0xff,
+	0x13, 0x47, 0xb8, 0x55, 0x38, 0xc5, 0x8b, 0xdc, 0x34, 0x3f, 0xc6, 0xba, 0xc8, 0x4f, 0x14, 0x0d,
+	0xa3, 0x32, 0x10, 0xea, 0x3b, 0x84, 0x62, 0x37, 0x22, 0xbe, 0xbe, 0x24, 0xea, 0x6a, 0x85, 0x75,
+	0x5d, 0x42, 0xfd, 0x27, 0xd2, 0x67, 0xef, 0x64, 0x8d, 0xdf, 0xce, 0x9a, 0x1b, 0xb3, 0x31, 0x6a,
+	0xf7, 0xcc, 0xfb, 0x0f, 0x25, 0x64, 0x0d, 0x66, 0x56, 0x2d, 0x44, 0x1b, 0xe0, 0xf5, 0x88, 0x3f,
+	0x8c, 0x88, 0xef, 0x64, 0x02, 0xe8, 0x25, 0x41, 0xa9, 0x17, 0x53, 0x26, 0xde, 0x0c, 0xf7, 0x17,
+	0xce, 0x83, 0x96, 0xe4, 0x54, 0x60, 0xde, 0x0c, 0xda, 0x3b, 0x74, 0x23, 0x19, 0x46, 0x91, 0x93,
+	0xe0, 0x34, 0x26, 0x94, 0x3b, 0x38, 0x8a, 0xd8, 0x91, 0x7c, 0xda, 0xcb, 0x02, 0x79, 0xb7, 0x78,
+	0x5f, 0x86, 0x51, 0x74, 0x20, 0x23, 0xfb, 0x93, 0xc4, 0x15, 0xe4, 0xeb, 0x49, 0x41, 0x06, 0xb4,
+	0xd7, 0xa8, 0x22, 0x9f, 0x94, 0xd3, 0x0b, 0x81, 0xb3, 0x41, 0xaa, 0xaf, 0x08, 0xee, 0x9d, 0xe2,
+	0x51, 0x85, 0xb5, 0xd3, 0x23, 0x5e, 0x3f, 0x61, 0x21, 0xe5, 0x57, 0x30, 0xd7, 0x65, 0xf5, 0x73,
+	0xd9, 0x5c, 0xff, 0xac, 0xa2, 0xe5, 0x7c, 0x4f, 0xb5, 0x36, 0x5a, 0xc6, 0xbe, 0x3f, 0x20, 0x20,
+	0xff, 0x18, 0xab, 0xb6, 0xfe, 0xe5, 0xac, 0xb9, 0x99, 0x33, 0xf7, 0xa5, 0xd2, 0xe5, 0x83, 0x90,
+	0x06, 0x87, 0x13, 0xa3, 0x76, 0x84, 0x96, 0xc4, 0x86, 0xe9, 0x0b, 0xff, 0x6a, 0x8b, 0x25, 0xef,
+	0xd1, 0xca, 0xfb, 0x93, 0xaa, 0xf2, 0xeb, 0xa4, 0xaa, 0xd8, 0x7b, 0xe7, 0x23, 0x43, 0xbd, 0x18,
+	0x19, 0xea, 0xcf, 0x91, 0xa1, 0x7e, 0x18, 0x1b, 0xca, 0xc5, 0xd8, 0x50, 0xbe, 0x8e, 0x0d, 0xe5,
+	0x55, 0x7e, 0x4f, 0x80, 0xdf, 0x37, 0x43, 0x66, 0xbd, 0x95, 0xf7, 0x89, 0x20, 0xb8, 0x25, 0x71,
+	0x37, 0xec, 0xfd, 0x1e, 0x00, 0xd3, 0x05, 0x41, 0xec, 0xd9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +247,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplyHistory) > 0 {
+		for iNdEx := len(m.SupplyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PullPaymentAllowances) > 0 {
+		for iNdEx := len(m.PullPaymentAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PullPaymentAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ScheduledSends) > 0 {
+		for iNdEx := len(m.ScheduledSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +445,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledSends) > 0 {
+		for _, e := range m.ScheduledSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PullPaymentAllowances) > 0 {
+		for _, e := range m.PullPaymentAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyHistory) > 0 {
+		for _, e := range m.SupplyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +689,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledSends = append(m.ScheduledSends, ScheduledSend{})
+			if err := m.ScheduledSends[len(m.ScheduledSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPaymentAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPaymentAllowances = append(m.PullPaymentAllowances, PullPaymentAllowance{})
+			if err := m.PullPaymentAllowances[len(m.PullPaymentAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyHistory = append(m.SupplyHistory, SupplyCheckpoint{})
+			if err := m.SupplyHistory[len(m.SupplyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -39,6 +39,21 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// ScheduledSendsPrefix is the prefix for the pending scheduled sends store.
+	ScheduledSendsPrefix = collections.NewPrefix(6)
+	// ScheduledSendsBySenderPrefix is the prefix for the scheduled sends sender index.
+	ScheduledSendsBySenderPrefix = collections.NewPrefix(7)
+	// ScheduledSendSequenceKey is the key for the next scheduled send id.
+	ScheduledSendSequenceKey = collections.NewPrefix(8)
+	// ScheduledSendTimeQueuePrefix is the prefix for the time-ordered scheduled send queue.
+	ScheduledSendTimeQueuePrefix = collections.NewPrefix(9)
+	// ScheduledSendHeightQueuePrefix is the prefix for the height-ordered scheduled send queue.
+	ScheduledSendHeightQueuePrefix = collections.NewPrefix(10)
+	// PullPaymentAllowancesPrefix is the prefix for the pull payment allowances store.
+	PullPaymentAllowancesPrefix = collections.NewPrefix(11)
+	// SupplyHistoryPrefix is the prefix for the per-denom supply checkpoints store.
+	SupplyHistoryPrefix = collections.NewPrefix(12)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
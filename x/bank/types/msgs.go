@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,11 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgScheduleSend{}
+	_ sdk.Msg = &MsgCancelScheduledSend{}
+	_ sdk.Msg = &MsgAuthorizePullPayment{}
+	_ sdk.Msg = &MsgRevokePullPayment{}
+	_ sdk.Msg = &MsgPullPayment{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +35,43 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgScheduleSend - construct a msg to send coins from one account to another
+// at a future block time or height. Exactly one of executeTime and executeHeight
+// is expected to be set.
+func NewMsgScheduleSend(fromAddr, toAddr string, amount sdk.Coins, executeTime *time.Time, executeHeight int64) *MsgScheduleSend {
+	return &MsgScheduleSend{
+		FromAddress:   fromAddr,
+		ToAddress:     toAddr,
+		Amount:        amount,
+		ExecuteTime:   executeTime,
+		ExecuteHeight: executeHeight,
+	}
+}
+
+// NewMsgCancelScheduledSend - construct a msg to cancel a pending scheduled send.
+func NewMsgCancelScheduledSend(fromAddr string, id uint64) *MsgCancelScheduledSend {
+	return &MsgCancelScheduledSend{FromAddress: fromAddr, Id: id}
+}
+
+// NewMsgAuthorizePullPayment - construct a msg to allow a payee to pull up to
+// spendLimit coins per period from the payer's account.
+func NewMsgAuthorizePullPayment(payer, payee string, spendLimit sdk.Coins, period time.Duration, expiration *time.Time) *MsgAuthorizePullPayment {
+	return &MsgAuthorizePullPayment{
+		Payer:      payer,
+		Payee:      payee,
+		SpendLimit: spendLimit,
+		Period:     period,
+		Expiration: expiration,
+	}
+}
+
+// NewMsgRevokePullPayment - construct a msg to revoke a pull payment allowance.
+func NewMsgRevokePullPayment(payer, payee string) *MsgRevokePullPayment {
+	return &MsgRevokePullPayment{Payer: payer, Payee: payee}
+}
+
+// NewMsgPullPayment - construct a msg to pull coins from a payer's account.
+func NewMsgPullPayment(payee, payer string, amount sdk.Coins) *MsgPullPayment {
+	return &MsgPullPayment{Payee: payee, Payer: payer, Amount: amount}
+}
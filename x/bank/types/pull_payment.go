@@ -0,0 +1,56 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of a PullPaymentAllowance.
+func (a PullPaymentAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Payer); err != nil {
+		return fmt.Errorf("invalid payer address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Payee); err != nil {
+		return fmt.Errorf("invalid payee address: %w", err)
+	}
+
+	if a.Payer == a.Payee {
+		return errors.New("payer and payee cannot be the same")
+	}
+
+	if err := ValidatePullPaymentLimit(a.SpendLimit, a.Period); err != nil {
+		return err
+	}
+
+	if err := a.PeriodSpent.Validate(); err != nil {
+		return fmt.Errorf("invalid period spent: %w", err)
+	}
+
+	if !a.PeriodSpent.IsAllLTE(a.SpendLimit) {
+		return fmt.Errorf("period spent %s exceeds spend limit %s", a.PeriodSpent, a.SpendLimit)
+	}
+
+	return nil
+}
+
+// ValidatePullPaymentLimit checks that a pull payment spend limit is a non
+// empty set of valid coins and that its period is positive.
+func ValidatePullPaymentLimit(spendLimit sdk.Coins, period time.Duration) error {
+	if err := spendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	if spendLimit.Empty() {
+		return errors.New("pull payment spend limit cannot be empty")
+	}
+
+	if period <= 0 {
+		return fmt.Errorf("pull payment period must be positive: %s", period)
+	}
+
+	return nil
+}
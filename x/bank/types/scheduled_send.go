@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of a ScheduledSend.
+func (s ScheduledSend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+
+	if err := s.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if s.Amount.Empty() {
+		return errors.New("scheduled send amount cannot be empty")
+	}
+
+	return ValidateSchedule(s.ExecuteTime != nil, s.ExecuteHeight)
+}
+
+// ValidateSchedule checks that exactly one of an execution time or a positive
+// execution height is provided.
+func ValidateSchedule(hasTime bool, height int64) error {
+	switch {
+	case height < 0:
+		return ErrInvalidSchedule.Wrapf("execute height cannot be negative: %d", height)
+	case hasTime && height != 0:
+		return ErrInvalidSchedule.Wrap("only one of execute time and execute height can be set")
+	case !hasTime && height == 0:
+		return ErrInvalidSchedule.Wrap("one of execute time and execute height must be set")
+	}
+
+	return nil
+}
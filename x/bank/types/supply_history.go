@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of a SupplyCheckpoint.
+func (c SupplyCheckpoint) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+
+	if c.Height <= 0 {
+		return fmt.Errorf("supply checkpoint height must be positive: %d", c.Height)
+	}
+
+	if c.Amount.IsNil() || c.Amount.IsNegative() {
+		return errors.New("supply checkpoint amount cannot be nil or negative")
+	}
+
+	return nil
+}
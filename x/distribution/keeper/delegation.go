@@ -200,6 +200,56 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.Validato
 	return rewards, nil
 }
 
+// GetDelegatorUnclaimedRewards returns the total rewards a delegator can
+// withdraw from all its delegations, truncated to whole coins. The periods of
+// the validators are incremented to compute the rewards, so it must only be
+// called by queries.
+func (k Keeper) GetDelegatorUnclaimedRewards(ctx context.Context, delegator sdk.AccAddress) (sdk.Coins, error) {
+	total := sdk.DecCoins{}
+
+	var iterErr error
+	err := k.stakingKeeper.IterateDelegations(
+		ctx, delegator,
+		func(_ int64, del sdk.DelegationI) (stop bool) {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			val, err := k.stakingKeeper.Validator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			delReward, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			total = total.Add(delReward...)
+			return false
+		},
+	)
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, _ := total.TruncateDecimal()
+	return rewards, nil
+}
+
 func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI) (sdk.Coins, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
@@ -254,6 +304,12 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 	// truncate reward dec coins, return remainder to decimal pool
 	finalRewards, remainder := rewards.TruncateDecimal()
 
+	// rewards in denoms which are not whitelisted are not paid out
+	finalRewards, err = k.handleRewardDust(ctx, delAddr, finalRewards)
+	if err != nil {
+		return nil, err
+	}
+
 	// add coins to user account
 	if !finalRewards.IsZero() {
 		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
@@ -324,3 +380,49 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	return finalRewards, nil
 }
+
+// handleRewardDust returns the rewards in whitelisted denoms, the rewards in
+// other denoms being sent to the reward dust handler, or to the community pool.
+func (k Keeper) handleRewardDust(ctx context.Context, delAddr sdk.AccAddress, rewards sdk.Coins) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payout, dust sdk.Coins
+	for _, coin := range rewards {
+		if params.IsRewardDenomWhitelisted(coin.Denom) {
+			payout = append(payout, coin)
+		} else {
+			dust = append(dust, coin)
+		}
+	}
+
+	if dust.IsZero() {
+		return rewards, nil
+	}
+
+	if k.rewardDustHandler != nil {
+		err = k.rewardDustHandler.HandleRewardDust(ctx, delAddr, dust)
+	} else {
+		err = k.poolKeeper.FundCommunityPool(ctx, dust, k.authKeeper.GetModuleAddress(types.ModuleName))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRewardDust,
+		event.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+	); err != nil {
+		return nil, err
+	}
+
+	return payout, nil
+}
@@ -28,6 +28,10 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	poolKeeper    types.PoolKeeper
 
+	// rewardDustHandler handles the rewards in denoms which are not
+	// whitelisted, they are sent to the community pool when it is nil.
+	rewardDustHandler types.RewardDustHandler
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -140,6 +144,17 @@ func NewKeeper(
 	return k
 }
 
+// SetRewardDustHandler sets the handler of the rewards in denoms which are not
+// whitelisted, instead of sending them to the community pool.
+func (k *Keeper) SetRewardDustHandler(h types.RewardDustHandler) *Keeper {
+	if k.rewardDustHandler != nil {
+		panic("cannot set reward dust handler twice")
+	}
+
+	k.rewardDustHandler = h
+	return k
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -38,6 +38,11 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// reward_denom_whitelist are the denoms of the rewards paid out to the
+	// delegators. The rewards in other denoms, e.g. dust of IBC denoms, are sent
+	// to the community pool instead of the delegators. All the denoms are paid out
+	// when it is empty.
+	RewardDenomWhitelist []string `protobuf:"bytes,5,rep,name=reward_denom_whitelist,json=rewardDenomWhitelist,proto3" json:"reward_denom_whitelist,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +85,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRewardDenomWhitelist() []string {
+	if m != nil {
+		return m.RewardDenomWhitelist
+	}
+	return nil
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +669,76 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1090 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x9c, 0xd4, 0x71, 0x8b, 0xed, 0x5a, 0x54,
+	0x98, 0x40, 0xec, 0xa6, 0x15, 0x08, 0xe5, 0x82, 0x9a, 0xb8, 0x15, 0x95, 0x0a, 0x44, 0x1b, 0x44,
+	0x25, 0x38, 0xac, 0xc6, 0xbb, 0x13, 0x7b, 0xc8, 0xee, 0xcc, 0x32, 0x33, 0x76, 0x92, 0x13, 0x1c,
+	0x0b, 0x07, 0xe0, 0x06, 0xe2, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
+	0x01, 0x42, 0x3d, 0x04, 0x48, 0x84, 0x82, 0xf8, 0x2b, 0xd0, 0xec, 0x8c, 0x77, 0x9d, 0x10, 0x50,
+	0x08, 0x8a, 0xb8, 0x58, 0x9e, 0xf7, 0x66, 0xde, 0xf7, 0xbd, 0xb7, 0xdf, 0xbc, 0x37, 0xb0, 0xe6,
+	0x71, 0x19, 0x72, 0x59, 0xf7, 0xa9, 0x54, 0x82, 0x36, 0x3b, 0x8a, 0x72, 0x56, 0xef, 0x2e, 0x34,
+	0x89, 0xc2, 0x0b, 0x87, 0x8c, 0xb5, 0x48, 0x70, 0xc5, 0xd1, 0x25, 0xb3, 0xbf, 0x76, 0xc8, 0x65,
+	0xf7, 0x17, 0x72, 0x2d, 0xde, 0xe2, 0xf1, 0xbe, 0xba, 0xfe, 0x67, 0x8e, 0x14, 0x8a, 0x16, 0xa2,
+	0x89, 0x25, 0x49, 0x42, 0x7b, 0x9c, 0xda, 0x90, 0x85, 0x59, 0xe3, 0x77, 0xcd, 0x41, 0x1b, 0xdf,
+	0xb8, 0x2e, 0xe0, 0x90, 0x32, 0x5e, 0x8f, 0x7f, 0x8d, 0xa9, 0xf2, 0xdb, 0x20, 0xcc, 0xae, 0x60,
+	0x81, 0x43, 0x89, 0xde, 0x83, 0xe7, 0x3d, 0x1e, 0x86, 0x1d, 0x46, 0xd5, 0x96, 0xab, 0xf0, 0x66,
+	0x1e, 0x94, 0x41, 0x75, 0x74, 0xe9, 0x95, 0x47, 0xbb, 0xa5, 0xcc, 0xd3, 0xdd, 0x92, 0xa5, 0x2a,
+	0xfd, 0xf5, 0x1a, 0xe5, 0xf5, 0x10, 0xab, 0x76, 0xed, 0x2e, 0x69, 0x61, 0x6f, 0xab, 0x41, 0xbc,
+	0x27, 0x3b, 0xf3, 0xd0, 0x22, 0x35, 0x88, 0xf7, 0xf0, 0x60, 0x7b, 0x0e, 0x38, 0xe3, 0x49, 0xb0,
+	0xb7, 0xf1, 0x26, 0x7a, 0x1f, 0xe6, 0x34, 0x61, 0xcd, 0x2a, 0xe2, 0x92, 0x08, 0x57, 0x90, 0x0d,
+	0x2c, 0xfc, 0xfc, 0x40, 0x8c, 0xf1, 0xea, 0xe9, 0x30, 0xf2, 0xc0, 0x41, 0x3a, 0xea, 0x8a, 0x0d,
+	0xea, 0xc4, 0x31, 0x51, 0x00, 0xa7, 0x9b, 0x9c, 0x75, 0xe4, 0x5f, 0xc0, 0x06, 0xff, 0x23, 0xd8,
+	0x54, 0x1c, 0xf6, 0x08, 0xda, 0x75, 0x38, 0xbd, 0x41, 0x55, 0xdb, 0x17, 0x78, 0xc3, 0xc5, 0xbe,
+	0x2f, 0x5c, 0xc2, 0x70, 0x33, 0x20, 0x7e, 0x7e, 0xa8, 0x0c, 0xaa, 0xe7, 0x9c, 0xa9, 0x9e, 0xf3,
+	0xa6, 0xef, 0x8b, 0x5b, 0xc6, 0x85, 0xee, 0xc0, 0x19, 0x43, 0xc9, 0xf5, 0x09, 0xe3, 0xa1, 0xbb,
+	0xd1, 0xa6, 0x8a, 0x04, 0x54, 0xaa, 0xfc, 0x70, 0x79, 0xb0, 0x3a, 0xba, 0x34, 0xf5, 0x74, 0x67,
+	0x7e, 0xd2, 0xe0, 0xcf, 0x4b, 0x7f, 0xbd, 0x7c, 0xad, 0xf6, 0xf2, 0x82, 0x93, 0x33, 0x47, 0x1a,
+	0xfa, 0xc4, 0xbd, 0xde, 0x81, 0xc5, 0xab, 0x9f, 0x1c, 0x6c, 0xcf, 0x95, 0xd3, 0xbd, 0xf5, 0xcd,
+	0xc3, 0xe2, 0x33, 0x1f, 0xb7, 0xf2, 0x23, 0x80, 0x85, 0x77, 0x70, 0x40, 0x7d, 0xac, 0xb8, 0x78,
+	0x9d, 0x4a, 0xc5, 0x05, 0xf5, 0x70, 0x60, 0x72, 0x90, 0xe8, 0x53, 0x00, 0x2f, 0x7a, 0x9d, 0xb0,
+	0x13, 0x60, 0x45, 0xbb, 0xc4, 0xd6, 0xcb, 0x15, 0x58, 0x51, 0x9e, 0x07, 0xe5, 0xc1, 0xea, 0xd8,
+	0xf5, 0xcb, 0x56, 0xda, 0x35, 0x5d, 0xf0, 0x9e, 0x44, 0x75, 0x71, 0x96, 0x39, 0x65, 0xa6, 0xa6,
+	0xdf, 0xfe, 0x5c, 0x7a, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0x79, 0x3c, 0xb4, 0xd2, 0xab, 0xf7,
+	0x51, 0x53, 0x5b, 0x11, 0x91, 0xbd, 0x33, 0xd2, 0xc8, 0x64, 0x3a, 0x85, 0x35, 0x64, 0x1c, 0x0d,
+	0x8a, 0x9e, 0x87, 0x93, 0x82, 0xac, 0x11, 0x41, 0x98, 0x47, 0x5c, 0x8f, 0x77, 0x98, 0x8a, 0xa5,
+	0x72, 0xde, 0x99, 0x48, 0xcc, 0xcb, 0xda, 0x5a, 0xf9, 0x06, 0xc0, 0x8b, 0x49, 0x62, 0xcb, 0x1d,
+	0x21, 0x08, 0x53, 0xbd, 0xac, 0x22, 0x38, 0x62, 0x32, 0x91, 0x67, 0x9c, 0x44, 0x0f, 0x06, 0xcd,
+	0xc0, 0x6c, 0x44, 0x04, 0xe5, 0x46, 0xd8, 0x43, 0x8e, 0x5d, 0x55, 0xbe, 0x04, 0xb0, 0x98, 0xb0,
+	0xbc, 0xe9, 0xd9, 0x9c, 0x89, 0xbf, 0xcc, 0xc3, 0x90, 0x4a, 0x49, 0x39, 0x43, 0x5d, 0x08, 0xbd,
+	0x64, 0x75, 0xc6, 0x7c, 0xfb, 0x90, 0x2a, 0x9f, 0x01, 0x78, 0x29, 0xa1, 0xf6, 0x56, 0x47, 0x49,
+	0x85, 0x99, 0x4f, 0x59, 0xeb, 0x7f, 0x2b, 0xa2, 0x66, 0x34, 0x95, 0x30, 0x5a, 0x0d, 0xb0, 0x6c,
+	0xdf, 0xea, 0x12, 0xa6, 0xd0, 0x0b, 0xf0, 0x99, 0x6e, 0xcf, 0xec, 0xda, 0x32, 0x83, 0xb8, 0xcc,
+	0x93, 0x89, 0x7d, 0x25, 0x36, 0xa3, 0x37, 0xe0, 0xb9, 0x35, 0x81, 0x3d, 0x7d, 0x03, 0x6c, 0x8b,
+	0x59, 0xf8, 0xd7, 0xb7, 0xde, 0x49, 0x42, 0x54, 0x3e, 0x06, 0x30, 0x77, 0x0c, 0x23, 0x89, 0x3e,
+	0x80, 0x33, 0x29, 0x25, 0xa9, 0x1d, 0x2e, 0x89, 0x3d, 0xb6, 0x56, 0xd7, 0x6a, 0xff, 0xd0, 0xe0,
+	0x6b, 0xc7, 0x84, 0x5c, 0x1a, 0xd5, 0x3c, 0x4d, 0x41, 0x72, 0xdd, 0x63, 0x20, 0x2b, 0x1f, 0x0d,
+	0xc0, 0x91, 0xdb, 0x84, 0xac, 0x70, 0x1e, 0xa0, 0x0f, 0xe1, 0x44, 0xda, 0xb2, 0x23, 0xce, 0x83,
+	0x13, 0x7d, 0xa2, 0xc5, 0xd3, 0x7e, 0xa2, 0x3c, 0x70, 0xd2, 0x11, 0x11, 0x13, 0x50, 0x70, 0xdc,
+	0x27, 0x1e, 0x0d, 0x71, 0x60, 0xe0, 0x07, 0x4e, 0x00, 0x7f, 0xe3, 0x14, 0xf0, 0xce, 0x98, 0x85,
+	0xd1, 0xa8, 0x95, 0x2f, 0x06, 0x60, 0x61, 0xb9, 0x9f, 0xc7, 0x6a, 0x44, 0x98, 0x6f, 0xfa, 0x32,
+	0x0e, 0x50, 0x0e, 0x0e, 0x2b, 0xaa, 0x02, 0x62, 0x06, 0x98, 0x63, 0x16, 0xa8, 0x0c, 0xc7, 0x7c,
+	0x22, 0x3d, 0x41, 0xa3, 0x54, 0x15, 0x4e, 0xbf, 0x09, 0x5d, 0x86, 0xa3, 0x82, 0x78, 0x34, 0xa2,
+	0x84, 0x29, 0x33, 0x2b, 0x9c, 0xd4, 0x80, 0xb6, 0x60, 0x16, 0x87, 0x71, 0x23, 0x1a, 0x8a, 0x93,
+	0x9c, 0x3d, 0x36, 0xc9, 0x38, 0xc3, 0xdb, 0x36, 0xc3, 0xea, 0x09, 0x32, 0x8c, 0xd3, 0xfb, 0xea,
+	0x60, 0x7b, 0x6e, 0x3c, 0x88, 0x65, 0xe8, 0x7a, 0xe9, 0x8d, 0xb0, 0x80, 0x8b, 0xd5, 0xfb, 0x0f,
+	0x4a, 0x99, 0xdf, 0x1f, 0x94, 0x32, 0xdf, 0xed, 0xcc, 0x17, 0x2c, 0x6a, 0x8b, 0x77, 0xfb, 0x40,
+	0x99, 0xd2, 0x9c, 0x41, 0xe5, 0x7b, 0x00, 0xa7, 0x1b, 0x44, 0x47, 0xd2, 0xaa, 0x51, 0x58, 0x28,
+	0xca, 0x5a, 0x77, 0xd8, 0x5a, 0xdc, 0x50, 0x23, 0x41, 0xba, 0x94, 0xeb, 0xb9, 0xd8, 0x7f, 0x77,
+	0x26, 0x7a, 0x66, 0x7b, 0x75, 0xee, 0xc2, 0x61, 0xa9, 0xf0, 0x3a, 0xb1, 0xf7, 0xe6, 0xb4, 0xe3,
+	0xdf, 0x04, 0x41, 0x0d, 0x98, 0x6d, 0x13, 0xda, 0x6a, 0x9b, 0x82, 0x0e, 0x2d, 0xbd, 0xf4, 0xc7,
+	0x6e, 0x69, 0xd2, 0x13, 0x44, 0x37, 0x79, 0xe6, 0x1a, 0xd7, 0xd7, 0x07, 0xdb, 0x73, 0x47, 0x6d,
+	0xb6, 0x00, 0x66, 0x51, 0xf9, 0x15, 0xc0, 0x59, 0x9b, 0x16, 0xe5, 0x2c, 0x49, 0xd0, 0x4e, 0xe0,
+	0x37, 0xe1, 0x85, 0xf4, 0x12, 0xea, 0x11, 0x4c, 0xa4, 0xb4, 0x8f, 0x97, 0x2b, 0x4f, 0x76, 0xe6,
+	0x9f, 0xb5, 0xd4, 0xd2, 0xfe, 0x6b, 0xb6, 0xac, 0x2a, 0xa1, 0xdb, 0x5c, 0xda, 0x53, 0xac, 0x1d,
+	0x31, 0x98, 0x4d, 0x5e, 0x27, 0x67, 0xd9, 0xf0, 0x2c, 0xca, 0xe2, 0x90, 0xfe, 0xbc, 0x95, 0x1f,
+	0x00, 0xbc, 0xfa, 0xf7, 0xa2, 0xbe, 0x47, 0x55, 0xbb, 0x41, 0x22, 0x2e, 0xa9, 0x3a, 0x23, 0x7d,
+	0xcf, 0xf4, 0xe9, 0x5b, 0xbb, 0xec, 0x0a, 0xe5, 0xe1, 0x88, 0x6f, 0x80, 0xf3, 0xc3, 0xb1, 0xa3,
+	0xb7, 0x5c, 0x7c, 0xee, 0xfe, 0x09, 0x24, 0xb9, 0xf4, 0xda, 0xc3, 0xbd, 0x22, 0x78, 0xb4, 0x57,
+	0x04, 0x8f, 0xf7, 0x8a, 0xe0, 0x97, 0xbd, 0x22, 0xf8, 0x7c, 0xbf, 0x98, 0x79, 0xbc, 0x5f, 0xcc,
+	0xfc, 0xb4, 0x5f, 0xcc, 0xbc, 0x7b, 0xe5, 0x90, 0xac, 0x8e, 0xbc, 0x5d, 0xe2, 0xa2, 0x35, 0xb3,
+	0xf1, 0x4b, 0xf5, 0xc6, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0x72, 0xe2, 0x70, 0x5c, 0x0b,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +772,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if len(this.RewardDenomWhitelist) != len(that1.RewardDenomWhitelist) {
+		return false
+	}
+	for i := range this.RewardDenomWhitelist {
+		if this.RewardDenomWhitelist[i] != that1.RewardDenomWhitelist[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1115,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardDenomWhitelist) > 0 {
+		for iNdEx := len(m.RewardDenomWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenomWhitelist[iNdEx])
+			copy(dAtA[i:], m.RewardDenomWhitelist[iNdEx])
+			i = encodeVarintDistribution(dAtA, i, uint64(len(m.RewardDenomWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1680,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if len(m.RewardDenomWhitelist) > 0 {
+		for _, s := range m.RewardDenomWhitelist {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2016,6 +2054,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomWhitelist = append(m.RewardDenomWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -41,6 +41,13 @@ type PoolKeeper interface {
 	SetToDistribute(ctx context.Context, amount sdk.Coins, addr string) error
 }
 
+// RewardDustHandler handles the rewards of a delegator in denoms which are not
+// whitelisted, e.g. to auction them. The rewards are held by the distribution
+// module account when the handler is called.
+type RewardDustHandler interface {
+	HandleRewardDust(ctx context.Context, delegator sdk.AccAddress, dust sdk.Coins) error
+}
+
 // StakingKeeper expected staking keeper (noalias)
 type StakingKeeper interface {
 	ValidatorAddressCodec() address.Codec
@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultParams returns default distribution parameters
@@ -18,7 +21,17 @@ func DefaultParams() Params {
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateRewardDenomWhitelist(p.RewardDenomWhitelist)
+}
+
+// IsRewardDenomWhitelisted returns whether the rewards in the given denom are
+// paid out to the delegators.
+func (p Params) IsRewardDenomWhitelisted(denom string) bool {
+	return len(p.RewardDenomWhitelist) == 0 || slices.Contains(p.RewardDenomWhitelist, denom)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +52,19 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDenomWhitelist(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid reward denom: %w", err)
+		}
+
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate reward denom: %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	return nil
+}
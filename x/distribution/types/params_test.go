@@ -47,3 +47,19 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_RewardDenomWhitelist(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsRewardDenomWhitelisted("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+
+	params.RewardDenomWhitelist = []string{"stake", "uatom"}
+	require.NoError(t, params.ValidateBasic())
+	require.True(t, params.IsRewardDenomWhitelisted("uatom"))
+	require.False(t, params.IsRewardDenomWhitelisted("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+
+	params.RewardDenomWhitelist = []string{"stake", "stake"}
+	require.ErrorContains(t, params.ValidateBasic(), "duplicate reward denom")
+
+	params.RewardDenomWhitelist = []string{"1stake"}
+	require.ErrorContains(t, params.ValidateBasic(), "invalid reward denom")
+}
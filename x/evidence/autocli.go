@@ -28,7 +28,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current evidence parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEvidence",
+					Skip:      true, // submitted through the evidence handlers commands
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update evidence module params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx evidence update-params-proposal '{ "bounty_fraction": "0.05" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 	}
 }
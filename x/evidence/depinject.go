@@ -1,8 +1,10 @@
 package evidence
 
 import (
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
-	"cosmossdk.io/core/address"
+	coreaddress "cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/depinject"
@@ -12,6 +14,7 @@ import (
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -28,6 +31,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
@@ -35,7 +39,8 @@ type ModuleInputs struct {
 
 	StakingKeeper  types.StakingKeeper
 	SlashingKeeper types.SlashingKeeper
-	AddressCodec   address.Codec
+	PoolKeeper     types.PoolKeeper `optional:"true"`
+	AddressCodec   coreaddress.Codec
 }
 
 type ModuleOutputs struct {
@@ -46,7 +51,22 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	// default to governance authority if not provided
+	authority := address.Module(types.GovModuleName)
+	if in.Config.Authority != "" {
+		if bz, err := in.AddressCodec.StringToBytes(in.Config.Authority); err == nil {
+			authority = bz
+		} else {
+			authority = address.Module(in.Config.Authority)
+		}
+	}
+
+	authStr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(fmt.Errorf("unable to decode authority in evidence: %w", err))
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.PoolKeeper, in.AddressCodec, authStr)
 	m := NewAppModule(in.Cdc, *k, in.CometService, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
@@ -3,6 +3,8 @@ package exported
 import (
 	"github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/core/address"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,7 +28,7 @@ type ValidatorEvidence interface {
 	Evidence
 
 	// The consensus address of the malicious validator at time of infraction
-	GetConsensusAddress() sdk.ConsAddress
+	GetConsensusAddress(consAc address.Codec) sdk.ConsAddress
 
 	// The total power of the malicious validator at time of infraction
 	GetValidatorPower() int64
@@ -19,6 +19,10 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 		return fmt.Errorf("failed to validate %s genesis state: %s", types.ModuleName, err)
 	}
 
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -37,8 +41,13 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 
 // ExportGenesis returns the evidence module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, error) {
-	gs := new(types.GenesisState)
-	err := k.Evidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &types.GenesisState{Params: params}
+	err = k.Evidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
 		anyEvi, err := codectypes.NewAnyWithValue(value)
 		if err != nil {
 			return false, err
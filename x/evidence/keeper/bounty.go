@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubmitEvidenceWithBounty submits the given evidence as SubmitEvidence does.
+// When bounties are enabled, the submitter is then paid the bounty fraction of
+// the tokens slashed from the misbehaving validator while handling the
+// evidence, from the community pool and up to its balance. The paid bounty is
+// returned.
+//
+// No bounty is paid for self reports, that is if the submitter is the operator
+// of the misbehaving validator or one of its delegators.
+func (k Keeper) SubmitEvidenceWithBounty(ctx context.Context, submitter sdk.AccAddress, evidence exported.Evidence) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	valEvidence, ok := evidence.(exported.ValidatorEvidence)
+	if !ok || !params.BountyFraction.IsPositive() || k.poolKeeper == nil {
+		return nil, k.SubmitEvidence(ctx, evidence)
+	}
+
+	consAddr := valEvidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		// the evidence handler is responsible for rejecting evidence about
+		// unknown validators, there is nothing to pay a bounty from anyway.
+		return nil, k.SubmitEvidence(ctx, evidence)
+	}
+
+	selfReport, err := k.isSelfReport(ctx, submitter, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensBefore := validator.GetTokens()
+	if err := k.SubmitEvidence(ctx, evidence); err != nil {
+		return nil, err
+	}
+
+	if selfReport {
+		return nil, nil
+	}
+
+	validator, err = k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	slashed := tokensBefore.Sub(validator.GetTokens())
+	if !slashed.IsPositive() {
+		return nil, nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	communityPool, err := k.poolKeeper.GetCommunityPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := params.BountyFraction.MulInt(slashed).TruncateInt()
+	if available := communityPool.AmountOf(bondDenom); amount.GT(available) {
+		amount = available
+	}
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	bounty := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+	if err := k.poolKeeper.DistributeFromCommunityPool(ctx, bounty, submitter); err != nil {
+		return nil, err
+	}
+
+	submitterStr, err := k.addressCodec.BytesToString(submitter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEvidenceBounty,
+		event.NewAttribute(types.AttributeKeySubmitter, submitterStr),
+		event.NewAttribute(types.AttributeKeyAmount, bounty.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return bounty, nil
+}
+
+// isSelfReport returns true if the submitter is the operator of the given
+// validator or one of its delegators.
+func (k Keeper) isSelfReport(ctx context.Context, submitter sdk.AccAddress, validator sdk.ValidatorI) (bool, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return false, err
+	}
+
+	if bytes.Equal(valAddr, submitter) {
+		return true, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegation(ctx, submitter, valAddr); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -27,17 +27,24 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+	poolKeeper     types.PoolKeeper
 	addressCodec   address.Codec
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// Params key: ParamsKey | value: Params
+	Params collections.Item[types.Params]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec,
+	slashingKeeper types.SlashingKeeper, poolKeeper types.PoolKeeper, ac address.Codec, authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -45,8 +52,11 @@ func NewKeeper(
 		cdc:            cdc,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
+		poolKeeper:     poolKeeper,
 		addressCodec:   ac,
+		authority:      authority,
 		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,6 +66,11 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetRouter sets the Evidence Handler router for the x/evidence module. Note,
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -82,6 +83,8 @@ type KeeperTestSuite struct {
 	accountKeeper  *evidencetestutil.MockAccountKeeper
 	slashingKeeper *evidencetestutil.MockSlashingKeeper
 	stakingKeeper  *evidencetestutil.MockStakingKeeper
+	poolKeeper     *evidencetestutil.MockPoolKeeper
+	authority      string
 	queryClient    types.QueryClient
 	encCfg         moduletestutil.TestEncodingConfig
 	msgServer      types.MsgServer
@@ -102,23 +105,32 @@ func (suite *KeeperTestSuite) SetupTest() {
 	stakingKeeper := evidencetestutil.NewMockStakingKeeper(ctrl)
 	slashingKeeper := evidencetestutil.NewMockSlashingKeeper(ctrl)
 	accountKeeper := evidencetestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := evidencetestutil.NewMockPoolKeeper(ctrl)
+
+	authority, err := suite.addressCodec.BytesToString(sdkaddress.Module(types.GovModuleName))
+	suite.Require().NoError(err)
 
 	evidenceKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		env,
 		stakingKeeper,
 		slashingKeeper,
+		poolKeeper,
 		address.NewBech32Codec("cosmos"),
+		authority,
 	)
 
 	suite.stakingKeeper = stakingKeeper
 	suite.slashingKeeper = slashingKeeper
+	suite.poolKeeper = poolKeeper
+	suite.authority = authority
 
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
 	evidenceKeeper.SetRouter(router)
 
 	suite.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+	suite.Require().NoError(evidenceKeeper.Params.Set(suite.ctx, types.DefaultParams()))
 	suite.encCfg = moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, evidence.AppModule{})
 
 	suite.accountKeeper = accountKeeper
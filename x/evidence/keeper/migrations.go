@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/evidence/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/evidence module state from the consensus version
+// 1 to version 2. Specifically, it sets the default module parameters, which
+// disable evidence bounties.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	return m.keeper.Params.Set(ctx, types.DefaultParams())
+}
@@ -23,7 +23,8 @@ var _ types.MsgServer = msgServer{}
 
 // SubmitEvidence implements the MsgServer.SubmitEvidence method.
 func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvidence) (*types.MsgSubmitEvidenceResponse, error) {
-	if _, err := ms.addressCodec.StringToBytes(msg.Submitter); err != nil {
+	submitter, err := ms.addressCodec.StringToBytes(msg.Submitter)
+	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
 	}
 
@@ -36,7 +37,7 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		return nil, errors.Wrapf(types.ErrInvalidEvidence, "failed basic validation: %s", err)
 	}
 
-	if err := ms.Keeper.SubmitEvidence(ctx, evidence); err != nil {
+	if _, err := ms.Keeper.SubmitEvidenceWithBounty(ctx, submitter, evidence); err != nil {
 		return nil, err
 	}
 
@@ -44,3 +45,20 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		Hash: evidence.Hash(),
 	}, nil
 }
+
+// UpdateParams implements the MsgServer.UpdateParams method.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
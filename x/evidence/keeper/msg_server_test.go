@@ -1,11 +1,18 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestSubmitEvidence() {
@@ -82,3 +89,161 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+// bountyValidator is a sdk.ValidatorI exposing only the operator and tokens,
+// which are the validator fields used to pay evidence bounties.
+type bountyValidator struct {
+	sdk.ValidatorI
+	operator string
+	tokens   math.Int
+}
+
+func (v bountyValidator) GetOperator() string { return v.operator }
+func (v bountyValidator) GetTokens() math.Int { return v.tokens }
+
+func (s *KeeperTestSuite) TestSubmitEvidenceBounty() {
+	pk := ed25519.GenPrivKey()
+	consAddr, err := s.consAddressCodec.BytesToString(pk.PubKey().Address())
+	s.Require().NoError(err)
+
+	valOperator, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddress)
+	s.Require().NoError(err)
+
+	submitter := sdk.AccAddress(pubkeys[1].Address())
+	selfReporter := sdk.AccAddress(valAddress)
+
+	newMsg := func(height int64, submitter sdk.AccAddress) *types.MsgSubmitEvidence {
+		submitterStr, err := s.addressCodec.BytesToString(submitter)
+		s.Require().NoError(err)
+
+		msg, err := types.NewMsgSubmitEvidence(submitterStr, &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: consAddr,
+		})
+		s.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSubmitEvidence
+		delegator bool
+		pool      sdk.Coins
+		expBounty sdk.Coins
+	}{
+		{
+			name:      "bounty paid to submitter",
+			msg:       newMsg(1, submitter),
+			pool:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			expBounty: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		{
+			name:      "bounty capped to community pool balance",
+			msg:       newMsg(3, submitter),
+			pool:      sdk.NewCoins(sdk.NewInt64Coin("stake", 4), sdk.NewInt64Coin("other", 1000)),
+			expBounty: sdk.NewCoins(sdk.NewInt64Coin("stake", 4)),
+		},
+		{
+			name: "no bounty for self report by the operator",
+			msg:  newMsg(5, selfReporter),
+		},
+		{
+			name:      "no bounty for self report by a delegator",
+			msg:       newMsg(7, submitter),
+			delegator: true,
+		},
+	}
+
+	params := types.NewParams(math.LegacyNewDecWithPrec(1, 1))
+	s.Require().NoError(s.evidenceKeeper.Params.Set(s.ctx, params))
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(s.consAddressCodec).AnyTimes()
+			s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			submitter, err := s.addressCodec.StringToBytes(tc.msg.Submitter)
+			s.Require().NoError(err)
+
+			selfReport := tc.delegator || bytes.Equal(submitter, selfReporter)
+			before := s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), gomock.Any()).Return(bountyValidator{operator: valOperator, tokens: math.NewInt(1000)}, nil)
+			if !selfReport {
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), gomock.Any()).Return(bountyValidator{operator: valOperator, tokens: math.NewInt(900)}, nil).After(before)
+			}
+
+			switch {
+			case tc.delegator:
+				s.stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(submitter), valAddress).Return(nil, nil)
+			case !bytes.Equal(submitter, selfReporter):
+				s.stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(submitter), valAddress).Return(nil, collections.ErrNotFound)
+			}
+
+			if !tc.expBounty.IsZero() {
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake", nil)
+				s.poolKeeper.EXPECT().GetCommunityPool(gomock.Any()).Return(tc.pool, nil)
+				s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), tc.expBounty, sdk.AccAddress(submitter)).Return(nil)
+			}
+
+			_, err = s.msgServer.SubmitEvidence(s.ctx, tc.msg)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.DefaultParams(),
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "negative bounty fraction",
+			req: &types.MsgUpdateParams{
+				Authority: s.authority,
+				Params:    types.NewParams(math.LegacyNewDec(-1)),
+			},
+			expErrMsg: "bounty fraction cannot be negative",
+		},
+		{
+			name: "bounty fraction greater than one",
+			req: &types.MsgUpdateParams{
+				Authority: s.authority,
+				Params:    types.NewParams(math.LegacyNewDec(2)),
+			},
+			expErrMsg: "bounty fraction too large",
+		},
+		{
+			name: "valid params",
+			req: &types.MsgUpdateParams{
+				Authority: s.authority,
+				Params:    types.NewParams(math.LegacyNewDecWithPrec(5, 2)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			params, err := s.evidenceKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -13,20 +14,32 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence       = "evidence"
+	bountyFraction = "bounty_fraction"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenBountyFraction randomized BountyFraction, between 0 and 10%.
+func GenBountyFraction(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
 
 	simState.AppParams.GetOrGenerate(evidence, &ev, simState.Rand, func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) })
 
+	var bounty sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(bountyFraction, &bounty, simState.Rand, func(r *rand.Rand) { bounty = GenBountyFraction(r) })
+
 	evidenceGenesis := types.NewGenesisState(ev)
+	evidenceGenesis.Params = types.NewParams(bounty)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
@@ -3,6 +3,9 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeEvidenceBounty = "evidence_bounty"
 
 	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeySubmitter    = "submitter"
+	AttributeKeyAmount       = "amount"
 )
@@ -15,7 +15,10 @@ import (
 // Evidence type constants
 const RouteEquivocation = "equivocation"
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence          = &Equivocation{}
+	_ exported.ValidatorEvidence = &Equivocation{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -75,8 +76,50 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the parameters of the x/evidence module.
+type Params struct {
+	// bounty_fraction is the fraction of the tokens slashed as a result of a
+	// submitted evidence paid to its submitter, from the community pool. Bounties
+	// are disabled if zero.
+	BountyFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=bounty_fraction,json=bountyFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bounty_fraction"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -84,31 +127,61 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xcd, 0x6e, 0xd3, 0x30,
+	0x1c, 0x8f, 0xd9, 0xa8, 0x34, 0xf3, 0xd9, 0xa8, 0x82, 0x50, 0x20, 0xa9, 0x26, 0x84, 0xaa, 0x49,
+	0xb5, 0xb7, 0x21, 0xed, 0x30, 0xc4, 0x81, 0x6a, 0xe3, 0xc4, 0x01, 0x15, 0x4e, 0x5c, 0x2a, 0xc7,
+	0xf1, 0x52, 0x6b, 0x24, 0x0e, 0xb1, 0x13, 0xe8, 0x1b, 0x20, 0x4e, 0x7b, 0x84, 0x71, 0xdb, 0x71,
+	0x87, 0x3e, 0xc4, 0x8e, 0x53, 0x4f, 0x88, 0xc3, 0x40, 0xe9, 0x61, 0x7b, 0x0c, 0x14, 0xdb, 0x0b,
+	0xe5, 0x12, 0xf9, 0xff, 0xf3, 0xef, 0xeb, 0x1f, 0xc3, 0xe7, 0x54, 0xc8, 0x44, 0x48, 0xcc, 0x4a,
+	0x1e, 0xb1, 0x94, 0x32, 0x5c, 0x6e, 0x85, 0x4c, 0x91, 0xad, 0x06, 0x40, 0x59, 0x2e, 0x94, 0x70,
+	0x1f, 0x1a, 0x1e, 0x6a, 0x60, 0xcb, 0xeb, 0xb6, 0x49, 0xc2, 0x53, 0x81, 0xf5, 0xd7, 0x70, 0xbb,
+	0x9d, 0x58, 0xc4, 0x42, 0x1f, 0x71, 0x7d, 0xb2, 0x68, 0x10, 0x0b, 0x11, 0x7f, 0x62, 0x58, 0x4f,
+	0x61, 0x71, 0x80, 0x15, 0x4f, 0x98, 0x54, 0x24, 0xc9, 0x2c, 0xe1, 0x91, 0x89, 0x18, 0x1b, 0xa5,
+	0xcd, 0xd3, 0xc3, 0xfa, 0x15, 0x80, 0xb7, 0xf7, 0x3f, 0x17, 0xbc, 0x14, 0x94, 0x28, 0x2e, 0x52,
+	0xf7, 0x01, 0x6c, 0x4d, 0x18, 0x8f, 0x27, 0xca, 0x03, 0x3d, 0xd0, 0x5f, 0x19, 0xd9, 0xc9, 0x7d,
+	0x05, 0x57, 0x6b, 0x5b, 0xef, 0x46, 0x0f, 0xf4, 0x6f, 0x6d, 0x77, 0x91, 0xc9, 0x44, 0xd7, 0x99,
+	0xe8, 0xc3, 0x75, 0xe6, 0xf0, 0xce, 0xd9, 0x45, 0xe0, 0x1c, 0xfd, 0x0e, 0xc0, 0xc9, 0xe5, 0xe9,
+	0x06, 0x18, 0x69, 0x99, 0xdb, 0x81, 0x37, 0x33, 0xf1, 0x85, 0xe5, 0xde, 0x8a, 0x76, 0x35, 0x83,
+	0xbb, 0x0f, 0xdb, 0x54, 0xa4, 0x92, 0xa5, 0xb2, 0x90, 0x63, 0x12, 0x45, 0x39, 0x93, 0xd2, 0x5b,
+	0xed, 0x81, 0xfe, 0xda, 0xd0, 0x9b, 0xcf, 0x06, 0x1d, 0x5b, 0xf5, 0xb5, 0xb9, 0x79, 0xaf, 0x72,
+	0x9e, 0xc6, 0xa3, 0xfb, 0x8d, 0xc4, 0xe2, 0xbb, 0xcf, 0xbe, 0x1d, 0x07, 0xce, 0xd5, 0x71, 0xe0,
+	0x7c, 0xbf, 0x3c, 0xdd, 0xb0, 0xff, 0x73, 0x20, 0xa3, 0x43, 0xbc, 0xbc, 0xd9, 0xfa, 0x0f, 0x00,
+	0x5b, 0xef, 0x48, 0x4e, 0x12, 0xe9, 0x8e, 0xe1, 0xbd, 0x50, 0x14, 0xa9, 0x9a, 0x8e, 0x0f, 0x72,
+	0x42, 0xeb, 0x5b, 0xbd, 0xed, 0xda, 0x70, 0xa7, 0xee, 0xfe, 0xeb, 0x22, 0x78, 0x6c, 0x4c, 0x64,
+	0x74, 0x88, 0xb8, 0xc0, 0x09, 0x51, 0x13, 0xf4, 0x96, 0xc5, 0x84, 0x4e, 0xf7, 0x18, 0x9d, 0xcf,
+	0x06, 0xd0, 0x16, 0xdb, 0x63, 0xd4, 0x2c, 0x79, 0xd7, 0xd8, 0xbd, 0xb1, 0x6e, 0xbb, 0x3b, 0xf3,
+	0xd9, 0xa0, 0xfd, 0xb5, 0x79, 0xea, 0x5e, 0xb9, 0x89, 0xb6, 0xd1, 0x66, 0x5d, 0xef, 0xc9, 0x52,
+	0xbd, 0x7f, 0x04, 0x6c, 0x8a, 0x0d, 0x5f, 0x9e, 0x54, 0x3e, 0x38, 0xab, 0x7c, 0x70, 0x5e, 0xf9,
+	0xe0, 0x4f, 0xe5, 0x83, 0xa3, 0x85, 0xef, 0x9c, 0x2f, 0x7c, 0xe7, 0xe7, 0xc2, 0x77, 0x3e, 0x3e,
+	0xfd, 0xaf, 0xd5, 0x92, 0x5a, 0x4d, 0x33, 0x26, 0xc3, 0x96, 0x7e, 0x8c, 0x17, 0x7f, 0x03, 0x00,
+	0x00, 0xff, 0xff, 0xc1, 0x69, 0x66, 0xbb, 0x7a, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BountyFraction.Equal(that1.BountyFraction) {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +230,39 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BountyFraction.Size()
+		i -= size
+		if _, err := m.BountyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +295,17 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BountyFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +465,90 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BountyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,7 +16,10 @@ import (
 // evidence module.
 type StakingKeeper interface {
 	ConsensusAddressCodec() address.Codec
+	ValidatorAddressCodec() address.Codec
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error)
+	BondDenom(ctx context.Context) (string, error)
 }
 
 // SlashingKeeper defines the slashing module interface contract needed by the
@@ -33,6 +36,13 @@ type SlashingKeeper interface {
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 }
 
+// PoolKeeper defines the community pool interface contract needed by the
+// evidence module to pay evidence bounties.
+type PoolKeeper interface {
+	GetCommunityPool(ctx context.Context) (sdk.Coins, error)
+	DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
 // AccountKeeper define the account keeper interface contracted needed by the evidence module
 type AccountKeeper interface {
 	SetAccount(ctx context.Context, acc sdk.AccountI)
@@ -28,6 +28,7 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 	}
 	return &GenesisState{
 		Evidence: evidence,
+		Params:   DefaultParams(),
 	}
 }
 
@@ -35,12 +36,17 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Evidence: []*types.Any{},
+		Params:   DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -104,10 +105,19 @@ func TestGenesisStateValidate(t *testing.T) {
 			func() {
 				genesisState = &types.GenesisState{
 					Evidence: []*codectypes.Any{{}},
+					Params:   types.DefaultParams(),
 				}
 			},
 			false,
 		},
+		{
+			"invalid params",
+			func() {
+				genesisState = types.DefaultGenesisState()
+				genesisState.Params = types.NewParams(math.LegacyNewDecWithPrec(11, 1))
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
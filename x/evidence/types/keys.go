@@ -8,9 +8,14 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	GovModuleName = "gov"
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = collections.NewPrefix(0)
+	ParamsKey         = collections.NewPrefix(1)
 )
@@ -1,9 +1,43 @@
 package types
 
 import (
+	"fmt"
 	"time"
+
+	"cosmossdk.io/math"
 )
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// DefaultBountyFraction is the default fraction of the slashed tokens paid to
+// the submitter of an evidence. Bounties are disabled by default.
+var DefaultBountyFraction = math.LegacyZeroDec()
+
+// NewParams creates a new Params object.
+func NewParams(bountyFraction math.LegacyDec) Params {
+	return Params{
+		BountyFraction: bountyFraction,
+	}
+}
+
+// DefaultParams returns the default parameters of the evidence module.
+func DefaultParams() Params {
+	return NewParams(DefaultBountyFraction)
+}
+
+// Validate performs basic validation of the evidence module parameters.
+func (p Params) Validate() error {
+	if p.BountyFraction.IsNil() {
+		return fmt.Errorf("bounty fraction cannot be nil")
+	}
+	if p.BountyFraction.IsNegative() {
+		return fmt.Errorf("bounty fraction cannot be negative: %s", p.BountyFraction)
+	}
+	if p.BountyFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("bounty fraction too large: %s", p.BountyFraction)
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -237,11 +239,96 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -249,36 +336,44 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x99, 0xfa, 0x91, 0xeb, 0xdc, 0x6b, 0x4c, 0xc6, 0x6b, 0x2e, 0xb7, 0xd1, 0x06, 0x4b,
-	0x22, 0x48, 0xc2, 0x4c, 0x41, 0x13, 0xd6, 0x90, 0xf8, 0xb5, 0xd3, 0x2e, 0xdd, 0x90, 0x29, 0x8c,
-	0x6d, 0x03, 0xce, 0x14, 0xa6, 0x10, 0x89, 0x71, 0xe3, 0x13, 0x98, 0x18, 0x57, 0xc6, 0xb7, 0xf0,
-	0x15, 0x4c, 0x5c, 0x92, 0xb8, 0x31, 0xae, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x29, 0x20, 0x1f, 0xa2,
-	0xcb, 0xe9, 0xf9, 0x9f, 0xff, 0xff, 0xd7, 0x73, 0x0e, 0x2c, 0x76, 0x84, 0x7c, 0x29, 0x24, 0x61,
-	0xe3, 0xb8, 0xcb, 0x78, 0x87, 0x91, 0x71, 0x2d, 0x60, 0x29, 0xad, 0x91, 0xc1, 0x88, 0x0d, 0x27,
-	0x38, 0x19, 0x8a, 0x54, 0xa0, 0x33, 0x2d, 0xc2, 0x99, 0x08, 0x1b, 0x91, 0x7d, 0xae, 0x0b, 0x6d,
-	0x25, 0x23, 0x46, 0xa5, 0x1e, 0x76, 0xc5, 0x18, 0x07, 0x54, 0x32, 0x6d, 0xb6, 0xb4, 0x4e, 0x68,
-	0x18, 0x73, 0x9a, 0xc6, 0x82, 0x1b, 0xed, 0x79, 0x28, 0x44, 0xd8, 0x67, 0x44, 0xbd, 0x82, 0xd1,
-	0x0b, 0x42, 0xb9, 0x89, 0xb6, 0x6f, 0x9a, 0x12, 0x4d, 0x62, 0x42, 0x39, 0x17, 0xa9, 0xea, 0x33,
-	0x21, 0x6e, 0x04, 0x4f, 0x9f, 0x2d, 0xac, 0x1f, 0x18, 0x30, 0x9f, 0x0d, 0x46, 0x4c, 0xa6, 0xa8,
-	0x04, 0xaf, 0x66, 0xac, 0xed, 0x88, 0xca, 0x28, 0x0f, 0x0a, 0xa0, 0x7c, 0xd2, 0xb2, 0xf2, 0xc0,
-	0x3f, 0xc9, 0x0a, 0x8f, 0xa9, 0x8c, 0x50, 0x09, 0x5e, 0x54, 0x75, 0xab, 0x00, 0xca, 0x57, 0x5a,
-	0xd7, 0x7f, 0x7c, 0xae, 0x5e, 0xd3, 0xdc, 0x55, 0xd9, 0xed, 0x15, 0x3c, 0x7c, 0xbf, 0xe1, 0x2b,
-	0x81, 0xfb, 0x04, 0xde, 0xd8, 0x48, 0x92, 0x89, 0xe0, 0x92, 0x21, 0x0f, 0x1e, 0x65, 0x8e, 0x2a,
-	0xe5, 0xb8, 0x7e, 0x8a, 0x35, 0x33, 0xce, 0x7e, 0x07, 0x37, 0xf9, 0xc4, 0x5f, 0xaa, 0x5c, 0x0a,
-	0xcf, 0x94, 0x55, 0xb3, 0xdf, 0xdf, 0xe4, 0x7e, 0x08, 0xe1, 0x6a, 0x38, 0xc6, 0xee, 0x0e, 0x36,
-	0x73, 0x5d, 0x4c, 0x12, 0xeb, 0xb5, 0x98, 0x49, 0xe2, 0xa7, 0x34, 0xcc, 0x7a, 0xfd, 0xb5, 0x4e,
-	0xf7, 0x03, 0x80, 0xf9, 0xed, 0x8c, 0x9d, 0xc4, 0x17, 0x0e, 0x13, 0xa3, 0x47, 0x7f, 0x60, 0x59,
-	0x0a, 0xab, 0x74, 0x10, 0x4b, 0xc7, 0xad, 0x73, 0xd5, 0xbf, 0x58, 0xf0, 0x92, 0xe2, 0x42, 0x1f,
-	0x01, 0x3c, 0xca, 0xc8, 0x50, 0x15, 0xef, 0x39, 0x30, 0xbc, 0x6b, 0xbb, 0x36, 0xfe, 0x57, 0xb9,
-	0x26, 0x70, 0xbd, 0xb7, 0xdf, 0x7e, 0xbd, 0xb7, 0x2a, 0xa8, 0x4c, 0xf6, 0x1d, 0xfb, 0xf2, 0xc3,
-	0xeb, 0xc5, 0xb2, 0xdf, 0xa0, 0x4f, 0x00, 0x1e, 0xaf, 0x8d, 0x0e, 0x79, 0x7f, 0x4f, 0xdc, 0xde,
-	0xa4, 0x5d, 0xfb, 0x8f, 0x0e, 0x83, 0x79, 0x57, 0x61, 0x16, 0xd1, 0xed, 0x83, 0x98, 0xad, 0xc6,
-	0xd7, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x9c, 0x39, 0xe0, 0xdd, 0xdc, 0xc9, 0x4d, 0xe7, 0x4e,
-	0xee, 0xfb, 0xdc, 0xc9, 0x3d, 0xbf, 0xa5, 0x7b, 0x65, 0xb7, 0x87, 0x63, 0x41, 0x5e, 0xad, 0x3c,
-	0xd2, 0x49, 0xc2, 0x64, 0x70, 0x59, 0x6d, 0xf8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76,
-	0x21, 0xac, 0x55, 0xf7, 0x03, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xa9, 0x86, 0x76, 0x5a, 0x91, 0x4e, 0x53, 0x9a, 0x2e, 0xba, 0x49, 0xb7, 0xd0,
+	0xc4, 0xd4, 0xcc, 0x6c, 0xa2, 0x50, 0x10, 0x2f, 0x0d, 0xf8, 0xeb, 0x56, 0xf7, 0xe8, 0xa5, 0x4c,
+	0x9a, 0x71, 0xb3, 0x34, 0xd9, 0xd9, 0x66, 0x36, 0xc1, 0x20, 0x5e, 0xfc, 0x0b, 0x04, 0xf1, 0x24,
+	0x1e, 0xbc, 0x79, 0xf4, 0xd0, 0x3f, 0xa2, 0x78, 0x2a, 0x7a, 0x11, 0x0f, 0x22, 0x89, 0x20, 0xfe,
+	0x17, 0x92, 0x99, 0xd9, 0xb4, 0x69, 0xb3, 0x4d, 0xbd, 0x84, 0xcc, 0xbc, 0xef, 0x7b, 0xdf, 0xcf,
+	0xbc, 0xf7, 0x16, 0xae, 0xef, 0x71, 0xd1, 0xe2, 0x82, 0xb0, 0xae, 0x57, 0x67, 0xfe, 0x1e, 0x23,
+	0xdd, 0x72, 0x8d, 0x85, 0xb4, 0x4c, 0x0e, 0x3a, 0xac, 0xdd, 0xc3, 0x41, 0x9b, 0x87, 0x1c, 0xad,
+	0x28, 0x11, 0x8e, 0x44, 0x58, 0x8b, 0x8c, 0x55, 0x15, 0xd8, 0x95, 0x32, 0xa2, 0x55, 0xf2, 0x60,
+	0x14, 0x75, 0xe1, 0x1a, 0x15, 0x4c, 0x15, 0x1b, 0x95, 0x0e, 0xa8, 0xeb, 0xf9, 0x34, 0xf4, 0xb8,
+	0xaf, 0xb5, 0xab, 0x2e, 0xe7, 0x6e, 0x93, 0x11, 0x79, 0xaa, 0x75, 0x9e, 0x13, 0xea, 0x6b, 0x6b,
+	0xe3, 0x86, 0x0e, 0xd1, 0xc0, 0x23, 0xd4, 0xf7, 0x79, 0x28, 0xf3, 0x22, 0x93, 0xb4, 0xcb, 0x5d,
+	0xae, 0xcc, 0x87, 0xff, 0xf4, 0xed, 0x22, 0x6d, 0x79, 0x3e, 0x27, 0xf2, 0x57, 0x5f, 0x6d, 0xc4,
+	0x3d, 0x73, 0xf4, 0x24, 0xa9, 0xb3, 0x1a, 0x30, 0xfd, 0x74, 0xc8, 0xfa, 0x40, 0x5f, 0x3b, 0xec,
+	0xa0, 0xc3, 0x44, 0x88, 0xf2, 0xf0, 0x5a, 0xa4, 0xdc, 0x6d, 0x50, 0xd1, 0xc8, 0x80, 0x1c, 0x28,
+	0x2c, 0x54, 0x93, 0x19, 0xe0, 0x2c, 0x44, 0x81, 0xc7, 0x54, 0x34, 0x50, 0x1e, 0x5e, 0x91, 0xf1,
+	0x64, 0x0e, 0x14, 0xe6, 0xaa, 0x4b, 0x3f, 0x0e, 0x4b, 0xd7, 0x95, 0x75, 0x49, 0xd4, 0xf7, 0x73,
+	0x36, 0xbe, 0xbb, 0xe5, 0x48, 0x81, 0xf5, 0x04, 0x2e, 0x9f, 0x71, 0x12, 0x01, 0xf7, 0x05, 0x43,
+	0x36, 0x9c, 0x8d, 0x2a, 0x4a, 0x97, 0xf9, 0x4a, 0x1a, 0xab, 0x26, 0xe0, 0xa8, 0x3f, 0x78, 0xdb,
+	0xef, 0x39, 0x23, 0x95, 0x45, 0xe1, 0x8a, 0x2c, 0xb5, 0xdd, 0x6c, 0x9e, 0xe5, 0x7e, 0x08, 0xe1,
+	0x49, 0xb7, 0x75, 0xb9, 0x0d, 0xac, 0x07, 0x35, 0x1c, 0x0d, 0x56, 0x73, 0xd6, 0xed, 0xc0, 0x3b,
+	0xd4, 0x8d, 0x72, 0x9d, 0x53, 0x99, 0xd6, 0x3b, 0x00, 0x33, 0xe7, 0x3d, 0x26, 0x12, 0xcf, 0x4c,
+	0x27, 0x46, 0x8f, 0xc6, 0xb0, 0x92, 0x12, 0x2b, 0x3f, 0x15, 0x4b, 0xd9, 0x8d, 0x71, 0x6d, 0x42,
+	0x24, 0xb1, 0x76, 0x68, 0x9b, 0xb6, 0x84, 0x26, 0xbf, 0xb7, 0xfc, 0xf5, 0xb0, 0xb4, 0xf8, 0x62,
+	0x34, 0xdb, 0x5c, 0xd7, 0xc6, 0x15, 0x6c, 0x5b, 0x01, 0x5c, 0x1a, 0x13, 0x6b, 0xfc, 0x2a, 0x4c,
+	0x05, 0xf2, 0x46, 0xf7, 0x27, 0x8b, 0x63, 0xd6, 0x1d, 0xab, 0xc4, 0xea, 0xdc, 0xd1, 0xcf, 0x6c,
+	0xe2, 0xd3, 0x9f, 0xcf, 0x45, 0xe0, 0xe8, 0xcc, 0x18, 0xc7, 0xca, 0xdf, 0x19, 0x78, 0x55, 0x5a,
+	0xa2, 0xf7, 0x00, 0xce, 0x46, 0x8d, 0x43, 0xa5, 0x58, 0x87, 0x49, 0xcb, 0x67, 0xe0, 0xcb, 0xca,
+	0xd5, 0x83, 0x2c, 0xfb, 0xf5, 0xb7, 0xdf, 0x6f, 0x93, 0x45, 0x54, 0x20, 0xd3, 0xb6, 0x9e, 0xbc,
+	0x1c, 0xee, 0xe2, 0x2b, 0xf4, 0x01, 0xc0, 0xf9, 0x53, 0x93, 0x45, 0xf6, 0xc5, 0x8e, 0xe7, 0x17,
+	0xcd, 0x28, 0xff, 0x47, 0x86, 0xc6, 0xbc, 0x25, 0x31, 0xd7, 0xd1, 0xda, 0x54, 0x4c, 0xf4, 0x11,
+	0xc0, 0x94, 0x6a, 0x3e, 0xda, 0xbc, 0xd8, 0x68, 0x6c, 0x11, 0x8c, 0xdb, 0x97, 0x13, 0x6b, 0xa0,
+	0xfb, 0x5f, 0x26, 0x0d, 0x51, 0x52, 0xae, 0xa1, 0x6c, 0x2c, 0xa5, 0x5a, 0x81, 0xea, 0xd6, 0x51,
+	0xdf, 0x04, 0xc7, 0x7d, 0x13, 0xfc, 0xea, 0x9b, 0xe0, 0xcd, 0xc0, 0x4c, 0x1c, 0x0f, 0xcc, 0xc4,
+	0xf7, 0x81, 0x99, 0x78, 0x76, 0x53, 0x65, 0x8a, 0xfa, 0x3e, 0xf6, 0x38, 0x39, 0x31, 0x20, 0x61,
+	0x2f, 0x60, 0xa2, 0x96, 0x92, 0x1f, 0xc9, 0x9d, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xf9,
+	0xaa, 0x39, 0x8b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +392,8 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -325,12 +422,23 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -343,6 +451,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -384,6 +495,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -396,6 +525,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -557,6 +690,62 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -630,6 +819,26 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1044,6 +1253,139 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
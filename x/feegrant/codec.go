@@ -14,6 +14,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateFeePool{}, "cosmos-sdk/MsgCreateFeePool")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeePoolGrantees{}, "cosmos-sdk/MsgUpdateFeePoolGrantees")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeFeePool{}, "cosmos-sdk/MsgRevokeFeePool")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
@@ -26,6 +29,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateFeePool{},
+		&MsgUpdateFeePoolGrantees{},
+		&MsgRevokeFeePool{},
 	)
 
 	registrar.RegisterInterface(
@@ -7,6 +7,9 @@ const (
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
+	EventTypeSetFeePool     = "set_fee_pool"
+	EventTypeUpdateFeePool  = "update_fee_pool"
+	EventTypeRevokeFeePool  = "revoke_fee_pool"
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
@@ -0,0 +1,79 @@
+package feegrant
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Accept checks whether the fee pool can pay the fee of the grantee at the
+// given block time, against both the budget of the pool and the spend limit
+// per grantee, and records the fee as spent by the pool and the grantee.
+//
+// If it returns an error, the fee payment is rejected and neither the pool nor
+// the grantee are updated.
+func (p *FeePool) Accept(blockTime time.Time, grantee *FeePoolGrantee, fee sdk.Coins) error {
+	if p.Expiration != nil && p.Expiration.Before(blockTime) {
+		return errorsmod.Wrap(ErrFeeLimitExpired, "fee pool")
+	}
+
+	granteeSpent := grantee.Spent.Add(fee...)
+	if !p.GranteeSpendLimit.Empty() && !granteeSpent.IsAllLTE(p.GranteeSpendLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "fee pool grantee")
+	}
+
+	spent := p.Spent.Add(fee...)
+	if !p.SpendLimit.Empty() && !spent.IsAllLTE(p.SpendLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "fee pool")
+	}
+
+	p.Spent = spent
+	grantee.Spent = granteeSpent
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on the FeePool.
+func (p FeePool) ValidateBasic() error {
+	if p.Granter == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if !p.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", p.SpendLimit)
+	}
+	if !p.GranteeSpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "grantee spend limit is invalid: %s", p.GranteeSpendLimit)
+	}
+	if !p.Spent.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount is invalid: %s", p.Spent)
+	}
+
+	if p.Expiration != nil && p.Expiration.Unix() < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on the FeePoolGrantee.
+func (g FeePoolGrantee) ValidateBasic() error {
+	if g.Granter == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Grantee == g.Granter {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fees")
+	}
+
+	if !g.Spent.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount is invalid: %s", g.Spent)
+	}
+
+	return nil
+}
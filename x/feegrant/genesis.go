@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -25,6 +27,27 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	pools := make(map[string]bool, len(data.FeePools))
+	for _, pool := range data.FeePools {
+		if err := pool.ValidateBasic(); err != nil {
+			return err
+		}
+		if pools[pool.Granter] {
+			return fmt.Errorf("duplicate fee pool for granter %s", pool.Granter)
+		}
+		pools[pool.Granter] = true
+	}
+
+	for _, grantee := range data.FeePoolGrantees {
+		if err := grantee.ValidateBasic(); err != nil {
+			return err
+		}
+		if !pools[grantee.Granter] {
+			return fmt.Errorf("no fee pool for granter %s of grantee %s", grantee.Granter, grantee.Grantee)
+		}
+	}
+
 	return nil
 }
 
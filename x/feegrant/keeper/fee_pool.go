@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateFeePool creates the fee pool of the granter, shared by the given
+// grantees, or by any address if the pool is open.
+func (k Keeper) CreateFeePool(ctx context.Context, granter sdk.AccAddress, pool feegrant.FeePool, grantees []sdk.AccAddress) error {
+	has, err := k.FeePools.Has(ctx, granter)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee pool already exists")
+	}
+
+	if pool.Open && len(grantees) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "an open fee pool cannot have an allow-list")
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if pool.Expiration != nil && pool.Expiration.Before(now) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	if err := k.addFeePoolGrantees(ctx, granter, pool.Granter, grantees); err != nil {
+		return err
+	}
+
+	if err := k.FeePools.Set(ctx, granter, pool); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeSetFeePool,
+		event.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+	)
+}
+
+// UpdateFeePoolGrantees adds grantees to and removes grantees from the
+// allow-list of the fee pool of the granter. The grantees added back to the
+// allow-list keep the amount of coins they already spent from the pool.
+func (k Keeper) UpdateFeePoolGrantees(ctx context.Context, granter sdk.AccAddress, add, remove []sdk.AccAddress) error {
+	pool, err := k.FeePools.Get(ctx, granter)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(feegrant.ErrNoAllowance, "fee pool not found")
+		}
+		return err
+	}
+
+	// removing a grantee from an open pool would reset its spent amount.
+	if pool.Open {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the grantees of an open fee pool cannot be updated")
+	}
+
+	if err := k.addFeePoolGrantees(ctx, granter, pool.Granter, add); err != nil {
+		return err
+	}
+
+	for _, grantee := range remove {
+		if err := k.PoolGrantees.Remove(ctx, collections.Join(granter, grantee)); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeUpdateFeePool,
+		event.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+	)
+}
+
+// RevokeFeePool removes the fee pool of the granter, along with its grantees.
+func (k Keeper) RevokeFeePool(ctx context.Context, granter sdk.AccAddress) error {
+	pool, err := k.FeePools.Get(ctx, granter)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(feegrant.ErrNoAllowance, "fee pool not found")
+		}
+		return err
+	}
+
+	if err := k.FeePools.Remove(ctx, granter); err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter)
+	if err := k.PoolGrantees.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeRevokeFeePool,
+		event.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+	)
+}
+
+// addFeePoolGrantees adds the grantees to the allow-list of the fee pool of the
+// granter, skipping the grantees already in it.
+func (k Keeper) addFeePoolGrantees(ctx context.Context, granter sdk.AccAddress, granterStr string, grantees []sdk.AccAddress) error {
+	for _, grantee := range grantees {
+		if grantee.Equals(granter) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fees")
+		}
+	}
+
+	for _, grantee := range grantees {
+		key := collections.Join(granter, grantee)
+		has, err := k.PoolGrantees.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		granteeStr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PoolGrantees.Set(ctx, key, feegrant.FeePoolGrantee{Granter: granterStr, Grantee: granteeStr}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// useFeePool pays the fee of the grantee from the fee pool of the granter, if
+// the grantee is allowed to use the pool and both the budget of the pool and
+// the spend limit per grantee cover the fee.
+func (k Keeper) useFeePool(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	pool, err := k.FeePools.Get(ctx, granter)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(granter, grantee)
+	poolGrantee, err := k.PoolGrantees.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound) && pool.Open:
+		granteeStr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+		if err != nil {
+			return err
+		}
+		poolGrantee = feegrant.FeePoolGrantee{Granter: pool.Granter, Grantee: granteeStr}
+
+	case errors.Is(err, collections.ErrNotFound):
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "grantee is not allowed to use the fee pool")
+
+	case err != nil:
+		return err
+	}
+
+	if err := pool.Accept(k.HeaderService.HeaderInfo(ctx).Time, &poolGrantee, fee); err != nil {
+		return err
+	}
+
+	if err := k.FeePools.Set(ctx, granter, pool); err != nil {
+		return err
+	}
+	if err := k.PoolGrantees.Set(ctx, key, poolGrantee); err != nil {
+		return err
+	}
+
+	return k.emitUseGrantEvent(ctx, pool.Granter, poolGrantee.Grantee)
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestFeePool() {
+	granter := suite.encodedAddrs[0]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	_, err := suite.msgSrvr.CreateFeePool(suite.ctx, &feegrant.MsgCreateFeePool{
+		Granter:  granter,
+		Open:     true,
+		Grantees: []string{suite.encodedAddrs[1]},
+	})
+	suite.Require().ErrorContains(err, "an open fee pool cannot have an allow-list")
+
+	_, err = suite.msgSrvr.CreateFeePool(suite.ctx, &feegrant.MsgCreateFeePool{
+		Granter:  granter,
+		Grantees: []string{granter},
+	})
+	suite.Require().ErrorContains(err, "cannot self-grant fees")
+
+	_, err = suite.msgSrvr.CreateFeePool(suite.ctx, &feegrant.MsgCreateFeePool{
+		Granter:           granter,
+		SpendLimit:        sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		GranteeSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		Expiration:        &exp,
+		Grantees:          []string{suite.encodedAddrs[1], suite.encodedAddrs[2]},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.CreateFeePool(suite.ctx, &feegrant.MsgCreateFeePool{Granter: granter})
+	suite.Require().ErrorContains(err, "fee pool already exists")
+
+	// the grantees of the allow-list share the pool.
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, nil))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], fee, nil))
+
+	// the other addresses cannot use it.
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[3], fee, nil)
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	// the spend limit of a grantee is exceeded.
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, nil)
+	suite.Require().ErrorContains(err, "fee pool grantee")
+
+	// the budget of the pool is exceeded.
+	_, err = suite.msgSrvr.UpdateFeePoolGrantees(suite.ctx, &feegrant.MsgUpdateFeePoolGrantees{
+		Granter:        granter,
+		AddGrantees:    []string{suite.encodedAddrs[3]},
+		RemoveGrantees: []string{suite.encodedAddrs[2]},
+	})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[3], fee, nil)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), nil))
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	poolRes, err := suite.feegrantKeeper.FeePool(suite.ctx, &feegrant.QueryFeePoolRequest{Granter: granter})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), poolRes.FeePool.Spent)
+
+	granteesRes, err := suite.feegrantKeeper.FeePoolGrantees(suite.ctx, &feegrant.QueryFeePoolGranteesRequest{Granter: granter})
+	suite.Require().NoError(err)
+	suite.Require().Len(granteesRes.Grantees, 2)
+
+	// an allowance of the grantee takes precedence over the pool.
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: fee}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, nil))
+
+	// the pool expires.
+	expiredCtx := suite.ctx.WithHeaderInfo(header.Info{Time: exp.AddDate(0, 0, 1)})
+	err = suite.feegrantKeeper.UseGrantedFees(expiredCtx, suite.addrs[0], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExpired)
+
+	_, err = suite.msgSrvr.RevokeFeePool(suite.ctx, &feegrant.MsgRevokeFeePool{Granter: granter})
+	suite.Require().NoError(err)
+	granteesRes, err = suite.feegrantKeeper.FeePoolGrantees(suite.ctx, &feegrant.QueryFeePoolGranteesRequest{Granter: granter})
+	suite.Require().NoError(err)
+	suite.Require().Empty(granteesRes.Grantees)
+
+	_, err = suite.msgSrvr.RevokeFeePool(suite.ctx, &feegrant.MsgRevokeFeePool{Granter: granter})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+}
+
+func (suite *KeeperTestSuite) TestOpenFeePool() {
+	granter := suite.encodedAddrs[0]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	_, err := suite.msgSrvr.CreateFeePool(suite.ctx, &feegrant.MsgCreateFeePool{
+		Granter:           granter,
+		GranteeSpendLimit: fee,
+		Open:              true,
+	})
+	suite.Require().NoError(err)
+
+	// any address can use an open pool, within the spend limit per grantee.
+	for _, grantee := range suite.addrs[1:5] {
+		suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], grantee, fee, nil))
+		err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], grantee, fee, nil)
+		suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	}
+
+	_, err = suite.msgSrvr.UpdateFeePoolGrantees(suite.ctx, &feegrant.MsgUpdateFeePoolGrantees{
+		Granter:        granter,
+		RemoveGrantees: []string{suite.encodedAddrs[1]},
+	})
+	suite.Require().ErrorContains(err, "the grantees of an open fee pool cannot be updated")
+
+	// the fee pool is revoked along with the allowances of the granter when its
+	// account is removed.
+	suite.Require().NoError(suite.feegrantKeeper.Hooks().AfterAccountRemoved(suite.ctx, suite.addrs[0]))
+	_, err = suite.feegrantKeeper.FeePool(suite.ctx, &feegrant.QueryFeePoolRequest{Granter: granter})
+	suite.Require().ErrorContains(err, "no fee pool")
+}
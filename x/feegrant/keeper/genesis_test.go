@@ -71,14 +71,23 @@ func TestImportExportGenesis(t *testing.T) {
 	err := f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, granteeAddr, allowance)
 	assert.NilError(t, err)
 
-	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
-	assert.NilError(t, err)
-
 	granter, err := f.accountKeeper.AddressCodec().BytesToString(granterAddr.Bytes())
 	assert.NilError(t, err)
 	grantee, err := f.accountKeeper.AddressCodec().BytesToString(granteeAddr.Bytes())
 	assert.NilError(t, err)
 
+	_, err = msgSrvr.CreateFeePool(f.ctx, &feegrant.MsgCreateFeePool{
+		Granter:    granter,
+		SpendLimit: coins,
+		Grantees:   []string{grantee},
+	})
+	assert.NilError(t, err)
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(genesis.FeePools))
+	assert.Equal(t, 1, len(genesis.FeePoolGrantees))
+
 	// revoke fee allowance
 	_, err = msgSrvr.RevokeAllowance(f.ctx, &feegrant.MsgRevokeAllowance{
 		Granter: granter,
@@ -86,6 +95,9 @@ func TestImportExportGenesis(t *testing.T) {
 	})
 	assert.NilError(t, err)
 
+	_, err = msgSrvr.RevokeFeePool(f.ctx, &feegrant.MsgRevokeFeePool{Granter: granter})
+	assert.NilError(t, err)
+
 	err = f.feegrantKeeper.InitGenesis(f.ctx, genesis)
 	assert.NilError(t, err)
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -116,3 +117,48 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// FeePool queries the fee pool funded by the given granter.
+func (q Keeper) FeePool(ctx context.Context, req *feegrant.QueryFeePoolRequest) (*feegrant.QueryFeePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := q.FeePools.Get(ctx, granterAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no fee pool funded by %s", req.Granter)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolResponse{FeePool: &pool}, nil
+}
+
+// FeePoolGrantees queries the grantees of the fee pool funded by the given granter.
+func (q Keeper) FeePoolGrantees(ctx context.Context, req *feegrant.QueryFeePoolGranteesRequest) (*feegrant.QueryFeePoolGranteesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantees, pageRes, err := query.CollectionPaginate(ctx, q.PoolGrantees, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], grantee feegrant.FeePoolGrantee) (*feegrant.FeePoolGrantee, error) {
+			return &grantee, nil
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](granterAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolGranteesResponse{Grantees: grantees, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feegrant"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ authtypes.AccountHooks = Hooks{}
+
+// Hooks implements the account hooks of the feegrant module, revoking all the
+// fee allowances and the fee pool of a granter when the public key of its
+// account is changed or cleared, or when its account is removed.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the account hooks of the feegrant module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterAccountPubKeyChanged implements authtypes.AccountHooks.
+func (h Hooks) AfterAccountPubKeyChanged(ctx context.Context, addr sdk.AccAddress, _, _ cryptotypes.PubKey) error {
+	return h.k.revokeGranterAllowances(ctx, addr)
+}
+
+// AfterAccountRemoved implements authtypes.AccountHooks.
+func (h Hooks) AfterAccountRemoved(ctx context.Context, addr sdk.AccAddress) error {
+	return h.k.revokeGranterAllowances(ctx, addr)
+}
+
+// revokeGranterAllowances revokes all the fee allowances of the granter,
+// including its fee pool. As the allowances are keyed by grantee, all of them
+// are iterated over.
+func (k Keeper) revokeGranterAllowances(ctx context.Context, granter sdk.AccAddress) error {
+	if err := k.RevokeFeePool(ctx, granter); err != nil && !errors.Is(err, feegrant.ErrNoAllowance) {
+		return err
+	}
+
+	var grantees []sdk.AccAddress
+	err := k.FeeAllowance.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ feegrant.Grant) (stop bool, err error) {
+		if key.K2().Equals(granter) {
+			grantees = append(grantees, key.K1())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, grantee := range grantees {
+		if err := k.revokeAllowance(ctx, granter, grantee); err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
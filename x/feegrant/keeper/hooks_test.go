@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func (suite *KeeperTestSuite) TestAccountHooks() {
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}
+
+	// addrs[0] -> addrs[1], addrs[0] -> addrs[2], addrs[1] -> addrs[2]
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], basic))
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], basic))
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[1], suite.addrs[2], basic))
+
+	hooks := suite.feegrantKeeper.Hooks()
+
+	// rotating the public key of the granter revokes all its allowances
+	oldPubKey, newPubKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	suite.Require().NoError(hooks.AfterAccountPubKeyChanged(suite.ctx, suite.addrs[0], oldPubKey, newPubKey))
+
+	_, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().Error(err)
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().Error(err)
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[1], suite.addrs[2])
+	suite.Require().NoError(err)
+
+	// removing the account of the granter revokes all its allowances
+	suite.Require().NoError(hooks.AfterAccountRemoved(suite.ctx, suite.addrs[1]))
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[1], suite.addrs[2])
+	suite.Require().Error(err)
+
+	// revoking again is a no-op
+	suite.Require().NoError(hooks.AfterAccountRemoved(suite.ctx, suite.addrs[1]))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -30,6 +31,10 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// FeePools key: granter | value: FeePool
+	FeePools collections.Map[sdk.AccAddress, feegrant.FeePool]
+	// PoolGrantees key: granter+grantee | value: FeePoolGrantee
+	PoolGrantees collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.FeePoolGrantee]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +61,20 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		FeePools: collections.NewMap(
+			sb,
+			feegrant.FeePoolKeyPrefix,
+			"fee_pools",
+			sdk.AccAddressKey,
+			codec.CollValue[feegrant.FeePool](cdc),
+		),
+		PoolGrantees: collections.NewMap(
+			sb,
+			feegrant.FeePoolGranteeKeyPrefix,
+			"fee_pool_grantees",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[feegrant.FeePoolGrantee](cdc),
+		),
 	}
 }
 
@@ -216,9 +235,21 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the grantee has no allowance from the granter, the fee is paid by the fee pool of the granter, if any.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
+	if errors.Is(err, collections.ErrNotFound) {
+		// without an allowance of its own, the grantee can be paid for by the
+		// fee pool of the granter.
+		hasPool, poolErr := k.FeePools.Has(ctx, granter)
+		if poolErr != nil {
+			return poolErr
+		}
+		if hasPool {
+			return k.useFeePool(ctx, granter, grantee, fee)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -280,6 +311,33 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, pool := range data.FeePools {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(pool.Granter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.FeePools.Set(ctx, granter, pool); err != nil {
+			return err
+		}
+	}
+
+	for _, poolGrantee := range data.FeePoolGrantees {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(poolGrantee.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(poolGrantee.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PoolGrantees.Set(ctx, collections.Join(sdk.AccAddress(granter), sdk.AccAddress(grantee)), poolGrantee); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -291,9 +349,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.FeePool
+	err = k.FeePools.Walk(ctx, nil, func(_ sdk.AccAddress, pool feegrant.FeePool) (stop bool, err error) {
+		pools = append(pools, pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var poolGrantees []feegrant.FeePoolGrantee
+	err = k.PoolGrantees.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], poolGrantee feegrant.FeePoolGrantee) (stop bool, err error) {
+		poolGrantees = append(poolGrantees, poolGrantee)
+		return false, nil
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:      grants,
+		FeePools:        pools,
+		FeePoolGrantees: poolGrantees,
 	}, err
 }
 
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/feegrant"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -103,3 +104,85 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// CreateFeePool creates a fee pool funded by the granter and shared by many grantees.
+func (k msgServer) CreateFeePool(ctx context.Context, msg *feegrant.MsgCreateFeePool) (*feegrant.MsgCreateFeePoolResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantees, err := k.granteesFromStrings(msg.Grantees)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := feegrant.FeePool{
+		Granter:           msg.Granter,
+		SpendLimit:        msg.SpendLimit,
+		GranteeSpendLimit: msg.GranteeSpendLimit,
+		Open:              msg.Open,
+		Expiration:        msg.Expiration,
+	}
+	if err := pool.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CreateFeePool(ctx, granter, pool, grantees); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateFeePoolResponse{}, nil
+}
+
+// UpdateFeePoolGrantees updates the allow-list of the fee pool of the granter.
+func (k msgServer) UpdateFeePoolGrantees(ctx context.Context, msg *feegrant.MsgUpdateFeePoolGrantees) (*feegrant.MsgUpdateFeePoolGranteesResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	add, err := k.granteesFromStrings(msg.AddGrantees)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := k.granteesFromStrings(msg.RemoveGrantees)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateFeePoolGrantees(ctx, granter, add, remove); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateFeePoolGranteesResponse{}, nil
+}
+
+// RevokeFeePool revokes the fee pool of the granter.
+func (k msgServer) RevokeFeePool(ctx context.Context, msg *feegrant.MsgRevokeFeePool) (*feegrant.MsgRevokeFeePoolResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeFeePool(ctx, granter); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeFeePoolResponse{}, nil
+}
+
+// granteesFromStrings decodes the addresses of the grantees of a fee pool.
+func (k msgServer) granteesFromStrings(grantees []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, 0, len(grantees))
+	for _, grantee := range grantees {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(grantee)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address %s: %s", grantee, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
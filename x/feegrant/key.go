@@ -20,4 +20,12 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// FeePoolKeyPrefix is the set of the kvstore for fee pool data
+	// - 0x02<granter_address_bytes>: FeePool
+	FeePoolKeyPrefix = collections.NewPrefix(2)
+
+	// FeePoolGranteeKeyPrefix is the set of the kvstore for fee pool grantee data
+	// - 0x03<granter_address_bytes><grantee_address_bytes>: FeePoolGrantee
+	FeePoolGranteeKeyPrefix = collections.NewPrefix(3)
 )
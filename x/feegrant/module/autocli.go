@@ -44,6 +44,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "FeePool",
+					Use:       "fee-pool [granter]",
+					Short:     "Query the fee pool funded by a granter",
+					Example:   fmt.Sprintf(`$ %s query feegrant fee-pool [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "FeePoolGrantees",
+					Use:       "fee-pool-grantees [granter]",
+					Short:     "Query the grantees of the fee pool funded by a granter",
+					Long:      "Queries the grantees of the fee pool funded by a granter, along with the amount of fees they spent from the pool.",
+					Example:   fmt.Sprintf(`$ %s query feegrant fee-pool-grantees [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +86,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired allowances in order to reduce the size of the store when the number of expired allowances is large.",
 					Example:   fmt.Sprintf(`$ %s tx feegrant prune --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod: "CreateFeePool",
+					Use:       "create-fee-pool [granter] [grantee]...",
+					Short:     "Create a fee pool shared by many grantees",
+					Long:      "Create a fee pool funded by the granter and shared by the given grantees, or by any address with --open, within a budget shared by all the grantees and an optional spend limit per grantee. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant create-fee-pool [granter] [grantee1] [grantee2] --spend-limit 1000000stake --grantee-spend-limit 1000stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantees", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateFeePoolGrantees",
+					Use:       "update-fee-pool-grantees [granter]",
+					Short:     "Add grantees to or remove grantees from the allow-list of a fee pool",
+					Example:   fmt.Sprintf(`$ %s tx feegrant update-fee-pool-grantees [granter] --add-grantees [grantee1],[grantee2] --remove-grantees [grantee3]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "RevokeFeePool",
+					Use:       "revoke-fee-pool [granter]",
+					Short:     "Revoke the fee pool of a granter",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-fee-pool [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/keeper"
 	"cosmossdk.io/x/feegrant/simulation"
@@ -36,10 +37,10 @@ type FeegrantInputs struct {
 	Registry      cdctypes.InterfaceRegistry
 }
 
-func ProvideModule(in FeegrantInputs) (keeper.Keeper, appmodule.AppModule) {
+func ProvideModule(in FeegrantInputs) (keeper.Keeper, appmodule.AppModule, authtypes.AccountHooksWrapper) {
 	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper)
 	m := NewAppModule(in.Cdc, in.AccountKeeper, in.BankKeeper, k, in.Registry)
-	return k, m
+	return k, m, authtypes.AccountHooksWrapper{AccountHooks: k.Hooks()}
 }
 
 // AppModuleSimulation functions
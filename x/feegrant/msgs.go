@@ -12,6 +12,8 @@ import (
 
 var (
 	_, _ sdk.Msg                       = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _ sdk.Msg                       = &MsgCreateFeePool{}, &MsgUpdateFeePoolGrantees{}
+	_    sdk.Msg                       = &MsgRevokeFeePool{}
 	_    types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
 
@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -22,6 +23,11 @@ const (
 	flagForZeroHeight    = "for-zero-height"
 	flagJailAllowedAddrs = "jail-allowed-addrs"
 	flagModulesToExport  = "modules-to-export"
+
+	// FlagStreaming is the flag of the export command, also passed to the app
+	// exporter as an app option, requesting the state to be exported as a
+	// genesis stream, see servertypes.ExportedApp.
+	FlagStreaming = "streaming"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -29,7 +35,15 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export state to JSON",
-		Args:  cobra.NoArgs,
+		Long: `Export state to JSON.
+
+The state of a node halted per halt-height or halt-time can be exported with
+'--height' set to the last committed height, e.g. the halt-height.
+
+With '--streaming', the state is written incrementally in the binary genesis
+stream format instead, for the apps supporting it. A node can be started from
+a genesis stream used as its genesis file.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 
@@ -76,13 +90,14 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(flagJailAllowedAddrs)
 			modulesToExport, _ := cmd.Flags().GetStringSlice(flagModulesToExport)
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			streaming, _ := cmd.Flags().GetBool(FlagStreaming)
 
 			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper, modulesToExport)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %w", err)
 			}
 
-			appGenesis, err := genutiltypes.AppGenesisFromFile(serverCtx.Config.GenesisFile())
+			appGenesis, err := genutiltypes.AppGenesisFromFileOrStream(serverCtx.Config.GenesisFile())
 			if err != nil {
 				return err
 			}
@@ -95,6 +110,14 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 			appGenesis.InitialHeight = exported.Height
 			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
 
+			if streaming {
+				if exported.StreamAppState == nil {
+					return errors.New("the app does not support streaming exports")
+				}
+
+				return writeGenesisStream(cmd.OutOrStdout(), outputDocument, appGenesis, exported)
+			}
+
 			out, err := json.Marshal(appGenesis)
 			if err != nil {
 				return err
@@ -119,6 +142,39 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 	cmd.Flags().StringSlice(flagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
 	cmd.Flags().StringSlice(flagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+	cmd.Flags().Bool(FlagStreaming, false, "Export state incrementally in the binary genesis stream format")
 
 	return cmd
 }
+
+// writeGenesisStream writes the genesis stream of the exported app to the
+// output document, or to the writer if not set.
+func writeGenesisStream(w io.Writer, outputDocument string, appGenesis *genutiltypes.AppGenesis, exported servertypes.ExportedApp) error {
+	if outputDocument != "" {
+		f, err := os.OpenFile(outputDocument, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	sw, err := genutiltypes.NewGenesisStreamWriter(w, appGenesis)
+	if err != nil {
+		return err
+	}
+
+	if err := exported.StreamAppState(sw); err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+
+	if err := sw.Close(); err != nil {
+		return err
+	}
+
+	if f, ok := w.(*os.File); ok && outputDocument != "" {
+		return f.Close()
+	}
+
+	return nil
+}
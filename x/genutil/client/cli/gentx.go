@@ -161,7 +161,7 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
+			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti || key.GetType() == keyring.TypeWatch {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return txBldr.PrintUnsignedTx(clientCtx, msg)
 			}
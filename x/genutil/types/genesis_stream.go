@@ -0,0 +1,512 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+)
+
+// The genesis stream format holds an AppGenesis without its app state, followed
+// by the genesis state of the modules written incrementally, so that the state
+// of large chains can be exported and imported without holding it in memory.
+//
+// A genesis stream starts with GenesisStreamMagic and is followed by records,
+// each starting with its kind:
+//   - the header record holds the length prefixed JSON of the AppGenesis,
+//   - a chunk record holds the length prefixed module name, field name and data
+//     of a chunk of a field of the genesis state of a module. The data of a
+//     field is the concatenation of the data of its chunks, in order. The
+//     modules written as a single JSON document use the empty field name,
+//   - the end record marks the end of a complete stream.
+//
+// Lengths are encoded as unsigned varints.
+const GenesisStreamMagic = "cosmos-sdk/genesis-stream/v1\n"
+
+const (
+	genesisStreamHeader byte = iota + 1
+	genesisStreamChunk
+	genesisStreamEnd
+)
+
+const (
+	// genesisStreamChunkSize is the size of the chunks a field is written in.
+	genesisStreamChunkSize = 1 << 20
+	// maxGenesisStreamNameLen is the maximum length of the module and field
+	// names of a chunk.
+	maxGenesisStreamNameLen = 1 << 10
+	// maxGenesisStreamHeaderLen is the maximum length of the header.
+	maxGenesisStreamHeaderLen = 1 << 28
+)
+
+// genesisStreamAppStateKey is the key of the app state referencing the genesis
+// stream a node is initialized from, see GenesisStreamAppState.
+const genesisStreamAppStateKey = "genesis_stream"
+
+// GenesisStreamWriter writes a genesis stream, it implements the
+// genesis.StreamWriter interface.
+type GenesisStreamWriter struct {
+	w *bufio.Writer
+}
+
+// NewGenesisStreamWriter writes the header of a genesis stream holding the
+// AppGenesis to the writer, its app state is not written. The genesis state of
+// the modules must then be written with ModuleTarget and WriteModuleJSON, and
+// the stream completed with Close.
+func NewGenesisStreamWriter(w io.Writer, appGenesis *AppGenesis) (*GenesisStreamWriter, error) {
+	header := *appGenesis
+	header.AppState = nil
+	bz, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	sw := &GenesisStreamWriter{w: bufio.NewWriter(w)}
+	if _, err := sw.w.WriteString(GenesisStreamMagic); err != nil {
+		return nil, err
+	}
+	if err := sw.w.WriteByte(genesisStreamHeader); err != nil {
+		return nil, err
+	}
+	if err := sw.writeBytes(bz); err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// ModuleTarget returns the genesis target writing the fields of the genesis
+// state of the module to the stream. The fields must be written one at a time.
+func (sw *GenesisStreamWriter) ModuleTarget(moduleName string) appmodule.GenesisTarget {
+	return func(field string) (io.WriteCloser, error) {
+		return &genesisStreamFieldWriter{sw: sw, moduleName: moduleName, field: field}, nil
+	}
+}
+
+// WriteModuleJSON writes the genesis state of the module to the stream.
+func (sw *GenesisStreamWriter) WriteModuleJSON(moduleName string, bz json.RawMessage) error {
+	fw := &genesisStreamFieldWriter{sw: sw, moduleName: moduleName}
+	if _, err := fw.Write(bz); err != nil {
+		return err
+	}
+
+	return fw.Close()
+}
+
+// Close completes the stream and flushes it to the underlying writer.
+func (sw *GenesisStreamWriter) Close() error {
+	if err := sw.w.WriteByte(genesisStreamEnd); err != nil {
+		return err
+	}
+
+	return sw.w.Flush()
+}
+
+func (sw *GenesisStreamWriter) writeChunk(moduleName, field string, data []byte) error {
+	if err := sw.w.WriteByte(genesisStreamChunk); err != nil {
+		return err
+	}
+	if err := sw.writeBytes([]byte(moduleName)); err != nil {
+		return err
+	}
+	if err := sw.writeBytes([]byte(field)); err != nil {
+		return err
+	}
+
+	return sw.writeBytes(data)
+}
+
+func (sw *GenesisStreamWriter) writeBytes(bz []byte) error {
+	if _, err := sw.w.Write(binary.AppendUvarint(nil, uint64(len(bz)))); err != nil {
+		return err
+	}
+
+	_, err := sw.w.Write(bz)
+	return err
+}
+
+// genesisStreamFieldWriter writes a field of the genesis state of a module in
+// chunks of genesisStreamChunkSize.
+type genesisStreamFieldWriter struct {
+	sw         *GenesisStreamWriter
+	moduleName string
+	field      string
+	buf        []byte
+	written    bool
+}
+
+func (fw *genesisStreamFieldWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		size := min(len(p), genesisStreamChunkSize-len(fw.buf))
+		fw.buf = append(fw.buf, p[:size]...)
+		p = p[size:]
+
+		if len(fw.buf) == genesisStreamChunkSize {
+			if err := fw.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// Close writes the last chunk of the field, the empty fields are written as an
+// empty chunk.
+func (fw *genesisStreamFieldWriter) Close() error {
+	if len(fw.buf) > 0 || !fw.written {
+		return fw.flush()
+	}
+
+	return nil
+}
+
+func (fw *genesisStreamFieldWriter) flush() error {
+	if err := fw.sw.writeChunk(fw.moduleName, fw.field, fw.buf); err != nil {
+		return err
+	}
+
+	fw.buf = fw.buf[:0]
+	fw.written = true
+	return nil
+}
+
+// GenesisStream is a genesis stream file opened for reading, it implements the
+// genesis.StreamReader interface. The chunks of the stream are indexed
+// when it is opened and read from the file when the modules read their genesis
+// state.
+type GenesisStream struct {
+	file       *os.File
+	appGenesis *AppGenesis
+	// modules holds the modules, in the order they were written.
+	modules []string
+	// fields holds the chunks of the fields of the genesis state of each module.
+	fields map[string]map[string][]genesisStreamChunkRef
+	// fieldNames holds the fields of each module, in the order they were written.
+	fieldNames map[string][]string
+}
+
+type genesisStreamChunkRef struct {
+	offset int64
+	size   int64
+}
+
+// IsGenesisStreamFile returns true if the file is a genesis stream.
+func IsGenesisStreamFile(path string) (bool, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	magic := make([]byte, len(GenesisStreamMagic))
+	if _, err := io.ReadFull(file, magic); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return string(magic) == GenesisStreamMagic, nil
+}
+
+// OpenGenesisStream opens and indexes the genesis stream file, it fails if the
+// stream is not complete.
+func OpenGenesisStream(path string) (*GenesisStream, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &GenesisStream{
+		file:       file,
+		fields:     map[string]map[string][]genesisStreamChunkRef{},
+		fieldNames: map[string][]string{},
+	}
+	if err := s.index(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read genesis stream %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+func (s *GenesisStream) index() error {
+	r := &countingReader{r: bufio.NewReader(s.file)}
+
+	magic := make([]byte, len(GenesisStreamMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != GenesisStreamMagic {
+		return errors.New("not a genesis stream")
+	}
+
+	for {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+
+		switch kind {
+		case genesisStreamHeader:
+			if s.appGenesis != nil {
+				return errors.New("duplicate header")
+			}
+			size, err := readLength(r, maxGenesisStreamHeaderLen)
+			if err != nil {
+				return err
+			}
+			bz := make([]byte, size)
+			if _, err := io.ReadFull(r, bz); err != nil {
+				return unexpectedEOF(err)
+			}
+			s.appGenesis, err = AppGenesisFromReader(bytes.NewReader(bz))
+			if err != nil {
+				return err
+			}
+
+		case genesisStreamChunk:
+			if s.appGenesis == nil {
+				return errors.New("missing header")
+			}
+			moduleName, err := readName(r)
+			if err != nil {
+				return err
+			}
+			field, err := readName(r)
+			if err != nil {
+				return err
+			}
+			size, err := readLength(r, -1)
+			if err != nil {
+				return err
+			}
+			chunk := genesisStreamChunkRef{offset: r.n, size: int64(size)}
+			if _, err := r.Discard(size); err != nil {
+				return unexpectedEOF(err)
+			}
+
+			fields, ok := s.fields[moduleName]
+			if !ok {
+				fields = map[string][]genesisStreamChunkRef{}
+				s.fields[moduleName] = fields
+				s.modules = append(s.modules, moduleName)
+			}
+			if _, ok := fields[field]; !ok {
+				s.fieldNames[moduleName] = append(s.fieldNames[moduleName], field)
+			}
+			fields[field] = append(fields[field], chunk)
+
+		case genesisStreamEnd:
+			if s.appGenesis == nil {
+				return errors.New("missing header")
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("unknown record kind %d", kind)
+		}
+	}
+}
+
+// AppGenesis returns the AppGenesis of the stream, without its app state.
+func (s *GenesisStream) AppGenesis() *AppGenesis {
+	return s.appGenesis
+}
+
+// Modules returns the modules whose genesis state is held by the stream, in
+// the order they were written.
+func (s *GenesisStream) Modules() []string {
+	return s.modules
+}
+
+// HasModule returns true if the stream holds the genesis state of the module.
+func (s *GenesisStream) HasModule(moduleName string) bool {
+	_, ok := s.fields[moduleName]
+	return ok
+}
+
+// ModuleSource returns the genesis source reading the fields of the genesis
+// state of the module from the stream.
+func (s *GenesisStream) ModuleSource(moduleName string) (appmodule.GenesisSource, error) {
+	fields := s.fields[moduleName]
+	if _, ok := fields[""]; ok {
+		// the module was written as a single JSON document.
+		bz, err := s.ModuleJSON(moduleName)
+		if err != nil {
+			return nil, err
+		}
+		return genesis.SourceFromRawJSON(bz)
+	}
+
+	return func(field string) (io.ReadCloser, error) {
+		if _, ok := fields[field]; !ok {
+			return nil, nil
+		}
+		return io.NopCloser(s.fieldReader(moduleName, field)), nil
+	}, nil
+}
+
+// ModuleJSON returns the genesis state of the module as a JSON document. The
+// fields of the modules written field by field are gathered in a JSON object.
+func (s *GenesisStream) ModuleJSON(moduleName string) (json.RawMessage, error) {
+	if _, ok := s.fields[moduleName][""]; ok {
+		return io.ReadAll(s.fieldReader(moduleName, ""))
+	}
+
+	fields := make(map[string]json.RawMessage, len(s.fieldNames[moduleName]))
+	for _, field := range s.fieldNames[moduleName] {
+		bz, err := io.ReadAll(s.fieldReader(moduleName, field))
+		if err != nil {
+			return nil, err
+		}
+		fields[field] = bz
+	}
+
+	return json.Marshal(fields)
+}
+
+// Close closes the stream file.
+func (s *GenesisStream) Close() error {
+	return s.file.Close()
+}
+
+func (s *GenesisStream) fieldReader(moduleName, field string) io.Reader {
+	chunks := s.fields[moduleName][field]
+	readers := make([]io.Reader, len(chunks))
+	for i, chunk := range chunks {
+		readers[i] = io.NewSectionReader(s.file, chunk.offset, chunk.size)
+	}
+
+	return io.MultiReader(readers...)
+}
+
+// GenesisStreamAppState returns the app state of the AppGenesis of a node
+// initialized from the genesis stream file, which references the file. The
+// InitChainer of the app reads the genesis state of the modules from the file,
+// see GenesisStreamPath.
+func GenesisStreamAppState(path string) (json.RawMessage, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]string{genesisStreamAppStateKey: path})
+}
+
+// GenesisStreamPath returns the path of the genesis stream file referenced by
+// the app state, if any.
+func GenesisStreamPath(appState json.RawMessage) (string, bool) {
+	var ref map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &ref); err != nil || len(ref) != 1 {
+		return "", false
+	}
+
+	var path string
+	if err := json.Unmarshal(ref[genesisStreamAppStateKey], &path); err != nil || path == "" {
+		return "", false
+	}
+
+	return path, true
+}
+
+// AppGenesisFromStreamFile returns the AppGenesis of the genesis stream file,
+// with an app state referencing the file, see GenesisStreamAppState.
+func AppGenesisFromStreamFile(path string) (*AppGenesis, error) {
+	s, err := OpenGenesisStream(path)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	appGenesis := *s.AppGenesis()
+	appGenesis.AppState, err = GenesisStreamAppState(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appGenesis, nil
+}
+
+// AppGenesisFromFileOrStream reads the AppGenesis from the genesis file of a
+// node, which is either a JSON genesis file or a genesis stream file, see
+// AppGenesisFromStreamFile.
+func AppGenesisFromFileOrStream(genFile string) (*AppGenesis, error) {
+	isStream, err := IsGenesisStreamFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStream {
+		return AppGenesisFromStreamFile(genFile)
+	}
+
+	return AppGenesisFromFile(genFile)
+}
+
+type countingReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *countingReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.n++
+	}
+	return b, err
+}
+
+func (r *countingReader) Discard(n int) (int, error) {
+	discarded, err := r.r.Discard(n)
+	r.n += int64(discarded)
+	return discarded, err
+}
+
+func readLength(r *countingReader, maxLen int) (int, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	if (maxLen >= 0 && size > uint64(maxLen)) || size > uint64(1<<62) {
+		return 0, fmt.Errorf("invalid length %d", size)
+	}
+
+	return int(size), nil
+}
+
+func readName(r *countingReader) (string, error) {
+	size, err := readLength(r, maxGenesisStreamNameLen)
+	if err != nil {
+		return "", err
+	}
+
+	name := make([]byte, size)
+	if _, err := io.ReadFull(r, name); err != nil {
+		return "", unexpectedEOF(err)
+	}
+
+	return string(name), nil
+}
+
+// unexpectedEOF reports the streams ending before their end record as truncated.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errors.New("truncated genesis stream")
+	}
+
+	return err
+}
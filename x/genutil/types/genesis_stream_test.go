@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func writeGenesisStream(t *testing.T, write func(sw *types.GenesisStreamWriter)) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	sw, err := types.NewGenesisStreamWriter(&buf, &types.AppGenesis{ChainID: "test", AppState: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	write(sw)
+	require.NoError(t, sw.Close())
+
+	path := filepath.Join(t.TempDir(), "genesis.bin")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	return path
+}
+
+func writeField(t *testing.T, target appmodule.GenesisTarget, field, data string) {
+	t.Helper()
+
+	w, err := target(field)
+	require.NoError(t, err)
+	// write in several calls, across the chunks of the field.
+	for len(data) > 0 {
+		n := min(len(data), 300_000)
+		_, err = w.Write([]byte(data[:n]))
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+}
+
+func readField(t *testing.T, source appmodule.GenesisSource, field string) string {
+	t.Helper()
+
+	r, err := source(field)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	bz, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestGenesisStream(t *testing.T) {
+	largeField := `"` + strings.Repeat("a", 3<<20) + `"`
+	path := writeGenesisStream(t, func(sw *types.GenesisStreamWriter) {
+		require.NoError(t, sw.WriteModuleJSON("bank", json.RawMessage(`{"balances":[]}`)))
+		target := sw.ModuleTarget("orm")
+		writeField(t, target, "large", largeField)
+		writeField(t, target, "small", "{}")
+	})
+
+	isStream, err := types.IsGenesisStreamFile(path)
+	require.NoError(t, err)
+	require.True(t, isStream)
+
+	stream, err := types.OpenGenesisStream(path)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.Equal(t, "test", stream.AppGenesis().ChainID)
+	require.Nil(t, stream.AppGenesis().AppState)
+	require.Equal(t, []string{"bank", "orm"}, stream.Modules())
+	require.False(t, stream.HasModule("staking"))
+
+	bz, err := stream.ModuleJSON("bank")
+	require.NoError(t, err)
+	require.Equal(t, `{"balances":[]}`, string(bz))
+
+	source, err := stream.ModuleSource("orm")
+	require.NoError(t, err)
+	require.Equal(t, largeField, readField(t, source, "large"))
+	require.Equal(t, "{}", readField(t, source, "small"))
+	r, err := source("missing")
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	// the modules written as a single document and field by field can be read
+	// either way.
+	source, err = stream.ModuleSource("bank")
+	require.NoError(t, err)
+	require.Equal(t, "[]", readField(t, source, "balances"))
+
+	bz, err = stream.ModuleJSON("orm")
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Equal(t, largeField, string(fields["large"]))
+}
+
+func TestGenesisStreamTruncated(t *testing.T) {
+	path := writeGenesisStream(t, func(sw *types.GenesisStreamWriter) {
+		require.NoError(t, sw.WriteModuleJSON("bank", json.RawMessage(`{}`)))
+	})
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0o600))
+
+	_, err = types.OpenGenesisStream(path)
+	require.ErrorContains(t, err, "truncated genesis stream")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"chain_id":"test"}`), 0o600))
+	isStream, err := types.IsGenesisStreamFile(path)
+	require.NoError(t, err)
+	require.False(t, isStream)
+	_, err = types.OpenGenesisStream(path)
+	require.ErrorContains(t, err, "not a genesis stream")
+}
+
+func TestAppGenesisFromFileOrStream(t *testing.T) {
+	path := writeGenesisStream(t, func(*types.GenesisStreamWriter) {})
+
+	appGenesis, err := types.AppGenesisFromFileOrStream(path)
+	require.NoError(t, err)
+	require.Equal(t, "test", appGenesis.ChainID)
+
+	streamPath, ok := types.GenesisStreamPath(appGenesis.AppState)
+	require.True(t, ok)
+	require.Equal(t, path, streamPath)
+
+	_, ok = types.GenesisStreamPath(json.RawMessage(`{"bank":{}}`))
+	require.False(t, ok)
+}
+
+func TestManagerGenesisStream(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule := mock.NewMockAppModuleWithAllExtensionsABCI(mockCtrl)
+	mockCoreAppModule := mock.NewMockCoreAppModule(mockCtrl)
+	mockAppModule.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(mockAppModule, module.CoreAppModuleAdaptor("module2", mockCoreAppModule))
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	mockAppModule.EXPECT().ExportGenesis(gomock.Any()).Return(json.RawMessage(`{"key":"value"}`), nil)
+	mockCoreAppModule.EXPECT().ExportGenesis(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, target appmodule.GenesisTarget) error {
+		writeField(t, target, "field", `"value"`)
+		return nil
+	})
+	path := writeGenesisStream(t, func(sw *types.GenesisStreamWriter) {
+		require.NoError(t, mm.ExportGenesisStream(ctx, nil, sw))
+	})
+
+	stream, err := types.OpenGenesisStream(path)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	mockAppModule.EXPECT().InitGenesis(gomock.Any(), json.RawMessage(`{"key":"value"}`)).Return([]module.ValidatorUpdate{{Power: 1}}, nil)
+	mockCoreAppModule.EXPECT().InitGenesis(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, source appmodule.GenesisSource) error {
+		require.Equal(t, `"value"`, readField(t, source, "field"))
+		return nil
+	})
+	res, err := mm.InitGenesisStream(ctx, stream)
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+}
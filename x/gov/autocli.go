@@ -89,6 +89,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "TallySnapshot",
+					Use:       "tally-snapshot [proposal-id]",
+					Short:     "Query the live tally of a proposal in voting period",
+					Long:      "Query the tally of a proposal in voting period, updated on each vote with the voting power the voter had at the time of the vote.",
+					Example:   fmt.Sprintf("%s query gov tally-snapshot 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "ExecutionReport",
+					Use:       "execution-report [proposal-id]",
+					Short:     "Query the execution report of a passed proposal",
+					Long:      "Query the result of the execution of the messages of a passed proposal: whether it succeeded, the gas consumed, and the events or error of each message.",
+					Example:   fmt.Sprintf("%s query gov execution-report 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -120,6 +140,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "FlagProposalSpam",
+					Use:       "flag-proposal-spam [proposal-id]",
+					Short:     "Flag a proposal in deposit or voting period as spam. Must be signed by a bonded validator operator.",
+					Long:      "Flag a proposal as spam. Once the validators flagging a proposal hold the spam flag threshold of the bonded tokens, the proposal is closed and its deposits are burnt.",
+					Example:   fmt.Sprintf(`$ %s tx gov flag-proposal-spam 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [option]",
@@ -112,6 +112,10 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional ids of the proposals that must pass before this one is executed
+  "depends_on": [1],
+  // optional ids of the proposals rejected if this one passes, and conversely
+  "conflicts_with": [2],
 }
 
 metadata example: 
@@ -147,6 +151,8 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.DependsOn = proposal.DependsOn
+			msg.ConflictsWith = proposal.ConflictsWith
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
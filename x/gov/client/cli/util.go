@@ -94,6 +94,8 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	DependsOn       []uint64          `json:"depends_on,omitempty"`
+	ConflictsWith   []uint64          `json:"conflicts_with,omitempty"`
 
 	proposalType govv1.ProposalType
 }
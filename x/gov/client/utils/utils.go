@@ -73,6 +73,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1.StatusRejected.String()
+	case "Deferred", "deferred":
+		return v1.StatusDeferred.String()
 	default:
 		return status
 	}
@@ -67,12 +67,29 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+		case v1.StatusDeferred:
+			if err := k.DeferredProposals.Set(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+		for _, id := range proposal.ConflictsWith {
+			if err := k.ProposalConflicts.Set(ctx, collections.Join(proposal.Id, id)); err != nil {
+				return err
+			}
+			if err := k.ProposalConflicts.Set(ctx, collections.Join(id, proposal.Id)); err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
 		}
 	}
 
+	// the tally snapshots are not exported, they are recomputed from the votes.
+	if err := k.RebuildTallySnapshots(ctx); err != nil {
+		return err
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
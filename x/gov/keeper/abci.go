@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"google.golang.org/protobuf/runtime/protoiface"
@@ -137,6 +138,12 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// the proposal may have been rejected earlier in this block because a
+		// conflicting proposal passed.
+		if proposal.Status != v1.StatusVotingPeriod {
+			continue
+		}
+
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults, err := k.Tally(ctx, proposal)
@@ -144,6 +151,11 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// the votes are removed by the tally, so is the snapshot.
+		if err := k.deleteTallySnapshot(ctx, proposal.Id); err != nil {
+			return err
+		}
+
 		// Deposits are always burned if tally said so, regardless of the proposal type.
 		// If a proposal passes, deposits are always refunded, regardless of the proposal type.
 		// If a proposal fails, and isn't spammy, deposits are refunded, unless the proposal is expedited or optimistic.
@@ -174,46 +186,31 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
+			resolved, failedReason, err := k.dependenciesStatus(ctx, proposal)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.RouterService.MessageRouterService()); err != nil {
-						// `idx` and `err` are populated with the msg index and error.
-						proposal.Status = v1.StatusFailed
-						proposal.FailedReason = err.Error()
-						tagValue = types.AttributeValueProposalFailed
-						logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-
-						return err
-					}
+			switch {
+			case failedReason != "":
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = failedReason
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but %s", failedReason)
+			case !resolved:
+				// execution is deferred until all the dependencies are resolved
+				proposal.Status = v1.StatusDeferred
+				tagValue = types.AttributeValueProposalDeferred
+				logMsg = "passed, execution deferred until its dependencies pass"
+
+				if err := k.DeferredProposals.Set(ctx, proposal.Id); err != nil {
+					return err
+				}
+			default:
+				tagValue, logMsg, err = k.executeProposal(ctx, &proposal)
+				if err != nil {
+					return err
 				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			}); err != nil {
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
 			}
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
@@ -254,6 +251,19 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// spam flags only apply to the proposals in voting period
+		if proposal.Status != v1.StatusVotingPeriod {
+			if err := k.deleteSpamFlags(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+
+		if proposal.Status == v1.StatusPassed {
+			if err := k.rejectConflictingProposals(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+
 		// call hook when proposal become active
 		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
 			return k.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
@@ -279,9 +289,169 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
+
+	return k.processDeferredProposals(ctx)
+}
+
+// processDeferredProposals executes the deferred proposals whose dependencies
+// have all passed, and fails the ones with a dependency that did not pass.
+func (k Keeper) processDeferredProposals(ctx context.Context) error {
+	iter, err := k.DeferredProposals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	deferredProps, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range deferredProps {
+		proposal, err := k.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+
+		// the proposal may have been rejected by a conflicting proposal executed
+		// earlier in this loop.
+		if proposal.Status != v1.StatusDeferred {
+			continue
+		}
+
+		resolved, failedReason, err := k.dependenciesStatus(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		var tagValue, logMsg string
+		switch {
+		case failedReason != "":
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = failedReason
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = fmt.Sprintf("deferred, but %s", failedReason)
+		case !resolved:
+			continue
+		default:
+			tagValue, logMsg, err = k.executeProposal(ctx, &proposal)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := k.DeferredProposals.Remove(ctx, proposal.Id); err != nil {
+			return err
+		}
+
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+
+		if proposal.Status == v1.StatusPassed {
+			if err := k.rejectConflictingProposals(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+
+		k.Logger.Info(
+			"deferred proposal resolved",
+			"proposal", proposal.Id,
+			"proposal_type", proposal.ProposalType,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeDeferredProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+	}
+
 	return nil
 }
 
+// executeProposal executes the messages of a passed proposal and updates its
+// status accordingly. The result of the execution is stored in an execution
+// report. It returns the event tag value and log message describing the
+// outcome.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal) (tagValue, logMsg string, err error) {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	report := v1.ExecutionReport{
+		ProposalId: proposal.Id,
+		Height:     headerInfo.Height,
+		ExecutedAt: headerInfo.Time,
+	}
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		report.Error = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err), k.ExecutionReports.Set(ctx, proposal.Id, report)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_ = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		// execute all messages, each in its own branch to measure the gas it
+		// consumes and collect the events it emits.
+		for idx, msg := range messages {
+			result := v1.MessageExecutionResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+			gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, math.MaxUint64, func(ctx context.Context) error {
+				if _, err := safeExecuteHandler(ctx, msg, k.RouterService.MessageRouterService()); err != nil {
+					return err
+				}
+
+				result.Events = executionEvents(ctx)
+				return nil
+			})
+			result.GasUsed = gasUsed
+			report.GasUsed += gasUsed
+
+			if err != nil {
+				result.Error = err.Error()
+				report.Results = append(report.Results, result)
+				report.Error = err.Error()
+
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = err.Error()
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+
+				return err
+			}
+
+			report.Results = append(report.Results, result)
+		}
+
+		proposal.Status = v1.StatusPassed
+		report.Success = true
+		tagValue = types.AttributeValueProposalPassed
+		logMsg = "passed"
+
+		return nil
+	}) // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+
+	return tagValue, logMsg, k.ExecutionReports.Set(ctx, proposal.Id, report)
+}
+
+// executionEvents returns the events emitted in the given branched context.
+// They are only available when the context is an sdk.Context.
+func executionEvents(ctx context.Context) []sdk.StringEvent {
+	sdkCtx, ok := ctx.(sdk.Context)
+	if !ok {
+		return nil
+	}
+
+	return sdk.StringifyEvents(sdkCtx.EventManager().Events().ToABCIEvents())
+}
+
 // executes route(msg) and recovers from panic.
 func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Router) (res protoiface.MessageV1, err error) {
 	defer func() {
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExecutionReport(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	_, err := queryServer.ExecutionReport(ctx, &v1.QueryExecutionReportRequest{ProposalId: 0})
+	require.ErrorContains(t, err, "proposal id can not be 0")
+	_, err = queryServer.ExecutionReport(ctx, &v1.QueryExecutionReportRequest{ProposalId: 1})
+	require.ErrorContains(t, err, "no execution report for proposal 1")
+
+	// the params update and the cancellation of a proposal in deposit period
+	// execute successfully.
+	canceled, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", govAcct, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	updateParams := &v1.MsgUpdateParams{Authority: govAcctStr, Params: v1.DefaultParams()}
+	cancelProposal := v1.NewMsgCancelProposal(canceled.Id, govAcctStr)
+	proposal, err := v1.NewProposal([]sdk.Msg{updateParams, cancelProposal}, 100, ctx.HeaderInfo().Time, ctx.HeaderInfo().Time, "", "title", "summary", govAcctStr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ExecuteProposal(ctx, &proposal))
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	res, err := queryServer.ExecutionReport(ctx, &v1.QueryExecutionReportRequest{ProposalId: 100})
+	require.NoError(t, err)
+	report := res.Report
+	require.True(t, report.Success)
+	require.Empty(t, report.Error)
+	require.Equal(t, int64(10), report.Height)
+	require.True(t, report.ExecutedAt.Equal(ctx.HeaderInfo().Time))
+	require.Len(t, report.Results, 2)
+	require.Equal(t, sdk.MsgTypeURL(updateParams), report.Results[0].MsgTypeUrl)
+	require.Equal(t, sdk.MsgTypeURL(cancelProposal), report.Results[1].MsgTypeUrl)
+	require.Positive(t, report.Results[0].GasUsed)
+	require.Positive(t, report.Results[1].GasUsed)
+	require.Equal(t, report.Results[0].GasUsed+report.Results[1].GasUsed, report.GasUsed)
+	require.Contains(t, report.Results[1].Events, sdk.StringEvent{
+		Type: types.EventTypeCancelProposal,
+		Attributes: []sdk.Attribute{
+			{Key: sdk.AttributeKeySender, Value: govAcctStr},
+			{Key: types.AttributeKeyProposalID, Value: fmt.Sprint(canceled.Id)},
+		},
+	})
+
+	// the bank message fails, the bank msg server being nil, and the execution
+	// stops at it.
+	proposal, err = v1.NewProposal(TestProposal, 101, ctx.HeaderInfo().Time, ctx.HeaderInfo().Time, "", "title", "summary", govAcctStr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ExecuteProposal(ctx, &proposal))
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+
+	res, err = queryServer.ExecutionReport(ctx, &v1.QueryExecutionReportRequest{ProposalId: 101})
+	require.NoError(t, err)
+	report = res.Report
+	require.False(t, report.Success)
+	require.Equal(t, proposal.FailedReason, report.Error)
+	require.Len(t, report.Results, 1)
+	require.Equal(t, sdk.MsgTypeURL(TestProposal[0]), report.Results[0].MsgTypeUrl)
+	require.Equal(t, report.Error, report.Results[0].Error)
+	require.Empty(t, report.Results[0].Events)
+}
@@ -16,3 +16,10 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 
 	return k.validateInitialDeposit(params, initialDeposit, proposalType)
 }
+
+// ExecuteProposal is a helper function used only in execution report tests
+// which returns the same functionality of executeProposal private function.
+func (k Keeper) ExecuteProposal(ctx sdk.Context, proposal *v1.Proposal) error {
+	_, _, err := k.executeProposal(ctx, proposal)
+	return err
+}
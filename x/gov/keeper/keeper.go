@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -65,6 +66,23 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// DeferredProposals key: proposalID
+	// This is used to track passed proposals waiting for their dependencies to pass
+	DeferredProposals collections.KeySet[uint64]
+	// ProposalConflicts key: proposalID+conflictingProposalID
+	// Conflicts are stored in both directions.
+	ProposalConflicts collections.KeySet[collections.Pair[uint64, uint64]]
+	// TallySnapshots key: proposalID | value: TallySnapshot
+	TallySnapshots collections.Map[uint64, v1.TallySnapshot]
+	// VotePowers key: proposalID+voterAddr | value: VotePower
+	VotePowers collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.VotePower]
+	// TallyDeductions key: proposalID+valAddr | value: voting power of the delegators who voted
+	TallyDeductions collections.Map[collections.Pair[uint64, sdk.ValAddress], math.LegacyDec]
+	// SpamFlags key: proposalID+valAddr
+	// This is used to track the validators flagging a proposal as spam
+	SpamFlags collections.KeySet[collections.Pair[uint64, sdk.ValAddress]]
+	// ExecutionReports key: proposalID | value: ExecutionReport
+	ExecutionReports collections.Map[uint64, v1.ExecutionReport]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -131,6 +149,13 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		DeferredProposals:      collections.NewKeySet(sb, types.DeferredProposalsKeyPrefix, "deferred_proposals", collections.Uint64Key),
+		ProposalConflicts:      collections.NewKeySet(sb, types.ProposalConflictsKeyPrefix, "proposal_conflicts", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		TallySnapshots:         collections.NewMap(sb, types.TallySnapshotsKeyPrefix, "tally_snapshots", collections.Uint64Key, codec.CollValue[v1.TallySnapshot](cdc)),
+		VotePowers:             collections.NewMap(sb, types.VotePowersKeyPrefix, "vote_powers", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[v1.VotePower](cdc)),
+		TallyDeductions:        collections.NewMap(sb, types.TallyDeductionsKeyPrefix, "tally_deductions", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey), sdk.LegacyDecValue),
+		SpamFlags:              collections.NewKeySet(sb, types.SpamFlagsKeyPrefix, "spam_flags", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey)),
+		ExecutionReports:       collections.NewMap(sb, types.ExecutionReportsKeyPrefix, "execution_reports", collections.Uint64Key, codec.CollValue[v1.ExecutionReport](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	v5 "cosmossdk.io/x/gov/migrations/v5"
 	v6 "cosmossdk.io/x/gov/migrations/v6"
+	v1 "cosmossdk.io/x/gov/types/v1"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -43,3 +44,21 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.KVStoreService, m.keeper.Params, m.keeper.Proposals)
 }
+
+// Migrate6to7 migrates from version 6 to 7, computing the tally snapshots of
+// the proposals in voting period.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return m.keeper.RebuildTallySnapshots(ctx)
+}
+
+// Migrate7to8 migrates from version 7 to 8, setting the spam flag threshold
+// parameter to its default value.
+func (m Migrator) Migrate7to8(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SpamFlagThreshold = v1.DefaultSpamFlagThreshold.String()
+	return m.keeper.Params.Set(ctx, params)
+}
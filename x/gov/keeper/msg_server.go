@@ -91,6 +91,13 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if len(msg.DependsOn) > 0 || len(msg.ConflictsWith) > 0 {
+		proposal, err = k.Keeper.SetProposalRelations(ctx, proposal, msg.DependsOn, msg.ConflictsWith)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -186,6 +193,29 @@ func (k msgServer) CancelProposal(ctx context.Context, msg *v1.MsgCancelProposal
 	}, nil
 }
 
+// FlagProposalSpam implements the MsgServer.FlagProposalSpam method.
+func (k msgServer) FlagProposalSpam(ctx context.Context, msg *v1.MsgFlagProposalSpam) (*v1.MsgFlagProposalSpamResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	closed, err := k.Keeper.FlagProposalSpam(ctx, msg.ProposalId, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeFlagProposalSpam,
+		event.NewAttribute(govtypes.AttributeKeyValidator, msg.Validator),
+		event.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprint(msg.ProposalId)),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeFlagProposalSpam)
+	}
+
+	return &v1.MsgFlagProposalSpamResponse{Closed: closed}, nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(ctx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	govAcct, err := k.authKeeper.AddressCodec().BytesToString(k.GetGovernanceAccount(ctx).GetAddress())
@@ -258,6 +258,14 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
+	if err := k.deleteProposalConflicts(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := k.deleteSpamFlags(ctx, proposalID); err != nil {
+		return err
+	}
+
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
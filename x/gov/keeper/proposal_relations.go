@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// SetProposalRelations records the proposals the given proposal depends on and
+// conflicts with. All the referenced proposals must exist. A proposal cannot
+// depend on a proposal that has already been rejected or has failed, nor
+// conflict with a proposal that has already passed.
+func (k Keeper) SetProposalRelations(ctx context.Context, proposal v1.Proposal, dependsOn, conflictsWith []uint64) (v1.Proposal, error) {
+	seen := make(map[uint64]bool, len(dependsOn)+len(conflictsWith))
+	check := func(id uint64) (v1.Proposal, error) {
+		if id == proposal.Id {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalRelation, "proposal %d cannot reference itself", id)
+		}
+		if seen[id] {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalRelation, "proposal %d is referenced more than once", id)
+		}
+		seen[id] = true
+
+		related, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalRelation, "proposal %d doesn't exist", id)
+			}
+			return v1.Proposal{}, err
+		}
+
+		return related, nil
+	}
+
+	for _, id := range dependsOn {
+		dependency, err := check(id)
+		if err != nil {
+			return v1.Proposal{}, err
+		}
+
+		if dependency.Status == v1.StatusRejected || dependency.Status == v1.StatusFailed {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalRelation, "cannot depend on proposal %d with status %s", id, dependency.Status)
+		}
+	}
+
+	for _, id := range conflictsWith {
+		conflict, err := check(id)
+		if err != nil {
+			return v1.Proposal{}, err
+		}
+
+		if conflict.Status == v1.StatusPassed {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalRelation, "cannot conflict with passed proposal %d", id)
+		}
+
+		if err := k.ProposalConflicts.Set(ctx, collections.Join(proposal.Id, id)); err != nil {
+			return v1.Proposal{}, err
+		}
+		if err := k.ProposalConflicts.Set(ctx, collections.Join(id, proposal.Id)); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	proposal.DependsOn = dependsOn
+	proposal.ConflictsWith = conflictsWith
+
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	return proposal, nil
+}
+
+// dependenciesStatus reports whether all the dependencies of a proposal have
+// passed. If one of them has been rejected, has failed or has been removed, a
+// non-empty failure reason is returned.
+func (k Keeper) dependenciesStatus(ctx context.Context, proposal v1.Proposal) (resolved bool, failedReason string, err error) {
+	resolved = true
+	for _, id := range proposal.DependsOn {
+		dependency, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, fmt.Sprintf("dependency %d was removed", id), nil
+			}
+			return false, "", err
+		}
+
+		switch dependency.Status {
+		case v1.StatusPassed:
+		case v1.StatusRejected, v1.StatusFailed:
+			return false, fmt.Sprintf("dependency %d did not pass: %s", id, dependency.Status), nil
+		default:
+			resolved = false
+		}
+	}
+
+	return resolved, "", nil
+}
+
+// rejectConflictingProposals rejects all the pending proposals conflicting with
+// the given passed proposal. Their deposits are refunded.
+func (k Keeper) rejectConflictingProposals(ctx context.Context, proposalID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID)
+	iter, err := k.ProposalConflicts.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range conflicts {
+		conflict, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		switch conflict.Status {
+		case v1.StatusDepositPeriod:
+			if err := k.InactiveProposalsQueue.Remove(ctx, collections.Join(*conflict.DepositEndTime, conflict.Id)); err != nil {
+				return err
+			}
+		case v1.StatusVotingPeriod:
+			if err := k.ActiveProposalsQueue.Remove(ctx, collections.Join(*conflict.VotingEndTime, conflict.Id)); err != nil {
+				return err
+			}
+			if err := k.deleteVotes(ctx, conflict.Id); err != nil {
+				return err
+			}
+		case v1.StatusDeferred:
+			if err := k.DeferredProposals.Remove(ctx, conflict.Id); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		// no-op for deferred proposals, their deposits were refunded at tally time.
+		if err := k.RefundAndDeleteDeposits(ctx, conflict.Id); err != nil {
+			return err
+		}
+
+		if err := k.deleteSpamFlags(ctx, conflict.Id); err != nil {
+			return err
+		}
+
+		conflict.Status = v1.StatusRejected
+		conflict.FailedReason = fmt.Sprintf("conflicting proposal %d passed", proposalID)
+		if conflict.FinalTallyResult == nil {
+			tally := v1.EmptyTallyResult()
+			conflict.FinalTallyResult = &tally
+		}
+
+		if err := k.Proposals.Set(ctx, conflict.Id, conflict); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeActiveProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", conflict.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalConflict),
+			event.NewAttribute(types.AttributeKeyProposalLog, conflict.FailedReason),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+
+		k.Logger.Info(
+			"proposal rejected",
+			"proposal", conflict.Id,
+			"title", conflict.Title,
+			"results", conflict.FailedReason,
+		)
+	}
+
+	return nil
+}
+
+// deleteProposalConflicts removes the conflicts recorded for a proposal, in
+// both directions.
+func (k Keeper) deleteProposalConflicts(ctx context.Context, proposalID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID)
+	iter, err := k.ProposalConflicts.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range conflicts {
+		if err := k.ProposalConflicts.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.ProposalConflicts.Remove(ctx, collections.Join(key.K2(), key.K1())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FlagProposalSpam records a bonded validator flagging a proposal in deposit or
+// voting period as spam. Once the validators flagging the proposal hold the
+// spam flag threshold of the bonded tokens, the proposal is closed and its
+// deposits are burnt. It returns whether the proposal was closed.
+func (k Keeper) FlagProposalSpam(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress) (bool, error) {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return false, err
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return false, types.ErrInvalidProposal.Wrap("proposal should be in the deposit or voting period")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(params.SpamFlagThreshold)
+	if err != nil {
+		return false, err
+	}
+	if !threshold.IsPositive() {
+		return false, types.ErrInvalidProposal.Wrap("spam flagging is disabled")
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		return false, err
+	}
+	if validator == nil || !validator.IsBonded() {
+		return false, sdkerrors.ErrUnauthorized.Wrap("only bonded validators can flag a proposal as spam")
+	}
+
+	key := collections.Join(proposalID, valAddr)
+	flagged, err := k.SpamFlags.Has(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if flagged {
+		return false, types.ErrAlreadyFlaggedSpam.Wrapf("proposal %d", proposalID)
+	}
+
+	if err := k.SpamFlags.Set(ctx, key); err != nil {
+		return false, err
+	}
+
+	flagPower, err := k.spamFlagPower(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	if flagPower.LT(threshold) {
+		return false, nil
+	}
+
+	return true, k.closeSpamProposal(ctx, proposal, flagPower)
+}
+
+// spamFlagPower returns the fraction of the bonded tokens held by the bonded
+// validators flagging a proposal as spam.
+func (k Keeper) spamFlagPower(ctx context.Context, proposalID uint64) (math.LegacyDec, error) {
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !totalBonded.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	flaggedTokens := math.ZeroInt()
+	var iterErr error
+	err = k.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.ValidatorI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		flagged, err := k.SpamFlags.Has(ctx, collections.Join(proposalID, sdk.ValAddress(valAddr)))
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if flagged {
+			flaggedTokens = flaggedTokens.Add(validator.GetBondedTokens())
+		}
+
+		return false
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if iterErr != nil {
+		return math.LegacyDec{}, iterErr
+	}
+
+	return math.LegacyNewDecFromInt(flaggedTokens).QuoInt(totalBonded), nil
+}
+
+// closeSpamProposal burns the deposits of a proposal flagged as spam and
+// deletes it.
+func (k Keeper) closeSpamProposal(ctx context.Context, proposal v1.Proposal, flagPower math.LegacyDec) error {
+	if err := k.DeleteAndBurnDeposits(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	if proposal.VotingStartTime != nil {
+		if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DeleteProposal(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeSpamProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeySpamFlagPower, flagPower.String()),
+	); err != nil {
+		return fmt.Errorf("failed to emit event: %w", err)
+	}
+
+	k.Logger.Info(
+		"proposal is closed as spam by validators",
+		"proposal", proposal.Id,
+		"spam_flag_power", flagPower.String(),
+	)
+
+	return nil
+}
+
+// deleteSpamFlags removes the spam flags of a proposal.
+func (k Keeper) deleteSpamFlags(ctx context.Context, proposalID uint64) error {
+	return k.SpamFlags.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID))
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFlagProposalSpam(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	require.NoError(t, trackMockBalances(mocks.bankKeeper))
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	addrs := simtestutil.CreateRandomAccounts(4)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:3])
+	s := tallyFixture{t: t, valAddrs: valAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+	expectValidators(s)
+	mocks.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			for i, valAddr := range valAddrs {
+				fn(int64(i), stakingtypes.Validator{
+					OperatorAddress: valAddr.String(),
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				})
+			}
+			return nil
+		}).
+		AnyTimes()
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(3000000), nil).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[3], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	// only bonded validators can flag a proposal.
+	_, err = govKeeper.FlagProposalSpam(ctx, proposal.Id, sdk.ValAddress(addrs[3]))
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+
+	_, err = govKeeper.FlagProposalSpam(ctx, proposal.Id+1, valAddrs[0])
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	closed, err := govKeeper.FlagProposalSpam(ctx, proposal.Id, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, closed)
+
+	_, err = govKeeper.FlagProposalSpam(ctx, proposal.Id, valAddrs[0])
+	require.ErrorIs(t, err, types.ErrAlreadyFlaggedSpam)
+
+	// two thirds of the bonded tokens are below the default threshold.
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	closed, err = govKeeper.FlagProposalSpam(ctx, proposal.Id, valAddrs[1])
+	require.NoError(t, err)
+	require.False(t, closed)
+
+	closed, err = govKeeper.FlagProposalSpam(ctx, proposal.Id, valAddrs[2])
+	require.NoError(t, err)
+	require.True(t, closed)
+
+	has, err := govKeeper.Proposals.Has(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = govKeeper.SpamFlags.Has(ctx, collections.Join(proposal.Id, valAddrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// spam flagging is disabled with a zero threshold.
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.SpamFlagThreshold = sdkmath.LegacyZeroDec().String()
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[3], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	_, err = govKeeper.FlagProposalSpam(ctx, proposal.Id, valAddrs[0])
+	require.ErrorContains(t, err, "spam flagging is disabled")
+}
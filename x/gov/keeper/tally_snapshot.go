@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TallySnapshot returns the tally of the votes cast so far on a proposal in
+// voting period, along with the block height at which it was last updated.
+//
+// Unlike Tally, it doesn't iterate over the votes: the tally is updated on each
+// vote with the voting power the voter has at the time of the vote, so the
+// voting power changes which happened after a vote are not reflected in it. It
+// always uses the default voting power of the voters, regardless of
+// Config.CalculateVoteResultsAndVotingPowerFn.
+func (k Keeper) TallySnapshot(ctx context.Context, proposalID uint64) (v1.TallyResult, int64, error) {
+	snapshot, err := k.TallySnapshots.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.EmptyTallyResult(), 0, nil
+		}
+		return v1.TallyResult{}, 0, err
+	}
+
+	results, err := tallySnapshotResults(snapshot)
+	if err != nil {
+		return v1.TallyResult{}, 0, err
+	}
+
+	return v1.NewTallyResultFromMap(results), snapshot.Height, nil
+}
+
+// RebuildTallySnapshots recomputes the tally snapshots of all the proposals in
+// voting period from their votes, with the current voting power of the voters.
+func (k Keeper) RebuildTallySnapshots(ctx context.Context) error {
+	for _, err := range []error{
+		k.TallySnapshots.Clear(ctx, nil),
+		k.VotePowers.Clear(ctx, nil),
+		k.TallyDeductions.Clear(ctx, nil),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	var proposalIDs []uint64
+	if err := k.Proposals.Walk(ctx, nil, func(id uint64, proposal v1.Proposal) (bool, error) {
+		if proposal.Status == v1.StatusVotingPeriod {
+			proposalIDs = append(proposalIDs, id)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, proposalID := range proposalIDs {
+		// the votes are collected first as the store is written while
+		// accounting them.
+		var votes []collections.KeyValue[collections.Pair[uint64, sdk.AccAddress], v1.Vote]
+		rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+		if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+			votes = append(votes, collections.KeyValue[collections.Pair[uint64, sdk.AccAddress], v1.Vote]{Key: key, Value: vote})
+			return false, nil
+		}); err != nil {
+			return err
+		}
+
+		for _, vote := range votes {
+			if err := k.updateTallySnapshot(ctx, proposalID, vote.Key.K2(), vote.Value.Options); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateTallySnapshot accounts the vote of voter in the tally snapshot of a
+// proposal, replacing its previous vote if any. It must be called before the
+// vote is stored, as the previous vote is read from the store.
+//
+// The snapshot follows the default tally: a voter has the voting power of its
+// delegations to bonded validators, and a bonded validator has the voting power
+// of its bonded tokens minus the voting power of the delegations of the voters
+// who voted themselves. The latter are recorded per validator, so that the
+// power of a validator can be adjusted when its delegators vote after it.
+func (k Keeper) updateTallySnapshot(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+	snapshot, err := k.TallySnapshots.Get(ctx, proposalID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	results, err := tallySnapshotResults(snapshot)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(proposalID, voter)
+	power, err := k.VotePowers.Get(ctx, key)
+	switch {
+	case err == nil:
+		previous, err := k.Votes.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeVotePower(ctx, proposalID, voter, previous.Options, power, results); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.addVotePower(ctx, proposalID, voter, options, results); err != nil {
+		return err
+	}
+
+	return k.TallySnapshots.Set(ctx, proposalID, newTallySnapshot(results, k.HeaderService.HeaderInfo(ctx).Height))
+}
+
+// addVotePower adds the voting power of voter to the results and records it.
+// The delegations of the voter are accounted before its own validator power,
+// so that its delegation to itself is deducted from the latter.
+func (k Keeper) addVotePower(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions, results map[v1.VoteOption]math.LegacyDec) error {
+	var power v1.VotePower
+
+	var iterErr error
+	if err := k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		validator, err := k.sk.Validator(ctx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if validator == nil || !validator.IsBonded() {
+			return false
+		}
+
+		// delegation shares * bonded / total shares
+		votingPower := delegation.GetShares().MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares())
+		addWeightedPower(results, options, votingPower)
+
+		// the delegation no longer counts in the voting power of the validator.
+		if iterErr = k.deductFromValidator(ctx, proposalID, valAddr, votingPower, results); iterErr != nil {
+			return true
+		}
+
+		power.Delegations = append(power.Delegations, &v1.ValidatorVotePower{
+			ValidatorAddress: delegation.GetValidatorAddr(),
+			Power:            votingPower.String(),
+		})
+		return false
+	}); err != nil {
+		return err
+	}
+	if iterErr != nil {
+		return iterErr
+	}
+
+	// the staking keeper returns an error if the voter is not a validator.
+	validator, err := k.sk.Validator(ctx, sdk.ValAddress(voter))
+	if err == nil && validator != nil && validator.IsBonded() {
+		deductions, err := k.tallyDeductions(ctx, proposalID, sdk.ValAddress(voter))
+		if err != nil {
+			return err
+		}
+
+		validatorPower := math.LegacyNewDecFromInt(validator.GetBondedTokens())
+		addWeightedPower(results, options, validatorPower.Sub(deductions))
+		power.ValidatorPower = validatorPower.String()
+	}
+
+	return k.VotePowers.Set(ctx, collections.Join(proposalID, voter), power)
+}
+
+// removeVotePower removes the voting power recorded for the vote of voter
+// from the results, in the reverse order of addVotePower.
+func (k Keeper) removeVotePower(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions, power v1.VotePower, results map[v1.VoteOption]math.LegacyDec) error {
+	if err := k.VotePowers.Remove(ctx, collections.Join(proposalID, voter)); err != nil {
+		return err
+	}
+
+	if power.ValidatorPower != "" {
+		validatorPower, err := math.LegacyNewDecFromStr(power.ValidatorPower)
+		if err != nil {
+			return err
+		}
+
+		deductions, err := k.tallyDeductions(ctx, proposalID, sdk.ValAddress(voter))
+		if err != nil {
+			return err
+		}
+
+		addWeightedPower(results, options, validatorPower.Sub(deductions).Neg())
+	}
+
+	for _, delegation := range power.Delegations {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		votingPower, err := math.LegacyNewDecFromStr(delegation.Power)
+		if err != nil {
+			return err
+		}
+
+		addWeightedPower(results, options, votingPower.Neg())
+		if err := k.deductFromValidator(ctx, proposalID, valAddr, votingPower.Neg(), results); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deductFromValidator deducts the given voting power from the voting power of
+// a validator, adjusting the results if the validator voted.
+func (k Keeper) deductFromValidator(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress, votingPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec) error {
+	deductions, err := k.tallyDeductions(ctx, proposalID, valAddr)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(proposalID, valAddr)
+	if deductions = deductions.Add(votingPower); deductions.IsZero() {
+		err = k.TallyDeductions.Remove(ctx, key)
+	} else {
+		err = k.TallyDeductions.Set(ctx, key, deductions)
+	}
+	if err != nil {
+		return err
+	}
+
+	// a validator has voted if the voting power of its vote was recorded as a
+	// validator, whether or not its vote is stored yet.
+	power, err := k.VotePowers.Get(ctx, collections.Join(proposalID, sdk.AccAddress(valAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if power.ValidatorPower == "" {
+		return nil
+	}
+
+	vote, err := k.Votes.Get(ctx, collections.Join(proposalID, sdk.AccAddress(valAddr)))
+	if err != nil {
+		return err
+	}
+
+	addWeightedPower(results, vote.Options, votingPower.Neg())
+	return nil
+}
+
+// tallyDeductions returns the voting power deducted from a validator.
+func (k Keeper) tallyDeductions(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	deductions, err := k.TallyDeductions.Get(ctx, collections.Join(proposalID, valAddr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyZeroDec(), nil
+		}
+		return math.LegacyDec{}, err
+	}
+
+	return deductions, nil
+}
+
+// deleteTallySnapshot deletes the tally snapshot of a proposal along with the
+// voting power recorded for its votes.
+func (k Keeper) deleteTallySnapshot(ctx context.Context, proposalID uint64) error {
+	if err := k.TallySnapshots.Remove(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := k.VotePowers.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)); err != nil {
+		return err
+	}
+
+	return k.TallyDeductions.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID))
+}
+
+// addWeightedPower adds the given voting power to the results, split across
+// the weighted vote options.
+func addWeightedPower(results map[v1.VoteOption]math.LegacyDec, options v1.WeightedVoteOptions, votingPower math.LegacyDec) {
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+	}
+}
+
+func tallySnapshotResults(snapshot v1.TallySnapshot) (map[v1.VoteOption]math.LegacyDec, error) {
+	results := createEmptyResults()
+	for option, power := range map[v1.VoteOption]string{
+		v1.OptionOne:   snapshot.OptionOnePower,
+		v1.OptionTwo:   snapshot.OptionTwoPower,
+		v1.OptionThree: snapshot.OptionThreePower,
+		v1.OptionFour:  snapshot.OptionFourPower,
+		v1.OptionSpam:  snapshot.SpamPower,
+	} {
+		if power == "" {
+			continue
+		}
+
+		dec, err := math.LegacyNewDecFromStr(power)
+		if err != nil {
+			return nil, err
+		}
+		results[option] = dec
+	}
+
+	return results, nil
+}
+
+func newTallySnapshot(results map[v1.VoteOption]math.LegacyDec, height int64) v1.TallySnapshot {
+	return v1.TallySnapshot{
+		OptionOnePower:   results[v1.OptionOne].String(),
+		OptionTwoPower:   results[v1.OptionTwo].String(),
+		OptionThreePower: results[v1.OptionThree].String(),
+		OptionFourPower:  results[v1.OptionFour].String(),
+		SpamPower:        results[v1.OptionSpam].String(),
+		Height:           height,
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTallySnapshot(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+	delAddr := addrs[2]
+	s := tallyFixture{t: t, valAddrs: valAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+	expectValidators(s)
+
+	// the first validator delegates to itself, the delegator to both validators.
+	delegations := map[string][]stakingtypes.Delegation{
+		sdk.AccAddress(valAddrs[0]).String(): {
+			{DelegatorAddress: sdk.AccAddress(valAddrs[0]).String(), ValidatorAddress: valAddrs[0].String(), Shares: sdkmath.LegacyNewDec(100000)},
+		},
+		delAddr.String(): {
+			{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddrs[0].String(), Shares: sdkmath.LegacyNewDec(300000)},
+			{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddrs[1].String(), Shares: sdkmath.LegacyNewDec(200000)},
+		},
+	}
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+			for i, d := range delegations[voter.String()] {
+				fn(int64(i), d)
+			}
+			return nil
+		}).
+		AnyTimes()
+	mocks.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			for i, valAddr := range valAddrs {
+				fn(int64(i), stakingtypes.Validator{
+					OperatorAddress: valAddr.String(),
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				})
+			}
+			return nil
+		}).
+		AnyTimes()
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(2000000), nil).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	queryServer := keeper.NewQueryServer(govKeeper)
+	_, err = queryServer.TallySnapshot(ctx, &v1.QueryTallySnapshotRequest{ProposalId: proposal.Id})
+	require.ErrorContains(t, err, "not in voting period")
+
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	res, err := queryServer.TallySnapshot(ctx, &v1.QueryTallySnapshotRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.EmptyTallyResult(), *res.Tally)
+	require.Zero(t, res.Height)
+
+	tally := func(yes, abstain, no int64) v1.TallyResult {
+		return v1.NewTallyResult(sdkmath.NewInt(yes), sdkmath.NewInt(abstain), sdkmath.NewInt(no), sdkmath.ZeroInt(), sdkmath.ZeroInt())
+	}
+	vote := func(voter sdk.AccAddress, options v1.WeightedVoteOptions, expected v1.TallyResult) {
+		t.Helper()
+		require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, options, ""))
+
+		res, err := queryServer.TallySnapshot(ctx, &v1.QueryTallySnapshotRequest{ProposalId: proposal.Id})
+		require.NoError(t, err)
+		require.Equal(t, expected, *res.Tally)
+		require.Equal(t, int64(10), res.Height)
+	}
+
+	vote(delAddr, v1.NewNonSplitVoteOption(v1.OptionNo), tally(0, 0, 500000))
+	vote(sdk.AccAddress(valAddrs[0]), v1.NewNonSplitVoteOption(v1.OptionYes), tally(700000, 0, 500000))
+	// the delegations of a validator to itself are not deducted from its own
+	// voting power when it changes its vote.
+	vote(sdk.AccAddress(valAddrs[0]), v1.NewNonSplitVoteOption(v1.OptionAbstain), tally(0, 700000, 500000))
+	// the delegations of a voter are given back to the validators which voted
+	// when it changes its vote.
+	vote(delAddr, v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, sdkmath.LegacyNewDecWithPrec(5, 1)),
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(5, 1)),
+	}, tally(250000, 700000, 250000))
+	vote(sdk.AccAddress(valAddrs[1]), v1.NewNonSplitVoteOption(v1.OptionNo), tally(250000, 700000, 1050000))
+
+	// the snapshot is rebuilt identically from the votes.
+	require.NoError(t, govKeeper.RebuildTallySnapshots(ctx))
+	snapshot, _, err := govKeeper.TallySnapshot(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, tally(250000, 700000, 1050000), snapshot)
+
+	// the snapshot matches the tally of the votes.
+	_, _, result, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, result, snapshot)
+}
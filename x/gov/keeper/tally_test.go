@@ -36,17 +36,42 @@ var (
 		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil)
 	}
 	delegatorVote = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption) {
+		iterateDelegations := func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+			for i, d := range delegations {
+				fn(int64(i), d)
+			}
+			return nil
+		}
+		// the delegations are iterated once by the tally snapshot when voting,
+		// and once by the tally.
+		expectValidators(s)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, voter, gomock.Any()).
+			DoAndReturn(iterateDelegations)
 		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
 		require.NoError(s.t, err)
 		s.mocks.stakingKeeper.EXPECT().
 			IterateDelegations(s.ctx, voter, gomock.Any()).
+			DoAndReturn(iterateDelegations)
+	}
+	expectValidators = func(s tallyFixture) {
+		s.mocks.stakingKeeper.EXPECT().
+			Validator(s.ctx, gomock.Any()).
 			DoAndReturn(
-				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
-					for i, d := range delegations {
-						fn(int64(i), d)
+				func(ctx context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error) {
+					for _, valAddr := range s.valAddrs {
+						if valAddr.Equals(addr) {
+							return stakingtypes.Validator{
+								OperatorAddress: valAddr.String(),
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							}, nil
+						}
 					}
-					return nil
-				})
+					return nil, stakingtypes.ErrNoValidatorFound
+				}).
+			AnyTimes()
 	}
 	validatorVote = func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
 		// validatorVote is like delegatorVote but without delegations
@@ -506,12 +531,16 @@ func TestTally_Standard(t *testing.T) {
 			}
 			tt.setup(suite)
 
+			snapshot, _, err := govKeeper.TallySnapshot(ctx, proposal.Id)
+			require.NoError(t, err)
+
 			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
 			assert.Equal(t, tt.expectedTally, tally)
+			assert.Equal(t, tt.expectedTally, snapshot, "wrong tally snapshot")
 			// Assert votes removal after tally
 			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
@@ -976,12 +1005,16 @@ func TestTally_Expedited(t *testing.T) {
 			}
 			tt.setup(suite)
 
+			snapshot, _, err := govKeeper.TallySnapshot(ctx, proposal.Id)
+			require.NoError(t, err)
+
 			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
 			assert.Equal(t, tt.expectedTally, tally)
+			assert.Equal(t, tt.expectedTally, snapshot, "wrong tally snapshot")
 			// Assert votes removal after tally
 			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
@@ -1140,12 +1173,16 @@ func TestTally_Optimistic(t *testing.T) {
 			}
 			tt.setup(suite)
 
+			snapshot, _, err := govKeeper.TallySnapshot(ctx, proposal.Id)
+			require.NoError(t, err)
+
 			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
 			assert.Equal(t, tt.expectedTally, tally)
+			assert.Equal(t, tt.expectedTally, snapshot, "wrong tally snapshot")
 			// Assert votes removal after tally
 			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
@@ -1533,12 +1570,16 @@ func TestTally_MultipleChoice(t *testing.T) {
 			}
 			tt.setup(suite)
 
+			snapshot, _, err := govKeeper.TallySnapshot(ctx, proposal.Id)
+			require.NoError(t, err)
+
 			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
 			assert.Equal(t, tt.expectedTally, tally)
+			assert.Equal(t, tt.expectedTally, snapshot, "wrong tally snapshot")
 			// Assert votes removal after tally
 			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
@@ -77,6 +77,11 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
+	// the tally snapshot reads the previous vote of the voter, if any.
+	if err := k.updateTallySnapshot(ctx, proposalID, voterAddr, options); err != nil {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
@@ -95,7 +100,8 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	)
 }
 
-// deleteVotes deletes all the votes from a given proposalID.
+// deleteVotes deletes all the votes from a given proposalID, along with its
+// tally snapshot.
 func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
 	err := k.Votes.Clear(ctx, rng)
@@ -103,5 +109,5 @@ func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 		return err
 	}
 
-	return nil
+	return k.deleteTallySnapshot(ctx, proposalID)
 }
@@ -26,14 +26,15 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
-const ConsensusVersion = 6
+const ConsensusVersion = 8
 
 var (
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasInvariants       = AppModule{}
+	_ module.HasName                = AppModule{}
+	_ module.HasAminoCodec          = AppModule{}
+	_ module.HasGRPCGateway         = AppModule{}
+	_ module.AppModuleSimulation    = AppModule{}
+	_ module.HasInvariants          = AppModule{}
+	_ module.HasBlockSummaryMetrics = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasEndBlocker         = AppModule{}
@@ -115,6 +116,20 @@ func (AppModule) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	v1beta1.RegisterInterfaces(registrar)
 }
 
+// RegisterBlockSummaryMetrics registers the proposals which entered or left
+// their voting period during the block in the block summary event.
+func (am AppModule) RegisterBlockSummaryMetrics(registry sdk.BlockSummaryRegistry) {
+	registry.RegisterBlockSummaryMetrics(
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeSubmitProposal, Attribute: govtypes.AttributeKeyVotingPeriodStart},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeProposalDeposit, Attribute: govtypes.AttributeKeyVotingPeriodStart},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeActiveProposal},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeInactiveProposal},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeDeferredProposal},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeCancelProposal},
+		sdk.BlockSummaryMetric{Name: govtypes.BlockSummaryKeyProposalsChanged, EventType: govtypes.EventTypeSpamProposal},
+	)
+}
+
 // RegisterInvariants registers module invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper, am.bankKeeper)
@@ -159,6 +174,14 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/gov from version 5 to 6: %w", err)
 	}
 
+	if err := mr.Register(govtypes.ModuleName, 6, m.Migrate6to7); err != nil {
+		return fmt.Errorf("failed to migrate x/gov from version 6 to 7: %w", err)
+	}
+
+	if err := mr.Register(govtypes.ModuleName, 7, m.Migrate7to8); err != nil {
+		return fmt.Errorf("failed to migrate x/gov from version 7 to 8: %w", err)
+	}
+
 	return nil
 }
 
@@ -33,6 +33,7 @@ const (
 	ProposalCancelRate            = "proposal_cancel_rate"
 	ProposalMaxCancelVotingPeriod = "proposal_max_cancel_voting_period"
 	MinDepositRatio               = "min_deposit_ratio"
+	SpamFlagThreshold             = "spam_flag_threshold"
 
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
@@ -118,6 +119,11 @@ func GenMinDepositRatio(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyMustNewDecFromStr("0.01")
 }
 
+// GenSpamFlagThreshold returns randomized SpamFlagThreshold
+func GenSpamFlagThreshold(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 500, 1000)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -170,6 +176,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var minDepositRatio sdkmath.LegacyDec
 	simState.AppParams.GetOrGenerate(MinDepositRatio, &minDepositRatio, simState.Rand, func(r *rand.Rand) { minDepositRatio = GenMinDepositRatio(r) })
 
+	var spamFlagThreshold sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(SpamFlagThreshold, &spamFlagThreshold, simState.Rand, func(r *rand.Rand) { spamFlagThreshold = GenSpamFlagThreshold(r) })
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
 		v1.NewParams(
@@ -194,6 +203,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			minDepositRatio.String(),
 			optimisticRejectedThreshold.String(),
 			[]string{},
+			spamFlagThreshold.String(),
 		),
 	)
 
@@ -55,7 +55,8 @@ type StakingKeeper interface {
 		context.Context, func(index int64, validator sdk.ValidatorI) (stop bool),
 	) error
 
-	TotalBondedTokens(context.Context) (math.Int, error) // total bonded tokens within the validator set
+	TotalBondedTokens(context.Context) (math.Int, error)               // total bonded tokens within the validator set
+	Validator(context.Context, sdk.ValAddress) (sdk.ValidatorI, error) // get a particular validator by operator address
 	IterateDelegations(
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
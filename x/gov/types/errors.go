@@ -28,4 +28,6 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrInvalidProposalRelation = errors.Register(ModuleName, 27, "invalid proposal dependency or conflict")
+	ErrAlreadyFlaggedSpam      = errors.Register(ModuleName, 28, "proposal already flagged as spam by validator")
 )
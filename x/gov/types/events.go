@@ -8,6 +8,9 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDeferredProposal = "deferred_proposal"
+	EventTypeFlagProposalSpam = "flag_proposal_spam"
+	EventTypeSpamProposal     = "spam_proposal"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +22,8 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyValidator            = "validator"              // operator address of the validator flagging a proposal as spam
+	AttributeKeySpamFlagPower        = "spam_flag_power"        // fraction of the bonded tokens held by the validators flagging a proposal as spam
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -27,8 +32,12 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalDeferred           = "proposal_deferred"            // passed, waiting for its dependencies
+	AttributeValueProposalConflict           = "proposal_conflict"            // rejected because a conflicting proposal passed
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
 	AttributeSignalDescription = "signal_description"
+
+	BlockSummaryKeyProposalsChanged = "proposals_changed_state"
 )
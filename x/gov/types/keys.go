@@ -26,6 +26,13 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	DeferredProposalsKeyPrefix   = collections.NewPrefix(52) // DeferredProposalsKeyPrefix stores the ids of the deferred proposals.
+	ProposalConflictsKeyPrefix   = collections.NewPrefix(53) // ProposalConflictsKeyPrefix stores the conflicts between proposals.
+	TallySnapshotsKeyPrefix      = collections.NewPrefix(54) // TallySnapshotsKeyPrefix stores the tally snapshots of proposals in voting period.
+	VotePowersKeyPrefix          = collections.NewPrefix(55) // VotePowersKeyPrefix stores the voting power of the votes accounted in the tally snapshots.
+	TallyDeductionsKeyPrefix     = collections.NewPrefix(56) // TallyDeductionsKeyPrefix stores the voting power deducted from validators in the tally snapshots.
+	SpamFlagsKeyPrefix           = collections.NewPrefix(57) // SpamFlagsKeyPrefix stores the validators flagging proposals as spam.
+	ExecutionReportsKeyPrefix    = collections.NewPrefix(58) // ExecutionReportsKeyPrefix stores the execution reports of passed proposals.
 )
 
 // Reserved kvstore keys
@@ -154,6 +154,10 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_DEFERRED defines a proposal status of a proposal that has
+	// passed but whose execution is deferred until the proposals it depends on
+	// have passed.
+	ProposalStatus_PROPOSAL_STATUS_DEFERRED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +167,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_DEFERRED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +177,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_DEFERRED":       6,
 }
 
 func (x ProposalStatus) String() string {
@@ -342,6 +348,13 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// depends_on defines the ids of the proposals that must have passed before
+	// this proposal can be executed. A passed proposal whose dependencies are
+	// still pending is deferred until they are resolved.
+	DependsOn []uint64 `protobuf:"varint,17,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// conflicts_with defines the ids of the proposals that are mutually exclusive
+	// with this proposal. When one of them passes, the others are rejected.
+	ConflictsWith []uint64 `protobuf:"varint,18,rep,packed,name=conflicts_with,json=conflictsWith,proto3" json:"conflicts_with,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +503,20 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetDependsOn() []uint64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *Proposal) GetConflictsWith() []uint64 {
+	if m != nil {
+		return m.ConflictsWith
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -956,15 +983,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1002,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1004,6 +1031,10 @@ type Params struct {
 	// Minimum percentage of total stake needed to vote for a result to be
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	// spam_flag_threshold defines the minimum fraction of the total bonded tokens
+	// held by the validators flagging a proposal as spam for the proposal to be
+	// closed and its deposits burnt. Zero disables spam flagging.
+	SpamFlagThreshold string `protobuf:"bytes,22,opt,name=spam_flag_threshold,json=spamFlagThreshold,proto3" json:"spam_flag_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1186,6 +1217,13 @@ func (m *Params) GetExpeditedQuorum() string {
 	return ""
 }
 
+func (m *Params) GetSpamFlagThreshold() string {
+	if m != nil {
+		return m.SpamFlagThreshold
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1271,6 +1309,392 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// TallySnapshot defines the tally of a proposal in voting period, updated
+// incrementally on each vote with the voting power the voter has at the time of
+// the vote.
+type TallySnapshot struct {
+	// option_one_power is the voting power of the votes for option one.
+	OptionOnePower string `protobuf:"bytes,1,opt,name=option_one_power,json=optionOnePower,proto3" json:"option_one_power,omitempty"`
+	// option_two_power is the voting power of the votes for option two.
+	OptionTwoPower string `protobuf:"bytes,2,opt,name=option_two_power,json=optionTwoPower,proto3" json:"option_two_power,omitempty"`
+	// option_three_power is the voting power of the votes for option three.
+	OptionThreePower string `protobuf:"bytes,3,opt,name=option_three_power,json=optionThreePower,proto3" json:"option_three_power,omitempty"`
+	// option_four_power is the voting power of the votes for option four.
+	OptionFourPower string `protobuf:"bytes,4,opt,name=option_four_power,json=optionFourPower,proto3" json:"option_four_power,omitempty"`
+	// spam_power is the voting power of the spam votes.
+	SpamPower string `protobuf:"bytes,5,opt,name=spam_power,json=spamPower,proto3" json:"spam_power,omitempty"`
+	// height is the block height at which the snapshot was last updated.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TallySnapshot) Reset()         { *m = TallySnapshot{} }
+func (m *TallySnapshot) String() string { return proto.CompactTextString(m) }
+func (*TallySnapshot) ProtoMessage()    {}
+func (*TallySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *TallySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallySnapshot.Merge(m, src)
+}
+func (m *TallySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallySnapshot proto.InternalMessageInfo
+
+func (m *TallySnapshot) GetOptionOnePower() string {
+	if m != nil {
+		return m.OptionOnePower
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetOptionTwoPower() string {
+	if m != nil {
+		return m.OptionTwoPower
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetOptionThreePower() string {
+	if m != nil {
+		return m.OptionThreePower
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetOptionFourPower() string {
+	if m != nil {
+		return m.OptionFourPower
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetSpamPower() string {
+	if m != nil {
+		return m.SpamPower
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// VotePower defines the voting power a vote contributed to the tally snapshot
+// of a proposal.
+type VotePower struct {
+	// delegations are the voting power of the voter through each of its
+	// delegations to bonded validators.
+	Delegations []*ValidatorVotePower `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// validator_power is the voting power of the voter as a bonded validator,
+	// before the deduction of the delegations of the voters who voted
+	// themselves. It is empty if the voter is not a bonded validator.
+	ValidatorPower string `protobuf:"bytes,2,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+}
+
+func (m *VotePower) Reset()         { *m = VotePower{} }
+func (m *VotePower) String() string { return proto.CompactTextString(m) }
+func (*VotePower) ProtoMessage()    {}
+func (*VotePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *VotePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePower.Merge(m, src)
+}
+func (m *VotePower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotePower proto.InternalMessageInfo
+
+func (m *VotePower) GetDelegations() []*ValidatorVotePower {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *VotePower) GetValidatorPower() string {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return ""
+}
+
+// ValidatorVotePower defines the voting power of a voter through its
+// delegation to a validator.
+type ValidatorVotePower struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// power is the voting power of the delegation.
+	Power string `protobuf:"bytes,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorVotePower) Reset()         { *m = ValidatorVotePower{} }
+func (m *ValidatorVotePower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVotePower) ProtoMessage()    {}
+func (*ValidatorVotePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *ValidatorVotePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVotePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVotePower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorVotePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVotePower.Merge(m, src)
+}
+func (m *ValidatorVotePower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVotePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVotePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVotePower proto.InternalMessageInfo
+
+func (m *ValidatorVotePower) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorVotePower) GetPower() string {
+	if m != nil {
+		return m.Power
+	}
+	return ""
+}
+
+// ExecutionReport defines the result of the execution of the messages of a
+// passed proposal.
+type ExecutionReport struct {
+	// proposal_id is the id of the executed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// success is true if all the messages of the proposal were executed
+	// successfully. The messages are executed atomically: if one of them fails,
+	// the state changes of all of them are discarded.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the error which failed the execution, empty on success.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas consumed by the execution of the messages.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// results are the results of the messages executed, in order. The execution
+	// stops at the first failing message, whose result is the last one.
+	Results []MessageExecutionResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+	// height is the block height at which the proposal was executed.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// executed_at is the block time at which the proposal was executed.
+	ExecutedAt time.Time `protobuf:"bytes,7,opt,name=executed_at,json=executedAt,proto3,stdtime" json:"executed_at"`
+}
+
+func (m *ExecutionReport) Reset()         { *m = ExecutionReport{} }
+func (m *ExecutionReport) String() string { return proto.CompactTextString(m) }
+func (*ExecutionReport) ProtoMessage()    {}
+func (*ExecutionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *ExecutionReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionReport.Merge(m, src)
+}
+func (m *ExecutionReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionReport proto.InternalMessageInfo
+
+func (m *ExecutionReport) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ExecutionReport) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionReport) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionReport) GetResults() []MessageExecutionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ExecutionReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionReport) GetExecutedAt() time.Time {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return time.Time{}
+}
+
+// MessageExecutionResult defines the result of the execution of a message of a
+// passed proposal.
+type MessageExecutionResult struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_used is the gas consumed by the execution of the message.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error is the error returned by the message, empty on success.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// events are the events emitted by the message, empty on failure.
+	Events []types.StringEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events"`
+}
+
+func (m *MessageExecutionResult) Reset()         { *m = MessageExecutionResult{} }
+func (m *MessageExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*MessageExecutionResult) ProtoMessage()    {}
+func (*MessageExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{15}
+}
+func (m *MessageExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageExecutionResult.Merge(m, src)
+}
+func (m *MessageExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageExecutionResult proto.InternalMessageInfo
+
+func (m *MessageExecutionResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MessageExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MessageExecutionResult) GetEvents() []types.StringEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1286,136 +1710,168 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*TallySnapshot)(nil), "cosmos.gov.v1.TallySnapshot")
+	proto.RegisterType((*VotePower)(nil), "cosmos.gov.v1.VotePower")
+	proto.RegisterType((*ValidatorVotePower)(nil), "cosmos.gov.v1.ValidatorVotePower")
+	proto.RegisterType((*ExecutionReport)(nil), "cosmos.gov.v1.ExecutionReport")
+	proto.RegisterType((*MessageExecutionResult)(nil), "cosmos.gov.v1.MessageExecutionResult")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0xf6, 0x92, 0x14, 0x25, 0xbe, 0x22, 0xa9, 0xd5, 0x48, 0x8a, 0xd6, 0x52, 0x44, 0xc9, 0x44,
-	0x11, 0xa8, 0x4e, 0x44, 0x4a, 0x49, 0xd5, 0xa6, 0x6e, 0x72, 0x20, 0xc5, 0x75, 0xbc, 0x86, 0x25,
-	0xb2, 0xcb, 0xb5, 0x6c, 0xb7, 0x28, 0x16, 0x2b, 0xed, 0x58, 0xda, 0x84, 0xbb, 0xc3, 0xee, 0x0e,
-	0xf5, 0xd1, 0x5f, 0x91, 0x63, 0x4f, 0x45, 0x6f, 0xcd, 0xb1, 0x07, 0xa3, 0xf7, 0xde, 0x82, 0x1e,
-	0x8a, 0xc0, 0xa7, 0x22, 0x40, 0xdd, 0xc2, 0x3e, 0x14, 0xc8, 0x4f, 0x28, 0x0a, 0xb4, 0x98, 0xd9,
-	0x59, 0xee, 0xf2, 0x43, 0x16, 0x15, 0xf4, 0x62, 0x53, 0xf3, 0x3e, 0xcf, 0x33, 0xef, 0xbc, 0x5f,
-	0x33, 0x24, 0x2c, 0x1f, 0x93, 0xc0, 0x25, 0x41, 0xf5, 0x84, 0x9c, 0x55, 0xcf, 0x76, 0xd8, 0x7f,
-	0x95, 0xae, 0x4f, 0x28, 0x41, 0x85, 0xd0, 0x50, 0x61, 0x2b, 0x67, 0x3b, 0x2b, 0x25, 0x81, 0x3b,
-	0xb2, 0x02, 0x5c, 0x3d, 0xdb, 0x39, 0xc2, 0xd4, 0xda, 0xa9, 0x1e, 0x13, 0xc7, 0x0b, 0xe1, 0x2b,
-	0x8b, 0x27, 0xe4, 0x84, 0xf0, 0x8f, 0x55, 0xf6, 0x49, 0xac, 0xae, 0x9f, 0x10, 0x72, 0xd2, 0xc1,
-	0x55, 0xfe, 0xd7, 0x51, 0xef, 0x79, 0x95, 0x3a, 0x2e, 0x0e, 0xa8, 0xe5, 0x76, 0x05, 0xe0, 0xf6,
-	0x30, 0xc0, 0xf2, 0x2e, 0x85, 0xa9, 0x34, 0x6c, 0xb2, 0x7b, 0xbe, 0x45, 0x1d, 0x12, 0xed, 0x78,
-	0x3b, 0xf4, 0xc8, 0x0c, 0x37, 0x15, 0xde, 0x86, 0xa6, 0x79, 0xcb, 0x75, 0x3c, 0x52, 0xe5, 0xff,
-	0x86, 0x4b, 0x65, 0x02, 0xe8, 0x09, 0x76, 0x4e, 0x4e, 0x29, 0xb6, 0x0f, 0x09, 0xc5, 0xcd, 0x2e,
-	0x53, 0x42, 0x3b, 0x90, 0x25, 0xfc, 0x93, 0x22, 0x6d, 0x48, 0x9b, 0xc5, 0x0f, 0x6f, 0x57, 0x06,
-	0x4e, 0x5d, 0x89, 0xa1, 0xba, 0x00, 0xa2, 0xf7, 0x20, 0x7b, 0xce, 0x85, 0x94, 0xd4, 0x86, 0xb4,
-	0x99, 0xab, 0x17, 0x5f, 0xbe, 0xd8, 0x02, 0xc1, 0x6a, 0xe0, 0x63, 0x5d, 0x58, 0xcb, 0xbf, 0x97,
-	0x60, 0xba, 0x81, 0xbb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0xd9, 0xae, 0x4f, 0xba, 0x24, 0xb0, 0x3a,
-	0xa6, 0x63, 0xf3, 0xbd, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x39, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0x2f, 0x5f, 0x6c, 0x2d, 0x0a, 0xdd, 0x9a, 0x6d, 0xfb, 0x38, 0x08, 0xda, 0xd4,
-	0x77, 0xbc, 0x13, 0x3d, 0x86, 0xa2, 0x4f, 0x20, 0x6b, 0xb9, 0xa4, 0xe7, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9c, 0x8d, 0xfd, 0x67, 0x69, 0xaa, 0x88, 0x34, 0x55, 0xf6, 0x88, 0xe3, 0xd5, 0x73, 0x5f,
-	0xbf, 0x5a, 0xbf, 0xf5, 0xd5, 0xbf, 0xfe, 0x78, 0x57, 0xd2, 0x05, 0xa7, 0xfc, 0xe7, 0x69, 0x98,
-	0x69, 0x09, 0x27, 0x50, 0x11, 0x52, 0x7d, 0xd7, 0x52, 0x8e, 0x8d, 0xb6, 0x61, 0xc6, 0xc5, 0x41,
-	0x60, 0x9d, 0xe0, 0x40, 0x49, 0x71, 0xf1, 0xc5, 0x4a, 0x98, 0x91, 0x4a, 0x94, 0x91, 0x4a, 0xcd,
-	0xbb, 0xd4, 0xfb, 0x28, 0xb4, 0x0b, 0xd9, 0x80, 0x5a, 0xb4, 0x17, 0x28, 0x69, 0x1e, 0xcc, 0xb5,
-	0xa1, 0x60, 0x46, 0x5b, 0xb5, 0x39, 0x48, 0x17, 0x60, 0xf4, 0x00, 0xd0, 0x73, 0xc7, 0xb3, 0x3a,
-	0x26, 0xb5, 0x3a, 0x9d, 0x4b, 0xd3, 0xc7, 0x41, 0xaf, 0x43, 0x95, 0xcc, 0x86, 0xb4, 0x39, 0xfb,
-	0xe1, 0xca, 0x90, 0x84, 0xc1, 0x20, 0x3a, 0x47, 0xe8, 0x32, 0x67, 0x25, 0x56, 0x50, 0x0d, 0x66,
-	0x83, 0xde, 0x91, 0xeb, 0x50, 0x93, 0x95, 0x99, 0x32, 0x25, 0x24, 0x86, 0xbd, 0x36, 0xa2, 0x1a,
-	0xac, 0x67, 0xbe, 0xfc, 0xc7, 0xba, 0xa4, 0x43, 0x48, 0x62, 0xcb, 0xe8, 0x21, 0xc8, 0x22, 0xba,
-	0x26, 0xf6, 0xec, 0x50, 0x27, 0x3b, 0xa1, 0x4e, 0x51, 0x30, 0x55, 0xcf, 0xe6, 0x5a, 0x1a, 0x14,
-	0x28, 0xa1, 0x56, 0xc7, 0x14, 0xeb, 0xca, 0xf4, 0x0d, 0x72, 0x94, 0xe7, 0xd4, 0xa8, 0x80, 0x1e,
-	0xc1, 0xfc, 0x19, 0xa1, 0x8e, 0x77, 0x62, 0x06, 0xd4, 0xf2, 0xc5, 0xf9, 0x66, 0x26, 0xf4, 0x6b,
-	0x2e, 0xa4, 0xb6, 0x19, 0x93, 0x3b, 0xf6, 0x00, 0xc4, 0x52, 0x7c, 0xc6, 0xdc, 0x84, 0x5a, 0x85,
-	0x90, 0x18, 0x1d, 0x71, 0x85, 0x15, 0x09, 0xb5, 0x6c, 0x8b, 0x5a, 0x0a, 0xb0, 0xb2, 0xd5, 0xfb,
-	0x7f, 0xa3, 0x1f, 0xc2, 0x14, 0x75, 0x68, 0x07, 0x2b, 0xb3, 0xbc, 0x9e, 0x17, 0xbe, 0x7d, 0xb1,
-	0x35, 0x17, 0x9e, 0x7c, 0x2b, 0xb0, 0xbf, 0xd8, 0xd8, 0xae, 0xfc, 0xe8, 0x27, 0x7a, 0x88, 0x40,
-	0x5b, 0x30, 0x1d, 0xf4, 0x5c, 0xd7, 0xf2, 0x2f, 0x95, 0xfc, 0xd5, 0xe0, 0x08, 0x83, 0x3e, 0x83,
-	0x99, 0xb0, 0x77, 0xb0, 0xaf, 0x14, 0x38, 0xfe, 0xfd, 0xab, 0x9a, 0x65, 0x9c, 0x4e, 0x9f, 0x8c,
-	0x3e, 0x82, 0x1c, 0xbe, 0xe8, 0x62, 0xdb, 0xa1, 0xd8, 0x56, 0x8a, 0x1b, 0xd2, 0xe6, 0x4c, 0x7d,
-	0x69, 0x84, 0xb1, 0xbb, 0xad, 0x48, 0x7a, 0x8c, 0x43, 0x1f, 0x43, 0xe1, 0xb9, 0xe5, 0x74, 0xb0,
-	0x6d, 0xfa, 0xd8, 0x0a, 0x88, 0xa7, 0xcc, 0x5d, 0xe1, 0xf2, 0xee, 0xb6, 0x9e, 0x0f, 0x91, 0x3a,
-	0x07, 0x22, 0x1d, 0x0a, 0xfd, 0x31, 0x40, 0x2f, 0xbb, 0x58, 0x91, 0x79, 0x9f, 0xac, 0x5e, 0xd1,
-	0x27, 0xc6, 0x65, 0x17, 0xd7, 0xe5, 0x6f, 0x5f, 0x6c, 0xe5, 0x2f, 0xd8, 0x5c, 0xde, 0x38, 0xdb,
-	0xa9, 0x6c, 0x57, 0xb6, 0xf5, 0x7c, 0x37, 0x61, 0x2f, 0xff, 0x45, 0x82, 0x85, 0x88, 0x10, 0x4f,
-	0xab, 0x00, 0xad, 0x01, 0x84, 0x03, 0xcb, 0x24, 0x1e, 0xe6, 0x6d, 0x9d, 0xd3, 0x73, 0xe1, 0x4a,
-	0xd3, 0xc3, 0x09, 0x33, 0x3d, 0x27, 0xe1, 0xc4, 0x89, 0xcc, 0xc6, 0x39, 0x41, 0x77, 0x20, 0x1f,
-	0x99, 0x4f, 0x7d, 0x8c, 0x79, 0x43, 0xe7, 0xf4, 0x59, 0x01, 0x60, 0x4b, 0x6c, 0xa6, 0x09, 0xc8,
-	0x73, 0xd2, 0xf3, 0x79, 0xbf, 0xe6, 0x74, 0x21, 0x7a, 0x9f, 0xf4, 0xfc, 0x04, 0x20, 0xe8, 0x5a,
-	0x2e, 0xef, 0xc6, 0x3e, 0xa0, 0xdd, 0xb5, 0xdc, 0x7b, 0xf2, 0xcb, 0xa1, 0xa3, 0x95, 0xff, 0x93,
-	0x86, 0xd9, 0x64, 0x43, 0x6f, 0x41, 0xee, 0x12, 0x07, 0xe6, 0x31, 0x9f, 0x70, 0xfc, 0x0c, 0x75,
-	0x39, 0x31, 0x6e, 0x35, 0xb6, 0xaa, 0xcf, 0x5c, 0xe2, 0x60, 0x8f, 0x21, 0xd0, 0x2e, 0x14, 0xac,
-	0xa3, 0x80, 0x5a, 0x8e, 0x27, 0x28, 0xa9, 0x2b, 0x28, 0x79, 0x01, 0x0b, 0x69, 0xef, 0xc3, 0x8c,
-	0x47, 0x04, 0x23, 0x7d, 0x05, 0x63, 0xda, 0x23, 0x21, 0xf8, 0x53, 0x40, 0x1e, 0x31, 0xcf, 0x1d,
-	0x7a, 0x6a, 0x9e, 0x61, 0x1a, 0xd1, 0x32, 0x57, 0xd0, 0xe6, 0x3c, 0xf2, 0xc4, 0xa1, 0xa7, 0x87,
-	0x98, 0x0a, 0xfa, 0xc7, 0x20, 0xc7, 0x69, 0x11, 0xe4, 0xa9, 0x91, 0x7b, 0x44, 0xf3, 0xa8, 0x5e,
-	0xec, 0x27, 0x6b, 0x98, 0x49, 0xcf, 0xa3, 0x6d, 0xb3, 0x6f, 0x63, 0x1a, 0xe7, 0x62, 0xcf, 0x4f,
-	0x00, 0x25, 0x93, 0x29, 0xb8, 0xd3, 0x63, 0xb9, 0x72, 0x22, 0xc5, 0x21, 0xfb, 0x1e, 0xcc, 0x27,
-	0xf2, 0x2c, 0xc8, 0x33, 0x63, 0xc9, 0x73, 0x71, 0xf6, 0x43, 0xee, 0x16, 0x00, 0xcb, 0xbd, 0x20,
-	0xe5, 0xc6, 0x92, 0x72, 0x0c, 0xc1, 0xe1, 0xe5, 0x3f, 0x49, 0x90, 0x61, 0x35, 0x7c, 0xfd, 0x7d,
-	0x59, 0x81, 0xa9, 0x33, 0x42, 0xf1, 0xf5, 0x77, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xe9, 0xd0, 0xb7,
-	0x40, 0xc9, 0xf0, 0x21, 0x7c, 0x67, 0xa8, 0xe7, 0x46, 0xdf, 0x06, 0x7a, 0xc4, 0x18, 0x18, 0x72,
-	0x53, 0x83, 0x43, 0xee, 0x61, 0x66, 0x26, 0x2d, 0x67, 0xca, 0x7f, 0x97, 0xa0, 0x20, 0x46, 0x75,
-	0xcb, 0xf2, 0x2d, 0x37, 0x40, 0xcf, 0x60, 0xd6, 0x75, 0xbc, 0xfe, 0xe4, 0x97, 0xae, 0x9b, 0xfc,
-	0x6b, 0x6c, 0xf2, 0x7f, 0xf7, 0x6a, 0x7d, 0x29, 0xc1, 0xfa, 0x80, 0xb8, 0x0e, 0xc5, 0x6e, 0x97,
-	0x5e, 0xea, 0xe0, 0x3a, 0x5e, 0x74, 0x17, 0xb8, 0x80, 0x5c, 0xeb, 0x22, 0x02, 0x99, 0x5d, 0xec,
-	0x3b, 0xc4, 0xe6, 0x81, 0x60, 0x3b, 0x0c, 0x0f, 0xf0, 0x86, 0x78, 0x34, 0xd5, 0x7f, 0xf0, 0xdd,
-	0xab, 0xf5, 0x77, 0x47, 0x89, 0xf1, 0x26, 0xbf, 0x65, 0xf3, 0x5d, 0x76, 0xad, 0x8b, 0xe8, 0x24,
-	0xdc, 0x7e, 0x2f, 0xa5, 0x48, 0xe5, 0xa7, 0x90, 0x3f, 0xe4, 0x73, 0x5f, 0x9c, 0xae, 0x01, 0xe2,
-	0x1e, 0x88, 0x76, 0x97, 0xae, 0xdb, 0x3d, 0xc3, 0xd5, 0xf3, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x74, 0xbc, 0x50, 0x7e, 0x0f, 0xb2, 0xbf, 0xee, 0x11, 0xbf, 0xe7, 0x8a, 0x76, 0x1f, 0x79, 0x5d,
-	0x85, 0x56, 0xf4, 0x01, 0xe4, 0x58, 0x31, 0x07, 0xa7, 0xa4, 0x63, 0x5f, 0xf1, 0x10, 0x8b, 0x01,
-	0x68, 0x17, 0x8a, 0xbc, 0x59, 0x63, 0x4a, 0x7a, 0x2c, 0xa5, 0xc0, 0x50, 0x46, 0x04, 0xe2, 0x0e,
-	0xfe, 0x37, 0x0f, 0x59, 0xe1, 0x9b, 0x7a, 0xc3, 0x9c, 0x26, 0x6e, 0xf3, 0x64, 0xfe, 0xf6, 0xbf,
-	0x5f, 0xfe, 0x32, 0xe3, 0xf3, 0x33, 0x9a, 0x8b, 0xf4, 0xf7, 0xc8, 0x45, 0x22, 0xee, 0x99, 0xc9,
-	0xe3, 0x3e, 0x75, 0xf3, 0xb8, 0x67, 0x27, 0x88, 0x3b, 0xd2, 0xe0, 0x36, 0x0b, 0xb4, 0xe3, 0x39,
-	0xd4, 0x89, 0x9f, 0x4f, 0x26, 0x77, 0x7f, 0xcc, 0xdc, 0x62, 0x0a, 0xef, 0xb8, 0x8e, 0xa7, 0x85,
-	0x78, 0x11, 0x1e, 0x9d, 0xa1, 0xd1, 0x63, 0x58, 0xea, 0x4f, 0x92, 0x63, 0xcb, 0x3b, 0xc6, 0x1d,
-	0x21, 0x13, 0x4e, 0xb0, 0x3b, 0x83, 0x32, 0xe3, 0xae, 0xf0, 0x85, 0x88, 0xbf, 0xc7, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x71, 0x58, 0xd6, 0xc6, 0x41, 0x34, 0xe2, 0x26, 0x7f, 0x8d, 0xec, 0x6e, 0xeb,
-	0x68, 0x50, 0xbf, 0x81, 0x03, 0x8a, 0x3e, 0x87, 0xe5, 0xfe, 0x7b, 0xc3, 0x1c, 0xcc, 0x2e, 0x5c,
-	0x97, 0xdd, 0x65, 0x96, 0xdd, 0x71, 0x1b, 0x2d, 0xf5, 0x25, 0x0f, 0x93, 0x99, 0xd7, 0x61, 0x21,
-	0xde, 0x2b, 0x4e, 0xd4, 0xec, 0xa4, 0xf1, 0x41, 0x7d, 0x76, 0x9c, 0xc0, 0xa7, 0x10, 0x6f, 0x66,
-	0x26, 0x7b, 0x26, 0x7f, 0x83, 0x9e, 0x89, 0xdd, 0xda, 0x8f, 0x9b, 0xe7, 0x53, 0x90, 0x8f, 0x7a,
-	0xbe, 0xc7, 0x82, 0x82, 0x4d, 0x51, 0xb1, 0x05, 0xfe, 0x70, 0x1b, 0xfb, 0x64, 0x2c, 0x32, 0x30,
-	0x9b, 0xe9, 0x3f, 0x0f, 0xcb, 0xf7, 0x10, 0xd6, 0x38, 0xbd, 0x9f, 0xbc, 0x7e, 0x17, 0xfa, 0x98,
-	0x49, 0x8a, 0x47, 0xe0, 0x58, 0xad, 0x15, 0xc6, 0x8c, 0x9e, 0x5a, 0x51, 0x0f, 0x86, 0x34, 0xf4,
-	0x53, 0x28, 0xc6, 0x6e, 0xb1, 0x62, 0xe6, 0x8f, 0xc2, 0x2b, 0x84, 0xf2, 0x91, 0x53, 0xec, 0x59,
-	0x80, 0xf6, 0x61, 0x3e, 0x11, 0x21, 0x51, 0x9d, 0xf2, 0xa4, 0xd1, 0x9f, 0x8b, 0x07, 0x4b, 0x58,
-	0x99, 0xbf, 0x84, 0x95, 0xe1, 0xca, 0x64, 0xd3, 0x46, 0x54, 0xcf, 0x3c, 0xd7, 0x2d, 0x8d, 0xe8,
-	0x0e, 0xbe, 0x30, 0x97, 0x07, 0x4b, 0x72, 0xdf, 0xba, 0x10, 0xb5, 0xd2, 0x85, 0x75, 0x76, 0x29,
-	0xba, 0x4e, 0x40, 0x9d, 0x63, 0xd3, 0xea, 0xd1, 0x53, 0xe2, 0x3b, 0xbf, 0xc1, 0xb6, 0x69, 0x85,
-	0x55, 0x8e, 0x03, 0x05, 0x6d, 0xa4, 0x37, 0x73, 0xf5, 0xcd, 0xb7, 0x74, 0xc0, 0xe0, 0x5e, 0x6b,
-	0xb1, 0x60, 0xad, 0xaf, 0x57, 0x8b, 0xe4, 0xd0, 0x11, 0x24, 0x00, 0xa6, 0x8f, 0x3f, 0xc7, 0xc7,
-	0x83, 0x75, 0xba, 0x30, 0xd1, 0x89, 0x56, 0x63, 0x11, 0x5d, 0x68, 0xc4, 0xd5, 0xfa, 0x29, 0x00,
-	0x7b, 0x65, 0x8a, 0x6a, 0x5a, 0x9c, 0x48, 0x90, 0xbd, 0x4b, 0x45, 0x4d, 0x69, 0x20, 0xc7, 0xc5,
-	0x2e, 0x44, 0x96, 0x26, 0x12, 0x99, 0xeb, 0xf3, 0x42, 0xa9, 0x7b, 0x0b, 0x2f, 0x47, 0xcb, 0xa5,
-	0xfc, 0x55, 0x0a, 0xd0, 0x7e, 0xf8, 0x1d, 0xbb, 0x6e, 0x05, 0xd8, 0xfe, 0x7f, 0xde, 0xc1, 0x89,
-	0xb9, 0x9f, 0x7a, 0xeb, 0xdc, 0xdf, 0x1a, 0x13, 0xa3, 0x91, 0xc1, 0x1f, 0xc7, 0x64, 0xe0, 0x9a,
-	0x48, 0xdf, 0xfc, 0x9a, 0xc8, 0x4c, 0x72, 0x3d, 0x8f, 0x7c, 0x7f, 0xb8, 0xfb, 0x07, 0x09, 0xf2,
-	0xc9, 0x6f, 0x4f, 0x68, 0x0d, 0x6e, 0xb7, 0xf4, 0x66, 0xab, 0xd9, 0xae, 0x3d, 0x32, 0x8d, 0x67,
-	0x2d, 0xd5, 0x7c, 0x7c, 0xd0, 0x6e, 0xa9, 0x7b, 0xda, 0x7d, 0x4d, 0x6d, 0xc8, 0xb7, 0xd0, 0x0a,
-	0xbc, 0x33, 0x68, 0x6e, 0x1b, 0xb5, 0x83, 0x46, 0x4d, 0x6f, 0xc8, 0x12, 0xba, 0x03, 0x6b, 0x83,
-	0xb6, 0xfd, 0xc7, 0x8f, 0x0c, 0xad, 0xf5, 0x48, 0x35, 0xf7, 0x1e, 0x34, 0xb5, 0x3d, 0x55, 0x4e,
-	0xa1, 0x77, 0x41, 0x19, 0x84, 0x34, 0x5b, 0x86, 0xb6, 0xaf, 0xb5, 0x0d, 0x6d, 0x4f, 0x4e, 0xa3,
-	0x55, 0x58, 0x1e, 0xb4, 0xaa, 0x4f, 0x5b, 0x6a, 0x43, 0x33, 0xd4, 0x86, 0x9c, 0xb9, 0xfb, 0x6f,
-	0x09, 0x20, 0xf1, 0x3b, 0xd4, 0x2a, 0x2c, 0x1f, 0x36, 0x8d, 0x50, 0xa0, 0x79, 0x30, 0xe4, 0xe5,
-	0x02, 0xcc, 0x25, 0x8d, 0xcf, 0xd4, 0xb6, 0x2c, 0x0d, 0x2f, 0x36, 0x0f, 0x54, 0x59, 0x42, 0xcb,
-	0xb0, 0x90, 0x5c, 0xac, 0xd5, 0xdb, 0x46, 0x4d, 0x3b, 0x90, 0x53, 0xc3, 0x68, 0xe3, 0x49, 0x53,
-	0x4e, 0x21, 0x04, 0xc5, 0xe4, 0xe2, 0x41, 0x53, 0x4e, 0xa3, 0x25, 0x98, 0x1f, 0x00, 0x3e, 0xd0,
-	0x55, 0x55, 0x4e, 0xb3, 0x93, 0x0e, 0x42, 0xcd, 0x27, 0x9a, 0xf1, 0xc0, 0x3c, 0x54, 0x8d, 0xa6,
-	0x9c, 0x41, 0x8b, 0x20, 0x27, 0xad, 0xf7, 0x9b, 0x8f, 0xf5, 0xd1, 0xd5, 0x76, 0xab, 0xb6, 0x2f,
-	0x4f, 0xad, 0xa4, 0x64, 0xe9, 0xee, 0x5f, 0x25, 0x28, 0x0e, 0xfe, 0x18, 0x84, 0xd6, 0x61, 0xb5,
-	0x1f, 0xac, 0xb6, 0x51, 0x33, 0x1e, 0xb7, 0x87, 0x82, 0x50, 0x86, 0xd2, 0x30, 0xa0, 0xa1, 0xb6,
-	0x9a, 0x6d, 0xcd, 0x30, 0x5b, 0xaa, 0xae, 0x35, 0x87, 0x53, 0x26, 0x30, 0x87, 0x4d, 0x43, 0x3b,
-	0xf8, 0x2c, 0x82, 0xa4, 0x06, 0x32, 0x2e, 0x20, 0xad, 0x5a, 0xbb, 0xad, 0x36, 0xc2, 0x43, 0x0e,
-	0xdb, 0x74, 0xf5, 0xa1, 0xba, 0xc7, 0x33, 0x36, 0x8e, 0x79, 0xbf, 0xa6, 0x3d, 0x52, 0x1b, 0xf2,
-	0x54, 0x7d, 0xf7, 0xeb, 0xd7, 0x25, 0xe9, 0x9b, 0xd7, 0x25, 0xe9, 0x9f, 0xaf, 0x4b, 0xd2, 0x97,
-	0x6f, 0x4a, 0xb7, 0xbe, 0x79, 0x53, 0xba, 0xf5, 0xb7, 0x37, 0xa5, 0x5b, 0xbf, 0x58, 0x0d, 0xcb,
-	0x37, 0xb0, 0xbf, 0xa8, 0x38, 0xa4, 0xca, 0x8b, 0xb5, 0xca, 0xbe, 0xfa, 0x07, 0xd5, 0xb3, 0x9d,
-	0xa3, 0x2c, 0xef, 0xd1, 0x8f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x55, 0x4b, 0xa4, 0x84,
-	0x15, 0x00, 0x00,
+	// 2402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0xe3, 0xd6,
+	0x11, 0x5f, 0xea, 0xcb, 0xd2, 0x58, 0x92, 0xe9, 0x67, 0xef, 0x9a, 0xeb, 0x8d, 0x3f, 0xd6, 0x4d,
+	0x02, 0x77, 0x13, 0xcb, 0x76, 0x52, 0x37, 0x69, 0x9a, 0x1c, 0x24, 0x8b, 0xce, 0x2a, 0x58, 0x5b,
+	0x2a, 0x25, 0x7b, 0x93, 0x16, 0x05, 0x41, 0x8b, 0xcf, 0x32, 0x13, 0x91, 0x4f, 0x25, 0x29, 0x7f,
+	0xf4, 0xaf, 0xc8, 0xb1, 0x40, 0x8b, 0xa2, 0xb7, 0x06, 0xe8, 0xa5, 0x87, 0x6d, 0xff, 0x80, 0x9e,
+	0x82, 0x5e, 0x1a, 0xec, 0xa1, 0x28, 0x02, 0x34, 0x2d, 0xb2, 0x87, 0x02, 0x41, 0xff, 0x82, 0xa2,
+	0x87, 0xe2, 0x7d, 0x50, 0xa4, 0x28, 0xc9, 0xab, 0x0d, 0x7a, 0x49, 0xcc, 0xf7, 0x7e, 0xbf, 0x99,
+	0x79, 0x33, 0xf3, 0x66, 0x46, 0x6f, 0x61, 0xa9, 0x4d, 0x3c, 0x9b, 0x78, 0xdb, 0x1d, 0x72, 0xb1,
+	0x7d, 0xb1, 0x4b, 0xff, 0x57, 0xea, 0xb9, 0xc4, 0x27, 0xa8, 0xc0, 0x37, 0x4a, 0x74, 0xe5, 0x62,
+	0x77, 0x79, 0x55, 0xe0, 0x4e, 0x0d, 0x0f, 0x6f, 0x5f, 0xec, 0x9e, 0x62, 0xdf, 0xd8, 0xdd, 0x6e,
+	0x13, 0xcb, 0xe1, 0xf0, 0xe5, 0xc5, 0x0e, 0xe9, 0x10, 0xf6, 0xe7, 0x36, 0xfd, 0x4b, 0xac, 0xae,
+	0x75, 0x08, 0xe9, 0x74, 0xf1, 0x36, 0xfb, 0x3a, 0xed, 0x9f, 0x6d, 0xfb, 0x96, 0x8d, 0x3d, 0xdf,
+	0xb0, 0x7b, 0x02, 0x70, 0x37, 0x0e, 0x30, 0x9c, 0x6b, 0xb1, 0xb5, 0x1a, 0xdf, 0x32, 0xfb, 0xae,
+	0xe1, 0x5b, 0x24, 0xd0, 0x78, 0x97, 0x5b, 0xa4, 0x73, 0xa5, 0xc2, 0x5a, 0xbe, 0x35, 0x6f, 0xd8,
+	0x96, 0x43, 0xb6, 0xd9, 0x7f, 0xc5, 0xd2, 0x77, 0xa2, 0xf6, 0x1b, 0xa7, 0x6d, 0x6b, 0x70, 0x08,
+	0xfa, 0xc1, 0x41, 0x1b, 0x04, 0xd0, 0x63, 0x6c, 0x75, 0xce, 0x7d, 0x6c, 0x9e, 0x10, 0x1f, 0xd7,
+	0x7b, 0x54, 0x1d, 0xda, 0x85, 0x0c, 0x61, 0x7f, 0x29, 0xd2, 0xba, 0xb4, 0x59, 0x7c, 0xe3, 0x6e,
+	0x69, 0xc8, 0x35, 0xa5, 0x10, 0xaa, 0x09, 0x20, 0x7a, 0x15, 0x32, 0x97, 0x4c, 0x90, 0x92, 0x58,
+	0x97, 0x36, 0x73, 0x95, 0xe2, 0xd3, 0x27, 0x5b, 0x20, 0x58, 0x55, 0xdc, 0xd6, 0xc4, 0xee, 0xc6,
+	0x6f, 0x24, 0x98, 0xa9, 0xe2, 0x1e, 0xf1, 0x2c, 0x1f, 0xad, 0xc1, 0x6c, 0xcf, 0x25, 0x3d, 0xe2,
+	0x19, 0x5d, 0xdd, 0x32, 0x99, 0xae, 0x94, 0x06, 0xc1, 0x52, 0xcd, 0x44, 0xdf, 0x87, 0x9c, 0xc9,
+	0xb1, 0xc4, 0x15, 0x72, 0x95, 0xa7, 0x4f, 0xb6, 0x16, 0x85, 0xdc, 0xb2, 0x69, 0xba, 0xd8, 0xf3,
+	0x9a, 0xbe, 0x6b, 0x39, 0x1d, 0x2d, 0x84, 0xa2, 0x77, 0x21, 0x63, 0xd8, 0xa4, 0xef, 0xf8, 0x4a,
+	0x72, 0x3d, 0xb9, 0x39, 0x1b, 0xda, 0x4f, 0x7d, 0x51, 0x12, 0x6e, 0x28, 0xed, 0x13, 0xcb, 0xa9,
+	0xe4, 0x3e, 0xff, 0x6a, 0xed, 0xd6, 0x67, 0xff, 0xfa, 0xfd, 0x03, 0x49, 0x13, 0x9c, 0x8d, 0xdf,
+	0x65, 0x21, 0xdb, 0x10, 0x46, 0xa0, 0x22, 0x24, 0x06, 0xa6, 0x25, 0x2c, 0x13, 0xed, 0x40, 0xd6,
+	0xc6, 0x9e, 0x67, 0x74, 0xb0, 0xa7, 0x24, 0x98, 0xf0, 0xc5, 0x12, 0x0f, 0x5b, 0x29, 0x08, 0x5b,
+	0xa9, 0xec, 0x5c, 0x6b, 0x03, 0x14, 0xda, 0x83, 0x8c, 0xe7, 0x1b, 0x7e, 0xdf, 0x53, 0x92, 0xcc,
+	0x99, 0x2b, 0x31, 0x67, 0x06, 0xaa, 0x9a, 0x0c, 0xa4, 0x09, 0x30, 0x7a, 0x08, 0xe8, 0xcc, 0x72,
+	0x8c, 0xae, 0xee, 0x1b, 0xdd, 0xee, 0xb5, 0xee, 0x62, 0xaf, 0xdf, 0xf5, 0x95, 0xd4, 0xba, 0xb4,
+	0x39, 0xfb, 0xc6, 0x72, 0x4c, 0x44, 0x8b, 0x42, 0x34, 0x86, 0xd0, 0x64, 0xc6, 0x8a, 0xac, 0xa0,
+	0x32, 0xcc, 0x7a, 0xfd, 0x53, 0xdb, 0xf2, 0x75, 0x9a, 0x8b, 0x4a, 0x5a, 0x88, 0x88, 0x5b, 0xdd,
+	0x0a, 0x12, 0xb5, 0x92, 0xfa, 0xf4, 0x1f, 0x6b, 0x92, 0x06, 0x9c, 0x44, 0x97, 0xd1, 0x07, 0x20,
+	0x0b, 0xef, 0xea, 0xd8, 0x31, 0xb9, 0x9c, 0xcc, 0x94, 0x72, 0x8a, 0x82, 0xa9, 0x3a, 0x26, 0x93,
+	0x55, 0x83, 0x82, 0x4f, 0x7c, 0xa3, 0xab, 0x8b, 0x75, 0x65, 0xe6, 0x05, 0x62, 0x94, 0x67, 0xd4,
+	0x20, 0x81, 0x1e, 0xc1, 0xfc, 0x05, 0xf1, 0x2d, 0xa7, 0xa3, 0x7b, 0xbe, 0xe1, 0x8a, 0xf3, 0x65,
+	0xa7, 0xb4, 0x6b, 0x8e, 0x53, 0x9b, 0x94, 0xc9, 0x0c, 0x7b, 0x08, 0x62, 0x29, 0x3c, 0x63, 0x6e,
+	0x4a, 0x59, 0x05, 0x4e, 0x0c, 0x8e, 0xb8, 0x4c, 0x93, 0xc4, 0x37, 0x4c, 0xc3, 0x37, 0x14, 0xa0,
+	0x69, 0xab, 0x0d, 0xbe, 0xd1, 0x77, 0x21, 0xed, 0x5b, 0x7e, 0x17, 0x2b, 0xb3, 0x2c, 0x9f, 0x17,
+	0xbe, 0x7c, 0xb2, 0x35, 0xc7, 0x4f, 0xbe, 0xe5, 0x99, 0x9f, 0xac, 0xef, 0x94, 0xbe, 0xf7, 0x96,
+	0xc6, 0x11, 0x68, 0x0b, 0x66, 0xbc, 0xbe, 0x6d, 0x1b, 0xee, 0xb5, 0x92, 0x9f, 0x0c, 0x0e, 0x30,
+	0xe8, 0x7d, 0xc8, 0xf2, 0xbb, 0x83, 0x5d, 0xa5, 0xc0, 0xf0, 0xaf, 0x4d, 0xba, 0x2c, 0xe3, 0xe4,
+	0x0c, 0xc8, 0xe8, 0x4d, 0xc8, 0xe1, 0xab, 0x1e, 0x36, 0x2d, 0x1f, 0x9b, 0x4a, 0x71, 0x5d, 0xda,
+	0xcc, 0x56, 0x6e, 0x8f, 0x30, 0xf6, 0x76, 0x14, 0x49, 0x0b, 0x71, 0xe8, 0x6d, 0x28, 0x9c, 0x19,
+	0x56, 0x17, 0x9b, 0xba, 0x8b, 0x0d, 0x8f, 0x38, 0xca, 0xdc, 0x04, 0x93, 0xf7, 0x76, 0xb4, 0x3c,
+	0x47, 0x6a, 0x0c, 0x88, 0x34, 0x28, 0x0c, 0xca, 0x80, 0x7f, 0xdd, 0xc3, 0x8a, 0xcc, 0xee, 0xc9,
+	0xbd, 0x09, 0xf7, 0xa4, 0x75, 0xdd, 0xc3, 0x15, 0xf9, 0xcb, 0x27, 0x5b, 0xf9, 0x2b, 0x5a, 0xbc,
+	0xd7, 0x2f, 0x76, 0x4b, 0x3b, 0xa5, 0x1d, 0x2d, 0xdf, 0x8b, 0xec, 0xa3, 0x6d, 0x00, 0x13, 0xf7,
+	0xb0, 0x63, 0x7a, 0x3a, 0x71, 0x94, 0xf9, 0xf5, 0xe4, 0x66, 0x6a, 0x0c, 0x27, 0x27, 0x30, 0x75,
+	0x07, 0xbd, 0x05, 0xc5, 0x36, 0x71, 0xce, 0xba, 0x56, 0xdb, 0xf7, 0xf4, 0x4b, 0xcb, 0x3f, 0x57,
+	0xd0, 0x04, 0x52, 0x61, 0x80, 0x7b, 0x6c, 0xf9, 0xe7, 0x1b, 0x7f, 0x96, 0x60, 0x21, 0x30, 0x2d,
+	0xac, 0x8b, 0x1e, 0x5a, 0x01, 0xe0, 0xa5, 0x51, 0x27, 0x0e, 0x66, 0x05, 0x24, 0xa7, 0xe5, 0xf8,
+	0x4a, 0xdd, 0xc1, 0x91, 0x6d, 0xff, 0x92, 0x28, 0x89, 0xe8, 0x76, 0xeb, 0x92, 0xa0, 0xfb, 0x90,
+	0x0f, 0xb6, 0xcf, 0x5d, 0x8c, 0x59, 0xe9, 0xc8, 0x69, 0xb3, 0x02, 0x40, 0x97, 0x68, 0xf5, 0x14,
+	0x90, 0x33, 0xd2, 0x77, 0x59, 0x65, 0xc8, 0x69, 0x42, 0xe8, 0x01, 0xe9, 0xbb, 0x11, 0x80, 0xd7,
+	0x33, 0x6c, 0x25, 0x1d, 0x05, 0x34, 0x7b, 0x86, 0xfd, 0x8e, 0xfc, 0x34, 0x76, 0xb6, 0x8d, 0xff,
+	0x26, 0x61, 0x36, 0x5a, 0x3a, 0xb6, 0x20, 0x77, 0x8d, 0x3d, 0xbd, 0xcd, 0x6a, 0x29, 0x3b, 0x43,
+	0x45, 0x8e, 0x14, 0xf6, 0x1a, 0x5d, 0xd5, 0xb2, 0xd7, 0xd8, 0xdb, 0xa7, 0x08, 0xb4, 0x07, 0x05,
+	0xe3, 0xd4, 0xf3, 0x0d, 0xcb, 0x11, 0x94, 0xc4, 0x04, 0x4a, 0x5e, 0xc0, 0x38, 0xed, 0x35, 0xc8,
+	0x3a, 0x44, 0x30, 0x92, 0x13, 0x18, 0x33, 0x0e, 0xe1, 0xe0, 0xf7, 0x00, 0x39, 0x84, 0x45, 0x48,
+	0xbf, 0xc0, 0x7e, 0x40, 0x4b, 0x4d, 0xa0, 0xcd, 0x39, 0x84, 0x46, 0xe9, 0x04, 0xfb, 0x82, 0xfe,
+	0x36, 0xc8, 0x61, 0x58, 0x04, 0x39, 0x3d, 0xd2, 0xb1, 0x6a, 0x8e, 0xaf, 0x15, 0x07, 0xc1, 0x8a,
+	0x33, 0xfd, 0xcb, 0x40, 0x6d, 0xe6, 0x26, 0x66, 0xeb, 0x52, 0xe8, 0x7c, 0x17, 0x50, 0x34, 0x98,
+	0x82, 0x3b, 0x33, 0x96, 0x2b, 0x47, 0x42, 0xcc, 0xd9, 0xef, 0xc0, 0x7c, 0x24, 0xce, 0x82, 0x9c,
+	0x1d, 0x4b, 0x9e, 0x0b, 0xa3, 0xcf, 0xb9, 0x5b, 0x00, 0x34, 0xf6, 0x82, 0x94, 0x1b, 0x4b, 0xca,
+	0x51, 0x04, 0x83, 0x6f, 0xfc, 0x51, 0x82, 0x14, 0xcd, 0xe1, 0xe7, 0x77, 0xe6, 0x12, 0xa4, 0x2f,
+	0x88, 0x8f, 0x9f, 0xdf, 0x95, 0x39, 0x0c, 0xfd, 0x10, 0x66, 0xb8, 0x6d, 0x9e, 0x92, 0x62, 0xe5,
+	0xfe, 0x7e, 0xec, 0x76, 0x8f, 0x4e, 0x21, 0x5a, 0xc0, 0x18, 0x2a, 0xa7, 0xe9, 0xe1, 0x72, 0xfa,
+	0x41, 0x2a, 0x9b, 0x94, 0x53, 0x1b, 0x7f, 0x97, 0xa0, 0x20, 0x9a, 0x42, 0xc3, 0x70, 0x0d, 0xdb,
+	0x43, 0x1f, 0xc1, 0xac, 0x6d, 0x39, 0x83, 0x1e, 0x23, 0x3d, 0xaf, 0xc7, 0xac, 0xd0, 0x1e, 0xf3,
+	0xcd, 0x57, 0x6b, 0xb7, 0x23, 0xac, 0xd7, 0x89, 0x6d, 0xf9, 0xd8, 0xee, 0xf9, 0xd7, 0x1a, 0xd8,
+	0x96, 0x13, 0x74, 0x1d, 0x1b, 0x90, 0x6d, 0x5c, 0x05, 0x20, 0xbd, 0x87, 0x5d, 0x8b, 0x98, 0xcc,
+	0x11, 0x54, 0x43, 0xbc, 0x55, 0x54, 0xc5, 0x0c, 0x57, 0x79, 0xf9, 0x9b, 0xaf, 0xd6, 0x5e, 0x1a,
+	0x25, 0x86, 0x4a, 0x7e, 0x41, 0x3b, 0x89, 0x6c, 0x1b, 0x57, 0xc1, 0x49, 0xd8, 0xfe, 0x3b, 0x09,
+	0x45, 0xda, 0xf8, 0x10, 0xf2, 0x27, 0xac, 0xc3, 0x88, 0xd3, 0x55, 0x41, 0x74, 0x9c, 0x40, 0xbb,
+	0xf4, 0x3c, 0xed, 0x29, 0x26, 0x3d, 0xcf, 0x59, 0x11, 0xc9, 0xbf, 0x96, 0xc4, 0x8d, 0x17, 0x92,
+	0x5f, 0x85, 0xcc, 0xcf, 0xfa, 0xc4, 0xed, 0xdb, 0x8a, 0x34, 0x92, 0x2d, 0x6c, 0x8e, 0xe3, 0xbb,
+	0xe8, 0x75, 0xc8, 0xd1, 0x64, 0xf6, 0xce, 0x49, 0xd7, 0x9c, 0x30, 0xf2, 0x85, 0x00, 0xb4, 0x07,
+	0x45, 0x76, 0x59, 0x43, 0x4a, 0x72, 0x2c, 0xa5, 0x40, 0x51, 0xad, 0x00, 0xc4, 0x0c, 0xfc, 0x4b,
+	0x01, 0x32, 0xc2, 0x36, 0xf5, 0x05, 0x63, 0x1a, 0x99, 0x1b, 0xa2, 0xf1, 0x3b, 0xfc, 0x76, 0xf1,
+	0x4b, 0x8d, 0x8f, 0xcf, 0x68, 0x2c, 0x92, 0xdf, 0x22, 0x16, 0x11, 0xbf, 0xa7, 0xa6, 0xf7, 0x7b,
+	0xfa, 0xc5, 0xfd, 0x9e, 0x99, 0xc2, 0xef, 0xa8, 0x06, 0x77, 0xa9, 0xa3, 0x2d, 0xc7, 0xf2, 0xad,
+	0x70, 0x50, 0xd3, 0x99, 0xf9, 0xca, 0xcc, 0x58, 0x09, 0x77, 0x6c, 0xcb, 0xa9, 0x71, 0xbc, 0x70,
+	0x8f, 0x46, 0xd1, 0xe8, 0x18, 0x6e, 0x0f, 0x2a, 0x49, 0xdb, 0x70, 0xda, 0xb8, 0x2b, 0xc4, 0xf0,
+	0x0a, 0x76, 0x7f, 0x58, 0xcc, 0xb8, 0x61, 0x61, 0x21, 0xe0, 0xef, 0x33, 0x3a, 0x17, 0xfb, 0x53,
+	0x58, 0x8c, 0x8b, 0x35, 0xb1, 0x17, 0x94, 0xb8, 0xe9, 0xe7, 0x9e, 0xbd, 0x1d, 0x0d, 0x0d, 0xcb,
+	0xaf, 0x62, 0xcf, 0x47, 0x1f, 0xc3, 0xd2, 0x60, 0xb2, 0xd1, 0x87, 0xa3, 0x0b, 0xcf, 0x8b, 0xee,
+	0x12, 0x8d, 0xee, 0x38, 0x45, 0xb7, 0x07, 0x22, 0x4f, 0xa2, 0x91, 0xd7, 0x60, 0x21, 0xd4, 0x15,
+	0x06, 0x6a, 0x76, 0x5a, 0xff, 0xa0, 0x01, 0x3b, 0x0c, 0xe0, 0x87, 0x10, 0x2a, 0xd3, 0xa3, 0x77,
+	0x26, 0xff, 0x02, 0x77, 0x26, 0x34, 0xeb, 0x30, 0xbc, 0x3c, 0xef, 0x81, 0x7c, 0xda, 0x77, 0x1d,
+	0xea, 0x14, 0xac, 0x8b, 0x8c, 0x2d, 0xb0, 0x11, 0x71, 0xec, 0x70, 0x5a, 0xa4, 0x60, 0x5a, 0xd3,
+	0x7f, 0xc4, 0xd3, 0xf7, 0x04, 0x56, 0x18, 0x7d, 0x10, 0xbc, 0xc1, 0x2d, 0x74, 0x31, 0x15, 0xa9,
+	0x14, 0x27, 0xcb, 0x5a, 0xa6, 0xcc, 0x60, 0xd4, 0x0a, 0xee, 0x20, 0xa7, 0xa1, 0x1f, 0x40, 0x31,
+	0x34, 0x8b, 0x26, 0xb3, 0x32, 0x37, 0x59, 0x50, 0x3e, 0x30, 0x8a, 0x8e, 0x05, 0xe8, 0x10, 0xe6,
+	0x23, 0x1e, 0x12, 0xd9, 0x29, 0x4f, 0xeb, 0xfd, 0xb9, 0xb0, 0xb0, 0xf0, 0xcc, 0xfc, 0x09, 0x2c,
+	0xc7, 0x33, 0x93, 0x56, 0x1b, 0x91, 0x3d, 0xf3, 0x4c, 0xee, 0xea, 0x88, 0xdc, 0xe1, 0x11, 0x73,
+	0x69, 0x38, 0x25, 0x0f, 0x8d, 0x2b, 0x91, 0x2b, 0x3d, 0x58, 0xa3, 0x4d, 0xd1, 0xb6, 0x3c, 0xdf,
+	0x6a, 0xeb, 0x46, 0xdf, 0x3f, 0x27, 0xae, 0xf5, 0x73, 0x6c, 0xea, 0x06, 0xcf, 0x72, 0xec, 0xb1,
+	0xb1, 0x35, 0x57, 0xd9, 0xbc, 0xe1, 0x06, 0x0c, 0xeb, 0x5a, 0x09, 0x05, 0x96, 0x07, 0xf2, 0xca,
+	0x81, 0x38, 0x74, 0x0a, 0x11, 0x80, 0xee, 0xe2, 0x8f, 0x71, 0x7b, 0x38, 0x4f, 0x17, 0xa6, 0x3a,
+	0xd1, 0xbd, 0x50, 0x88, 0x26, 0x64, 0x84, 0xd9, 0xfa, 0x1e, 0x00, 0x9d, 0x32, 0x45, 0x36, 0x2d,
+	0x4e, 0x25, 0x90, 0xce, 0xa5, 0x22, 0xa7, 0x6a, 0x20, 0x87, 0xc9, 0x2e, 0x84, 0xdc, 0x9e, 0x4a,
+	0xc8, 0xdc, 0x80, 0x27, 0x44, 0x1d, 0xc1, 0x02, 0x9b, 0x97, 0xce, 0xba, 0x46, 0x27, 0x72, 0xc6,
+	0x3b, 0x53, 0x49, 0x9b, 0xa7, 0xd4, 0x83, 0xae, 0xd1, 0x09, 0x1b, 0xd8, 0xc2, 0xd3, 0xd1, 0xf4,
+	0xdb, 0xf8, 0x2c, 0x01, 0xe8, 0x90, 0xbf, 0x0e, 0x54, 0x0c, 0x0f, 0x9b, 0xff, 0xcf, 0x9e, 0x1e,
+	0xe9, 0x23, 0x89, 0x1b, 0xfb, 0xc8, 0xd6, 0x18, 0x9f, 0x8f, 0x34, 0x92, 0xd0, 0xc7, 0x43, 0x6d,
+	0x27, 0xf9, 0xe2, 0x6d, 0x27, 0x35, 0x4d, 0xbb, 0x1f, 0xfd, 0x3d, 0xf2, 0xd7, 0x04, 0x14, 0xd8,
+	0x74, 0xd2, 0x74, 0x8c, 0x9e, 0x77, 0x4e, 0xe2, 0xf3, 0x7b, 0x8f, 0x5c, 0x62, 0x77, 0xc2, 0xa4,
+	0x12, 0xce, 0xef, 0x0d, 0x8a, 0x8a, 0xcd, 0xef, 0x9c, 0x99, 0xb8, 0x89, 0xd9, 0xba, 0x24, 0x9c,
+	0x19, 0x9f, 0xdf, 0x39, 0x77, 0xbc, 0x17, 0xa2, 0xf3, 0x3b, 0x67, 0xc7, 0xe6, 0x77, 0x4e, 0x1e,
+	0xef, 0x8f, 0xc8, 0xfc, 0xce, 0xb9, 0xc1, 0xfc, 0xce, 0x49, 0x13, 0xda, 0x3d, 0x45, 0x70, 0xf8,
+	0x1d, 0xc8, 0x9c, 0xf3, 0x47, 0x38, 0xda, 0xe6, 0x93, 0x9a, 0xf8, 0x1a, 0xe3, 0xd8, 0x5f, 0x4a,
+	0x90, 0xa3, 0x15, 0x90, 0xf3, 0xf6, 0x61, 0xd6, 0xc4, 0x5d, 0xdc, 0x31, 0xf8, 0x84, 0x2e, 0x8d,
+	0x9d, 0xd0, 0x4f, 0x8c, 0xae, 0x65, 0x1a, 0x3e, 0x71, 0x07, 0x3c, 0x2d, 0xca, 0x42, 0x6f, 0xc1,
+	0xdc, 0x45, 0x00, 0xb9, 0xd9, 0xbd, 0x03, 0x18, 0x93, 0x32, 0xc6, 0xba, 0x5f, 0x49, 0x80, 0x46,
+	0xd5, 0xa1, 0x23, 0x98, 0x0f, 0x35, 0x88, 0x8a, 0xa7, 0x48, 0x83, 0x4a, 0xbd, 0x22, 0x74, 0x0c,
+	0x98, 0xc3, 0xbf, 0x44, 0xe4, 0x8b, 0xd8, 0x3a, 0x7a, 0x19, 0xd2, 0x37, 0xd9, 0x99, 0xee, 0x4d,
+	0x30, 0xef, 0x0f, 0x09, 0x98, 0x53, 0xaf, 0x70, 0xbb, 0xcf, 0x7e, 0xa6, 0xe0, 0x1e, 0x71, 0xa7,
+	0x78, 0xcb, 0x54, 0xe8, 0x63, 0x4e, 0xbb, 0x4d, 0x4d, 0xa6, 0xea, 0xb2, 0x5a, 0xf0, 0x89, 0x16,
+	0x21, 0x8d, 0x5d, 0x97, 0x88, 0x8c, 0xd2, 0xf8, 0x07, 0xba, 0x0b, 0xd9, 0x8e, 0xe1, 0xe9, 0x7d,
+	0x0f, 0xf3, 0xdb, 0x93, 0xd2, 0x66, 0x3a, 0x86, 0x77, 0xec, 0x61, 0x13, 0xa9, 0x30, 0xc3, 0x9f,
+	0x03, 0x3d, 0x25, 0xcd, 0x42, 0xf5, 0x4a, 0x2c, 0x54, 0xa2, 0xba, 0x44, 0x6c, 0xa4, 0xe8, 0x4a,
+	0x8a, 0xf6, 0x76, 0x2d, 0xe0, 0x4e, 0xca, 0x16, 0x3a, 0x66, 0x63, 0xc6, 0xa4, 0x7d, 0x85, 0xff,
+	0x4e, 0xbd, 0xf9, 0x0d, 0x2c, 0x4b, 0xe5, 0xf2, 0x37, 0xc3, 0x80, 0x58, 0x1e, 0x97, 0x74, 0x7f,
+	0x92, 0xe0, 0xce, 0x78, 0xd3, 0xd0, 0x3a, 0xe4, 0x6d, 0xaf, 0xc3, 0x9e, 0x7f, 0xf4, 0xbe, 0xdb,
+	0x15, 0xef, 0x25, 0x60, 0x7b, 0x1d, 0xfa, 0x9c, 0x73, 0xec, 0x76, 0x87, 0xfc, 0x91, 0x18, 0xf6,
+	0xc7, 0x78, 0x07, 0xee, 0x43, 0x06, 0x5f, 0x60, 0xc7, 0x0f, 0x7e, 0x71, 0xbe, 0x32, 0x34, 0xf4,
+	0xb0, 0x37, 0xf0, 0x60, 0xf2, 0xe1, 0x19, 0xa2, 0x52, 0xb4, 0x70, 0x92, 0xa0, 0x8e, 0x1e, 0xe2,
+	0xc1, 0x6f, 0x25, 0xc8, 0x47, 0x9f, 0xa2, 0xd0, 0x0a, 0xdc, 0x6d, 0x68, 0xf5, 0x46, 0xbd, 0x59,
+	0x7e, 0xa4, 0xb7, 0x3e, 0x6a, 0xa8, 0xfa, 0xf1, 0x51, 0xb3, 0xa1, 0xee, 0xd7, 0x0e, 0x6a, 0x6a,
+	0x55, 0xbe, 0x85, 0x96, 0xe1, 0xce, 0xf0, 0x76, 0xb3, 0x55, 0x3e, 0xaa, 0x96, 0xb5, 0xaa, 0x2c,
+	0xa1, 0xfb, 0xb0, 0x32, 0xbc, 0x77, 0x78, 0xfc, 0xa8, 0x55, 0x6b, 0x3c, 0x52, 0xf5, 0xfd, 0x87,
+	0xf5, 0xda, 0xbe, 0x2a, 0x27, 0xd0, 0x4b, 0xa0, 0x0c, 0x43, 0xea, 0x8d, 0x56, 0xed, 0xb0, 0xd6,
+	0x6c, 0xd5, 0xf6, 0xe5, 0x24, 0xba, 0x07, 0x4b, 0xc3, 0xbb, 0xea, 0x87, 0x0d, 0xb5, 0x5a, 0x6b,
+	0xa9, 0x55, 0x39, 0xf5, 0xe0, 0x3f, 0x12, 0x40, 0xe4, 0x51, 0xff, 0x1e, 0x2c, 0x9d, 0xd4, 0x5b,
+	0x5c, 0x40, 0xfd, 0x28, 0x66, 0xe5, 0x02, 0xcc, 0x45, 0x37, 0x3f, 0x52, 0x9b, 0xb2, 0x14, 0x5f,
+	0xac, 0x1f, 0xa9, 0xb2, 0x84, 0x96, 0x60, 0x21, 0xba, 0x58, 0xae, 0x34, 0x5b, 0xe5, 0xda, 0x91,
+	0x9c, 0x88, 0xa3, 0x5b, 0x8f, 0xeb, 0x72, 0x02, 0x21, 0x28, 0x46, 0x17, 0x8f, 0xea, 0x72, 0x12,
+	0xdd, 0x86, 0xf9, 0x21, 0xe0, 0x43, 0x4d, 0x55, 0xe5, 0x24, 0x3d, 0xe9, 0x30, 0x54, 0x7f, 0x5c,
+	0x6b, 0x3d, 0xd4, 0x4f, 0xd4, 0x56, 0x5d, 0x4e, 0xa1, 0x45, 0x90, 0xa3, 0xbb, 0x07, 0xf5, 0x63,
+	0x6d, 0x74, 0xb5, 0xd9, 0x28, 0x1f, 0xca, 0xe9, 0xe5, 0x84, 0x2c, 0x3d, 0xf8, 0xb7, 0x04, 0xc5,
+	0xe1, 0x97, 0x75, 0xb4, 0x06, 0xf7, 0x06, 0xce, 0x6a, 0xb6, 0xca, 0xad, 0xe3, 0x66, 0xcc, 0x09,
+	0x1b, 0xb0, 0x1a, 0x07, 0x54, 0xd5, 0x46, 0xbd, 0x59, 0x6b, 0xe9, 0x0d, 0x55, 0xab, 0xd5, 0xe3,
+	0x21, 0x13, 0x98, 0x93, 0x7a, 0xab, 0x76, 0xf4, 0x7e, 0x00, 0x49, 0x0c, 0x45, 0x5c, 0x40, 0x1a,
+	0xe5, 0x66, 0x53, 0xad, 0xf2, 0x43, 0xc6, 0xf7, 0x34, 0xf5, 0x03, 0x75, 0x9f, 0x45, 0x6c, 0x1c,
+	0xf3, 0xa0, 0x5c, 0x7b, 0xa4, 0x56, 0xe5, 0xf4, 0x38, 0x66, 0x55, 0x3d, 0x50, 0x35, 0x4d, 0xad,
+	0xca, 0x99, 0xca, 0xde, 0xe7, 0x5f, 0xaf, 0x4a, 0x5f, 0x7c, 0xbd, 0x2a, 0xfd, 0xf3, 0xeb, 0x55,
+	0xe9, 0xd3, 0x67, 0xab, 0xb7, 0xbe, 0x78, 0xb6, 0x7a, 0xeb, 0x6f, 0xcf, 0x56, 0x6f, 0xfd, 0xf8,
+	0x1e, 0xcf, 0x7a, 0xcf, 0xfc, 0xa4, 0x64, 0x91, 0x6d, 0x96, 0xca, 0xdb, 0xf4, 0x9a, 0x79, 0xf4,
+	0x9f, 0x83, 0x32, 0xec, 0x36, 0xbf, 0xf9, 0xbf, 0x01, 0x00, 0x9d, 0xe9, 0x76, 0xf6, 0x14, 0x1b,
+	0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1522,6 +1978,46 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictsWith) > 0 {
+		dAtA2 := make([]byte, len(m.ConflictsWith)*10)
+		var j1 int
+		for _, num := range m.ConflictsWith {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DependsOn) > 0 {
+		dAtA4 := make([]byte, len(m.DependsOn)*10)
+		var j3 int
+		for _, num := range m.DependsOn {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGov(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1575,22 +2071,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1609,22 +2105,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1888,12 +2384,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1935,12 +2431,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2011,6 +2507,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpamFlagThreshold) > 0 {
+		i -= len(m.SpamFlagThreshold)
+		copy(dAtA[i:], m.SpamFlagThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SpamFlagThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.ExpeditedQuorum) > 0 {
 		i -= len(m.ExpeditedQuorum)
 		copy(dAtA[i:], m.ExpeditedQuorum)
@@ -2119,12 +2624,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2171,22 +2676,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2258,12 +2763,289 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SpamPower) > 0 {
+		i -= len(m.SpamPower)
+		copy(dAtA[i:], m.SpamPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SpamPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OptionFourPower) > 0 {
+		i -= len(m.OptionFourPower)
+		copy(dAtA[i:], m.OptionFourPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFourPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OptionThreePower) > 0 {
+		i -= len(m.OptionThreePower)
+		copy(dAtA[i:], m.OptionThreePower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThreePower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OptionTwoPower) > 0 {
+		i -= len(m.OptionTwoPower)
+		copy(dAtA[i:], m.OptionTwoPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwoPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OptionOnePower) > 0 {
+		i -= len(m.OptionOnePower)
+		copy(dAtA[i:], m.OptionOnePower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOnePower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorPower) > 0 {
+		i -= len(m.ValidatorPower)
+		copy(dAtA[i:], m.ValidatorPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVotePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVotePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVotePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Power) > 0 {
+		i -= len(m.Power)
+		copy(dAtA[i:], m.Power)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Power)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutedAt):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintGov(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2389,6 +3171,20 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if len(m.DependsOn) > 0 {
+		l = 0
+		for _, e := range m.DependsOn {
+			l += sovGov(uint64(e))
+		}
+		n += 2 + sovGov(uint64(l)) + l
+	}
+	if len(m.ConflictsWith) > 0 {
+		l = 0
+		for _, e := range m.ConflictsWith {
+			l += sovGov(uint64(e))
+		}
+		n += 2 + sovGov(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2638,6 +3434,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	l = len(m.SpamFlagThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2670,22 +3470,149 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TallySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOnePower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwoPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThreePower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFourPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.SpamPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
+
+func (m *VotePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ValidatorPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVotePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Power)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGov(uint64(m.GasUsed))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutedAt)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MessageExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGov(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3426,6 +4353,158 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DependsOn = append(m.DependsOn, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DependsOn) == 0 {
+					m.DependsOn = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DependsOn = append(m.DependsOn, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+		case 18:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConflictsWith = append(m.ConflictsWith, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConflictsWith) == 0 {
+					m.ConflictsWith = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConflictsWith = append(m.ConflictsWith, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictsWith", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5195,6 +6274,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpamFlagThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpamFlagThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5430,6 +6541,858 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TallySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOnePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOnePower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwoPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThreePower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFourPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpamPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpamPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &ValidatorVotePower{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVotePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVotePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVotePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Power = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MessageExecutionResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types.StringEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
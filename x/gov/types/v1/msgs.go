@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
-	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}, &MsgFlagProposalSpam{}
+	_, _                      codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -133,6 +133,14 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgFlagProposalSpam creates a new MsgFlagProposalSpam instance.
+func NewMsgFlagProposalSpam(proposalID uint64, validator string) *MsgFlagProposalSpam {
+	return &MsgFlagProposalSpam{
+		ProposalId: proposalID,
+		Validator:  validator,
+	}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
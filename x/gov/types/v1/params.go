@@ -37,6 +37,7 @@ var (
 	DefaultMinDepositRatio              = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold  = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses = []string(nil)
+	DefaultSpamFlagThreshold            = sdkmath.LegacyNewDecWithPrec(667, 3)
 )
 
 // NewParams creates a new Params instance with given values.
@@ -47,6 +48,7 @@ func NewParams(
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
+	spamFlagThreshold string,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -70,6 +72,7 @@ func NewParams(
 		MinDepositRatio:               minDepositRatio,
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		SpamFlagThreshold:             spamFlagThreshold,
 	}
 }
 
@@ -97,6 +100,7 @@ func DefaultParams() Params {
 		DefaultMinDepositRatio.String(),
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
+		DefaultSpamFlagThreshold.String(),
 	)
 }
 
@@ -203,6 +207,17 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
 	}
 
+	spamFlagThreshold, err := sdkmath.LegacyNewDecFromStr(p.SpamFlagThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid spam flag threshold string: %w", err)
+	}
+	if spamFlagThreshold.IsNegative() {
+		return fmt.Errorf("spam flag threshold cannot be negative: %s", spamFlagThreshold)
+	}
+	if spamFlagThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("spam flag threshold too large: %s", spamFlagThreshold)
+	}
+
 	if p.VotingPeriod == nil {
 		return fmt.Errorf("voting period must not be nil: %d", p.VotingPeriod)
 	}
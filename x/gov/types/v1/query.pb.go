@@ -1157,6 +1157,201 @@ func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
 	return nil
 }
 
+// QueryTallySnapshotRequest is the request type for the Query/TallySnapshot RPC method.
+type QueryTallySnapshotRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallySnapshotRequest) Reset()         { *m = QueryTallySnapshotRequest{} }
+func (m *QueryTallySnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallySnapshotRequest) ProtoMessage()    {}
+func (*QueryTallySnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{22}
+}
+func (m *QueryTallySnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallySnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallySnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallySnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallySnapshotRequest.Merge(m, src)
+}
+func (m *QueryTallySnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallySnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallySnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallySnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryTallySnapshotRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallySnapshotResponse is the response type for the Query/TallySnapshot RPC method.
+type QueryTallySnapshotResponse struct {
+	// tally defines the tally of the votes cast so far.
+	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// height is the block height at which the tally was last updated. The
+	// voting power changes which happened after the votes were cast are not
+	// reflected in the tally.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryTallySnapshotResponse) Reset()         { *m = QueryTallySnapshotResponse{} }
+func (m *QueryTallySnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallySnapshotResponse) ProtoMessage()    {}
+func (*QueryTallySnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{23}
+}
+func (m *QueryTallySnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallySnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallySnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallySnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallySnapshotResponse.Merge(m, src)
+}
+func (m *QueryTallySnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallySnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallySnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallySnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryTallySnapshotResponse) GetTally() *TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *QueryTallySnapshotResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryExecutionReportRequest is the request type for the Query/ExecutionReport RPC method.
+type QueryExecutionReportRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryExecutionReportRequest) Reset()         { *m = QueryExecutionReportRequest{} }
+func (m *QueryExecutionReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionReportRequest) ProtoMessage()    {}
+func (*QueryExecutionReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{24}
+}
+func (m *QueryExecutionReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionReportRequest.Merge(m, src)
+}
+func (m *QueryExecutionReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionReportRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionReportRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryExecutionReportResponse is the response type for the Query/ExecutionReport RPC method.
+type QueryExecutionReportResponse struct {
+	// report defines the result of the execution of the proposal.
+	Report *ExecutionReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *QueryExecutionReportResponse) Reset()         { *m = QueryExecutionReportResponse{} }
+func (m *QueryExecutionReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionReportResponse) ProtoMessage()    {}
+func (*QueryExecutionReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{25}
+}
+func (m *QueryExecutionReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionReportResponse.Merge(m, src)
+}
+func (m *QueryExecutionReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionReportResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionReportResponse) GetReport() *ExecutionReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -1180,89 +1375,101 @@ func init() {
 	proto.RegisterType((*QueryProposalVoteOptionsResponse)(nil), "cosmos.gov.v1.QueryProposalVoteOptionsResponse")
 	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1.QueryMessageBasedParamsRequest")
 	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryTallySnapshotRequest)(nil), "cosmos.gov.v1.QueryTallySnapshotRequest")
+	proto.RegisterType((*QueryTallySnapshotResponse)(nil), "cosmos.gov.v1.QueryTallySnapshotResponse")
+	proto.RegisterType((*QueryExecutionReportRequest)(nil), "cosmos.gov.v1.QueryExecutionReportRequest")
+	proto.RegisterType((*QueryExecutionReportResponse)(nil), "cosmos.gov.v1.QueryExecutionReportResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0xaf, 0xd3, 0xff, 0x27, 0x69, 0x37, 0x6e, 0xdb, 0x35, 0x73, 0xd7, 0x34, 0x75, 0xa1, 0x2d,
-	0x88, 0xd8, 0x4d, 0xff, 0xac, 0x02, 0x86, 0xd0, 0xda, 0xd1, 0xc1, 0x00, 0x51, 0xb2, 0xc1, 0x03,
-	0x2f, 0x91, 0xdb, 0x58, 0x96, 0xb5, 0xd4, 0xd7, 0xcb, 0x75, 0x2c, 0x4a, 0xa9, 0x90, 0x26, 0xf1,
-	0xe7, 0x09, 0x90, 0x98, 0x04, 0x1f, 0x62, 0x8f, 0xfd, 0x06, 0xbc, 0xa0, 0x3d, 0x4d, 0xf0, 0x82,
-	0xf6, 0x84, 0x5a, 0x3e, 0x08, 0xf2, 0xbd, 0xc7, 0x8e, 0xed, 0x38, 0x69, 0x3a, 0x4d, 0x7b, 0xcc,
-	0xf5, 0xef, 0xfc, 0xce, 0xef, 0x9c, 0x7b, 0xee, 0x39, 0x27, 0x70, 0x75, 0x9f, 0xb2, 0x03, 0xca,
-	0x34, 0x93, 0x7a, 0x9a, 0x57, 0xd6, 0x1e, 0x34, 0x8d, 0xc6, 0xa1, 0xea, 0x34, 0xa8, 0x4b, 0xc9,
-	0x98, 0xf8, 0xa4, 0x9a, 0xd4, 0x53, 0xbd, 0xb2, 0xfc, 0x06, 0x22, 0xf7, 0x74, 0x66, 0x08, 0x9c,
-	0xe6, 0x95, 0xf7, 0x0c, 0x57, 0x2f, 0x6b, 0x8e, 0x6e, 0x5a, 0xb6, 0xee, 0x5a, 0xd4, 0x16, 0xa6,
-	0xf2, 0x35, 0x93, 0x52, 0xb3, 0x6e, 0x68, 0xba, 0x63, 0x69, 0xba, 0x6d, 0x53, 0x97, 0x7f, 0x64,
-	0xf8, 0x75, 0x3a, 0xee, 0xd3, 0xe7, 0x17, 0x1f, 0x50, 0x4c, 0x95, 0xff, 0xd2, 0xd0, 0x3d, 0xff,
-	0xa1, 0xc8, 0x90, 0xff, 0xcc, 0xf7, 0xb9, 0x4d, 0x6d, 0xe6, 0x5a, 0x6e, 0xd3, 0xe7, 0xab, 0x18,
-	0x0f, 0x9a, 0x06, 0x73, 0x95, 0xf7, 0xe0, 0x6a, 0xca, 0x37, 0xe6, 0x50, 0x9b, 0x19, 0x44, 0x81,
-	0xdc, 0x7e, 0xe4, 0x3c, 0x2f, 0x15, 0xa5, 0xe5, 0xd1, 0x4a, 0xec, 0x4c, 0xd9, 0x84, 0x49, 0x4e,
-	0xb0, 0xdb, 0xa0, 0x0e, 0x65, 0x7a, 0x1d, 0x89, 0xc9, 0x1c, 0x64, 0x1d, 0x3c, 0xaa, 0x5a, 0x35,
-	0x6e, 0x3a, 0x50, 0x81, 0xe0, 0xe8, 0xc3, 0x9a, 0xf2, 0x31, 0x4c, 0x25, 0x0c, 0xd1, 0xeb, 0x1a,
-	0x8c, 0x04, 0x30, 0x6e, 0x96, 0x5d, 0x9d, 0x56, 0x63, 0xe9, 0x54, 0x43, 0x93, 0x10, 0xa8, 0xfc,
-	0x9c, 0x49, 0xd0, 0xb1, 0x40, 0xc8, 0x0e, 0x5c, 0x0a, 0x85, 0x30, 0x57, 0x77, 0x9b, 0x8c, 0xb3,
-	0x8e, 0xaf, 0xce, 0x76, 0x60, 0xbd, 0xcb, 0x41, 0x95, 0x71, 0x27, 0xf6, 0x9b, 0xa8, 0x30, 0xe8,
-	0x51, 0xd7, 0x68, 0xe4, 0x33, 0x7e, 0x16, 0xb6, 0xf2, 0x7f, 0x9d, 0x94, 0x26, 0x91, 0xe0, 0x66,
-	0xad, 0xd6, 0x30, 0x18, 0xbb, 0xeb, 0x36, 0x2c, 0xdb, 0xac, 0x08, 0x18, 0xb9, 0x0e, 0xa3, 0x35,
-	0xc3, 0xa1, 0xcc, 0x72, 0x69, 0x23, 0xdf, 0x7f, 0x8e, 0x4d, 0x0b, 0x4a, 0x76, 0x00, 0x5a, 0x35,
-	0x91, 0x1f, 0xe0, 0x09, 0x58, 0x0c, 0xa4, 0xfa, 0x05, 0xa4, 0x8a, 0x42, 0xc3, 0x02, 0x52, 0x77,
-	0x75, 0xd3, 0xc0, 0x58, 0x2b, 0x11, 0x4b, 0xe5, 0x77, 0x09, 0xae, 0x24, 0x33, 0x82, 0x19, 0xde,
-	0x80, 0xd1, 0x20, 0x38, 0x3f, 0x19, 0xfd, 0xdd, 0x52, 0xdc, 0x42, 0x92, 0xdb, 0x31, 0x65, 0x19,
-	0xae, 0x6c, 0xe9, 0x5c, 0x65, 0xc2, 0x67, 0x4c, 0xda, 0x3e, 0x5c, 0xe6, 0xca, 0xbe, 0xa0, 0xae,
-	0xd1, 0x6b, 0xbd, 0x5c, 0x34, 0xff, 0xca, 0x0d, 0x78, 0x25, 0xe2, 0x04, 0x23, 0x5f, 0x82, 0x01,
-	0xff, 0x2b, 0xd6, 0xd5, 0x44, 0x22, 0x68, 0x0e, 0xe5, 0x00, 0xe5, 0x9b, 0x88, 0x35, 0xeb, 0x59,
-	0xe3, 0x4e, 0x4a, 0x86, 0x9e, 0xe7, 0xee, 0x7e, 0x94, 0x80, 0x44, 0xdd, 0xa3, 0xfa, 0xd7, 0x45,
-	0x0a, 0x82, 0x3b, 0x4b, 0x95, 0x2f, 0x10, 0x2f, 0xee, 0xae, 0xde, 0x42, 0x25, 0xbb, 0x7a, 0x43,
-	0x3f, 0x08, 0x33, 0xb1, 0x00, 0x59, 0x87, 0x1f, 0x54, 0xdd, 0x43, 0x47, 0xa4, 0x73, 0x74, 0x2b,
-	0x93, 0x97, 0x7c, 0x53, 0xff, 0xf8, 0xde, 0xa1, 0x63, 0x28, 0x8f, 0x33, 0x30, 0x11, 0xb3, 0xc5,
-	0x30, 0x6e, 0xc1, 0x98, 0x47, 0x5d, 0xcb, 0x36, 0xab, 0x02, 0x8c, 0xb7, 0x31, 0xd3, 0x1e, 0x8e,
-	0x65, 0x9b, 0xc2, 0x96, 0x73, 0xe7, 0xbc, 0xc8, 0x09, 0xb9, 0x0d, 0xe3, 0xf8, 0x68, 0x02, 0x1a,
-	0x11, 0xe5, 0xb5, 0x04, 0xcd, 0x2d, 0x01, 0x8a, 0xf0, 0x8c, 0xd5, 0xa2, 0x47, 0xe4, 0x26, 0xe4,
-	0x5c, 0xbd, 0x5e, 0x3f, 0x0c, 0x68, 0xfa, 0x39, 0x8d, 0x9c, 0xa0, 0xb9, 0xe7, 0x43, 0x22, 0x24,
-	0x59, 0xb7, 0x75, 0x40, 0xb6, 0x61, 0x08, 0x8d, 0xc5, 0x7b, 0x9d, 0x4a, 0xbe, 0x26, 0x61, 0x37,
-	0xf9, 0xec, 0xa4, 0x74, 0x59, 0x7c, 0x29, 0xb1, 0xda, 0xfd, 0xa2, 0xb7, 0xa2, 0xae, 0x6f, 0x56,
-	0xd0, 0x54, 0xb1, 0x31, 0x5b, 0x28, 0xb8, 0xe7, 0xa2, 0x8b, 0x35, 0x9a, 0x4c, 0xcf, 0x8d, 0x46,
-	0xf9, 0x00, 0x3b, 0x77, 0xe8, 0x0f, 0xaf, 0x67, 0x05, 0x86, 0x11, 0x84, 0x17, 0x73, 0x25, 0x3d,
-	0xa3, 0x95, 0x00, 0xa6, 0x7c, 0x1b, 0x67, 0x7a, 0xf9, 0xef, 0xe5, 0x91, 0x84, 0xdd, 0xbf, 0xa5,
-	0x00, 0x83, 0x59, 0x85, 0x11, 0x54, 0x19, 0xbc, 0x9a, 0x4e, 0xd1, 0x84, 0xb8, 0x17, 0xf7, 0x76,
-	0xde, 0x86, 0x69, 0xae, 0x8a, 0xd7, 0x4e, 0xc5, 0x60, 0xcd, 0xba, 0x7b, 0x81, 0xf1, 0x98, 0x6f,
-	0xb7, 0x0d, 0x6f, 0x68, 0x90, 0x57, 0x1f, 0xde, 0x4f, 0x6a, 0xa9, 0xa2, 0x89, 0x00, 0x2a, 0x5b,
-	0x30, 0x17, 0x9b, 0x05, 0x7e, 0xab, 0xf8, 0xd4, 0xe1, 0x8b, 0x45, 0xcf, 0x8a, 0x2c, 0x28, 0x76,
-	0xe6, 0x40, 0x65, 0xef, 0x83, 0xff, 0x48, 0x8d, 0x2a, 0x15, 0xe7, 0x28, 0x50, 0xe9, 0x30, 0x5c,
-	0xa2, 0x0c, 0x59, 0xaf, 0xf5, 0x43, 0xb9, 0x03, 0x05, 0xee, 0xea, 0x13, 0x83, 0x31, 0xdd, 0x34,
-	0xb6, 0x74, 0x66, 0xd4, 0xe2, 0x0d, 0x68, 0x19, 0x86, 0x0f, 0x98, 0x59, 0x6d, 0x36, 0xea, 0xd8,
-	0x7c, 0x2e, 0x3d, 0x3b, 0x29, 0x65, 0xbf, 0xf2, 0x17, 0xa2, 0x62, 0x59, 0x5d, 0x51, 0x57, 0x2a,
-	0x43, 0x07, 0xcc, 0xfc, 0xbc, 0x51, 0x57, 0x0e, 0x30, 0xf4, 0x34, 0x2e, 0x54, 0x7d, 0x27, 0x7c,
-	0xbe, 0x42, 0xef, 0x7c, 0x42, 0x6f, 0xbb, 0x69, 0x8a, 0x3b, 0xc1, 0xb0, 0xfa, 0x24, 0x07, 0x83,
-	0xdc, 0x1f, 0xf9, 0x5e, 0x82, 0x5c, 0x74, 0xad, 0x22, 0x4b, 0x09, 0xda, 0x4e, 0x4b, 0x99, 0xbc,
-	0x7c, 0x3e, 0x50, 0x28, 0x57, 0x16, 0x1e, 0xfe, 0xfd, 0xdf, 0xaf, 0x99, 0x59, 0x32, 0xa3, 0xc5,
-	0xf7, 0xc2, 0xe8, 0x8a, 0x46, 0xbe, 0x93, 0x60, 0x24, 0x48, 0x39, 0x59, 0x48, 0xe3, 0x4e, 0x2c,
-	0x6f, 0xf2, 0xab, 0xdd, 0x41, 0xe8, 0x5c, 0xe5, 0xce, 0x97, 0xc9, 0x62, 0xc2, 0x79, 0xb8, 0x31,
-	0x68, 0x47, 0x91, 0x8a, 0x3a, 0x26, 0x5f, 0xc3, 0x68, 0xb8, 0x8b, 0x90, 0xae, 0x2e, 0x82, 0x6b,
-	0x96, 0x5f, 0x3b, 0x07, 0x85, 0x4a, 0x8a, 0x5c, 0x89, 0x4c, 0xf2, 0x9d, 0x94, 0x90, 0x1f, 0x24,
-	0x18, 0xf0, 0xcb, 0x8d, 0xcc, 0xa5, 0x31, 0x46, 0x16, 0x11, 0xb9, 0xd8, 0x19, 0x80, 0xde, 0x6e,
-	0x70, 0x6f, 0xd7, 0xc9, 0x7a, 0x6f, 0x71, 0x6b, 0x7c, 0x22, 0x6b, 0x47, 0x7c, 0x2d, 0x39, 0x26,
-	0x0f, 0x25, 0x18, 0xe4, 0x63, 0x9d, 0x74, 0xf4, 0x14, 0x86, 0x3f, 0xdf, 0x05, 0x81, 0x62, 0xd6,
-	0xb9, 0x18, 0x95, 0xbc, 0x79, 0x11, 0x31, 0xc4, 0x86, 0x21, 0x1c, 0x5d, 0xa9, 0x2e, 0x62, 0x6f,
-	0x4d, 0x56, 0xba, 0x41, 0x50, 0xc6, 0x2c, 0x97, 0x31, 0x4d, 0xa6, 0x92, 0x32, 0x84, 0x97, 0xdf,
-	0x24, 0x18, 0xc6, 0x46, 0x4b, 0x52, 0xe9, 0xe2, 0x43, 0x4f, 0x5e, 0xe8, 0x8a, 0x41, 0x9f, 0xdb,
-	0xdc, 0xe7, 0xbb, 0xe4, 0x9d, 0x1e, 0x43, 0x0f, 0x1a, 0xbc, 0x76, 0x14, 0x0e, 0xc1, 0x63, 0xf2,
-	0x93, 0x04, 0x23, 0xc1, 0xd4, 0x20, 0xdd, 0xdc, 0xb2, 0xae, 0x8f, 0x23, 0x39, 0x78, 0x94, 0x4d,
-	0x2e, 0xae, 0x4c, 0xb4, 0x0b, 0x8a, 0x23, 0x8f, 0x24, 0xc8, 0x46, 0x3a, 0x38, 0x59, 0x4c, 0x73,
-	0xd7, 0x3e, 0x51, 0xe4, 0xa5, 0x73, 0x71, 0xcf, 0x59, 0x31, 0x7c, 0x82, 0x90, 0x3f, 0x24, 0x98,
-	0x48, 0xe9, 0xdb, 0x44, 0xed, 0xf6, 0x42, 0xdb, 0xc7, 0x8c, 0xac, 0xf5, 0x8c, 0x47, 0xb9, 0x1f,
-	0x3d, 0x39, 0x29, 0xe5, 0x44, 0xa7, 0xf5, 0x78, 0xab, 0xe5, 0xf2, 0x37, 0xc8, 0xda, 0x05, 0x0a,
-	0x3e, 0x98, 0x47, 0xe4, 0xb1, 0x04, 0xa4, 0xbd, 0x9b, 0x93, 0x52, 0x9a, 0xa8, 0x8e, 0xc3, 0x47,
-	0x56, 0x7b, 0x85, 0x07, 0xb5, 0x90, 0x1a, 0xc2, 0x3c, 0x99, 0x4b, 0x7d, 0x2c, 0xda, 0x11, 0x4e,
-	0xb6, 0xe3, 0xad, 0x8d, 0x3f, 0x4f, 0x0b, 0xd2, 0xd3, 0xd3, 0x82, 0xf4, 0xef, 0x69, 0x41, 0xfa,
-	0xe5, 0xac, 0xd0, 0xf7, 0xf4, 0xac, 0xd0, 0xf7, 0xcf, 0x59, 0xa1, 0xef, 0xcb, 0x19, 0x61, 0xc9,
-	0x6a, 0xf7, 0x55, 0x8b, 0x6a, 0x9c, 0x5b, 0xf3, 0x57, 0x71, 0xa6, 0x79, 0xe5, 0xbd, 0x21, 0xfe,
-	0xbf, 0x7f, 0xed, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x67, 0xac, 0x9e, 0xa1, 0x10, 0x00,
-	0x00,
+	// 1352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xd4, 0x46,
+	0x14, 0xc7, 0x9b, 0x0f, 0x92, 0xb7, 0x09, 0xa1, 0x43, 0x42, 0x16, 0x07, 0x36, 0x8b, 0xd3, 0x92,
+	0x40, 0xbb, 0x76, 0x36, 0x10, 0x22, 0x5a, 0xda, 0x8a, 0xf0, 0xd5, 0xd2, 0x22, 0xa8, 0xa1, 0x1c,
+	0x7a, 0x59, 0x99, 0xac, 0x6b, 0x2c, 0x36, 0x1e, 0xe3, 0xf1, 0xae, 0x48, 0xd3, 0xa8, 0x12, 0x52,
+	0x3f, 0x4e, 0x6d, 0xa5, 0x22, 0xb5, 0x7f, 0x04, 0xb7, 0xe6, 0x56, 0xa9, 0x97, 0x5e, 0xaa, 0x9e,
+	0x50, 0x7b, 0xa9, 0x38, 0x55, 0xd0, 0x3f, 0xa4, 0xf2, 0xcc, 0xf3, 0xc6, 0xf6, 0xda, 0x1b, 0x6f,
+	0x84, 0x7a, 0x02, 0xcf, 0xfc, 0xde, 0xef, 0xfd, 0xe6, 0x7d, 0xcc, 0x9b, 0x2c, 0x1c, 0x59, 0xa3,
+	0x6c, 0x9d, 0x32, 0xcd, 0xa2, 0x6d, 0xad, 0x5d, 0xd3, 0x1e, 0xb4, 0x4c, 0x6f, 0x43, 0x75, 0x3d,
+	0xea, 0x53, 0x32, 0x2e, 0xb6, 0x54, 0x8b, 0xb6, 0xd5, 0x76, 0x4d, 0x3e, 0x85, 0xc8, 0xbb, 0x06,
+	0x33, 0x05, 0x4e, 0x6b, 0xd7, 0xee, 0x9a, 0xbe, 0x51, 0xd3, 0x5c, 0xc3, 0xb2, 0x1d, 0xc3, 0xb7,
+	0xa9, 0x23, 0x4c, 0xe5, 0xa3, 0x16, 0xa5, 0x56, 0xd3, 0xd4, 0x0c, 0xd7, 0xd6, 0x0c, 0xc7, 0xa1,
+	0x3e, 0xdf, 0x64, 0xb8, 0x3b, 0x1d, 0xf7, 0x19, 0xf0, 0x8b, 0x0d, 0x14, 0x53, 0xe7, 0x5f, 0x1a,
+	0xba, 0xe7, 0x1f, 0x8a, 0x0c, 0xa5, 0x8f, 0x02, 0x9f, 0x17, 0xa9, 0xc3, 0x7c, 0xdb, 0x6f, 0x05,
+	0x7c, 0xba, 0xf9, 0xa0, 0x65, 0x32, 0x5f, 0x79, 0x17, 0x8e, 0xa4, 0xec, 0x31, 0x97, 0x3a, 0xcc,
+	0x24, 0x0a, 0x8c, 0xad, 0x45, 0xd6, 0x4b, 0x52, 0x45, 0x5a, 0x18, 0xd5, 0x63, 0x6b, 0xca, 0x0a,
+	0x4c, 0x72, 0x82, 0x9b, 0x1e, 0x75, 0x29, 0x33, 0x9a, 0x48, 0x4c, 0x66, 0xa1, 0xe8, 0xe2, 0x52,
+	0xdd, 0x6e, 0x70, 0xd3, 0x41, 0x1d, 0xc2, 0xa5, 0xf7, 0x1b, 0xca, 0x87, 0x30, 0x95, 0x30, 0x44,
+	0xaf, 0xa7, 0x61, 0x24, 0x84, 0x71, 0xb3, 0xe2, 0xd2, 0xb4, 0x1a, 0x0b, 0xa7, 0xda, 0x31, 0xe9,
+	0x00, 0x95, 0xef, 0x0a, 0x09, 0x3a, 0x16, 0x0a, 0xb9, 0x02, 0x13, 0x1d, 0x21, 0xcc, 0x37, 0xfc,
+	0x16, 0xe3, 0xac, 0x07, 0x96, 0x8e, 0x65, 0xb0, 0xde, 0xe2, 0x20, 0xfd, 0x80, 0x1b, 0xfb, 0x26,
+	0x2a, 0x0c, 0xb5, 0xa9, 0x6f, 0x7a, 0xa5, 0x42, 0x10, 0x85, 0xd5, 0xd2, 0x9f, 0xdb, 0xd5, 0x49,
+	0x24, 0xb8, 0xd0, 0x68, 0x78, 0x26, 0x63, 0xb7, 0x7c, 0xcf, 0x76, 0x2c, 0x5d, 0xc0, 0xc8, 0x59,
+	0x18, 0x6d, 0x98, 0x2e, 0x65, 0xb6, 0x4f, 0xbd, 0xd2, 0xc0, 0x2e, 0x36, 0x3b, 0x50, 0x72, 0x05,
+	0x60, 0xa7, 0x26, 0x4a, 0x83, 0x3c, 0x00, 0x27, 0x42, 0xa9, 0x41, 0x01, 0xa9, 0xa2, 0xd0, 0xb0,
+	0x80, 0xd4, 0x9b, 0x86, 0x65, 0xe2, 0x59, 0xf5, 0x88, 0xa5, 0xf2, 0x93, 0x04, 0x87, 0x93, 0x11,
+	0xc1, 0x08, 0x2f, 0xc3, 0x68, 0x78, 0xb8, 0x20, 0x18, 0x03, 0xbd, 0x42, 0xbc, 0x83, 0x24, 0x57,
+	0x63, 0xca, 0x0a, 0x5c, 0xd9, 0xfc, 0xae, 0xca, 0x84, 0xcf, 0x98, 0xb4, 0x35, 0x38, 0xc8, 0x95,
+	0xdd, 0xa1, 0xbe, 0x99, 0xb7, 0x5e, 0xfa, 0x8d, 0xbf, 0x72, 0x1e, 0x5e, 0x89, 0x38, 0xc1, 0x93,
+	0xcf, 0xc3, 0x60, 0xb0, 0x8b, 0x75, 0x75, 0x28, 0x71, 0x68, 0x0e, 0xe5, 0x00, 0xe5, 0xf3, 0x88,
+	0x35, 0xcb, 0xad, 0xf1, 0x4a, 0x4a, 0x84, 0xf6, 0x92, 0xbb, 0x6f, 0x24, 0x20, 0x51, 0xf7, 0xa8,
+	0xfe, 0xa4, 0x08, 0x41, 0x98, 0xb3, 0x54, 0xf9, 0x02, 0xf1, 0xf2, 0x72, 0x75, 0x0e, 0x95, 0xdc,
+	0x34, 0x3c, 0x63, 0xbd, 0x13, 0x89, 0x39, 0x28, 0xba, 0x7c, 0xa1, 0xee, 0x6f, 0xb8, 0x22, 0x9c,
+	0xa3, 0xab, 0x85, 0x92, 0xa4, 0x83, 0x58, 0xbe, 0xbd, 0xe1, 0x9a, 0xca, 0x93, 0x02, 0x1c, 0x8a,
+	0xd9, 0xe2, 0x31, 0x2e, 0xc1, 0x78, 0x9b, 0xfa, 0xb6, 0x63, 0xd5, 0x05, 0x18, 0xb3, 0x31, 0xd3,
+	0x7d, 0x1c, 0xdb, 0xb1, 0x84, 0x2d, 0xe7, 0x1e, 0x6b, 0x47, 0x56, 0xc8, 0x55, 0x38, 0x80, 0x4d,
+	0x13, 0xd2, 0x88, 0x53, 0x1e, 0x4d, 0xd0, 0x5c, 0x12, 0xa0, 0x08, 0xcf, 0x78, 0x23, 0xba, 0x44,
+	0x2e, 0xc0, 0x98, 0x6f, 0x34, 0x9b, 0x1b, 0x21, 0xcd, 0x00, 0xa7, 0x91, 0x13, 0x34, 0xb7, 0x03,
+	0x48, 0x84, 0xa4, 0xe8, 0xef, 0x2c, 0x90, 0x8b, 0x30, 0x8c, 0xc6, 0xa2, 0x5f, 0xa7, 0x92, 0xdd,
+	0x24, 0xec, 0x26, 0x9f, 0x6d, 0x57, 0x0f, 0x8a, 0x9d, 0x2a, 0x6b, 0xdc, 0xaf, 0xb4, 0x17, 0xd5,
+	0x33, 0x2b, 0x3a, 0x9a, 0x2a, 0x0e, 0x46, 0x0b, 0x05, 0xe7, 0x2e, 0xba, 0xd8, 0x45, 0x53, 0xc8,
+	0x7d, 0xd1, 0x28, 0xef, 0xc1, 0x64, 0xdc, 0x1f, 0xa6, 0x67, 0x11, 0xf6, 0x23, 0x08, 0x13, 0x73,
+	0x38, 0x3d, 0xa2, 0x7a, 0x08, 0x53, 0xbe, 0x88, 0x33, 0xfd, 0xff, 0xfd, 0xf2, 0x58, 0x82, 0xa9,
+	0x84, 0x02, 0x3c, 0xcc, 0x12, 0x8c, 0xa0, 0xca, 0xb0, 0x6b, 0xb2, 0x4e, 0xd3, 0xc1, 0xbd, 0xbc,
+	0xde, 0x79, 0x13, 0xa6, 0xb9, 0x2a, 0x5e, 0x3b, 0xba, 0xc9, 0x5a, 0x4d, 0xbf, 0x8f, 0xf1, 0x58,
+	0xea, 0xb6, 0xed, 0x64, 0x68, 0x88, 0x57, 0x5f, 0x49, 0xca, 0x2e, 0x55, 0x34, 0x11, 0x40, 0x65,
+	0x15, 0x66, 0x63, 0xb3, 0x20, 0xb8, 0x2a, 0x6e, 0xb8, 0x81, 0xc8, 0xdc, 0xc9, 0x52, 0x6c, 0xa8,
+	0x64, 0x73, 0xa0, 0xb2, 0xcb, 0x10, 0x34, 0xa9, 0x59, 0xa7, 0x62, 0x1d, 0x05, 0x2a, 0x19, 0xc3,
+	0x25, 0xca, 0x50, 0x6c, 0xef, 0x7c, 0x28, 0xd7, 0xa0, 0xcc, 0x5d, 0x5d, 0x37, 0x19, 0x33, 0x2c,
+	0x73, 0xd5, 0x60, 0x66, 0x23, 0x7e, 0x01, 0x2d, 0xc0, 0xfe, 0x75, 0x66, 0xd5, 0x5b, 0x5e, 0x13,
+	0x2f, 0x9f, 0x89, 0x67, 0xdb, 0xd5, 0xe2, 0xc3, 0xe0, 0x41, 0x54, 0xa9, 0xa9, 0x8b, 0xea, 0xa2,
+	0x3e, 0xbc, 0xce, 0xac, 0x8f, 0xbd, 0xa6, 0xb2, 0x0e, 0xb3, 0x99, 0x5c, 0xa8, 0xfa, 0x5a, 0xa7,
+	0x7d, 0x85, 0xde, 0xe3, 0x09, 0xbd, 0xdd, 0xa6, 0x29, 0xee, 0xb0, 0x8b, 0xcf, 0xe3, 0x83, 0x8a,
+	0x27, 0xe1, 0x96, 0x63, 0xb8, 0xec, 0x1e, 0xcd, 0x9f, 0xf5, 0x4f, 0x41, 0x4e, 0xb3, 0xde, 0x6b,
+	0xde, 0xc9, 0x61, 0x18, 0xbe, 0x67, 0xda, 0xd6, 0x3d, 0x9f, 0x97, 0xf1, 0x80, 0x8e, 0x5f, 0xca,
+	0x3b, 0x30, 0xc3, 0xfd, 0x5c, 0x7e, 0x68, 0xae, 0xe1, 0x9b, 0xcf, 0xa5, 0x5e, 0x7e, 0x9d, 0x77,
+	0xe0, 0x68, 0xba, 0x3d, 0x2a, 0x3d, 0x0b, 0xc3, 0x1e, 0x5f, 0x41, 0xa9, 0xe5, 0x84, 0xd4, 0xa4,
+	0x1d, 0xa2, 0x97, 0x7e, 0x9d, 0x80, 0x21, 0x4e, 0x4c, 0xbe, 0x92, 0x60, 0x2c, 0xfa, 0x28, 0x25,
+	0xf3, 0x09, 0x8a, 0xac, 0x27, 0xad, 0xbc, 0xb0, 0x3b, 0x50, 0xa8, 0x54, 0xe6, 0x1e, 0xfd, 0xf5,
+	0xef, 0x0f, 0x85, 0x63, 0x64, 0x46, 0x8b, 0xbf, 0xaa, 0xa3, 0x0f, 0x5c, 0xf2, 0xa5, 0x04, 0x23,
+	0x61, 0xc1, 0x92, 0xb9, 0x34, 0xee, 0xc4, 0xd3, 0x57, 0x7e, 0xb5, 0x37, 0x08, 0x9d, 0xab, 0xdc,
+	0xf9, 0x02, 0x39, 0x91, 0x70, 0x1e, 0x46, 0x99, 0x69, 0x9b, 0x91, 0x1c, 0x6c, 0x91, 0xcf, 0x60,
+	0x34, 0xe4, 0x60, 0xa4, 0xa7, 0x8b, 0xb0, 0x49, 0xe4, 0xd7, 0x76, 0x41, 0xa1, 0x92, 0x0a, 0x57,
+	0x22, 0x93, 0x52, 0x96, 0x12, 0xf2, 0xb5, 0x04, 0x83, 0x41, 0xb3, 0x92, 0xd9, 0x34, 0xc6, 0xc8,
+	0x33, 0x4e, 0xae, 0x64, 0x03, 0xd0, 0xdb, 0x79, 0xee, 0xed, 0x2c, 0x39, 0x93, 0xef, 0xdc, 0x1a,
+	0x7f, 0xcf, 0x68, 0x9b, 0xc1, 0x3f, 0xde, 0x16, 0x79, 0x24, 0xc1, 0x50, 0x40, 0xc7, 0x48, 0xa6,
+	0xa7, 0xce, 0xf1, 0x8f, 0xf7, 0x40, 0xa0, 0x98, 0x33, 0x5c, 0x8c, 0x4a, 0xde, 0xe8, 0x47, 0x0c,
+	0x71, 0x60, 0x18, 0x07, 0x7f, 0xaa, 0x8b, 0xd8, 0x4d, 0x25, 0x2b, 0xbd, 0x20, 0x28, 0xe3, 0x18,
+	0x97, 0x31, 0x4d, 0xa6, 0x92, 0x32, 0x84, 0x97, 0x1f, 0x25, 0xd8, 0x8f, 0x63, 0x8a, 0xa4, 0xd2,
+	0xc5, 0x9f, 0x0c, 0xf2, 0x5c, 0x4f, 0x0c, 0xfa, 0xbc, 0xc8, 0x7d, 0xbe, 0x4d, 0xde, 0xca, 0x79,
+	0xf4, 0x70, 0x3c, 0x6a, 0x9b, 0xf8, 0x3f, 0xea, 0x6d, 0x91, 0x6f, 0x25, 0x18, 0x41, 0x62, 0x46,
+	0x7a, 0xb9, 0x65, 0x3d, 0x9b, 0x23, 0x39, 0xb6, 0x95, 0x15, 0x2e, 0xae, 0x46, 0xb4, 0x3e, 0xc5,
+	0x91, 0xc7, 0x12, 0x14, 0x23, 0xf7, 0x20, 0x39, 0x91, 0xe6, 0xae, 0x7b, 0x1e, 0xcb, 0xf3, 0xbb,
+	0xe2, 0xf6, 0x58, 0x31, 0xe2, 0x1e, 0xfe, 0x4d, 0x82, 0x43, 0x29, 0x53, 0x8f, 0xa8, 0xbd, 0x3a,
+	0xb4, 0x7b, 0x48, 0xcb, 0x5a, 0x6e, 0x3c, 0xca, 0xfd, 0xe0, 0x8f, 0xed, 0xea, 0x98, 0x98, 0x53,
+	0x6d, 0x3e, 0xa8, 0xb8, 0xfc, 0x65, 0x72, 0xba, 0x8f, 0x82, 0x0f, 0xa7, 0x39, 0x79, 0x22, 0x01,
+	0xe9, 0x9e, 0x85, 0xa4, 0x9a, 0x26, 0x2a, 0x73, 0x74, 0xcb, 0x6a, 0x5e, 0x78, 0x58, 0x0b, 0xa9,
+	0x47, 0x38, 0x4e, 0x66, 0x53, 0x9b, 0x45, 0xdb, 0xc4, 0x77, 0xc1, 0x16, 0xf9, 0x59, 0x82, 0xf1,
+	0xd8, 0x20, 0x25, 0x0b, 0x99, 0x59, 0x4e, 0x4c, 0x6a, 0xf9, 0x64, 0x0e, 0x24, 0xea, 0xbb, 0x9e,
+	0xaa, 0x6f, 0x85, 0x2c, 0xf7, 0x53, 0x21, 0x75, 0x16, 0x6a, 0xfc, 0x45, 0x82, 0x89, 0xc4, 0x78,
+	0x24, 0xa7, 0xd2, 0xd4, 0xa4, 0xcf, 0x6e, 0xf9, 0xf5, 0x5c, 0x58, 0xd4, 0x7e, 0x23, 0x55, 0xfb,
+	0x39, 0xb2, 0x92, 0x53, 0xbb, 0x19, 0xf2, 0xd6, 0xc5, 0x00, 0x5f, 0x5d, 0xfe, 0xfd, 0x79, 0x59,
+	0x7a, 0xfa, 0xbc, 0x2c, 0xfd, 0xf3, 0xbc, 0x2c, 0x7d, 0xff, 0xa2, 0xbc, 0xef, 0xe9, 0x8b, 0xf2,
+	0xbe, 0xbf, 0x5f, 0x94, 0xf7, 0x7d, 0x32, 0x23, 0x18, 0x59, 0xe3, 0xbe, 0x6a, 0x53, 0x8d, 0xfb,
+	0xd4, 0x82, 0x3f, 0x1e, 0x59, 0xf0, 0x53, 0xd8, 0x30, 0xff, 0xa5, 0xea, 0xf4, 0x7f, 0x03, 0x00,
+	0x0b, 0x35, 0xe8, 0x52, 0x53, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1299,6 +1506,12 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// TallySnapshot queries the live tally of a proposal in voting period, which
+	// is updated on each vote rather than computed from all the votes.
+	TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error)
+	// ExecutionReport queries the result of the execution of the messages of a
+	// passed proposal: the gas consumed, and the events or error of each message.
+	ExecutionReport(ctx context.Context, in *QueryExecutionReportRequest, opts ...grpc.CallOption) (*QueryExecutionReportResponse, error)
 }
 
 type queryClient struct {
@@ -1408,6 +1621,24 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error) {
+	out := new(QueryTallySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/TallySnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionReport(ctx context.Context, in *QueryExecutionReportRequest, opts ...grpc.CallOption) (*QueryExecutionReportResponse, error) {
+	out := new(QueryExecutionReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ExecutionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1432,6 +1663,12 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// TallySnapshot queries the live tally of a proposal in voting period, which
+	// is updated on each vote rather than computed from all the votes.
+	TallySnapshot(context.Context, *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error)
+	// ExecutionReport queries the result of the execution of the messages of a
+	// passed proposal: the gas consumed, and the events or error of each message.
+	ExecutionReport(context.Context, *QueryExecutionReportRequest) (*QueryExecutionReportResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1471,6 +1708,12 @@ func (*UnimplementedQueryServer) ProposalVoteOptions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (*UnimplementedQueryServer) TallySnapshot(ctx context.Context, req *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallySnapshot not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionReport(ctx context.Context, req *QueryExecutionReportRequest) (*QueryExecutionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionReport not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1674,6 +1917,42 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/TallySnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallySnapshot(ctx, req.(*QueryTallySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ExecutionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionReport(ctx, req.(*QueryExecutionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1722,6 +2001,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "TallySnapshot",
+			Handler:    _Query_TallySnapshot_Handler,
+		},
+		{
+			MethodName: "ExecutionReport",
+			Handler:    _Query_ExecutionReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2556,55 +2843,186 @@ func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTallySnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTallySnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallySnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTallySnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallySnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallySnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Constitution)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExecutionReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryExecutionReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Proposal != nil {
@@ -2899,6 +3317,59 @@ func (m *QueryMessageBasedParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTallySnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallySnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryExecutionReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryExecutionReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5059,6 +5530,335 @@ func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTallySnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallySnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallySnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallySnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallySnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallySnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &TallyResult{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &ExecutionReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
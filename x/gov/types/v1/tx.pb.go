@@ -61,6 +61,12 @@ type MsgSubmitProposal struct {
 	// proposal_type defines the type of proposal
 	// When not set defaults to PROPOSAL_TYPE_STANDARD
 	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// depends_on defines the ids of the proposals that must have passed before
+	// this proposal can be executed.
+	DependsOn []uint64 `protobuf:"varint,9,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// conflicts_with defines the ids of the proposals that are mutually exclusive
+	// with this proposal.
+	ConflictsWith []uint64 `protobuf:"varint,10,rep,packed,name=conflicts_with,json=conflictsWith,proto3" json:"conflicts_with,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -153,6 +159,20 @@ func (m *MsgSubmitProposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MsgSubmitProposal) GetDependsOn() []uint64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetConflictsWith() []uint64 {
+	if m != nil {
+		return m.ConflictsWith
+	}
+	return nil
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -1169,6 +1189,107 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgFlagProposalSpam defines a message to flag a proposal as spam.
+type MsgFlagProposalSpam struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// validator is the operator address of the bonded validator flagging the proposal.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *MsgFlagProposalSpam) Reset()         { *m = MsgFlagProposalSpam{} }
+func (m *MsgFlagProposalSpam) String() string { return proto.CompactTextString(m) }
+func (*MsgFlagProposalSpam) ProtoMessage()    {}
+func (*MsgFlagProposalSpam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgFlagProposalSpam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlagProposalSpam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlagProposalSpam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlagProposalSpam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlagProposalSpam.Merge(m, src)
+}
+func (m *MsgFlagProposalSpam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlagProposalSpam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlagProposalSpam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlagProposalSpam proto.InternalMessageInfo
+
+func (m *MsgFlagProposalSpam) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgFlagProposalSpam) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// MsgFlagProposalSpamResponse defines the Msg/FlagProposalSpam response type.
+type MsgFlagProposalSpamResponse struct {
+	// closed is true if the flag closed the proposal and burnt its deposits.
+	Closed bool `protobuf:"varint,1,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (m *MsgFlagProposalSpamResponse) Reset()         { *m = MsgFlagProposalSpamResponse{} }
+func (m *MsgFlagProposalSpamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlagProposalSpamResponse) ProtoMessage()    {}
+func (*MsgFlagProposalSpamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgFlagProposalSpamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlagProposalSpamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlagProposalSpamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlagProposalSpamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlagProposalSpamResponse.Merge(m, src)
+}
+func (m *MsgFlagProposalSpamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlagProposalSpamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlagProposalSpamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlagProposalSpamResponse proto.InternalMessageInfo
+
+func (m *MsgFlagProposalSpamResponse) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1311,115 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgFlagProposalSpam)(nil), "cosmos.gov.v1.MsgFlagProposalSpam")
+	proto.RegisterType((*MsgFlagProposalSpamResponse)(nil), "cosmos.gov.v1.MsgFlagProposalSpamResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xda, 0xfb, 0x61, 0x27, 0x2d, 0x12, 0xe2, 0xd2, 0x7a, 0xdf, 0xd7, 0xbc, 0xf7, 0x9b, 0x37, 0xbf,
-	0xf7, 0x02, 0x96, 0x1a, 0x08, 0x9b, 0x08, 0x17, 0x75, 0xd4, 0x2d, 0x76, 0xcb, 0x45, 0x7b, 0x47,
-	0xb6, 0xda, 0xc8, 0x46, 0xfc, 0x31, 0x47, 0x2e, 0xeb, 0xa8, 0x2b, 0x77, 0xcb, 0x62, 0x86, 0x99,
-	0x6d, 0xa9, 0x18, 0x16, 0xbb, 0xe5, 0x2d, 0x68, 0xab, 0xe5, 0x62, 0x03, 0x19, 0x2d, 0xc7, 0x5c,
-	0x5c, 0xf6, 0x87, 0x21, 0x5e, 0x8e, 0x22, 0xad, 0x23, 0x1d, 0xd1, 0x9f, 0x45, 0xf2, 0x8b, 0x49,
-	0x57, 0x1c, 0xf3, 0xba, 0xa3, 0x60, 0x47, 0x31, 0x95, 0x8e, 0x90, 0xde, 0x84, 0x45, 0xfa, 0xb5,
-	0xd5, 0xf9, 0xb4, 0xa8, 0xb6, 0x7a, 0x81, 0x43, 0x4c, 0xac, 0x93, 0x43, 0x4c, 0xac, 0x33, 0xc5,
-	0x82, 0x6a, 0x1a, 0x2d, 0x54, 0xa4, 0xff, 0x32, 0x51, 0x36, 0x18, 0xc6, 0x36, 0x4c, 0x88, 0x6d,
-	0xd5, 0xb4, 0x1c, 0x03, 0xe9, 0xc7, 0x29, 0xb0, 0x50, 0xc3, 0xfa, 0x8d, 0xce, 0x96, 0x69, 0xd8,
-	0xd7, 0xdb, 0xc8, 0x42, 0x58, 0x6d, 0xf2, 0x25, 0x90, 0x34, 0x21, 0xc6, 0xaa, 0x0e, 0xb1, 0xc0,
-	0xe5, 0x62, 0xf9, 0xd9, 0xf5, 0xb4, 0xec, 0x44, 0x92, 0xdd, 0x48, 0xf2, 0x95, 0x56, 0x4f, 0xf1,
-	0xac, 0xf8, 0x7b, 0x1c, 0x98, 0x37, 0x5a, 0x86, 0x6d, 0xa8, 0xcd, 0xba, 0x06, 0x2d, 0x84, 0x0d,
-	0x5b, 0x98, 0xa4, 0x9e, 0x2b, 0x32, 0x2b, 0x8c, 0x80, 0x26, 0x33, 0xd0, 0xe4, 0x4d, 0x64, 0xb4,
-	0xaa, 0xef, 0x3c, 0x78, 0x9c, 0x9d, 0xf8, 0xe1, 0x49, 0x36, 0xaf, 0x1b, 0xf6, 0x76, 0x67, 0x4b,
-	0x6e, 0x20, 0x93, 0xa1, 0xc0, 0xfe, 0x2b, 0x60, 0xed, 0x6e, 0xd1, 0xee, 0x59, 0x10, 0x53, 0x07,
-	0xfc, 0xcd, 0xc1, 0xee, 0x5a, 0xaa, 0x09, 0x75, 0xb5, 0xd1, 0xab, 0x13, 0xd8, 0xf1, 0x77, 0x07,
-	0xbb, 0x6b, 0x9c, 0x32, 0xc7, 0x4e, 0x7e, 0xdb, 0x39, 0x98, 0xbf, 0x00, 0x92, 0x16, 0x2d, 0x05,
-	0xb6, 0x85, 0x58, 0x8e, 0xcb, 0xcf, 0x54, 0x85, 0xfd, 0x7e, 0x21, 0xcd, 0xf2, 0xb8, 0xa2, 0x69,
-	0x6d, 0x88, 0xf1, 0x0d, 0xbb, 0x6d, 0xb4, 0x74, 0xc5, 0xb3, 0xe4, 0x45, 0x52, 0xb4, 0xad, 0x6a,
-	0xaa, 0xad, 0x0a, 0x53, 0xc4, 0x4b, 0xf1, 0xbe, 0xf9, 0x57, 0xc1, 0xb4, 0x6d, 0xd8, 0x4d, 0x28,
-	0x4c, 0xd3, 0x70, 0x8b, 0x8f, 0xfa, 0x85, 0xf9, 0x41, 0x8a, 0xb9, 0x92, 0x7c, 0xe1, 0x75, 0xc5,
-	0xb1, 0xe0, 0x0b, 0x20, 0x81, 0x3b, 0xa6, 0xa9, 0xb6, 0x7b, 0x42, 0x7c, 0xb4, 0xb1, 0x6b, 0xc3,
-	0x9f, 0x07, 0x33, 0x70, 0xc7, 0x82, 0x9a, 0x61, 0x43, 0x4d, 0x48, 0xe4, 0xb8, 0x7c, 0xb2, 0x7a,
-	0x22, 0xe4, 0xb0, 0x51, 0x12, 0x38, 0x65, 0x60, 0xc7, 0x2b, 0xe0, 0x98, 0xc5, 0xee, 0xaa, 0x4e,
-	0xe0, 0x11, 0x92, 0x39, 0x2e, 0x3f, 0xb7, 0x7e, 0x52, 0xf6, 0xb5, 0xab, 0xec, 0xde, 0xe7, 0xcd,
-	0x9e, 0x05, 0xab, 0xc7, 0x1f, 0xf5, 0x0b, 0xa9, 0x1d, 0xd2, 0x93, 0xb9, 0x6e, 0x59, 0x2e, 0xc9,
-	0x25, 0x25, 0x65, 0x0d, 0xe9, 0x2b, 0xe5, 0x2f, 0x0f, 0x76, 0xd7, 0x3c, 0x34, 0xee, 0x1d, 0xec,
-	0xae, 0x65, 0x87, 0x2e, 0xa1, 0x5b, 0x2e, 0x86, 0xda, 0x44, 0xba, 0x04, 0x56, 0x42, 0x42, 0x05,
-	0x62, 0x0b, 0xb5, 0x30, 0xe4, 0xb3, 0x60, 0xd6, 0xcb, 0xd1, 0xd0, 0x04, 0x2e, 0xc7, 0xe5, 0xa7,
-	0x14, 0xe0, 0x8a, 0xae, 0x69, 0xd2, 0x4f, 0x1c, 0x48, 0xd7, 0xb0, 0x7e, 0x75, 0x07, 0x36, 0xde,
-	0xa7, 0x57, 0xba, 0x89, 0x5a, 0x36, 0x6c, 0xd9, 0xfc, 0x07, 0x20, 0xd1, 0x70, 0x7e, 0x52, 0xaf,
-	0x11, 0xcd, 0x57, 0xcd, 0xec, 0xf5, 0x0b, 0xa2, 0xaf, 0x60, 0xb7, 0xb5, 0xa8, 0xaf, 0xe2, 0x06,
-	0xe1, 0x57, 0xc1, 0x8c, 0xda, 0xb1, 0xb7, 0x51, 0xdb, 0xb0, 0x7b, 0xc2, 0x24, 0xbd, 0xd9, 0x81,
-	0xa0, 0xb2, 0x41, 0xea, 0x1e, 0x7c, 0x93, 0xc2, 0xa5, 0x50, 0xe1, 0xa1, 0x24, 0xa5, 0x0c, 0x58,
-	0x8d, 0x92, 0xbb, 0xe5, 0x4b, 0x7f, 0x70, 0x20, 0x51, 0xc3, 0xfa, 0x6d, 0x64, 0x43, 0x7e, 0x23,
-	0x02, 0x8a, 0x6a, 0xfa, 0xaf, 0xc7, 0xd9, 0x61, 0xb1, 0xd3, 0xca, 0x43, 0x00, 0xf1, 0x32, 0x98,
-	0xee, 0x22, 0x1b, 0xb6, 0x9d, 0x9c, 0xc7, 0xf4, 0xb0, 0x63, 0xc6, 0x97, 0x41, 0x1c, 0x59, 0xb6,
-	0x81, 0x5a, 0xb4, 0xe9, 0xe7, 0x06, 0x2f, 0x8f, 0xb5, 0x03, 0xc9, 0xe5, 0x43, 0x6a, 0xa0, 0x30,
-	0xc3, 0x71, 0x3d, 0x5f, 0x79, 0x89, 0x00, 0xe3, 0x84, 0x26, 0xa0, 0x9c, 0x08, 0x81, 0x42, 0xe2,
-	0x49, 0x0b, 0x60, 0x9e, 0xfd, 0xf4, 0x4a, 0xff, 0x9b, 0xf3, 0x64, 0x1f, 0x41, 0x43, 0xdf, 0x26,
-	0x1d, 0xfb, 0x1f, 0x41, 0xf0, 0x16, 0x48, 0x38, 0x95, 0x61, 0x21, 0x46, 0xd9, 0xe7, 0x74, 0x00,
-	0x03, 0x37, 0xa1, 0x21, 0x2c, 0x5c, 0x8f, 0xb1, 0x60, 0xbc, 0xe6, 0x07, 0xe3, 0x54, 0x24, 0x18,
-	0x6e, 0x70, 0x69, 0x05, 0x2c, 0x07, 0x44, 0x1e, 0x38, 0x7f, 0x72, 0x00, 0xd4, 0xb0, 0xee, 0x52,
-	0xd5, 0x0b, 0xe2, 0x72, 0x11, 0xcc, 0x30, 0x96, 0x45, 0x87, 0x63, 0x33, 0x30, 0xe5, 0x2f, 0x81,
-	0xb8, 0x6a, 0xa2, 0x4e, 0xcb, 0x66, 0xf0, 0x8c, 0x21, 0xe7, 0x19, 0x42, 0xce, 0xce, 0xc9, 0xcc,
-	0xa7, 0x72, 0x8e, 0x3e, 0x15, 0x2f, 0x1a, 0x01, 0x42, 0x08, 0x01, 0xc1, 0x2a, 0x93, 0xd2, 0x80,
-	0x1f, 0x7c, 0x79, 0xe5, 0xff, 0xe2, 0xf4, 0xc6, 0x2d, 0x4b, 0x53, 0x6d, 0x78, 0x5d, 0x6d, 0xab,
-	0x26, 0x26, 0xc5, 0x0c, 0xde, 0x27, 0x77, 0x58, 0x31, 0x9e, 0x29, 0xff, 0x06, 0x88, 0x5b, 0x34,
-	0x02, 0x45, 0x60, 0x76, 0xfd, 0x44, 0x90, 0xfe, 0xa8, 0xd2, 0x57, 0x88, 0x63, 0x5f, 0xb9, 0xb6,
-	0x1f, 0xa6, 0xe4, 0x30, 0x0d, 0x9c, 0x19, 0xaa, 0x6d, 0xc7, 0x9d, 0xe9, 0x81, 0xe4, 0x25, 0x99,
-	0x5e, 0xf5, 0xb0, 0xc8, 0xad, 0xb5, 0xb2, 0x18, 0x71, 0x8a, 0xf4, 0x2d, 0x47, 0x07, 0xee, 0xa6,
-	0xda, 0x6a, 0xc0, 0xe6, 0xd0, 0xc0, 0x8d, 0x68, 0x83, 0xf9, 0x40, 0x1b, 0xf8, 0x3a, 0x60, 0x78,
-	0xc6, 0x4d, 0x1e, 0x75, 0xc6, 0x55, 0x72, 0xfb, 0xe1, 0xd1, 0xe2, 0xe3, 0x7d, 0xe9, 0x57, 0x8e,
-	0x92, 0xba, 0x3f, 0x3f, 0x8f, 0xd4, 0x9f, 0x3f, 0xcf, 0x6b, 0xe0, 0x58, 0x83, 0xc6, 0x82, 0x5a,
-	0x9d, 0x2c, 0x1f, 0xec, 0xae, 0xc4, 0x10, 0xa5, 0xdf, 0x74, 0x37, 0x93, 0x6a, 0x92, 0x5c, 0xd8,
-	0xfd, 0x27, 0x59, 0x4e, 0x49, 0xb9, 0xae, 0x44, 0xc9, 0x9f, 0x05, 0xf3, 0x5e, 0xa8, 0x6d, 0xfa,
-	0xae, 0x28, 0xd1, 0x4d, 0x29, 0x73, 0xae, 0xf8, 0x3d, 0x2a, 0x8d, 0x00, 0x7e, 0xa3, 0x24, 0x7d,
-	0x15, 0x03, 0x59, 0x6f, 0x5a, 0xd5, 0x3a, 0x4d, 0xdb, 0xb0, 0x9a, 0x70, 0x73, 0x1b, 0x19, 0x0d,
-	0xe8, 0x5d, 0x43, 0xd4, 0x16, 0xc3, 0xfd, 0x1f, 0xb6, 0x98, 0xc9, 0x17, 0xda, 0x62, 0x62, 0x81,
-	0x2d, 0x26, 0xed, 0x6e, 0x31, 0x0e, 0xbb, 0xb1, 0x85, 0x45, 0x18, 0x2c, 0x2c, 0x74, 0xbb, 0x19,
-	0xec, 0x26, 0x57, 0x41, 0x8a, 0x30, 0x5e, 0xdd, 0xa5, 0xd4, 0x38, 0xbd, 0x3a, 0x69, 0xc4, 0x96,
-	0x31, 0xa0, 0x54, 0xac, 0xcc, 0x76, 0x07, 0x1f, 0x95, 0xd5, 0xfd, 0x7e, 0x61, 0xd6, 0xd9, 0x3c,
-	0xe8, 0xe2, 0xe1, 0x6f, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65, 0x3e,
-	0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a, 0xcb,
-	0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd, 0xe4,
-	0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63, 0x4c,
-	0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0x06, 0x47, 0x4a, 0x65, 0x90, 0x89, 0x2e, 0xc2, 0xa3, 0x9a,
-	0x50, 0xe1, 0xdf, 0x73, 0x60, 0x96, 0xc2, 0xaa, 0x21, 0xb2, 0xa3, 0xbc, 0x70, 0xb5, 0x9b, 0x20,
-	0x66, 0x62, 0x9d, 0x3d, 0xda, 0xe8, 0x3d, 0xec, 0xe4, 0x5e, 0xbf, 0xb0, 0x1c, 0xf5, 0x3a, 0x6a,
-	0x58, 0x57, 0x88, 0xf7, 0x61, 0xe5, 0x5d, 0x06, 0x8b, 0x43, 0xa9, 0x7a, 0xb7, 0xbd, 0x04, 0xe2,
-	0x6d, 0x88, 0x3b, 0x4d, 0x67, 0x0b, 0x4c, 0x29, 0xec, 0x2b, 0x54, 0xeb, 0xfa, 0xbd, 0x24, 0x88,
-	0xd5, 0xb0, 0xce, 0xdf, 0x01, 0x73, 0x81, 0xbf, 0x63, 0x72, 0xc1, 0x2b, 0x08, 0x6e, 0xab, 0x62,
-	0xfe, 0x30, 0x0b, 0x2f, 0x1d, 0x08, 0x16, 0xc2, 0xab, 0xea, 0x99, 0xb0, 0x7b, 0xc8, 0x48, 0x3c,
-	0x77, 0x04, 0x23, 0xef, 0x98, 0xcb, 0x60, 0x8a, 0xee, 0x8c, 0x4b, 0x61, 0x27, 0x22, 0x17, 0x33,
-	0xd1, 0x72, 0xcf, 0xff, 0x36, 0x48, 0xf9, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65, 0xbc,
-	0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48, 0x95,
-	0x17, 0xe8, 0x2e, 0x48, 0xf9, 0xa6, 0x7f, 0x44, 0x82, 0xc3, 0xfa, 0xa8, 0x04, 0xa3, 0xa6, 0xad,
-	0xb4, 0xb8, 0x17, 0x9e, 0xb6, 0xfc, 0x67, 0x60, 0x2e, 0x30, 0x69, 0x23, 0x5a, 0xc2, 0x6f, 0x11,
-	0xd5, 0x12, 0xd1, 0xd3, 0x30, 0xe2, 0xc8, 0x8d, 0x12, 0xff, 0x35, 0x07, 0x56, 0xc7, 0x0e, 0x19,
-	0x79, 0x54, 0xcb, 0x45, 0xdb, 0x8b, 0x17, 0x9f, 0xcf, 0xde, 0xcb, 0xee, 0xf8, 0x5e, 0xbf, 0x90,
-	0xca, 0x0d, 0x3d, 0x14, 0xfe, 0x73, 0xb0, 0x18, 0xc5, 0x84, 0x2f, 0x8f, 0x42, 0xd8, 0x67, 0x26,
-	0x16, 0x8e, 0x64, 0x36, 0xe6, 0xf8, 0x3b, 0x20, 0xe9, 0xf1, 0x91, 0x18, 0x55, 0x94, 0xa3, 0x13,
-	0xa5, 0xd1, 0xba, 0xd1, 0xd1, 0xc5, 0xe9, 0x2f, 0xc8, 0x14, 0xad, 0x6e, 0x3c, 0x78, 0x9a, 0xe1,
-	0x1e, 0x3e, 0xcd, 0x70, 0xbf, 0x3f, 0xcd, 0x70, 0xf7, 0x9f, 0x65, 0x26, 0x1e, 0x3e, 0xcb, 0x4c,
-	0xfc, 0xf6, 0x2c, 0x33, 0xf1, 0xf1, 0x49, 0x27, 0x2a, 0xd6, 0xee, 0xca, 0x06, 0x62, 0x0b, 0x1d,
-	0x9d, 0xca, 0xc5, 0x6e, 0x79, 0x2b, 0x4e, 0x29, 0xed, 0xfc, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0x4c, 0x36, 0xd9, 0x09, 0x12, 0x00, 0x00,
+	// 1608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x89, 0x63, 0x4f, 0x9c, 0xa4, 0xd9, 0xa4, 0xc9, 0x66, 0x93, 0xda, 0xee, 0x16,
+	0x5a, 0x93, 0xe2, 0x75, 0x9c, 0x36, 0x2d, 0x98, 0xaa, 0xa8, 0x0e, 0x2d, 0x54, 0xc2, 0xb4, 0xda,
+	0xfe, 0x92, 0xa0, 0x92, 0xb5, 0xf1, 0x4e, 0x37, 0xab, 0xee, 0xee, 0x2c, 0x9e, 0xb5, 0x49, 0x0e,
+	0x48, 0x88, 0x63, 0x4f, 0xbd, 0x20, 0x21, 0x24, 0x6e, 0x48, 0xfc, 0x38, 0xe5, 0xe0, 0x1b, 0x47,
+	0x2e, 0x55, 0x0e, 0xa8, 0xca, 0x01, 0xa1, 0x1e, 0x5a, 0xd4, 0x0a, 0x22, 0xf1, 0x4f, 0x14, 0xed,
+	0xec, 0xee, 0xd8, 0xde, 0x5d, 0xdb, 0x69, 0x90, 0x10, 0x97, 0x64, 0x67, 0xde, 0xf7, 0xde, 0xcc,
+	0xfb, 0xe6, 0xcd, 0x9b, 0x2f, 0x01, 0x73, 0x35, 0x84, 0x0d, 0x84, 0x0b, 0x2a, 0x6a, 0x16, 0x9a,
+	0xc5, 0x82, 0xbd, 0x25, 0x5a, 0x75, 0x64, 0x23, 0x76, 0xc2, 0x9d, 0x17, 0x55, 0xd4, 0x14, 0x9b,
+	0x45, 0x3e, 0xed, 0xc1, 0x36, 0x64, 0x0c, 0x0b, 0xcd, 0xe2, 0x06, 0xb4, 0xe5, 0x62, 0xa1, 0x86,
+	0x34, 0xd3, 0x85, 0xf3, 0xf3, 0xdd, 0x61, 0x1c, 0x2f, 0xd7, 0x30, 0xab, 0x22, 0x15, 0x91, 0xcf,
+	0x82, 0xf3, 0xe5, 0xcd, 0x2e, 0xb8, 0xf0, 0xaa, 0x6b, 0xf0, 0x96, 0xf2, 0x4c, 0x2a, 0x42, 0xaa,
+	0x0e, 0x0b, 0x64, 0xb4, 0xd1, 0xb8, 0x57, 0x90, 0xcd, 0xed, 0xc0, 0x22, 0x06, 0x56, 0x9d, 0x45,
+	0x0c, 0xac, 0x7a, 0x86, 0x69, 0xd9, 0xd0, 0x4c, 0x54, 0x20, 0x3f, 0xbd, 0xa9, 0x4c, 0x30, 0x8c,
+	0xad, 0x19, 0x10, 0xdb, 0xb2, 0x61, 0xb9, 0x00, 0xe1, 0xbb, 0x51, 0x30, 0x5d, 0xc1, 0xea, 0x8d,
+	0xc6, 0x86, 0xa1, 0xd9, 0xd7, 0xeb, 0xc8, 0x42, 0x58, 0xd6, 0xd9, 0x15, 0x90, 0x30, 0x20, 0xc6,
+	0xb2, 0x0a, 0x31, 0xc7, 0x64, 0x63, 0xb9, 0xf1, 0xd5, 0x59, 0xd1, 0x8d, 0x24, 0xfa, 0x91, 0xc4,
+	0x4b, 0xe6, 0xb6, 0x44, 0x51, 0xec, 0x03, 0x06, 0x4c, 0x69, 0xa6, 0x66, 0x6b, 0xb2, 0x5e, 0x55,
+	0xa0, 0x85, 0xb0, 0x66, 0x73, 0xc3, 0xc4, 0x73, 0x41, 0xf4, 0x12, 0x73, 0x48, 0x13, 0x3d, 0xd2,
+	0xc4, 0x75, 0xa4, 0x99, 0xe5, 0x2b, 0x8f, 0x9e, 0x66, 0x86, 0x7e, 0x7a, 0x96, 0xc9, 0xa9, 0x9a,
+	0xbd, 0xd9, 0xd8, 0x10, 0x6b, 0xc8, 0xf0, 0x58, 0xf0, 0x7e, 0xe5, 0xb1, 0x72, 0xbf, 0x60, 0x6f,
+	0x5b, 0x10, 0x13, 0x07, 0xfc, 0xcd, 0xfe, 0xce, 0x72, 0x4a, 0x87, 0xaa, 0x5c, 0xdb, 0xae, 0x3a,
+	0xb4, 0xe3, 0x1f, 0xf6, 0x77, 0x96, 0x19, 0x69, 0xd2, 0x5b, 0xf9, 0x3d, 0x77, 0x61, 0xf6, 0x2c,
+	0x48, 0x58, 0x24, 0x15, 0x58, 0xe7, 0x62, 0x59, 0x26, 0x97, 0x2c, 0x73, 0x7b, 0xad, 0xfc, 0xac,
+	0xb7, 0x8f, 0x4b, 0x8a, 0x52, 0x87, 0x18, 0xdf, 0xb0, 0xeb, 0x9a, 0xa9, 0x4a, 0x14, 0xc9, 0xf2,
+	0x4e, 0xd2, 0xb6, 0xac, 0xc8, 0xb6, 0xcc, 0x8d, 0x38, 0x5e, 0x12, 0x1d, 0xb3, 0x6f, 0x80, 0x51,
+	0x5b, 0xb3, 0x75, 0xc8, 0x8d, 0x92, 0x70, 0x33, 0x4f, 0x5a, 0xf9, 0xa9, 0xf6, 0x16, 0xb3, 0x2b,
+	0xe2, 0xd9, 0xf3, 0x92, 0x8b, 0x60, 0xf3, 0x60, 0x0c, 0x37, 0x0c, 0x43, 0xae, 0x6f, 0x73, 0xf1,
+	0xde, 0x60, 0x1f, 0xc3, 0x9e, 0x01, 0x49, 0xb8, 0x65, 0x41, 0x45, 0xb3, 0xa1, 0xc2, 0x8d, 0x65,
+	0x99, 0x5c, 0xa2, 0x7c, 0x34, 0xe4, 0xb0, 0xb6, 0xc2, 0x31, 0x52, 0x1b, 0xc7, 0x4a, 0x60, 0xc2,
+	0xf2, 0xce, 0xaa, 0xea, 0xd0, 0xc3, 0x25, 0xb2, 0x4c, 0x6e, 0x72, 0x75, 0x51, 0xec, 0x2a, 0x57,
+	0xd1, 0x3f, 0xcf, 0x9b, 0xdb, 0x16, 0x2c, 0x1f, 0x79, 0xd2, 0xca, 0xa7, 0xb6, 0x9c, 0x9a, 0xcc,
+	0x36, 0x8b, 0xe2, 0x8a, 0xb8, 0x22, 0xa5, 0xac, 0x0e, 0x3b, 0x5b, 0x00, 0x40, 0x81, 0x16, 0x34,
+	0x15, 0x5c, 0x45, 0x26, 0x97, 0xcc, 0xc6, 0x72, 0x23, 0x11, 0x3e, 0x49, 0x0f, 0x73, 0xcd, 0x64,
+	0xcf, 0x83, 0xc9, 0x1a, 0x32, 0xef, 0xe9, 0x5a, 0xcd, 0xc6, 0xd5, 0xcf, 0x34, 0x7b, 0x93, 0x03,
+	0x3d, 0x9c, 0x26, 0x28, 0xee, 0x8e, 0x66, 0x6f, 0x96, 0x8a, 0x5f, 0xee, 0xef, 0x2c, 0x53, 0xde,
+	0x1f, 0xec, 0xef, 0x2c, 0x67, 0x3a, 0x8e, 0xbb, 0x59, 0x2c, 0x84, 0x0a, 0x52, 0xb8, 0x00, 0x16,
+	0x42, 0x93, 0x12, 0xc4, 0x16, 0x32, 0x31, 0x64, 0x33, 0x60, 0x9c, 0xb2, 0xa1, 0x29, 0x1c, 0x93,
+	0x65, 0x72, 0x23, 0x12, 0xf0, 0xa7, 0xae, 0x2a, 0xc2, 0xcf, 0x0c, 0x98, 0xad, 0x60, 0xf5, 0xf2,
+	0x16, 0xac, 0x7d, 0x48, 0x8a, 0x67, 0x1d, 0x99, 0x36, 0x34, 0x6d, 0xf6, 0x23, 0x30, 0x56, 0x73,
+	0x3f, 0x89, 0x57, 0x8f, 0x32, 0x2f, 0xa7, 0x77, 0x5b, 0x79, 0xbe, 0x8b, 0x5a, 0xbf, 0x88, 0x89,
+	0xaf, 0xe4, 0x07, 0x61, 0x97, 0x40, 0x52, 0x6e, 0xd8, 0x9b, 0xa8, 0xae, 0xd9, 0xdb, 0xdc, 0x30,
+	0xa9, 0xa1, 0xf6, 0x44, 0x69, 0xcd, 0xc9, 0xbb, 0x3d, 0x76, 0x12, 0x17, 0x42, 0x89, 0x87, 0x36,
+	0x29, 0xa4, 0xc1, 0x52, 0xd4, 0xbc, 0x9f, 0xbe, 0xf0, 0x27, 0x03, 0xc6, 0x2a, 0x58, 0xbd, 0x8d,
+	0x6c, 0xc8, 0xae, 0x45, 0x50, 0x51, 0x9e, 0xfd, 0xfb, 0x69, 0xa6, 0x73, 0xda, 0xbd, 0x34, 0x1d,
+	0x04, 0xb1, 0x22, 0x18, 0x6d, 0x22, 0x1b, 0xd6, 0xb9, 0xe1, 0x01, 0xb7, 0xc5, 0x85, 0xb1, 0x45,
+	0x10, 0x47, 0x96, 0xad, 0x21, 0x93, 0x5c, 0xaf, 0xc9, 0xf6, 0x1d, 0xf7, 0x0a, 0xcf, 0xd9, 0xcb,
+	0x35, 0x02, 0x90, 0x3c, 0x60, 0xbf, 0xdb, 0x55, 0x7a, 0xcd, 0x21, 0xc6, 0x0d, 0xed, 0x90, 0x72,
+	0x34, 0x44, 0x8a, 0x13, 0x4f, 0x98, 0x06, 0x53, 0xde, 0x27, 0x4d, 0xfd, 0x25, 0x43, 0xe7, 0xee,
+	0x40, 0x4d, 0xdd, 0x74, 0xee, 0xc6, 0x7f, 0x44, 0xc1, 0x3b, 0x60, 0xcc, 0xcd, 0x0c, 0x73, 0x31,
+	0xd2, 0xe7, 0x8e, 0x07, 0x38, 0xf0, 0x37, 0xd4, 0xc1, 0x85, 0xef, 0xd1, 0x97, 0x8c, 0x37, 0xbb,
+	0xc9, 0x38, 0x16, 0x49, 0x86, 0x1f, 0x5c, 0x58, 0x00, 0xf3, 0x81, 0x29, 0x4a, 0xce, 0x5f, 0x0c,
+	0x00, 0x15, 0xac, 0xfa, 0x4d, 0xf1, 0x90, 0xbc, 0x9c, 0x03, 0x49, 0xaf, 0x9f, 0xa3, 0xc1, 0xdc,
+	0xb4, 0xa1, 0xec, 0x05, 0x10, 0x97, 0x0d, 0xd4, 0x30, 0x6d, 0x8f, 0x9e, 0x3e, 0xcf, 0x40, 0xd2,
+	0x79, 0x06, 0xdc, 0x95, 0x3d, 0x9f, 0xd2, 0x69, 0x72, 0x55, 0x68, 0x34, 0x87, 0x08, 0x2e, 0x44,
+	0x84, 0x97, 0x99, 0x30, 0x0b, 0xd8, 0xf6, 0x88, 0xa6, 0xff, 0xab, 0x5b, 0x1b, 0xb7, 0x2c, 0x45,
+	0xb6, 0xe1, 0x75, 0xb9, 0x2e, 0x1b, 0xd8, 0x49, 0xa6, 0x7d, 0x3f, 0x99, 0x41, 0xc9, 0x50, 0x28,
+	0xfb, 0x16, 0x88, 0x5b, 0x24, 0x02, 0x61, 0x60, 0x7c, 0xf5, 0x68, 0xb0, 0xd1, 0x12, 0x63, 0x57,
+	0x22, 0x2e, 0xbe, 0x74, 0x75, 0x2f, 0xdc, 0xfc, 0xc3, 0x6d, 0xe0, 0x44, 0x47, 0x6e, 0x5b, 0xbe,
+	0x7a, 0x08, 0x6c, 0x5e, 0x10, 0xc1, 0x7c, 0x60, 0xca, 0xcf, 0xb5, 0x34, 0x13, 0xb1, 0x8a, 0xf0,
+	0x2d, 0x43, 0x9e, 0xf6, 0x75, 0xd9, 0xac, 0x41, 0xbd, 0xe3, 0x69, 0x8f, 0x28, 0x83, 0xa9, 0x40,
+	0x19, 0x74, 0x55, 0x40, 0xe7, 0x6b, 0x3a, 0x7c, 0xd0, 0xd7, 0xb4, 0x94, 0xdd, 0x0b, 0x3f, 0x62,
+	0x5d, 0x7d, 0x5f, 0xf8, 0x8d, 0x01, 0x0b, 0xa1, 0xfd, 0xd1, 0xa6, 0xfe, 0xea, 0xfb, 0xbc, 0x0a,
+	0x26, 0x6a, 0x24, 0x16, 0x54, 0xaa, 0x8e, 0xcc, 0xf1, 0xce, 0x8a, 0x0f, 0xb5, 0xf4, 0x9b, 0xbe,
+	0x06, 0x2a, 0x27, 0x9c, 0x03, 0x7b, 0xf8, 0x2c, 0xc3, 0x48, 0x29, 0xdf, 0xd5, 0x31, 0xb2, 0xa7,
+	0xc0, 0x14, 0x0d, 0xb5, 0x49, 0xee, 0x15, 0x69, 0x74, 0x23, 0xd2, 0xa4, 0x3f, 0xfd, 0x01, 0x99,
+	0x8d, 0x20, 0x7e, 0x6d, 0x45, 0xf8, 0x2a, 0x06, 0x32, 0xf4, 0xb5, 0xaa, 0x34, 0x74, 0x5b, 0xb3,
+	0x74, 0xb8, 0xbe, 0x89, 0xb4, 0x1a, 0xa4, 0xc7, 0x10, 0xa5, 0x97, 0x98, 0xff, 0x83, 0x5e, 0x1a,
+	0x3e, 0x94, 0x5e, 0x8a, 0x05, 0xf4, 0xd2, 0xac, 0xaf, 0x97, 0xdc, 0xee, 0xe6, 0x0e, 0x58, 0xae,
+	0x2d, 0x8d, 0x88, 0x8e, 0x6a, 0xab, 0xa0, 0xcb, 0x20, 0xe5, 0x74, 0xbc, 0xaa, 0xdf, 0x52, 0xe3,
+	0xe4, 0xe8, 0x84, 0x1e, 0x7a, 0xa6, 0xdd, 0x52, 0xb1, 0x34, 0xde, 0x6c, 0x0f, 0x4a, 0x4b, 0x7b,
+	0xad, 0xfc, 0xb8, 0x2b, 0x3d, 0x88, 0xf2, 0xe8, 0x2e, 0xb8, 0x4f, 0xc0, 0xa9, 0x01, 0xc7, 0x72,
+	0x60, 0x49, 0x51, 0x9a, 0x0a, 0xac, 0x24, 0xfc, 0xc2, 0x80, 0x39, 0x7a, 0x3d, 0x2b, 0xae, 0x2c,
+	0xfe, 0x97, 0x5d, 0x67, 0x1e, 0x8c, 0x19, 0x58, 0xad, 0x36, 0xea, 0xba, 0xa7, 0x25, 0xe2, 0x06,
+	0x56, 0x6f, 0xd5, 0x75, 0xf6, 0x6d, 0xda, 0x8e, 0x62, 0x59, 0x26, 0xe2, 0xe9, 0xf1, 0x96, 0x2f,
+	0xcb, 0x18, 0x2a, 0x5e, 0xa7, 0xf0, 0xfb, 0xd1, 0xb1, 0x08, 0x86, 0xda, 0x4b, 0x0a, 0x45, 0x90,
+	0x8e, 0x4e, 0x82, 0xb6, 0x9a, 0x50, 0xe2, 0x3f, 0x32, 0x60, 0x9c, 0xd0, 0xaa, 0x20, 0x47, 0xa3,
+	0x1c, 0x3a, 0xdb, 0x75, 0x10, 0x33, 0xb0, 0xca, 0x0d, 0xf7, 0xd1, 0x61, 0x8b, 0xbb, 0xad, 0xfc,
+	0x7c, 0xd4, 0xed, 0xa8, 0x60, 0x55, 0x72, 0xbc, 0x07, 0xa5, 0x77, 0x11, 0xcc, 0x74, 0x6c, 0x95,
+	0x9e, 0xf6, 0x1c, 0x88, 0xd7, 0x21, 0x6e, 0xe8, 0xae, 0x0a, 0x4c, 0x49, 0xde, 0x28, 0x9c, 0xeb,
+	0xf7, 0x0c, 0x09, 0x70, 0x45, 0x97, 0x55, 0xbf, 0x64, 0x6e, 0x58, 0xb2, 0x71, 0x88, 0x66, 0xf5,
+	0x2e, 0x48, 0x36, 0x65, 0x5d, 0x53, 0xe4, 0xf6, 0xb3, 0x7a, 0x7c, 0xaf, 0x95, 0xf7, 0x5e, 0x7b,
+	0xf1, 0xb6, 0x6f, 0x0b, 0xd0, 0x45, 0x7d, 0xa2, 0x33, 0xa5, 0x66, 0xe1, 0x0a, 0x58, 0x8c, 0xd8,
+	0x68, 0x67, 0xc6, 0x35, 0x1d, 0x61, 0xe8, 0xee, 0x35, 0x21, 0x79, 0xa3, 0x50, 0xc6, 0xab, 0x2f,
+	0x13, 0x20, 0x56, 0xc1, 0x2a, 0x7b, 0x17, 0x4c, 0x06, 0xfe, 0x46, 0xcc, 0x06, 0x8b, 0x2e, 0xa8,
+	0xcf, 0xf9, 0xdc, 0x20, 0x04, 0xdd, 0x0e, 0x04, 0xd3, 0x61, 0x71, 0x7e, 0x22, 0xec, 0x1e, 0x02,
+	0xf1, 0xa7, 0x0f, 0x00, 0xa2, 0xcb, 0x5c, 0x04, 0x23, 0x44, 0x25, 0xcf, 0x85, 0x9d, 0x9c, 0x79,
+	0x3e, 0x1d, 0x3d, 0x4f, 0xfd, 0x6f, 0x83, 0x54, 0x97, 0xd4, 0xec, 0x81, 0xf7, 0xed, 0xfc, 0xc9,
+	0xfe, 0x76, 0x1a, 0xf7, 0x7d, 0x30, 0xe6, 0xb7, 0xe2, 0x85, 0xb0, 0x8b, 0x67, 0xe2, 0x8f, 0xf7,
+	0x34, 0xd1, 0x40, 0xf7, 0x41, 0xaa, 0x4b, 0xef, 0x44, 0x6c, 0xb0, 0xd3, 0xce, 0x9f, 0xec, 0x6f,
+	0xa7, 0x5a, 0x6a, 0x66, 0x37, 0xac, 0x2f, 0xd8, 0x4f, 0xc1, 0x64, 0x40, 0x5b, 0x44, 0x94, 0x44,
+	0x37, 0x82, 0xcf, 0x0d, 0x42, 0xf4, 0x59, 0x72, 0x6d, 0x85, 0xfd, 0x9a, 0x01, 0x4b, 0x7d, 0x9f,
+	0x55, 0xb1, 0x57, 0xc9, 0x45, 0xe3, 0xf9, 0x73, 0xaf, 0x86, 0xa7, 0xbb, 0x3b, 0xb2, 0xdb, 0xca,
+	0xa7, 0xb2, 0x1d, 0x17, 0x85, 0xfd, 0x1c, 0xcc, 0x44, 0xf5, 0xfe, 0xd7, 0x7b, 0x31, 0xdc, 0x05,
+	0xe3, 0xf3, 0x07, 0x82, 0xf5, 0x59, 0xfe, 0x2e, 0x48, 0xd0, 0x0e, 0xcc, 0x47, 0x25, 0xe5, 0xda,
+	0x78, 0xa1, 0xb7, 0xad, 0x4f, 0x74, 0x0c, 0x8e, 0x84, 0x7a, 0x5e, 0x44, 0xa4, 0x20, 0x86, 0x5f,
+	0x1e, 0x8c, 0xa1, 0xab, 0x4e, 0xed, 0x76, 0xb7, 0x1e, 0x7e, 0xf4, 0x0b, 0x47, 0xac, 0x94, 0xd7,
+	0x1e, 0x3d, 0x4f, 0x33, 0x8f, 0x9f, 0xa7, 0x99, 0x3f, 0x9e, 0xa7, 0x99, 0x87, 0x2f, 0xd2, 0x43,
+	0x8f, 0x5f, 0xa4, 0x87, 0x7e, 0x7f, 0x91, 0x1e, 0xfa, 0x78, 0xd1, 0x0d, 0x8e, 0x95, 0xfb, 0xa2,
+	0x86, 0x3c, 0xdd, 0x4c, 0xc4, 0x8f, 0xf3, 0xaf, 0xb9, 0x38, 0x79, 0x39, 0xce, 0xfc, 0x33, 0x00,
+	0xb7, 0x9e, 0x9c, 0xa4, 0xda, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1457,11 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// FlagProposalSpam defines a method for a bonded validator to flag a proposal
+	// in deposit or voting period as spam. Once the validators flagging the
+	// proposal hold the spam flag threshold of the bonded tokens, the proposal is
+	// closed and its deposits are burnt.
+	FlagProposalSpam(ctx context.Context, in *MsgFlagProposalSpam, opts ...grpc.CallOption) (*MsgFlagProposalSpamResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1562,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) FlagProposalSpam(ctx context.Context, in *MsgFlagProposalSpam, opts ...grpc.CallOption) (*MsgFlagProposalSpamResponse, error) {
+	out := new(MsgFlagProposalSpamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/FlagProposalSpam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1596,11 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// FlagProposalSpam defines a method for a bonded validator to flag a proposal
+	// in deposit or voting period as spam. Once the validators flagging the
+	// proposal hold the spam flag threshold of the bonded tokens, the proposal is
+	// closed and its deposits are burnt.
+	FlagProposalSpam(context.Context, *MsgFlagProposalSpam) (*MsgFlagProposalSpamResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1637,9 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) FlagProposalSpam(ctx context.Context, req *MsgFlagProposalSpam) (*MsgFlagProposalSpamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagProposalSpam not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1825,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FlagProposalSpam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlagProposalSpam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlagProposalSpam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/FlagProposalSpam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlagProposalSpam(ctx, req.(*MsgFlagProposalSpam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1716,6 +1887,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "FlagProposalSpam",
+			Handler:    _Msg_FlagProposalSpam_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1741,6 +1916,42 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConflictsWith) > 0 {
+		dAtA2 := make([]byte, len(m.ConflictsWith)*10)
+		var j1 int
+		for _, num := range m.ConflictsWith {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DependsOn) > 0 {
+		dAtA4 := make([]byte, len(m.DependsOn)*10)
+		var j3 int
+		for _, num := range m.DependsOn {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -2252,12 +2463,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -2517,6 +2728,74 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFlagProposalSpam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlagProposalSpam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlagProposalSpam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlagProposalSpamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlagProposalSpamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlagProposalSpamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2568,6 +2847,20 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 1 + sovTx(uint64(m.ProposalType))
 	}
+	if len(m.DependsOn) > 0 {
+		l = 0
+		for _, e := range m.DependsOn {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ConflictsWith) > 0 {
+		l = 0
+		for _, e := range m.ConflictsWith {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2871,6 +3164,34 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFlagProposalSpam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFlagProposalSpamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Closed {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3141,6 +3462,158 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DependsOn = append(m.DependsOn, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DependsOn) == 0 {
+					m.DependsOn = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DependsOn = append(m.DependsOn, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConflictsWith = append(m.ConflictsWith, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConflictsWith) == 0 {
+					m.ConflictsWith = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConflictsWith = append(m.ConflictsWith, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictsWith", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5109,6 +5582,177 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFlagProposalSpam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlagProposalSpam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlagProposalSpam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlagProposalSpamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlagProposalSpamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlagProposalSpamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
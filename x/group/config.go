@@ -1,6 +1,10 @@
 package group
 
-import "time"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Config used to initialize x/group module avoiding using global variable.
 type Config struct {
@@ -20,6 +24,18 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// ExecutionIncentiveGracePeriod defines the duration after a proposal's
+	// voting period ends after which any account executing the proposal
+	// receives the ExecutionIncentiveFee from the group policy account, so
+	// that passed proposals do not get stuck when their proposers disappear.
+	// It must be lower than MaxExecutionPeriod for the fee to be ever paid.
+	// Defaults to one day if not explicitly set.
+	ExecutionIncentiveGracePeriod time.Duration
+
+	// ExecutionIncentiveFee defines the fee paid to the executor of a proposal
+	// after the ExecutionIncentiveGracePeriod. No fee is paid if empty.
+	ExecutionIncentiveFee sdk.Coins
 }
 
 // DefaultConfig returns the default config for group.
@@ -29,5 +45,7 @@ func DefaultConfig() Config {
 		MaxMetadataLen:        255,
 		MaxProposalTitleLen:   255,
 		MaxProposalSummaryLen: 10200,
+
+		ExecutionIncentiveGracePeriod: time.Hour * 24, // One day.
 	}
 }
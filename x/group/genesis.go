@@ -55,6 +55,26 @@ func (s GenesisState) Validate() error {
 		groupMembers[g.GroupId] = *g
 	}
 
+	for _, h := range s.GroupMemberHistory {
+		if _, exists := groups[h.GroupId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group member history with GroupId %d doesn't exist", h.GroupId))
+		}
+
+		if err := h.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "GroupMemberHistory validation failed")
+		}
+	}
+
+	for _, h := range s.GroupWeightHistory {
+		if _, exists := groups[h.GroupId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group weight history with GroupId %d doesn't exist", h.GroupId))
+		}
+
+		if err := h.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "GroupWeightHistory validation failed")
+		}
+	}
+
 	for _, p := range s.Proposals {
 
 		// check that group policy with proposal address exists
@@ -43,6 +43,10 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// group_member_history is the history of the weights of the groups members.
+	GroupMemberHistory []*GroupMemberHistory `protobuf:"bytes,9,rep,name=group_member_history,json=groupMemberHistory,proto3" json:"group_member_history,omitempty"`
+	// group_weight_history is the history of the groups total weights.
+	GroupWeightHistory []*GroupWeightHistory `protobuf:"bytes,10,rep,name=group_weight_history,json=groupWeightHistory,proto3" json:"group_weight_history,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +138,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetGroupMemberHistory() []*GroupMemberHistory {
+	if m != nil {
+		return m.GroupMemberHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupWeightHistory() []*GroupWeightHistory {
+	if m != nil {
+		return m.GroupWeightHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,28 +159,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x7a, 0x41,
-	0x14, 0xc7, 0xbd, 0x3f, 0xff, 0xfc, 0x74, 0xfc, 0x53, 0x0c, 0x05, 0x37, 0xad, 0xc1, 0x5a, 0x09,
-	0xc1, 0x5c, 0xb4, 0x45, 0xeb, 0xda, 0x48, 0x8b, 0x40, 0xae, 0xd0, 0xa2, 0x4d, 0xa8, 0x9d, 0xe4,
-	0x92, 0x7a, 0xc6, 0x7b, 0x26, 0xc9, 0xb7, 0xe8, 0x09, 0x7a, 0x9e, 0x96, 0x2e, 0x5b, 0x86, 0xbe,
-	0x48, 0x78, 0x46, 0x31, 0xd4, 0xdd, 0xcc, 0x99, 0xcf, 0xf7, 0x7c, 0xbe, 0x30, 0xe2, 0xac, 0x87,
-	0x34, 0x44, 0x0a, 0xfa, 0x31, 0xbe, 0x99, 0x60, 0x52, 0x0f, 0xfa, 0x30, 0x02, 0x8a, 0x48, 0x9b,
-	0x18, 0x2d, 0xca, 0x03, 0xf7, 0xac, 0xf9, 0x59, 0x4f, 0xea, 0xe5, 0xca, 0x36, 0x6f, 0xa7, 0x06,
-	0x56, 0xf4, 0xc5, 0x67, 0x52, 0x14, 0x9a, 0x2e, 0xdf, 0xb6, 0x1d, 0x0b, 0xb2, 0x22, 0x72, 0x0c,
-	0x3e, 0x11, 0x8c, 0x7d, 0xaf, 0xea, 0xd5, 0x52, 0x61, 0x96, 0x07, 0x6d, 0x18, 0xcb, 0x86, 0xc8,
-	0xf0, 0x99, 0xfc, 0x7f, 0xd5, 0x64, 0x2d, 0xdf, 0x28, 0xeb, 0x2d, 0x99, 0x6e, 0x2e, 0x0f, 0x77,
-	0xa3, 0x17, 0x0c, 0x57, 0xa4, 0xbc, 0x11, 0x45, 0xb7, 0x70, 0x08, 0xc3, 0x2e, 0xc4, 0xe4, 0x27,
-	0x39, 0x7a, 0xba, 0x3f, 0x7a, 0xcf, 0x50, 0x58, 0xe8, 0x6f, 0x2e, 0x24, 0x6b, 0xe2, 0xd0, 0xad,
-	0x30, 0x38, 0x88, 0x7a, 0x53, 0xae, 0x96, 0xe2, 0x6a, 0x25, 0x9e, 0xb7, 0x78, 0xbc, 0x2c, 0xd8,
-	0x14, 0xa5, 0x3f, 0x64, 0x04, 0xe4, 0xa7, 0xd9, 0x56, 0xdd, 0x6f, 0x73, 0x41, 0xae, 0x5b, 0xdc,
-	0x6c, 0x8a, 0x80, 0xe4, 0xb9, 0x28, 0x98, 0x18, 0x0d, 0x52, 0x67, 0xc0, 0xba, 0x0c, 0xeb, 0xf2,
-	0xeb, 0xd9, 0xd2, 0x75, 0x2d, 0x72, 0xeb, 0x2b, 0xf9, 0xff, 0x59, 0x73, 0xb2, 0xa3, 0x69, 0xad,
-	0x88, 0x70, 0xc3, 0xca, 0x4b, 0x91, 0x9e, 0xa0, 0x05, 0xf2, 0xb3, 0x1c, 0x3a, 0xde, 0x09, 0x3d,
-	0xa0, 0x85, 0xd0, 0x31, 0xb7, 0xfa, 0x6b, 0xae, 0xbc, 0xd9, 0x5c, 0x79, 0x3f, 0x73, 0xe5, 0x7d,
-	0x2c, 0x54, 0x62, 0xb6, 0x50, 0x89, 0xef, 0x85, 0x4a, 0x3c, 0x1e, 0xb9, 0x18, 0x3d, 0xbf, 0xea,
-	0x08, 0x83, 0x77, 0xf7, 0xbf, 0xdd, 0x0c, 0xff, 0xeb, 0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0xb4, 0xee, 0x7e, 0x26, 0x02, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0x02, 0x31,
+	0x14, 0x85, 0x19, 0xf9, 0x11, 0xca, 0x8f, 0xa6, 0xc1, 0x64, 0x04, 0x9d, 0xa0, 0x6e, 0x48, 0x4c,
+	0x3a, 0x01, 0x17, 0xae, 0x75, 0x83, 0x2e, 0x4c, 0xc8, 0x10, 0x35, 0x71, 0x43, 0x00, 0xeb, 0xd0,
+	0x08, 0x74, 0x98, 0x5b, 0x51, 0xde, 0xc2, 0xc7, 0x72, 0xe1, 0x82, 0xa5, 0x4b, 0x03, 0x2f, 0x62,
+	0xb8, 0x05, 0x87, 0xbf, 0xb0, 0x6b, 0x4f, 0xbf, 0x73, 0xce, 0x4d, 0x73, 0xc9, 0x71, 0x4b, 0x42,
+	0x57, 0x82, 0xed, 0xfa, 0xf2, 0xcd, 0xb3, 0x07, 0x25, 0xdb, 0xe5, 0x3d, 0x0e, 0x02, 0x98, 0xe7,
+	0x4b, 0x25, 0xe9, 0x9e, 0x7e, 0x66, 0xf8, 0xcc, 0x06, 0xa5, 0x5c, 0x7e, 0x95, 0x57, 0x43, 0x8f,
+	0xcf, 0xe8, 0xd3, 0xef, 0x08, 0x49, 0x55, 0xb4, 0xbf, 0xa6, 0x1a, 0x8a, 0xd3, 0x3c, 0x49, 0x20,
+	0x58, 0x07, 0xde, 0x37, 0x8d, 0x82, 0x51, 0x8c, 0x38, 0x71, 0x14, 0x6a, 0xbc, 0x4f, 0xcb, 0x24,
+	0x86, 0x67, 0x30, 0x77, 0x0a, 0xe1, 0x62, 0xb2, 0x9c, 0x63, 0x2b, 0x65, 0xac, 0x32, 0x3d, 0xdc,
+	0xf6, 0x5e, 0xa4, 0x33, 0x23, 0xe9, 0x15, 0x49, 0xeb, 0xc0, 0x2e, 0xef, 0x36, 0xb9, 0x0f, 0x66,
+	0x18, 0xad, 0x47, 0x9b, 0xad, 0x77, 0x08, 0x39, 0x29, 0x37, 0xb8, 0x00, 0x2d, 0x92, 0x7d, 0x1d,
+	0xe1, 0xc9, 0x8e, 0x68, 0x0d, 0x71, 0xb4, 0x08, 0x8e, 0x96, 0x41, 0xbd, 0x8a, 0xf2, 0x74, 0xc0,
+	0x0a, 0xc9, 0x2c, 0x90, 0x82, 0x83, 0x19, 0xc5, 0xb6, 0xc2, 0xe6, 0x36, 0x6d, 0xc4, 0x71, 0xd3,
+	0x41, 0x92, 0xe0, 0x40, 0x4f, 0x48, 0xca, 0xf3, 0xa5, 0x27, 0xa1, 0xd1, 0xc1, 0xba, 0x18, 0xd6,
+	0x25, 0xe7, 0xda, 0xb4, 0xeb, 0x92, 0x24, 0xe6, 0x57, 0x30, 0x77, 0xb1, 0xe6, 0x70, 0xad, 0xa6,
+	0x3a, 0x23, 0x9c, 0x80, 0xa5, 0xe7, 0x24, 0x3a, 0x90, 0x8a, 0x83, 0x19, 0x47, 0xd3, 0xc1, 0x9a,
+	0xe9, 0x41, 0x2a, 0xee, 0x68, 0x86, 0xde, 0x93, 0xec, 0xe2, 0xf7, 0xd5, 0xdb, 0x02, 0x94, 0xf4,
+	0x87, 0x66, 0x02, 0xbd, 0x67, 0xdb, 0x7e, 0xf1, 0x46, 0xa3, 0x0e, 0x75, 0xd7, 0xb4, 0x20, 0xf6,
+	0x9d, 0x0b, 0xb7, 0xad, 0xfe, 0x63, 0xc9, 0xb6, 0xd8, 0x47, 0x64, 0x97, 0x63, 0x97, 0xb4, 0x6b,
+	0xf6, 0x35, 0xb6, 0x8c, 0xd1, 0xd8, 0x32, 0x7e, 0xc7, 0x96, 0xf1, 0x39, 0xb1, 0x42, 0xa3, 0x89,
+	0x15, 0xfa, 0x99, 0x58, 0xa1, 0xa7, 0xac, 0x4e, 0x84, 0xe7, 0x57, 0x26, 0xa4, 0xfd, 0xa1, 0xb7,
+	0xb1, 0x19, 0xc3, 0x2d, 0xbc, 0xf8, 0x1b, 0x00, 0x56, 0x3f, 0xb7, 0xbd, 0xd4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +205,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupWeightHistory) > 0 {
+		for iNdEx := len(m.GroupWeightHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupWeightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.GroupMemberHistory) > 0 {
+		for iNdEx := len(m.GroupMemberHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupMemberHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +377,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GroupMemberHistory) > 0 {
+		for _, e := range m.GroupMemberHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupWeightHistory) > 0 {
+		for _, e := range m.GroupWeightHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -594,6 +654,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMemberHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupMemberHistory = append(m.GroupMemberHistory, &GroupMemberHistory{})
+			if err := m.GroupMemberHistory[len(m.GroupMemberHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupWeightHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupWeightHistory = append(m.GroupWeightHistory, &GroupWeightHistory{})
+			if err := m.GroupWeightHistory[len(m.GroupWeightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -142,8 +142,9 @@ func Paginate(
 	if limit == 0 {
 		limit = defaultPageLimit
 
-		// count total results when the limit is zero/not supplied
-		countTotal = true
+		// count total results when the limit is zero/not supplied, unless
+		// explicitly skipped
+		countTotal = countTotal || !pageRequest.SkipCountTotal
 	}
 
 	if it == nil {
@@ -25,6 +25,14 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		return errors.Wrap(err, "group members")
 	}
 
+	if err := k.groupMemberHistoryTable.Import(store, genesisState.GroupMemberHistory, 0); err != nil {
+		return errors.Wrap(err, "group member history")
+	}
+
+	if err := k.groupWeightHistoryTable.Import(store, genesisState.GroupWeightHistory, 0); err != nil {
+		return errors.Wrap(err, "group weight history")
+	}
+
 	if err := k.groupPolicyTable.Import(store, genesisState.GroupPolicies, 0); err != nil {
 		return errors.Wrap(err, "group policies")
 	}
@@ -66,6 +74,20 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.Ge
 	}
 	genesisState.GroupMembers = groupMembers
 
+	var groupMemberHistory []*group.GroupMemberHistory
+	_, err = k.groupMemberHistoryTable.Export(store, &groupMemberHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "group member history")
+	}
+	genesisState.GroupMemberHistory = groupMemberHistory
+
+	var groupWeightHistory []*group.GroupWeightHistory
+	_, err = k.groupWeightHistoryTable.Export(store, &groupWeightHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "group weight history")
+	}
+	genesisState.GroupWeightHistory = groupWeightHistory
+
 	var groupPolicies []*group.GroupPolicyInfo
 	_, err = k.groupPolicyTable.Export(store, &groupPolicies)
 	if err != nil {
@@ -21,11 +21,17 @@ const (
 	GroupTableSeqPrefix     byte = 0x1
 	GroupByAdminIndexPrefix byte = 0x2
 
+	// Group Weight History Table
+	GroupWeightHistoryTablePrefix byte = 0x3
+
 	// Group Member Table
 	GroupMemberTablePrefix         byte = 0x10
 	GroupMemberByGroupIndexPrefix  byte = 0x11
 	GroupMemberByMemberIndexPrefix byte = 0x12
 
+	// Group Member History Table
+	GroupMemberHistoryTablePrefix byte = 0x13
+
 	// Group Policy Table
 	GroupPolicyTablePrefix        byte = 0x20
 	GroupPolicyTableSeqPrefix     byte = 0x21
@@ -52,11 +58,17 @@ type Keeper struct {
 	groupTable        orm.AutoUInt64Table
 	groupByAdminIndex orm.Index
 
+	// Group Weight History Table
+	groupWeightHistoryTable orm.PrimaryKeyTable
+
 	// Group Member Table
 	groupMemberTable         orm.PrimaryKeyTable
 	groupMemberByGroupIndex  orm.Index
 	groupMemberByMemberIndex orm.Index
 
+	// Group Member History Table
+	groupMemberHistoryTable orm.PrimaryKeyTable
+
 	// Group Policy Table
 	groupPolicySeq          orm.Sequence
 	groupPolicyTable        orm.PrimaryKeyTable
@@ -92,6 +104,8 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 		config.MaxMetadataLen = 1000 			// example metadata length in bytes
 		config.MaxProposalTitleLen = 255 		// example max title length in characters
 		config.MaxProposalSummaryLen = 10200 	// example max summary length in characters
+		config.ExecutionIncentiveGracePeriod = "86400s" 	// example execution incentive grace period in seconds
+		config.ExecutionIncentiveFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)) 	// example execution incentive fee
 	*/
 
 	defaultConfig := group.DefaultConfig()
@@ -111,6 +125,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If ExecutionIncentiveGracePeriod not set by app developer, set to default value.
+	if config.ExecutionIncentiveGracePeriod <= 0 {
+		config.ExecutionIncentiveGracePeriod = defaultConfig.ExecutionIncentiveGracePeriod
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc, k.accKeeper.AddressCodec())
@@ -154,6 +172,20 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.groupMemberTable = *groupMemberTable
 
+	// Group Member History Table
+	groupMemberHistoryTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberHistoryTablePrefix}, &group.GroupMemberHistory{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupMemberHistoryTable = *groupMemberHistoryTable
+
+	// Group Weight History Table
+	groupWeightHistoryTable, err := orm.NewPrimaryKeyTable([2]byte{GroupWeightHistoryTablePrefix}, &group.GroupWeightHistory{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupWeightHistoryTable = *groupWeightHistoryTable
+
 	// Group Policy Table
 	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
 	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc, k.accKeeper.AddressCodec())
@@ -84,6 +84,8 @@ func (s *TestSuite) SetupTest() {
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger(), runtime.EnvWithRouterService(bApp.GRPCQueryRouter(), bApp.MsgServiceRouter()))
 	config := group.DefaultConfig()
+	config.ExecutionIncentiveGracePeriod = time.Hour
+	config.ExecutionIncentiveFee = sdk.NewCoins(sdk.NewInt64Coin("test", 10))
 	s.groupKeeper = keeper.NewKeeper(env, encCfg.Codec, s.accountKeeper, config)
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.blockTime})
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
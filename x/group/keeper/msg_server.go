@@ -118,6 +118,12 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 			return errorsmod.Wrap(err, "group total weight")
 		}
 
+		// Record the weights of the current group version, so that the
+		// proposals submitted with this version can be tallied with them.
+		if err := k.recordGroupWeight(ctx, *g); err != nil {
+			return errorsmod.Wrap(err, "group weight history")
+		}
+
 		for _, member := range msg.MemberUpdates {
 			if err := k.assertMetadataLength(member.Metadata, "group member metadata"); err != nil {
 				return err
@@ -148,6 +154,14 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				return err
 			}
 
+			previousWeight := "0"
+			if found {
+				previousWeight = prevGroupMember.Member.Weight
+			}
+			if err := k.recordMemberWeight(ctx, g.Id, member.Address, g.Version+1, previousWeight); err != nil {
+				return errorsmod.Wrap(err, "group member history")
+			}
+
 			// Handle delete for members with zero weight.
 			if newMemberWeight.IsZero() {
 				// We can't delete a group member that doesn't already exist.
@@ -599,19 +613,20 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 	}
 
 	m := &group.Proposal{
-		Id:                 k.proposalTable.Sequence().PeekNextVal(kvStore),
-		GroupPolicyAddress: msg.GroupPolicyAddress,
-		Metadata:           msg.Metadata,
-		Proposers:          msg.Proposers,
-		SubmitTime:         k.HeaderService.HeaderInfo(ctx).Time,
-		GroupVersion:       groupInfo.Version,
-		GroupPolicyVersion: policyAcc.Version,
-		Status:             group.PROPOSAL_STATUS_SUBMITTED,
-		ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
-		VotingPeriodEnd:    k.HeaderService.HeaderInfo(ctx).Time.Add(policy.GetVotingPeriod()), // The voting window begins as soon as the proposal is submitted.
-		FinalTallyResult:   group.DefaultTallyResult(),
-		Title:              msg.Title,
-		Summary:            msg.Summary,
+		Id:                  k.proposalTable.Sequence().PeekNextVal(kvStore),
+		GroupPolicyAddress:  msg.GroupPolicyAddress,
+		Metadata:            msg.Metadata,
+		Proposers:           msg.Proposers,
+		SubmitTime:          k.HeaderService.HeaderInfo(ctx).Time,
+		GroupVersion:        groupInfo.Version,
+		GroupPolicyVersion:  policyAcc.Version,
+		Status:              group.PROPOSAL_STATUS_SUBMITTED,
+		ExecutorResult:      group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		VotingPeriodEnd:     k.HeaderService.HeaderInfo(ctx).Time.Add(policy.GetVotingPeriod()), // The voting window begins as soon as the proposal is submitted.
+		FinalTallyResult:    group.DefaultTallyResult(),
+		Title:               msg.Title,
+		Summary:             msg.Summary,
+		VotingPowerSnapshot: policy.GetVotingPowerSnapshot(),
 	}
 
 	if err := m.SetMsgs(msgs); err != nil {
@@ -747,9 +762,20 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	}
 
 	// Count and store votes.
-	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
-	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
-		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+	if proposal.VotingPowerSnapshot == group.VOTING_POWER_SNAPSHOT_AT_SUBMISSION {
+		// Only the members of the group at submission can vote.
+		_, isMember, err := k.votingWeightAt(ctx, groupInfo.Id, msg.Voter, proposal)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+		}
+		if !isMember {
+			return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "voter address: %s was not in group at proposal submission", msg.Voter)
+		}
+	} else {
+		voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
+		if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
+			return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+		}
 	}
 	newVote := group.Vote{
 		ProposalId: msg.ProposalId,
@@ -794,7 +820,15 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	totalWeight := groupInfo.TotalWeight
+	if p.VotingPowerSnapshot == group.VOTING_POWER_SNAPSHOT_AT_SUBMISSION {
+		totalWeight, err = k.groupWeightAt(ctx, groupInfo, p.GroupVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -870,6 +904,7 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 			k.Logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
 		} else {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+			k.payExecutionIncentive(ctx, proposal, policyInfo.Address, msg.Executor)
 		}
 	}
 
@@ -950,6 +985,14 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
+	if err := k.recordGroupWeight(ctx, groupInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "group weight history")
+	}
+
+	if err := k.recordMemberWeight(ctx, groupInfo.Id, msg.Address, groupInfo.Version+1, gm.Member.Weight); err != nil {
+		return nil, errorsmod.Wrap(err, "group member history")
+	}
+
 	// delete group member in the groupMemberTable.
 	if err := k.groupMemberTable.Delete(kvStore, gm); err != nil {
 		return nil, errorsmod.Wrap(err, "group member")
@@ -2787,6 +2787,64 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestExecProposalIncentiveFee() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	feeSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[5],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 10)},
+	}
+	proposers := []string{s.addrsStr[1]}
+
+	specs := map[string]struct {
+		srcBlockTime time.Time
+		executor     string
+		feeErr       error
+		expFee       bool
+	}{
+		"no fee before the grace period": {
+			srcBlockTime: s.blockTime.Add(time.Hour),
+			executor:     s.addrsStr[5],
+		},
+		"fee paid to the executor after the grace period": {
+			srcBlockTime: s.blockTime.Add(time.Second).Add(time.Hour), // Voting period is 1s
+			executor:     s.addrsStr[5],
+			expFee:       true,
+		},
+		"no fee paid to the group policy account": {
+			srcBlockTime: s.blockTime.Add(time.Second).Add(time.Hour),
+			executor:     s.groupPolicyStrAddr,
+		},
+		"proposal executed when the fee cannot be paid": {
+			srcBlockTime: s.blockTime.Add(time.Second).Add(time.Hour),
+			executor:     s.addrsStr[5],
+			feeErr:       fmt.Errorf("insufficient funds"),
+			expFee:       true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+			proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+
+			if spec.expFee {
+				s.bankKeeper.EXPECT().Send(gomock.Any(), feeSend).Return(nil, spec.feeErr)
+			}
+
+			sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: spec.srcBlockTime})
+			res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: spec.executor, ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+		})
+	}
+}
+
 func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	proposers := []string{s.addrsStr[1]}
 	specs := map[string]struct {
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
 
@@ -52,6 +53,33 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 	return nil
 }
 
+// payExecutionIncentive pays the execution incentive fee from the group policy
+// account to the executor of a proposal executed after the execution incentive
+// grace period. The fee is paid on a best effort basis: the execution of the
+// proposal is not reverted if the group policy account cannot pay it.
+func (k Keeper) payExecutionIncentive(ctx context.Context, proposal group.Proposal, groupPolicyAddr, executor string) {
+	fee := k.config.ExecutionIncentiveFee
+	if fee.IsZero() || executor == groupPolicyAddr {
+		return
+	}
+
+	gracePeriodEnd := proposal.VotingPeriodEnd.Add(k.config.ExecutionIncentiveGracePeriod)
+	if k.HeaderService.HeaderInfo(ctx).Time.Before(gracePeriodEnd) {
+		return
+	}
+
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		_, err := k.RouterService.MessageRouterService().InvokeUntyped(ctx, &banktypes.MsgSend{
+			FromAddress: groupPolicyAddr,
+			ToAddress:   executor,
+			Amount:      fee,
+		})
+		return err
+	}); err != nil {
+		k.Logger.Info("execution incentive fee payment failed", "cause", err, "proposalID", proposal.Id)
+	}
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them
 // are equal to the given account address of group policy.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec, addressCodec address.Codec) error {
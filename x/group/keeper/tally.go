@@ -13,6 +13,8 @@ import (
 
 // Tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
+// The votes are weighted according to the voting power snapshot of the
+// proposal.
 func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
@@ -43,23 +45,38 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 			return group.TallyResult{}, err
 		}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}, k.accKeeper.AddressCodec()), &member)
+		var weight string
+		if p.VotingPowerSnapshot == group.VOTING_POWER_SNAPSHOT_AT_SUBMISSION {
+			// The votes are weighted with the weights at submission, even if
+			// the voters left the group since then.
+			var isMember bool
+			weight, isMember, err = k.votingWeightAt(ctx, groupID, vote.Voter, p)
+			if err != nil {
+				return group.TallyResult{}, err
+			}
+			if !isMember {
+				continue
+			}
+		} else {
+			var member group.GroupMember
+			err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
+				GroupId: groupID,
+				Member:  &group.Member{Address: vote.Voter},
+			}, k.accKeeper.AddressCodec()), &member)
 
-		switch {
-		case sdkerrors.ErrNotFound.Is(err):
-			// If the member left the group after voting, then we simply skip the
-			// vote.
-			continue
-		case err != nil:
-			// For any other errors, we stop and return the error.
-			return group.TallyResult{}, err
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				// If the member left the group after voting, then we simply skip the
+				// vote.
+				continue
+			case err != nil:
+				// For any other errors, we stop and return the error.
+				return group.TallyResult{}, err
+			}
+			weight = member.Member.Weight
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/core/header"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 
@@ -84,3 +85,98 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTallyVotingPowerSnapshot() {
+	specs := map[string]struct {
+		snapshot    group.VotingPowerSnapshot
+		expNewVoter bool
+		expTally    group.TallyResult
+		expStatus   group.ProposalStatus
+	}{
+		"weights at tally": {
+			snapshot:    group.VOTING_POWER_SNAPSHOT_AT_TALLY,
+			expNewVoter: true,
+			// the member who left cannot vote, and the votes are weighted with
+			// the updated weights.
+			expTally: group.TallyResult{YesCount: "11", NoCount: "4", AbstainCount: "0", NoWithVetoCount: "0"},
+			// 11 / 15 yes.
+			expStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"weights at submission": {
+			snapshot: group.VOTING_POWER_SNAPSHOT_AT_SUBMISSION,
+			// the member who left can still vote, the new member cannot, and
+			// the votes are weighted with the weights at submission.
+			expTally: group.TallyResult{YesCount: "3", NoCount: "3", AbstainCount: "0", NoWithVetoCount: "0"},
+			// 3 / 6 yes.
+			expStatus: group.PROPOSAL_STATUS_REJECTED,
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			policy := &group.PercentageDecisionPolicy{
+				Percentage:          "0.6",
+				Windows:             &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+				VotingPowerSnapshot: spec.snapshot,
+			}
+			policyAddr, groupID := s.createGroupAndGroupPolicy(s.addrs[0], []group.MemberRequest{
+				{Address: s.addrsStr[0], Weight: "1"},
+				{Address: s.addrsStr[1], Weight: "2"},
+				{Address: s.addrsStr[2], Weight: "3"},
+			}, policy)
+
+			proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{s.addrsStr[0]},
+			})
+			s.Require().NoError(err)
+			proposalID := proposalRes.ProposalId
+
+			for _, voter := range []string{s.addrsStr[0], s.addrsStr[1]} {
+				_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter, Option: group.VOTE_OPTION_YES})
+				s.Require().NoError(err)
+			}
+
+			// the members are updated while the proposal is being voted.
+			_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+				Admin:   s.addrsStr[0],
+				GroupId: groupID,
+				MemberUpdates: []group.MemberRequest{
+					{Address: s.addrsStr[1], Weight: "10"},
+					{Address: s.addrsStr[3], Weight: "4"},
+				},
+			})
+			s.Require().NoError(err)
+			_, err = s.groupKeeper.LeaveGroup(s.ctx, &group.MsgLeaveGroup{Address: s.addrsStr[2], GroupId: groupID})
+			s.Require().NoError(err)
+
+			_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[2], Option: group.VOTE_OPTION_NO})
+			if spec.expNewVoter {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[3], Option: group.VOTE_OPTION_NO})
+			if spec.expNewVoter {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, "not in group at proposal submission")
+			}
+
+			res, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expTally, res.Tally)
+
+			ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.sdkCtx.HeaderInfo().Time.Add(time.Hour + 1)})
+			s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+			proposal, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.snapshot, proposal.Proposal.VotingPowerSnapshot)
+			s.Require().Equal(spec.expStatus, proposal.Proposal.Status)
+			s.Require().Equal(spec.expTally, proposal.Proposal.FinalTallyResult)
+		})
+	}
+}
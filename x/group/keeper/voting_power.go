@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
+	"cosmossdk.io/x/group/internal/orm"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The weights of the group members are versioned with the group: before the
+// members of a group are updated to a new version, the previous weights of the
+// updated members and the previous total weight of the group are recorded in
+// history tables, keyed by the new version. The weight at a given version is
+// the one of the first history entry recorded after this version, or the
+// current weight if there is none. This lets the proposals whose decision
+// policy uses VOTING_POWER_SNAPSHOT_AT_SUBMISSION be tallied with the weights
+// of the group version at submission.
+
+// recordMemberWeight records the weight of a group member before the update of
+// the group to the given version.
+func (k Keeper) recordMemberWeight(ctx context.Context, groupID uint64, address string, untilVersion uint64, weight string) error {
+	return k.groupMemberHistoryTable.Create(k.KVStoreService.OpenKVStore(ctx), &group.GroupMemberHistory{
+		GroupId:       groupID,
+		MemberAddress: address,
+		UntilVersion:  untilVersion,
+		Weight:        weight,
+	})
+}
+
+// recordGroupWeight records the total weight of the group before its update to
+// the next version.
+func (k Keeper) recordGroupWeight(ctx context.Context, g group.GroupInfo) error {
+	return k.groupWeightHistoryTable.Create(k.KVStoreService.OpenKVStore(ctx), &group.GroupWeightHistory{
+		GroupId:      g.Id,
+		UntilVersion: g.Version + 1,
+		TotalWeight:  g.TotalWeight,
+	})
+}
+
+// memberWeightAt returns the weight of the account in the given group version,
+// "0" if the account was not a member of the group.
+func (k Keeper) memberWeightAt(ctx context.Context, groupID uint64, address string, version uint64) (string, error) {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+
+	start := orm.PrimaryKey(&group.GroupMemberHistory{GroupId: groupID, MemberAddress: address, UntilVersion: version + 1}, k.accKeeper.AddressCodec())
+	// The history of the member spans all the keys sharing the group id and
+	// address parts, the version being the last 8 bytes of the key.
+	end := storetypes.PrefixEndBytes(start[:len(start)-8])
+
+	var h group.GroupMemberHistory
+	if found, err := k.loadFirst(k.groupMemberHistoryTable, kvStore, start, end, &h); err != nil || found {
+		return h.Weight, err
+	}
+
+	var member group.GroupMember
+	err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
+		GroupId: groupID,
+		Member:  &group.Member{Address: address},
+	}, k.accKeeper.AddressCodec()), &member)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return "0", nil
+	case err != nil:
+		return "", err
+	}
+
+	return member.Member.Weight, nil
+}
+
+// groupWeightAt returns the total weight of the group in the given version.
+func (k Keeper) groupWeightAt(ctx context.Context, g group.GroupInfo, version uint64) (string, error) {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+
+	start := orm.PrimaryKey(&group.GroupWeightHistory{GroupId: g.Id, UntilVersion: version + 1}, k.accKeeper.AddressCodec())
+	end := storetypes.PrefixEndBytes(start[:len(start)-8])
+
+	var h group.GroupWeightHistory
+	if found, err := k.loadFirst(k.groupWeightHistoryTable, kvStore, start, end, &h); err != nil || found {
+		return h.TotalWeight, err
+	}
+
+	return g.TotalWeight, nil
+}
+
+// loadFirst loads the first row of the table in the [start, end) range into
+// dest, and returns false if the range is empty.
+func (k Keeper) loadFirst(table orm.PrimaryKeyTable, kvStore store.KVStore, start, end []byte, dest orm.PrimaryKeyed) (bool, error) {
+	it, err := table.PrefixScan(kvStore, start, end)
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+
+	_, err = it.LoadNext(dest)
+	switch {
+	case errors.ErrORMIteratorDone.Is(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+// votingWeightAt returns the weight of the voter of a proposal tallied with
+// the weights at submission, and whether the voter was a member of the group
+// at submission.
+func (k Keeper) votingWeightAt(ctx context.Context, groupID uint64, voter string, p group.Proposal) (string, bool, error) {
+	weight, err := k.memberWeightAt(ctx, groupID, voter, p.GroupVersion)
+	if err != nil {
+		return "", false, err
+	}
+
+	weightDec, err := math.NewNonNegativeDecFromString(weight)
+	if err != nil {
+		return "", false, err
+	}
+
+	return weight, !weightDec.IsZero(), nil
+}
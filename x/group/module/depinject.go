@@ -1,15 +1,19 @@
 package module
 
 import (
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/group/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -43,6 +47,15 @@ type GroupOutputs struct {
 }
 
 func ProvideModule(in GroupInputs) GroupOutputs {
+	executionIncentiveFee := sdk.NewCoins()
+	for _, coin := range in.Config.ExecutionIncentiveFee {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			panic(fmt.Errorf("invalid execution incentive fee amount %s%s", coin.Amount, coin.Denom))
+		}
+		executionIncentiveFee = executionIncentiveFee.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
 	k := keeper.NewKeeper(in.Environment,
 		in.Cdc,
 		in.AccountKeeper,
@@ -51,6 +64,9 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 			MaxMetadataLen:        in.Config.MaxMetadataLen,
 			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
 			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+
+			ExecutionIncentiveGracePeriod: in.Config.ExecutionIncentiveGracePeriod.AsDuration(),
+			ExecutionIncentiveFee:         executionIncentiveFee,
 		},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
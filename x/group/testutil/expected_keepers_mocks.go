@@ -126,6 +126,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AuthorizePullPayment mocks base method.
+func (m *MockBankKeeper) AuthorizePullPayment(arg0 context.Context, arg1 *types.MsgAuthorizePullPayment) (*types.MsgAuthorizePullPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizePullPayment", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgAuthorizePullPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizePullPayment indicates an expected call of AuthorizePullPayment.
+func (mr *MockBankKeeperMockRecorder) AuthorizePullPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizePullPayment", reflect.TypeOf((*MockBankKeeper)(nil).AuthorizePullPayment), arg0, arg1)
+}
+
 // Burn mocks base method.
 func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +156,21 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// CancelScheduledSend mocks base method.
+func (m *MockBankKeeper) CancelScheduledSend(arg0 context.Context, arg1 *types.MsgCancelScheduledSend) (*types.MsgCancelScheduledSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledSend", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCancelScheduledSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledSend indicates an expected call of CancelScheduledSend.
+func (mr *MockBankKeeperMockRecorder) CancelScheduledSend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledSend", reflect.TypeOf((*MockBankKeeper)(nil).CancelScheduledSend), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -184,6 +214,51 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// PullPayment mocks base method.
+func (m *MockBankKeeper) PullPayment(arg0 context.Context, arg1 *types.MsgPullPayment) (*types.MsgPullPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullPayment", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgPullPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullPayment indicates an expected call of PullPayment.
+func (mr *MockBankKeeperMockRecorder) PullPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullPayment", reflect.TypeOf((*MockBankKeeper)(nil).PullPayment), arg0, arg1)
+}
+
+// RevokePullPayment mocks base method.
+func (m *MockBankKeeper) RevokePullPayment(arg0 context.Context, arg1 *types.MsgRevokePullPayment) (*types.MsgRevokePullPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePullPayment", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRevokePullPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePullPayment indicates an expected call of RevokePullPayment.
+func (mr *MockBankKeeperMockRecorder) RevokePullPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePullPayment", reflect.TypeOf((*MockBankKeeper)(nil).RevokePullPayment), arg0, arg1)
+}
+
+// ScheduleSend mocks base method.
+func (m *MockBankKeeper) ScheduleSend(arg0 context.Context, arg1 *types.MsgScheduleSend) (*types.MsgScheduleSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleSend", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgScheduleSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleSend indicates an expected call of ScheduleSend.
+func (mr *MockBankKeeperMockRecorder) ScheduleSend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleSend", reflect.TypeOf((*MockBankKeeper)(nil).ScheduleSend), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types.MsgSend) (*types.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
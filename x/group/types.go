@@ -43,6 +43,9 @@ type DecisionPolicy interface {
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
 	Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error)
+	// GetVotingPowerSnapshot returns whether the proposals are tallied with the
+	// weights of the group members at submission or at tally time.
+	GetVotingPowerSnapshot() VotingPowerSnapshot
 
 	ValidateBasic() error
 	Validate(g GroupInfo, config Config) error
@@ -53,7 +56,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Windows: &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -76,7 +79,7 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
-	return nil
+	return validateVotingPowerSnapshot(p.VotingPowerSnapshot)
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
@@ -158,7 +161,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{Percentage: percentage, Windows: &DecisionPolicyWindows{votingPeriod, executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -185,7 +188,7 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
-	return nil
+	return validateVotingPowerSnapshot(p.VotingPowerSnapshot)
 }
 
 // Validate validates the policy against the group.
@@ -242,6 +245,15 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// validateVotingPowerSnapshot returns an error if the voting power snapshot of
+// a decision policy is not defined.
+func validateVotingPowerSnapshot(snapshot VotingPowerSnapshot) error {
+	if _, ok := VotingPowerSnapshot_name[int32(snapshot)]; !ok {
+		return errorsmod.Wrapf(errors.ErrInvalid, "voting power snapshot %d", snapshot)
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -382,6 +394,57 @@ func (g GroupMember) ValidateBasic() error {
 	return nil
 }
 
+func (h GroupMemberHistory) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(h.MemberAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{h.GroupId, addr, h.UntilVersion}, nil
+}
+
+// ValidateBasic does basic validation on group member history.
+func (h GroupMemberHistory) ValidateBasic() error {
+	if h.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "group member history's group id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.MemberAddress); err != nil {
+		return errorsmod.Wrap(err, "group member history's address")
+	}
+
+	if h.UntilVersion == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "group member history's version")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(h.Weight); err != nil {
+		return errorsmod.Wrap(err, "weight must be non negative")
+	}
+
+	return nil
+}
+
+func (h GroupWeightHistory) PrimaryKeyFields(_ address.Codec) ([]interface{}, error) {
+	return []interface{}{h.GroupId, h.UntilVersion}, nil
+}
+
+// ValidateBasic does basic validation on group weight history.
+func (h GroupWeightHistory) ValidateBasic() error {
+	if h.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "group weight history's group id")
+	}
+
+	if h.UntilVersion == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "group weight history's version")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(h.TotalWeight); err != nil {
+		return errorsmod.Wrap(err, "total weight")
+	}
+
+	return nil
+}
+
 // MemberToMemberRequest converts a `Member` (used for storage)
 // to a `MemberRequest` (used in requests). The only difference
 // between the two is that `MemberRequest` doesn't have any `AddedAt` field
@@ -31,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VotingPowerSnapshot defines when the voting power of the group members is
+// determined for the proposals of a decision policy.
+type VotingPowerSnapshot int32
+
+const (
+	// An empty value defaults to VOTING_POWER_SNAPSHOT_AT_TALLY.
+	VOTING_POWER_SNAPSHOT_UNSPECIFIED VotingPowerSnapshot = 0
+	// The votes are weighted with the weights of the members at tally time,
+	// the votes of the members who left the group are skipped, and the decision
+	// policy is applied to the group total weight at tally time.
+	VOTING_POWER_SNAPSHOT_AT_TALLY VotingPowerSnapshot = 1
+	// The votes are weighted with the weights of the members at proposal
+	// submission, i.e. at the proposal's group_version, and the decision policy
+	// is applied to the group total weight at submission. Only the members of
+	// the group at submission can vote, even if they left the group since then.
+	VOTING_POWER_SNAPSHOT_AT_SUBMISSION VotingPowerSnapshot = 2
+)
+
+var VotingPowerSnapshot_name = map[int32]string{
+	0: "VOTING_POWER_SNAPSHOT_UNSPECIFIED",
+	1: "VOTING_POWER_SNAPSHOT_AT_TALLY",
+	2: "VOTING_POWER_SNAPSHOT_AT_SUBMISSION",
+}
+
+var VotingPowerSnapshot_value = map[string]int32{
+	"VOTING_POWER_SNAPSHOT_UNSPECIFIED":   0,
+	"VOTING_POWER_SNAPSHOT_AT_TALLY":      1,
+	"VOTING_POWER_SNAPSHOT_AT_SUBMISSION": 2,
+}
+
+func (x VotingPowerSnapshot) String() string {
+	return proto.EnumName(VotingPowerSnapshot_name, int32(x))
+}
+
+func (VotingPowerSnapshot) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given proposal.
 type VoteOption int32
 
@@ -69,7 +107,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
 }
 
 // ProposalStatus defines proposal statuses.
@@ -117,7 +155,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +191,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -308,6 +346,9 @@ type ThresholdDecisionPolicy struct {
 	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// windows defines the different windows for voting and execution.
 	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+	// voting_power_snapshot defines whether the votes and the group total weight
+	// are weighted as of the proposal submission or as of the tally.
+	VotingPowerSnapshot VotingPowerSnapshot `protobuf:"varint,3,opt,name=voting_power_snapshot,json=votingPowerSnapshot,proto3,enum=cosmos.group.v1.VotingPowerSnapshot" json:"voting_power_snapshot,omitempty"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -357,6 +398,13 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+func (m *ThresholdDecisionPolicy) GetVotingPowerSnapshot() VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshot
+	}
+	return VOTING_POWER_SNAPSHOT_UNSPECIFIED
+}
+
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
 //  1. The percentage of all `YES` voters' weights out of the total group weight
@@ -369,6 +417,9 @@ type PercentageDecisionPolicy struct {
 	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	// windows defines the different windows for voting and execution.
 	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+	// voting_power_snapshot defines whether the votes and the group total weight
+	// are weighted as of the proposal submission or as of the tally.
+	VotingPowerSnapshot VotingPowerSnapshot `protobuf:"varint,3,opt,name=voting_power_snapshot,json=votingPowerSnapshot,proto3,enum=cosmos.group.v1.VotingPowerSnapshot" json:"voting_power_snapshot,omitempty"`
 }
 
 func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
@@ -418,6 +469,13 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+func (m *PercentageDecisionPolicy) GetVotingPowerSnapshot() VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshot
+	}
+	return VOTING_POWER_SNAPSHOT_UNSPECIFIED
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -634,6 +692,150 @@ func (m *GroupMember) GetMember() *Member {
 	return nil
 }
 
+// GroupMemberHistory records the weight of a group member before an update of
+// the group members, so that the weight of the member can be looked up at any
+// group version: the weight of a member at a given version is the weight of
+// the history entry with the lowest until_version greater than the version, or
+// the current weight of the member if there is no such entry.
+type GroupMemberHistory struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// member_address is the account address of the group member.
+	MemberAddress string `protobuf:"bytes,2,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty"`
+	// until_version is the group version which updated the weight of the member.
+	UntilVersion uint64 `protobuf:"varint,3,opt,name=until_version,json=untilVersion,proto3" json:"until_version,omitempty"`
+	// weight is the weight of the member in the group versions preceding
+	// until_version, "0" if the account was not a member of the group.
+	Weight string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *GroupMemberHistory) Reset()         { *m = GroupMemberHistory{} }
+func (m *GroupMemberHistory) String() string { return proto.CompactTextString(m) }
+func (*GroupMemberHistory) ProtoMessage()    {}
+func (*GroupMemberHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+}
+func (m *GroupMemberHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMemberHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMemberHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupMemberHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMemberHistory.Merge(m, src)
+}
+func (m *GroupMemberHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMemberHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMemberHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMemberHistory proto.InternalMessageInfo
+
+func (m *GroupMemberHistory) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupMemberHistory) GetMemberAddress() string {
+	if m != nil {
+		return m.MemberAddress
+	}
+	return ""
+}
+
+func (m *GroupMemberHistory) GetUntilVersion() uint64 {
+	if m != nil {
+		return m.UntilVersion
+	}
+	return 0
+}
+
+func (m *GroupMemberHistory) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// GroupWeightHistory records the total weight of a group before an update of
+// the group members, see GroupMemberHistory.
+type GroupWeightHistory struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// until_version is the group version which updated the total weight.
+	UntilVersion uint64 `protobuf:"varint,2,opt,name=until_version,json=untilVersion,proto3" json:"until_version,omitempty"`
+	// total_weight is the sum of the group members' weights in the group
+	// versions preceding until_version.
+	TotalWeight string `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+}
+
+func (m *GroupWeightHistory) Reset()         { *m = GroupWeightHistory{} }
+func (m *GroupWeightHistory) String() string { return proto.CompactTextString(m) }
+func (*GroupWeightHistory) ProtoMessage()    {}
+func (*GroupWeightHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+}
+func (m *GroupWeightHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupWeightHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupWeightHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupWeightHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupWeightHistory.Merge(m, src)
+}
+func (m *GroupWeightHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupWeightHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupWeightHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupWeightHistory proto.InternalMessageInfo
+
+func (m *GroupWeightHistory) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupWeightHistory) GetUntilVersion() uint64 {
+	if m != nil {
+		return m.UntilVersion
+	}
+	return 0
+}
+
+func (m *GroupWeightHistory) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
 // GroupPolicyInfo represents the high-level on-chain information for a group policy.
 type GroupPolicyInfo struct {
 	// address is the account address of group policy.
@@ -659,7 +861,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,13 +936,17 @@ type Proposal struct {
 	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// voting_power_snapshot is the voting power snapshot of the decision policy
+	// at proposal submission, which determines the weights used to tally the
+	// proposal.
+	VotingPowerSnapshot VotingPowerSnapshot `protobuf:"varint,15,opt,name=voting_power_snapshot,json=votingPowerSnapshot,proto3,enum=cosmos.group.v1.VotingPowerSnapshot" json:"voting_power_snapshot,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +991,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1039,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,6 +1104,7 @@ func (m *Vote) GetSubmitTime() time.Time {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.group.v1.VotingPowerSnapshot", VotingPowerSnapshot_name, VotingPowerSnapshot_value)
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
@@ -908,6 +1115,8 @@ func init() {
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
+	proto.RegisterType((*GroupMemberHistory)(nil), "cosmos.group.v1.GroupMemberHistory")
+	proto.RegisterType((*GroupWeightHistory)(nil), "cosmos.group.v1.GroupWeightHistory")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
@@ -917,93 +1126,105 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0x25, 0x92, 0x37, 0xd2, 0x9d, 0x56, 0x92, 0x43, 0xea, 0x68,
+	0x27, 0xe7, 0x38, 0x10, 0xa9, 0xd3, 0x05, 0x39, 0xe0, 0x9a, 0x84, 0xa4, 0xf6, 0x4e, 0x14, 0x64,
+	0x92, 0xd8, 0x5d, 0x4a, 0xb1, 0x9b, 0xc5, 0x4a, 0x3b, 0xa6, 0x16, 0x26, 0x77, 0x98, 0xdd, 0x21,
+	0x65, 0xfe, 0x07, 0x46, 0x9a, 0xb8, 0x0a, 0xd2, 0x04, 0x30, 0x90, 0x26, 0x4d, 0x00, 0x23, 0x10,
+	0x5c, 0xa4, 0x4c, 0x65, 0xa4, 0x08, 0x8c, 0x54, 0x81, 0x8b, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95,
+	0x36, 0x40, 0xb0, 0x33, 0xb3, 0x12, 0xbf, 0x6d, 0x19, 0x49, 0x80, 0x34, 0x04, 0x67, 0xde, 0x6f,
+	0xde, 0xd7, 0xfc, 0xde, 0x6f, 0x16, 0x36, 0x4f, 0x89, 0xd7, 0x21, 0x5e, 0xb1, 0xe5, 0x92, 0x5e,
+	0xb7, 0xd8, 0xff, 0xbc, 0x48, 0x07, 0x5d, 0xec, 0x15, 0xba, 0x2e, 0xa1, 0x04, 0xa5, 0xb9, 0xb1,
+	0xc0, 0x8c, 0x85, 0xfe, 0xe7, 0x1b, 0xab, 0x2d, 0xd2, 0x22, 0xcc, 0x56, 0xf4, 0xff, 0x71, 0xd8,
+	0x46, 0xb6, 0x45, 0x48, 0xab, 0x8d, 0x8b, 0x6c, 0x75, 0xd2, 0x7b, 0x50, 0xb4, 0x7a, 0xae, 0x49,
+	0x6d, 0xe2, 0x08, 0x7b, 0x6e, 0xdc, 0x4e, 0xed, 0x0e, 0xf6, 0xa8, 0xd9, 0xe9, 0x0a, 0xc0, 0x3a,
+	0x8f, 0x63, 0x70, 0xcf, 0x22, 0xa8, 0x30, 0x8d, 0x9f, 0x35, 0x9d, 0x81, 0x30, 0x7d, 0x64, 0x76,
+	0x6c, 0x87, 0x14, 0xd9, 0x2f, 0xdf, 0xca, 0x3f, 0x97, 0x20, 0x7a, 0x17, 0x77, 0x4e, 0xb0, 0x8b,
+	0x76, 0x21, 0x66, 0x5a, 0x96, 0x8b, 0x3d, 0x4f, 0x96, 0xb6, 0xa4, 0xdb, 0x89, 0xb2, 0xfc, 0xa7,
+	0x8b, 0xed, 0x55, 0xe1, 0xbb, 0xc4, 0x2d, 0x1a, 0x75, 0x6d, 0xa7, 0xa5, 0x06, 0x40, 0xf4, 0x09,
+	0x44, 0xcf, 0xb1, 0xdd, 0x3a, 0xa3, 0x72, 0xc8, 0x3f, 0xa2, 0x8a, 0x15, 0xda, 0x80, 0x78, 0x07,
+	0x53, 0xd3, 0x32, 0xa9, 0x29, 0x87, 0x99, 0xe5, 0x72, 0x8d, 0xf6, 0x20, 0x6e, 0x5a, 0x16, 0xb6,
+	0x0c, 0x93, 0xca, 0x91, 0x2d, 0xe9, 0x76, 0x72, 0x77, 0xa3, 0xc0, 0x73, 0x2e, 0x04, 0x39, 0x17,
+	0xf4, 0xa0, 0xde, 0xf2, 0xf2, 0x8b, 0xbf, 0xe4, 0x16, 0x9e, 0xfc, 0x35, 0x27, 0xfd, 0xfa, 0xed,
+	0xb3, 0x3b, 0x12, 0x8b, 0x8c, 0xad, 0x12, 0xcd, 0x9f, 0xc3, 0x32, 0xcf, 0x5b, 0xc5, 0x3f, 0xe9,
+	0x61, 0x8f, 0xfe, 0xaf, 0xd2, 0xcf, 0xff, 0x36, 0x04, 0x6b, 0xfa, 0x99, 0x8b, 0xbd, 0x33, 0xd2,
+	0xb6, 0xf6, 0xf0, 0xa9, 0xed, 0xd9, 0xc4, 0x69, 0x90, 0xb6, 0x7d, 0x3a, 0x40, 0x37, 0x20, 0x41,
+	0x03, 0x13, 0xcf, 0x42, 0xbd, 0xda, 0x40, 0x3f, 0x82, 0xd8, 0xb9, 0xed, 0x58, 0xe4, 0xdc, 0x63,
+	0xe1, 0x92, 0xbb, 0xdf, 0x29, 0x8c, 0xd1, 0xa5, 0x30, 0xea, 0xef, 0x98, 0xa3, 0xd5, 0xe0, 0x18,
+	0x6a, 0xc3, 0xc7, 0x7d, 0x42, 0x6d, 0xa7, 0x65, 0x74, 0xc9, 0x39, 0x76, 0x0d, 0xcf, 0x31, 0xbb,
+	0xde, 0x19, 0xa1, 0x2c, 0xc9, 0xd4, 0xee, 0xad, 0x09, 0x7f, 0x47, 0x0c, 0xdd, 0xf0, 0xc1, 0x9a,
+	0xc0, 0x96, 0xd1, 0xab, 0x8b, 0xed, 0xd4, 0x23, 0xce, 0xdf, 0xad, 0xfe, 0x4e, 0x61, 0xb7, 0xb0,
+	0xa3, 0xae, 0xf4, 0x27, 0x81, 0x5f, 0x55, 0xff, 0x70, 0xb1, 0x9d, 0x9d, 0x9f, 0xe1, 0x4f, 0xdf,
+	0x3e, 0xbb, 0x93, 0xe7, 0x90, 0x6d, 0xcf, 0x7a, 0x58, 0x9c, 0xd1, 0x98, 0xfc, 0xf3, 0x10, 0xc8,
+	0x0d, 0xec, 0x9e, 0x62, 0x87, 0x9a, 0x2d, 0x3c, 0xd6, 0xb5, 0x2c, 0x40, 0xf7, 0xd2, 0x26, 0xda,
+	0x36, 0xb4, 0xf3, 0x7f, 0xd7, 0xb7, 0x83, 0xf7, 0xeb, 0xdb, 0xcd, 0xa1, 0xbe, 0xcd, 0xea, 0x4d,
+	0xfe, 0xf7, 0x12, 0x7c, 0x3c, 0xb5, 0x38, 0x74, 0x17, 0x96, 0x83, 0x9a, 0xb0, 0x6b, 0x13, 0xce,
+	0xb7, 0xe4, 0xee, 0xfa, 0xc4, 0x2c, 0xed, 0x09, 0x6d, 0xe1, 0xa3, 0xf4, 0x8b, 0xcb, 0x51, 0x5a,
+	0x12, 0xb9, 0xb3, 0xd3, 0xe8, 0x3e, 0xac, 0x76, 0x6c, 0xc7, 0xc0, 0x8f, 0xf0, 0x69, 0xcf, 0x47,
+	0x07, 0x5e, 0x43, 0xd7, 0xf4, 0x8a, 0x3a, 0xb6, 0xa3, 0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x3f, 0x24,
+	0x48, 0x7c, 0xe3, 0x37, 0xa2, 0xea, 0x3c, 0x20, 0x28, 0x05, 0x21, 0x9b, 0x67, 0x1b, 0x51, 0x43,
+	0xb6, 0x85, 0x0a, 0xb0, 0x68, 0x5a, 0x1d, 0xdb, 0x91, 0x43, 0xef, 0x18, 0x5b, 0x0e, 0x9b, 0xab,
+	0x2d, 0x32, 0xc4, 0xfa, 0xd8, 0xf5, 0x9b, 0xc5, 0xa4, 0x25, 0xa2, 0x06, 0x4b, 0xf4, 0x29, 0x2c,
+	0x51, 0x42, 0xcd, 0xb6, 0x21, 0x06, 0x7e, 0x91, 0x9d, 0x4c, 0xb2, 0xbd, 0x63, 0x3e, 0xf5, 0xfb,
+	0x00, 0xa7, 0x2e, 0x36, 0x29, 0x97, 0xa6, 0xe8, 0x75, 0xa5, 0x29, 0x21, 0x0e, 0x97, 0x68, 0xfe,
+	0x1e, 0x24, 0x59, 0xbd, 0x42, 0x59, 0xd7, 0x21, 0xce, 0x78, 0x60, 0x5c, 0xd6, 0x1d, 0x63, 0xeb,
+	0xaa, 0x85, 0x8a, 0x10, 0xed, 0x30, 0x90, 0x68, 0xf4, 0xda, 0x04, 0x15, 0x85, 0xca, 0x09, 0x58,
+	0xfe, 0x37, 0x12, 0xa0, 0x21, 0xdf, 0xfb, 0xb6, 0x47, 0x89, 0x3b, 0x98, 0x17, 0xe2, 0x87, 0x90,
+	0xe2, 0x67, 0x8d, 0x40, 0x1f, 0xdf, 0xd5, 0xe8, 0x65, 0x8e, 0x17, 0x9b, 0xe8, 0x26, 0x2c, 0xf7,
+	0x1c, 0x6a, 0xb7, 0x8d, 0xa0, 0xb5, 0x61, 0x16, 0x60, 0x89, 0x6d, 0x1e, 0x89, 0xfe, 0x5e, 0x49,
+	0x69, 0x64, 0x58, 0x4a, 0xf3, 0xe7, 0x22, 0x5d, 0xde, 0xe3, 0xf7, 0x48, 0x77, 0x22, 0x5a, 0x68,
+	0x4a, 0xb4, 0xf1, 0xdb, 0x0c, 0x4f, 0xdc, 0x66, 0xfe, 0x5f, 0x21, 0x48, 0xb3, 0xc8, 0x7c, 0x6c,
+	0x18, 0xf5, 0x3e, 0xe4, 0x8d, 0x18, 0x4e, 0x35, 0x34, 0x9a, 0xea, 0x25, 0x73, 0xc3, 0xd7, 0x67,
+	0x6e, 0x64, 0x36, 0x73, 0x17, 0x47, 0x99, 0x6b, 0x42, 0xda, 0x12, 0x0a, 0x60, 0x74, 0x59, 0x2d,
+	0x82, 0x9b, 0xab, 0x13, 0xdc, 0x2c, 0x39, 0x83, 0x72, 0xfe, 0xdd, 0xea, 0xa3, 0xa6, 0xac, 0x91,
+	0xf5, 0x18, 0xf3, 0x63, 0x1f, 0xce, 0xfc, 0xaf, 0xe2, 0x8f, 0x9f, 0xe6, 0x16, 0xfe, 0xfe, 0x34,
+	0x27, 0xe5, 0x7f, 0x1e, 0x83, 0x78, 0xc3, 0x25, 0x5d, 0xe2, 0x99, 0xed, 0x89, 0x99, 0x3f, 0x80,
+	0x55, 0xde, 0x54, 0x5e, 0xd0, 0x7b, 0x33, 0x13, 0xb5, 0xae, 0x6e, 0x34, 0xa0, 0xe7, 0x3c, 0x3d,
+	0xf8, 0x01, 0x24, 0xba, 0x2c, 0x07, 0xec, 0x7a, 0x72, 0x64, 0x2b, 0x3c, 0xd7, 0xf9, 0x15, 0x14,
+	0x1d, 0x40, 0xd2, 0xeb, 0x9d, 0x74, 0x6c, 0x6a, 0xf8, 0x5f, 0x5e, 0xf2, 0xe2, 0x75, 0x3b, 0x02,
+	0xfc, 0xb4, 0x6f, 0xf7, 0x09, 0xcd, 0x6b, 0x0d, 0xee, 0x37, 0xca, 0x09, 0xcd, 0x36, 0x03, 0x42,
+	0xef, 0x8c, 0x35, 0x24, 0xc0, 0xc6, 0x18, 0x76, 0xb8, 0xec, 0xe0, 0xc4, 0x97, 0x10, 0xf5, 0xa8,
+	0x49, 0x7b, 0x9e, 0x1c, 0x67, 0x8f, 0x58, 0x6e, 0x42, 0x39, 0x82, 0xee, 0x6b, 0x0c, 0xa6, 0x0a,
+	0x38, 0x6a, 0x02, 0x7a, 0x60, 0x3b, 0x66, 0xdb, 0xa0, 0x66, 0xbb, 0x3d, 0x30, 0x5c, 0xec, 0xf5,
+	0xda, 0x54, 0x4e, 0xb0, 0x12, 0x6f, 0x4c, 0x38, 0xd1, 0x7d, 0x90, 0xca, 0x30, 0xe5, 0x84, 0x5f,
+	0x24, 0x2f, 0x30, 0xc3, 0x5c, 0x0c, 0x19, 0x51, 0x13, 0x3e, 0x1a, 0x79, 0x8f, 0x0c, 0xec, 0x58,
+	0x32, 0x5c, 0xb7, 0x71, 0xe9, 0xe1, 0x47, 0x49, 0x71, 0x2c, 0xd4, 0x80, 0x34, 0x7f, 0x93, 0x88,
+	0x1b, 0xa4, 0x9a, 0x64, 0xf5, 0x7e, 0x36, 0xb3, 0x5e, 0x45, 0xe0, 0x79, 0x62, 0x6a, 0x0a, 0x8f,
+	0xac, 0xd1, 0x8e, 0xcf, 0x17, 0xcf, 0x33, 0x5b, 0xd8, 0x93, 0x97, 0xb6, 0xc2, 0xb3, 0x06, 0x49,
+	0xbd, 0x44, 0xa1, 0xef, 0xc2, 0x22, 0xb5, 0x69, 0x1b, 0xcb, 0xcb, 0x8c, 0x9e, 0x2b, 0xaf, 0x2e,
+	0xb6, 0xd3, 0x57, 0x8f, 0xf7, 0xd6, 0x4e, 0xe1, 0xfb, 0x5f, 0xaa, 0x1c, 0x81, 0xb6, 0x21, 0xe6,
+	0xf5, 0x3a, 0x1d, 0xd3, 0x1d, 0xc8, 0xa9, 0xd9, 0xe0, 0x00, 0x33, 0xfb, 0xc3, 0x24, 0xfd, 0xdf,
+	0xf8, 0x30, 0x89, 0xf8, 0xc3, 0x99, 0xff, 0xa5, 0x04, 0xc9, 0xe1, 0x8b, 0xdb, 0x84, 0xc4, 0x00,
+	0x7b, 0xc6, 0x29, 0xe9, 0x39, 0x54, 0x7c, 0x7d, 0xc5, 0x07, 0xd8, 0xab, 0xf8, 0x6b, 0x9f, 0xbc,
+	0xe6, 0x89, 0x47, 0x4d, 0xdb, 0x11, 0x00, 0xfe, 0xa1, 0xbc, 0x24, 0x36, 0x39, 0x68, 0x1d, 0xe2,
+	0x0e, 0x11, 0x76, 0x3e, 0x81, 0x31, 0x87, 0x70, 0xd3, 0xf7, 0x00, 0x39, 0xc4, 0x38, 0xb7, 0xe9,
+	0x99, 0xd1, 0xc7, 0x34, 0x00, 0x71, 0xf1, 0x4b, 0x3b, 0xe4, 0xd8, 0xa6, 0x67, 0x47, 0x98, 0x72,
+	0xb0, 0xc8, 0xef, 0x9f, 0x12, 0x44, 0x8e, 0x08, 0xc5, 0x28, 0x07, 0xc9, 0xae, 0xb8, 0xd2, 0xab,
+	0x77, 0x02, 0x82, 0x2d, 0xae, 0xbf, 0x7d, 0x42, 0xc5, 0xdb, 0x39, 0x57, 0x7f, 0x19, 0x0c, 0x7d,
+	0x01, 0x51, 0xd2, 0xa5, 0xc1, 0x0b, 0x96, 0xda, 0xdd, 0x9c, 0xd6, 0x5e, 0x5c, 0x67, 0x10, 0x55,
+	0x40, 0xe7, 0x8a, 0xf6, 0x7f, 0x50, 0x26, 0xee, 0xfc, 0x4c, 0x82, 0x95, 0x29, 0xb7, 0x8b, 0xbe,
+	0x0d, 0x9f, 0x1e, 0xd5, 0xf5, 0x6a, 0xed, 0x1b, 0xa3, 0x51, 0x3f, 0x56, 0x54, 0x43, 0xab, 0x95,
+	0x1a, 0xda, 0x7e, 0x5d, 0x37, 0x9a, 0x35, 0xad, 0xa1, 0x54, 0xaa, 0x5f, 0x57, 0x95, 0xbd, 0xcc,
+	0x02, 0xca, 0x43, 0x76, 0x3a, 0xac, 0xa4, 0x1b, 0x7a, 0xe9, 0xf0, 0xf0, 0x5e, 0x46, 0x42, 0x9f,
+	0xc1, 0xcd, 0x99, 0x18, 0xad, 0x59, 0xbe, 0x5b, 0xd5, 0xb4, 0x6a, 0xbd, 0x96, 0x09, 0x6d, 0x44,
+	0x1e, 0xff, 0x2a, 0xbb, 0xe0, 0x67, 0x04, 0x57, 0x0d, 0x41, 0x9b, 0xb0, 0x76, 0x54, 0xd7, 0x15,
+	0xa3, 0xde, 0xd0, 0xab, 0xf5, 0xda, 0x58, 0xf8, 0x15, 0x48, 0x0f, 0x1b, 0xef, 0x29, 0x5a, 0x46,
+	0x42, 0x6b, 0xb0, 0x32, 0xbc, 0x59, 0x2a, 0x6b, 0x7a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
+	0xb0, 0xa1, 0x56, 0xcf, 0x84, 0xd1, 0x0d, 0x90, 0x47, 0xf7, 0x8c, 0xe3, 0xaa, 0xbe, 0x6f, 0x1c,
+	0x29, 0x7a, 0x3d, 0x13, 0x11, 0x19, 0xfd, 0x51, 0x82, 0xd4, 0xa8, 0xaa, 0xa1, 0x1c, 0x6c, 0x36,
+	0xd4, 0x7a, 0xa3, 0xae, 0x95, 0x0e, 0x0d, 0x4d, 0x2f, 0xe9, 0x4d, 0x6d, 0x2c, 0xb3, 0x6f, 0xc1,
+	0xfa, 0x38, 0x80, 0xd5, 0xaa, 0xeb, 0xca, 0x5e, 0x46, 0xf2, 0xc3, 0x8e, 0x9b, 0x4b, 0x95, 0x8a,
+	0xd2, 0xf0, 0xad, 0xa1, 0x69, 0x56, 0x55, 0x39, 0x50, 0x2a, 0xbe, 0x35, 0xec, 0x77, 0x64, 0xe2,
+	0x6c, 0xb9, 0xae, 0xfa, 0xc6, 0xc8, 0xb4, 0xb8, 0x7e, 0x41, 0x7b, 0x6a, 0xe9, 0xb8, 0x96, 0x59,
+	0x14, 0x05, 0xfd, 0x4e, 0x82, 0x4f, 0xa6, 0xcb, 0x16, 0xba, 0x0d, 0xb7, 0x2e, 0xcf, 0x2b, 0x3f,
+	0x56, 0x2a, 0x4d, 0xbd, 0xae, 0x1a, 0xaa, 0xa2, 0x35, 0x0f, 0xc7, 0xaf, 0xfe, 0x16, 0x6c, 0xcd,
+	0x44, 0xd6, 0xea, 0xba, 0xa1, 0x36, 0x6b, 0x19, 0x69, 0x2e, 0x4a, 0x6b, 0x56, 0x2a, 0x8a, 0xa6,
+	0x65, 0x42, 0x73, 0x51, 0x5f, 0x97, 0xaa, 0x87, 0x4d, 0x55, 0xc9, 0x84, 0x79, 0xf2, 0xe5, 0xc2,
+	0x8b, 0xd7, 0x59, 0xe9, 0xe5, 0xeb, 0xac, 0xf4, 0xb7, 0xd7, 0x59, 0xe9, 0xc9, 0x9b, 0xec, 0xc2,
+	0xcb, 0x37, 0xd9, 0x85, 0x3f, 0xbf, 0xc9, 0x2e, 0xdc, 0x17, 0x53, 0xe8, 0x59, 0x0f, 0x0b, 0x36,
+	0x29, 0x0a, 0x69, 0x3a, 0x89, 0xb2, 0x81, 0xf8, 0xe2, 0xdf, 0x03, 0x00, 0x1d, 0x25, 0x10, 0x09,
+	0x42, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1164,6 +1385,11 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerSnapshot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPowerSnapshot))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Windows != nil {
 		{
 			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
@@ -1206,6 +1432,11 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerSnapshot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPowerSnapshot))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Windows != nil {
 		{
 			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
@@ -1369,6 +1600,93 @@ func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroupMemberHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMemberHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMemberHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UntilVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UntilVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupWeightHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupWeightHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupWeightHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UntilVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UntilVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupPolicyInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1463,6 +1781,11 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerSnapshot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPowerSnapshot))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1743,6 +2066,9 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 		l = m.Windows.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.VotingPowerSnapshot != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPowerSnapshot))
+	}
 	return n
 }
 
@@ -1760,6 +2086,9 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 		l = m.Windows.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.VotingPowerSnapshot != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPowerSnapshot))
+	}
 	return n
 }
 
@@ -1821,6 +2150,48 @@ func (m *GroupMember) Size() (n int) {
 	return n
 }
 
+func (m *GroupMemberHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UntilVersion != 0 {
+		n += 1 + sovTypes(uint64(m.UntilVersion))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupWeightHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.UntilVersion != 0 {
+		n += 1 + sovTypes(uint64(m.UntilVersion))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *GroupPolicyInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1909,6 +2280,9 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.VotingPowerSnapshot != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPowerSnapshot))
+	}
 	return n
 }
 
@@ -2390,6 +2764,25 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshot", wireType)
+			}
+			m.VotingPowerSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerSnapshot |= VotingPowerSnapshot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2508,9 +2901,28 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshot", wireType)
+			}
+			m.VotingPowerSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerSnapshot |= VotingPowerSnapshot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -2967,6 +3379,278 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroupMemberHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMemberHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMemberHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilVersion", wireType)
+			}
+			m.UntilVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupWeightHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupWeightHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupWeightHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilVersion", wireType)
+			}
+			m.UntilVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3637,6 +4321,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshot", wireType)
+			}
+			m.VotingPowerSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerSnapshot |= VotingPowerSnapshot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package mint
 
 import (
+	"slices"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -20,6 +24,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetMintHooks),
 	)
 }
 
@@ -76,3 +81,22 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{MintKeeper: k, Module: m}
 }
+
+// InvokeSetMintHooks sets the mint hooks provided by the other modules,
+// invoked in the alphabetical order of the module names.
+func InvokeSetMintHooks(keeper keeper.Keeper, mintHooks map[string]types.MintHooksWrapper) error {
+	if len(mintHooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(mintHooks)
+	slices.Sort(modNames)
+
+	var multiHooks types.MultiMintHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, mintHooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
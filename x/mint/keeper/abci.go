@@ -75,8 +75,14 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		}
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// fund the reserve with its fraction of the minted coins
+	reserveCoins, err := k.FundReserve(ctx, mintedCoins, params.ReserveFraction)
+	if err != nil {
+		return err
+	}
+
+	// send the rest of the minted coins to the fee collector account
+	err = k.AddCollectedFees(ctx, mintedCoins.Sub(reserveCoins...))
 	if err != nil {
 		return err
 	}
@@ -91,5 +97,6 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		event.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
 		event.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
 		event.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		event.NewAttribute(types.AttributeKeyReserveAmount, reserveCoins.AmountOf(params.MintDenom).String()),
 	)
 }
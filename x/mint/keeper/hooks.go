@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mintHooks holds the hooks of the mint keeper.
+type mintHooks struct {
+	types.MintHooks
+}
+
+// SetHooks sets the mint hooks, invoked when the reserve is funded. It panics
+// if the hooks are already set.
+func (k Keeper) SetHooks(hooks types.MintHooks) {
+	if k.hooks.MintHooks != nil {
+		panic("cannot set mint hooks twice")
+	}
+
+	k.hooks.MintHooks = hooks
+}
+
+// FundReserve sends the reserve fraction of the minted coins to the reserve
+// module account and invokes the AfterReserveFunded hooks, returning the coins
+// sent. The hooks are run in a branched context: if they fail, their state
+// changes are discarded and the coins stay in the reserve, so that a faulty
+// hook does not halt the chain.
+func (k Keeper) FundReserve(ctx context.Context, mintedCoins sdk.Coins, fraction math.LegacyDec) (sdk.Coins, error) {
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	reserveCoins := sdk.NewCoins()
+	for _, coin := range mintedCoins {
+		reserveCoins = reserveCoins.Add(sdk.NewCoin(coin.Denom, fraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	if reserveCoins.IsZero() {
+		return reserveCoins, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ReserveName, reserveCoins); err != nil {
+		return nil, err
+	}
+
+	if k.hooks.MintHooks == nil {
+		return reserveCoins, nil
+	}
+
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.hooks.AfterReserveFunded(ctx, reserveCoins)
+	}); err != nil {
+		// purposely ignoring the error here not to halt the chain if the hook fails
+		k.logger.Error("mint hooks failed after funding the reserve", "amount", reserveCoins, "err", err)
+	}
+
+	return reserveCoins, nil
+}
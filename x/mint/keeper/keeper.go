@@ -30,6 +30,10 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
+
+	// hooks are shared by the copies of the keeper, so that they can be set
+	// after the keeper has been provided to the other modules.
+	hooks *mintHooks
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -58,6 +62,7 @@ func NewKeeper(
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		hooks:            &mintHooks{},
 	}
 
 	schema, err := sb.Build()
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -95,3 +97,50 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+// reserveHooks records the reserve fundings and fails if err is set.
+type reserveHooks struct {
+	funded sdk.Coins
+	err    error
+}
+
+func (h *reserveHooks) AfterReserveFunded(_ context.Context, amount sdk.Coins) error {
+	h.funded = h.funded.Add(amount...)
+	return h.err
+}
+
+func (s *IntegrationTestSuite) TestFundReserve() {
+	minted := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1001)))
+
+	// no reserve fraction, no funding.
+	funded, err := s.mintKeeper.FundReserve(s.ctx, minted, math.LegacyZeroDec())
+	s.Require().NoError(err)
+	s.Require().True(funded.IsZero())
+	funded, err = s.mintKeeper.FundReserve(s.ctx, minted, math.LegacyDec{})
+	s.Require().NoError(err)
+	s.Require().True(funded.IsZero())
+
+	// the reserve is funded with the truncated fraction of the minted coins,
+	// even without hooks.
+	reserve := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(250)))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, types.ReserveName, reserve).Return(nil).Times(3)
+	funded, err = s.mintKeeper.FundReserve(s.ctx, minted, math.LegacyNewDecWithPrec(25, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(reserve, funded)
+
+	hooks := &reserveHooks{}
+	s.mintKeeper.SetHooks(types.NewMultiMintHooks(hooks))
+	s.Require().Panics(func() { s.mintKeeper.SetHooks(hooks) })
+
+	funded, err = s.mintKeeper.FundReserve(s.ctx, minted, math.LegacyNewDecWithPrec(25, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(reserve, funded)
+	s.Require().Equal(reserve, hooks.funded)
+
+	// a failing hook does not fail the funding.
+	hooks.err = errors.New("buyback failed")
+	funded, err = s.mintKeeper.FundReserve(s.ctx, minted, math.LegacyNewDecWithPrec(25, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(reserve, funded)
+	s.Require().Equal(reserve.Add(reserve...), hooks.funded)
+}
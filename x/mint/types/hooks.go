@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintHooks defines the hooks invoked by the mint module when it funds the
+// reserve module account with the reserve fraction of the minted tokens. They
+// let other modules spend the funds of the reserve, e.g. to buy back and burn
+// tokens or to seed liquidity pools, the funds not spent staying in the
+// reserve.
+type MintHooks interface {
+	// AfterReserveFunded is called after the given coins are sent to the
+	// ReserveName module account.
+	AfterReserveFunded(ctx context.Context, amount sdk.Coins) error
+}
+
+// MintHooksWrapper is a wrapper for modules to inject MintHooks using depinject.
+type MintHooksWrapper struct{ MintHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (MintHooksWrapper) IsOnePerModuleType() {}
+
+var _ MintHooks = MultiMintHooks{}
+
+// MultiMintHooks combines multiple mint hooks, all hook functions are run in
+// array sequence.
+type MultiMintHooks []MintHooks
+
+// NewMultiMintHooks returns a MultiMintHooks running the given hooks.
+func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
+	return hooks
+}
+
+// AfterReserveFunded implements MintHooks.
+func (h MultiMintHooks) AfterReserveFunded(ctx context.Context, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterReserveFunded(ctx, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
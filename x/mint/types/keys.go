@@ -15,6 +15,10 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// ReserveName is the name of the module account funded with the reserve
+	// fraction of the minted tokens.
+	ReserveName = "mint_reserve"
+
 	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
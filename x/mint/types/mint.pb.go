@@ -83,6 +83,9 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// fraction of the minted tokens funding the reserve module account, whose
+	// funds are spent by the mint hooks, the rest going to the fee collector.
+	ReserveFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=reserve_fraction,json=reserveFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reserve_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,36 +143,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x37, 0xba, 0xae, 0xec, 0x68, 0xd1, 0x4e, 0x2d, 0x4c, 0x2b, 0x4d, 0x4b, 0x0f, 0x52,
-	0x2a, 0xdd, 0xb0, 0x14, 0x3c, 0x78, 0x5c, 0xf7, 0x52, 0xb1, 0xb8, 0xc4, 0x83, 0xa8, 0xe0, 0xf0,
-	0x6e, 0x32, 0xa6, 0xe3, 0x66, 0x66, 0xc2, 0xcc, 0xb4, 0x64, 0xbf, 0x82, 0x27, 0x3f, 0x86, 0xc7,
-	0x1e, 0xbc, 0xf4, 0x1b, 0xf4, 0x58, 0x3c, 0x89, 0x87, 0x22, 0xbb, 0x87, 0x7e, 0x0d, 0xc9, 0x4c,
-	0x48, 0xfd, 0x73, 0xd2, 0x7a, 0x09, 0xc9, 0xf3, 0xcc, 0xfb, 0x7b, 0x1e, 0xc2, 0xbc, 0x28, 0x4c,
-	0x94, 0x11, 0xca, 0x44, 0x82, 0x4b, 0x1b, 0x1d, 0xf5, 0xc7, 0xcc, 0x42, 0xdf, 0x7d, 0xf4, 0x0a,
-	0xad, 0xac, 0xc2, 0x4b, 0xde, 0xef, 0x39, 0xa9, 0xf6, 0x57, 0xef, 0x65, 0x2a, 0x53, 0xce, 0x8f,
-	0xaa, 0x37, 0x7f, 0x74, 0x75, 0xc5, 0x1f, 0xa5, 0xde, 0xa8, 0xe7, 0xbc, 0xb5, 0x08, 0x82, 0x4b,
-	0x15, 0xb9, 0xa7, 0x97, 0x36, 0x4f, 0x02, 0xd4, 0xd9, 0xe7, 0xd2, 0x32, 0x8d, 0x9f, 0xa3, 0x2e,
-	0x97, 0xef, 0x72, 0xb0, 0x5c, 0x49, 0x12, 0x6c, 0x04, 0x5b, 0xdd, 0x41, 0xff, 0xf4, 0x7c, 0xbd,
-	0xf5, 0xed, 0x7c, 0xfd, 0xbe, 0xc7, 0x98, 0x74, 0xd2, 0xe3, 0x2a, 0x12, 0x60, 0x0f, 0x7a, 0xcf,
-	0x58, 0x06, 0xc9, 0x74, 0xc8, 0x92, 0x2f, 0x9f, 0x77, 0x50, 0x9d, 0x32, 0x64, 0x49, 0x7c, 0xc9,
-	0xc0, 0x6f, 0xd1, 0x22, 0x48, 0x79, 0x08, 0x79, 0xd5, 0xe5, 0x88, 0x1b, 0xae, 0xa4, 0x21, 0xd7,
-	0xfe, 0x15, 0x7c, 0xd7, 0xb3, 0x46, 0x0d, 0x6a, 0xf3, 0xa4, 0x8d, 0x3a, 0x23, 0xd0, 0x20, 0x0c,
-	0x5e, 0x43, 0xa8, 0xfa, 0x35, 0x34, 0x65, 0x52, 0x09, 0x5f, 0x3e, 0xee, 0x56, 0xca, 0xb0, 0x12,
-	0xf0, 0x7b, 0xb4, 0xdc, 0xd4, 0xa2, 0x1a, 0x2c, 0xa3, 0xc9, 0x01, 0xc8, 0x8c, 0xd5, 0x6d, 0x1e,
-	0xfd, 0x75, 0x9b, 0x4f, 0x17, 0xc7, 0xdb, 0x41, 0xbc, 0xd4, 0x40, 0x63, 0xb0, 0xec, 0x89, 0x43,
-	0xe2, 0x37, 0x68, 0xe1, 0x32, 0x4b, 0x40, 0x49, 0xae, 0x5f, 0x29, 0xe3, 0x76, 0x03, 0xdb, 0x87,
-	0xf2, 0x37, 0x38, 0x97, 0xa4, 0xfd, 0xbf, 0xe0, 0x5c, 0xe2, 0x97, 0xe8, 0x56, 0xa6, 0x20, 0xa7,
-	0x63, 0x25, 0x53, 0x96, 0x92, 0x1b, 0x57, 0x42, 0xa3, 0x0a, 0x35, 0x70, 0x24, 0xfc, 0x00, 0xdd,
-	0x19, 0xe7, 0x2a, 0x99, 0x18, 0x5a, 0x30, 0x4d, 0xa7, 0x0c, 0x34, 0xe9, 0x6c, 0x04, 0x5b, 0xed,
-	0x78, 0xc1, 0xcb, 0x23, 0xa6, 0x5f, 0x31, 0xd0, 0xf8, 0x29, 0x42, 0x02, 0x4a, 0x6a, 0x0e, 0x8b,
-	0x22, 0x9f, 0x92, 0x9b, 0x2e, 0xff, 0x61, 0x9d, 0xbf, 0xfc, 0x67, 0xfe, 0x9e, 0xb4, 0x3f, 0x25,
-	0xef, 0x49, 0x1b, 0x77, 0x05, 0x94, 0x2f, 0xdc, 0xf4, 0xe3, 0xb5, 0x0f, 0x17, 0xc7, 0xdb, 0xc4,
-	0x7b, 0x3b, 0x26, 0x9d, 0x44, 0xa5, 0x5f, 0x2e, 0x7f, 0x61, 0x06, 0xbb, 0xa7, 0xb3, 0x30, 0x38,
-	0x9b, 0x85, 0xc1, 0xf7, 0x59, 0x18, 0x7c, 0x9c, 0x87, 0xad, 0xb3, 0x79, 0xd8, 0xfa, 0x3a, 0x0f,
-	0x5b, 0xaf, 0x57, 0x7e, 0x09, 0xaa, 0xa7, 0xec, 0xb4, 0x60, 0x66, 0xdc, 0x71, 0x3b, 0xb3, 0xfb,
-	0x23, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xda, 0x10, 0x60, 0xae, 0x03, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xd8, 0x0a, 0x35, 0x54, 0xdb, 0x3c, 0x26, 0x65, 0x43, 0xcb, 0xaa, 0x1d, 0xd0,
+	0x34, 0xd4, 0x64, 0x65, 0x12, 0x07, 0x8e, 0xa5, 0x02, 0x0d, 0x31, 0x51, 0x85, 0x03, 0x02, 0x24,
+	0xa2, 0xb7, 0xa9, 0x97, 0x99, 0xc6, 0x76, 0x64, 0x7b, 0x55, 0xfa, 0x15, 0x38, 0xf1, 0x31, 0xb8,
+	0x20, 0xed, 0xb0, 0x0b, 0xdf, 0x60, 0xc7, 0x89, 0x13, 0xda, 0x61, 0x42, 0xed, 0x61, 0x5f, 0x03,
+	0xc5, 0x8e, 0x3a, 0xfe, 0x9c, 0xc6, 0x76, 0x89, 0x92, 0xf7, 0xf1, 0xf3, 0x7b, 0x1e, 0x47, 0x36,
+	0xf2, 0x62, 0xa1, 0x98, 0x50, 0x01, 0xa3, 0x5c, 0x07, 0xc3, 0x56, 0x8f, 0x68, 0x68, 0x99, 0x0f,
+	0x3f, 0x93, 0x42, 0x0b, 0xbc, 0x68, 0x75, 0xdf, 0x8c, 0x4a, 0x7d, 0xe5, 0x5e, 0x22, 0x12, 0x61,
+	0xf4, 0xa0, 0x78, 0xb3, 0x4b, 0x57, 0x96, 0xed, 0xd2, 0xc8, 0x0a, 0xa5, 0xcf, 0x4a, 0x0b, 0xc0,
+	0x28, 0x17, 0x81, 0x79, 0xda, 0xd1, 0xfa, 0x37, 0x07, 0x55, 0x77, 0x29, 0xd7, 0x44, 0xe2, 0x57,
+	0xa8, 0x46, 0xf9, 0x5e, 0x0a, 0x9a, 0x0a, 0xee, 0x3a, 0x0d, 0x67, 0xa3, 0xd6, 0x6e, 0x1d, 0x9f,
+	0xad, 0x55, 0x4e, 0xcf, 0xd6, 0xee, 0x5b, 0x8c, 0xea, 0x0f, 0x7c, 0x2a, 0x02, 0x06, 0x7a, 0xdf,
+	0x7f, 0x49, 0x12, 0x88, 0x47, 0x1d, 0x12, 0x7f, 0x3f, 0x6a, 0xa2, 0x32, 0xa5, 0x43, 0xe2, 0xf0,
+	0x82, 0x81, 0x3f, 0xa0, 0x05, 0xe0, 0xfc, 0x00, 0xd2, 0xa2, 0xcb, 0x90, 0x2a, 0x2a, 0xb8, 0x72,
+	0x6f, 0xfc, 0x2f, 0x78, 0xde, 0xb2, 0xba, 0x53, 0xd4, 0xfa, 0xd7, 0x59, 0x54, 0xed, 0x82, 0x04,
+	0xa6, 0xf0, 0x2a, 0x42, 0xc5, 0xaf, 0x89, 0xfa, 0x84, 0x0b, 0x66, 0xcb, 0x87, 0xb5, 0x62, 0xd2,
+	0x29, 0x06, 0xf8, 0x23, 0x5a, 0x9a, 0xd6, 0x8a, 0x24, 0x68, 0x12, 0xc5, 0xfb, 0xc0, 0x13, 0x52,
+	0xb6, 0x79, 0x7c, 0xe9, 0x36, 0x5f, 0xce, 0x0f, 0x37, 0x9d, 0x70, 0x71, 0x0a, 0x0d, 0x41, 0x93,
+	0xa7, 0x06, 0x89, 0xdf, 0xa3, 0xfa, 0x45, 0x16, 0x83, 0xdc, 0xbd, 0x79, 0xa5, 0x8c, 0xbb, 0x53,
+	0xd8, 0x2e, 0xe4, 0x7f, 0xc1, 0x29, 0x77, 0x67, 0xae, 0x0b, 0x4e, 0x39, 0x7e, 0x83, 0xee, 0x24,
+	0x02, 0xd2, 0xa8, 0x27, 0x78, 0x9f, 0xf4, 0xdd, 0xd9, 0x2b, 0xa1, 0x51, 0x81, 0x6a, 0x1b, 0x12,
+	0x7e, 0x80, 0xe6, 0x7a, 0xa9, 0x88, 0x07, 0x2a, 0xca, 0x88, 0x8c, 0x46, 0x04, 0xa4, 0x5b, 0x6d,
+	0x38, 0x1b, 0x33, 0x61, 0xdd, 0x8e, 0xbb, 0x44, 0xbe, 0x25, 0x20, 0xf1, 0x0b, 0x84, 0x18, 0xe4,
+	0x91, 0x3a, 0xc8, 0xb2, 0x74, 0xe4, 0xde, 0x32, 0xf9, 0x0f, 0xcb, 0xfc, 0xa5, 0x7f, 0xf3, 0x77,
+	0xb8, 0xfe, 0x2d, 0x79, 0x87, 0xeb, 0xb0, 0xc6, 0x20, 0x7f, 0x6d, 0xdc, 0x58, 0xa2, 0x79, 0x49,
+	0x14, 0x91, 0x43, 0x12, 0xed, 0x49, 0x88, 0xcd, 0xa1, 0xbe, 0x6d, 0x88, 0xcf, 0x2f, 0xbd, 0xa3,
+	0xd3, 0xa3, 0x66, 0x3d, 0x37, 0xd7, 0xb1, 0x31, 0xdc, 0xf2, 0x1f, 0xf9, 0x5b, 0x76, 0x8b, 0x73,
+	0x65, 0xc0, 0xb3, 0x92, 0xff, 0x64, 0xf5, 0xd3, 0xf9, 0xe1, 0xa6, 0x6b, 0x7d, 0x4d, 0xd5, 0x1f,
+	0x04, 0xd6, 0x14, 0xd8, 0x43, 0xda, 0xde, 0x3e, 0x1e, 0x7b, 0xce, 0xc9, 0xd8, 0x73, 0x7e, 0x8e,
+	0x3d, 0xe7, 0xf3, 0xc4, 0xab, 0x9c, 0x4c, 0xbc, 0xca, 0x8f, 0x89, 0x57, 0x79, 0xb7, 0xfc, 0x47,
+	0x95, 0xd2, 0xa5, 0x47, 0x19, 0x51, 0xbd, 0xaa, 0xb9, 0xa7, 0xdb, 0xbf, 0x06, 0x00, 0xfb, 0x82,
+	0xb2, 0xd1, 0x22, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -235,6 +241,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReserveFraction.Size()
+		i -= size
+		if _, err := m.ReserveFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -347,6 +363,8 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.ReserveFraction.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -724,6 +742,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
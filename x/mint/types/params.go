@@ -20,6 +20,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		ReserveFraction:     math.LegacyZeroDec(),
 	}
 }
 
@@ -33,6 +34,7 @@ func DefaultParams() Params {
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
 		MaxSupply:           math.ZeroInt(),             // assuming zero is infinite
+		ReserveFraction:     math.LegacyZeroDec(),
 	}
 }
 
@@ -59,6 +61,9 @@ func (p Params) Validate() error {
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
+	if err := validateReserveFraction(p.ReserveFraction); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -186,3 +191,24 @@ func validateMaxSupply(i interface{}) error {
 
 	return nil
 }
+
+func validateReserveFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the params stored before the introduction of the reserve have no reserve
+	// fraction, meaning that no tokens fund the reserve.
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("reserve fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reserve fraction too large: %s", v)
+	}
+
+	return nil
+}
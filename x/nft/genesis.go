@@ -21,6 +21,24 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, history := range data.OwnershipHistories {
+		if len(history.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(history.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		for _, record := range history.Records {
+			if len(record.PreviousOwner) > 0 {
+				if _, err := ac.StringToBytes(record.PreviousOwner); err != nil {
+					return err
+				}
+			}
+			if _, err := ac.StringToBytes(record.Owner); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,8 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// ownership_histories defines the ownership records of the nfts.
+	OwnershipHistories []*OwnershipHistory `protobuf:"bytes,3,rep,name=ownership_histories,json=ownershipHistories,proto3" json:"ownership_histories,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +80,13 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetOwnershipHistories() []*OwnershipHistory {
+	if m != nil {
+		return m.OwnershipHistories
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -132,30 +142,103 @@ func (m *Entry) GetNfts() []*NFT {
 	return nil
 }
 
+// OwnershipHistory defines the ownership records of a nft
+type OwnershipHistory struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// records are the ownership records of the nft, oldest first
+	Records []*OwnershipRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *OwnershipHistory) Reset()         { *m = OwnershipHistory{} }
+func (m *OwnershipHistory) String() string { return proto.CompactTextString(m) }
+func (*OwnershipHistory) ProtoMessage()    {}
+func (*OwnershipHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0095f7548e354a72, []int{2}
+}
+func (m *OwnershipHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipHistory.Merge(m, src)
+}
+func (m *OwnershipHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipHistory proto.InternalMessageInfo
+
+func (m *OwnershipHistory) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *OwnershipHistory) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OwnershipHistory) GetRecords() []*OwnershipRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.nft.v1beta1.GenesisState")
 	proto.RegisterType((*Entry)(nil), "cosmos.nft.v1beta1.Entry")
+	proto.RegisterType((*OwnershipHistory)(nil), "cosmos.nft.v1beta1.OwnershipHistory")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x44, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x40,
-	0x3e, 0x4f, 0x62, 0x03, 0x7b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8, 0x93, 0x50,
-	0x50, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcb, 0x4e, 0x02, 0x31,
+	0x14, 0x86, 0xe9, 0x20, 0xa2, 0xd5, 0x10, 0x53, 0x4d, 0x1c, 0x88, 0x99, 0x4c, 0xd0, 0x05, 0x89,
+	0xda, 0xe1, 0xb2, 0x33, 0x71, 0x83, 0xf1, 0xba, 0xd0, 0xa4, 0xba, 0x72, 0x43, 0x80, 0xe9, 0x48,
+	0xa3, 0xb6, 0x64, 0xda, 0xa0, 0xbc, 0x83, 0x0b, 0x1f, 0xc6, 0x87, 0x70, 0x49, 0x5c, 0x19, 0x37,
+	0x1a, 0x78, 0x11, 0x33, 0x67, 0x66, 0x16, 0x28, 0xba, 0x3c, 0x3d, 0xdf, 0xff, 0x9f, 0x4b, 0x0f,
+	0x76, 0xbb, 0x4a, 0xdf, 0x2b, 0xed, 0xc9, 0xc0, 0x78, 0x83, 0x5a, 0x87, 0x9b, 0x76, 0xcd, 0xbb,
+	0xe1, 0x92, 0x6b, 0xa1, 0x69, 0x3f, 0x54, 0x46, 0x11, 0x12, 0x13, 0x54, 0x06, 0x86, 0x26, 0x44,
+	0x69, 0x63, 0x86, 0x2a, 0xca, 0x83, 0xa2, 0x54, 0x8c, 0xb3, 0x2d, 0x88, 0xbc, 0x44, 0x0e, 0x41,
+	0xf9, 0x13, 0xe1, 0xe5, 0xe3, 0xd8, 0xfe, 0xd2, 0xb4, 0x0d, 0x27, 0x0d, 0x9c, 0xef, 0xde, 0xb5,
+	0xb5, 0xe6, 0xda, 0x46, 0x6e, 0xb6, 0xb2, 0x54, 0x2f, 0xd2, 0xdf, 0xf5, 0xe8, 0x41, 0x84, 0xb0,
+	0x94, 0x8c, 0x44, 0x5c, 0x9a, 0x50, 0x70, 0x6d, 0x5b, 0x7f, 0x8b, 0x0e, 0xa5, 0x09, 0x87, 0x2c,
+	0x25, 0x09, 0xc7, 0xab, 0xea, 0x41, 0xf2, 0x50, 0xf7, 0x44, 0xbf, 0xd5, 0x13, 0xda, 0x28, 0x30,
+	0xc8, 0x82, 0xc1, 0xd6, 0x2c, 0x83, 0x8b, 0x14, 0x3f, 0x01, 0x7a, 0xd8, 0x2c, 0x7c, 0xbc, 0xec,
+	0x62, 0x19, 0x18, 0x77, 0x50, 0xa5, 0x75, 0x5a, 0x65, 0x44, 0x4d, 0x13, 0x82, 0xeb, 0xf2, 0x19,
+	0xce, 0x41, 0x61, 0xb2, 0x86, 0x73, 0x90, 0xb6, 0x91, 0x8b, 0x2a, 0x8b, 0x2c, 0x0e, 0xc8, 0x36,
+	0x9e, 0x93, 0x81, 0x49, 0xfb, 0x5e, 0x9f, 0x55, 0xf6, 0xfc, 0xe8, 0x8a, 0x01, 0x54, 0x7e, 0x42,
+	0x78, 0xe5, 0x67, 0x13, 0xa4, 0x88, 0x17, 0x60, 0x0f, 0x2d, 0xe1, 0x27, 0xd6, 0xf1, 0x5e, 0x4e,
+	0x7d, 0x52, 0xc0, 0x96, 0xf0, 0x6d, 0x0b, 0x1e, 0x2d, 0xe1, 0x93, 0x7d, 0x9c, 0x0f, 0x79, 0x57,
+	0x85, 0x7e, 0x3a, 0xe6, 0xe6, 0xbf, 0x63, 0x32, 0x60, 0x59, 0xaa, 0xd9, 0x2b, 0xbc, 0x4d, 0x8d,
+	0xdb, 0xdc, 0x79, 0x1d, 0x3b, 0x68, 0x34, 0x76, 0xd0, 0xd7, 0xd8, 0x41, 0xcf, 0x13, 0x27, 0x33,
+	0x9a, 0x38, 0x99, 0xf7, 0x89, 0x93, 0xb9, 0x4e, 0x6e, 0x44, 0xfb, 0xb7, 0x54, 0x28, 0xef, 0x31,
+	0xba, 0x85, 0xce, 0x3c, 0xfc, 0x78, 0xe3, 0x7b, 0x00, 0x38, 0x18, 0x0e, 0x4d, 0x62, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +261,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnershipHistories) > 0 {
+		for iNdEx := len(m.OwnershipHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -253,6 +350,57 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OwnershipHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -282,6 +430,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OwnershipHistories) > 0 {
+		for _, e := range m.OwnershipHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -304,6 +458,29 @@ func (m *Entry) Size() (n int) {
 	return n
 }
 
+func (m *OwnershipHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -407,6 +584,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipHistories = append(m.OwnershipHistories, &OwnershipHistory{})
+			if err := m.OwnershipHistories[len(m.OwnershipHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -544,6 +755,154 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OwnershipHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &OwnershipRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
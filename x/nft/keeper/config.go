@@ -0,0 +1,15 @@
+package keeper
+
+// Config is a config struct used for initializing the nft module to avoid using globals.
+type Config struct {
+	// MaxOwnershipHistory defines the maximum number of ownership records kept per nft,
+	// the oldest records are pruned first. 0 disables the ownership history.
+	MaxOwnershipHistory uint64
+}
+
+// DefaultConfig returns the default config for nft.
+func DefaultConfig() Config {
+	return Config{
+		MaxOwnershipHistory: 100,
+	}
+}
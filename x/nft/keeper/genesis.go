@@ -27,6 +27,13 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 			}
 		}
 	}
+	// the exported history replaces the records of the mints above
+	for _, history := range data.OwnershipHistories {
+		if !k.HasNFT(ctx, history.ClassId, history.Id) {
+			return nft.ErrNFTNotExists.Wrapf("ownership history of nft: class: %s, id: %s", history.ClassId, history.Id)
+		}
+		k.setOwnershipHistory(ctx, history.ClassId, history.Id, history.Records)
+	}
 	return nil
 }
 
@@ -34,9 +41,18 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 	classes := k.GetClasses(ctx)
 	nftMap := make(map[string][]*nft.NFT)
+	var histories []*nft.OwnershipHistory
 	for _, class := range classes {
 		nfts := k.GetNFTsOfClass(ctx, class.Id)
 		for i, n := range nfts {
+			if records := k.GetOwnershipHistory(ctx, n.ClassId, n.Id); len(records) > 0 {
+				history := &nft.OwnershipHistory{ClassId: n.ClassId, Id: n.Id}
+				for j := range records {
+					history.Records = append(history.Records, &records[j])
+				}
+				histories = append(histories, history)
+			}
+
 			owner := k.GetOwner(ctx, n.ClassId, n.Id)
 			ownerStr, err := k.ac.BytesToString(owner.Bytes())
 			if err != nil {
@@ -64,7 +80,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:            classes,
+		Entries:            entries,
+		OwnershipHistories: histories,
 	}, nil
 }
@@ -259,3 +259,34 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// OwnershipHistory return the ownership records of an NFT, oldest first.
+func (k Keeper) OwnershipHistory(ctx context.Context, r *nft.QueryOwnershipHistoryRequest) (*nft.QueryOwnershipHistoryResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	var records []*nft.OwnershipRecord
+	pageRes, err := query.Paginate(k.getOwnershipHistoryStore(ctx, r.ClassId, r.Id), r.Pagination, func(_, value []byte) error {
+		var record nft.OwnershipRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryOwnershipHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
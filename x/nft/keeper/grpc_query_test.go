@@ -11,6 +11,8 @@ import (
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -985,3 +987,36 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestOwnershipHistory_Query() {
+	require := s.Require()
+	s.TestMint()
+	for _, receiver := range []sdk.AccAddress{s.addrs[1], s.addrs[2]} {
+		require.NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, receiver))
+	}
+
+	_, err := s.queryClient.OwnershipHistory(gocontext.Background(), &nft.QueryOwnershipHistoryRequest{Id: testID})
+	require.ErrorContains(err, nft.ErrEmptyClassID.Error())
+
+	res, err := s.queryClient.OwnershipHistory(gocontext.Background(), &nft.QueryOwnershipHistoryRequest{
+		ClassId:    testClassID,
+		Id:         testID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Records, 2)
+	require.EqualValues(3, res.Pagination.Total)
+	require.Empty(res.Records[0].PreviousOwner)
+	require.Equal(s.encodedAddrs[0], res.Records[0].Owner)
+	require.Equal(s.encodedAddrs[1], res.Records[1].Owner)
+
+	res, err = s.queryClient.OwnershipHistory(gocontext.Background(), &nft.QueryOwnershipHistoryRequest{
+		ClassId:    testClassID,
+		Id:         testID,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.Records, 1)
+	require.Equal(s.encodedAddrs[1], res.Records[0].PreviousOwner)
+	require.Equal(s.encodedAddrs[2], res.Records[0].Owner)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetOwnershipHistory returns the ownership records of the specified nft, oldest first
+func (k Keeper) GetOwnershipHistory(ctx context.Context, classID, nftID string) (records []nft.OwnershipRecord) {
+	historyStore := k.getOwnershipHistoryStore(ctx, classID, nftID)
+	iterator := historyStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record nft.OwnershipRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// recordOwnership appends an ownership record to the history of a nft and prunes
+// the records exceeding the configured retention.
+// previousOwner is empty when the nft is minted.
+func (k Keeper) recordOwnership(ctx context.Context, classID, nftID string, previousOwner, owner sdk.AccAddress) error {
+	if k.config.MaxOwnershipHistory == 0 {
+		return nil
+	}
+
+	record := nft.OwnershipRecord{
+		Height: k.HeaderService.HeaderInfo(ctx).Height,
+	}
+
+	var err error
+	if !previousOwner.Empty() {
+		if record.PreviousOwner, err = k.ac.BytesToString(previousOwner); err != nil {
+			return err
+		}
+	}
+	if record.Owner, err = k.ac.BytesToString(owner); err != nil {
+		return err
+	}
+
+	historyStore := k.getOwnershipHistoryStore(ctx, classID, nftID)
+
+	// records are keyed by an increasing sequence, the next one follows the latest record
+	var sequence uint64
+	iterator := historyStore.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		sequence = sdk.BigEndianToUint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	historyStore.Set(sdk.Uint64ToBigEndian(sequence), k.cdc.MustMarshal(&record))
+
+	// prune the oldest records, keeping the last MaxOwnershipHistory ones
+	if sequence+1 <= k.config.MaxOwnershipHistory {
+		return nil
+	}
+	deleteRange(historyStore, nil, sdk.Uint64ToBigEndian(sequence+1-k.config.MaxOwnershipHistory))
+	return nil
+}
+
+// setOwnershipHistory replaces the ownership records of a nft, keeping the last
+// MaxOwnershipHistory ones
+func (k Keeper) setOwnershipHistory(ctx context.Context, classID, nftID string, records []*nft.OwnershipRecord) {
+	k.deleteOwnershipHistory(ctx, classID, nftID)
+	if uint64(len(records)) > k.config.MaxOwnershipHistory {
+		records = records[uint64(len(records))-k.config.MaxOwnershipHistory:]
+	}
+
+	historyStore := k.getOwnershipHistoryStore(ctx, classID, nftID)
+	for i, record := range records {
+		historyStore.Set(sdk.Uint64ToBigEndian(uint64(i)), k.cdc.MustMarshal(record))
+	}
+}
+
+// deleteOwnershipHistory removes all the ownership records of a nft
+func (k Keeper) deleteOwnershipHistory(ctx context.Context, classID, nftID string) {
+	deleteRange(k.getOwnershipHistoryStore(ctx, classID, nftID), nil, nil)
+}
+
+func (k Keeper) getOwnershipHistoryStore(ctx context.Context, classID, nftID string) prefix.Store {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return prefix.NewStore(runtime.KVStoreAdapter(store), ownershipHistoryStoreKey(classID, nftID))
+}
+
+// deleteRange deletes the keys of the given store within the [start, end) range
+func deleteRange(store prefix.Store, start, end []byte) {
+	iterator := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
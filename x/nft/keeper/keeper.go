@@ -12,14 +12,15 @@ import (
 type Keeper struct {
 	appmodule.Environment
 
-	cdc codec.BinaryCodec
-	bk  nft.BankKeeper
-	ac  address.Codec
+	cdc    codec.BinaryCodec
+	bk     nft.BankKeeper
+	ac     address.Codec
+	config Config
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(env appmodule.Environment,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, config Config,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
@@ -31,5 +32,6 @@ func NewKeeper(env appmodule.Environment,
 		cdc:         cdc,
 		bk:          bk,
 		ac:          ak.AddressCodec(),
+		config:      config,
 	}
 }
@@ -75,7 +75,7 @@ func (s *TestSuite) SetupTest() {
 	s.accountKeeper = accountKeeper
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
-	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper, keeper.DefaultConfig())
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
@@ -334,6 +334,58 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestOwnershipHistory() {
+	class := nft.Class{
+		Id: testClassID,
+	}
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+	}
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 1})
+	err = s.nftKeeper.Mint(ctx, expNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 2})
+	err = s.nftKeeper.Transfer(ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+
+	s.Require().Equal([]nft.OwnershipRecord{
+		{Owner: s.encodedAddrs[0], Height: 1},
+		{PreviousOwner: s.encodedAddrs[0], Owner: s.encodedAddrs[1], Height: 2},
+	}, s.nftKeeper.GetOwnershipHistory(s.ctx, testClassID, testID))
+
+	// the history of a burnt nft is removed
+	err = s.nftKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().NoError(err)
+	s.Require().Empty(s.nftKeeper.GetOwnershipHistory(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestOwnershipHistoryRetention() {
+	key := storetypes.NewKVStoreKey(nft.StoreKey)
+	ctx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, s.accountKeeper, nfttestutil.NewMockBankKeeper(gomock.NewController(s.T())), keeper.Config{MaxOwnershipHistory: 2})
+
+	err := nftKeeper.SaveClass(ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+	err = nftKeeper.Mint(ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+	for _, receiver := range []sdk.AccAddress{s.addrs[1], s.addrs[2]} {
+		err = nftKeeper.Transfer(ctx, testClassID, testID, receiver)
+		s.Require().NoError(err)
+	}
+
+	// only the last two records are kept
+	s.Require().Equal([]nft.OwnershipRecord{
+		{PreviousOwner: s.encodedAddrs[0], Owner: s.encodedAddrs[1]},
+		{PreviousOwner: s.encodedAddrs[1], Owner: s.encodedAddrs[2]},
+	}, nftKeeper.GetOwnershipHistory(ctx, testClassID, testID))
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
@@ -353,13 +405,23 @@ func (s *TestSuite) TestExportGenesis() {
 	}
 	err = s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
 
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
-			Owner: s.encodedAddrs[0],
+			Owner: s.encodedAddrs[1],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		OwnershipHistories: []*nft.OwnershipHistory{{
+			ClassId: testClassID,
+			Id:      testID,
+			Records: []*nft.OwnershipRecord{
+				{Owner: s.encodedAddrs[0]},
+				{PreviousOwner: s.encodedAddrs[0], Owner: s.encodedAddrs[1]},
+			},
+		}},
 	}
 	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().NoError(err)
@@ -380,16 +442,38 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expRecords := []*nft.OwnershipRecord{
+		{Owner: s.encodedAddrs[1], Height: 1},
+		{PreviousOwner: s.encodedAddrs[1], Owner: s.encodedAddrs[0], Height: 2},
+	}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		OwnershipHistories: []*nft.OwnershipHistory{{
+			ClassId: testClassID,
+			Id:      testID,
+			Records: expRecords,
+		}},
 	}
 	err := s.nftKeeper.InitGenesis(s.ctx, expGenesis)
 	s.Require().NoError(err)
 
+	// the history of the genesis replaces the record of the mint
+	s.Require().Equal([]nft.OwnershipRecord{*expRecords[0], *expRecords[1]}, s.nftKeeper.GetOwnershipHistory(s.ctx, testClassID, testID))
+
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expGenesis, genesis)
+
+	// the history of an unknown nft is rejected
+	err = s.nftKeeper.InitGenesis(s.ctx, &nft.GenesisState{
+		OwnershipHistories: []*nft.OwnershipHistory{{ClassId: testClassID, Id: "unknown", Records: expRecords}},
+	})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
 	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
 	s.Require().True(has)
 	s.Require().EqualValues(expClass, actual)
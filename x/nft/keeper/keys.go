@@ -16,6 +16,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	OwnershipHistoryKey  = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -105,3 +106,17 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// ownershipHistoryStoreKey returns the prefix of the ownership records of a nft
+// Items are stored with the following key: values
+// 0x06<classID length(1 Byte)><classID><nftID length(1 Byte)><nftID><sequence(8 Bytes)>
+func ownershipHistoryStoreKey(classID, nftID string) []byte {
+	classIDBz := address.MustLengthPrefix(conv.UnsafeStrToBytes(classID))
+	nftIDBz := address.MustLengthPrefix(conv.UnsafeStrToBytes(nftID))
+
+	key := make([]byte, len(OwnershipHistoryKey)+len(classIDBz)+len(nftIDBz))
+	copy(key, OwnershipHistoryKey)
+	copy(key[len(OwnershipHistoryKey):], classIDBz)
+	copy(key[len(OwnershipHistoryKey)+len(classIDBz):], nftIDBz)
+	return key
+}
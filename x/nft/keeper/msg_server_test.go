@@ -40,6 +40,11 @@ func (s *TestSuite) TestSend() {
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&ExpNFT},
 		}},
+		OwnershipHistories: []*nft.OwnershipHistory{{
+			ClassId: ExpNFT.ClassId,
+			Id:      ExpNFT.Id,
+			Records: []*nft.OwnershipRecord{{Owner: s.encodedAddrs[0]}},
+		}},
 	}
 	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().NoError(err)
@@ -32,6 +32,10 @@ func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
 
+	if err := k.recordOwnership(ctx, token.ClassId, token.Id, nil, receiver); err != nil {
+		return err
+	}
+
 	recStr, err := k.ac.BytesToString(receiver.Bytes())
 	if err != nil {
 		return err
@@ -72,6 +76,7 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
+	k.deleteOwnershipHistory(ctx, classID, nftID)
 
 	ownerStr, err := k.ac.BytesToString(owner.Bytes())
 	if err != nil {
@@ -139,7 +144,7 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.recordOwnership(ctx, classID, nftID, owner, receiver)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
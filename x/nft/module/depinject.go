@@ -26,6 +26,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config      *modulev1.Module
 	Environment appmodule.Environment
 	Cdc         codec.Codec
 	Registry    cdctypes.InterfaceRegistry
@@ -42,7 +43,12 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	defaultConfig := keeper.DefaultConfig()
+	if in.Config.MaxOwnershipHistory != 0 {
+		defaultConfig.MaxOwnershipHistory = in.Config.MaxOwnershipHistory
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper, defaultConfig)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return ModuleOutputs{NFTKeeper: k, Module: m}
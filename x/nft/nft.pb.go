@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -205,35 +206,106 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// OwnershipRecord defines a change of the owner of a NFT, recorded when the NFT is minted or transferred.
+type OwnershipRecord struct {
+	// previous_owner is the owner of the NFT before the change, empty when the NFT was minted.
+	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// owner is the owner of the NFT after the change.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// height is the block height at which the ownership changed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OwnershipRecord) Reset()         { *m = OwnershipRecord{} }
+func (m *OwnershipRecord) String() string { return proto.CompactTextString(m) }
+func (*OwnershipRecord) ProtoMessage()    {}
+func (*OwnershipRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *OwnershipRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipRecord.Merge(m, src)
+}
+func (m *OwnershipRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipRecord proto.InternalMessageInfo
+
+func (m *OwnershipRecord) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *OwnershipRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnershipRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*OwnershipRecord)(nil), "cosmos.nft.v1beta1.OwnershipRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x6f, 0xd4, 0x30,
+	0x18, 0x3e, 0x27, 0xf7, 0x01, 0xae, 0x38, 0x90, 0x55, 0x21, 0x5f, 0x85, 0xa2, 0xa8, 0xd3, 0x0d,
+	0xd4, 0x69, 0xcb, 0xc6, 0x82, 0x5a, 0x24, 0x04, 0x0b, 0x48, 0x81, 0x89, 0x25, 0x72, 0x62, 0x5f,
+	0x62, 0x71, 0x67, 0x47, 0xb6, 0x73, 0x70, 0xbf, 0x80, 0x95, 0xdf, 0xc1, 0xdc, 0x91, 0x1f, 0xc0,
+	0x58, 0x75, 0x62, 0x44, 0x77, 0x7f, 0x04, 0xd9, 0x71, 0x2b, 0x86, 0x4a, 0xb7, 0xbd, 0xcf, 0xc7,
+	0x6b, 0x3d, 0x8f, 0xfc, 0xc2, 0x67, 0x95, 0x32, 0x2b, 0x65, 0x32, 0xb9, 0xb0, 0xd9, 0xfa, 0xac,
+	0xe4, 0x96, 0x9e, 0xb9, 0x99, 0xb4, 0x5a, 0x59, 0x85, 0x50, 0xaf, 0x12, 0xc7, 0x04, 0xf5, 0x68,
+	0x56, 0x2b, 0x55, 0x2f, 0x79, 0xe6, 0x1d, 0x65, 0xb7, 0xc8, 0xa8, 0xdc, 0xf4, 0xf6, 0xa3, 0x59,
+	0x6f, 0x2f, 0x3c, 0xca, 0xc2, 0xae, 0x07, 0xc7, 0xbf, 0x00, 0x1c, 0xbd, 0x5e, 0x52, 0x63, 0xd0,
+	0x14, 0x46, 0x82, 0x61, 0x90, 0x82, 0xf9, 0xc3, 0x3c, 0x12, 0x0c, 0x21, 0x38, 0x94, 0x74, 0xc5,
+	0x71, 0xe4, 0x19, 0x3f, 0xa3, 0xa7, 0x70, 0x6c, 0x36, 0xab, 0x52, 0x2d, 0x71, 0xec, 0xd9, 0x80,
+	0x50, 0x0a, 0x0f, 0x18, 0x37, 0x95, 0x16, 0xad, 0x15, 0x4a, 0xe2, 0xa1, 0x17, 0xff, 0xa7, 0xd0,
+	0x13, 0x18, 0x77, 0x5a, 0xe0, 0x91, 0x57, 0xdc, 0x88, 0x66, 0xf0, 0x41, 0xa7, 0x45, 0xd1, 0x50,
+	0xd3, 0xe0, 0xb1, 0xa7, 0x27, 0x9d, 0x16, 0x6f, 0xa9, 0x69, 0xd0, 0x1c, 0x0e, 0x19, 0xb5, 0x14,
+	0x4f, 0x52, 0x30, 0x3f, 0x38, 0x3f, 0x24, 0x7d, 0x33, 0x72, 0xdb, 0x8c, 0x5c, 0xc8, 0x4d, 0xee,
+	0x1d, 0xc7, 0xdf, 0x01, 0x8c, 0xdf, 0xbf, 0xf9, 0xe4, 0x1e, 0xab, 0x5c, 0x8b, 0xe2, 0xae, 0xc2,
+	0xc4, 0xe3, 0x77, 0x2c, 0xf4, 0x8a, 0xee, 0x7a, 0x85, 0x24, 0xf1, 0xfd, 0x49, 0x86, 0xf7, 0x27,
+	0x81, 0x7b, 0x93, 0xfc, 0x04, 0xf0, 0xf1, 0x87, 0xaf, 0x92, 0x6b, 0xd3, 0x88, 0x36, 0xe7, 0x95,
+	0xd2, 0x0c, 0xbd, 0x82, 0xd3, 0x56, 0xf3, 0xb5, 0x50, 0x9d, 0x29, 0x94, 0xd3, 0xfa, 0x6c, 0x97,
+	0xf8, 0xe6, 0xea, 0xe4, 0x30, 0x7c, 0xc3, 0x05, 0x63, 0x9a, 0x1b, 0xf3, 0xd1, 0x6a, 0x21, 0xeb,
+	0xfc, 0xd1, 0xad, 0xdf, 0x3f, 0x85, 0x08, 0x1c, 0xf5, 0x7b, 0xd1, 0x9e, 0xbd, 0xde, 0xe6, 0xfe,
+	0xa7, 0xe1, 0xa2, 0x6e, 0xac, 0xaf, 0x17, 0xe7, 0x01, 0xbd, 0x9c, 0xde, 0x5c, 0x9d, 0x40, 0xb9,
+	0xb0, 0xe9, 0xfa, 0x94, 0x9c, 0x93, 0xd3, 0xcb, 0xe7, 0xbf, 0xb7, 0x09, 0xb8, 0xde, 0x26, 0xe0,
+	0xef, 0x36, 0x01, 0x3f, 0x76, 0xc9, 0xe0, 0x7a, 0x97, 0x0c, 0xfe, 0xec, 0x92, 0xc1, 0xe7, 0x70,
+	0x59, 0x86, 0x7d, 0x21, 0x42, 0x65, 0xdf, 0xdc, 0xcd, 0x95, 0x63, 0x5f, 0xf7, 0xc5, 0xbf, 0x01,
+	0x00, 0x1f, 0x2b, 0x66, 0x61, 0x94, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +448,48 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OwnershipRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +567,26 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *OwnershipRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovNft(uint64(m.Height))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1085,139 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OwnershipRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
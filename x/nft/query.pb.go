@@ -1224,6 +1224,125 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOwnershipHistoryRequest is the request type for the Query/OwnershipHistory RPC method
+type QueryOwnershipHistoryRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnershipHistoryRequest) Reset()         { *m = QueryOwnershipHistoryRequest{} }
+func (m *QueryOwnershipHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipHistoryRequest) ProtoMessage()    {}
+func (*QueryOwnershipHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipHistoryRequest.Merge(m, src)
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipHistoryRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryOwnershipHistoryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryOwnershipHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOwnershipHistoryResponse is the response type for the Query/OwnershipHistory RPC method
+type QueryOwnershipHistoryResponse struct {
+	// records is the list of ownership records of the NFT
+	Records []*OwnershipRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnershipHistoryResponse) Reset()         { *m = QueryOwnershipHistoryResponse{} }
+func (m *QueryOwnershipHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipHistoryResponse) ProtoMessage()    {}
+func (*QueryOwnershipHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipHistoryResponse.Merge(m, src)
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnershipHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipHistoryResponse) GetRecords() []*OwnershipRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryOwnershipHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1249,74 +1368,83 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryOwnershipHistoryRequest)(nil), "cosmos.nft.v1beta1.QueryOwnershipHistoryRequest")
+	proto.RegisterType((*QueryOwnershipHistoryResponse)(nil), "cosmos.nft.v1beta1.QueryOwnershipHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdc, 0x54,
-	0x10, 0xce, 0xdb, 0x64, 0x13, 0x98, 0x4a, 0xa1, 0x4c, 0x23, 0xba, 0xeb, 0xa6, 0x66, 0x71, 0x9b,
-	0x64, 0x9b, 0x12, 0x3b, 0x9b, 0x14, 0x0e, 0x08, 0x38, 0x04, 0xb1, 0x15, 0x14, 0x05, 0xd8, 0xe6,
-	0x42, 0x11, 0xaa, 0xbc, 0x59, 0xef, 0xca, 0x22, 0xb5, 0xb7, 0xb1, 0xb7, 0x10, 0x45, 0x3d, 0xd0,
-	0x03, 0xa2, 0xe2, 0x82, 0x44, 0x7f, 0x03, 0x47, 0x0e, 0x08, 0xc1, 0x5f, 0x40, 0x9c, 0x2a, 0xb8,
-	0x70, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xbd, 0xf1, 0xc6, 0x8e, 0x9f, 0x9f, 0xeb, 0x6d, 0x8e, 0x6f,
-	0xdf, 0xcc, 0x7c, 0xdf, 0xcc, 0x9b, 0x99, 0xcf, 0x0b, 0xfa, 0xae, 0x1f, 0xdc, 0xf3, 0x03, 0xcb,
-	0xeb, 0x87, 0xd6, 0x83, 0x56, 0xd7, 0x09, 0xed, 0x96, 0x75, 0x7f, 0xe4, 0xec, 0x1f, 0x98, 0xc3,
-	0x7d, 0x3f, 0xf4, 0x11, 0xc5, 0xbd, 0xe9, 0xf5, 0x43, 0x93, 0xee, 0xb5, 0x55, 0xf2, 0xe9, 0xda,
-	0x81, 0x23, 0x8c, 0xc7, 0xae, 0x43, 0x7b, 0xe0, 0x7a, 0x76, 0xe8, 0xfa, 0x9e, 0xf0, 0xd7, 0x16,
-	0x07, 0xbe, 0x3f, 0xd8, 0x73, 0x2c, 0x7b, 0xe8, 0x5a, 0xb6, 0xe7, 0xf9, 0x21, 0xbf, 0x0c, 0xe2,
-	0x5b, 0x09, 0x7a, 0x84, 0x24, 0x6e, 0xeb, 0xe2, 0xf6, 0x2e, 0x3f, 0x59, 0x44, 0x84, 0x1f, 0x8c,
-	0x36, 0x5c, 0xf8, 0x34, 0x02, 0xde, 0xb2, 0xf7, 0x6c, 0x6f, 0xd7, 0xe9, 0x38, 0xf7, 0x47, 0x4e,
-	0x10, 0x62, 0x1d, 0x5e, 0xd8, 0xdd, 0xb3, 0x83, 0xe0, 0xae, 0xdb, 0xab, 0xb1, 0x06, 0x6b, 0xbe,
-	0xd8, 0x99, 0xe3, 0xe7, 0x0f, 0x7a, 0xb8, 0x00, 0x55, 0xff, 0x2b, 0xcf, 0xd9, 0xaf, 0x55, 0xf8,
-	0xef, 0xe2, 0x60, 0xec, 0x42, 0x23, 0x19, 0x67, 0xeb, 0x80, 0x9f, 0x6e, 0x87, 0xfb, 0xae, 0x37,
-	0x98, 0x34, 0xe8, 0x5b, 0xf3, 0x7f, 0xfd, 0xba, 0x06, 0x5e, 0x3f, 0x6c, 0x3c, 0x58, 0x37, 0x5b,
-	0x66, 0xcb, 0x30, 0x61, 0x21, 0x4d, 0x36, 0x18, 0xfa, 0x5e, 0xe0, 0xe0, 0x2b, 0x30, 0x6b, 0xdf,
-	0xf3, 0x47, 0x5e, 0xc8, 0xc3, 0xce, 0x74, 0xe8, 0x64, 0xdc, 0x82, 0xd7, 0x14, 0xa4, 0xd4, 0xce,
-	0x19, 0xf0, 0x77, 0xe1, 0x65, 0xee, 0xfe, 0x71, 0x44, 0xed, 0x19, 0x52, 0x9a, 0x87, 0x8a, 0xdb,
-	0xa3, 0x7c, 0x2a, 0x6e, 0xcf, 0xf8, 0x1c, 0xf4, 0x13, 0xff, 0xb2, 0xf5, 0x39, 0x15, 0x2c, 0x43,
-	0x6e, 0x15, 0x30, 0x49, 0x8e, 0x52, 0x1b, 0x57, 0x95, 0x25, 0x9f, 0xea, 0x26, 0xbc, 0x9a, 0x4b,
-	0x44, 0xe5, 0x98, 0x01, 0xb5, 0x08, 0xf4, 0xf6, 0x68, 0x38, 0xdc, 0x3b, 0x28, 0xce, 0xc2, 0xf8,
-	0x88, 0x90, 0x85, 0x43, 0xc9, 0x1a, 0x64, 0xe0, 0xd7, 0xa8, 0x75, 0x63, 0xf8, 0x82, 0x66, 0xf8,
-	0x90, 0x3a, 0x54, 0x0a, 0x5e, 0xb2, 0x17, 0xbe, 0x67, 0x70, 0x9e, 0xfb, 0x6f, 0xb7, 0x77, 0x82,
-	0x49, 0xdb, 0x1b, 0xdb, 0x00, 0x27, 0x63, 0x5e, 0x9b, 0x6e, 0xb0, 0xe6, 0xb9, 0x8d, 0x65, 0x93,
-	0xc6, 0x33, 0xda, 0x09, 0xa6, 0x58, 0x20, 0x34, 0xd0, 0xe6, 0x27, 0xf6, 0x20, 0x1e, 0xd0, 0x4e,
-	0xc2, 0xd3, 0x78, 0xcc, 0xa8, 0x35, 0x05, 0x1b, 0xca, 0xe5, 0x3a, 0xcc, 0x78, 0xfd, 0x30, 0xa8,
-	0xb1, 0xc6, 0x74, 0xf3, 0xdc, 0xc6, 0x45, 0x33, 0xbb, 0x7f, 0xcc, 0xed, 0xf6, 0x4e, 0x87, 0x1b,
-	0xe1, 0xcd, 0x14, 0x95, 0x0a, 0xa7, 0xb2, 0x52, 0x48, 0x45, 0x20, 0xa5, 0xb8, 0xbc, 0x0d, 0x2f,
-	0xc5, 0x54, 0x26, 0x98, 0x91, 0xcf, 0x60, 0x31, 0xf6, 0x3e, 0xeb, 0x09, 0x79, 0xe7, 0xe4, 0xc5,
-	0xc6, 0x25, 0xba, 0x06, 0xd3, 0x5e, 0x5f, 0xbc, 0xb5, 0xa2, 0x42, 0x91, 0x8d, 0x71, 0x07, 0x2e,
-	0xe7, 0x30, 0x2b, 0x1d, 0x4b, 0xb2, 0xd6, 0xc4, 0xf3, 0xbd, 0x17, 0xa5, 0xf2, 0x0c, 0x63, 0x74,
-	0x8b, 0x36, 0x09, 0xb7, 0x7f, 0xde, 0x29, 0x7a, 0x9f, 0x86, 0x98, 0xc0, 0x29, 0x1b, 0x0b, 0xaa,
-	0xdc, 0x81, 0xf2, 0xa9, 0xcb, 0xf2, 0x11, 0x1e, 0xc2, 0xce, 0xe8, 0xd2, 0x68, 0xcb, 0x38, 0x4d,
-	0x18, 0x33, 0x43, 0xf5, 0x0b, 0x1a, 0x78, 0x6e, 0xe4, 0x8c, 0x2b, 0x95, 0x1e, 0x23, 0x36, 0xf1,
-	0x18, 0x3d, 0x61, 0x24, 0x2f, 0xe3, 0xf8, 0x44, 0x7c, 0x13, 0x44, 0xf5, 0x9c, 0x78, 0x98, 0x14,
-	0xd4, 0x63, 0xcb, 0x33, 0x9b, 0xa8, 0x8d, 0xdf, 0xe7, 0xa1, 0xca, 0x69, 0xe1, 0x13, 0x06, 0x73,
-	0x24, 0x65, 0xb8, 0x22, 0xa3, 0x20, 0x51, 0x72, 0xad, 0x59, 0x6c, 0x28, 0x40, 0x8d, 0x37, 0x1f,
-	0xfd, 0xfd, 0xdf, 0x8f, 0x95, 0x75, 0x34, 0x2d, 0xc9, 0xc7, 0x44, 0x57, 0x18, 0x5b, 0x87, 0x7c,
-	0x75, 0x3d, 0xb4, 0x0e, 0xe3, 0xfe, 0x7a, 0x88, 0xbf, 0x31, 0x58, 0x90, 0x29, 0x2c, 0xde, 0x28,
-	0x82, 0x96, 0xf5, 0xae, 0xf6, 0x46, 0x49, 0x2f, 0x62, 0xdf, 0xfa, 0x33, 0xd5, 0x2c, 0x3c, 0x97,
-	0xcb, 0x78, 0x49, 0x91, 0x0b, 0x3e, 0x66, 0x50, 0xe5, 0x22, 0x88, 0x4b, 0xb9, 0x98, 0x49, 0xb5,
-	0xd7, 0x96, 0x8b, 0xcc, 0x62, 0x2e, 0x1c, 0xfd, 0x3a, 0x5e, 0x93, 0xa1, 0xf3, 0x02, 0x26, 0xea,
-	0x67, 0x1d, 0x46, 0x45, 0xfc, 0x99, 0x01, 0x66, 0x05, 0x19, 0x37, 0xd4, 0x88, 0xd2, 0x02, 0x6e,
-	0x96, 0xf2, 0x21, 0xca, 0x96, 0xa4, 0x7c, 0x97, 0xb0, 0x9e, 0x9b, 0x00, 0x7e, 0xc7, 0x60, 0x56,
-	0x48, 0x29, 0xe6, 0x97, 0x25, 0xf5, 0x65, 0xa0, 0xad, 0x14, 0xda, 0x11, 0x99, 0x35, 0x0e, 0xbf,
-	0x82, 0x4b, 0x32, 0xf8, 0x80, 0xdb, 0x26, 0x1b, 0xf0, 0x17, 0x06, 0x17, 0x24, 0xaa, 0x8e, 0x9b,
-	0x05, 0x78, 0xd2, 0xea, 0xdd, 0x28, 0xe7, 0x44, 0x8c, 0xd7, 0x25, 0xe5, 0x5b, 0x44, 0x2d, 0x9f,
-	0x3f, 0x8e, 0x60, 0x26, 0x92, 0x6b, 0xbc, 0x9a, 0x8b, 0x97, 0xf8, 0xb6, 0xd0, 0x96, 0x0a, 0xac,
-	0x88, 0x46, 0x83, 0x03, 0x6b, 0x58, 0xb3, 0xe4, 0xff, 0x07, 0x02, 0x7c, 0xc4, 0x60, 0x7a, 0xbb,
-	0xbd, 0x83, 0x57, 0x54, 0x01, 0x63, 0xd4, 0xab, 0x6a, 0xa3, 0x38, 0x77, 0x0e, 0xba, 0x8a, 0xcd,
-	0x3c, 0xd0, 0x4c, 0xb3, 0xff, 0xc4, 0xe0, 0xfc, 0x69, 0x21, 0xc5, 0x75, 0x15, 0x98, 0xf4, 0xa9,
-	0x5a, 0x25, 0x3c, 0xe2, 0xce, 0x92, 0xbc, 0x53, 0x1d, 0x2f, 0xe6, 0x30, 0xc7, 0x6f, 0x19, 0x54,
-	0xf9, 0x5e, 0x57, 0x6c, 0x88, 0xa4, 0x6a, 0x2b, 0x36, 0x44, 0x4a, 0x5f, 0x0d, 0x93, 0x23, 0x37,
-	0x71, 0x59, 0x86, 0x4c, 0x12, 0x92, 0x6c, 0xf1, 0x68, 0x3d, 0x64, 0xa5, 0x55, 0xb1, 0x1e, 0x72,
-	0xbf, 0x0d, 0x14, 0xeb, 0x21, 0x5f, 0xbb, 0xcb, 0xac, 0x07, 0x4e, 0x1a, 0xbf, 0x61, 0x30, 0x47,
-	0x3a, 0xaa, 0xd0, 0xaa, 0xb4, 0x92, 0x2b, 0xb4, 0xea, 0x94, 0x24, 0x1b, 0x57, 0x54, 0xfb, 0x9d,
-	0xea, 0xb7, 0xf5, 0xfa, 0x1f, 0x47, 0x3a, 0x7b, 0x7a, 0xa4, 0xb3, 0x7f, 0x8f, 0x74, 0xf6, 0xc3,
-	0xb1, 0x3e, 0xf5, 0xf4, 0x58, 0x9f, 0xfa, 0xe7, 0x58, 0x9f, 0xba, 0x43, 0x7f, 0xc6, 0x83, 0xde,
-	0x97, 0xa6, 0xeb, 0x5b, 0x5f, 0x47, 0xde, 0xdd, 0x59, 0xfe, 0x87, 0x78, 0xf3, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x12, 0xdc, 0xfc, 0xc9, 0x0f, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x71, 0x02, 0xaf, 0x52, 0x1b, 0x5e, 0x23, 0x6a, 0x6f, 0x53, 0x63, 0x36, 0x4d,
+	0xe2, 0x26, 0x64, 0xd7, 0x76, 0x0a, 0x07, 0x44, 0x91, 0x08, 0xc2, 0x05, 0x8a, 0x02, 0xb8, 0xb9,
+	0x50, 0x84, 0xaa, 0xb5, 0xbd, 0x76, 0x57, 0xa4, 0xbb, 0xae, 0x77, 0x5d, 0x88, 0xa2, 0x1e, 0xe8,
+	0x01, 0x51, 0x71, 0x41, 0xa2, 0x7f, 0x80, 0x0b, 0x47, 0x0e, 0x08, 0x81, 0xc4, 0x2f, 0x40, 0x3d,
+	0x55, 0x70, 0xe1, 0x88, 0x12, 0x7e, 0x02, 0x3f, 0x00, 0xed, 0xcc, 0xac, 0xb3, 0xeb, 0x9d, 0x9d,
+	0xcd, 0xba, 0x3e, 0x8e, 0xe7, 0xbd, 0xf9, 0xbe, 0xf7, 0xe6, 0xbd, 0x37, 0x9f, 0x17, 0x4a, 0x6d,
+	0xc7, 0xbd, 0xeb, 0xb8, 0xba, 0xdd, 0xf5, 0xf4, 0xfb, 0xb5, 0x96, 0xe9, 0x19, 0x35, 0xfd, 0xde,
+	0xd0, 0x1c, 0x1c, 0x68, 0xfd, 0x81, 0xe3, 0x39, 0x88, 0x6c, 0x5f, 0xb3, 0xbb, 0x9e, 0xc6, 0xf7,
+	0x95, 0x0d, 0xee, 0xd3, 0x32, 0x5c, 0x93, 0x19, 0x8f, 0x5c, 0xfb, 0x46, 0xcf, 0xb2, 0x0d, 0xcf,
+	0x72, 0x6c, 0xe6, 0xaf, 0x2c, 0xf7, 0x1c, 0xa7, 0xb7, 0x6f, 0xea, 0x46, 0xdf, 0xd2, 0x0d, 0xdb,
+	0x76, 0x3c, 0xba, 0xe9, 0x06, 0xbb, 0x02, 0x74, 0x1f, 0x89, 0xed, 0x16, 0xd9, 0xee, 0x6d, 0xba,
+	0xd2, 0x39, 0x11, 0xba, 0x50, 0x1b, 0x70, 0xfe, 0x63, 0x1f, 0x78, 0xc7, 0xd8, 0x37, 0xec, 0xb6,
+	0xd9, 0x34, 0xef, 0x0d, 0x4d, 0xd7, 0xc3, 0x22, 0x3c, 0xd7, 0xde, 0x37, 0x5c, 0xf7, 0xb6, 0xd5,
+	0x29, 0x90, 0x32, 0xa9, 0x3c, 0xdf, 0x5c, 0xa0, 0xeb, 0xf7, 0x3a, 0xb8, 0x04, 0x79, 0xe7, 0x0b,
+	0xdb, 0x1c, 0x14, 0x72, 0xf4, 0x77, 0xb6, 0x50, 0xdb, 0x50, 0x0e, 0x9f, 0xb3, 0x73, 0x40, 0x57,
+	0x37, 0xbd, 0x81, 0x65, 0xf7, 0x26, 0x3d, 0xf4, 0xf5, 0xb3, 0x7f, 0xfe, 0xb2, 0x05, 0x76, 0xd7,
+	0x2b, 0xdf, 0xaf, 0x6a, 0x35, 0xad, 0xa6, 0x6a, 0xb0, 0x14, 0x25, 0xeb, 0xf6, 0x1d, 0xdb, 0x35,
+	0xf1, 0x45, 0x98, 0x37, 0xee, 0x3a, 0x43, 0xdb, 0xa3, 0xc7, 0xce, 0x35, 0xf9, 0x4a, 0xbd, 0x01,
+	0x2f, 0x4b, 0x48, 0xc9, 0x9d, 0x63, 0xe0, 0x6f, 0xc2, 0x0b, 0xd4, 0xfd, 0x43, 0x9f, 0xda, 0x29,
+	0x42, 0x3a, 0x0b, 0x39, 0xab, 0xc3, 0xe3, 0xc9, 0x59, 0x1d, 0xf5, 0x53, 0x28, 0x9d, 0xf8, 0x67,
+	0xcd, 0xcf, 0xd8, 0x61, 0x31, 0x72, 0x1b, 0x80, 0x61, 0x72, 0x3c, 0xb4, 0x51, 0x56, 0x49, 0xf8,
+	0xaa, 0xae, 0xc3, 0x4b, 0x89, 0x44, 0x64, 0x8e, 0x31, 0x50, 0x9d, 0x83, 0xde, 0x1c, 0xf6, 0xfb,
+	0xfb, 0x07, 0xe9, 0x51, 0xa8, 0x1f, 0x70, 0x64, 0xe6, 0x90, 0x31, 0x07, 0x31, 0xf8, 0x2d, 0x5e,
+	0xba, 0x01, 0x7c, 0x4a, 0x31, 0xbc, 0xcf, 0x2b, 0x54, 0x08, 0x9e, 0xb1, 0x16, 0xbe, 0x25, 0xb0,
+	0x48, 0xfd, 0x77, 0x1b, 0x7b, 0xee, 0xa4, 0xe5, 0x8d, 0x0d, 0x80, 0x93, 0x36, 0x2f, 0xcc, 0x96,
+	0x49, 0xe5, 0x4c, 0x7d, 0x4d, 0xe3, 0xed, 0xe9, 0xcf, 0x04, 0x8d, 0x0d, 0x10, 0xde, 0xd0, 0xda,
+	0x47, 0x46, 0x2f, 0x68, 0xd0, 0x66, 0xc8, 0x53, 0x7d, 0x44, 0x78, 0x69, 0x32, 0x36, 0x3c, 0x96,
+	0x4d, 0x98, 0xb3, 0xbb, 0x9e, 0x5b, 0x20, 0xe5, 0xd9, 0xca, 0x99, 0xfa, 0x05, 0x2d, 0x3e, 0x7f,
+	0xb4, 0xdd, 0xc6, 0x5e, 0x93, 0x1a, 0xe1, 0xf5, 0x08, 0x95, 0x1c, 0xa5, 0xb2, 0x9e, 0x4a, 0x85,
+	0x21, 0x45, 0xb8, 0xbc, 0x01, 0xe7, 0x02, 0x2a, 0x13, 0xf4, 0xc8, 0x27, 0xb0, 0x1c, 0x78, 0x4f,
+	0xbb, 0x43, 0xae, 0x9d, 0xdc, 0xd8, 0x28, 0x45, 0x57, 0x60, 0xd6, 0xee, 0xb2, 0xbb, 0x96, 0x64,
+	0xc8, 0xb7, 0x51, 0x6f, 0xc1, 0xa5, 0x04, 0x66, 0x99, 0xcf, 0x12, 0x8c, 0x35, 0x76, 0x7d, 0x6f,
+	0xfb, 0xa1, 0x9c, 0xa2, 0x8d, 0x6e, 0xf0, 0x49, 0x42, 0xed, 0x9f, 0xb5, 0x8b, 0xde, 0xe1, 0x4d,
+	0xcc, 0xc1, 0x79, 0x34, 0x3a, 0xe4, 0xa9, 0x03, 0x8f, 0xa7, 0x28, 0x8a, 0x87, 0x79, 0x30, 0x3b,
+	0xb5, 0xc5, 0x5b, 0x5b, 0xc4, 0x69, 0xc2, 0x33, 0x63, 0x54, 0x3f, 0xe3, 0x0d, 0x4f, 0x8d, 0xcc,
+	0x51, 0xa6, 0xa2, 0x6d, 0x44, 0x26, 0x6e, 0xa3, 0xc7, 0x84, 0x3f, 0x2f, 0xa3, 0xf3, 0x39, 0xf1,
+	0x6d, 0x60, 0xd9, 0x33, 0x83, 0x66, 0x92, 0x50, 0x0f, 0x2c, 0xa7, 0xd7, 0x51, 0x3f, 0x10, 0xde,
+	0x14, 0x74, 0x5e, 0xbb, 0x77, 0xac, 0xfe, 0xbb, 0x96, 0xeb, 0x39, 0x83, 0x83, 0xec, 0x4d, 0x31,
+	0xad, 0x89, 0x13, 0xb9, 0x99, 0xba, 0x56, 0x55, 0x7f, 0x23, 0xbc, 0x3d, 0xe2, 0x1c, 0x79, 0x0e,
+	0xaf, 0xc1, 0xc2, 0xc0, 0x6c, 0x3b, 0x83, 0x4e, 0x90, 0xc3, 0x15, 0x51, 0x0e, 0x47, 0xee, 0x4d,
+	0x6a, 0xdb, 0x0c, 0x7c, 0xa6, 0x96, 0xcd, 0x71, 0xe6, 0xf5, 0xff, 0xce, 0x41, 0x9e, 0x32, 0xc7,
+	0xc7, 0x04, 0x16, 0xb8, 0x50, 0xc0, 0x75, 0x11, 0x39, 0x81, 0x4e, 0x52, 0x2a, 0xe9, 0x86, 0x8c,
+	0x84, 0xfa, 0xda, 0xc3, 0xbf, 0xfe, 0xfd, 0x3e, 0x57, 0x45, 0x4d, 0x17, 0x48, 0xb5, 0x16, 0x33,
+	0xd6, 0x0f, 0xe9, 0xc3, 0xf0, 0x40, 0x3f, 0x0c, 0x2e, 0xf4, 0x01, 0xfe, 0x4a, 0x60, 0x49, 0xa4,
+	0x5f, 0xf0, 0x6a, 0x1a, 0xb4, 0x68, 0x32, 0x28, 0xaf, 0x66, 0xf4, 0xe2, 0xec, 0x6b, 0x4f, 0x22,
+	0xad, 0x48, 0x63, 0xb9, 0x84, 0x17, 0x25, 0xb1, 0xe0, 0x23, 0x02, 0x79, 0x7a, 0x9f, 0xb8, 0x9a,
+	0x88, 0x19, 0xd6, 0x52, 0xca, 0x5a, 0x9a, 0x59, 0xc0, 0x85, 0xa2, 0x6f, 0xe2, 0x15, 0x11, 0x3a,
+	0x4d, 0x60, 0x28, 0x7f, 0xfa, 0xa1, 0x9f, 0xc4, 0x9f, 0x08, 0x60, 0x5c, 0xee, 0x60, 0x5d, 0x8e,
+	0x28, 0x4c, 0xe0, 0x76, 0x26, 0x1f, 0x4e, 0x59, 0x17, 0xa4, 0xef, 0x22, 0x16, 0x13, 0x03, 0xc0,
+	0x6f, 0x08, 0xcc, 0x33, 0xa1, 0x82, 0xc9, 0x69, 0x89, 0xe8, 0x2e, 0x65, 0x3d, 0xd5, 0x8e, 0x93,
+	0xd9, 0xa2, 0xf0, 0xeb, 0xb8, 0x2a, 0x82, 0x77, 0xa9, 0x6d, 0xb8, 0x00, 0x7f, 0x26, 0x70, 0x5e,
+	0xa0, 0x99, 0x70, 0x3b, 0x05, 0x4f, 0x98, 0xbd, 0xab, 0xd9, 0x9c, 0x38, 0xe3, 0xaa, 0x20, 0x7d,
+	0xcb, 0xa8, 0x24, 0xf3, 0xc7, 0x21, 0xcc, 0xf9, 0x62, 0x08, 0x2f, 0x27, 0xe2, 0x85, 0x94, 0x9b,
+	0xb2, 0x9a, 0x62, 0xc5, 0x69, 0x94, 0x29, 0xb0, 0x82, 0x05, 0x5d, 0xfc, 0x6f, 0xcb, 0xc5, 0x87,
+	0x04, 0x66, 0x77, 0x1b, 0x7b, 0xb8, 0x22, 0x3b, 0x30, 0x40, 0xbd, 0x2c, 0x37, 0x0a, 0x62, 0xa7,
+	0xa0, 0x1b, 0x58, 0x49, 0x02, 0x8d, 0x15, 0xfb, 0x8f, 0x04, 0x16, 0xc7, 0x65, 0x0a, 0x56, 0x65,
+	0x60, 0xc2, 0xab, 0xaa, 0x65, 0xf0, 0x08, 0x2a, 0x4b, 0x70, 0x4f, 0x45, 0xbc, 0x90, 0xc0, 0x1c,
+	0xbf, 0x26, 0x90, 0xa7, 0xaf, 0xa6, 0x64, 0x42, 0x84, 0x35, 0x91, 0x64, 0x42, 0x44, 0xd4, 0x8b,
+	0xaa, 0x51, 0xe4, 0x0a, 0xae, 0x89, 0x90, 0xf9, 0x03, 0x1d, 0x2e, 0x71, 0x7f, 0x3c, 0xc4, 0x85,
+	0x8b, 0x64, 0x3c, 0x24, 0x2a, 0x2f, 0xc9, 0x78, 0x48, 0x56, 0x46, 0x59, 0xc6, 0x03, 0x25, 0x8d,
+	0x5f, 0x11, 0x58, 0xe0, 0x2a, 0x45, 0xf2, 0x56, 0x45, 0x75, 0x92, 0xe4, 0xad, 0x1a, 0x13, 0x3c,
+	0xea, 0x8a, 0x6c, 0xbe, 0x07, 0x02, 0xe7, 0x77, 0x02, 0x8b, 0xe3, 0xcf, 0xbd, 0xa4, 0xcc, 0x12,
+	0xd4, 0x8b, 0xa4, 0xcc, 0x92, 0xb4, 0x84, 0xfa, 0xd6, 0x93, 0xc8, 0x1b, 0x4e, 0xc9, 0x6e, 0xe1,
+	0xa6, 0x88, 0xec, 0x1d, 0xe6, 0x3a, 0xde, 0x23, 0x3b, 0xaf, 0xfc, 0x71, 0x54, 0x22, 0x4f, 0x8f,
+	0x4a, 0xe4, 0x9f, 0xa3, 0x12, 0xf9, 0xee, 0xb8, 0x34, 0xf3, 0xf4, 0xb8, 0x34, 0xf3, 0xf7, 0x71,
+	0x69, 0xe6, 0x16, 0xff, 0x4e, 0xe3, 0x76, 0x3e, 0xd7, 0x2c, 0x47, 0xff, 0xd2, 0x3f, 0xad, 0x35,
+	0x4f, 0xbf, 0x95, 0x6c, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x44, 0xd6, 0x42, 0x1c, 0xe4, 0x11,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1356,6 +1484,8 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// OwnershipHistory queries the ownership records of a NFT, oldest first.
+	OwnershipHistory(ctx context.Context, in *QueryOwnershipHistoryRequest, opts ...grpc.CallOption) (*QueryOwnershipHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1474,6 +1604,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) OwnershipHistory(ctx context.Context, in *QueryOwnershipHistoryRequest, opts ...grpc.CallOption) (*QueryOwnershipHistoryResponse, error) {
+	out := new(QueryOwnershipHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/OwnershipHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -1501,6 +1640,8 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// OwnershipHistory queries the ownership records of a NFT, oldest first.
+	OwnershipHistory(context.Context, *QueryOwnershipHistoryRequest) (*QueryOwnershipHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1543,6 +1684,9 @@ func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) OwnershipHistory(ctx context.Context, req *QueryOwnershipHistoryRequest) (*QueryOwnershipHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1764,6 +1908,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnershipHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/OwnershipHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipHistory(ctx, req.(*QueryOwnershipHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1816,6 +1978,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "OwnershipHistory",
+			Handler:    _Query_OwnershipHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -2657,6 +2823,104 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOwnershipHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3020,6 +3284,46 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOwnershipHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5298,6 +5602,276 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOwnershipHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnershipHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &OwnershipRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,6 +54,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "LiftTombstone",
+					Use:            "lift-tombstone-proposal [validator] [waiting_period] [slash_fraction]",
+					Short:          "Submit a proposal to lift the tombstone of a validator",
+					Long:           "Submit a proposal to lift the tombstone of a validator, e.g. after an accidental double-sign. The validator stays jailed for the waiting period, after which it can be unjailed, and the slash fraction of its stake is burned.",
+					Example:        fmt.Sprintf(`%s tx slashing lift-tombstone-proposal cosmosvaloper1... 168h 0.1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}, {ProtoField: "waiting_period"}, {ProtoField: "slash_fraction"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiftTombstone lifts the tombstone of a validator. The validator stays jailed
+// until the waiting period elapsed, after which it can be unjailed, and the
+// given fraction of its stake is burned.
+//
+// The stake of a validator can no longer be burned once it is unbonded, so the
+// tombstone of a validator must be lifted before it completes unbonding.
+func (k Keeper) LiftTombstone(ctx context.Context, validatorAddr sdk.ValAddress, waitingPeriod time.Duration, slashFraction math.LegacyDec) error {
+	validator, err := k.sk.Validator(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return types.ErrNoSigningInfoFound.Wrapf("cannot lift tombstone of validator %s: %s", validator.GetOperator(), err)
+	}
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+	if validator.IsUnbonded() {
+		return types.ErrBadValidatorAddr.Wrapf("cannot lift tombstone of unbonded validator %s", validator.GetOperator())
+	}
+
+	// the consensus power of a jailed validator is zero, so the power to slash
+	// is derived from its tokens
+	power := sdk.TokensToConsensusPower(validator.GetTokens(), k.sk.PowerReduction(ctx))
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	coinsBurned, err := k.sk.Slash(ctx, consAddr, height, power, slashFraction)
+	if err != nil {
+		return err
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(waitingPeriod)
+	// reset the missed blocks so that the validator is not slashed for downtime
+	// once it is bonded again
+	signInfo.MissedBlocksCounter = 0
+	if err := k.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
+		return err
+	}
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo); err != nil {
+		return err
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	k.Logger.Info(
+		"lifted validator tombstone",
+		"validator", consStr,
+		"jailed_until", signInfo.JailedUntil,
+		"burned_coins", coinsBurned,
+	)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeLiftTombstone,
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+		event.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		event.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.String()),
+		event.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -39,6 +40,33 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// LiftTombstone implements MsgServer.LiftTombstone method.
+// It defines a governance method to lift the tombstone of a validator.
+func (k msgServer) LiftTombstone(ctx context.Context, msg *types.MsgLiftTombstone) (*types.MsgLiftTombstoneResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if msg.WaitingPeriod <= 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("waiting period must be positive: %s", msg.WaitingPeriod)
+	}
+
+	if msg.SlashFraction.IsNil() || !msg.SlashFraction.IsPositive() || msg.SlashFraction.GT(math.LegacyOneDec()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("slash fraction must be positive and at most one: %s", msg.SlashFraction)
+	}
+
+	if err := k.Keeper.LiftTombstone(ctx, valAddr, msg.WaitingPeriod, msg.SlashFraction); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLiftTombstoneResponse{}, nil
+}
+
 // Unjail implements MsgServer.Unjail method.
 // Validators must submit a transaction to unjail itself after
 // having been jailed (and thus unbonded) for downtime
@@ -359,3 +359,155 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestLiftTombstone() {
+	slashFraction := sdkmath.LegacyNewDecWithPrec(1, 1)
+	waitingPeriod := time.Hour * 24
+
+	// setupValidator stores the signing info of a new jailed validator with the
+	// given status and returns its address
+	setupValidator := func(status types.BondStatus, tombstoned bool) sdk.ValAddress {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+		s.Require().NoError(err)
+		consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(addr)
+		s.Require().NoError(err)
+
+		val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+		s.Require().NoError(err)
+		val.Tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+		val.DelegatorShares = sdkmath.LegacyNewDec(1)
+		val.Jailed = true
+		val.Status = status
+
+		info := slashingtypes.NewValidatorSigningInfo(consStr, int64(4),
+			time.Unix(253402300799, 0), tombstoned, int64(10))
+		s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
+		s.stakingKeeper.EXPECT().Validator(s.ctx, sdk.ValAddress(addr)).Return(val, nil)
+
+		return sdk.ValAddress(addr)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func() *slashingtypes.MsgLiftTombstone
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				return &slashingtypes.MsgLiftTombstone{Authority: "foo"}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid waiting period",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				_, _, valAddr := testdata.KeyTestPubAddr()
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+				s.Require().NoError(err)
+				return &slashingtypes.MsgLiftTombstone{
+					Authority:     s.slashingKeeper.GetAuthority(),
+					ValidatorAddr: valStr,
+					SlashFraction: slashFraction,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "waiting period must be positive",
+		},
+		{
+			name: "invalid slash fraction",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				_, _, valAddr := testdata.KeyTestPubAddr()
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+				s.Require().NoError(err)
+				return &slashingtypes.MsgLiftTombstone{
+					Authority:     s.slashingKeeper.GetAuthority(),
+					ValidatorAddr: valStr,
+					WaitingPeriod: waitingPeriod,
+					SlashFraction: sdkmath.LegacyZeroDec(),
+				}
+			},
+			expErr:    true,
+			expErrMsg: "slash fraction must be positive",
+		},
+		{
+			name: "validator not tombstoned",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				valAddr := setupValidator(types.Unbonding, false)
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+				s.Require().NoError(err)
+				return &slashingtypes.MsgLiftTombstone{
+					Authority:     s.slashingKeeper.GetAuthority(),
+					ValidatorAddr: valStr,
+					WaitingPeriod: waitingPeriod,
+					SlashFraction: slashFraction,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "validator not tombstoned",
+		},
+		{
+			name: "validator unbonded",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				valAddr := setupValidator(types.Unbonded, true)
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+				s.Require().NoError(err)
+				return &slashingtypes.MsgLiftTombstone{
+					Authority:     s.slashingKeeper.GetAuthority(),
+					ValidatorAddr: valStr,
+					WaitingPeriod: waitingPeriod,
+					SlashFraction: slashFraction,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "cannot lift tombstone of unbonded validator",
+		},
+		{
+			name: "valid request",
+			malleate: func() *slashingtypes.MsgLiftTombstone {
+				valAddr := setupValidator(types.Unbonding, true)
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+				s.Require().NoError(err)
+
+				s.stakingKeeper.EXPECT().PowerReduction(s.ctx).Return(sdk.DefaultPowerReduction)
+				s.stakingKeeper.EXPECT().Slash(s.ctx, sdk.ConsAddress(valAddr), s.ctx.HeaderInfo().Height, int64(100), slashFraction).
+					Return(sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction), nil)
+				s.stakingKeeper.EXPECT().ValidatorIdentifier(s.ctx, sdk.ConsAddress(valAddr)).Return(nil, nil)
+
+				return &slashingtypes.MsgLiftTombstone{
+					Authority:     s.slashingKeeper.GetAuthority(),
+					ValidatorAddr: valStr,
+					WaitingPeriod: waitingPeriod,
+					SlashFraction: slashFraction,
+				}
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			req := tc.malleate()
+			_, err := s.msgServer.LiftTombstone(s.ctx, req)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			valAddr, err := s.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddr)
+			s.Require().NoError(err)
+			info, err := s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, sdk.ConsAddress(valAddr))
+			s.Require().NoError(err)
+			s.Require().False(info.Tombstoned)
+			s.Require().Equal(s.ctx.HeaderInfo().Time.Add(waitingPeriod), info.JailedUntil)
+			s.Require().Zero(info.MissedBlocksCounter)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/slashing/types"
 
@@ -67,5 +68,17 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 		}
 	}
 
-	return k.sk.Unjail(ctx, consAddr)
+	if err := k.sk.Unjail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnjail,
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+	)
 }
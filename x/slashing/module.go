@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -26,10 +27,11 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasName                = AppModule{}
+	_ module.HasAminoCodec          = AppModule{}
+	_ module.HasGRPCGateway         = AppModule{}
+	_ module.AppModuleSimulation    = AppModule{}
+	_ module.HasBlockSummaryMetrics = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasBeginBlocker       = AppModule{}
@@ -97,6 +99,15 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
+// RegisterBlockSummaryMetrics registers the validators jailed and unjailed
+// during the block in the block summary event.
+func (am AppModule) RegisterBlockSummaryMetrics(registry sdk.BlockSummaryRegistry) {
+	registry.RegisterBlockSummaryMetrics(
+		sdk.BlockSummaryMetric{Name: types.BlockSummaryKeyValidatorsJailed, EventType: types.EventTypeSlash, Attribute: types.AttributeKeyJailed},
+		sdk.BlockSummaryMetric{Name: types.BlockSummaryKeyValidatorsUnjailed, EventType: types.EventTypeUnjail},
+	)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
@@ -5,6 +5,9 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeLiftTombstone = "lift_tombstone"
+	EventTypeUnjail        = "unjail"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -12,8 +15,12 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyJailedUntil  = "jailed_until"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+
+	BlockSummaryKeyValidatorsJailed   = "validators_jailed"
+	BlockSummaryKeyValidatorsUnjailed = "validators_unjailed"
 )
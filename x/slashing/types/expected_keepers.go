@@ -47,6 +47,9 @@ type StakingKeeper interface {
 	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 
+	// PowerReduction returns the power reduction used to convert tokens to consensus power
+	PowerReduction(ctx context.Context) math.Int
+
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(context.Context) (uint32, error)
 
@@ -8,6 +8,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgLiftTombstone{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
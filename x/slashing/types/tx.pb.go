@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -12,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -201,47 +206,168 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgLiftTombstone is the Msg/LiftTombstone request type.
+type MsgLiftTombstone struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validator_addr is the operator address of the tombstoned validator.
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// waiting_period is the duration the validator stays jailed for once its
+	// tombstone is lifted. It must be positive.
+	WaitingPeriod time.Duration `protobuf:"bytes,3,opt,name=waiting_period,json=waitingPeriod,proto3,stdduration" json:"waiting_period"`
+	// slash_fraction is the fraction of the stake of the validator which is
+	// burned when its tombstone is lifted. It must be positive.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+}
+
+func (m *MsgLiftTombstone) Reset()         { *m = MsgLiftTombstone{} }
+func (m *MsgLiftTombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftTombstone) ProtoMessage()    {}
+func (*MsgLiftTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgLiftTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiftTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftTombstone.Merge(m, src)
+}
+func (m *MsgLiftTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiftTombstone proto.InternalMessageInfo
+
+func (m *MsgLiftTombstone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgLiftTombstone) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MsgLiftTombstone) GetWaitingPeriod() time.Duration {
+	if m != nil {
+		return m.WaitingPeriod
+	}
+	return 0
+}
+
+// MsgLiftTombstoneResponse defines the response structure for executing a
+// MsgLiftTombstone message.
+type MsgLiftTombstoneResponse struct {
+}
+
+func (m *MsgLiftTombstoneResponse) Reset()         { *m = MsgLiftTombstoneResponse{} }
+func (m *MsgLiftTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftTombstoneResponse) ProtoMessage()    {}
+func (*MsgLiftTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgLiftTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiftTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftTombstoneResponse.Merge(m, src)
+}
+func (m *MsgLiftTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftTombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiftTombstoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgLiftTombstone)(nil), "cosmos.slashing.v1beta1.MsgLiftTombstone")
+	proto.RegisterType((*MsgLiftTombstoneResponse)(nil), "cosmos.slashing.v1beta1.MsgLiftTombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xb7, 0x18, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xf2, 0xbe, 0xb4, 0x45, 0x97, 0x1f, 0xe1, 0x7c, 0x05,
-	0x03, 0x3d, 0x13, 0x73, 0x90, 0x2f, 0x11, 0xd6, 0x80, 0x3c, 0xa8, 0x8a, 0xe4, 0xc1, 0x0a, 0x44,
-	0x2c, 0xa3, 0x79, 0x44, 0x49, 0x8f, 0x4b, 0x1c, 0x4d, 0x08, 0xe6, 0x6f, 0x2b, 0x61, 0x2c, 0xf6,
-	0x18, 0x3d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xc6, 0xa5, 0x12,
-	0x4e, 0x2f, 0xc0, 0x83, 0x52, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0xb5, 0x42, 0xf5, 0x5c, 0x3c, 0x28,
-	0x41, 0xad, 0x81, 0x57, 0x2f, 0x92, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xa3, 0x56, 0xf8, 0x14,
-	0xa6, 0x17, 0xa5, 0x58, 0x1b, 0x40, 0xc1, 0xed, 0x64, 0xbd, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x41, 0x93, 0x66, 0x71, 0x4a, 0xb6, 0x5e, 0x66,
-	0x3e, 0x72, 0x00, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x53, 0xbd, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0x0b, 0x6f, 0x20, 0xb7, 0x03, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x33, 0xed, 0xef, 0x57, 0xc9, 0xd8, 0x17, 0xbb, 0x2d, 0x34, 0x8d, 0x74, 0x13, 0x17,
+	0x2c, 0x31, 0x90, 0xd9, 0xa6, 0xda, 0x0a, 0x11, 0x0f, 0x86, 0x22, 0x82, 0x2d, 0x96, 0xfa, 0x82,
+	0x08, 0x12, 0x26, 0xd9, 0xe9, 0x76, 0x6c, 0xb2, 0x13, 0x76, 0xa6, 0xb5, 0x3d, 0x29, 0x3d, 0x89,
+	0x27, 0xf1, 0xe4, 0x51, 0xf4, 0xd2, 0x63, 0x0e, 0xf9, 0x23, 0x8a, 0xa7, 0x92, 0x93, 0xf4, 0x50,
+	0x25, 0x3d, 0x04, 0xc4, 0x3f, 0x42, 0x76, 0x67, 0xf2, 0xb2, 0x89, 0x49, 0x8b, 0x97, 0x64, 0x67,
+	0xe6, 0xf3, 0xbc, 0x7e, 0x9f, 0x07, 0xc6, 0x0b, 0x8c, 0x97, 0x18, 0x37, 0x79, 0x11, 0xf3, 0x2d,
+	0xea, 0xd8, 0xe6, 0x6e, 0x3a, 0x4f, 0x04, 0x4e, 0x9b, 0x62, 0x0f, 0x95, 0x5d, 0x26, 0x98, 0x36,
+	0x23, 0x09, 0xd4, 0x24, 0x90, 0x22, 0xa2, 0xd3, 0x36, 0xb3, 0x99, 0xcf, 0x98, 0xde, 0x97, 0xc4,
+	0xa3, 0xba, 0xcd, 0x98, 0x5d, 0x24, 0xa6, 0x7f, 0xca, 0xef, 0x6c, 0x9a, 0xd6, 0x8e, 0x8b, 0x05,
+	0x65, 0x8e, 0x7a, 0x9f, 0xef, 0x17, 0xb0, 0xe5, 0x5f, 0x72, 0xb3, 0x92, 0xcb, 0xc9, 0x00, 0x2a,
+	0x07, 0xf9, 0xa4, 0x32, 0x32, 0x4b, 0xdc, 0xb3, 0xf6, 0xfe, 0xd4, 0xc3, 0x24, 0x2e, 0x51, 0x87,
+	0x99, 0xfe, 0xaf, 0xbc, 0x32, 0xbe, 0x02, 0x18, 0x5e, 0xe3, 0xf6, 0x53, 0xe7, 0x15, 0xa6, 0x45,
+	0xcd, 0x82, 0xe3, 0xbb, 0xb8, 0x48, 0x2d, 0x2c, 0x98, 0x9b, 0xc3, 0x96, 0xe5, 0x46, 0x40, 0x1c,
+	0x24, 0xc2, 0xd9, 0xbb, 0xbf, 0x4e, 0x63, 0x97, 0xbc, 0x33, 0xe1, 0xbc, 0x56, 0x4d, 0xcd, 0xa9,
+	0x70, 0xcf, 0x9a, 0xec, 0x3d, 0xf9, 0xf4, 0x58, 0xb8, 0xd4, 0xb1, 0xbf, 0x34, 0x2a, 0xc9, 0x26,
+	0x7c, 0xd8, 0xa8, 0x24, 0xc1, 0xc6, 0xd8, 0x6e, 0x27, 0x98, 0x59, 0x78, 0xf7, 0x39, 0x16, 0x3a,
+	0x68, 0x54, 0x92, 0x5d, 0xc1, 0xde, 0x37, 0x2a, 0xc9, 0x69, 0xe9, 0x3a, 0xc5, 0xad, 0x6d, 0xb3,
+	0x95, 0x97, 0x31, 0x05, 0x27, 0x5b, 0x87, 0x0d, 0xc2, 0xcb, 0xcc, 0xe1, 0xc4, 0x38, 0x01, 0x70,
+	0xc2, 0xbb, 0x2d, 0x5b, 0x58, 0x90, 0x75, 0xec, 0xe2, 0x12, 0xd7, 0x96, 0x61, 0x18, 0xef, 0x88,
+	0x2d, 0xe6, 0x52, 0xb1, 0xaf, 0x72, 0x8f, 0xd4, 0xaa, 0x29, 0xe5, 0x15, 0x05, 0xf2, 0xdc, 0x68,
+	0xa3, 0x5a, 0x16, 0x8e, 0x94, 0x7d, 0x0f, 0x91, 0xa1, 0x38, 0x48, 0x5c, 0x5e, 0x8c, 0xa1, 0x3e,
+	0xaa, 0x22, 0x19, 0x28, 0x1b, 0x3e, 0x3a, 0x8d, 0x85, 0x64, 0x75, 0xca, 0x32, 0xf3, 0xb0, 0x56,
+	0x4d, 0x4d, 0xb4, 0xd3, 0x8f, 0x2f, 0xa0, 0x5b, 0xb7, 0xbd, 0x2a, 0xdb, 0x61, 0xbc, 0x02, 0xaf,
+	0x77, 0x14, 0xb8, 0xd7, 0x56, 0xb9, 0xab, 0x10, 0x03, 0xc1, 0x99, 0xae, 0xab, 0x66, 0xdd, 0x99,
+	0xa9, 0xbf, 0xc4, 0x31, 0x3e, 0x0e, 0xc3, 0x2b, 0x6b, 0xdc, 0x5e, 0xa5, 0x9b, 0xe2, 0x09, 0x2b,
+	0xe5, 0xb9, 0x60, 0x0e, 0xf9, 0xe7, 0x6e, 0x3c, 0xe8, 0x19, 0x83, 0x21, 0xdf, 0xf8, 0xda, 0xb9,
+	0xda, 0x77, 0x49, 0xad, 0x3d, 0x82, 0xe3, 0xaf, 0x31, 0x15, 0xd4, 0xb1, 0x73, 0x65, 0xe2, 0x52,
+	0x66, 0x45, 0x86, 0xfd, 0xfe, 0xce, 0x22, 0xb9, 0x06, 0xa8, 0xb9, 0x06, 0x68, 0x45, 0xad, 0x41,
+	0x76, 0xcc, 0xeb, 0xec, 0xa7, 0x1f, 0x31, 0xa0, 0x66, 0x47, 0xd9, 0xaf, 0xfb, 0xe6, 0xda, 0x4b,
+	0x38, 0xee, 0xf7, 0x2c, 0xb7, 0xe9, 0xe2, 0x82, 0xc7, 0x47, 0xfe, 0x8b, 0x83, 0xc4, 0x68, 0x76,
+	0xd9, 0xb3, 0x3a, 0x39, 0x8d, 0x5d, 0x95, 0xe9, 0x71, 0x6b, 0x1b, 0x51, 0x66, 0x96, 0xb0, 0xd8,
+	0x42, 0xab, 0xc4, 0xc6, 0x85, 0xfd, 0x15, 0x52, 0xa8, 0x55, 0x53, 0x50, 0x65, 0xbf, 0x42, 0x0a,
+	0xca, 0xbd, 0xef, 0xed, 0xbe, 0x72, 0x96, 0x59, 0xed, 0xe9, 0xed, 0x52, 0xba, 0x57, 0xc3, 0xf9,
+	0xbe, 0x1a, 0x06, 0xfa, 0x6f, 0x98, 0x30, 0xd2, 0x7d, 0x37, 0x40, 0xc5, 0xa5, 0xf4, 0xe2, 0xef,
+	0x21, 0x38, 0xbc, 0xc6, 0x6d, 0xed, 0x39, 0x1c, 0x51, 0x1b, 0x69, 0xf4, 0x1d, 0xc4, 0xd6, 0x42,
+	0x44, 0x93, 0xe7, 0x33, 0xcd, 0xb0, 0xda, 0x1b, 0x38, 0x1a, 0x58, 0x98, 0xc4, 0x40, 0xdb, 0x0e,
+	0x32, 0xba, 0x70, 0x51, 0xb2, 0xb5, 0xa0, 0x53, 0xdf, 0x7a, 0x07, 0x55, 0x3b, 0x00, 0x70, 0x2c,
+	0x38, 0xa5, 0x37, 0x06, 0x39, 0x0e, 0xa0, 0xd1, 0xf4, 0x85, 0xd1, 0x01, 0x49, 0x2c, 0xa5, 0xa3,
+	0xff, 0xbf, 0xf5, 0xc4, 0xcf, 0xde, 0x39, 0xac, 0xeb, 0xe0, 0xa8, 0xae, 0x83, 0xe3, 0xba, 0x0e,
+	0x7e, 0xd6, 0x75, 0xf0, 0xe1, 0x4c, 0x0f, 0x1d, 0x9f, 0xe9, 0xa1, 0xef, 0x67, 0x7a, 0xe8, 0xc5,
+	0x5c, 0x60, 0x94, 0x3a, 0x74, 0x16, 0xfb, 0x65, 0xc2, 0xf3, 0x23, 0xfe, 0xe8, 0xde, 0xfc, 0x13,
+	0x00, 0x00, 0xff, 0xff, 0xf9, 0x19, 0x04, 0x43, 0x22, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -337,6 +463,60 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgLiftTombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgLiftTombstone)
+	if !ok {
+		that2, ok := that.(MsgLiftTombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if this.WaitingPeriod != that1.WaitingPeriod {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	return true
+}
+func (this *MsgLiftTombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgLiftTombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgLiftTombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -357,6 +537,11 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// LiftTombstone defines a governance operation for lifting the tombstone of a
+	// validator, e.g. after an accidental double-sign. The validator stays jailed
+	// for a waiting period, after which it can be unjailed, and part of its stake
+	// is burned. The authority defaults to the x/gov module account.
+	LiftTombstone(ctx context.Context, in *MsgLiftTombstone, opts ...grpc.CallOption) (*MsgLiftTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -385,6 +570,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) LiftTombstone(ctx context.Context, in *MsgLiftTombstone, opts ...grpc.CallOption) (*MsgLiftTombstoneResponse, error) {
+	out := new(MsgLiftTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/LiftTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -394,6 +588,11 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// LiftTombstone defines a governance operation for lifting the tombstone of a
+	// validator, e.g. after an accidental double-sign. The validator stays jailed
+	// for a waiting period, after which it can be unjailed, and part of its stake
+	// is burned. The authority defaults to the x/gov module account.
+	LiftTombstone(context.Context, *MsgLiftTombstone) (*MsgLiftTombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,6 +605,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) LiftTombstone(ctx context.Context, req *MsgLiftTombstone) (*MsgLiftTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftTombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -447,6 +649,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LiftTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/LiftTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftTombstone(ctx, req.(*MsgLiftTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -459,6 +679,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "LiftTombstone",
+			Handler:    _Msg_LiftTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -580,6 +804,84 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLiftTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WaitingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WaitingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -637,6 +939,36 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLiftTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WaitingPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiftTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -940,6 +1272,236 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLiftTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiftTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiftTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WaitingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiftTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiftTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiftTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
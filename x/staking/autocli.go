@@ -137,6 +137,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "SlashSimulation",
+					Use:       "slash-simulation [delegator-addr] [validator-addr] [slash-fraction]",
+					Short:     "Simulate the effect of a validator slash on a delegation",
+					Long:      "Query the token value of a delegation and its unbonding entries if the validator were slashed by the given fraction.",
+					Example:   fmt.Sprintf("$ %s query staking slash-simulation [delegator-addr] [validator-addr] 0.05 --infraction-height 100", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_addr"},
+						{ProtoField: "validator_addr"},
+						{ProtoField: "slash_fraction"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorWeights",
+					Use:       "delegator-weights",
+					Short:     "Query the weights of the delegators of a validator set",
+					Long:      "Query the token value of the delegations of each delegator to the given validators, or to the bonded validators by default, and optionally its pro rata share of an amount to distribute.",
+					Example:   fmt.Sprintf("$ %s query staking delegator-weights --validator-addrs [validator-addr1],[validator-addr2] --amount 1000000", version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
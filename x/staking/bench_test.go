@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -43,7 +44,7 @@ func benchmarkValidateGenesis(b *testing.B, n int) {
 	for i := 0; i < b.N; i++ {
 		genesisState := types.DefaultGenesisState()
 		genesisState.Validators = validators
-		if err := staking.ValidateGenesis(genesisState); err != nil {
+		if err := staking.ValidateGenesis(genesisState, address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")); err != nil {
 			b.Fatal(err)
 		}
 	}
@@ -6,6 +6,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -50,12 +51,16 @@ func WriteValidators(ctx sdk.Context, keeper *keeper.Keeper) (vals []cmttypes.Ge
 
 // ValidateGenesis validates the provided staking genesis state to ensure the
 // expected invariants holds. (i.e. params in correct bounds, no duplicate validators)
-func ValidateGenesis(data *types.GenesisState) error {
+func ValidateGenesis(data *types.GenesisState, valAc, delAc address.Codec) error {
 	if err := validateGenesisStateValidators(data.Validators); err != nil {
 		return err
 	}
 
-	return data.Params.Validate()
+	if err := validateGenesisStateRedelegationCooldowns(data.RedelegationCooldowns, delAc); err != nil {
+		return err
+	}
+
+	return data.Params.Validate(valAc)
 }
 
 func validateGenesisStateValidators(validators []types.Validator) error {
@@ -95,3 +100,18 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateRedelegationCooldowns(cooldowns []types.RedelegationCooldown, delAc address.Codec) error {
+	seen := make(map[string]bool, len(cooldowns))
+	for _, cooldown := range cooldowns {
+		if _, err := delAc.StringToBytes(cooldown.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid redelegation cooldown delegator address %s: %w", cooldown.DelegatorAddress, err)
+		}
+		if seen[cooldown.DelegatorAddress] {
+			return fmt.Errorf("duplicate redelegation cooldown in genesis state: delegator %s", cooldown.DelegatorAddress)
+		}
+		seen[cooldown.DelegatorAddress] = true
+	}
+
+	return nil
+}
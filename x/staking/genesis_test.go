@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +23,10 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = math.OneInt()
 	genValidators1[0].DelegatorShares = math.LegacyOneDec()
 
+	valAc, delAc := address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")
+	delAddr, err := delAc.BytesToString(sdk.AccAddress(pk.Address()))
+	assert.NoError(t, err)
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -41,6 +47,19 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate redelegation cooldowns
+		{"redelegation cooldown", func(data *types.GenesisState) {
+			data.RedelegationCooldowns = []types.RedelegationCooldown{{DelegatorAddress: delAddr, EndTime: time.Unix(1, 0)}}
+		}, false},
+		{"invalid redelegation cooldown delegator", func(data *types.GenesisState) {
+			data.RedelegationCooldowns = []types.RedelegationCooldown{{DelegatorAddress: "invalid", EndTime: time.Unix(1, 0)}}
+		}, true},
+		{"duplicate redelegation cooldown", func(data *types.GenesisState) {
+			data.RedelegationCooldowns = []types.RedelegationCooldown{
+				{DelegatorAddress: delAddr, EndTime: time.Unix(1, 0)},
+				{DelegatorAddress: delAddr, EndTime: time.Unix(2, 0)},
+			}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -51,9 +70,9 @@ func TestValidateGenesis(t *testing.T) {
 			tt.mutate(genesisState)
 
 			if tt.wantErr {
-				assert.Error(t, staking.ValidateGenesis(genesisState))
+				assert.Error(t, staking.ValidateGenesis(genesisState, valAc, delAc))
 			} else {
-				assert.NoError(t, staking.ValidateGenesis(genesisState))
+				assert.NoError(t, staking.ValidateGenesis(genesisState, valAc, delAc))
 			}
 		})
 	}
@@ -237,6 +237,38 @@ func (k Keeper) GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress
 	return bonded.RoundInt(), err
 }
 
+// GetDelegatorBondedCoins returns the total amount a delegator has bonded, in
+// the bond denom.
+func (k Keeper) GetDelegatorBondedCoins(ctx context.Context, delegator sdk.AccAddress) (sdk.Coins, error) {
+	bonded, err := k.GetDelegatorBonded(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.bondDenomCoins(ctx, bonded)
+}
+
+// GetDelegatorUnbondingCoins returns the total amount a delegator has
+// unbonding, in the bond denom.
+func (k Keeper) GetDelegatorUnbondingCoins(ctx context.Context, delegator sdk.AccAddress) (sdk.Coins, error) {
+	unbonding, err := k.GetDelegatorUnbonding(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.bondDenomCoins(ctx, unbonding)
+}
+
+// bondDenomCoins returns the coins of the given amount of the bond denom.
+func (k Keeper) bondDenomCoins(ctx context.Context, amount math.Int) (sdk.Coins, error) {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, amount)), nil
+}
+
 // IterateDelegatorDelegations iterates through one delegator's delegations.
 func (k Keeper) IterateDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(delegation types.Delegation) (stop bool)) error {
 	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
@@ -1073,6 +1105,14 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	if err := k.checkRedelegationRestrictions(ctx, delAddr, valSrcAddr, valDstAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.startRedelegationCooldown(ctx, delAddr); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
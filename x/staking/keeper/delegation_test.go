@@ -131,6 +131,10 @@ func (s *KeeperTestSuite) TestDelegation() {
 	require.NoError(err)
 	require.Equal(expBonded, math.LegacyNewDecFromInt(resDelBond))
 
+	resDelBondCoins, err := keeper.GetDelegatorBondedCoins(ctx, addrDels[0])
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, resDelBond)), resDelBondCoins)
+
 	// delete a record
 	require.NoError(keeper.RemoveDelegation(ctx, bond2to3))
 	_, err = keeper.Delegations.Get(ctx, collections.Join(addrDels[1], valAddrs[2]))
@@ -285,6 +289,10 @@ func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	require.NoError(err)
 	require.Equal(expUnbond, resDelUnbond)
 
+	resDelUnbondCoins, err := keeper.GetDelegatorUnbondingCoins(ctx, delAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expUnbond)), resDelUnbondCoins)
+
 	// delete a record
 	require.NoError(keeper.RemoveUnbondingDelegation(ctx, ubd))
 	_, err = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
@@ -858,6 +866,96 @@ func (s *KeeperTestSuite) TestRedelegationMaxEntries() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestRedelegationRestrictions() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(3)
+
+	// create three bonded validators, the first one with a self-delegation
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	for i, addr := range addrVals {
+		validator := testutil.NewValidator(s.T(), addr, PKs[i])
+		validator, issuedShares := validator.AddTokensFromDel(valTokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+		if i == 0 {
+			delegation := stakingtypes.NewDelegation(s.addressToString(sdk.AccAddress(addr)), s.valAddressToString(addr), issuedShares)
+			require.NoError(keeper.SetDelegation(ctx, delegation))
+		}
+	}
+	delAddr := sdk.AccAddress(addrVals[0])
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.DeniedRedelegationRoutes = []stakingtypes.RedelegationRoute{{DstValidatorAddress: s.valAddressToString(addrVals[2])}}
+	params.MaxRedelegationHops = 2
+	params.RedelegationCooldown = time.Hour
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the redelegations to the denied destination fail
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[2], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrRedelegationRouteDenied)
+
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.NoError(err)
+
+	// a redelegation within the cooldown fails
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrRedelegationCooldown)
+
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: ctx.HeaderInfo().Time.Add(time.Hour)})
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.NoError(err)
+
+	// a redelegation exceeding the hops in progress fails
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: ctx.HeaderInfo().Time.Add(time.Hour)})
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrMaxRedelegationHops)
+
+	// without restrictions, the redelegation succeeds
+	params.MaxRedelegationHops = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = keeper.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRedelegationCooldowns() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, _ := createValAddrs(3)
+	now := ctx.HeaderInfo().Time
+	require.NoError(keeper.SetRedelegationCooldown(ctx, delAddrs[0], now.Add(time.Hour)))
+	require.NoError(keeper.SetRedelegationCooldown(ctx, delAddrs[1], now.Add(time.Hour)))
+	require.NoError(keeper.SetRedelegationCooldown(ctx, delAddrs[2], now.Add(2*time.Hour)))
+	// the cooldown in progress is replaced
+	require.NoError(keeper.SetRedelegationCooldown(ctx, delAddrs[1], now.Add(3*time.Hour)))
+
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.ZeroInt()))
+	genesis, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]stakingtypes.RedelegationCooldown{
+		{DelegatorAddress: s.addressToString(delAddrs[0]), EndTime: now.Add(time.Hour)},
+		{DelegatorAddress: s.addressToString(delAddrs[1]), EndTime: now.Add(3 * time.Hour)},
+		{DelegatorAddress: s.addressToString(delAddrs[2]), EndTime: now.Add(2 * time.Hour)},
+	}, genesis.RedelegationCooldowns)
+
+	// the cooldowns ended are pruned
+	require.NoError(keeper.PruneExpiredRedelegationCooldowns(ctx, now.Add(2*time.Hour)))
+	for i, expected := range []bool{false, true, false} {
+		has, err := keeper.RedelegationCooldowns.Has(ctx, delAddrs[i])
+		require.NoError(err)
+		require.Equal(expected, has)
+	}
+
+	iter, err := keeper.RedelegationCooldownQueue.Iterate(ctx, nil)
+	require.NoError(err)
+	keys, err := iter.Keys()
+	require.NoError(err)
+	require.Equal([]collections.Pair[time.Time, []byte]{collections.Join(now.Add(3*time.Hour), delAddrs[1].Bytes())}, keys)
+}
+
 func (s *KeeperTestSuite) TestRedelegateSelfDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
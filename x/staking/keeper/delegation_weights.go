@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IterateDelegatorWeights iterates over the delegators of the given validators,
+// or of the bonded validators if none is given, in delegator address order. cb
+// is called with the token value of the delegations of each delegator to the
+// validator set, which is its weight in the set. The iteration stops when cb
+// returns true or an error.
+func (k Keeper) IterateDelegatorWeights(
+	ctx context.Context, valAddrs []sdk.ValAddress,
+	cb func(delAddr sdk.AccAddress, tokens math.LegacyDec) (stop bool, err error),
+) error {
+	validators, _, err := k.delegatorWeightsValidators(ctx, valAddrs)
+	if err != nil {
+		return err
+	}
+
+	return k.iterateDelegatorWeights(ctx, validators, nil, cb)
+}
+
+// DelegationWeightedDistribution distributes amount over the delegators of the
+// given validators, or of the bonded validators if none is given, pro rata to
+// their weight in the set as returned by IterateDelegatorWeights. cb is called
+// with the truncated share of each delegator, in delegator address order. The
+// iteration stops when cb returns true or an error.
+func (k Keeper) DelegationWeightedDistribution(
+	ctx context.Context, valAddrs []sdk.ValAddress, amount math.Int,
+	cb func(delAddr sdk.AccAddress, share math.Int) (stop bool, err error),
+) error {
+	validators, totalTokens, err := k.delegatorWeightsValidators(ctx, valAddrs)
+	if err != nil {
+		return err
+	}
+	if !totalTokens.IsPositive() {
+		return nil
+	}
+
+	return k.iterateDelegatorWeights(ctx, validators, nil, func(delAddr sdk.AccAddress, tokens math.LegacyDec) (bool, error) {
+		return cb(delAddr, delegatorShare(amount, tokens, totalTokens))
+	})
+}
+
+// delegatorShare returns the truncated share of amount of a delegator of the
+// given weight.
+func delegatorShare(amount math.Int, tokens, totalTokens math.LegacyDec) math.Int {
+	return amount.ToLegacyDec().Mul(tokens).Quo(totalTokens).TruncateInt()
+}
+
+// delegatorWeightsValidators returns the given validators, or the bonded
+// validators if none is given, by operator address bytes along with their
+// total tokens.
+func (k Keeper) delegatorWeightsValidators(ctx context.Context, valAddrs []sdk.ValAddress) (map[string]types.Validator, math.LegacyDec, error) {
+	validators := make(map[string]types.Validator)
+	totalTokens := math.LegacyZeroDec()
+
+	if len(valAddrs) == 0 {
+		bonded, err := k.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+
+		for _, validator := range bonded {
+			valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+			if err != nil {
+				return nil, math.LegacyDec{}, err
+			}
+			validators[string(valAddr)] = validator
+			totalTokens = totalTokens.Add(math.LegacyNewDecFromInt(validator.Tokens))
+		}
+
+		return validators, totalTokens, nil
+	}
+
+	for _, valAddr := range valAddrs {
+		if _, ok := validators[string(valAddr)]; ok {
+			continue
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+		validators[string(valAddr)] = validator
+		totalTokens = totalTokens.Add(math.LegacyNewDecFromInt(validator.Tokens))
+	}
+
+	return validators, totalTokens, nil
+}
+
+// iterateDelegatorWeights iterates over the delegators of the given validators
+// from the start delegator, inclusive, see IterateDelegatorWeights. The
+// delegations are keyed by delegator first, so the weight of a delegator is
+// complete once the iteration moves to the next delegator.
+func (k Keeper) iterateDelegatorWeights(
+	ctx context.Context, validators map[string]types.Validator, start sdk.AccAddress,
+	cb func(delAddr sdk.AccAddress, tokens math.LegacyDec) (stop bool, err error),
+) error {
+	var rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	if start != nil {
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).
+			StartInclusive(collections.PairPrefix[sdk.AccAddress, sdk.ValAddress](start))
+	}
+
+	var (
+		delAddr sdk.AccAddress
+		tokens  math.LegacyDec
+		stopped bool
+	)
+	err := k.Delegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], delegation types.Delegation) (bool, error) {
+		validator, ok := validators[string(key.K2())]
+		if !ok {
+			return false, nil
+		}
+
+		if delAddr != nil && !delAddr.Equals(key.K1()) {
+			stop, err := cb(delAddr, tokens)
+			if stop || err != nil {
+				stopped = true
+				return true, err
+			}
+			delAddr = nil
+		}
+		if delAddr == nil {
+			delAddr, tokens = key.K1(), math.LegacyZeroDec()
+		}
+
+		tokens = tokens.Add(validator.TokensFromShares(delegation.Shares))
+		return false, nil
+	})
+	if err != nil || stopped || delAddr == nil {
+		return err
+	}
+
+	_, err = cb(delAddr, tokens)
+	return err
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
@@ -137,6 +138,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, cooldown := range data.RedelegationCooldowns {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(cooldown.DelegatorAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delegator address: %s", err)
+		}
+		if err := k.SetRedelegationCooldown(ctx, delAddr, cooldown.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -275,14 +286,28 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var cooldowns []types.RedelegationCooldown
+	err = k.RedelegationCooldowns.Walk(ctx, nil, func(delAddr []byte, end time.Time) (bool, error) {
+		delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+		cooldowns = append(cooldowns, types.RedelegationCooldown{DelegatorAddress: delAddrStr, EndTime: end})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                params,
+		LastTotalPower:        totalPower,
+		LastValidatorPowers:   lastValidatorPowers,
+		Validators:            allValidators,
+		Delegations:           allDelegations,
+		UnbondingDelegations:  unbondingDelegations,
+		Redelegations:         redelegations,
+		Exported:              true,
+		RedelegationCooldowns: cooldowns,
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/staking/types"
@@ -489,6 +490,128 @@ func (k Querier) Pool(ctx context.Context, _ *types.QueryPoolRequest) (*types.Qu
 	return &types.QueryPoolResponse{Pool: pool}, nil
 }
 
+// SlashSimulation queries the effect of a hypothetical slash of a validator on
+// a delegator's delegation and unbonding delegation entries
+func (k Querier) SlashSimulation(ctx context.Context, req *types.QuerySlashSimulationRequest) (*types.QuerySlashSimulationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	if req.SlashFraction.IsNil() {
+		return nil, status.Error(codes.InvalidArgument, "slash fraction cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, slashedBalance, unbondingEntries, err := k.SimulateSlash(ctx, delAddr, valAddr, req.InfractionHeight, req.SlashFraction)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	case errors.Is(err, types.ErrNoDelegation):
+		return nil, status.Errorf(
+			codes.NotFound,
+			"delegation with delegator %s not found for validator %s",
+			req.DelegatorAddr, req.ValidatorAddr)
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashSimulationResponse{
+		Balance:          sdk.NewCoin(bondDenom, balance),
+		SlashedBalance:   sdk.NewCoin(bondDenom, slashedBalance),
+		UnbondingEntries: unbondingEntries,
+	}, nil
+}
+
+// DelegatorWeights queries the weights of the delegators of a validator set,
+// and their share of an amount to distribute
+func (k Querier) DelegatorWeights(ctx context.Context, req *types.QueryDelegatorWeightsRequest) (*types.QueryDelegatorWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	limit := uint64(query.DefaultLimit)
+	var start sdk.AccAddress
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse || req.Pagination.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		start = req.Pagination.Key
+	}
+
+	valAddrs := make([]sdk.ValAddress, 0, len(req.ValidatorAddrs))
+	for _, addr := range req.ValidatorAddrs {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(addr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	validators, totalTokens, err := k.delegatorWeightsValidators(ctx, valAddrs)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var (
+		weights []types.DelegatorWeight
+		nextKey []byte
+	)
+	err = k.iterateDelegatorWeights(ctx, validators, start, func(delAddr sdk.AccAddress, tokens math.LegacyDec) (bool, error) {
+		if uint64(len(weights)) == limit {
+			nextKey = delAddr
+			return true, nil
+		}
+
+		delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		weight := types.DelegatorWeight{DelegatorAddress: delegator, Tokens: tokens, Amount: math.ZeroInt()}
+		if !req.Amount.IsNil() && totalTokens.IsPositive() {
+			weight.Amount = delegatorShare(req.Amount, tokens, totalTokens)
+		}
+		weights = append(weights, weight)
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorWeightsResponse{
+		Weights:     weights,
+		TotalTokens: totalTokens,
+		Pagination:  &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
 // Params queries the staking parameters
 func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params, err := k.Keeper.Params.Get(ctx)
@@ -3,11 +3,18 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryValidator() {
@@ -61,3 +68,201 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQuerySlashSimulation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now()})
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	addrDels, valAddrs := createValAddrs(2)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	ubdTokens := keeper.TokensFromConsensusPower(ctx, 2)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.NotBondedPoolName, types.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(validator.IsBonded())
+
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(addrDels[0]), s.valAddressToString(valAddrs[0]), issuedShares)))
+
+	ubd := types.NewUnbondingDelegation(
+		addrDels[0], valAddrs[0], 5, ctx.HeaderInfo().Time.Add(time.Hour), ubdTokens, 0,
+		keeper.ValidatorAddressCodec(), s.accountKeeper.AddressCodec(),
+	)
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		msg               string
+		req               *types.QuerySlashSimulationRequest
+		expErr            bool
+		expSlashedBalance math.Int
+		expSlashedEntry   math.Int
+	}{
+		{
+			msg:    "empty request",
+			req:    &types.QuerySlashSimulationRequest{},
+			expErr: true,
+		},
+		{
+			msg: "slash fraction above one",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr: s.addressToString(addrDels[0]),
+				ValidatorAddr: s.valAddressToString(valAddrs[0]),
+				SlashFraction: math.LegacyNewDec(2),
+			},
+			expErr: true,
+		},
+		{
+			msg: "future infraction",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr:    s.addressToString(addrDels[0]),
+				ValidatorAddr:    s.valAddressToString(valAddrs[0]),
+				SlashFraction:    half,
+				InfractionHeight: 11,
+			},
+			expErr: true,
+		},
+		{
+			msg: "no delegation",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr: s.addressToString(addrDels[1]),
+				ValidatorAddr: s.valAddressToString(valAddrs[0]),
+				SlashFraction: half,
+			},
+			expErr: true,
+		},
+		{
+			// the unbonding entry absorbs part of the slash, leaving less to
+			// burn from the validator
+			msg: "unbonding entry exposed",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr: s.addressToString(addrDels[0]),
+				ValidatorAddr: s.valAddressToString(valAddrs[0]),
+				SlashFraction: half,
+			},
+			expSlashedBalance: keeper.TokensFromConsensusPower(ctx, 6),
+			expSlashedEntry:   keeper.TokensFromConsensusPower(ctx, 1),
+		},
+		{
+			msg: "unbonding entry created before infraction",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr:    s.addressToString(addrDels[0]),
+				ValidatorAddr:    s.valAddressToString(valAddrs[0]),
+				SlashFraction:    half,
+				InfractionHeight: 6,
+			},
+			expSlashedBalance: keeper.TokensFromConsensusPower(ctx, 5),
+			expSlashedEntry:   ubdTokens,
+		},
+		{
+			msg: "infraction at current height",
+			req: &types.QuerySlashSimulationRequest{
+				DelegatorAddr:    s.addressToString(addrDels[0]),
+				ValidatorAddr:    s.valAddressToString(valAddrs[0]),
+				SlashFraction:    half,
+				InfractionHeight: 10,
+			},
+			expSlashedBalance: keeper.TokensFromConsensusPower(ctx, 5),
+			expSlashedEntry:   ubdTokens,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := querier.SlashSimulation(ctx, tc.req)
+			if tc.expErr {
+				require.Error(err)
+				require.Nil(res)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(delTokens, res.Balance.Amount)
+			require.Equal(tc.expSlashedBalance, res.SlashedBalance.Amount)
+			require.Len(res.UnbondingEntries, 1)
+			require.Equal(ubdTokens, res.UnbondingEntries[0].Balance)
+			require.Equal(tc.expSlashedEntry, res.UnbondingEntries[0].SlashedBalance)
+		})
+	}
+
+	// the simulation must not modify any state
+	val, err := keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(delTokens, val.Tokens)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryDelegatorWeights() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	addrDels, valAddrs := createValAddrs(2)
+
+	// validator 0 is delegated 6 and 4 by the delegators, validator 1 is
+	// delegated 10 by delegator 0
+	validator0 := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator0, shares00 := validator0.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 6))
+	validator0, shares01 := validator0.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 4))
+	validator1 := testutil.NewValidator(s.T(), valAddrs[1], PKs[1])
+	validator1, shares10 := validator1.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.NotBondedPoolName, types.BondedPoolName, gomock.Any()).Times(2)
+	stakingkeeper.TestingUpdateValidator(keeper, ctx, validator0, true)
+	stakingkeeper.TestingUpdateValidator(keeper, ctx, validator1, true)
+
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(addrDels[0]), s.valAddressToString(valAddrs[0]), shares00)))
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(addrDels[1]), s.valAddressToString(valAddrs[0]), shares01)))
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(addrDels[0]), s.valAddressToString(valAddrs[1]), shares10)))
+
+	tokens := func(power int64) math.LegacyDec {
+		return math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	// bonded validators by default
+	res, err := querier.DelegatorWeights(ctx, &types.QueryDelegatorWeightsRequest{Amount: math.NewInt(1000)})
+	require.NoError(err)
+	require.Equal(tokens(20), res.TotalTokens)
+	require.Equal([]types.DelegatorWeight{
+		{DelegatorAddress: s.addressToString(addrDels[0]), Tokens: tokens(16), Amount: math.NewInt(800)},
+		{DelegatorAddress: s.addressToString(addrDels[1]), Tokens: tokens(4), Amount: math.NewInt(200)},
+	}, res.Weights)
+	require.Nil(res.Pagination.NextKey)
+
+	// paginated by delegator
+	res, err = querier.DelegatorWeights(ctx, &types.QueryDelegatorWeightsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(err)
+	require.Len(res.Weights, 1)
+	require.Equal(tokens(16), res.Weights[0].Tokens)
+	require.Equal(math.ZeroInt(), res.Weights[0].Amount)
+	require.Equal([]byte(addrDels[1]), res.Pagination.NextKey)
+
+	res, err = querier.DelegatorWeights(ctx, &types.QueryDelegatorWeightsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(err)
+	require.Len(res.Weights, 1)
+	require.Equal(s.addressToString(addrDels[1]), res.Weights[0].DelegatorAddress)
+	require.Nil(res.Pagination.NextKey)
+
+	// given validator set
+	res, err = querier.DelegatorWeights(ctx, &types.QueryDelegatorWeightsRequest{
+		ValidatorAddrs: []string{s.valAddressToString(valAddrs[1])},
+		Amount:         math.NewInt(1000),
+	})
+	require.NoError(err)
+	require.Equal([]types.DelegatorWeight{
+		{DelegatorAddress: s.addressToString(addrDels[0]), Tokens: tokens(10), Amount: math.NewInt(1000)},
+	}, res.Weights)
+
+	_, err = querier.DelegatorWeights(ctx, &types.QueryDelegatorWeightsRequest{Pagination: &query.PageRequest{Offset: 1}})
+	require.Error(err)
+
+	// keeper API
+	var shares []math.Int
+	require.NoError(keeper.DelegationWeightedDistribution(ctx, nil, math.NewInt(10), func(_ sdk.AccAddress, share math.Int) (bool, error) {
+		shares = append(shares, share)
+		return false, nil
+	}))
+	require.Equal([]math.Int{math.NewInt(8), math.NewInt(2)}, shares)
+}
@@ -126,6 +126,10 @@ type Keeper struct {
 	NewToOldConsKeyMap collections.Map[[]byte, []byte]
 	// OldToNewConsKeyMap: prefix for rotated new cons address to old cons address
 	OldToNewConsKeyMap collections.Map[[]byte, []byte]
+	// RedelegationCooldowns key: delAddr | value: end of the delegator's redelegation cooldown
+	RedelegationCooldowns collections.Map[[]byte, time.Time]
+	// RedelegationCooldownQueue key: end of the cooldown | delAddr, used to prune the expired cooldowns
+	RedelegationCooldownQueue collections.KeySet[collections.Pair[time.Time, []byte]]
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
@@ -271,6 +275,21 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.BytesKey, sdk.TimeKey),
 		),
 
+		// key format is: 107 | delAddr
+		RedelegationCooldowns: collections.NewMap(
+			sb, types.RedelegationCooldownKey,
+			"redelegation_cooldowns",
+			collections.BytesKey,
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
+
+		// key format is: 108 | time | delAddr
+		RedelegationCooldownQueue: collections.NewKeySet(
+			sb, types.RedelegationCooldownQueueKey,
+			"redelegation_cooldown_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey),
+		),
+
 		// key format is: 103 | time
 		ValidatorConsensusKeyRotationRecordQueue: collections.NewMap(
 			sb, types.ValidatorConsensusKeyRotationRecordQueueKey,
@@ -286,7 +286,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"3f96c33eb2a13da59eebd0477d7900267bffebbe1c6e106b6cb60952749890bd",
 	)
 	s.Require().NoError(err)
 
@@ -301,7 +301,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"3f96c33eb2a13da59eebd0477d7900267bffebbe1c6e106b6cb60952749890bd",
 	)
 	s.Require().NoError(err)
 }
@@ -319,7 +319,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"587c900a538bdedffabf9eddef416332f89c7488471a2cebec6d39e0c58ac476",
 	)
 	s.Require().NoError(err)
 
@@ -332,7 +332,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"587c900a538bdedffabf9eddef416332f89c7488471a2cebec6d39e0c58ac476",
 	)
 
 	s.Require().NoError(err)
@@ -351,7 +351,10 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		// the hashed store holds the params set by SetupTest, so the hashes of these
+		// tests change with the encoding of the params, e.g. when adding the always
+		// encoded redelegation_cooldown
+		"53f2ac385039cdf5f37317c297b87183af6b29a95590bd0e6be8d2c95b6271d3",
 	)
 	s.Require().NoError(err)
 
@@ -364,7 +367,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"53f2ac385039cdf5f37317c297b87183af6b29a95590bd0e6be8d2c95b6271d3",
 	)
 
 	s.Require().NoError(err)
@@ -395,7 +398,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"59ccfd7f6fcda70632cff301b74f488c2033d3f0197db0dad50401e2e6de0dea",
 	)
 	s.Require().NoError(err)
 
@@ -419,7 +422,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"59ccfd7f6fcda70632cff301b74f488c2033d3f0197db0dad50401e2e6de0dea",
 	)
 	s.Require().NoError(err)
 }
@@ -436,7 +439,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"2c57f82021656233c1f5bf11caa76bdf25b3d559e44bb928cad340a4ccab716c",
 	)
 	s.Require().NoError(err)
 
@@ -449,7 +452,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"2c57f82021656233c1f5bf11caa76bdf25b3d559e44bb928cad340a4ccab716c",
 	)
 	s.Require().NoError(err)
 }
@@ -483,7 +486,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"dfe70e75a9f6c56e75e63b8377e325fc16849ef1b582a72fd85a5bc486854f1d",
 	)
 	s.Require().NoError(err)
 
@@ -509,7 +512,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"dfe70e75a9f6c56e75e63b8377e325fc16849ef1b582a72fd85a5bc486854f1d",
 	)
 	s.Require().NoError(err)
 }
@@ -532,7 +535,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"821f5967a2f325b4a4451fd1c79e13509b75718aab5d3e0274030f329a5ec57f",
 	)
 	s.Require().NoError(err)
 
@@ -547,7 +550,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"821f5967a2f325b4a4451fd1c79e13509b75718aab5d3e0274030f329a5ec57f",
 	)
 	s.Require().NoError(err)
 }
@@ -575,7 +578,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ece5f2943231e260b018b895409bb7aa8d607b1ed2373bf4af9b039c49f8c075",
 	)
 	s.Require().NoError(err)
 
@@ -597,7 +600,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ece5f2943231e260b018b895409bb7aa8d607b1ed2373bf4af9b039c49f8c075",
 	)
 	s.Require().NoError(err)
 }
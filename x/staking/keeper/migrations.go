@@ -47,3 +47,15 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7. It bumps
+// the commission rate of the validators below the minimum commission rate, which
+// could have been registered in genesis or before the minimum was enforced.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	minRate, err := m.keeper.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.BumpCommissionRatesToMinimum(ctx, minRate)
+}
@@ -598,7 +598,7 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	if err := msg.Params.Validate(k.validatorAddressCodec); err != nil {
 		return nil, err
 	}
 
@@ -615,27 +615,8 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 
 	// when min commission rate is updated, we need to update the commission rate of all validators
 	if !previousParams.MinCommissionRate.Equal(msg.Params.MinCommissionRate) {
-		minRate := msg.Params.MinCommissionRate
-
-		vals, err := k.GetAllValidators(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, val := range vals {
-			// set the commission rate to min rate
-			if val.Commission.CommissionRates.Rate.LT(minRate) {
-				val.Commission.CommissionRates.Rate = minRate
-				// set the max rate to minRate if it is less than min rate
-				if val.Commission.CommissionRates.MaxRate.LT(minRate) {
-					val.Commission.CommissionRates.MaxRate = minRate
-				}
-
-				val.Commission.UpdateTime = k.HeaderService.HeaderInfo(ctx).Time
-				if err := k.SetValidator(ctx, val); err != nil {
-					return nil, fmt.Errorf("failed to set validator after MinCommissionRate param change: %w", err)
-				}
-			}
+		if err := k.BumpCommissionRatesToMinimum(ctx, msg.Params.MinCommissionRate); err != nil {
+			return nil, fmt.Errorf("failed to bump commission rates after MinCommissionRate param change: %w", err)
 		}
 	}
 
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkRedelegationRestrictions enforces the redelegation restrictions set in
+// the params on a redelegation from valSrcAddr to valDstAddr: the denied
+// redelegation routes, the maximum number of redelegation entries in progress
+// for the delegator, and the cooldown between two of its redelegations.
+func (k Keeper) checkRedelegationRestrictions(
+	ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range params.DeniedRedelegationRoutes {
+		denied, err := k.routeMatches(route, valSrcAddr, valDstAddr)
+		if err != nil {
+			return err
+		}
+		if denied {
+			return errorsmod.Wrapf(types.ErrRedelegationRouteDenied, "%s -> %s", route.SrcValidatorAddress, route.DstValidatorAddress)
+		}
+	}
+
+	if params.MaxRedelegationHops > 0 {
+		hops, err := k.redelegationEntriesCount(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+		if hops >= uint64(params.MaxRedelegationHops) {
+			return errorsmod.Wrapf(types.ErrMaxRedelegationHops, "%d redelegations in progress, max %d", hops, params.MaxRedelegationHops)
+		}
+	}
+
+	cooldownEnd, err := k.RedelegationCooldowns.Get(ctx, delAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return err
+	case k.HeaderService.HeaderInfo(ctx).Time.Before(cooldownEnd):
+		return errorsmod.Wrapf(types.ErrRedelegationCooldown, "next redelegation allowed at %s", cooldownEnd)
+	default:
+		if err := k.removeRedelegationCooldown(ctx, delAddr, cooldownEnd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startRedelegationCooldown starts the redelegation cooldown of the delegator,
+// if the params set one.
+func (k Keeper) startRedelegationCooldown(ctx context.Context, delAddr sdk.AccAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.RedelegationCooldown == 0 {
+		return nil
+	}
+
+	return k.SetRedelegationCooldown(ctx, delAddr, k.HeaderService.HeaderInfo(ctx).Time.Add(params.RedelegationCooldown))
+}
+
+// SetRedelegationCooldown sets the end of the redelegation cooldown of the
+// delegator, replacing the one in progress.
+func (k Keeper) SetRedelegationCooldown(ctx context.Context, delAddr sdk.AccAddress, end time.Time) error {
+	prevEnd, err := k.RedelegationCooldowns.Get(ctx, delAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		if err := k.RedelegationCooldownQueue.Remove(ctx, collections.Join(prevEnd, delAddr.Bytes())); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RedelegationCooldowns.Set(ctx, delAddr, end); err != nil {
+		return err
+	}
+	return k.RedelegationCooldownQueue.Set(ctx, collections.Join(end, delAddr.Bytes()))
+}
+
+// PruneExpiredRedelegationCooldowns removes the redelegation cooldowns ended
+// at the given time.
+func (k Keeper) PruneExpiredRedelegationCooldowns(ctx context.Context, currTime time.Time) error {
+	var expired []collections.Pair[time.Time, []byte]
+	rng := collections.NewPrefixUntilPairRange[time.Time, []byte](currTime)
+	err := k.RedelegationCooldownQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, []byte]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.removeRedelegationCooldown(ctx, key.K2(), key.K1()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeRedelegationCooldown removes the redelegation cooldown of the delegator
+// ending at the given time.
+func (k Keeper) removeRedelegationCooldown(ctx context.Context, delAddr sdk.AccAddress, end time.Time) error {
+	if err := k.RedelegationCooldowns.Remove(ctx, delAddr); err != nil {
+		return err
+	}
+	return k.RedelegationCooldownQueue.Remove(ctx, collections.Join(end, delAddr.Bytes()))
+}
+
+// routeMatches returns whether the redelegation route matches a redelegation
+// from valSrcAddr to valDstAddr, an empty validator address matching any
+// validator.
+func (k Keeper) routeMatches(route types.RedelegationRoute, valSrcAddr, valDstAddr sdk.ValAddress) (bool, error) {
+	for _, v := range []struct {
+		route string
+		addr  sdk.ValAddress
+	}{{route.SrcValidatorAddress, valSrcAddr}, {route.DstValidatorAddress, valDstAddr}} {
+		if v.route == "" {
+			continue
+		}
+
+		bz, err := k.validatorAddressCodec.StringToBytes(v.route)
+		if err != nil {
+			return false, err
+		}
+		if !bytes.Equal(bz, v.addr) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// redelegationEntriesCount returns the number of redelegation entries of the
+// delegator which are not completed yet.
+func (k Keeper) redelegationEntriesCount(ctx context.Context, delAddr sdk.AccAddress) (uint64, error) {
+	var count uint64
+	rng := collections.NewPrefixedTripleRange[[]byte, []byte, []byte](delAddr)
+	err := k.Redelegations.Walk(ctx, rng, func(_ collections.Triple[[]byte, []byte, []byte], red types.Redelegation) (bool, error) {
+		count += uint64(len(red.Entries))
+		return false, nil
+	})
+
+	return count, err
+}
@@ -413,3 +413,119 @@ func (k Keeper) SlashRedelegation(ctx context.Context, srcValidator types.Valida
 
 	return totalSlashAmount, nil
 }
+
+// SimulateSlash computes, without modifying any state, the token value of a
+// delegator's delegation and unbonding delegation entries on a validator if
+// that validator were slashed by slashFactor for an infraction committed at
+// infractionHeight. It mirrors the accounting performed by Slash, using the
+// current potential consensus power of the validator as its power at the time
+// of the infraction.
+func (k Keeper) SimulateSlash(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, infractionHeight int64, slashFactor math.LegacyDec) (
+	balance, slashedBalance math.Int, unbondingEntries []types.SlashSimulationUnbondingEntry, err error,
+) {
+	if slashFactor.IsNegative() || slashFactor.GT(math.LegacyOneDec()) {
+		return math.ZeroInt(), math.ZeroInt(), nil, fmt.Errorf("slash factor must be between 0 and 1, got %v", slashFactor)
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if infractionHeight > height {
+		return math.ZeroInt(), math.ZeroInt(), nil, fmt.Errorf(
+			"cannot simulate slash for future infraction at height %d, current height is %d", infractionHeight, height)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	if validator.IsUnbonded() {
+		return math.ZeroInt(), math.ZeroInt(), nil, fmt.Errorf("cannot slash unbonded validator: %s", validator.GetOperator())
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	power := validator.PotentialConsensusPower(k.PowerReduction(ctx))
+	remainingSlashAmount := slashFactor.MulInt(k.TokensFromConsensusPower(ctx, power)).TruncateInt()
+
+	// exposedAmount returns the amount that Slash would attempt to slash from
+	// an unbonding or redelegation entry.
+	exposedAmount := func(creationHeight int64, initialBalance math.Int, mature bool) math.Int {
+		if creationHeight < infractionHeight || mature {
+			return math.ZeroInt()
+		}
+		return slashFactor.MulInt(initialBalance).TruncateInt()
+	}
+
+	// Slash special-cases infractions at the current height and does not look
+	// through unbonding delegations and redelegations.
+	if infractionHeight < height {
+		unbondingDelegations, err := k.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+
+		for _, ubd := range unbondingDelegations {
+			for _, entry := range ubd.Entries {
+				slashAmount := exposedAmount(entry.CreationHeight, entry.InitialBalance, entry.IsMature(now) && !entry.OnHold())
+				remainingSlashAmount = remainingSlashAmount.Sub(slashAmount)
+
+				if ubd.DelegatorAddress == delAddrStr {
+					unbondingEntries = append(unbondingEntries, types.SlashSimulationUnbondingEntry{
+						CreationHeight: entry.CreationHeight,
+						Balance:        entry.Balance,
+						SlashedBalance: entry.Balance.Sub(math.MinInt(slashAmount, entry.Balance)),
+					})
+				}
+			}
+		}
+
+		redelegations, err := k.GetRedelegationsFromSrcValidator(ctx, valAddr)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+
+		for _, red := range redelegations {
+			for _, entry := range red.Entries {
+				slashAmount := exposedAmount(entry.CreationHeight, entry.InitialBalance, entry.IsMature(now) && !entry.OnHold())
+				remainingSlashAmount = remainingSlashAmount.Sub(slashAmount)
+			}
+		}
+	} else {
+		ubd, err := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+		if err != nil && !errors.Is(err, types.ErrNoUnbondingDelegation) {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+
+		for _, entry := range ubd.Entries {
+			unbondingEntries = append(unbondingEntries, types.SlashSimulationUnbondingEntry{
+				CreationHeight: entry.CreationHeight,
+				Balance:        entry.Balance,
+				SlashedBalance: entry.Balance,
+			})
+		}
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if len(unbondingEntries) == 0 {
+			return math.ZeroInt(), math.ZeroInt(), nil, types.ErrNoDelegation
+		}
+		return math.ZeroInt(), math.ZeroInt(), unbondingEntries, nil
+	case err != nil:
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	// cannot decrease balance below zero
+	tokensToBurn := math.MinInt(remainingSlashAmount, validator.Tokens)
+	tokensToBurn = math.MaxInt(tokensToBurn, math.ZeroInt())
+
+	balance = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	slashedBalance = validator.RemoveTokens(tokensToBurn).TokensFromShares(delegation.Shares).TruncateInt()
+
+	return balance, slashedBalance, unbondingEntries, nil
+}
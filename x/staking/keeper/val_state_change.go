@@ -121,6 +121,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 		return nil, err
 	}
 
+	err = k.PruneExpiredRedelegationCooldowns(ctx, time)
+	if err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
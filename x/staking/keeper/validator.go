@@ -11,6 +11,7 @@ import (
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -208,6 +209,45 @@ func (k Keeper) UpdateValidatorCommission(ctx context.Context,
 	return commission, nil
 }
 
+// BumpCommissionRatesToMinimum sets the commission rate of the validators whose
+// rate is below minRate to minRate, along with their max rate if it is below
+// minRate too. An event is emitted for each bumped validator.
+func (k Keeper) BumpCommissionRatesToMinimum(ctx context.Context, minRate math.LegacyDec) error {
+	vals, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+	for _, val := range vals {
+		if !val.Commission.CommissionRates.Rate.LT(minRate) {
+			continue
+		}
+
+		previousRate := val.Commission.CommissionRates.Rate
+		val.Commission.CommissionRates.Rate = minRate
+		if val.Commission.CommissionRates.MaxRate.LT(minRate) {
+			val.Commission.CommissionRates.MaxRate = minRate
+		}
+
+		val.Commission.UpdateTime = blockTime
+		if err := k.SetValidator(ctx, val); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeBumpCommissionRate,
+			event.NewAttribute(types.AttributeKeyValidator, val.OperatorAddress),
+			event.NewAttribute(types.AttributeKeyPreviousRate, previousRate.String()),
+			event.NewAttribute(types.AttributeKeyCommissionRate, minRate.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RemoveValidator removes the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) error {
@@ -395,6 +395,50 @@ func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMigrate6to7BumpsCommissionRates() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	rates := []struct {
+		rate, maxRate       math.LegacyDec
+		expRate, expMaxRate math.LegacyDec
+	}{
+		{math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(2, 2), math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(5, 2)},
+		{math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(3, 1), math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(3, 1)},
+		{math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(3, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(3, 1)},
+	}
+
+	vals := make([]stakingtypes.Validator, len(rates))
+	for i, r := range rates {
+		vals[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		vals[i], err = vals[i].SetInitialCommission(stakingtypes.NewCommission(r.rate, r.maxRate, math.LegacyZeroDec()))
+		require.NoError(err)
+		require.NoError(keeper.SetValidator(ctx, vals[i]))
+	}
+
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate6to7(ctx))
+
+	for i, r := range rates {
+		val, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.Equal(r.expRate, val.Commission.Rate, "validator %d", i)
+		require.Equal(r.expMaxRate, val.Commission.MaxRate, "validator %d", i)
+	}
+
+	var bumped int
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == stakingtypes.EventTypeBumpCommissionRate {
+			bumped++
+		}
+	}
+	require.Equal(2, bumped)
+}
+
 func (s *KeeperTestSuite) TestValidatorToken() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
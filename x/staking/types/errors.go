@@ -52,4 +52,9 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// redelegation restriction errors
+	ErrMaxRedelegationHops     = errors.Register(ModuleName, 49, "too many redelegations in progress for delegator")
+	ErrRedelegationRouteDenied = errors.Register(ModuleName, 50, "redelegation route is denied")
+	ErrRedelegationCooldown    = errors.Register(ModuleName, 51, "redelegation cooldown has not elapsed for delegator")
 )
@@ -10,9 +10,11 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeBumpCommissionRate        = "bump_commission_rate"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyPreviousRate      = "previous_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -46,6 +50,8 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// redelegation_cooldowns defines the redelegation cooldowns in progress at genesis.
+	RedelegationCooldowns []RedelegationCooldown `protobuf:"bytes,9,rep,name=redelegation_cooldowns,json=redelegationCooldowns,proto3" json:"redelegation_cooldowns"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +136,13 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetRedelegationCooldowns() []RedelegationCooldown {
+	if m != nil {
+		return m.RedelegationCooldowns
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -171,9 +184,65 @@ func (m *LastValidatorPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
+// RedelegationCooldown defines the end of the redelegation cooldown of a delegator.
+type RedelegationCooldown struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// end_time is the time from which the delegator can redelegate again.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *RedelegationCooldown) Reset()         { *m = RedelegationCooldown{} }
+func (m *RedelegationCooldown) String() string { return proto.CompactTextString(m) }
+func (*RedelegationCooldown) ProtoMessage()    {}
+func (*RedelegationCooldown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
+}
+func (m *RedelegationCooldown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationCooldown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationCooldown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationCooldown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationCooldown.Merge(m, src)
+}
+func (m *RedelegationCooldown) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationCooldown) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationCooldown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationCooldown proto.InternalMessageInfo
+
+func (m *RedelegationCooldown) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *RedelegationCooldown) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
+	proto.RegisterType((*RedelegationCooldown)(nil), "cosmos.staking.v1beta1.RedelegationCooldown")
 }
 
 func init() {
@@ -181,39 +250,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x4f, 0xdb, 0x40,
+	0x18, 0xc6, 0xe3, 0x52, 0x42, 0xb8, 0x40, 0x0b, 0x47, 0x82, 0xdc, 0x08, 0x39, 0x69, 0xc4, 0x10,
+	0xd1, 0xc6, 0x86, 0x54, 0xed, 0xc0, 0x46, 0x4a, 0x55, 0x21, 0x21, 0x15, 0x05, 0xe8, 0xc0, 0x62,
+	0x5d, 0xb8, 0xab, 0xb1, 0x62, 0xdf, 0x59, 0xbe, 0xe3, 0x4f, 0xc7, 0x6e, 0x1d, 0xd9, 0xbb, 0x30,
+	0x76, 0xec, 0xc0, 0xd0, 0x8f, 0xc0, 0x88, 0x98, 0xaa, 0x0e, 0xb4, 0x82, 0xa1, 0xfd, 0x18, 0x95,
+	0xef, 0x6c, 0xd7, 0x28, 0xb1, 0xc4, 0x12, 0xe5, 0xee, 0x7d, 0x9e, 0xdf, 0xf3, 0xfa, 0x74, 0xf7,
+	0x82, 0xc5, 0x7d, 0xc6, 0x7d, 0xc6, 0x2d, 0x2e, 0xd0, 0xc0, 0xa5, 0x8e, 0x75, 0xb4, 0xd2, 0x27,
+	0x02, 0xad, 0x58, 0x0e, 0xa1, 0x84, 0xbb, 0xdc, 0x0c, 0x42, 0x26, 0x18, 0x9c, 0x57, 0x2a, 0x33,
+	0x56, 0x99, 0xb1, 0xaa, 0x56, 0x71, 0x98, 0xc3, 0xa4, 0xc4, 0x8a, 0xfe, 0x29, 0x75, 0x2d, 0x8f,
+	0x99, 0xb8, 0x95, 0xea, 0x89, 0x52, 0xd9, 0xca, 0x1e, 0x07, 0xa8, 0xd2, 0x2c, 0xf2, 0x5d, 0xca,
+	0x2c, 0xf9, 0x1b, 0x6f, 0xd5, 0x1d, 0xc6, 0x1c, 0x8f, 0x58, 0x72, 0xd5, 0x3f, 0xfc, 0x60, 0x09,
+	0xd7, 0x27, 0x5c, 0x20, 0x3f, 0x50, 0x82, 0xe6, 0x97, 0x22, 0x98, 0x7a, 0xab, 0x9a, 0xde, 0x16,
+	0x48, 0x10, 0xb8, 0x06, 0x8a, 0x01, 0x0a, 0x91, 0xcf, 0x75, 0xad, 0xa1, 0xb5, 0xca, 0x1d, 0xc3,
+	0x1c, 0xfd, 0x11, 0xe6, 0x96, 0x54, 0x75, 0x27, 0x2f, 0xae, 0xeb, 0x85, 0xaf, 0x7f, 0xbe, 0x2d,
+	0x69, 0xbd, 0xd8, 0x08, 0xf7, 0xc0, 0x8c, 0x87, 0xb8, 0xb0, 0x05, 0x13, 0xc8, 0xb3, 0x03, 0x76,
+	0x4c, 0x42, 0xfd, 0x41, 0x43, 0x6b, 0x4d, 0x75, 0x97, 0x23, 0xf1, 0xcf, 0xeb, 0x7a, 0x55, 0x31,
+	0x39, 0x1e, 0x98, 0x2e, 0xb3, 0x7c, 0x24, 0x0e, 0xcc, 0x0d, 0x2a, 0xae, 0xce, 0xdb, 0x20, 0x0e,
+	0xdb, 0xa0, 0x42, 0x31, 0x1f, 0x45, 0xa4, 0x9d, 0x08, 0xb4, 0x15, 0x71, 0xa0, 0x0b, 0xaa, 0x92,
+	0x7d, 0x84, 0x3c, 0x17, 0x23, 0xc1, 0x42, 0xc5, 0xe7, 0xfa, 0x58, 0x63, 0xac, 0x55, 0xee, 0x2c,
+	0xe5, 0x75, 0xbb, 0x89, 0xb8, 0x78, 0x9f, 0x78, 0x24, 0x2a, 0xdb, 0xf9, 0x9c, 0x37, 0x54, 0xe6,
+	0x70, 0x13, 0x80, 0x34, 0x85, 0xeb, 0x0f, 0x25, 0xff, 0x69, 0x1e, 0x3f, 0x35, 0x67, 0xb1, 0x19,
+	0x3f, 0x7c, 0x07, 0xca, 0x98, 0x78, 0xc4, 0x41, 0xc2, 0x65, 0x94, 0xeb, 0xe3, 0x12, 0xd7, 0xcc,
+	0xc3, 0xad, 0xa7, 0xd2, 0x2c, 0x2f, 0x4b, 0x80, 0x03, 0x50, 0x3d, 0xa4, 0x7d, 0x46, 0xb1, 0x4b,
+	0x1d, 0x3b, 0x8b, 0x2e, 0x4a, 0xf4, 0xb3, 0x3c, 0xf4, 0x6e, 0x62, 0x1a, 0x9d, 0x51, 0x39, 0x1c,
+	0xae, 0x73, 0xb8, 0x0b, 0xa6, 0x43, 0x92, 0x0d, 0x99, 0x90, 0x21, 0x8b, 0x79, 0x21, 0x3d, 0x82,
+	0x47, 0xd2, 0xef, 0x52, 0x60, 0x0d, 0x94, 0xc8, 0x49, 0xc0, 0x42, 0x41, 0xb0, 0x5e, 0x6a, 0x68,
+	0xad, 0x52, 0x2f, 0x5d, 0xc3, 0x4f, 0x1a, 0x98, 0xcf, 0xaa, 0xed, 0x7d, 0xc6, 0x3c, 0xcc, 0x8e,
+	0x29, 0xd7, 0x27, 0x65, 0xf8, 0xf3, 0xfb, 0x84, 0xbf, 0x8e, 0x4d, 0xdd, 0x05, 0x79, 0xf5, 0xce,
+	0xdb, 0x8f, 0x95, 0xa9, 0xcd, 0xf1, 0xa0, 0xb1, 0x6c, 0xbe, 0xec, 0xa8, 0xbe, 0xaa, 0xe1, 0x08,
+	0x0f, 0x6f, 0x1e, 0x00, 0x38, 0x7c, 0x71, 0x60, 0x07, 0x4c, 0x20, 0x8c, 0x43, 0xc2, 0xd5, 0x1b,
+	0x99, 0xec, 0xea, 0x57, 0xe7, 0xed, 0x4a, 0xdc, 0xcc, 0x9a, 0xaa, 0x6c, 0x8b, 0xd0, 0xa5, 0x4e,
+	0x2f, 0x11, 0xc2, 0x0a, 0x18, 0xff, 0xff, 0x10, 0xc6, 0x7a, 0x6a, 0xb1, 0x5a, 0xfa, 0x7c, 0x56,
+	0x2f, 0xfc, 0x3d, 0xab, 0x17, 0x9a, 0xdf, 0x35, 0x50, 0x19, 0xd5, 0x37, 0x7c, 0x03, 0x66, 0xe3,
+	0x5d, 0x16, 0xda, 0xf7, 0x8d, 0x9d, 0x49, 0x2d, 0xf1, 0x3e, 0x5c, 0x07, 0x25, 0x42, 0xb1, 0x1d,
+	0x3d, 0x7f, 0xd9, 0x42, 0xb9, 0x53, 0x33, 0xd5, 0x6c, 0x30, 0x93, 0xd9, 0x60, 0xee, 0x24, 0xb3,
+	0xa1, 0x3b, 0x1d, 0x1d, 0xd6, 0xe9, 0xaf, 0xba, 0xa6, 0x4e, 0x67, 0x82, 0x50, 0x1c, 0x15, 0x57,
+	0xe7, 0xae, 0x86, 0x0f, 0xb0, 0xfb, 0xea, 0xe2, 0xc6, 0xd0, 0x2e, 0x6f, 0x0c, 0xed, 0xf7, 0x8d,
+	0xa1, 0x9d, 0xde, 0x1a, 0x85, 0xcb, 0x5b, 0xa3, 0xf0, 0xe3, 0xd6, 0x28, 0xec, 0x2d, 0xdc, 0x79,
+	0xe6, 0x27, 0xe9, 0x64, 0x13, 0x1f, 0x03, 0xc2, 0xfb, 0x45, 0x19, 0xfc, 0xe2, 0xdf, 0x00, 0xd4,
+	0x8f, 0x8f, 0x93, 0x4c, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +313,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RedelegationCooldowns) > 0 {
+		for iNdEx := len(m.RedelegationCooldowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationCooldowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -374,6 +465,44 @@ func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedelegationCooldown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationCooldown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -428,6 +557,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.RedelegationCooldowns) > 0 {
+		for _, e := range m.RedelegationCooldowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -447,6 +582,21 @@ func (m *LastValidatorPower) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationCooldown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -738,6 +888,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationCooldowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationCooldowns = append(m.RedelegationCooldowns, RedelegationCooldown{})
+			if err := m.RedelegationCooldowns[len(m.RedelegationCooldowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -860,6 +1044,121 @@ func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationCooldown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -68,6 +68,9 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	RedelegationCooldownKey      = collections.NewPrefix(107) // prefix for the end of the redelegation cooldown of each delegator
+	RedelegationCooldownQueueKey = collections.NewPrefix(108) // prefix for the redelegation cooldowns by end time
 )
 
 // Reserved kvstore keys
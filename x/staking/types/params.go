@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -89,8 +90,8 @@ func UnmarshalParams(cdc *codec.LegacyAmino, value []byte) (params Params, err e
 	return
 }
 
-// validate a set of params
-func (p Params) Validate() error {
+// validate a set of params, the validator addresses being decoded with valAc
+func (p Params) Validate(valAc address.Codec) error {
 	if err := validateUnbondingTime(p.UnbondingTime); err != nil {
 		return err
 	}
@@ -119,6 +120,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRedelegationCooldown(p.RedelegationCooldown); err != nil {
+		return err
+	}
+
+	if err := validateDeniedRedelegationRoutes(p.DeniedRedelegationRoutes, valAc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,3 +243,47 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateRedelegationCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("redelegation cooldown must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDeniedRedelegationRoutes(i interface{}, valAc address.Codec) error {
+	v, ok := i.([]RedelegationRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[RedelegationRoute]bool, len(v))
+	for _, route := range v {
+		if route.SrcValidatorAddress == "" && route.DstValidatorAddress == "" {
+			return errors.New("denied redelegation route must have a source or a destination validator")
+		}
+		for _, addr := range []string{route.SrcValidatorAddress, route.DstValidatorAddress} {
+			if addr == "" {
+				continue
+			}
+			if _, err := valAc.StringToBytes(addr); err != nil {
+				return fmt.Errorf("invalid denied redelegation route validator address %s: %w", addr, err)
+			}
+		}
+		if route.SrcValidatorAddress == route.DstValidatorAddress {
+			return fmt.Errorf("denied redelegation route has the same source and destination validator: %s", route.SrcValidatorAddress)
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate denied redelegation route: %s -> %s", route.SrcValidatorAddress, route.DstValidatorAddress)
+		}
+		seen[route] = true
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -27,23 +28,46 @@ func TestParamsEqual(t *testing.T) {
 
 func TestValidateParams(t *testing.T) {
 	params := types.DefaultParams()
+	valAc := address.NewBech32Codec("cosmosvaloper")
+	val1, err := valAc.BytesToString(sdk.ValAddress("val1"))
+	require.NoError(t, err)
+	val2, err := valAc.BytesToString(sdk.ValAddress("val2"))
+	require.NoError(t, err)
 
 	coinZero := sdk.NewInt64Coin("stake", 0)
 
 	// default params have no error
-	require.NoError(t, params.Validate())
+	require.NoError(t, params.Validate(valAc))
 
 	// validate min commission rate
 	params.MinCommissionRate = math.LegacyNewDec(-1)
-	require.Error(t, params.Validate())
+	require.Error(t, params.Validate(valAc))
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
-	require.Error(t, params.Validate())
+	require.Error(t, params.Validate(valAc))
 
 	// reset params to default
 	params = types.DefaultParams()
 
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
-	require.Error(t, params.Validate())
+	require.Error(t, params.Validate(valAc))
+
+	// check redelegation restrictions
+	params = types.DefaultParams()
+	params.RedelegationCooldown = -1
+	require.Error(t, params.Validate(valAc))
+
+	params = types.DefaultParams()
+	params.DeniedRedelegationRoutes = []types.RedelegationRoute{{}}
+	require.Error(t, params.Validate(valAc))
+
+	params.DeniedRedelegationRoutes = []types.RedelegationRoute{{SrcValidatorAddress: val1}, {SrcValidatorAddress: val1}}
+	require.Error(t, params.Validate(valAc))
+
+	params.DeniedRedelegationRoutes = []types.RedelegationRoute{{SrcValidatorAddress: val1}, {DstValidatorAddress: "val2"}}
+	require.ErrorContains(t, params.Validate(valAc), "invalid denied redelegation route validator address val2")
+
+	params.DeniedRedelegationRoutes = []types.RedelegationRoute{{SrcValidatorAddress: val1}, {SrcValidatorAddress: val1, DstValidatorAddress: val2}}
+	require.NoError(t, params.Validate(valAc))
 }
@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "PlanHistory",
+					Use:       "plan-history",
+					Short:     "Query the history of the upgrade plans",
+					Long:      "Gets the actions performed on the upgrade plans (scheduling, cancellation, rescheduling, skipping and application), from the oldest to the most recent one",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +61,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod:   "RescheduleUpgrade",
+					Use:         "reschedule-upgrade-proposal [name] [height]",
+					Short:       "Submit a proposal to move a planned chain upgrade to another height.",
+					GovProposal: true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "height"},
+					},
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
@@ -55,6 +55,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	var (
 		homePath           string
 		skipUpgradeHeights = make(map[int64]bool)
+		timeWarp           bool
 	)
 
 	if in.AppOpts != nil {
@@ -63,6 +64,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		}
 
 		homePath = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		timeWarp = cast.ToBool(in.AppOpts.Get(server.FlagUnsafeUpgradeTimeWarp))
 	}
 
 	// default to governance authority if not provided
@@ -78,6 +80,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr)
+	k.SetTimeWarp(timeWarp)
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
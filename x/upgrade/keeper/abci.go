@@ -65,6 +65,12 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 		return nil
 	}
 
+	if k.timeWarp && plan.Height > blockHeight+1 {
+		if err := k.warpToUpgrade(sdkCtx, plan, blockHeight); err != nil {
+			return err
+		}
+	}
+
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(blockHeight) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
@@ -76,7 +82,7 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 			if err := k.ClearUpgradePlan(ctx); err != nil {
 				return err
 			}
-			return nil
+			return k.recordPlanAction(ctx, types.PLAN_ACTION_SKIPPED, plan, 0)
 		}
 
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
@@ -116,6 +122,19 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 	return nil
 }
 
+// warpToUpgrade reschedules the pending upgrade plan at the block following the
+// given height. It refuses to do so when the last block was signed by more than
+// one validator, as the time warp is only meant for local single-node chains.
+func (k Keeper) warpToUpgrade(ctx sdk.Context, plan types.Plan, blockHeight int64) error {
+	if votes := len(ctx.CometInfo().LastCommit.Votes); votes > 1 {
+		k.Logger.Error("upgrade time warp is disabled, the chain has more than one validator", "validators", votes)
+		return nil
+	}
+
+	k.Logger.Info(fmt.Sprintf("upgrade time warp: rescheduling upgrade \"%s\" from height %d to %d", plan.Name, plan.Height, blockHeight+1))
+	return k.RescheduleUpgrade(ctx, plan.Name, blockHeight+1)
+}
+
 // BuildUpgradeNeededMsg prints the message that notifies that an upgrade is needed.
 func BuildUpgradeNeededMsg(plan types.Plan) string {
 	return fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -160,6 +161,34 @@ func TestDoHeightUpgrade(t *testing.T) {
 	s.VerifyDoUpgrade(t)
 }
 
+func TestTimeWarpUpgrade(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 1000})
+	require.NoError(t, err)
+
+	t.Log("Verify that the plan is not rescheduled without time warp")
+	require.NoError(t, s.preModule.PreBlock(s.ctx))
+	plan, err := s.keeper.GetUpgradePlan(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), plan.Height)
+
+	t.Log("Verify that the plan is not rescheduled with more than one validator")
+	s.keeper.SetTimeWarp(true)
+	multiValCtx := s.ctx.WithCometInfo(comet.Info{LastCommit: comet.CommitInfo{Votes: make([]comet.VoteInfo, 2)}})
+	require.NoError(t, s.preModule.PreBlock(multiValCtx))
+	plan, err = s.keeper.GetUpgradePlan(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), plan.Height)
+
+	t.Log("Verify that the plan is rescheduled at the next block with time warp")
+	require.NoError(t, s.preModule.PreBlock(s.ctx))
+	plan, err = s.keeper.GetUpgradePlan(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, s.ctx.HeaderInfo().Height+1, plan.Height)
+
+	s.VerifyDoUpgrade(t)
+}
+
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	t.Log("Can overwrite plan")
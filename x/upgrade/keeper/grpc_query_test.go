@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type UpgradeTestSuite struct {
@@ -239,6 +240,34 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestPlanHistory() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 3})
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(ctx, plan))
+	suite.Require().NoError(suite.upgradeKeeper.RescheduleUpgrade(ctx, "test-plan", 8))
+	suite.Require().NoError(suite.upgradeKeeper.CancelUpgrade(ctx))
+
+	res, err := suite.queryClient.PlanHistory(context.Background(), &types.QueryPlanHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PlanHistoryEntry{
+		{Action: types.PLAN_ACTION_SCHEDULED, Plan: plan, BlockHeight: 3},
+		{Action: types.PLAN_ACTION_RESCHEDULED, Plan: types.Plan{Name: "test-plan", Height: 8}, BlockHeight: 3, PreviousHeight: 5},
+		{Action: types.PLAN_ACTION_CANCELLED, Plan: types.Plan{Name: "test-plan", Height: 8}, BlockHeight: 3},
+	}, res.Entries)
+
+	res, err = suite.queryClient.PlanHistory(context.Background(), &types.QueryPlanHistoryRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(types.PLAN_ACTION_RESCHEDULED, res.Entries[0].Action)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Require().Equal([]string{types.EventTypeUpgradeScheduled, types.EventTypeUpgradeRescheduled, types.EventTypeUpgradeCancelled}, eventTypes)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -40,6 +41,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	timeWarp           bool                            // tells if the pending upgrade plan is fast-forwarded to the next block, see SetTimeWarp.
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -72,6 +74,15 @@ func (k *Keeper) SetInitVersionMap(vm module.VersionMap) {
 	k.initVersionMap = vm
 }
 
+// SetTimeWarp enables the upgrade time warp, a dev-mode facility rehearsing an
+// upgrade on a local single-node chain. When enabled, a pending upgrade plan
+// scheduled after the next block is rescheduled at the next block, so that the
+// chain halts for the upgrade without waiting for the plan height.
+// It changes the state of the chain and must never be enabled on a network.
+func (k *Keeper) SetTimeWarp(enabled bool) {
+	k.timeWarp = enabled
+}
+
 // GetInitVersionMap gets the initial version map
 // This is only used in upgrade InitGenesis and should not be used in any other context.
 func (k *Keeper) GetInitVersionMap() module.VersionMap {
@@ -231,6 +242,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	if err := k.recordPlanAction(ctx, types.PLAN_ACTION_SCHEDULED, plan, 0); err != nil {
+		return err
+	}
+
 	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(plan.Height, 10))})
 
 	return nil
@@ -369,6 +384,134 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 	return store.Delete(types.PlanKey())
 }
 
+// CancelUpgrade cancels the scheduled upgrade if any, clearing its plan and
+// associated IBC states, and records the cancellation in the plan history.
+func (k Keeper) CancelUpgrade(ctx context.Context) error {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		// cancelling when no upgrade is scheduled is a no-op
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.ClearUpgradePlan(ctx); err != nil {
+		return err
+	}
+
+	return k.recordPlanAction(ctx, types.PLAN_ACTION_CANCELLED, plan, 0)
+}
+
+// RescheduleUpgrade moves the scheduled upgrade with the given name to another
+// height, along with the upgraded IBC client state, and records the move in the
+// plan history.
+func (k Keeper) RescheduleUpgrade(ctx context.Context, name string, height int64) error {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return err
+	}
+
+	if plan.Name != name {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "scheduled upgrade is %s, not %s", plan.Name, name)
+	}
+
+	if height <= 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
+	}
+
+	if height < k.HeaderService.HeaderInfo(ctx).Height {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be rescheduled in the past")
+	}
+
+	if height == plan.Height {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s is already scheduled at height %d", name, height)
+	}
+
+	// the upgraded client state is stored under the plan height, so it must
+	// be moved with the plan.
+	client, err := k.GetUpgradedClient(ctx, plan.Height)
+	if err != nil && !errors.Is(err, types.ErrNoUpgradedClientFound) {
+		return err
+	}
+
+	if err := k.ClearIBCState(ctx, plan.Height); err != nil {
+		return err
+	}
+
+	if client != nil {
+		if err := k.SetUpgradedClient(ctx, height, client); err != nil {
+			return err
+		}
+	}
+
+	previousHeight := plan.Height
+	plan.Height = height
+
+	bz, err := k.cdc.Marshal(&plan)
+	if err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	if err := store.Set(types.PlanKey(), bz); err != nil {
+		return err
+	}
+
+	if err := k.recordPlanAction(ctx, types.PLAN_ACTION_RESCHEDULED, plan, previousHeight); err != nil {
+		return err
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(plan.Height, 10))})
+
+	return nil
+}
+
+// recordPlanAction appends the action performed on the plan to the plan
+// history, and emits the matching event.
+func (k Keeper) recordPlanAction(ctx context.Context, action types.PlanAction, plan types.Plan, previousHeight int64) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+
+	// the next sequence follows the one of the last recorded entry
+	var sequence uint64
+	prefix := []byte{types.PlanHistoryByte}
+	it, err := store.ReverseIterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	if it.Valid() {
+		sequence = binary.BigEndian.Uint64(it.Key()[len(prefix):]) + 1
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	entry := types.PlanHistoryEntry{
+		Action:         action,
+		Plan:           plan,
+		BlockHeight:    k.HeaderService.HeaderInfo(ctx).Height,
+		PreviousHeight: previousHeight,
+	}
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(types.PlanHistoryKey(sequence), bz); err != nil {
+		return err
+	}
+
+	attrs := []event.Attribute{
+		event.NewAttribute(types.AttributeKeyName, plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+	}
+	if previousHeight != 0 {
+		attrs = append(attrs, event.NewAttribute(types.AttributeKeyPreviousHeight, strconv.FormatInt(previousHeight, 10)))
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(action.EventType(), attrs...)
+}
+
 // GetUpgradePlan returns the currently scheduled Plan if any. If not found it returns
 // ErrNoUpgradePlanFound, but other errors may be returned if there is an error reading from the store.
 func (k Keeper) GetUpgradePlan(ctx context.Context) (plan types.Plan, err error) {
@@ -452,7 +595,11 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
-	return k.setDone(ctx, plan.Name)
+	if err := k.setDone(ctx, plan.Name); err != nil {
+		return err
+	}
+
+	return k.recordPlanAction(ctx, types.PLAN_ACTION_APPLIED, plan, 0)
 }
 
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
@@ -22,8 +22,8 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 }
 
 var (
-	_    types.MsgServer = msgServer{}
-	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_       types.MsgServer = msgServer{}
+	_, _, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}, &types.MsgRescheduleUpgrade{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -46,10 +46,24 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
 	}
 
-	err := k.ClearUpgradePlan(ctx)
+	err := k.Keeper.CancelUpgrade(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// RescheduleUpgrade implements the Msg/RescheduleUpgrade Msg service.
+func (k msgServer) RescheduleUpgrade(ctx context.Context, msg *types.MsgRescheduleUpgrade) (*types.MsgRescheduleUpgradeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	err := k.Keeper.RescheduleUpgrade(ctx, msg.Name, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRescheduleUpgradeResponse{}, nil
+}
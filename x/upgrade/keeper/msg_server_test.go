@@ -130,3 +130,97 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRescheduleUpgrade() {
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "some name",
+		Info:   "some info",
+		Height: 123450000,
+	}))
+	s.Require().NoError(s.upgradeKeeper.SetUpgradedClient(s.ctx, 123450000, []byte("client")))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgRescheduleUpgrade
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAddrs[0],
+				Name:      "some name",
+				Height:    123460000,
+			},
+			true,
+			"expected authority account as only signer for proposal message",
+		},
+		{
+			"other plan name",
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Name:      "other name",
+				Height:    123460000,
+			},
+			true,
+			"scheduled upgrade is some name, not other name",
+		},
+		{
+			"height in the past",
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Name:      "some name",
+				Height:    5,
+			},
+			true,
+			"upgrade cannot be rescheduled in the past",
+		},
+		{
+			"same height",
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Name:      "some name",
+				Height:    123450000,
+			},
+			true,
+			"already scheduled at height 123450000",
+		},
+		{
+			"upgrade rescheduled successfully",
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Name:      "some name",
+				Height:    123460000,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.RescheduleUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				plan, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(int64(123460000), plan.Height)
+				s.Require().Equal("some info", plan.Info)
+
+				// the upgraded client follows the plan
+				_, err = s.upgradeKeeper.GetUpgradedClient(s.ctx, 123450000)
+				s.Require().ErrorIs(err, types.ErrNoUpgradedClientFound)
+				client, err := s.upgradeKeeper.GetUpgradedClient(s.ctx, 123460000)
+				s.Require().NoError(err)
+				s.Require().Equal([]byte("client"), client)
+			}
+		})
+	}
+
+	_, err := s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAuthority})
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.RescheduleUpgrade(s.ctx, &types.MsgRescheduleUpgrade{Authority: s.encodedAuthority, Name: "some name", Height: 123470000})
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+}
@@ -0,0 +1,33 @@
+package types
+
+// Upgrade module event types
+const (
+	EventTypeUpgradeScheduled   = "upgrade_scheduled"
+	EventTypeUpgradeCancelled   = "upgrade_cancelled"
+	EventTypeUpgradeRescheduled = "upgrade_rescheduled"
+	EventTypeUpgradeSkipped     = "upgrade_skipped"
+	EventTypeUpgradeApplied     = "upgrade_applied"
+
+	AttributeKeyName           = "name"
+	AttributeKeyHeight         = "height"
+	AttributeKeyPreviousHeight = "previous_height"
+)
+
+// EventType returns the type of the event emitted when the action is performed
+// on a plan.
+func (a PlanAction) EventType() string {
+	switch a {
+	case PLAN_ACTION_SCHEDULED:
+		return EventTypeUpgradeScheduled
+	case PLAN_ACTION_CANCELLED:
+		return EventTypeUpgradeCancelled
+	case PLAN_ACTION_RESCHEDULED:
+		return EventTypeUpgradeRescheduled
+	case PLAN_ACTION_SKIPPED:
+		return EventTypeUpgradeSkipped
+	case PLAN_ACTION_APPLIED:
+		return EventTypeUpgradeApplied
+	default:
+		return ""
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +31,9 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// PlanHistoryByte is a prefix to look up the actions performed on upgrade plans by sequence
+	PlanHistoryByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +50,13 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// PlanHistoryKey is the key under which the plan history entry with the given
+// sequence is saved. The sequence is big endian encoded so that the entries are
+// iterated in the order they were recorded.
+func PlanHistoryKey(sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{PlanHistoryByte}, sequence)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -484,6 +486,109 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryPlanHistoryRequest is the request type for the Query/PlanHistory RPC
+// method.
+type QueryPlanHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanHistoryRequest) Reset()         { *m = QueryPlanHistoryRequest{} }
+func (m *QueryPlanHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanHistoryRequest) ProtoMessage()    {}
+func (*QueryPlanHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryPlanHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanHistoryRequest.Merge(m, src)
+}
+func (m *QueryPlanHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPlanHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPlanHistoryResponse is the response type for the Query/PlanHistory RPC
+// method.
+type QueryPlanHistoryResponse struct {
+	// entries are the actions performed on the upgrade plans.
+	Entries []PlanHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanHistoryResponse) Reset()         { *m = QueryPlanHistoryResponse{} }
+func (m *QueryPlanHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanHistoryResponse) ProtoMessage()    {}
+func (*QueryPlanHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryPlanHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanHistoryResponse.Merge(m, src)
+}
+func (m *QueryPlanHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPlanHistoryResponse) GetEntries() []PlanHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPlanHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +600,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryPlanHistoryRequest)(nil), "cosmos.upgrade.v1beta1.QueryPlanHistoryRequest")
+	proto.RegisterType((*QueryPlanHistoryResponse)(nil), "cosmos.upgrade.v1beta1.QueryPlanHistoryResponse")
 }
 
 func init() {
@@ -502,50 +609,61 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0x65, 0x0a, 0x5f, 0xf8, 0xf2, 0xa9, 0x01, 0x33, 0xc4, 0xba, 0xac, 0xa4, 0xd6, 0x01, 0x15,
-	0x23, 0xdd, 0x85, 0xd6, 0x70, 0x40, 0x63, 0x14, 0x0e, 0x8a, 0x11, 0xa2, 0x35, 0x7a, 0xf0, 0xd2,
-	0x0c, 0xec, 0xa4, 0x34, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
-	0xcd, 0x83, 0x37, 0x13, 0xff, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0,
-	0x0f, 0x31, 0x3b, 0x33, 0x25, 0xfd, 0xb1, 0xbb, 0xa0, 0xb7, 0xce, 0xce, 0x7b, 0xef, 0xf3, 0xde,
-	0xce, 0xbc, 0x2d, 0x90, 0x75, 0x8f, 0x37, 0x3d, 0x6e, 0x87, 0x7e, 0x2d, 0xa0, 0x0e, 0xb3, 0xb7,
-	0xe7, 0xd6, 0x98, 0xa0, 0x73, 0xf6, 0x56, 0xc8, 0x82, 0x1d, 0xcb, 0x0f, 0x3c, 0xe1, 0xe1, 0x9c,
-	0xc2, 0x58, 0x1a, 0x63, 0x69, 0x8c, 0x39, 0x51, 0xf3, 0xbc, 0x5a, 0x83, 0xd9, 0xd4, 0xaf, 0xdb,
-	0xd4, 0x75, 0x3d, 0x41, 0x45, 0xdd, 0x73, 0xb9, 0x62, 0x99, 0x53, 0x09, 0xca, 0x2d, 0x15, 0x85,
-	0x1a, 0x57, 0xa8, 0xaa, 0x5c, 0xd9, 0x7a, 0x90, 0x5c, 0x90, 0x71, 0x38, 0xff, 0x38, 0x72, 0xb1,
-	0x14, 0x06, 0x01, 0x73, 0xc5, 0xa3, 0x06, 0x75, 0x2b, 0x6c, 0x2b, 0x64, 0x5c, 0x90, 0x87, 0x60,
-	0xf4, 0x6e, 0x71, 0xdf, 0x73, 0x39, 0xc3, 0xb3, 0x30, 0xe0, 0x37, 0xa8, 0x6b, 0xa0, 0x02, 0x9a,
-	0xce, 0x96, 0x26, 0xac, 0x78, 0xf3, 0x96, 0xe4, 0x48, 0x24, 0x29, 0xea, 0x41, 0x77, 0x7d, 0xbf,
-	0x51, 0x67, 0x4e, 0xdb, 0x20, 0x8c, 0x61, 0xc0, 0xa5, 0x4d, 0x26, 0xc5, 0x86, 0x2b, 0xf2, 0x37,
-	0x29, 0xe9, 0xe1, 0x1d, 0x70, 0x3d, 0x3c, 0x07, 0x83, 0x1b, 0xac, 0x5e, 0xdb, 0x10, 0x92, 0xd1,
-	0x5f, 0xd1, 0x2b, 0xb2, 0x0c, 0x44, 0x72, 0x9e, 0x2a, 0x17, 0xce, 0x52, 0x84, 0x76, 0x79, 0xc8,
-	0x9f, 0x08, 0x2a, 0x58, 0x6b, 0xda, 0x45, 0xc8, 0x36, 0x28, 0x17, 0xd5, 0x0e, 0x09, 0x88, 0x1e,
-	0xdd, 0x97, 0x4f, 0x16, 0x32, 0x06, 0x22, 0x2f, 0x61, 0x32, 0x55, 0x4a, 0x3b, 0x59, 0x01, 0x43,
-	0x47, 0x76, 0xaa, 0xeb, 0x2d, 0x48, 0x95, 0x47, 0x18, 0x23, 0x53, 0x40, 0xd3, 0x67, 0x16, 0xc7,
-	0x7e, 0x7c, 0x2c, 0x8e, 0xaa, 0xb7, 0x53, 0xe4, 0xce, 0x66, 0x61, 0xd6, 0xba, 0x51, 0xae, 0xe4,
-	0xc2, 0x58, 0xd9, 0x68, 0xf2, 0x83, 0x81, 0xff, 0xd1, 0xd9, 0x0c, 0xa9, 0x80, 0x29, 0xe7, 0xaf,
-	0x78, 0x4e, 0xd8, 0x60, 0xcf, 0x58, 0xc0, 0xa3, 0x43, 0x6f, 0x8b, 0xd0, 0x94, 0x1b, 0xd5, 0xb6,
-	0xf7, 0x06, 0xea, 0xd1, 0x2a, 0x6d, 0xb2, 0x85, 0xb1, 0xaf, 0xbd, 0x53, 0xc9, 0x3e, 0x82, 0x0b,
-	0xb1, 0xa2, 0x3a, 0xcc, 0x2a, 0x8c, 0x6a, 0xd5, 0x6d, 0xbd, 0x65, 0xa0, 0x42, 0xff, 0x74, 0xb6,
-	0x74, 0x39, 0xe9, 0x78, 0x3b, 0x84, 0x2a, 0x23, 0xcd, 0x0e, 0xdd, 0x78, 0x13, 0x33, 0x70, 0x4e,
-	0x9d, 0x6b, 0x28, 0x36, 0xbc, 0xa0, 0x2e, 0x76, 0x74, 0xa6, 0x38, 0xf4, 0x3c, 0xb9, 0x07, 0xb9,
-	0x6e, 0xb4, 0x36, 0x6b, 0xc0, 0x10, 0x75, 0x9c, 0x80, 0x71, 0xae, 0xe3, 0xb7, 0x96, 0xb1, 0x42,
-	0xa5, 0xb7, 0x43, 0xf0, 0x9f, 0x54, 0xc2, 0xef, 0x10, 0x64, 0xdb, 0x6e, 0x34, 0xb6, 0x93, 0xc2,
-	0x25, 0xd4, 0xc2, 0x9c, 0x3d, 0x3d, 0x41, 0x79, 0x25, 0x33, 0xfb, 0xdf, 0x7e, 0xbf, 0xc9, 0x5c,
-	0xc1, 0x53, 0x76, 0x42, 0x5b, 0xd7, 0x15, 0xa9, 0x1a, 0x15, 0x05, 0xbf, 0x47, 0x90, 0x6d, 0xbb,
-	0xf5, 0x27, 0x18, 0xec, 0xad, 0xd3, 0x09, 0x06, 0x63, 0x0a, 0x45, 0xca, 0xd2, 0x60, 0x11, 0x5f,
-	0x4f, 0x32, 0x48, 0x15, 0x49, 0x1a, 0xb4, 0x77, 0xa3, 0x4b, 0xb7, 0x87, 0x7f, 0x22, 0xc8, 0xc5,
-	0xd7, 0x03, 0x2f, 0xa4, 0x3a, 0x48, 0xad, 0xa7, 0x79, 0xf3, 0x9f, 0xb8, 0x3a, 0xc8, 0xb2, 0x0c,
-	0x72, 0x07, 0xdf, 0xb6, 0xd3, 0xbf, 0x8b, 0x3d, 0x6d, 0xb5, 0x77, 0xdb, 0xbe, 0x09, 0x7b, 0xaf,
-	0x32, 0x08, 0x7f, 0x42, 0x30, 0xd2, 0x59, 0x14, 0x5c, 0x4a, 0xb5, 0x16, 0x5b, 0x55, 0xb3, 0xfc,
-	0x57, 0x1c, 0x1d, 0x63, 0xf1, 0x4b, 0x6f, 0x73, 0x64, 0xb2, 0x6b, 0xf8, 0x6a, 0x52, 0xb2, 0xae,
-	0xea, 0xe2, 0x0f, 0x08, 0x86, 0x8f, 0x6b, 0x83, 0x8b, 0xe9, 0x77, 0xa2, 0xab, 0x8c, 0xa6, 0x75,
-	0x5a, 0xb8, 0x36, 0x7c, 0xab, 0xd7, 0xf0, 0xbc, 0x34, 0x3c, 0x89, 0x2f, 0x25, 0xde, 0xa9, 0x96,
-	0xca, 0xe2, 0xfc, 0xe7, 0xc3, 0x3c, 0x3a, 0x38, 0xcc, 0xa3, 0x5f, 0x87, 0x79, 0xf4, 0xfa, 0x28,
-	0xdf, 0x77, 0x70, 0x94, 0xef, 0xfb, 0x7e, 0x94, 0xef, 0x7b, 0x3e, 0xa1, 0xb8, 0xdc, 0xd9, 0xb4,
-	0xea, 0x9e, 0xfd, 0xe2, 0x58, 0x43, 0xec, 0xf8, 0x8c, 0xaf, 0x0d, 0xca, 0xbf, 0xb0, 0xf2, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x0d, 0x4f, 0xe3, 0x5f, 0x07, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x33, 0x45,
+	0x18, 0xee, 0xf4, 0xab, 0xfc, 0x98, 0x1a, 0x20, 0x03, 0xd6, 0x52, 0x49, 0xa9, 0x0b, 0x42, 0x55,
+	0xba, 0x5b, 0x5a, 0xc3, 0xa1, 0x1a, 0x83, 0x25, 0x0a, 0x18, 0x21, 0xba, 0x46, 0x0f, 0x5e, 0x9a,
+	0x81, 0x9d, 0x6c, 0x37, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0xda, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
+	0xdd, 0x9b, 0x89, 0x67, 0xc3, 0xd5, 0x93, 0x17, 0xc2, 0x45, 0x82, 0x17, 0x63, 0x8c, 0x31, 0xe0,
+	0x1f, 0x62, 0x76, 0x66, 0xb6, 0x6e, 0xe9, 0x6e, 0x8b, 0xdf, 0xad, 0x3b, 0xf3, 0x3e, 0xcf, 0xfb,
+	0x3c, 0xbb, 0xef, 0xfb, 0x00, 0x54, 0x8e, 0x1d, 0xda, 0x75, 0xa8, 0xe6, 0xbb, 0xa6, 0x87, 0x0d,
+	0xa2, 0x9d, 0x6d, 0x1e, 0x11, 0x86, 0x37, 0xb5, 0x53, 0x9f, 0x78, 0x3d, 0xd5, 0xf5, 0x1c, 0xe6,
+	0xa0, 0x9c, 0xa8, 0x51, 0x65, 0x8d, 0x2a, 0x6b, 0x0a, 0x4b, 0xa6, 0xe3, 0x98, 0x1d, 0xa2, 0x61,
+	0xd7, 0xd2, 0xb0, 0x6d, 0x3b, 0x0c, 0x33, 0xcb, 0xb1, 0xa9, 0x40, 0x15, 0x56, 0x13, 0x98, 0x43,
+	0x16, 0x51, 0xb5, 0x28, 0xaa, 0x5a, 0xfc, 0x49, 0x93, 0x8d, 0xc4, 0xd5, 0x1b, 0x92, 0xe0, 0x08,
+	0x53, 0x22, 0xf4, 0xf4, 0x39, 0x5c, 0x6c, 0x5a, 0x36, 0xef, 0x26, 0x6b, 0x17, 0x4c, 0xc7, 0x74,
+	0x04, 0x47, 0xf0, 0x4b, 0x9c, 0x2a, 0x8b, 0xf0, 0xe5, 0x4f, 0x02, 0xdc, 0x8e, 0xef, 0x79, 0xc4,
+	0x66, 0x1f, 0x77, 0xb0, 0xad, 0x93, 0x53, 0x9f, 0x50, 0xa6, 0x7c, 0x04, 0xf3, 0xc3, 0x57, 0xd4,
+	0x75, 0x6c, 0x4a, 0x50, 0x15, 0x66, 0xdc, 0x0e, 0xb6, 0xf3, 0xa0, 0x04, 0xca, 0xd9, 0xda, 0x92,
+	0x1a, 0x6f, 0x5f, 0xe5, 0x18, 0x5e, 0xa9, 0x54, 0x64, 0xa3, 0xf7, 0x5c, 0xb7, 0x63, 0x11, 0x23,
+	0xd2, 0x08, 0x21, 0x98, 0xb1, 0x71, 0x97, 0x70, 0xb2, 0x69, 0x9d, 0xff, 0x56, 0x6a, 0xb2, 0xf9,
+	0x40, 0xb9, 0x6c, 0x9e, 0x83, 0x13, 0x6d, 0x62, 0x99, 0x6d, 0xc6, 0x11, 0xcf, 0x74, 0xf9, 0xa4,
+	0xec, 0x43, 0x85, 0x63, 0x3e, 0x13, 0x2a, 0x8c, 0x9d, 0xa0, 0xda, 0xa6, 0x3e, 0xfd, 0x94, 0x61,
+	0x46, 0xc2, 0x6e, 0xcb, 0x30, 0xdb, 0xc1, 0x94, 0xb5, 0x06, 0x28, 0x60, 0x70, 0xb4, 0xc7, 0x4f,
+	0x1a, 0xe9, 0x3c, 0x50, 0xbe, 0x86, 0x2b, 0x23, 0xa9, 0xa4, 0x92, 0x03, 0x98, 0x97, 0x96, 0x8d,
+	0xd6, 0x71, 0x58, 0xd2, 0xa2, 0x41, 0x4d, 0x3e, 0x5d, 0x02, 0xe5, 0x17, 0x9b, 0xf3, 0x7f, 0x5c,
+	0x55, 0x66, 0xc5, 0xdb, 0xa9, 0x50, 0xe3, 0xa4, 0x54, 0x55, 0xdf, 0xaa, 0xeb, 0x39, 0x3f, 0x96,
+	0x36, 0xe8, 0xfc, 0x61, 0x66, 0x0a, 0xcc, 0xa5, 0x15, 0x1d, 0x16, 0x78, 0xff, 0x03, 0xc7, 0xf0,
+	0x3b, 0xe4, 0x73, 0xe2, 0xd1, 0x60, 0x6c, 0x22, 0x16, 0xba, 0xfc, 0xa2, 0x15, 0x79, 0x6f, 0x50,
+	0x1c, 0x1d, 0xe2, 0x2e, 0x69, 0xcc, 0xdf, 0x0d, 0x77, 0x55, 0x2e, 0x01, 0x7c, 0x25, 0x96, 0x54,
+	0x9a, 0x39, 0x84, 0xb3, 0x92, 0xf5, 0x4c, 0x5e, 0xe5, 0x41, 0xe9, 0x59, 0x39, 0x5b, 0x7b, 0x2d,
+	0xe9, 0xf3, 0x0e, 0x10, 0xe9, 0x33, 0xdd, 0x01, 0xde, 0x78, 0x11, 0x1b, 0xf0, 0x25, 0xf1, 0x5d,
+	0x7d, 0xd6, 0x76, 0x3c, 0x8b, 0xf5, 0xa4, 0xa7, 0xb8, 0xea, 0x2d, 0x65, 0x17, 0xe6, 0x1e, 0x57,
+	0x4b, 0xb1, 0x79, 0x38, 0x89, 0x0d, 0xc3, 0x23, 0x94, 0x4a, 0xfb, 0xe1, 0x63, 0x3c, 0xd1, 0x97,
+	0x72, 0xfa, 0x82, 0x39, 0xda, 0xb3, 0x28, 0x73, 0xbc, 0xb0, 0x31, 0xfa, 0x00, 0xc2, 0xff, 0x76,
+	0x45, 0x0e, 0xf4, 0x5a, 0xe8, 0x38, 0x58, 0x2c, 0x55, 0x2c, 0x7a, 0x7f, 0xa6, 0xb1, 0x19, 0xce,
+	0x92, 0x1e, 0x41, 0x36, 0x16, 0xee, 0xae, 0x2a, 0x73, 0x5f, 0x85, 0xbb, 0x5b, 0x3a, 0xab, 0xaa,
+	0x35, 0xb5, 0xaa, 0xfc, 0x02, 0xe4, 0x20, 0x0f, 0x74, 0x96, 0x26, 0xf6, 0xe0, 0x24, 0xb1, 0x99,
+	0x67, 0x91, 0xf0, 0x4d, 0x97, 0x47, 0x2d, 0x92, 0x44, 0xbf, 0x6f, 0x33, 0xaf, 0xd7, 0xcc, 0x5c,
+	0xff, 0xb5, 0x9c, 0xd2, 0x43, 0x38, 0xda, 0x1d, 0x30, 0x91, 0xe6, 0x26, 0xd6, 0xc7, 0x9a, 0x10,
+	0x32, 0xc6, 0xbb, 0xa8, 0xfd, 0x3a, 0x05, 0x5f, 0xe0, 0x2e, 0xd0, 0xf7, 0x00, 0x66, 0x23, 0x81,
+	0x80, 0xb4, 0x24, 0xc5, 0x09, 0xa9, 0x52, 0xa8, 0x3e, 0x1d, 0x20, 0xe4, 0x29, 0x1b, 0x97, 0xbf,
+	0xfd, 0xf3, 0x5d, 0x7a, 0x0d, 0xad, 0x6a, 0x09, 0x71, 0x79, 0x2c, 0x40, 0xad, 0x20, 0x67, 0xd0,
+	0x0f, 0x00, 0x66, 0x23, 0xa1, 0x31, 0x46, 0xe0, 0x70, 0x1a, 0x8d, 0x11, 0x18, 0x93, 0x47, 0x4a,
+	0x9d, 0x0b, 0xac, 0xa0, 0x37, 0x93, 0x04, 0x62, 0x01, 0xe2, 0x02, 0xb5, 0xf3, 0x60, 0x67, 0x2f,
+	0xd0, 0x9f, 0x00, 0xe6, 0xe2, 0xd3, 0x05, 0x35, 0x46, 0x2a, 0x18, 0x99, 0x6e, 0x85, 0xb7, 0x9f,
+	0x0b, 0x2b, 0x8d, 0xec, 0x73, 0x23, 0xdb, 0xe8, 0x5d, 0x6d, 0xf4, 0x1f, 0xa6, 0xa1, 0xb0, 0xd3,
+	0xce, 0x23, 0x91, 0x7a, 0xf1, 0x4d, 0x1a, 0xa0, 0x9f, 0x01, 0x9c, 0x19, 0xcc, 0x19, 0x54, 0x1b,
+	0x29, 0x2d, 0x36, 0xe9, 0x0a, 0xf5, 0xff, 0x85, 0x91, 0x36, 0x9a, 0x37, 0xc3, 0xc1, 0xc3, 0x9d,
+	0xbd, 0x8e, 0xd6, 0x93, 0x9c, 0x3d, 0x4a, 0x3e, 0xf4, 0x23, 0x80, 0xd3, 0xfd, 0xd4, 0x41, 0x95,
+	0xd1, 0x33, 0xf1, 0x28, 0xcb, 0x0a, 0xea, 0x53, 0xcb, 0xa5, 0xe0, 0x77, 0x86, 0x05, 0x6f, 0x71,
+	0xc1, 0x2b, 0xe8, 0xd5, 0xc4, 0x99, 0xea, 0x8b, 0xfb, 0x09, 0xc0, 0x6c, 0x24, 0x1f, 0xc6, 0x4c,
+	0xfc, 0x70, 0x02, 0x8e, 0x99, 0xf8, 0x98, 0xe0, 0x52, 0xb6, 0x6f, 0x62, 0x52, 0x62, 0xdc, 0x9a,
+	0x06, 0xd3, 0xdf, 0x6a, 0x0b, 0xa6, 0xe6, 0xd6, 0xf5, 0x7d, 0x11, 0xdc, 0xde, 0x17, 0xc1, 0xdf,
+	0xf7, 0x45, 0xf0, 0xed, 0x43, 0x31, 0x75, 0xfb, 0x50, 0x4c, 0xfd, 0xfe, 0x50, 0x4c, 0x7d, 0xb1,
+	0x24, 0xe0, 0xd4, 0x38, 0x51, 0x2d, 0x47, 0xeb, 0x37, 0xd1, 0x58, 0xcf, 0x25, 0xf4, 0x68, 0x82,
+	0xff, 0xdb, 0x52, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xed, 0x7c, 0x3f, 0xac, 0x95, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +693,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// PlanHistory queries the actions performed on the upgrade plans, from the
+	// oldest to the most recent one.
+	PlanHistory(ctx context.Context, in *QueryPlanHistoryRequest, opts ...grpc.CallOption) (*QueryPlanHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -631,6 +752,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) PlanHistory(ctx context.Context, in *QueryPlanHistoryRequest, opts ...grpc.CallOption) (*QueryPlanHistoryResponse, error) {
+	out := new(QueryPlanHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/PlanHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -648,6 +778,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// PlanHistory queries the actions performed on the upgrade plans, from the
+	// oldest to the most recent one.
+	PlanHistory(context.Context, *QueryPlanHistoryRequest) (*QueryPlanHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -669,6 +802,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) PlanHistory(ctx context.Context, req *QueryPlanHistoryRequest) (*QueryPlanHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -764,6 +900,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PlanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/PlanHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanHistory(ctx, req.(*QueryPlanHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -788,6 +942,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "PlanHistory",
+			Handler:    _Query_PlanHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1087,6 +1245,90 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPlanHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1220,6 +1462,38 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPlanHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1964,6 +2238,212 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPlanHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PlanHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
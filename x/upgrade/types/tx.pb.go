@@ -206,44 +206,155 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgRescheduleUpgrade is the Msg/RescheduleUpgrade request type.
+type MsgRescheduleUpgrade struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the scheduled upgrade plan, guarding against
+	// rescheduling another plan than the one the proposal was about.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the new height at which the upgrade must be performed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgRescheduleUpgrade) Reset()         { *m = MsgRescheduleUpgrade{} }
+func (m *MsgRescheduleUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgrade) ProtoMessage()    {}
+func (*MsgRescheduleUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgRescheduleUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgrade.Merge(m, src)
+}
+func (m *MsgRescheduleUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgrade proto.InternalMessageInfo
+
+func (m *MsgRescheduleUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRescheduleUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRescheduleUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgRescheduleUpgradeResponse is the Msg/RescheduleUpgrade response type.
+type MsgRescheduleUpgradeResponse struct {
+}
+
+func (m *MsgRescheduleUpgradeResponse) Reset()         { *m = MsgRescheduleUpgradeResponse{} }
+func (m *MsgRescheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgradeResponse) ProtoMessage()    {}
+func (*MsgRescheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.Merge(m, src)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgRescheduleUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgRescheduleUpgrade")
+	proto.RegisterType((*MsgRescheduleUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgRescheduleUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0x3a, 0xc7, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x39, 0x5f, 0xda, 0xa2, 0xcb, 0x0f, 0xd1, 0xa1, 0x5b, 0x9c, 0x92, 0xad, 0x60, 0xa0,
-	0x67, 0x62, 0xd6, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0xfc, 0xae, 0xe7, 0x1b, 0xb4, 0x64, 0x11, 0x2a,
-	0xf4, 0x31, 0x5d, 0xae, 0x64, 0xc8, 0x25, 0x85, 0x29, 0x1a, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x25, 0x8c, 0xc5, 0x0a, 0xa5, 0x5e, 0x46, 0x2e, 0x01, 0xdf, 0xe2, 0x74, 0xe7, 0xc4,
-	0xbc, 0xe4, 0xd4, 0x1c, 0x0a, 0x43, 0xc0, 0xca, 0x91, 0x28, 0x4f, 0x48, 0xa3, 0x7a, 0x02, 0xc5,
-	0x6a, 0x25, 0x7d, 0x2e, 0x09, 0x74, 0x31, 0xbc, 0x1e, 0x30, 0x9a, 0xc1, 0xc4, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0xd4, 0xc6, 0xc8, 0xc5, 0x8f, 0x1e, 0x93, 0x5a, 0xb8, 0xe2, 0x00, 0x33, 0x94, 0xa4,
-	0x8c, 0x88, 0x57, 0x0b, 0x73, 0x90, 0x92, 0xf0, 0x29, 0x4c, 0x07, 0x09, 0x35, 0x30, 0x72, 0xf1,
-	0xa2, 0x06, 0xa7, 0x06, 0x1e, 0xa3, 0x51, 0x54, 0x4a, 0x19, 0x10, 0xab, 0x12, 0xaf, 0x13, 0xa4,
-	0x58, 0x1b, 0x40, 0x29, 0xcb, 0xc9, 0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x64, 0x20, 0x3a, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x79, 0xaa, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x3d, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0xb7, 0xaa, 0xa0, 0xdc, 0x03, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0xb9, 0x92, 0x46, 0xe2, 0xaa, 0x2a, 0x89, 0x83, 0x52, 0xd7, 0xa5, 0xc6, 0xb2, 0x3a,
+	0x20, 0x54, 0x7c, 0x40, 0x11, 0x03, 0x9d, 0x42, 0xa4, 0x6e, 0x48, 0x95, 0xa3, 0x2e, 0x5d, 0x2a,
+	0x07, 0x5f, 0x0f, 0x2b, 0xe0, 0xb3, 0x7c, 0x07, 0x4d, 0xb6, 0xa8, 0x43, 0x87, 0x4a, 0x91, 0xfa,
+	0x67, 0x64, 0x64, 0xe0, 0x2f, 0xe8, 0x14, 0x65, 0xa8, 0x22, 0xa6, 0x4e, 0x55, 0x05, 0x03, 0xff,
+	0x46, 0x85, 0xcf, 0x80, 0x1c, 0x3b, 0x88, 0x2a, 0x8b, 0x7d, 0x7e, 0xef, 0xf3, 0x7e, 0x7c, 0xfd,
+	0x9e, 0x0d, 0xf3, 0x6d, 0xca, 0x7a, 0x94, 0xa1, 0xbe, 0x47, 0x7c, 0xcb, 0xc6, 0x68, 0x50, 0x39,
+	0xc1, 0xdc, 0xaa, 0x20, 0x7e, 0x66, 0x78, 0x3e, 0xe5, 0x54, 0x3a, 0x10, 0x80, 0x11, 0x02, 0x46,
+	0x08, 0x28, 0x59, 0x42, 0x09, 0x0d, 0x10, 0x34, 0x3f, 0x09, 0x5a, 0x79, 0x2e, 0xe8, 0x4f, 0xc2,
+	0x11, 0x86, 0x0a, 0xd7, 0xab, 0x7b, 0x2a, 0x2d, 0x12, 0x0b, 0xea, 0x59, 0x48, 0xf5, 0x18, 0x41,
+	0x83, 0xca, 0xfc, 0x16, 0x3a, 0xf6, 0xac, 0x9e, 0xe3, 0x52, 0x14, 0x5c, 0x85, 0x49, 0xff, 0x05,
+	0xa0, 0xd4, 0x62, 0xe4, 0x98, 0x7e, 0xe6, 0x5f, 0x2c, 0x1f, 0x7f, 0x10, 0x89, 0xa4, 0x3a, 0xcc,
+	0x58, 0x7d, 0xde, 0xa1, 0xbe, 0xc3, 0xcf, 0x65, 0xa0, 0x81, 0x42, 0xa6, 0x29, 0x8f, 0x47, 0xa5,
+	0x6c, 0xd8, 0xcd, 0xa1, 0x6d, 0xfb, 0x98, 0xb1, 0x63, 0xee, 0x3b, 0x2e, 0x31, 0x57, 0xa8, 0xf4,
+	0x16, 0x6e, 0x79, 0x5d, 0xcb, 0x95, 0x1f, 0x69, 0xa0, 0xf0, 0xa4, 0x9a, 0x33, 0x92, 0x85, 0x1b,
+	0xef, 0xbb, 0x96, 0xdb, 0xcc, 0x5c, 0xff, 0xc9, 0xa7, 0xae, 0x66, 0xc3, 0x22, 0x30, 0x83, 0xa0,
+	0xc6, 0xd1, 0x78, 0x54, 0xda, 0x11, 0x11, 0x25, 0x66, 0x9f, 0x6a, 0x65, 0xa3, 0x56, 0xff, 0x3a,
+	0x1b, 0x16, 0x57, 0xf9, 0xbf, 0xcf, 0x86, 0xc5, 0x97, 0x2b, 0x02, 0xc5, 0x3b, 0xd7, 0x2b, 0x50,
+	0x89, 0x5b, 0x4d, 0xcc, 0x3c, 0xea, 0x32, 0xdc, 0xd8, 0x4f, 0x28, 0xa1, 0x5f, 0x02, 0xb8, 0xdb,
+	0x62, 0xe4, 0xc8, 0x72, 0xdb, 0xb8, 0xfb, 0xc0, 0x37, 0xd0, 0x38, 0xdc, 0x48, 0xc4, 0x8b, 0xa8,
+	0x88, 0x48, 0x69, 0x1d, 0x41, 0xf9, 0xae, 0x6d, 0xbd, 0x80, 0x9f, 0x00, 0x66, 0x5b, 0x8c, 0x98,
+	0x98, 0xb5, 0x3b, 0xd8, 0xee, 0x77, 0x1f, 0x3c, 0x46, 0x09, 0x6e, 0xb9, 0x56, 0x0f, 0x07, 0x63,
+	0xcc, 0x98, 0xc1, 0x59, 0x3a, 0x80, 0xdb, 0x1d, 0xec, 0x90, 0x0e, 0x97, 0xd3, 0x1a, 0x28, 0xa4,
+	0xcd, 0xf0, 0xa9, 0xf1, 0x6e, 0x3c, 0x2a, 0xed, 0x9e, 0x2d, 0x56, 0x50, 0x1b, 0x94, 0x8d, 0xaa,
+	0x51, 0x8e, 0x2b, 0xce, 0x47, 0x15, 0xc7, 0x7a, 0xd5, 0x6b, 0x30, 0x97, 0x64, 0x5f, 0x2a, 0xcf,
+	0x26, 0xd5, 0xa9, 0x5e, 0xa5, 0x61, 0xba, 0xc5, 0x88, 0xf4, 0x0d, 0xc0, 0x9d, 0xbb, 0x4b, 0x5c,
+	0xbc, 0x6f, 0xfd, 0xe2, 0x0b, 0xa2, 0x54, 0x37, 0x67, 0x17, 0x1d, 0xe9, 0xfb, 0x37, 0xf1, 0x59,
+	0x48, 0x17, 0x00, 0x3e, 0x8d, 0x6e, 0x52, 0x61, 0x4d, 0xea, 0x08, 0xa9, 0x94, 0x37, 0x25, 0xd7,
+	0xb7, 0x70, 0x09, 0xe0, 0x5e, 0x7c, 0x17, 0x5e, 0xaf, 0x49, 0x1e, 0xa3, 0x95, 0xda, 0xff, 0xd0,
+	0xcb, 0x76, 0xb2, 0x37, 0x09, 0x33, 0x52, 0x1e, 0x5f, 0xcc, 0x3f, 0xf2, 0x66, 0xfd, 0x7a, 0xa2,
+	0x82, 0xdb, 0x89, 0x0a, 0xfe, 0x4e, 0x54, 0xf0, 0x63, 0xaa, 0xa6, 0x6e, 0xa7, 0x6a, 0xea, 0xf7,
+	0x54, 0x4d, 0x7d, 0xcc, 0x89, 0x5a, 0xcc, 0x3e, 0x35, 0x1c, 0x8a, 0x96, 0xf1, 0x88, 0x9f, 0x7b,
+	0x98, 0x9d, 0x6c, 0x07, 0x7f, 0xaa, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x02, 0xad, 0x5d,
+	0x37, 0x67, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +374,11 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height. Being a distinct message, its
+	// governance parameters, e.g. its quorum, may differ from the ones of
+	// SoftwareUpgrade.
+	RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -291,6 +407,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error) {
+	out := new(MsgRescheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/RescheduleUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -298,6 +423,11 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height. Being a distinct message, its
+	// governance parameters, e.g. its quorum, may differ from the ones of
+	// SoftwareUpgrade.
+	RescheduleUpgrade(context.Context, *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -310,6 +440,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) RescheduleUpgrade(ctx context.Context, req *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -351,6 +484,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RescheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/RescheduleUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, req.(*MsgRescheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -363,6 +514,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "RescheduleUpgrade",
+			Handler:    _Msg_RescheduleUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -484,6 +639,71 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRescheduleUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -541,6 +761,35 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRescheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgRescheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -844,6 +1093,189 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRescheduleUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,51 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PlanAction is an action performed on an upgrade plan.
+type PlanAction int32
+
+const (
+	// PLAN_ACTION_UNSPECIFIED defines a no-op action.
+	PLAN_ACTION_UNSPECIFIED PlanAction = 0
+	// PLAN_ACTION_SCHEDULED is the scheduling of the plan.
+	PLAN_ACTION_SCHEDULED PlanAction = 1
+	// PLAN_ACTION_CANCELLED is the cancellation of the plan.
+	PLAN_ACTION_CANCELLED PlanAction = 2
+	// PLAN_ACTION_RESCHEDULED is the move of the plan to another height.
+	PLAN_ACTION_RESCHEDULED PlanAction = 3
+	// PLAN_ACTION_SKIPPED is the skipping of the plan at its height, the node
+	// being configured to skip it.
+	PLAN_ACTION_SKIPPED PlanAction = 4
+	// PLAN_ACTION_APPLIED is the application of the plan at its height.
+	PLAN_ACTION_APPLIED PlanAction = 5
+)
+
+var PlanAction_name = map[int32]string{
+	0: "PLAN_ACTION_UNSPECIFIED",
+	1: "PLAN_ACTION_SCHEDULED",
+	2: "PLAN_ACTION_CANCELLED",
+	3: "PLAN_ACTION_RESCHEDULED",
+	4: "PLAN_ACTION_SKIPPED",
+	5: "PLAN_ACTION_APPLIED",
+}
+
+var PlanAction_value = map[string]int32{
+	"PLAN_ACTION_UNSPECIFIED": 0,
+	"PLAN_ACTION_SCHEDULED":   1,
+	"PLAN_ACTION_CANCELLED":   2,
+	"PLAN_ACTION_RESCHEDULED": 3,
+	"PLAN_ACTION_SKIPPED":     4,
+	"PLAN_ACTION_APPLIED":     5,
+}
+
+func (x PlanAction) String() string {
+	return proto.EnumName(PlanAction_name, int32(x))
+}
+
+func (PlanAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{0}
+}
+
 // Plan specifies information about a planned upgrade and when it should occur.
 type Plan struct {
 	// Sets the name for the upgrade. This name will be used by the upgraded
@@ -223,11 +268,58 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// PlanHistoryEntry records an action performed on an upgrade plan.
+type PlanHistoryEntry struct {
+	// action is the action performed on the plan.
+	Action PlanAction `protobuf:"varint,1,opt,name=action,proto3,enum=cosmos.upgrade.v1beta1.PlanAction" json:"action,omitempty"`
+	// plan is the plan after the action was performed.
+	Plan Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan"`
+	// block_height is the height of the block in which the action was performed.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// previous_height is the height of the plan before it was rescheduled.
+	PreviousHeight int64 `protobuf:"varint,4,opt,name=previous_height,json=previousHeight,proto3" json:"previous_height,omitempty"`
+}
+
+func (m *PlanHistoryEntry) Reset()         { *m = PlanHistoryEntry{} }
+func (m *PlanHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PlanHistoryEntry) ProtoMessage()    {}
+func (*PlanHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *PlanHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanHistoryEntry.Merge(m, src)
+}
+func (m *PlanHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanHistoryEntry proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cosmos.upgrade.v1beta1.PlanAction", PlanAction_name, PlanAction_value)
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*PlanHistoryEntry)(nil), "cosmos.upgrade.v1beta1.PlanHistoryEntry")
 }
 
 func init() {
@@ -235,41 +327,53 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0xcf, 0x04, 0xc3, 0x8a, 0xc9, 0x2e, 0x64, 0x4d, 0x20, 0x26, 0xcb, 0x3a, 0xd9, 0x68, 0xa5,
+	0x8d, 0x90, 0x62, 0x43, 0xd8, 0x53, 0x7a, 0xa8, 0x12, 0xc7, 0x15, 0x11, 0x69, 0xea, 0x3a, 0xc0,
+	0xa1, 0x97, 0xc8, 0x49, 0x86, 0x60, 0xe1, 0x78, 0x2c, 0x7b, 0x92, 0x36, 0xdf, 0xa0, 0xea, 0x89,
+	0x8f, 0x50, 0xa9, 0x97, 0xaa, 0x27, 0x0e, 0xf9, 0x10, 0xa8, 0x27, 0xc4, 0xa9, 0x52, 0xa5, 0xfe,
+	0x81, 0x03, 0xbd, 0x54, 0xfd, 0x0a, 0xd5, 0x8c, 0x6d, 0x08, 0x29, 0x45, 0x55, 0xd5, 0x4b, 0x34,
+	0xf3, 0xde, 0xfb, 0xbd, 0xdf, 0xef, 0xfd, 0x5e, 0x3c, 0xf0, 0xdf, 0x36, 0xf6, 0x7a, 0xd8, 0x93,
+	0xfb, 0x4e, 0xd7, 0x35, 0x3a, 0x48, 0x1e, 0xac, 0xb7, 0x10, 0x31, 0xd6, 0xc3, 0xbb, 0xe4, 0xb8,
+	0x98, 0x60, 0x7e, 0xc9, 0xaf, 0x92, 0xc2, 0x68, 0x50, 0x95, 0x5a, 0xee, 0x62, 0xdc, 0xb5, 0x90,
+	0xcc, 0xaa, 0x5a, 0xfd, 0x3d, 0xd9, 0xb0, 0x87, 0x3e, 0x24, 0x95, 0xe8, 0xe2, 0x2e, 0x66, 0x47,
+	0x99, 0x9e, 0x82, 0x68, 0x7a, 0x12, 0x40, 0xcc, 0x1e, 0xf2, 0x88, 0xd1, 0x73, 0x82, 0x82, 0x65,
+	0x9f, 0xa9, 0xe9, 0x23, 0x03, 0x5a, 0x3f, 0xf5, 0xa7, 0xd1, 0x33, 0x6d, 0x2c, 0xb3, 0x5f, 0x3f,
+	0x94, 0xfd, 0x02, 0x20, 0xa7, 0x59, 0x86, 0xcd, 0xf3, 0x90, 0xb3, 0x8d, 0x1e, 0x12, 0x40, 0x06,
+	0xe4, 0x66, 0x75, 0x76, 0xe6, 0xef, 0x42, 0x8e, 0x76, 0x17, 0xa2, 0x19, 0x90, 0x8b, 0x15, 0x52,
+	0x92, 0x4f, 0x2d, 0x85, 0xd4, 0xd2, 0x76, 0x48, 0x5d, 0x9e, 0x3f, 0x7e, 0x97, 0x8e, 0x1c, 0xbe,
+	0x4f, 0x83, 0x97, 0x17, 0x47, 0xab, 0x40, 0x00, 0x3a, 0x03, 0xf2, 0x4b, 0x70, 0x66, 0x1f, 0x99,
+	0xdd, 0x7d, 0x22, 0x4c, 0x65, 0x40, 0x6e, 0x4a, 0x0f, 0x6e, 0x94, 0xcc, 0xb4, 0xf7, 0xb0, 0xc0,
+	0xf9, 0x64, 0xf4, 0xcc, 0xd7, 0xe0, 0x62, 0x60, 0x4e, 0xa7, 0xd9, 0xb6, 0x4c, 0x64, 0x93, 0xa6,
+	0x47, 0x0c, 0x82, 0x84, 0x69, 0xc6, 0x9e, 0xf8, 0x86, 0xbd, 0x64, 0x0f, 0xcb, 0x51, 0x01, 0xe8,
+	0x0b, 0x21, 0x4c, 0x61, 0xa8, 0x06, 0x05, 0x15, 0x85, 0x4f, 0xcf, 0xd3, 0xe0, 0xd9, 0xc5, 0xd1,
+	0xea, 0xbc, 0xef, 0x40, 0xde, 0xeb, 0x1c, 0xc8, 0x74, 0xd0, 0xec, 0x5b, 0x00, 0x93, 0x0d, 0xbc,
+	0x47, 0x1e, 0x1b, 0x2e, 0xda, 0xf1, 0x91, 0x9a, 0x8b, 0x1d, 0xec, 0x19, 0x16, 0x9f, 0x80, 0xd3,
+	0xc4, 0x24, 0x56, 0xe8, 0x82, 0x7f, 0xe1, 0x33, 0x30, 0xd6, 0x41, 0x5e, 0xdb, 0x35, 0x1d, 0x62,
+	0x62, 0x9b, 0xb9, 0x31, 0xab, 0x8f, 0x87, 0xf8, 0x3b, 0x90, 0x73, 0x2c, 0xc3, 0x66, 0x53, 0xc6,
+	0x0a, 0x2b, 0xd2, 0xcd, 0xcb, 0x96, 0x28, 0x7f, 0x79, 0x96, 0x5a, 0xc5, 0x6c, 0xd2, 0x19, 0xa8,
+	0xb8, 0x45, 0xa5, 0xbe, 0x1e, 0xe5, 0x53, 0x01, 0xaa, 0x8b, 0x07, 0x97, 0x08, 0x05, 0xdb, 0x04,
+	0xd9, 0x84, 0x0e, 0x92, 0x1d, 0x1b, 0xe4, 0x3b, 0xfa, 0x05, 0x90, 0x7d, 0x05, 0xe0, 0xdf, 0x8a,
+	0x61, 0xb7, 0x91, 0xf5, 0x8b, 0x67, 0x2c, 0x3e, 0xfc, 0x31, 0x99, 0xb9, 0x31, 0x99, 0xb7, 0x0a,
+	0x11, 0x40, 0x76, 0x17, 0xfe, 0x71, 0x1f, 0x77, 0xfa, 0x16, 0xda, 0x45, 0xae, 0x47, 0x7d, 0xbc,
+	0xe9, 0x4f, 0x28, 0xc0, 0xdf, 0x06, 0x7e, 0x9a, 0xa9, 0xe2, 0xf4, 0xf0, 0x5a, 0x4c, 0x52, 0x45,
+	0xa7, 0xa3, 0xfc, 0xd8, 0x8a, 0x33, 0x6b, 0xd2, 0xff, 0x1b, 0xd9, 0xcf, 0x00, 0xc6, 0xa9, 0xd7,
+	0x9b, 0xa6, 0x47, 0xb0, 0x3b, 0x54, 0x6d, 0xe2, 0x0e, 0xf9, 0x22, 0x9c, 0x31, 0xda, 0x6c, 0x38,
+	0xda, 0x7d, 0xae, 0x90, 0xbd, 0x6d, 0x4b, 0x25, 0x56, 0xa9, 0x07, 0x88, 0xcb, 0xfd, 0x46, 0x7f,
+	0x62, 0xbf, 0xfc, 0x3f, 0xf0, 0xf7, 0x96, 0x85, 0xdb, 0x07, 0xcd, 0x6b, 0x9f, 0x42, 0x8c, 0xc5,
+	0x36, 0xfd, 0xef, 0xe1, 0x3f, 0x38, 0xef, 0xb8, 0x68, 0x60, 0xe2, 0xbe, 0x17, 0x56, 0x71, 0xac,
+	0x6a, 0x2e, 0x0c, 0xfb, 0x85, 0xc5, 0xc4, 0xe9, 0x28, 0x1f, 0x7f, 0x12, 0xbe, 0x2d, 0x99, 0xc1,
+	0x9a, 0x54, 0x90, 0xd6, 0x56, 0x47, 0x00, 0xc2, 0x2b, 0xd5, 0xfc, 0x5f, 0x30, 0xa9, 0xd5, 0x4a,
+	0xf5, 0x66, 0x49, 0xd9, 0xae, 0x3e, 0xa8, 0x37, 0x77, 0xea, 0x0d, 0x4d, 0x55, 0xaa, 0xf7, 0xaa,
+	0x6a, 0x25, 0x1e, 0xe1, 0x97, 0xe1, 0xe2, 0x78, 0xb2, 0xa1, 0x6c, 0xaa, 0x95, 0x9d, 0x9a, 0x5a,
+	0x89, 0x83, 0xc9, 0x94, 0x52, 0xaa, 0x2b, 0x6a, 0x8d, 0xa6, 0xa2, 0x93, 0x2d, 0x75, 0xf5, 0x0a,
+	0x37, 0xc5, 0x27, 0xe1, 0xc2, 0xb5, 0x96, 0x5b, 0x55, 0x4d, 0x53, 0x2b, 0x71, 0x6e, 0x32, 0x51,
+	0xd2, 0xb4, 0x1a, 0x15, 0x31, 0x9d, 0xe2, 0x9e, 0xbe, 0x10, 0x23, 0xe5, 0xe2, 0xf1, 0x47, 0x31,
+	0x72, 0x7c, 0x26, 0x82, 0x93, 0x33, 0x11, 0x7c, 0x38, 0x13, 0xc1, 0xe1, 0xb9, 0x18, 0x39, 0x39,
+	0x17, 0x23, 0x6f, 0xce, 0xc5, 0xc8, 0xa3, 0x15, 0xdf, 0x64, 0xaf, 0x73, 0x20, 0x99, 0x58, 0xbe,
+	0x9c, 0x59, 0x26, 0x43, 0x07, 0x79, 0xad, 0x19, 0xf6, 0x0c, 0x6c, 0x7c, 0x0d, 0x00, 0x00, 0xff,
+	0xff, 0x1e, 0x8d, 0x10, 0x0e, 0x7e, 0x05, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -573,6 +677,54 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PlanHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.PreviousHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -662,6 +814,26 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *PlanHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovUpgrade(uint64(m.Action))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.BlockHeight))
+	}
+	if m.PreviousHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.PreviousHeight))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1232,6 +1404,146 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PlanHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PlanAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHeight", wireType)
+			}
+			m.PreviousHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0